@@ -0,0 +1,196 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fakeAI is a Groq-compatible chat completions endpoint answering every
+// prompt of the server's AI provider with a canned, valid reply after a fixed
+// latency, so that tailoring can be load tested without a model, quotas or
+// costs. Point groq.baseUrl at it.
+//
+// Replies are chosen by the JSON schema quoted in the prompt, and reuse the
+// bullet IDs and texts of the prompt so that they pass the server's
+// validation.
+type fakeAI struct {
+	latency time.Duration
+}
+
+var (
+	// selectionBulletID matches the bullets of selection prompts.
+	selectionBulletID = regexp.MustCompile(`\[ID: ([^\]]+)\]`)
+
+	// themeBulletID matches the achievements of theme grouping and impact
+	// scoring prompts.
+	themeBulletID = regexp.MustCompile(`(?m)^- \[([^\]]+)\]`)
+
+	// budgetedText matches the texts of shortening prompts.
+	budgetedText = regexp.MustCompile(`(?m)^- \[([^\]]+)\] \(at most (\d+) characters\) (.*)$`)
+)
+
+// maxFakeSelection is the most bullets the fake AI selects.
+const maxFakeSelection = 8
+
+func (f *fakeAI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/chat/completions") {
+		http.NotFound(w, r)
+		return
+	}
+
+	var req struct {
+		Messages []struct {
+			Content string `json:"content"`
+		} `json:"messages"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.Messages) == 0 {
+		http.Error(w, "invalid chat completion request", http.StatusBadRequest)
+		return
+	}
+
+	select {
+	case <-time.After(f.latency):
+	case <-r.Context().Done():
+		return
+	}
+
+	content, err := json.Marshal(fakeReply(req.Messages[0].Content))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"choices": []map[string]any{
+			{"message": map[string]string{"role": "assistant", "content": string(content)}},
+		},
+	})
+}
+
+// fakeReply returns the reply to a prompt, recognized by the keys of the
+// schema it quotes. Schemas sharing keys are told apart by the more specific
+// ones first.
+func fakeReply(prompt string) any {
+	switch {
+	case strings.Contains(prompt, `"selected_bullet_ids"`):
+		ids := submatches(selectionBulletID, prompt)
+		if len(ids) > maxFakeSelection {
+			ids = ids[:maxFakeSelection]
+		}
+		reasons := make(map[string]string, len(ids))
+		for _, id := range ids {
+			reasons[id] = "Relevant to the role."
+		}
+		return map[string]any{
+			"selected_bullet_ids": ids,
+			"reasoning":           "Selected the most relevant achievements.",
+			"bullet_reasons":      reasons,
+		}
+
+	case strings.Contains(prompt, `"translated_content"`):
+		bullet := originalBullet(prompt)
+		return map[string]any{"tailored_content": bullet, "translated_content": bullet, "keywords": []string{}}
+
+	case strings.Contains(prompt, `"tailored_content"`):
+		return map[string]any{"tailored_content": originalBullet(prompt), "keywords": []string{}}
+
+	case strings.Contains(prompt, `"shortened"`):
+		var shortened []map[string]string
+		for _, m := range budgetedText.FindAllStringSubmatch(prompt, -1) {
+			budget, _ := strconv.Atoi(m[2])
+			shortened = append(shortened, map[string]string{"id": m[1], "text": truncateWords(m[3], budget)})
+		}
+		return map[string]any{"shortened": shortened}
+
+	case strings.Contains(prompt, `"scores"`):
+		var scores []map[string]any
+		for _, id := range submatches(themeBulletID, prompt) {
+			scores = append(scores, map[string]any{"id": id, "score": 60})
+		}
+		return map[string]any{"scores": scores}
+
+	case strings.Contains(prompt, `"themes"`):
+		return map[string]any{
+			"themes": []map[string]any{{"name": "Delivery", "bullet_ids": submatches(themeBulletID, prompt)}},
+		}
+
+	case strings.Contains(prompt, `"verdict"`):
+		return map[string]any{
+			"score":           70,
+			"verdict":         "maybe",
+			"summary":         "A solid candidate for the role.",
+			"strengths":       []string{"Relevant experience"},
+			"weaknesses":      []string{"Few metrics"},
+			"red_flags":       []string{},
+			"suggested_edits": []any{},
+		}
+
+	case strings.Contains(prompt, `"breakdown"`):
+		return map[string]any{
+			"score":       75,
+			"breakdown":   map[string]int{"skills": 80, "experience": 75, "seniority": 70, "keywords": 75},
+			"explanation": "Good overall match.",
+		}
+
+	case strings.Contains(prompt, `"required_skills"`):
+		return map[string]any{
+			"title":            "Backend Engineer",
+			"company":          "Load Test Inc.",
+			"required_skills":  []string{"Go", "PostgreSQL"},
+			"preferred_skills": []string{"Kubernetes"},
+			"keywords":         []string{"APIs", "performance"},
+			"seniority_level":  "senior",
+			"years_experience": 5,
+			"summary":          "Build and operate backend services.",
+			"benefits":         []string{},
+		}
+
+	case strings.Contains(prompt, `"variants"`):
+		return map[string]any{
+			"variants": []map[string]string{
+				{"angle": "technical", "summary": "Backend engineer delivering reliable services."},
+				{"angle": "leadership", "summary": "Backend engineer leading reliable service delivery."},
+				{"angle": "impact", "summary": "Backend engineer whose services keep customers online."},
+			},
+		}
+
+	default:
+		return map[string]any{"summary": "Backend engineer delivering reliable services."}
+	}
+}
+
+// originalBullet returns the bullet quoted in a tailoring prompt, which the
+// fake AI returns unchanged.
+func originalBullet(prompt string) string {
+	_, rest, ok := strings.Cut(prompt, "ORIGINAL BULLET:\n")
+	if !ok {
+		return "Delivered reliable backend services."
+	}
+	bullet, _, _ := strings.Cut(rest, "\n")
+	return strings.TrimSpace(bullet)
+}
+
+// truncateWords cuts text after the last whole word within limit bytes.
+func truncateWords(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+	if i := strings.LastIndexByte(text[:limit+1], ' '); i > 0 {
+		return text[:i]
+	}
+	return text
+}
+
+// submatches returns the first group of every match of re in s.
+func submatches(re *regexp.Regexp, s string) []string {
+	var out []string
+	for _, m := range re.FindAllStringSubmatch(s, -1) {
+		out = append(out, m[1])
+	}
+	return out
+}
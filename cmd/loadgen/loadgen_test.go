@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/groq"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// TestFakeAI runs the server's AI provider against the fake one, so that
+// prompt changes that break the fake replies fail here rather than in a load
+// test.
+func TestFakeAI(t *testing.T) {
+	server := httptest.NewServer(&fakeAI{})
+	t.Cleanup(server.Close)
+
+	client, err := groq.New(groq.Config{
+		APIKey:            "fake-key", // pragma: allowlist secret
+		BaseURL:           server.URL,
+		MaxRepairAttempts: -1,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	analysis, err := client.AnalyzeJob(ctx, ports.AnalyzeJobRequest{JobDescription: scenarioJobDescription})
+	require.NoError(t, err)
+	assert.NotEmpty(t, analysis.RequiredSkills)
+
+	bullets := []domain.Bullet{
+		{ID: "b-1", Content: scenarioBullets[0]},
+		{ID: "b-2", Content: scenarioBullets[1]},
+	}
+	selection, err := client.SelectBullets(ctx, ports.SelectBulletsRequest{
+		JobAnalysis:      analysis,
+		AvailableBullets: bullets,
+		MaxBullets:       5,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b-1", "b-2"}, selection.SelectedBulletIDs)
+
+	for _, language := range []string{"en", "pt-BR"} {
+		tailored, err := client.TailorBullet(ctx, ports.TailorBulletRequest{
+			Bullet:         bullets[0],
+			JobAnalysis:    analysis,
+			TargetLanguage: language,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, domain.ValidateTailoredBullet(bullets[0].Content, tailored.Keywords, tailored.TailoredContent), language)
+	}
+
+	summary, err := client.GenerateSummary(ctx, ports.GenerateSummaryRequest{User: &domain.User{}, JobAnalysis: analysis, SelectedBullets: bullets})
+	require.NoError(t, err)
+	assert.NotEmpty(t, summary.Summary)
+	assert.Len(t, summary.Variants, 3)
+
+	content := &domain.ResumeContent{Summary: summary.Summary, Skills: []string{"Go"}}
+	score, err := client.ScoreMatch(ctx, ports.ScoreMatchRequest{JobAnalysis: analysis, Resume: content})
+	require.NoError(t, err)
+	assert.Positive(t, int(*score))
+
+	critique, err := client.CritiqueResume(ctx, ports.CritiqueResumeRequest{JobDescription: scenarioJobDescription, Resume: content})
+	require.NoError(t, err)
+	assert.NotEmpty(t, critique.Verdict)
+
+	themes, err := client.GroupBulletsByTheme(ctx, ports.GroupBulletsByThemeRequest{
+		JobAnalysis: analysis,
+		Bullets:     []domain.TailoredBullet{{BulletID: "b-1", TailoredContent: scenarioBullets[0]}},
+		MaxThemes:   3,
+	})
+	require.NoError(t, err)
+	require.Len(t, themes, 1)
+	assert.Equal(t, []string{"b-1"}, themes[0].BulletIDs)
+
+	shortened, err := client.ShortenTexts(ctx, ports.ShortenTextsRequest{
+		Texts: []ports.TextBudget{{ID: "b-1", Text: scenarioBullets[0], MaxLength: 20}},
+	})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(shortened["b-1"]), 20)
+	assert.True(t, strings.HasPrefix(scenarioBullets[0], shortened["b-1"]))
+
+	impact, err := client.ScoreBulletImpact(ctx, ports.ScoreBulletImpactRequest{
+		Bullets: []domain.Bullet{{ID: "b-1", Content: scenarioBullets[0]}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"b-1": 60}, impact)
+}
+
+func TestPercentile(t *testing.T) {
+	var latencies []time.Duration
+	for i := 1; i <= 100; i++ {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	assert.Equal(t, 50*time.Millisecond, percentile(latencies, 50))
+	assert.Equal(t, 95*time.Millisecond, percentile(latencies, 95))
+	assert.Equal(t, time.Millisecond, percentile(latencies[:1], 95))
+	assert.Zero(t, percentile(nil, 95))
+}
+
+func TestCheckBudgets(t *testing.T) {
+	reports := []endpointReport{
+		{Endpoint: "GET /v1/resumes", Requests: 100, P95: 80 * time.Millisecond},
+		{Endpoint: "POST /v1/resumes/{id}/tailor", Requests: 100, Errors: 5, P95: 6 * time.Second},
+	}
+
+	assert.Empty(t, checkBudgets(reports, budgets{"POST /v1/resumes/{id}/tailor": 8 * time.Second}, 100*time.Millisecond, 0.05))
+
+	violations := checkBudgets(reports, budgets{}, 100*time.Millisecond, 0.01)
+	assert.Equal(t, []string{
+		"POST /v1/resumes/{id}/tailor: p95 6s over budget 100ms",
+		"5 of 200 requests failed, over the 1.0% allowed",
+	}, violations)
+}
@@ -0,0 +1,226 @@
+// Command loadgen load tests a running server: virtual users run CRUD and
+// tailoring scenarios concurrently, and the p50/p95 latency of every endpoint
+// is reported and checked against performance budgets.
+//
+// Tailoring is load tested without a model by serving a fake AI provider
+// (see fakeAI) and pointing the server's groq.baseUrl at it:
+//
+//	go run ./cmd/loadgen -fake-ai :8089 -serve-only &
+//	CHAMELEON_GROQ_BASEURL=http://localhost:8089 go run ./cmd/server &
+//	go run ./cmd/loadgen -url http://localhost:8080 -tokens-file tokens.txt \
+//		-users 20 -duration 2m -budget 'POST /v1/resumes/{id}/tailor=8s'
+//
+// Virtual users authenticate with the given bearer tokens, taken in turn;
+// the accounts need a plan whose quotas allow the tailoring load.
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// budgets are the p95 latency budgets of endpoints, set with -budget.
+type budgets map[string]time.Duration
+
+func (b budgets) String() string {
+	parts := make([]string, 0, len(b))
+	for endpoint, budget := range b {
+		parts = append(parts, endpoint+"="+budget.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+func (b budgets) Set(value string) error {
+	endpoint, duration, ok := strings.Cut(value, "=")
+	if !ok {
+		return errors.New(`budget must be "METHOD /path=duration"`)
+	}
+	budget, err := time.ParseDuration(duration)
+	if err != nil {
+		return fmt.Errorf("invalid budget duration: %w", err)
+	}
+	b[strings.TrimSpace(endpoint)] = budget
+	return nil
+}
+
+func main() {
+	os.Exit(run())
+}
+
+// run runs the load test and returns the process exit code: 1 when a budget
+// is exceeded or too many requests fail, 2 on invalid flags.
+func run() int {
+	endpointBudgets := budgets{}
+	baseURL := flag.String("url", "http://localhost:8080", "base URL of the server")
+	tokenList := flag.String("tokens", "", "comma-separated bearer tokens of the virtual users")
+	tokensFile := flag.String("tokens-file", "", "file with one bearer token per line")
+	users := flag.Int("users", 10, "number of concurrent virtual users")
+	duration := flag.Duration("duration", time.Minute, "how long to run")
+	tailorEvery := flag.Int("tailor-every", 1, "tailor a resume every n iterations of a virtual user (0 disables tailoring)")
+	timeout := flag.Duration("timeout", 60*time.Second, "request timeout")
+	fakeAIAddr := flag.String("fake-ai", "", "serve the fake AI provider on this address, e.g. :8089")
+	fakeAILatency := flag.Duration("fake-ai-latency", 300*time.Millisecond, "latency of the fake AI provider's replies")
+	serveOnly := flag.Bool("serve-only", false, "only serve the fake AI provider, until interrupted")
+	defaultBudget := flag.Duration("default-budget", 0, "p95 latency budget of endpoints without their own (0 for none)")
+	maxErrorRate := flag.Float64("max-error-rate", 0.01, "highest fraction of failed requests")
+	flag.Var(endpointBudgets, "budget", `p95 latency budget of an endpoint, as "METHOD /path=duration" (repeatable)`)
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *fakeAIAddr != "" {
+		server := &http.Server{
+			Addr:              *fakeAIAddr,
+			Handler:           &fakeAI{latency: *fakeAILatency},
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		go func() {
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				fmt.Fprintf(os.Stderr, "fake AI provider: %v\n", err)
+				stop()
+			}
+		}()
+		defer server.Close()
+		fmt.Fprintf(os.Stderr, "Fake AI provider listening on %s\n", *fakeAIAddr)
+	}
+	if *serveOnly {
+		<-ctx.Done()
+		return 0
+	}
+
+	tokens, err := loadTokens(*tokenList, *tokensFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	if len(tokens) == 0 || *users < 1 {
+		fmt.Fprintln(os.Stderr, "at least one token (-tokens or -tokens-file) and one user are required")
+		return 2
+	}
+
+	results := newStats()
+	httpClient := &http.Client{
+		Timeout:   *timeout,
+		Transport: &http.Transport{MaxIdleConnsPerHost: *users},
+	}
+	baseClient := client{http: httpClient, baseURL: strings.TrimSuffix(*baseURL, "/"), stats: results}
+
+	// Make sure every account exists before the clock starts.
+	for _, token := range tokens {
+		c := baseClient
+		c.token = token
+		if err := c.syncUser(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to sync user: %v\n", err)
+			return 1
+		}
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, *duration)
+	defer cancel()
+
+	fmt.Fprintf(os.Stderr, "Running %d virtual users for %s against %s\n", *users, *duration, baseClient.baseURL)
+	start := time.Now()
+	var wg sync.WaitGroup
+	var iterations, failures int
+	var mu sync.Mutex
+	for i := range *users {
+		c := baseClient
+		c.token = tokens[i%len(tokens)]
+		wg.Go(func() {
+			for n := 0; runCtx.Err() == nil; n++ {
+				tailor := *tailorEvery > 0 && n%*tailorEvery == 0
+				err := c.runScenario(runCtx, n, tailor)
+
+				mu.Lock()
+				iterations++
+				if err != nil && runCtx.Err() == nil {
+					failures++
+					if failures <= 10 {
+						fmt.Fprintf(os.Stderr, "user %d: %v\n", i, err)
+					}
+				}
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	reports := results.report()
+	fmt.Printf("\n%d iterations (%d failed) in %s\n\n", iterations, failures, time.Since(start).Round(time.Second))
+	writeReport(os.Stdout, reports)
+
+	if violations := checkBudgets(reports, endpointBudgets, *defaultBudget, *maxErrorRate); len(violations) > 0 {
+		fmt.Println("\nPerformance budget exceeded:")
+		for _, v := range violations {
+			fmt.Println("  " + v)
+		}
+		return 1
+	}
+	return 0
+}
+
+// checkBudgets returns the endpoints whose p95 latency exceeds their budget,
+// or the default one, and whether too many requests failed overall.
+func checkBudgets(reports []endpointReport, endpointBudgets budgets, defaultBudget time.Duration, maxErrorRate float64) []string {
+	var violations []string
+	var requests, errs int
+	for _, r := range reports {
+		requests += r.Requests
+		errs += r.Errors
+
+		budget, ok := endpointBudgets[r.Endpoint]
+		if !ok {
+			budget = defaultBudget
+		}
+		if budget > 0 && r.P95 > budget {
+			violations = append(violations, fmt.Sprintf("%s: p95 %s over budget %s", r.Endpoint, r.P95.Round(time.Millisecond), budget))
+		}
+	}
+	if requests > 0 && float64(errs)/float64(requests) > maxErrorRate {
+		violations = append(violations, fmt.Sprintf("%d of %d requests failed, over the %.1f%% allowed", errs, requests, maxErrorRate*100))
+	}
+	return violations
+}
+
+// loadTokens returns the tokens of the comma-separated list and the file,
+// one per line, skipping blank lines.
+func loadTokens(list, file string) ([]string, error) {
+	var tokens []string
+	for token := range strings.SplitSeq(list, ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	if file == "" {
+		return tokens, nil
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tokens file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if token := strings.TrimSpace(scanner.Text()); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read tokens file: %w", err)
+	}
+	return tokens, nil
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// client makes the requests of a virtual user, recording them in stats.
+type client struct {
+	http    *http.Client
+	baseURL string
+	token   string
+	stats   *stats
+}
+
+// do sends a request to the endpoint, with body encoded as JSON when not
+// nil, and decodes the JSON response into out when not nil. Responses other
+// than wantStatus fail the request. path fills the endpoint's pattern.
+func (c *client) do(ctx context.Context, endpoint, path string, body any, wantStatus int, out any) error {
+	method, _, _ := strings.Cut(endpoint, " ")
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("%s: %w", endpoint, err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("%s: %w", endpoint, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		// Requests cut short by the end of the run are not failures.
+		if ctx.Err() == nil {
+			c.stats.record(endpoint, time.Since(start), true)
+		}
+		return fmt.Errorf("%s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	latency := time.Since(start)
+	if err != nil {
+		c.stats.record(endpoint, latency, true)
+		return fmt.Errorf("%s: failed to read response: %w", endpoint, err)
+	}
+
+	failed := resp.StatusCode != wantStatus
+	c.stats.record(endpoint, latency, failed)
+	if failed {
+		return fmt.Errorf("%s: status %d: %s", endpoint, resp.StatusCode, bytes.TrimSpace(respBody))
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("%s: failed to decode response: %w", endpoint, err)
+		}
+	}
+	return nil
+}
+
+// syncUser creates the virtual user's account, if new. It is not part of
+// the measured load.
+func (c *client) syncUser(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/v1/auth/sync", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+	return nil
+}
+
+// created is the part of created resources the scenario needs.
+type created struct {
+	ID string `json:"id"`
+}
+
+// scenarioBullets are the bullets added to each experience.
+var scenarioBullets = []string{
+	"Reduced API p99 latency by 40% by introducing connection pooling and query caching",
+	"Migrated 12 services from a monolith to Go microservices with zero downtime",
+	"Led a team of 5 engineers delivering the billing platform two weeks ahead of schedule",
+	"Built CI pipelines cutting release time from 2 hours to 15 minutes",
+}
+
+// scenarioJobDescription is the job the scenario tailors resumes to.
+const scenarioJobDescription = `Senior Backend Engineer
+
+We are looking for a senior backend engineer to design, build and operate
+Go services backed by PostgreSQL. Experience with Kubernetes, performance
+tuning and mentoring engineers is a plus.`
+
+// runScenario runs one iteration of a virtual user: it creates an
+// experience with bullets, reads and updates it, and, when tailor is set,
+// creates a resume, tailors it to a job, reads it and deletes it, before
+// deleting the experience. It stops at the first failed request.
+func (c *client) runScenario(ctx context.Context, iteration int, tailor bool) error {
+	var exp created
+	err := c.do(ctx, "POST /v1/experiences", "/v1/experiences", map[string]any{
+		"type":         "work",
+		"title":        fmt.Sprintf("Backend Engineer %d", iteration),
+		"organization": "Load Test Inc.",
+		"start_date":   "2020-01-15",
+		"is_current":   true,
+	}, http.StatusCreated, &exp)
+	if err != nil {
+		return err
+	}
+	expPath := "/v1/experiences/" + exp.ID
+	defer func() {
+		// Clean up even when the run ends, so that runs leave no data behind.
+		cleanup, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+		defer cancel()
+		_ = c.do(cleanup, "DELETE /v1/experiences/{id}", expPath, nil, http.StatusNoContent, nil)
+	}()
+
+	for _, bullet := range scenarioBullets {
+		if err := c.do(ctx, "POST /v1/experiences/{id}/bullets", expPath+"/bullets",
+			map[string]any{"content": bullet}, http.StatusCreated, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := c.do(ctx, "GET /v1/experiences", "/v1/experiences", nil, http.StatusOK, nil); err != nil {
+		return err
+	}
+	if err := c.do(ctx, "GET /v1/experiences/{id}", expPath, nil, http.StatusOK, nil); err != nil {
+		return err
+	}
+	if err := c.do(ctx, "PUT /v1/experiences/{id}", expPath,
+		map[string]any{"location": "Remote"}, http.StatusOK, nil); err != nil {
+		return err
+	}
+
+	if !tailor {
+		return nil
+	}
+
+	var resume created
+	err = c.do(ctx, "POST /v1/resumes", "/v1/resumes", map[string]any{
+		"job_description": scenarioJobDescription,
+		"job_title":       "Senior Backend Engineer",
+		"company_name":    "Load Test Inc.",
+		"target_language": "en",
+	}, http.StatusCreated, &resume)
+	if err != nil {
+		return err
+	}
+	resumePath := "/v1/resumes/" + resume.ID
+	defer func() {
+		cleanup, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+		defer cancel()
+		_ = c.do(cleanup, "DELETE /v1/resumes/{id}", resumePath, nil, http.StatusNoContent, nil)
+	}()
+
+	if err := c.do(ctx, "POST /v1/resumes/{id}/tailor", resumePath+"/tailor",
+		map[string]any{}, http.StatusOK, nil); err != nil {
+		return err
+	}
+	if err := c.do(ctx, "GET /v1/resumes/{id}", resumePath, nil, http.StatusOK, nil); err != nil {
+		return err
+	}
+	return c.do(ctx, "GET /v1/resumes", "/v1/resumes", nil, http.StatusOK, nil)
+}
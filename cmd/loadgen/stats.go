@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// stats records the latency and outcome of requests per endpoint. Endpoints
+// are a method and a path pattern, such as "GET /v1/resumes/{id}".
+type stats struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointStats
+}
+
+// endpointStats are the requests to one endpoint.
+type endpointStats struct {
+	latencies []time.Duration
+	errors    int
+}
+
+// endpointReport summarizes the requests to an endpoint.
+type endpointReport struct {
+	Endpoint string
+	Requests int
+	Errors   int
+	P50      time.Duration
+	P95      time.Duration
+	Max      time.Duration
+}
+
+func newStats() *stats {
+	return &stats{endpoints: make(map[string]*endpointStats)}
+}
+
+// record records a request; failed ones count as errors.
+func (s *stats) record(endpoint string, latency time.Duration, failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.endpoints[endpoint]
+	if !ok {
+		e = &endpointStats{}
+		s.endpoints[endpoint] = e
+	}
+	e.latencies = append(e.latencies, latency)
+	if failed {
+		e.errors++
+	}
+}
+
+// report summarizes every endpoint, sorted by endpoint.
+func (s *stats) report() []endpointReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reports := make([]endpointReport, 0, len(s.endpoints))
+	for endpoint, e := range s.endpoints {
+		latencies := slices.Clone(e.latencies)
+		slices.Sort(latencies)
+		reports = append(reports, endpointReport{
+			Endpoint: endpoint,
+			Requests: len(latencies),
+			Errors:   e.errors,
+			P50:      percentile(latencies, 50),
+			P95:      percentile(latencies, 95),
+			Max:      latencies[len(latencies)-1],
+		})
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Endpoint < reports[j].Endpoint })
+	return reports
+}
+
+// percentile returns the p-th percentile of sorted latencies, by the nearest
+// rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// writeReport writes the reports as a table.
+func writeReport(w io.Writer, reports []endpointReport) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ENDPOINT\tREQUESTS\tERRORS\tP50\tP95\tMAX")
+	for _, r := range reports {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\n", r.Endpoint, r.Requests, r.Errors,
+			r.P50.Round(time.Millisecond), r.P95.Round(time.Millisecond), r.Max.Round(time.Millisecond))
+	}
+	_ = tw.Flush()
+}
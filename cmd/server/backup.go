@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/postgres"
+	"github.com/SeltikHD/chameleon-vitae/internal/config"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// exportUserUsage documents the export-user command.
+const exportUserUsage = `Usage: server export-user -user <id> [flags]
+
+Writes a portable archive of all the data of a user, as JSON, for support or
+for moving the user to another instance with import-user. Cached PDFs,
+bullet embeddings, usage counts, linked sign-in methods and the plan are not
+included.
+
+Flags:
+`
+
+// importUserUsage documents the import-user command.
+const importUserUsage = `Usage: server import-user [flags]
+
+Creates a user with the data of an archive written by export-user or the
+admin export endpoint. IDs are kept unless -remap-ids is set, which gives the
+user and all their records new IDs, so that an archive can be imported into
+the instance it was exported from. The import is undone if it fails midway.
+
+Flags:
+`
+
+// newUserBackupService creates the UserBackupService of db.
+func newUserBackupService(db *postgres.DB) *services.UserBackupService {
+	return services.NewUserBackupService(
+		db.UserRepository(),
+		db.PreferencesRepository(),
+		db.ExperienceRepository(),
+		db.SkillRepository(),
+		db.SpokenLanguageRepository(),
+		db.EducationRepository(),
+		db.AcademicEntryRepository(),
+		db.ProjectRepository(),
+		db.ProjectBulletRepository(),
+		db.ResumeRepository(),
+		db.ResumeTagRepository(),
+		db.ResumeCritiqueRepository(),
+		db.BulletFeedbackRepository(),
+		db.ResumeActivityRepository(),
+		db.SavedResumeFilterRepository(),
+		db.AuditRepository(),
+	)
+}
+
+// runExportUser runs the export-user command and returns the process exit
+// code.
+func runExportUser(args []string) int {
+	flags := flag.NewFlagSet("export-user", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), exportUserUsage)
+		flags.PrintDefaults()
+	}
+	userID := flags.String("user", "", "ID of the user to export")
+	output := flags.String("out", "-", `file to write the archive to, or "-" for stdout`)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *userID == "" {
+		log.Error().Msg("-user is required")
+		return 2
+	}
+
+	return withDatabase(func(ctx context.Context, db *postgres.DB) int {
+		archive, err := newUserBackupService(db).ExportUser(ctx, "", *userID)
+		if err != nil {
+			log.Error().Err(err).Str("user_id", *userID).Msg("User export failed")
+			return 1
+		}
+
+		w := io.Writer(os.Stdout)
+		if *output != "-" {
+			file, err := os.Create(*output)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to create archive file")
+				return 1
+			}
+			defer file.Close()
+			w = file
+		}
+
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(archive); err != nil {
+			log.Error().Err(err).Msg("Failed to write archive")
+			return 1
+		}
+
+		log.Info().
+			Str("user_id", *userID).
+			Int("experiences", len(archive.Experiences)).
+			Int("resumes", len(archive.Resumes)).
+			Msg("User exported")
+		return 0
+	})
+}
+
+// runImportUser runs the import-user command and returns the process exit
+// code.
+func runImportUser(args []string) int {
+	flags := flag.NewFlagSet("import-user", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), importUserUsage)
+		flags.PrintDefaults()
+	}
+	input := flags.String("in", "-", `archive file to import, or "-" for stdin`)
+	remapIDs := flags.Bool("remap-ids", false, "give the user and their records new IDs")
+	firebaseUID := flags.String("firebase-uid", "", "sign-in of the imported user, instead of the archived one")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	r := io.Reader(os.Stdin)
+	if *input != "-" {
+		file, err := os.Open(*input)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to open archive file")
+			return 1
+		}
+		defer file.Close()
+		r = file
+	}
+
+	var archive services.UserArchive
+	if err := json.NewDecoder(r).Decode(&archive); err != nil {
+		log.Error().Err(err).Msg("Failed to read archive")
+		return 1
+	}
+
+	return withDatabase(func(ctx context.Context, db *postgres.DB) int {
+		user, err := newUserBackupService(db).ImportUser(ctx, services.ImportUserRequest{
+			Archive:     &archive,
+			RemapIDs:    *remapIDs,
+			FirebaseUID: *firebaseUID,
+		})
+		if err != nil {
+			log.Error().Err(err).Str("archived_user_id", archive.User.ID).Msg("User import failed")
+			return 1
+		}
+
+		fmt.Fprintln(os.Stdout, user.ID)
+		log.Info().
+			Str("user_id", user.ID).
+			Str("archived_user_id", archive.User.ID).
+			Msg("User imported")
+		return 0
+	})
+}
+
+// withDatabase loads the configuration, connects to the database and runs
+// fn, returning its exit code.
+func withDatabase(fn func(ctx context.Context, db *postgres.DB) int) int {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load configuration")
+		return 1
+	}
+	initLogger(cfg)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	db, err := initDatabase(ctx, cfg)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to initialize database")
+		return 1
+	}
+	defer db.Close()
+
+	return fn(ctx, db)
+}
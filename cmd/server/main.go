@@ -15,7 +15,7 @@
 //	@securityDefinitions.apikey	BearerAuth
 //	@in							header
 //	@name						Authorization
-//	@description				Firebase JWT token. Format: "Bearer {token}"
+//	@description				ID token of the configured Firebase or OIDC provider, or an impersonation token issued to an admin. Format: "Bearer {token}"
 //
 //	@externalDocs.description	OpenAPI
 //	@externalDocs.url			https://swagger.io/resources/open-api/
@@ -23,6 +23,8 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"expvar"
 	"fmt"
 	"net/http"
 	"os"
@@ -30,6 +32,9 @@ import (
 	"syscall"
 	"time"
 
+	// Embed the IANA time zone database for preference validation.
+	_ "time/tzdata"
+
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 
@@ -38,19 +43,46 @@ import (
 
 	// Adapters
 	httpAdapter "github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http"
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/avatar"
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/chromium"
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/clamav"
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/firebase"
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/gcs"
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/gotenberg"
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/groq"
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/jina"
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/kafka"
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/nats"
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/oidc"
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/openai"
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/postgres"
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/sentry"
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/storage"
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/webhook"
 
 	// Config and Services
 	"github.com/SeltikHD/chameleon-vitae/internal/config"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/pkg/circuitbreaker"
+	"github.com/SeltikHD/chameleon-vitae/pkg/httpclient"
+	"github.com/SeltikHD/chameleon-vitae/pkg/qrcode"
+	"github.com/SeltikHD/chameleon-vitae/pkg/signedurl"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "migrate-storage":
+			os.Exit(runMigrateStorage(os.Args[2:]))
+		case "export-user":
+			os.Exit(runExportUser(os.Args[2:]))
+		case "import-user":
+			os.Exit(runImportUser(os.Args[2:]))
+		}
+	}
+
 	// Initialize context for startup operations
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -77,30 +109,74 @@ func main() {
 	defer adapters.Close()
 
 	// Initialize services
-	svc := initializeServices(adapters)
+	svc := initializeServices(cfg, adapters)
+
+	// Resume the background jobs interrupted by the last shutdown
+	if resumed, err := svc.Jobs.Resume(ctx); err != nil {
+		log.Error().Err(err).Msg("Failed to resume background jobs")
+	} else if resumed > 0 {
+		log.Info().Int("jobs", resumed).Msg("Resumed background jobs")
+	}
+
+	// Announce the deprecation of v1, once configured
+	var deprecations map[string]httpAdapter.Deprecation
+	if !cfg.Server.V1DeprecatedAt.IsZero() {
+		deprecations = map[string]httpAdapter.Deprecation{
+			"/v1": {At: cfg.Server.V1DeprecatedAt, Sunset: cfg.Server.V1SunsetAt, Successor: "/v2"},
+		}
+	}
 
 	// Initialize HTTP router
 	routerCfg := httpAdapter.RouterConfig{
-		EnableSwagger:   cfg.Server.EnableSwagger,
-		EnableProfiling: cfg.Server.EnableProfiling,
-		RequestTimeout:  cfg.Server.WriteTimeout,
-		MaxRequestSize:  cfg.Server.MaxRequestSize,
-		AllowedOrigins:  cfg.Server.AllowedOrigins,
-		BaseURL:         fmt.Sprintf("http://%s:%d", cfg.Server.Host, cfg.Server.Port),
+		EnableSwagger:     cfg.Server.EnableSwagger,
+		EnableProfiling:   cfg.Server.EnableProfiling,
+		RequestTimeout:    cfg.Server.WriteTimeout,
+		MaxRequestSize:    cfg.Server.MaxRequestSize,
+		RequestSizeLimits: cfg.Server.RequestSizeLimits,
+		AllowedOrigins:    cfg.Server.AllowedOrigins,
+		BaseURL:           fmt.Sprintf("http://%s:%d", cfg.Server.Host, cfg.Server.Port),
+		AccessLog: httpAdapter.AccessLogConfig{
+			ExcludePaths:      cfg.Server.AccessLogExcludePaths,
+			SampleRates:       cfg.Server.AccessLogSampleRates,
+			DefaultSampleRate: cfg.Server.AccessLogDefaultSampleRate,
+		},
+		Files:         adapters.Files,
+		FileURLSigner: adapters.FileURLSigner,
+		AdminUserIDs:  cfg.Server.AdminUserIDs,
+		Deprecations:  deprecations,
+		ErrorReporter: adapters.ErrorReporter,
 	}
 
 	router := httpAdapter.NewRouter(routerCfg, httpAdapter.Services{
-		UserService:       svc.User,
-		ExperienceService: svc.Experience,
-		BulletService:     svc.Bullet,
-		SkillService:      svc.Skill,
-		ResumeService:     svc.Resume,
-		EducationService:  svc.Education,
-		ProjectService:    svc.Project,
+		UserService:        svc.User,
+		ExperienceService:  svc.Experience,
+		BulletService:      svc.Bullet,
+		SkillService:       svc.Skill,
+		ResumeService:      svc.Resume,
+		EducationService:   svc.Education,
+		AcademicService:    svc.Academic,
+		ProjectService:     svc.Project,
+		ImportService:      svc.Import,
+		QuotaService:       svc.Quota,
+		PreferencesService: svc.Preferences,
+		TimelineService:    svc.Timeline,
+		CritiqueService:    svc.Critique,
+		FeedbackService:    svc.Feedback,
+		ActivityService:    svc.Activity,
+		ResumeTagService:   svc.ResumeTag,
+		SavedFilterService: svc.SavedFilter,
+		SuggestService:     svc.Suggest,
+		StorageJanitor:     svc.StorageJanitor,
+
+		ImpersonationService: svc.Impersonation,
+
+		UserBackupService: svc.UserBackup,
+
+		ProfileBundleService: svc.ProfileBundle,
 	})
 
 	// Set up authentication middleware
-	router.SetAuthMiddleware(adapters.Firebase, adapters.DB.UserRepository())
+	router.SetAuthMiddleware(adapters.Auth, adapters.DB.UserRepository())
 
 	// Create HTTP server
 	server := &http.Server{
@@ -124,23 +200,128 @@ func main() {
 		}
 	}()
 
+	// Delete PDFs left behind by deleted resumes, rescore edited bullets and
+	// deliver resume events
+	pollersCtx, stopPollers := context.WithCancel(context.Background())
+	defer stopPollers()
+	if cfg.Storage.CleanupInterval > 0 {
+		go runStorageJanitor(pollersCtx, svc.StorageJanitor, cfg.Storage.CleanupInterval)
+	}
+	if cfg.Rescoring.Interval > 0 {
+		go runImpactRescoring(pollersCtx, svc.ImpactRescoring, cfg.Rescoring.Interval)
+	}
+	if svc.OutboxRelay != nil {
+		go runOutboxRelay(pollersCtx, svc.OutboxRelay, cfg.Outbox.PollInterval)
+	}
+
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Info().Msg("Shutting down server...")
+	stopPollers()
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer shutdownCancel()
 
 	if err := server.Shutdown(shutdownCtx); err != nil {
-		log.Fatal().Err(err).Msg("Server forced to shutdown")
+		log.Error().Err(err).Msg("Server forced to shutdown")
+	}
+
+	// Wait for background jobs, saving the unfinished ones for the next start
+	log.Info().Msg("Draining background jobs...")
+	saved, err := svc.Jobs.Drain(shutdownCtx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to save interrupted background jobs")
+	} else if saved > 0 {
+		log.Warn().Int("jobs", saved).Msg("Saved interrupted background jobs for the next start")
 	}
 
 	log.Info().Msg("Server stopped gracefully")
 }
 
+// runStorageJanitor deletes orphaned storage files every interval until ctx
+// is canceled.
+func runStorageJanitor(ctx context.Context, janitor *services.StorageJanitorService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := janitor.CleanupOrphans(ctx, false)
+			if err != nil {
+				log.Error().Err(err).Msg("Storage cleanup failed")
+				continue
+			}
+			log.Info().
+				Int("deleted", result.Deleted).
+				Int64("reclaimed_bytes", result.ReclaimableBytes).
+				Msg("Storage cleanup finished")
+		}
+	}
+}
+
+// runImpactRescoring rescores the bullets added or edited since they were
+// last scored every interval until ctx is canceled.
+func runImpactRescoring(ctx context.Context, rescoring *services.ImpactRescoringService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := rescoring.Rescore(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Error().Err(err).Msg("Impact rescoring failed")
+				}
+				continue
+			}
+			if result.Scored > 0 || result.Failed > 0 {
+				log.Info().
+					Int("scored", result.Scored).
+					Int("failed", result.Failed).
+					Msg("Bullet impact scores updated")
+			}
+		}
+	}
+}
+
+// runOutboxRelay delivers due events every interval until ctx is
+// canceled. Events whose delivery is interrupted are delivered on a later
+// poll, possibly by another instance.
+func runOutboxRelay(ctx context.Context, relay *services.OutboxRelay, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := relay.Deliver(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Error().Err(err).Msg("Outbox delivery failed")
+				}
+				continue
+			}
+			if result.Sent > 0 || result.Failed > 0 {
+				log.Info().
+					Int("sent", result.Sent).
+					Int("failed", result.Failed).
+					Msg("Outbox events delivered")
+			}
+		}
+	}
+}
+
 // initLogger initializes the zerolog logger based on configuration.
 func initLogger(cfg *config.Config) {
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
@@ -163,12 +344,35 @@ func initLogger(cfg *config.Config) {
 
 // Adapters holds all initialized adapters.
 type Adapters struct {
-	DB        *postgres.DB
-	Firebase  *firebase.Adapter
-	Groq      *groq.Client
-	Gotenberg *gotenberg.Client
-	Jina      *jina.Client
-	Storage   *storage.LocalStorage
+	DB         *postgres.DB
+	Auth       ports.AuthProvider
+	Groq       *groq.Client
+	PDF        ports.PDFEngine
+	Jina       *jina.Client
+	Embeddings *openai.Client
+	VirusScan  *clamav.Client
+	Storage    ports.FileStorage
+
+	// Avatars downloads the profile pictures cached in Storage; nil when
+	// disabled.
+	Avatars *avatar.Fetcher
+
+	// ErrorReporter receives recovered panics and unexpected service
+	// errors; nil when disabled.
+	ErrorReporter ports.ErrorReporter
+
+	// Events receive the events of the outbox: the webhook and the event
+	// bus, when enabled.
+	Events []ports.EventPublisher
+
+	// NATS is the event bus publisher when the provider is NATS; nil
+	// otherwise. Its connection is kept open until shutdown.
+	NATS *nats.Client
+
+	// Files serves stored files when storage is local, and FileURLSigner
+	// signs and verifies their URLs.
+	Files         http.Handler
+	FileURLSigner *signedurl.Signer
 }
 
 // Close closes all adapters gracefully.
@@ -177,9 +381,9 @@ func (a *Adapters) Close() {
 		a.DB.Close()
 		log.Debug().Msg("Database connection closed")
 	}
-	if a.Firebase != nil {
-		if err := a.Firebase.Close(); err != nil {
-			log.Error().Err(err).Msg("Failed to close Firebase adapter")
+	if a.Auth != nil {
+		if err := a.Auth.Close(); err != nil {
+			log.Error().Err(err).Msg("Failed to close auth provider")
 		}
 	}
 	if a.Groq != nil {
@@ -187,9 +391,9 @@ func (a *Adapters) Close() {
 			log.Error().Err(err).Msg("Failed to close Groq client")
 		}
 	}
-	if a.Gotenberg != nil {
-		if err := a.Gotenberg.Close(); err != nil {
-			log.Error().Err(err).Msg("Failed to close Gotenberg client")
+	if a.PDF != nil {
+		if err := a.PDF.Close(); err != nil {
+			log.Error().Err(err).Msg("Failed to close PDF engine")
 		}
 	}
 	if a.Jina != nil {
@@ -197,11 +401,31 @@ func (a *Adapters) Close() {
 			log.Error().Err(err).Msg("Failed to close Jina client")
 		}
 	}
+	if a.Embeddings != nil {
+		if err := a.Embeddings.Close(); err != nil {
+			log.Error().Err(err).Msg("Failed to close embeddings client")
+		}
+	}
+	if a.VirusScan != nil {
+		if err := a.VirusScan.Close(); err != nil {
+			log.Error().Err(err).Msg("Failed to close virus scanner")
+		}
+	}
 	if a.Storage != nil {
 		if err := a.Storage.Close(); err != nil {
 			log.Error().Err(err).Msg("Failed to close storage")
 		}
 	}
+	if a.ErrorReporter != nil {
+		if err := a.ErrorReporter.Close(); err != nil {
+			log.Error().Err(err).Msg("Failed to close error reporter")
+		}
+	}
+	if a.NATS != nil {
+		if err := a.NATS.Close(); err != nil {
+			log.Error().Err(err).Msg("Failed to close NATS connection")
+		}
+	}
 	log.Info().Msg("All adapters closed")
 }
 
@@ -209,78 +433,155 @@ func (a *Adapters) Close() {
 func initializeAdapters(ctx context.Context, cfg *config.Config) (*Adapters, error) {
 	adapters := &Adapters{}
 
-	// Initialize PostgreSQL
-	log.Info().Msg("Connecting to PostgreSQL...")
-	dbCfg := postgres.Config{
-		Host:              cfg.Database.Host,
-		Port:              cfg.Database.Port,
-		User:              cfg.Database.User,
-		Password:          cfg.Database.Password, // pragma: allowlist secret
-		Database:          cfg.Database.Database,
-		SSLMode:           cfg.Database.SSLMode,
-		MaxConns:          cfg.Database.MaxOpenConns,
-		MinConns:          cfg.Database.MaxIdleConns,
-		MaxConnLifetime:   cfg.Database.ConnMaxLifetime,
-		MaxConnIdleTime:   cfg.Database.ConnMaxIdleTime,
-		HealthCheckPeriod: cfg.Database.HealthCheckPeriod,
+	// All external service adapters share the same circuit breaker settings.
+	breakerCfg := circuitbreaker.DefaultConfig()
+	breakerCfg.FailureThreshold = cfg.CircuitBreaker.FailureThreshold
+	breakerCfg.OpenTimeout = cfg.CircuitBreaker.OpenTimeout
+
+	// They also share one outbound transport, each with its own request timeout.
+	httpClients, err := httpclient.New(httpclient.Config{
+		ProxyURL:            cfg.HTTPClient.ProxyURL,
+		CAFiles:             cfg.HTTPClient.CAFiles,
+		MaxIdleConns:        cfg.HTTPClient.MaxIdleConns,
+		MaxIdleConnsPerHost: cfg.HTTPClient.MaxIdleConnsPerHost,
+		MaxConnsPerHost:     cfg.HTTPClient.MaxConnsPerHost,
+		IdleConnTimeout:     cfg.HTTPClient.IdleConnTimeout,
+		DialTimeout:         cfg.HTTPClient.DialTimeout,
+		TLSHandshakeTimeout: cfg.HTTPClient.TLSHandshakeTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize HTTP client: %w", err)
 	}
-	db, err := postgres.New(ctx, dbCfg)
+
+	// Initialize PostgreSQL
+	db, err := initDatabase(ctx, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to PostgreSQL: %w", err)
+		return nil, err
 	}
 	adapters.DB = db
-	log.Info().Msg("PostgreSQL connected successfully")
 
-	// Initialize Firebase
-	log.Info().Msg("Initializing Firebase authentication...")
-	fbCfg := firebase.Config{
-		ProjectID:       cfg.Firebase.ProjectID,
-		CredentialsFile: cfg.Firebase.CredentialsFile,
-	}
-	if cfg.Firebase.CredentialsJSON != "" {
-		fbCfg.CredentialsJSON = []byte(cfg.Firebase.CredentialsJSON)
-	}
-	fb, err := firebase.New(ctx, fbCfg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize Firebase: %w", err)
+	// Initialize auth provider
+	switch cfg.Auth.Provider {
+	case "oidc":
+		log.Info().Msg("Initializing OIDC authentication...")
+		oidcCfg := oidc.Config{
+			IssuerURL:           cfg.OIDC.IssuerURL,
+			ClientID:            cfg.OIDC.ClientID,
+			ClientSecret:        cfg.OIDC.ClientSecret, // pragma: allowlist secret
+			JWKSURL:             cfg.OIDC.JWKSURL,
+			JWKSRefreshInterval: cfg.OIDC.JWKSRefreshInterval,
+			ClockSkew:           cfg.OIDC.ClockSkew,
+			Claims: oidc.ClaimMapping{
+				UserID:        cfg.OIDC.ClaimUserID,
+				Email:         cfg.OIDC.ClaimEmail,
+				EmailVerified: cfg.OIDC.ClaimEmailVerified,
+				Name:          cfg.OIDC.ClaimName,
+				Picture:       cfg.OIDC.ClaimPicture,
+				Provider:      cfg.OIDC.ClaimProvider,
+			},
+			Timeout:    cfg.OIDC.Timeout,
+			HTTPClient: httpClients.Client(cfg.OIDC.Timeout),
+		}
+		oidcAdapter, err := oidc.New(ctx, oidcCfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize OIDC: %w", err)
+		}
+		adapters.Auth = oidcAdapter
+		log.Info().Msg("OIDC initialized successfully")
+	default:
+		log.Info().Msg("Initializing Firebase authentication...")
+		fbCfg := firebase.Config{
+			ProjectID:       cfg.Firebase.ProjectID,
+			CredentialsFile: cfg.Firebase.CredentialsFile,
+		}
+		if cfg.Firebase.CredentialsJSON != "" {
+			fbCfg.CredentialsJSON = []byte(cfg.Firebase.CredentialsJSON)
+		}
+		fb, err := firebase.New(ctx, fbCfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Firebase: %w", err)
+		}
+		adapters.Auth = fb
+		log.Info().Msg("Firebase initialized successfully")
 	}
-	adapters.Firebase = fb
-	log.Info().Msg("Firebase initialized successfully")
 
 	// Initialize Groq
 	log.Info().Msg("Initializing Groq AI provider...")
 	groqCfg := groq.Config{
-		APIKey:          cfg.Groq.APIKey, // pragma: allowlist secret
-		ModelGeneration: cfg.Groq.DefaultModel,
-		ModelAnalysis:   cfg.Groq.AnalysisModel,
-		MaxRetries:      cfg.Groq.MaxRetries,
-		Timeout:         cfg.Groq.RequestTimeout,
+		APIKey:                cfg.Groq.APIKey, // pragma: allowlist secret
+		BaseURL:               cfg.Groq.BaseURL,
+		ModelGeneration:       cfg.Groq.DefaultModel,
+		ModelAnalysis:         cfg.Groq.AnalysisModel,
+		MaxRetries:            cfg.Groq.MaxRetries,
+		MaxRepairAttempts:     cfg.Groq.MaxRepairAttempts,
+		Timeout:               cfg.Groq.RequestTimeout,
+		CacheTTL:              cfg.Groq.CacheTTL,
+		CacheMaxEntries:       cfg.Groq.CacheMaxEntries,
+		MaxConcurrentRequests: cfg.Groq.MaxConcurrentRequests,
+		MaxPromptTokens:       cfg.Groq.MaxPromptTokens,
+		CircuitBreaker:        breakerCfg,
+		HTTPClient:            httpClients.Client(cfg.Groq.RequestTimeout),
+		Prices: map[string]groq.ModelPrice{
+			cfg.Groq.AnalysisModel: {Input: cfg.Groq.AnalysisModelPrice.Input, Output: cfg.Groq.AnalysisModelPrice.Output},
+			cfg.Groq.DefaultModel:  {Input: cfg.Groq.DefaultModelPrice.Input, Output: cfg.Groq.DefaultModelPrice.Output},
+		},
 	}
 	groqClient, err := groq.New(groqCfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize Groq: %w", err)
 	}
 	adapters.Groq = groqClient
+	expvar.Publish("groq_limiter", expvar.Func(func() any {
+		return groqClient.LimiterStats()
+	}))
 	log.Info().Msg("Groq initialized successfully")
 
-	// Initialize Gotenberg
-	log.Info().Msg("Initializing Gotenberg PDF engine...")
-	gotenCfg := gotenberg.Config{
-		URL:     cfg.PDF.BaseURL,
-		Timeout: cfg.PDF.Timeout,
-	}
-	gotenClient, err := gotenberg.New(gotenCfg)
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize Gotenberg: %w", err)
+	// Initialize PDF engine
+	switch cfg.PDF.Engine {
+	case "chromium":
+		log.Info().Msg("Initializing local Chromium PDF engine...")
+		chromiumCfg := chromium.Config{
+			ExecPath:  cfg.PDF.ChromiumPath,
+			Timeout:   cfg.PDF.Timeout,
+			NoSandbox: cfg.PDF.ChromiumNoSandbox,
+		}
+		chromiumClient, err := chromium.New(chromiumCfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Chromium: %w", err)
+		}
+		adapters.PDF = chromiumClient
+		log.Info().Msg("Chromium initialized successfully")
+	default:
+		log.Info().Msg("Initializing Gotenberg PDF engine...")
+		gotenCfg := gotenberg.Config{
+			URL:                 cfg.PDF.BaseURL,
+			Timeout:             cfg.PDF.Timeout,
+			MaxRetries:          cfg.PDF.MaxRetries,
+			HealthCheckInterval: cfg.PDF.HealthCheckInterval,
+			CircuitBreaker:      breakerCfg,
+			HTTPClient:          httpClients.Client(cfg.PDF.Timeout),
+		}
+		gotenClient, err := gotenberg.New(gotenCfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Gotenberg: %w", err)
+		}
+		adapters.PDF = gotenClient
+		log.Info().Msg("Gotenberg initialized successfully")
 	}
-	adapters.Gotenberg = gotenClient
-	log.Info().Msg("Gotenberg initialized successfully")
 
 	// Initialize Jina
 	log.Info().Msg("Initializing Jina job parser...")
 	jinaCfg := jina.Config{
-		APIKey:  cfg.Jina.APIKey, // pragma: allowlist secret
-		Timeout: cfg.Jina.Timeout,
+		APIKey:               cfg.Jina.APIKey, // pragma: allowlist secret
+		Timeout:              cfg.Jina.Timeout,
+		BaseURL:              cfg.Jina.BaseURL,
+		AllowedDomains:       cfg.Jina.AllowedDomains,
+		BlockedDomains:       cfg.Jina.BlockedDomains,
+		AllowPrivateNetworks: cfg.Jina.AllowPrivateNetworks,
+		IgnoreRobots:         cfg.Jina.IgnoreRobots,
+		UserAgent:            cfg.Jina.UserAgent,
+		CircuitBreaker:       breakerCfg,
+		HTTPClient:           httpClients.Client(cfg.Jina.Timeout),
 	}
 	jinaClient, err := jina.New(jinaCfg)
 	if err != nil {
@@ -289,41 +590,263 @@ func initializeAdapters(ctx context.Context, cfg *config.Config) (*Adapters, err
 	adapters.Jina = jinaClient
 	log.Info().Msg("Jina initialized successfully")
 
-	// Initialize Local Storage
-	log.Info().Msg("Initializing file storage...")
+	// Initialize embeddings (optional)
+	if cfg.Embeddings.Provider == "openai" {
+		log.Info().Str("model", cfg.Embeddings.Model).Msg("Initializing embeddings provider...")
+		embeddingsClient, err := openai.New(openai.Config{
+			APIKey:         cfg.Embeddings.APIKey, // pragma: allowlist secret
+			BaseURL:        cfg.Embeddings.BaseURL,
+			Model:          cfg.Embeddings.Model,
+			Dimensions:     cfg.Embeddings.Dimensions,
+			BatchSize:      cfg.Embeddings.BatchSize,
+			Timeout:        cfg.Embeddings.Timeout,
+			CircuitBreaker: breakerCfg,
+			HTTPClient:     httpClients.Client(cfg.Embeddings.Timeout),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize embeddings: %w", err)
+		}
+		adapters.Embeddings = embeddingsClient
+		log.Info().Msg("Embeddings provider initialized successfully")
+	}
+
+	// Initialize profile picture caching (optional)
+	if cfg.Avatars.Cache {
+		avatarFetcher, err := avatar.New(avatar.Config{
+			AllowedDomains: cfg.Avatars.AllowedDomains,
+			Size:           cfg.Avatars.Size,
+			MaxBytes:       cfg.Avatars.MaxBytes,
+			Timeout:        cfg.Avatars.Timeout,
+			HTTPClient:     httpClients.Client(cfg.Avatars.Timeout),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize avatar fetcher: %w", err)
+		}
+		adapters.Avatars = avatarFetcher
+	}
+
+	// Initialize virus scanner (optional)
+	if cfg.VirusScan.Provider == "clamav" {
+		log.Info().Str("address", cfg.VirusScan.Address).Msg("Initializing ClamAV virus scanner...")
+		clamavClient, err := clamav.New(clamav.Config{
+			Network:        cfg.VirusScan.Network,
+			Address:        cfg.VirusScan.Address,
+			Timeout:        cfg.VirusScan.Timeout,
+			CircuitBreaker: breakerCfg,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize ClamAV: %w", err)
+		}
+		adapters.VirusScan = clamavClient
+		log.Info().Msg("ClamAV initialized successfully")
+	}
+
+	// Initialize error reporter (optional)
+	if cfg.ErrorReporting.Provider == "sentry" {
+		log.Info().Msg("Initializing Sentry error reporter...")
+		sentryClient, err := sentry.New(sentry.Config{
+			DSN:         cfg.ErrorReporting.DSN,
+			Environment: cfg.App.Environment,
+			Release:     cfg.App.Version,
+			Timeout:     cfg.ErrorReporting.Timeout,
+			SendPII:     cfg.ErrorReporting.SendPII,
+			HTTPClient:  httpClients.Client(cfg.ErrorReporting.Timeout),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Sentry: %w", err)
+		}
+		adapters.ErrorReporter = sentryClient
+		log.Info().Msg("Sentry initialized successfully")
+	}
+
+	// Initialize webhook event delivery (optional)
+	if cfg.Webhooks.URL != "" {
+		log.Info().Msg("Initializing webhook event publisher...")
+		webhookClient, err := webhook.New(webhook.Config{
+			URL:        cfg.Webhooks.URL,
+			Secret:     cfg.Webhooks.Secret,
+			Timeout:    cfg.Webhooks.Timeout,
+			HTTPClient: httpClients.Client(cfg.Webhooks.Timeout),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize webhooks: %w", err)
+		}
+		adapters.Events = append(adapters.Events, webhookClient)
+		log.Info().Msg("Webhook event publisher initialized successfully")
+	}
+
+	// Initialize event bus publishing (optional)
+	switch cfg.EventBus.Provider {
+	case "nats":
+		log.Info().Msg("Initializing NATS event publisher...")
+		natsClient, err := nats.New(nats.Config{
+			URL:           cfg.EventBus.NATSURL,
+			Token:         cfg.EventBus.NATSToken,
+			CredsFile:     cfg.EventBus.NATSCredsFile,
+			SubjectPrefix: cfg.EventBus.SubjectPrefix,
+			Timeout:       cfg.EventBus.Timeout,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize NATS: %w", err)
+		}
+		adapters.NATS = natsClient
+		adapters.Events = append(adapters.Events, natsClient)
+		log.Info().Msg("NATS event publisher initialized successfully")
+	case "kafka":
+		log.Info().Str("topic", cfg.EventBus.Topic).Msg("Initializing Kafka event publisher...")
+		kafkaClient, err := kafka.New(kafka.Config{
+			RESTProxyURL: cfg.EventBus.KafkaRESTURL,
+			Topic:        cfg.EventBus.Topic,
+			Timeout:      cfg.EventBus.Timeout,
+			HTTPClient:   httpClients.Client(cfg.EventBus.Timeout),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Kafka: %w", err)
+		}
+		adapters.Events = append(adapters.Events, kafkaClient)
+		log.Info().Msg("Kafka event publisher initialized successfully")
+	}
+
+	// Initialize file storage
+	if err := initStorage(ctx, cfg, adapters); err != nil {
+		return nil, err
+	}
+
+	return adapters, nil
+}
+
+// initDatabase connects to PostgreSQL.
+func initDatabase(ctx context.Context, cfg *config.Config) (*postgres.DB, error) {
+	log.Info().Msg("Connecting to PostgreSQL...")
+	dbCfg := postgres.Config{
+		Host:              cfg.Database.Host,
+		Port:              cfg.Database.Port,
+		User:              cfg.Database.User,
+		Password:          cfg.Database.Password, // pragma: allowlist secret
+		Database:          cfg.Database.Database,
+		SSLMode:           cfg.Database.SSLMode,
+		MaxConns:          cfg.Database.MaxOpenConns,
+		MinConns:          cfg.Database.MaxIdleConns,
+		MaxConnLifetime:   cfg.Database.ConnMaxLifetime,
+		MaxConnIdleTime:   cfg.Database.ConnMaxIdleTime,
+		HealthCheckPeriod: cfg.Database.HealthCheckPeriod,
+		ReplicaDSN:        cfg.Database.ReplicaDSN,
+		RowLevelSecurity:  cfg.Database.RowLevelSecurity,
+	}
+	db, err := postgres.New(ctx, dbCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to PostgreSQL: %w", err)
+	}
+	log.Info().
+		Bool("replica", db.HasReplica()).
+		Bool("row_level_security", dbCfg.RowLevelSecurity).
+		Msg("PostgreSQL connected successfully")
+
+	return db, nil
+}
+
+// initStorage initializes the file storage selected by storage.type. Local
+// storage also sets the handler serving its files and the signer of their URLs.
+func initStorage(ctx context.Context, cfg *config.Config, adapters *Adapters) error {
+	log.Info().Str("type", cfg.Storage.Type).Msg("Initializing file storage...")
+
+	if cfg.Storage.Type == "gcs" {
+		gcsStorage, err := gcs.New(ctx, gcs.Config{
+			Bucket:          cfg.Storage.GCSBucket,
+			CredentialsFile: cfg.Storage.GCSCredentialsFile,
+			URLTTL:          cfg.Storage.URLTTL,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to initialize storage: %w", err)
+		}
+		adapters.Storage = gcsStorage
+		log.Info().Msg("File storage initialized successfully")
+		return nil
+	}
+
+	signingKey := []byte(cfg.Storage.SigningKey)
+	if len(signingKey) == 0 {
+		log.Warn().Msg("storage.signingKey is not set; file URLs will stop working on restart")
+		signingKey = make([]byte, signedurl.MinKeySize)
+		if _, err := rand.Read(signingKey); err != nil {
+			return fmt.Errorf("failed to generate storage signing key: %w", err)
+		}
+	}
+	signer, err := signedurl.New(signingKey)
+	if err != nil {
+		return fmt.Errorf("failed to initialize file URL signer: %w", err)
+	}
+	adapters.FileURLSigner = signer
+
 	storageCfg := storage.LocalConfig{
 		BasePath: cfg.Storage.LocalPath,
 		BaseURL:  fmt.Sprintf("http://%s:%d/files", cfg.Server.Host, cfg.Server.Port),
+		Signer:   signer,
+		URLTTL:   cfg.Storage.URLTTL,
 	}
 	localStorage, err := storage.NewLocalStorage(storageCfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize storage: %w", err)
+		return fmt.Errorf("failed to initialize storage: %w", err)
 	}
 	adapters.Storage = localStorage
+	adapters.Files = localStorage.Handler()
 	log.Info().Msg("File storage initialized successfully")
 
-	return adapters, nil
+	return nil
 }
 
 // Services holds all initialized services.
 type Services struct {
-	User       *services.UserService
-	Experience *services.ExperienceService
-	Bullet     *services.BulletService
-	Skill      *services.SkillService
-	Resume     *services.ResumeService
-	Education  *services.EducationService
-	Project    *services.ProjectService
+	User        *services.UserService
+	Experience  *services.ExperienceService
+	Bullet      *services.BulletService
+	Skill       *services.SkillService
+	Resume      *services.ResumeService
+	Education   *services.EducationService
+	Academic    *services.AcademicService
+	Project     *services.ProjectService
+	Import      *services.ImportService
+	Quota       *services.QuotaService
+	Preferences *services.PreferencesService
+	Timeline    *services.TimelineService
+	Critique    *services.CritiqueService
+	Feedback    *services.FeedbackService
+	Activity    *services.ActivityService
+	ResumeTag   *services.ResumeTagService
+	SavedFilter *services.SavedFilterService
+	Suggest     *services.SuggestService
+
+	StorageJanitor  *services.StorageJanitorService
+	ImpactRescoring *services.ImpactRescoringService
+	Impersonation   *services.ImpersonationService
+	UserBackup      *services.UserBackupService
+	ProfileBundle   *services.ProfileBundleService
+
+	// Jobs runs the background work of requests, drained on shutdown.
+	Jobs *services.JobRunner
+
+	// OutboxRelay delivers events; nil without webhook and event bus.
+	OutboxRelay *services.OutboxRelay
 }
 
 // initializeServices initializes all application services.
-func initializeServices(adapters *Adapters) *Services {
+func initializeServices(cfg *config.Config, adapters *Adapters) *Services {
 	log.Info().Msg("Initializing services...")
 
 	userService := services.NewUserService(
 		adapters.DB.UserRepository(),
-		adapters.Firebase,
+		adapters.Auth,
 	)
+	userService.SetTrustedEmailProviders(cfg.Auth.TrustedEmailProviders)
+	if sessions, ok := adapters.Auth.(ports.SessionManager); ok {
+		userService.SetSessionManager(sessions)
+	}
+	if adapters.Avatars != nil {
+		apiURL := fmt.Sprintf("http://%s:%d", cfg.Server.Host, cfg.Server.Port)
+		userService.SetAvatarCache(adapters.Avatars, adapters.Storage, func(userID string) string {
+			return apiURL + "/v1/users/" + userID + "/avatar"
+		})
+	}
 
 	experienceService := services.NewExperienceService(
 		adapters.DB.ExperienceRepository(),
@@ -345,6 +868,10 @@ func initializeServices(adapters *Adapters) *Services {
 		adapters.DB.EducationRepository(),
 	)
 
+	academicService := services.NewAcademicService(
+		adapters.DB.AcademicEntryRepository(),
+	)
+
 	projectService := services.NewProjectService(
 		adapters.DB.ProjectRepository(),
 		adapters.DB.ProjectBulletRepository(),
@@ -360,20 +887,225 @@ func initializeServices(adapters *Adapters) *Services {
 		adapters.DB.EducationRepository(),
 		adapters.DB.ProjectRepository(),
 		adapters.Groq,
-		adapters.Gotenberg,
+		adapters.PDF,
 		adapters.Jina,
 		adapters.Storage,
 	)
+	resumeService.SetQRCodeEncoder(qrcode.Encoder{})
+	resumeService.SetBulletRanking(services.BulletRanking{
+		HalfLifeYears: cfg.Tailoring.RecencyHalfLifeYears,
+		ImpactWeight:  cfg.Tailoring.ImpactWeight,
+	})
+	resumeService.SetTailorBudget(services.TailorBudget{
+		Analysis:  cfg.Tailoring.AnalysisTimeout,
+		Selection: cfg.Tailoring.SelectionTimeout,
+		Tailoring: cfg.Tailoring.TailorTimeout,
+		Summary:   cfg.Tailoring.SummaryTimeout,
+		Scoring:   cfg.Tailoring.ScoringTimeout,
+		Themes:    cfg.Tailoring.ThemesTimeout,
+	})
+	if adapters.Embeddings != nil {
+		bulletService.SetEmbeddings(adapters.Embeddings, adapters.DB.BulletEmbeddingRepository())
+		resumeService.SetEmbeddings(
+			adapters.Embeddings,
+			adapters.DB.BulletEmbeddingRepository(),
+			cfg.Embeddings.PreRankLimit,
+		)
+	}
+
+	quotaService := services.NewQuotaService(
+		adapters.DB.UserRepository(),
+		adapters.DB.UsageRepository(),
+		map[domain.Plan]domain.PlanLimits{
+			domain.PlanFree: planLimits(cfg.Plans.Free),
+			domain.PlanPro:  planLimits(cfg.Plans.Pro),
+		},
+	)
+	resumeService.SetQuotas(quotaService)
+
+	preferencesService := services.NewPreferencesService(
+		adapters.DB.PreferencesRepository(),
+	)
+	resumeService.SetPreferences(preferencesService)
+
+	profileBundleService := services.NewProfileBundleService(
+		userService,
+		adapters.DB.UserRepository(),
+		adapters.DB.ExperienceRepository(),
+		adapters.DB.EducationRepository(),
+		adapters.DB.ProjectRepository(),
+		adapters.DB.SkillRepository(),
+		adapters.DB.SpokenLanguageRepository(),
+		adapters.DB,
+	)
+
+	timelineService := services.NewTimelineService(
+		adapters.DB.ExperienceRepository(),
+	)
+
+	critiqueService := services.NewCritiqueService(
+		adapters.DB.ResumeRepository(),
+		adapters.DB.ResumeCritiqueRepository(),
+		adapters.Groq,
+	)
+
+	feedbackService := services.NewFeedbackService(
+		adapters.DB.ResumeRepository(),
+		adapters.DB.BulletFeedbackRepository(),
+	)
+	resumeService.SetFeedback(adapters.DB.BulletFeedbackRepository())
+	resumeService.SetAcademicEntries(adapters.DB.AcademicEntryRepository())
+	if cfg.PDF.TemplateDir != "" {
+		customTemplates, err := services.LoadCustomTemplates(os.DirFS(cfg.PDF.TemplateDir))
+		if err != nil {
+			log.Fatal().Err(err).Str("dir", cfg.PDF.TemplateDir).Msg("Failed to load custom templates")
+		}
+		resumeService.SetCustomTemplates(customTemplates)
+		log.Info().Strs("templates", customTemplates.Names()).Msg("Custom templates loaded")
+	}
+
+	activityService := services.NewActivityService(
+		adapters.DB.ResumeRepository(),
+		adapters.DB.ResumeActivityRepository(),
+	)
+	resumeService.SetActivityLog(activityService)
+	critiqueService.SetActivityLog(activityService)
+
+	jobRunner := services.NewJobRunner(adapters.DB.BackgroundJobRepository())
+	resumeService.SetJobRunner(jobRunner)
+
+	var outboxRelay *services.OutboxRelay
+	if len(adapters.Events) > 0 {
+		userService.SetEventOutbox(true)
+		resumeService.SetEventOutbox(true)
+
+		// Claims outlast delivering a whole batch to every publisher.
+		var publishTimeout time.Duration
+		if cfg.Webhooks.URL != "" {
+			publishTimeout += cfg.Webhooks.Timeout
+		}
+		if cfg.EventBus.Provider != "" {
+			publishTimeout += cfg.EventBus.Timeout
+		}
+		outboxRelay = services.NewOutboxRelay(
+			adapters.DB.OutboxRepository(),
+			services.EventPublishers(adapters.Events),
+			services.OutboxRelayConfig{
+				BatchSize: cfg.Outbox.BatchSize,
+				Lease:     publishTimeout * time.Duration(cfg.Outbox.BatchSize+1),
+				Retention: cfg.Outbox.Retention,
+			},
+		)
+	}
+
+	if adapters.ErrorReporter != nil {
+		resumeService.SetErrorReporter(adapters.ErrorReporter)
+		critiqueService.SetErrorReporter(adapters.ErrorReporter)
+	}
+
+	resumeService.SetResumeTags(adapters.DB.ResumeTagRepository())
+
+	resumeTagService := services.NewResumeTagService(
+		adapters.DB.ResumeTagRepository(),
+		adapters.DB.ResumeRepository(),
+	)
+
+	savedFilterService := services.NewSavedFilterService(
+		adapters.DB.SavedResumeFilterRepository(),
+		resumeService,
+	)
+
+	suggestService := services.NewSuggestService(adapters.DB.SuggestionRepository())
+
+	storageJanitor := services.NewStorageJanitorService(
+		adapters.DB.ResumeRepository(),
+		adapters.Storage,
+	)
+
+	impactRescoring := services.NewImpactRescoringService(
+		adapters.DB.BulletRepository(),
+		adapters.Groq,
+		services.ImpactRescoringConfig{
+			BatchSize:  cfg.Rescoring.BatchSize,
+			MaxBullets: cfg.Rescoring.MaxBullets,
+			Pause:      cfg.Rescoring.Pause,
+		},
+	)
+
+	impersonationKey, err := impersonationSigningKey(cfg.Server.ImpersonationSigningKey)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to generate impersonation signing key")
+	}
+	impersonationSigner, err := signedurl.New(impersonationKey)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize impersonation token signer")
+	}
+	impersonationService := services.NewImpersonationService(
+		adapters.DB.UserRepository(),
+		adapters.DB.AuditRepository(),
+		impersonationSigner,
+		cfg.Server.ImpersonationTTL,
+	)
+
+	importService := services.NewImportService(
+		adapters.DB.ExperienceRepository(),
+	)
+	importService.SetUploadStorage(adapters.Storage)
+	if adapters.VirusScan != nil {
+		importService.SetVirusScanner(adapters.VirusScan)
+	}
 
 	log.Info().Msg("All services initialized successfully")
 
 	return &Services{
-		User:       userService,
-		Experience: experienceService,
-		Bullet:     bulletService,
-		Skill:      skillService,
-		Resume:     resumeService,
-		Education:  educationService,
-		Project:    projectService,
+		User:        userService,
+		Experience:  experienceService,
+		Bullet:      bulletService,
+		Skill:       skillService,
+		Resume:      resumeService,
+		Education:   educationService,
+		Academic:    academicService,
+		Project:     projectService,
+		Import:      importService,
+		Quota:       quotaService,
+		Preferences: preferencesService,
+		Timeline:    timelineService,
+		Critique:    critiqueService,
+		Feedback:    feedbackService,
+		Activity:    activityService,
+		ResumeTag:   resumeTagService,
+		SavedFilter: savedFilterService,
+		Suggest:     suggestService,
+
+		StorageJanitor:  storageJanitor,
+		ImpactRescoring: impactRescoring,
+		Impersonation:   impersonationService,
+		UserBackup:      newUserBackupService(adapters.DB),
+		ProfileBundle:   profileBundleService,
+		Jobs:            jobRunner,
+		OutboxRelay:     outboxRelay,
+	}
+}
+
+// impersonationSigningKey returns the configured key of impersonation
+// tokens, or a random one when none is configured.
+func impersonationSigningKey(configured string) ([]byte, error) {
+	if configured != "" {
+		return []byte(configured), nil
+	}
+	log.Warn().Msg("server.impersonation.signingKey is not set; impersonation tokens will stop working on restart")
+	key := make([]byte, signedurl.MinKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// planLimits converts configured plan limits to domain limits.
+func planLimits(cfg config.PlanLimitsConfig) domain.PlanLimits {
+	return domain.PlanLimits{
+		ResumesPerMonth:          cfg.ResumesPerMonth,
+		TailorsPerDay:            cfg.TailorsPerDay,
+		PDFRegenerationsPerMonth: cfg.PDFRegenerationsPerMonth,
 	}
 }
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/config"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// migrateStorageUsage documents the migrate-storage command.
+const migrateStorageUsage = `Usage: server migrate-storage -from <local|gcs> [flags]
+
+Copies stored files (cached resume PDFs) from the given storage to the one
+configured in storage.type, then points resume PDF URLs to the configured
+storage in a single transaction. Source files are kept.
+
+Flags:
+`
+
+// runMigrateStorage runs the migrate-storage command and returns the process
+// exit code.
+func runMigrateStorage(args []string) int {
+	flags := flag.NewFlagSet("migrate-storage", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), migrateStorageUsage)
+		flags.PrintDefaults()
+	}
+	from := flags.String("from", "", `source storage type, "local" or "gcs"`)
+	fromPath := flags.String("from-path", "./storage", "source directory, for local sources")
+	fromBucket := flags.String("from-bucket", "", "source bucket, for gcs sources")
+	fromCredentials := flags.String("from-credentials-file", "", "source service account file, for gcs sources")
+	prefixes := flags.String("prefixes", strings.Join(services.DefaultMigrationPrefixes, ","), "comma-separated key prefixes to copy")
+	dryRun := flags.Bool("dry-run", false, "list the files to copy without copying them")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to load configuration")
+		return 1
+	}
+	initLogger(cfg)
+
+	sourceCfg := *cfg
+	sourceCfg.Storage.Type = *from
+	sourceCfg.Storage.LocalPath = *fromPath
+	sourceCfg.Storage.GCSBucket = *fromBucket
+	sourceCfg.Storage.GCSCredentialsFile = *fromCredentials
+
+	switch {
+	case *from != "local" && *from != "gcs":
+		log.Error().Msg(`-from must be "local" or "gcs"`)
+		return 2
+	case *from == "gcs" && *fromBucket == "":
+		log.Error().Msg("-from-bucket is required for gcs sources")
+		return 2
+	case sourceCfg.Storage == cfg.Storage:
+		log.Error().Msg("source and destination storage are the same")
+		return 2
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	db, err := initDatabase(ctx, cfg)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to initialize database")
+		return 1
+	}
+	destination := &Adapters{DB: db}
+	defer destination.Close()
+
+	if err := initStorage(ctx, cfg, destination); err != nil {
+		log.Error().Err(err).Msg("Failed to initialize destination storage")
+		return 1
+	}
+	source := &Adapters{}
+	defer source.Close()
+
+	if err := initStorage(ctx, &sourceCfg, source); err != nil {
+		log.Error().Err(err).Msg("Failed to initialize source storage")
+		return 1
+	}
+
+	migration := services.NewStorageMigrationService(db.ResumeRepository())
+	result, err := migration.Migrate(ctx, services.MigrateStorageRequest{
+		Source:      source.Storage,
+		Destination: destination.Storage,
+		Prefixes:    strings.Split(*prefixes, ","),
+		DryRun:      *dryRun,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Storage migration failed")
+		return 1
+	}
+
+	for _, key := range result.Keys {
+		fmt.Fprintln(os.Stdout, key)
+	}
+	log.Info().
+		Int("files", len(result.Keys)).
+		Int("resumes_updated", result.ResumesUpdated).
+		Bool("dry_run", result.DryRun).
+		Msg("Storage migration finished")
+
+	return 0
+}
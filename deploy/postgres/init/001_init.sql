@@ -8,9 +8,12 @@
 -- Enable UUID extension for generating UUIDs
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
--- Enable pg_trgm for fuzzy text search (useful for matching skills)
+-- Enable pg_trgm for fuzzy text search (skill matching, type-ahead suggestions)
 CREATE EXTENSION IF NOT EXISTS pg_trgm;
 
+-- Enable pgvector for semantic search over bullet embeddings
+CREATE EXTENSION IF NOT EXISTS vector;
+
 -- ============================================================================
 -- Core Tables
 -- ============================================================================
@@ -20,6 +23,8 @@ CREATE TABLE IF NOT EXISTS users (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     firebase_uid VARCHAR(128) UNIQUE NOT NULL,
     picture_url VARCHAR(512),
+    -- Provider picture that picture_url was cached from
+    picture_source_url VARCHAR(2048),
     email VARCHAR(255),
     name VARCHAR(255),
     headline VARCHAR(255),
@@ -31,10 +36,23 @@ CREATE TABLE IF NOT EXISTS users (
     github_url VARCHAR(512),
     portfolio_url VARCHAR(512),
     preferred_language VARCHAR(10) DEFAULT 'en',
+    plan VARCHAR(20) NOT NULL DEFAULT 'free' CHECK (plan IN ('free', 'pro')),
+    -- Tokens issued before are rejected
+    sessions_revoked_at TIMESTAMP WITH TIME ZONE,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
 );
 
+-- Sign-in methods linked to a user besides the one they signed up with.
+CREATE TABLE IF NOT EXISTS user_identities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider_user_id VARCHAR(128) UNIQUE NOT NULL,
+    provider VARCHAR(50) NOT NULL DEFAULT '',
+    email VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
 -- Experiences (work, education, volunteer, freelance, etc.)
 CREATE TABLE IF NOT EXISTS experiences (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
@@ -57,8 +75,11 @@ CREATE TABLE IF NOT EXISTS experiences (
     organization VARCHAR(255) NOT NULL,
     location VARCHAR(255),
     start_date DATE NOT NULL,
+    start_date_precision VARCHAR(5) NOT NULL DEFAULT 'day' CHECK (start_date_precision IN ('day', 'month')),
     end_date DATE,
+    end_date_precision VARCHAR(5) NOT NULL DEFAULT 'day' CHECK (end_date_precision IN ('day', 'month')),
     is_current BOOLEAN DEFAULT FALSE,
+    exclude_from_tailoring BOOLEAN NOT NULL DEFAULT FALSE,
     description TEXT,
     url VARCHAR(512),
     metadata JSONB DEFAULT '{}',
@@ -78,6 +99,9 @@ CREATE TABLE IF NOT EXISTS bullets (
     -- of this bullet point. Used for prioritization during resume generation.
     -- Higher scores = more impressive achievements (quantifiable results, leadership, etc.)
     impact_score INTEGER DEFAULT 50 CHECK (impact_score >= 0 AND impact_score <= 100),
+    -- MD5 of the content impact_score was computed or given for; bullets
+    -- whose content no longer matches are rescored by the nightly rescoring.
+    impact_scored_hash CHAR(32),
     keywords TEXT[] DEFAULT '{}',
     metadata JSONB DEFAULT '{}',
     display_order INTEGER DEFAULT 0,
@@ -85,6 +109,16 @@ CREATE TABLE IF NOT EXISTS bullets (
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
 );
 
+-- Precomputed bullet embeddings for semantic pre-ranking.
+-- Recomputed when the bullet text (content_hash) or the model changes.
+CREATE TABLE IF NOT EXISTS bullet_embeddings (
+    bullet_id UUID PRIMARY KEY REFERENCES bullets(id) ON DELETE CASCADE,
+    model VARCHAR(100) NOT NULL,
+    content_hash CHAR(64) NOT NULL,
+    embedding vector(1536) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
 -- Skills with categories and proficiency
 CREATE TABLE IF NOT EXISTS skills (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
@@ -139,6 +173,24 @@ CREATE TABLE IF NOT EXISTS resumes (
         'rejected',
         'accepted'
     )),
+    -- Job insights extracted from the description; NULL when not mentioned
+    remote_policy VARCHAR(10) CHECK (remote_policy IN ('remote', 'hybrid', 'onsite')),
+    salary_min INTEGER CHECK (salary_min > 0),
+    salary_max INTEGER CHECK (salary_max > 0),
+    salary_currency CHAR(3),
+    salary_period VARCHAR(5) CHECK (salary_period IN ('year', 'month', 'hour')),
+    benefits TEXT[] NOT NULL DEFAULT '{}',
+    visa_sponsorship BOOLEAN,
+    qr_code VARCHAR(10) NOT NULL DEFAULT '' CHECK (qr_code IN ('', 'portfolio', 'website', 'linkedin', 'github')),
+    contact_priority TEXT[] NOT NULL DEFAULT '{}',
+    section_config JSONB NOT NULL DEFAULT '{}',
+    max_pages SMALLINT NOT NULL DEFAULT 1 CHECK (max_pages BETWEEN 1 AND 2),
+    included_experience_ids UUID[] DEFAULT '{}',
+    excluded_experience_ids UUID[] DEFAULT '{}',
+    selection_explanation JSONB,
+    archived_at TIMESTAMP WITH TIME ZONE,
+    pinned BOOLEAN NOT NULL DEFAULT FALSE,
+    last_accessed_at TIMESTAMP WITH TIME ZONE,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
 );
@@ -156,7 +208,10 @@ CREATE TABLE IF NOT EXISTS education (
     location VARCHAR(255),
     -- Date range
     start_date DATE,
+    start_date_precision VARCHAR(5) NOT NULL DEFAULT 'day' CHECK (start_date_precision IN ('day', 'month')),
     end_date DATE,  -- NULL means "Present" or "Expected"
+    end_date_precision VARCHAR(5) NOT NULL DEFAULT 'day' CHECK (end_date_precision IN ('day', 'month')),
+    is_expected BOOLEAN NOT NULL DEFAULT FALSE,  -- end_date is an expected graduation date
     -- Optional metadata
     gpa VARCHAR(20),  -- e.g., "3.8/4.0" or "First Class Honours"
     honors TEXT[],    -- e.g., {"Dean's List", "Summa Cum Laude"}
@@ -167,6 +222,30 @@ CREATE TABLE IF NOT EXISTS education (
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
 );
 
+-- Represents the academic sections of a CV: publications, grants, teaching
+-- and service. Rendered by the academic template only.
+CREATE TABLE IF NOT EXISTS academic_entries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    section VARCHAR(20) NOT NULL CHECK (section IN ('publications', 'grants', 'teaching', 'service')),
+    title TEXT NOT NULL,
+    organization VARCHAR(255),  -- Venue, funder, institution or body served
+    authors TEXT[] NOT NULL DEFAULT '{}',
+    amount VARCHAR(50),  -- Grants, e.g. "USD 250,000"
+    url TEXT,
+    description TEXT,
+    -- Date range; publications use end_date as the publication date
+    start_date DATE,
+    start_date_precision VARCHAR(5) NOT NULL DEFAULT 'day' CHECK (start_date_precision IN ('day', 'month')),
+    end_date DATE,
+    end_date_precision VARCHAR(5) NOT NULL DEFAULT 'day' CHECK (end_date_precision IN ('day', 'month')),
+    -- Display order (lower = higher priority)
+    display_order INTEGER NOT NULL DEFAULT 0,
+    -- Timestamps
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
 -- Represents side projects, open-source contributions, and personal work.
 -- Each project can have multiple bullets (achievements/features).
 CREATE TABLE IF NOT EXISTS projects (
@@ -183,7 +262,9 @@ CREATE TABLE IF NOT EXISTS projects (
     repository_url VARCHAR(500), -- Source code URL if different
     -- Date range
     start_date DATE,
+    start_date_precision VARCHAR(5) NOT NULL DEFAULT 'day' CHECK (start_date_precision IN ('day', 'month')),
     end_date DATE,  -- NULL means "Present" or ongoing
+    end_date_precision VARCHAR(5) NOT NULL DEFAULT 'day' CHECK (end_date_precision IN ('day', 'month')),
     -- Display order (lower = higher priority)
     display_order INTEGER NOT NULL DEFAULT 0,
     -- Timestamps
@@ -205,31 +286,199 @@ CREATE TABLE IF NOT EXISTS project_bullets (
     updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
 );
 
+-- Hiring-manager reviews of tailored resumes. Each row reviews one version of
+-- the generated content (content_hash), so improvements can be tracked.
+CREATE TABLE IF NOT EXISTS resume_critiques (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    resume_id UUID NOT NULL REFERENCES resumes(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    content_hash CHAR(64) NOT NULL,
+    score INTEGER NOT NULL CHECK (score >= 0 AND score <= 100),
+    verdict VARCHAR(20) NOT NULL CHECK (verdict IN ('interview', 'maybe', 'reject')),
+    summary TEXT NOT NULL DEFAULT '',
+    strengths JSONB NOT NULL DEFAULT '[]',
+    weaknesses JSONB NOT NULL DEFAULT '[]',
+    red_flags JSONB NOT NULL DEFAULT '[]',
+    suggested_edits JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Users' verdicts on tailored bullets, one per resume and bullet. Recent
+-- feedback steers later tailoring. bullet_id has no foreign key so feedback
+-- outlives deleted bullets.
+CREATE TABLE IF NOT EXISTS bullet_feedback (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    resume_id UUID NOT NULL REFERENCES resumes(id) ON DELETE CASCADE,
+    bullet_id UUID NOT NULL,
+    verdict VARCHAR(20) NOT NULL CHECK (verdict IN ('accepted', 'edited', 'rejected')),
+    tailored_content TEXT NOT NULL,
+    edited_content TEXT,
+    comment TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (resume_id, bullet_id)
+);
+
+-- Activity log of a resume: user notes, status changes, PDF generations and
+-- AI runs, in the order they happened.
+CREATE TABLE IF NOT EXISTS resume_activity (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    resume_id UUID NOT NULL REFERENCES resumes(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('note', 'status_change', 'pdf_generated', 'ai_run')),
+    note TEXT NOT NULL DEFAULT '',
+    from_status VARCHAR(20) NOT NULL DEFAULT '',
+    to_status VARCHAR(20) NOT NULL DEFAULT '',
+    detail VARCHAR(100) NOT NULL DEFAULT '',
+    ai_settings JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Append-only log of security-relevant actions, such as admin impersonation.
+-- Users are not referenced, so that entries outlive deleted accounts.
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    action VARCHAR(50) NOT NULL,
+    actor_id UUID NOT NULL,
+    subject_id UUID,
+    session_id UUID,
+    detail TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- User-defined labels for organizing resumes, e.g. "Backend" or "2025 Q1".
+CREATE TABLE IF NOT EXISTS resume_tags (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS resume_tag_assignments (
+    resume_id UUID NOT NULL REFERENCES resumes(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES resume_tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (resume_id, tag_id)
+);
+
+-- Named resume list searches users can run again.
+CREATE TABLE IF NOT EXISTS saved_resume_filters (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    search JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Metered actions counted against plan limits (resume creation, tailoring,
+-- PDF regeneration). Rows are kept when resumes are deleted.
+CREATE TABLE IF NOT EXISTS usage_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    action VARCHAR(30) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Background jobs (PDF caching) interrupted by a shutdown, run again on the
+-- next start. No foreign keys: jobs of deleted resumes are dropped when run.
+CREATE TABLE IF NOT EXISTS background_jobs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind VARCHAR(30) NOT NULL,
+    user_id UUID NOT NULL,
+    resume_id UUID NOT NULL,
+    params JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Events recorded in the same transaction as the user and resume changes
+-- they describe, delivered to webhooks and event buses by the outbox relay.
+-- No foreign keys: events outlive their users and resumes.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    type VARCHAR(50) NOT NULL,
+    user_id UUID NOT NULL,
+    resume_id UUID,
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Per-user defaults for resume generation and email opt-ins. Users without a
+-- row get the application defaults.
+CREATE TABLE IF NOT EXISTS user_preferences (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    default_template VARCHAR(20) NOT NULL DEFAULT 'jake' CHECK (default_template IN ('jake', 'europass')),
+    default_target_language VARCHAR(10) NOT NULL DEFAULT 'en',
+    default_max_bullets INTEGER NOT NULL DEFAULT 15 CHECK (default_max_bullets BETWEEN 1 AND 50),
+    default_font_size INTEGER NOT NULL DEFAULT 0 CHECK (default_font_size = 0 OR default_font_size BETWEEN 9 AND 12),
+    date_format VARCHAR(10) NOT NULL DEFAULT 'locale',
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    filename_pattern VARCHAR(100) NOT NULL DEFAULT '{name}_Resume_{target}',
+    email_product_updates BOOLEAN NOT NULL DEFAULT FALSE,
+    email_usage_alerts BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
 -- ============================================================================
 -- Indexes
 -- ============================================================================
 
 CREATE INDEX IF NOT EXISTS idx_users_firebase_uid ON users(firebase_uid);
+CREATE INDEX IF NOT EXISTS idx_users_email_lower ON users(LOWER(email));
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities(user_id);
 CREATE INDEX IF NOT EXISTS idx_experiences_user_id ON experiences(user_id);
 CREATE INDEX IF NOT EXISTS idx_experiences_type ON experiences(type);
 CREATE INDEX IF NOT EXISTS idx_experiences_user_type ON experiences(user_id, type);
+CREATE INDEX IF NOT EXISTS idx_experiences_title_trgm ON experiences USING GIN(title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_experiences_organization_trgm ON experiences USING GIN(organization gin_trgm_ops);
 CREATE INDEX IF NOT EXISTS idx_bullets_experience_id ON bullets(experience_id);
 CREATE INDEX IF NOT EXISTS idx_bullets_keywords ON bullets USING GIN(keywords);
 CREATE INDEX IF NOT EXISTS idx_bullets_impact_score ON bullets(impact_score DESC);
+CREATE INDEX IF NOT EXISTS idx_bullets_rescoring ON bullets(updated_at)
+    WHERE impact_scored_hash IS DISTINCT FROM md5(content);
+CREATE INDEX IF NOT EXISTS idx_bullet_embeddings_model ON bullet_embeddings(model);
+CREATE INDEX IF NOT EXISTS idx_bullet_embeddings_embedding ON bullet_embeddings USING hnsw (embedding vector_cosine_ops);
 CREATE INDEX IF NOT EXISTS idx_skills_user_id ON skills(user_id);
 CREATE INDEX IF NOT EXISTS idx_skills_name_trgm ON skills USING GIN(name gin_trgm_ops);
 CREATE INDEX IF NOT EXISTS idx_skills_category ON skills(category);
 CREATE INDEX IF NOT EXISTS idx_spoken_languages_user_id ON spoken_languages(user_id);
 CREATE INDEX IF NOT EXISTS idx_resumes_user_id ON resumes(user_id);
 CREATE INDEX IF NOT EXISTS idx_resumes_status ON resumes(status);
+CREATE INDEX IF NOT EXISTS idx_resumes_user_remote_policy ON resumes(user_id, remote_policy);
+CREATE INDEX IF NOT EXISTS idx_resumes_user_created ON resumes(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_resumes_user_score ON resumes(user_id, score);
+CREATE INDEX IF NOT EXISTS idx_resumes_user_language ON resumes(user_id, LOWER(target_language));
+CREATE INDEX IF NOT EXISTS idx_resumes_user_archived ON resumes(user_id, created_at DESC) WHERE archived_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_resumes_user_accessed ON resumes(user_id, last_accessed_at DESC NULLS LAST);
+CREATE INDEX IF NOT EXISTS idx_resumes_company_name_trgm ON resumes USING GIN(company_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_resumes_job_title_trgm ON resumes USING GIN(job_title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_resume_critiques_resume_created ON resume_critiques(resume_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_bullet_feedback_user_updated ON bullet_feedback(user_id, updated_at DESC);
+CREATE INDEX IF NOT EXISTS idx_resume_activity_resume_created ON resume_activity(resume_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_created ON audit_events(actor_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_events_subject_created ON audit_events(subject_id, created_at);
 CREATE INDEX IF NOT EXISTS idx_education_user_id ON education(user_id);
 CREATE INDEX IF NOT EXISTS idx_education_user_order ON education(user_id, display_order);
+CREATE INDEX IF NOT EXISTS idx_academic_entries_user_order ON academic_entries(user_id, display_order);
 CREATE INDEX IF NOT EXISTS idx_projects_user_id ON projects(user_id);
 CREATE INDEX IF NOT EXISTS idx_projects_user_order ON projects(user_id, display_order);
 CREATE INDEX IF NOT EXISTS idx_projects_tech_stack ON projects USING GIN(tech_stack);
 CREATE INDEX IF NOT EXISTS idx_project_bullets_project_id ON project_bullets(project_id);
 CREATE INDEX IF NOT EXISTS idx_project_bullets_project_order ON project_bullets(project_id, display_order);
+CREATE INDEX IF NOT EXISTS idx_usage_events_user_action_created ON usage_events(user_id, action, created_at);
+CREATE INDEX IF NOT EXISTS idx_outbox_events_due ON outbox_events(next_attempt_at) WHERE sent_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_sent ON outbox_events(sent_at) WHERE sent_at IS NOT NULL;
 CREATE UNIQUE INDEX idx_skills_user_name_unique ON skills (user_id, LOWER(name));
+CREATE UNIQUE INDEX idx_resume_tags_user_name_unique ON resume_tags (user_id, LOWER(name));
+CREATE INDEX IF NOT EXISTS idx_resume_tag_assignments_tag_id ON resume_tag_assignments(tag_id);
+CREATE INDEX IF NOT EXISTS idx_resume_tags_name_trgm ON resume_tags USING GIN(name gin_trgm_ops);
+CREATE UNIQUE INDEX idx_saved_resume_filters_user_name_unique ON saved_resume_filters (user_id, LOWER(name));
 
 -- ============================================================================
 -- Triggers for updated_at
@@ -268,6 +517,11 @@ CREATE TRIGGER update_education_updated_at
     FOR EACH ROW
     EXECUTE FUNCTION update_updated_at_column();
 
+CREATE TRIGGER update_academic_entries_updated_at
+    BEFORE UPDATE ON academic_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
 CREATE TRIGGER update_projects_updated_at
     BEFORE UPDATE ON projects
     FOR EACH ROW
@@ -278,6 +532,153 @@ CREATE TRIGGER update_project_bullets_updated_at
     FOR EACH ROW
     EXECUTE FUNCTION update_updated_at_column();
 
+CREATE TRIGGER update_user_preferences_updated_at
+    BEFORE UPDATE ON user_preferences
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_resume_tags_updated_at
+    BEFORE UPDATE ON resume_tags
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_bullet_feedback_updated_at
+    BEFORE UPDATE ON bullet_feedback
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_saved_resume_filters_updated_at
+    BEFORE UPDATE ON saved_resume_filters
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- ============================================================================
+-- Row-Level Security
+-- ============================================================================
+-- Defense in depth for database.rowLevelSecurity: the API sets
+-- app.current_user_id in the transaction of each statement made for a user,
+-- and these policies hide the rows of other users even when an ownership
+-- check is missing. Without the setting (workers, admin endpoints, or the
+-- mode disabled) every row is visible. Superusers and roles with BYPASSRLS
+-- skip the policies, so the API must connect with an ordinary role.
+-- Users, sign-in identities, jobs, outbox and audit events are looked up
+-- across users and are not covered.
+
+CREATE OR REPLACE FUNCTION app_user_allowed(owner UUID)
+RETURNS BOOLEAN AS $$
+    SELECT COALESCE(current_setting('app.current_user_id', true), '') = ''
+        OR owner::text = current_setting('app.current_user_id', true);
+$$ LANGUAGE sql STABLE;
+
+ALTER TABLE experiences ENABLE ROW LEVEL SECURITY;
+ALTER TABLE experiences FORCE ROW LEVEL SECURITY;
+CREATE POLICY experiences_owner ON experiences
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE skills ENABLE ROW LEVEL SECURITY;
+ALTER TABLE skills FORCE ROW LEVEL SECURITY;
+CREATE POLICY skills_owner ON skills
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE spoken_languages ENABLE ROW LEVEL SECURITY;
+ALTER TABLE spoken_languages FORCE ROW LEVEL SECURITY;
+CREATE POLICY spoken_languages_owner ON spoken_languages
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE resumes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE resumes FORCE ROW LEVEL SECURITY;
+CREATE POLICY resumes_owner ON resumes
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE education ENABLE ROW LEVEL SECURITY;
+ALTER TABLE education FORCE ROW LEVEL SECURITY;
+CREATE POLICY education_owner ON education
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE academic_entries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE academic_entries FORCE ROW LEVEL SECURITY;
+CREATE POLICY academic_entries_owner ON academic_entries
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE projects ENABLE ROW LEVEL SECURITY;
+ALTER TABLE projects FORCE ROW LEVEL SECURITY;
+CREATE POLICY projects_owner ON projects
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE resume_critiques ENABLE ROW LEVEL SECURITY;
+ALTER TABLE resume_critiques FORCE ROW LEVEL SECURITY;
+CREATE POLICY resume_critiques_owner ON resume_critiques
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE bullet_feedback ENABLE ROW LEVEL SECURITY;
+ALTER TABLE bullet_feedback FORCE ROW LEVEL SECURITY;
+CREATE POLICY bullet_feedback_owner ON bullet_feedback
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE resume_activity ENABLE ROW LEVEL SECURITY;
+ALTER TABLE resume_activity FORCE ROW LEVEL SECURITY;
+CREATE POLICY resume_activity_owner ON resume_activity
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE resume_tags ENABLE ROW LEVEL SECURITY;
+ALTER TABLE resume_tags FORCE ROW LEVEL SECURITY;
+CREATE POLICY resume_tags_owner ON resume_tags
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE saved_resume_filters ENABLE ROW LEVEL SECURITY;
+ALTER TABLE saved_resume_filters FORCE ROW LEVEL SECURITY;
+CREATE POLICY saved_resume_filters_owner ON saved_resume_filters
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE usage_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE usage_events FORCE ROW LEVEL SECURITY;
+CREATE POLICY usage_events_owner ON usage_events
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+ALTER TABLE user_preferences ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_preferences FORCE ROW LEVEL SECURITY;
+CREATE POLICY user_preferences_owner ON user_preferences
+    USING (app_user_allowed(user_id))
+    WITH CHECK (app_user_allowed(user_id));
+
+-- Rows without a user_id are owned through their parent.
+ALTER TABLE bullets ENABLE ROW LEVEL SECURITY;
+ALTER TABLE bullets FORCE ROW LEVEL SECURITY;
+CREATE POLICY bullets_owner ON bullets
+    USING (app_user_allowed((SELECT e.user_id FROM experiences e WHERE e.id = experience_id)))
+    WITH CHECK (app_user_allowed((SELECT e.user_id FROM experiences e WHERE e.id = experience_id)));
+
+ALTER TABLE bullet_embeddings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE bullet_embeddings FORCE ROW LEVEL SECURITY;
+CREATE POLICY bullet_embeddings_owner ON bullet_embeddings
+    USING (app_user_allowed((SELECT e.user_id FROM bullets b JOIN experiences e ON e.id = b.experience_id WHERE b.id = bullet_id)))
+    WITH CHECK (app_user_allowed((SELECT e.user_id FROM bullets b JOIN experiences e ON e.id = b.experience_id WHERE b.id = bullet_id)));
+
+ALTER TABLE project_bullets ENABLE ROW LEVEL SECURITY;
+ALTER TABLE project_bullets FORCE ROW LEVEL SECURITY;
+CREATE POLICY project_bullets_owner ON project_bullets
+    USING (app_user_allowed((SELECT p.user_id FROM projects p WHERE p.id = project_id)))
+    WITH CHECK (app_user_allowed((SELECT p.user_id FROM projects p WHERE p.id = project_id)));
+
+ALTER TABLE resume_tag_assignments ENABLE ROW LEVEL SECURITY;
+ALTER TABLE resume_tag_assignments FORCE ROW LEVEL SECURITY;
+CREATE POLICY resume_tag_assignments_owner ON resume_tag_assignments
+    USING (app_user_allowed((SELECT r.user_id FROM resumes r WHERE r.id = resume_id)))
+    WITH CHECK (app_user_allowed((SELECT r.user_id FROM resumes r WHERE r.id = resume_id)));
+
 -- ============================================================================
 -- Comments
 -- ============================================================================
@@ -287,15 +688,23 @@ COMMENT ON COLUMN users.firebase_uid IS 'Unique identifier from Firebase Authent
 COMMENT ON COLUMN users.headline IS 'Professional headline (e.g., "Senior Software Engineer")';
 COMMENT ON COLUMN users.summary IS 'Professional summary for the resume header';
 COMMENT ON COLUMN users.preferred_language IS 'ISO 639-1 language code for resume generation';
+COMMENT ON COLUMN users.plan IS 'Subscription plan (free, pro) that determines usage limits';
 
 COMMENT ON TABLE experiences IS 'Work experiences, projects, education, certifications, and other resume entries';
 COMMENT ON COLUMN experiences.type IS 'Type of experience: work, education, certification, project, freelance, volunteer, open_source, hackathon, side_project, event_organization, publication, award';
 COMMENT ON COLUMN experiences.display_order IS 'Order in which experiences appear within their type';
+COMMENT ON COLUMN experiences.start_date_precision IS 'Granularity of start_date: day, or month (stored as the 1st)';
+COMMENT ON COLUMN experiences.exclude_from_tailoring IS 'Whether tailoring leaves out the experience''s bullets unless a resume includes it';
 
 COMMENT ON TABLE bullets IS 'Atomic experience bullets for AI-powered resume tailoring';
 COMMENT ON COLUMN bullets.impact_score IS 'AI-calculated impact score (0-100) for prioritization. Higher = more impressive.';
+COMMENT ON COLUMN bullets.impact_scored_hash IS 'MD5 of the content the impact score was computed or given for; NULL or stale hashes are rescored nightly';
 COMMENT ON COLUMN bullets.keywords IS 'Keywords extracted from the bullet for job matching';
 
+COMMENT ON TABLE bullet_embeddings IS 'Embedding vectors of bullets used to pre-rank them against job descriptions';
+COMMENT ON COLUMN bullet_embeddings.embedding IS 'Embedding vector; the embeddings model must produce 1536 dimensions';
+COMMENT ON COLUMN bullet_embeddings.content_hash IS 'SHA-256 of the embedded text; a mismatch marks the embedding as stale';
+
 COMMENT ON TABLE skills IS 'User skills with proficiency levels';
 COMMENT ON COLUMN skills.category IS 'Skill category (e.g., Programming Languages, Frameworks, Tools)';
 COMMENT ON COLUMN skills.is_highlighted IS 'Whether to feature this skill prominently';
@@ -305,12 +714,67 @@ COMMENT ON TABLE spoken_languages IS 'Natural languages spoken by the user';
 COMMENT ON TABLE resumes IS 'Generated resumes tailored to specific job applications';
 COMMENT ON COLUMN resumes.score IS 'AI-calculated match score with job description (0-100)';
 COMMENT ON COLUMN resumes.target_language IS 'Language for resume generation (e.g., en, pt-br)';
+COMMENT ON COLUMN resumes.remote_policy IS 'Work location from the job description: remote, hybrid, onsite; NULL if not stated';
+COMMENT ON COLUMN resumes.salary_period IS 'Period the salary amounts are paid for: year, month, hour';
+COMMENT ON COLUMN resumes.visa_sponsorship IS 'Whether the job offers visa sponsorship; NULL if not mentioned';
+COMMENT ON COLUMN resumes.qr_code IS 'Profile link encoded as a QR code in the resume header; empty for none';
+COMMENT ON COLUMN resumes.contact_priority IS 'Header contact fields from the highest priority to the lowest; unlisted fields follow in the default order';
+COMMENT ON COLUMN resumes.section_config IS 'Per-section display options, e.g. {"skills_display": "bars"}';
+COMMENT ON COLUMN resumes.max_pages IS 'Pages the resume may span; content past them is left out of the PDF';
+COMMENT ON COLUMN resumes.included_experience_ids IS 'Experiences tailored for this resume even when excluded from tailoring';
+COMMENT ON COLUMN resumes.excluded_experience_ids IS 'Experiences left out when tailoring this resume';
+COMMENT ON COLUMN resumes.selection_explanation IS 'Why tailoring chose or dropped each bullet: {reasoning, bullets: [{bullet_id, outcome, reason}]}; NULL before tailoring';
+COMMENT ON COLUMN resumes.archived_at IS 'When the user archived the resume; NULL unless archived';
+COMMENT ON COLUMN resumes.pinned IS 'Whether the user pinned the resume, to list it first';
+COMMENT ON COLUMN resumes.last_accessed_at IS 'When the user last opened the resume; updated on reads at most once a minute';
+
+COMMENT ON TABLE resume_critiques IS 'AI hiring-manager reviews of tailored resumes, kept to track improvements across versions';
+COMMENT ON COLUMN resume_critiques.content_hash IS 'SHA-256 of the reviewed generated_content; identifies the resume version';
+COMMENT ON COLUMN resume_critiques.suggested_edits IS 'Array of {section, original, suggested, reason} objects';
+
+COMMENT ON TABLE bullet_feedback IS 'User verdicts on tailored bullets, aggregated into writing preferences for later tailoring';
+COMMENT ON COLUMN bullet_feedback.tailored_content IS 'Tailored text the verdict is about, kept as shown to the user';
+COMMENT ON COLUMN bullet_feedback.edited_content IS 'User rewrite of the bullet; set only when verdict is edited';
+
+COMMENT ON TABLE resume_activity IS 'Per-resume activity log tracking the lifecycle of a job application';
+COMMENT ON COLUMN resume_activity.detail IS 'PDF template for pdf_generated; AI operation (tailor, critique) for ai_run';
+COMMENT ON COLUMN resume_activity.ai_settings IS 'Seed, prompt versions, models and temperatures an ai_run was made with';
+
+COMMENT ON TABLE audit_events IS 'Append-only log of security-relevant actions such as admin impersonation';
+COMMENT ON COLUMN audit_events.action IS 'Action: impersonation_started, impersonated_request';
+COMMENT ON COLUMN audit_events.session_id IS 'Groups the events of one impersonation';
+
+COMMENT ON TABLE background_jobs IS 'Background jobs saved at shutdown to be resumed on the next start';
+COMMENT ON COLUMN background_jobs.params IS 'Kind-specific arguments, e.g. {template, density} for cache_pdf';
+
+COMMENT ON TABLE outbox_events IS 'Transactional outbox of user and resume events, delivered at least once to webhooks and event buses';
+COMMENT ON COLUMN outbox_events.next_attempt_at IS 'When the relay may deliver the event next; moved forward while a relay holds it and after failures';
+
+COMMENT ON TABLE resume_tags IS 'User-defined labels for organizing resumes; names are unique per user ignoring case';
+COMMENT ON TABLE resume_tag_assignments IS 'Tags on resumes (many-to-many)';
+
+COMMENT ON TABLE saved_resume_filters IS 'Named resume list searches; names are unique per user ignoring case';
+COMMENT ON COLUMN saved_resume_filters.search IS 'Search criteria keyed by the resume list query parameters';
+
+COMMENT ON TABLE academic_entries IS 'Publications, grants, teaching and service entries of academic CVs';
+COMMENT ON COLUMN academic_entries.section IS 'CV section: publications, grants, teaching, service';
+COMMENT ON COLUMN academic_entries.authors IS 'Publication authors in byline order';
 
 COMMENT ON TABLE education IS 'Formal education entries for resume generation (ADR-012)';
 COMMENT ON TABLE projects IS 'Side projects and personal work for resume generation (ADR-012)';
 COMMENT ON TABLE project_bullets IS 'Achievement bullets for projects, similar to experience bullets';
 
 COMMENT ON COLUMN education.display_order IS 'Custom sort order; lower values appear first';
+COMMENT ON COLUMN education.is_expected IS 'Whether end_date is an expected (future) graduation date';
 COMMENT ON COLUMN education.honors IS 'Array of honors/awards (e.g., Dean''s List, Cum Laude)';
 COMMENT ON COLUMN projects.tech_stack IS 'Array of technologies used (e.g., Python, React, Docker)';
 COMMENT ON COLUMN projects.display_order IS 'Custom sort order; lower values appear first';
+
+COMMENT ON TABLE usage_events IS 'Metered user actions counted against plan limits';
+COMMENT ON COLUMN usage_events.action IS 'Metered action: resume_created, tailor, pdf_regeneration';
+
+COMMENT ON TABLE user_preferences IS 'Per-user defaults for resume generation and email opt-ins';
+COMMENT ON COLUMN user_preferences.default_font_size IS 'Base font size in pt; 0 keeps each template default';
+COMMENT ON COLUMN user_preferences.date_format IS 'Resume date style: locale, mon_yyyy, mm/yyyy, yyyy-mm';
+COMMENT ON COLUMN user_preferences.timezone IS 'IANA time zone name';
+COMMENT ON COLUMN user_preferences.filename_pattern IS 'Downloaded resume file name with tokens such as {name}, {company} and {date}; the extension is appended';
@@ -42,52 +42,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/auth/sync": {
-            "post": {
-                "description": "Synchronizes a Firebase authenticated user with the local PostgreSQL database. Creates a new user if not exists, updates if exists (upsert behavior).",
-                "consumes": [
-                    "application/json"
+        "/v1/academic": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Returns the publications, grants, teaching and service entries of the authenticated user, ordered by display_order and then most recent first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "academic"
                 ],
-                "summary": "Sync user from Firebase",
+                "summary": "List academic entries",
                 "parameters": [
                     {
+                        "enum": [
+                            "publications",
+                            "grants",
+                            "teaching",
+                            "service"
+                        ],
                         "type": "string",
-                        "description": "Bearer token from Firebase",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "description": "User sync request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.SyncUserRequest"
-                        }
+                        "description": "Only entries of this section",
+                        "name": "section",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.SyncUserResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListAcademicEntriesResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request body",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Invalid or expired token",
+                    "422": {
+                        "description": "Unknown section",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -99,16 +97,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/bullets/{bulletID}": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing bullet",
+                "description": "Creates a publication, grant, teaching or service entry for the academic CV template. Dates are YYYY-MM-DD or YYYY-MM; publications use end_date as the publication date.",
                 "consumes": [
                     "application/json"
                 ],
@@ -116,32 +112,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bullets"
+                    "academic"
                 ],
-                "summary": "Update bullet",
+                "summary": "Create academic entry",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Bullet ID",
-                        "name": "bulletID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Bullet data",
+                        "description": "Academic entry data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateBulletRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.CreateAcademicEntryRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.BulletResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.AcademicEntryResponse"
                         }
                     },
                     "400": {
@@ -156,12 +145,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Bullet not found",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
-                        }
-                    },
                     "422": {
                         "description": "Validation failed",
                         "schema": {
@@ -175,30 +158,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/academic/{entryID}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a bullet",
+                "description": "Retrieves a specific academic entry by ID",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "bullets"
+                    "academic"
                 ],
-                "summary": "Delete bullet",
+                "summary": "Get academic entry",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Bullet ID",
-                        "name": "bulletID",
+                        "description": "Academic entry ID",
+                        "name": "entryID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.AcademicEntryResponse"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -207,7 +198,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Bullet not found",
+                        "description": "Academic entry not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -219,16 +210,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/bullets/{bulletID}/score": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Triggers AI recalculation of the bullet's impact score against a job description",
+                "description": "Updates an existing academic entry; omitted fields are left unchanged and empty strings clear optional ones",
                 "consumes": [
                     "application/json"
                 ],
@@ -236,24 +225,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "bullets"
+                    "academic"
                 ],
-                "summary": "Recalculate bullet score",
+                "summary": "Update academic entry",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Bullet ID",
-                        "name": "bulletID",
+                        "description": "Academic entry ID",
+                        "name": "entryID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Job description for analysis",
+                        "description": "Academic entry data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.AnalyzeBulletRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateAcademicEntryRequest"
                         }
                     }
                 ],
@@ -261,7 +250,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ScoreBulletResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.AcademicEntryResponse"
                         }
                     },
                     "400": {
@@ -277,7 +266,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Bullet not found",
+                        "description": "Academic entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -289,29 +284,33 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/education": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all education entries for the authenticated user, ordered by display_order",
+                "description": "Deletes an academic entry",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "education"
+                    "academic"
+                ],
+                "summary": "Delete academic entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Academic entry ID",
+                        "name": "entryID",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List education entries",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ListEducationResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -319,6 +318,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Academic entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -326,14 +331,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/admin/impersonate/{userID}": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new education entry for the authenticated user",
+                "description": "Issues a short-lived token for acting as the user, for support debugging. Tokens of the read scope, the default, only allow GET requests. Issuing the token and every request made with it are recorded in the audit log, and responses to those requests carry the X-Impersonated-By header. Admin endpoints cannot be called while impersonating.",
                 "consumes": [
                     "application/json"
                 ],
@@ -341,17 +348,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "education"
+                    "admin"
                 ],
-                "summary": "Create education entry",
+                "summary": "Impersonate user",
                 "parameters": [
                     {
-                        "description": "Education data",
+                        "type": "string",
+                        "description": "ID of the user to impersonate",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Token scope",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.CreateEducationRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ImpersonateRequest"
                         }
                     }
                 ],
@@ -359,7 +372,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.EducationResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ImpersonationResponse"
                         }
                     },
                     "400": {
@@ -374,8 +387,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
                     "422": {
-                        "description": "Validation failed",
+                        "description": "Invalid scope, or the admin's own account",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -385,39 +410,36 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
+                    },
+                    "501": {
+                        "description": "Impersonation not configured",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/v1/education/{educationID}": {
+        "/v1/admin/storage/orphans": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves a specific education entry by ID",
+                "description": "Dry run of the periodic storage cleanup: lists the cached resume PDFs whose resume no longer exists, and the space deleting them would reclaim, without deleting anything. Restricted to the users in server.adminUserIds.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "education"
-                ],
-                "summary": "Get education entry",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Education ID",
-                        "name": "educationID",
-                        "in": "path",
-                        "required": true
-                    }
+                    "admin"
                 ],
+                "summary": "List orphaned storage files",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.EducationResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.StorageOrphansResponse"
                         }
                     },
                     "401": {
@@ -426,8 +448,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Education not found",
+                    "403": {
+                        "description": "Not an admin",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -439,14 +461,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/admin/users/import": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing education entry",
+                "description": "Creates a user with the data of an archive made by GET /v1/admin/users/{userID}/export or the export-user command, possibly on another instance. IDs are kept unless remap_ids is true, which gives the user and all their records new IDs so that an archive can be imported next to the user it was exported from. firebase_uid replaces the sign-in of the archived user. Resume PDFs are generated again on download. Imports are recorded in the audit log.",
                 "consumes": [
                     "application/json"
                 ],
@@ -454,32 +478,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "education"
+                    "admin"
                 ],
-                "summary": "Update education entry",
+                "summary": "Import user",
                 "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Give the user and their records new IDs",
+                        "name": "remap_ids",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Education ID",
-                        "name": "educationID",
-                        "in": "path",
-                        "required": true
+                        "description": "Sign-in of the imported user, instead of the archived one",
+                        "name": "firebase_uid",
+                        "in": "query"
                     },
                     {
-                        "description": "Education data",
+                        "description": "User archive",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateEducationRequest"
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_services.UserArchive"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.EducationResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.UserResponse"
                         }
                     },
                     "400": {
@@ -494,14 +523,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Education not found",
+                    "403": {
+                        "description": "Not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "The user or their sign-in already exists",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Archive too large",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
                     "422": {
-                        "description": "Validation failed",
+                        "description": "Unsupported archive version, or no sign-in",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -513,33 +554,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/admin/users/{userID}/export": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes an education entry",
+                "description": "Downloads a portable archive of all the data of a user: profile, preferences, experiences, skills, languages, education, academic entries, projects, resumes with their tags, critiques, feedback and activity, and saved filters. Import it with POST /v1/admin/users/import or the import-user command. Cached PDFs, bullet embeddings, usage counts, linked sign-in methods and the plan are not included. Exports are recorded in the audit log.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "education"
+                    "admin"
                 ],
-                "summary": "Delete education entry",
+                "summary": "Export user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Education ID",
-                        "name": "educationID",
+                        "description": "ID of the user to export",
+                        "name": "userID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_services.UserArchive"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -547,8 +593,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Education not found",
+                        "description": "User not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -562,89 +614,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/experiences": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
+        "/v1/auth/sync": {
+            "post": {
+                "description": "Synchronizes a Firebase authenticated user with the local PostgreSQL database. Creates a new user if not exists, updates if exists (upsert behavior). A new sign-in method whose email, verified by a trusted provider, matches an existing account is linked to it.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Returns a paginated list of experiences for the authenticated user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "experiences"
+                    "auth"
                 ],
-                "summary": "List experiences",
+                "summary": "Sync user from Firebase",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by experience type",
-                        "name": "type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 50,
-                        "description": "Pagination limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Pagination offset",
-                        "name": "offset",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ListExperiencesResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
-                        }
+                        "description": "Bearer token from Firebase",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Creates a new experience for the authenticated user",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "experiences"
-                ],
-                "summary": "Create experience",
-                "parameters": [
                     {
-                        "description": "Experience data",
+                        "description": "User sync request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.CreateExperienceRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.SyncUserRequest"
                         }
                     }
                 ],
@@ -652,7 +649,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ExperienceResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.SyncUserResponse"
                         }
                     },
                     "400": {
@@ -662,13 +659,13 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Invalid or expired token",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Validation failed",
+                    "409": {
+                        "description": "Another account uses the email, unverified or from an untrusted provider",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -682,45 +679,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/experiences/{experienceID}": {
+        "/v1/bullets/search": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a specific experience with all its bullets",
+                "description": "Searches bullets by keyword and, when an embeddings provider is configured, by semantic similarity. Results from both are fused into a single ranking.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "experiences"
+                    "bullets"
                 ],
-                "summary": "Get experience",
+                "summary": "Search bullets",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Experience ID",
-                        "name": "experienceID",
-                        "in": "path",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum results (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ExperienceResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListBulletsResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Missing query",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Experience not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -732,14 +735,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/bullets/{bulletID}": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing experience",
+                "description": "Updates an existing bullet",
                 "consumes": [
                     "application/json"
                 ],
@@ -747,24 +752,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "experiences"
+                    "bullets"
                 ],
-                "summary": "Update experience",
+                "summary": "Update bullet",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Experience ID",
-                        "name": "experienceID",
+                        "description": "Bullet ID",
+                        "name": "bulletID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Experience data",
+                        "description": "Bullet data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateExperienceRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateBulletRequest"
                         }
                     }
                 ],
@@ -772,7 +777,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ExperienceResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.BulletResponse"
                         }
                     },
                     "400": {
@@ -788,7 +793,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Experience not found",
+                        "description": "Bullet not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -813,16 +818,16 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes an experience and all its bullets",
+                "description": "Deletes a bullet",
                 "tags": [
-                    "experiences"
+                    "bullets"
                 ],
-                "summary": "Delete experience",
+                "summary": "Delete bullet",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Experience ID",
-                        "name": "experienceID",
+                        "description": "Bullet ID",
+                        "name": "bulletID",
                         "in": "path",
                         "required": true
                     }
@@ -838,7 +843,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Experience not found",
+                        "description": "Bullet not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -852,14 +857,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/experiences/{experienceID}/bullets": {
+        "/v1/bullets/{bulletID}/score": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new bullet point under an experience",
+                "description": "Triggers AI recalculation of the bullet's impact score against a job description",
                 "consumes": [
                     "application/json"
                 ],
@@ -869,30 +874,30 @@ const docTemplate = `{
                 "tags": [
                     "bullets"
                 ],
-                "summary": "Create bullet",
+                "summary": "Recalculate bullet score",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Experience ID",
-                        "name": "experienceID",
+                        "description": "Bullet ID",
+                        "name": "bulletID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Bullet data",
+                        "description": "Job description for analysis",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.CreateBulletRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.AnalyzeBulletRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.BulletResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ScoreBulletResponse"
                         }
                     },
                     "400": {
@@ -908,19 +913,19 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Experience not found",
+                        "description": "Bullet not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Validation failed",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "503": {
+                        "description": "AI service unavailable",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -928,26 +933,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/languages": {
+        "/v1/education": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all spoken languages for the authenticated user",
+                "description": "Returns all education entries for the authenticated user, ordered by display_order",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "languages"
+                    "education"
                 ],
-                "summary": "List spoken languages",
+                "summary": "List education entries",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ListSpokenLanguagesResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListEducationResponse"
                         }
                     },
                     "401": {
@@ -970,7 +975,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new spoken language for the authenticated user",
+                "description": "Creates a new education entry for the authenticated user. Dates are YYYY-MM-DD or YYYY-MM; set is_expected when end_date is an expected graduation date, rendered as Expected Jun 2025 on resumes.",
                 "consumes": [
                     "application/json"
                 ],
@@ -978,17 +983,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "languages"
+                    "education"
                 ],
-                "summary": "Create spoken language",
+                "summary": "Create education entry",
                 "parameters": [
                     {
-                        "description": "Spoken language data",
+                        "description": "Education data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.CreateSpokenLanguageRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.CreateEducationRequest"
                         }
                     }
                 ],
@@ -996,7 +1001,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.SpokenLanguageResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.EducationResponse"
                         }
                     },
                     "400": {
@@ -1011,12 +1016,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Language already exists",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
-                        }
-                    },
                     "422": {
                         "description": "Validation failed",
                         "schema": {
@@ -1032,30 +1031,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/languages/{languageID}": {
-            "delete": {
+        "/v1/education/{educationID}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a spoken language",
+                "description": "Retrieves a specific education entry by ID",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "languages"
+                    "education"
                 ],
-                "summary": "Delete spoken language",
+                "summary": "Get education entry",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Spoken language ID",
-                        "name": "languageID",
+                        "description": "Education ID",
+                        "name": "educationID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.EducationResponse"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -1064,7 +1069,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Language not found",
+                        "description": "Education not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1076,28 +1081,53 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/me": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the authenticated user's complete profile",
+                "description": "Updates an existing education entry",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "education"
+                ],
+                "summary": "Update education entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Education ID",
+                        "name": "educationID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Education data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateEducationRequest"
+                        }
+                    }
                 ],
-                "summary": "Get current user profile",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.UserResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.EducationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
                     "401": {
@@ -1106,54 +1136,52 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Education not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     }
-                }
-            },
-            "patch": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Updates the authenticated user's profile fields",
-                "consumes": [
-                    "application/json"
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Deletes an education entry",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "education"
                 ],
-                "summary": "Update current user profile",
+                "summary": "Delete education entry",
                 "parameters": [
                     {
-                        "description": "Update data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateUserRequest"
-                        }
+                        "type": "string",
+                        "description": "Education ID",
+                        "name": "educationID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.UserResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request body",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -1161,8 +1189,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Validation failed",
+                    "404": {
+                        "description": "Education not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1176,26 +1204,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/projects": {
+        "/v1/experiences": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all projects for the authenticated user, ordered by display_order",
+                "description": "Returns a paginated list of experiences for the authenticated user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "experiences"
+                ],
+                "summary": "List experiences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by experience type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Pagination limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Pagination offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List projects",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ListProjectsResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListExperiencesResponse"
                         }
                     },
                     "401": {
@@ -1218,7 +1268,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new project for the authenticated user",
+                "description": "Creates a new experience for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1226,17 +1276,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "experiences"
                 ],
-                "summary": "Create project",
+                "summary": "Create experience",
                 "parameters": [
                     {
-                        "description": "Project data",
+                        "description": "Experience data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.CreateProjectRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.CreateExperienceRequest"
                         }
                     }
                 ],
@@ -1244,7 +1294,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ProjectResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ExperienceResponse"
                         }
                     },
                     "400": {
@@ -1274,26 +1324,78 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/projects/{projectID}": {
+        "/v1/experiences/timeline": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves a specific project by ID with its bullets",
+                "description": "Returns all experiences normalized to months and sorted by start date, with gaps longer than gap_months between activities (including an ongoing gap up to the current month) and full-time roles that overlap by more than one month. Awards, publications, certifications, hackathons and events neither fill gaps nor overlap. Work experiences with metadata.employment_type set to part_time are not counted as full-time. Each finding comes with a suggestion.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "experiences"
                 ],
-                "summary": "Get project",
+                "summary": "Get experience timeline",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 3,
+                        "description": "Flag gaps longer than this many months (1-24)",
+                        "name": "gap_months",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.TimelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid gap_months",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/experiences/{experienceID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a specific experience with all its bullets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "experiences"
+                ],
+                "summary": "Get experience",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "projectID",
+                        "description": "Experience ID",
+                        "name": "experienceID",
                         "in": "path",
                         "required": true
                     }
@@ -1302,7 +1404,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ProjectResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ExperienceResponse"
                         }
                     },
                     "401": {
@@ -1312,7 +1414,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Project not found",
+                        "description": "Experience not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1331,7 +1433,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing project",
+                "description": "Updates an existing experience",
                 "consumes": [
                     "application/json"
                 ],
@@ -1339,24 +1441,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "experiences"
                 ],
-                "summary": "Update project",
+                "summary": "Update experience",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "projectID",
+                        "description": "Experience ID",
+                        "name": "experienceID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Project data",
+                        "description": "Experience data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateProjectRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateExperienceRequest"
                         }
                     }
                 ],
@@ -1364,7 +1466,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ProjectResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ExperienceResponse"
                         }
                     },
                     "400": {
@@ -1380,7 +1482,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Project not found",
+                        "description": "Experience not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1405,26 +1507,23 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a project and all its bullets",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Deletes an experience and all its bullets",
                 "tags": [
-                    "projects"
+                    "experiences"
                 ],
-                "summary": "Delete project",
+                "summary": "Delete experience",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "projectID",
+                        "description": "Experience ID",
+                        "name": "experienceID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "No Content"
+                        "description": "No content"
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -1433,7 +1532,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Project not found",
+                        "description": "Experience not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1447,14 +1546,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/projects/{projectID}/bullets": {
+        "/v1/experiences/{experienceID}/bullets": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds a new bullet to a project",
+                "description": "Creates a new bullet point under an experience",
                 "consumes": [
                     "application/json"
                 ],
@@ -1462,14 +1561,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "bullets"
                 ],
-                "summary": "Add project bullet",
+                "summary": "Create bullet",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "projectID",
+                        "description": "Experience ID",
+                        "name": "experienceID",
                         "in": "path",
                         "required": true
                     },
@@ -1479,7 +1578,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.CreateProjectBulletRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.CreateBulletRequest"
                         }
                     }
                 ],
@@ -1487,7 +1586,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ProjectBulletResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.BulletResponse"
                         }
                     },
                     "400": {
@@ -1503,7 +1602,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Project not found",
+                        "description": "Experience not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1517,55 +1622,77 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/projects/{projectID}/bullets/{bulletID}": {
-            "delete": {
+        "/v1/import/csv": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a bullet from a project",
+                "description": "Imports experiences and bullets from a CSV sent as the request body. Each row holds one bullet; rows with the same type, title, organization and start_date form one experience. Columns: type, title*, organization*, location, start_date*, end_date, is_current, description, url, bullet, keywords (separated by \";\"), impact_score. The import is all-or-nothing: any invalid row rejects the whole file with row-level errors.",
+                "consumes": [
+                    "text/csv"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "projects"
+                    "import"
                 ],
-                "summary": "Delete project bullet",
+                "summary": "Import experiences from CSV",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Project ID",
-                        "name": "projectID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Bullet ID",
-                        "name": "bulletID",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Validate without importing",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                    "200": {
+                        "description": "Dry run result",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ImportCSVResponse"
                         }
                     },
-                    "404": {
-                        "description": "Project or bullet not found",
+                    "201": {
+                        "description": "Imported",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ImportCSVResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Empty request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Infected file or invalid rows",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Virus scanner unavailable",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1573,48 +1700,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/resumes": {
-            "get": {
+        "/v1/import/uploads": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a paginated list of resumes for the authenticated user",
+                "description": "Starts a chunked upload. Send the file in chunks with PUT /v1/import/uploads/{uploadID}/chunks/{index}, then import it with POST /v1/import/uploads/{uploadID}/complete.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "resumes"
-                ],
-                "summary": "List resumes",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Filter by status",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Pagination limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Pagination offset",
-                        "name": "offset",
-                        "in": "query"
-                    }
+                    "import"
                 ],
+                "summary": "Start chunked upload",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Upload started",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ListResumesResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ImportUploadResponse"
                         }
                     },
                     "401": {
@@ -1630,44 +1735,45 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/import/uploads/{uploadID}/chunks/{index}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new resume draft from a job description",
+                "description": "Stores one chunk of a chunked upload, sent as the raw request body. Chunks may be sent in any order; sending an index again replaces the chunk.",
                 "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "resumes"
+                    "import"
                 ],
-                "summary": "Create resume",
+                "summary": "Upload chunk",
                 "parameters": [
                     {
-                        "description": "Resume data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.CreateResumeRequest"
-                        }
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "uploadID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "0-based chunk index",
+                        "name": "index",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
-                        }
+                    "204": {
+                        "description": "Chunk stored"
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Invalid chunk",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1678,8 +1784,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Validation failed",
+                    "404": {
+                        "description": "Upload not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Chunk too large",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1693,35 +1805,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/resumes/{resumeID}": {
-            "get": {
+        "/v1/import/uploads/{uploadID}/complete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a specific resume with full content",
+                "description": "Assembles chunks 0 to chunks-1 and imports the file like POST /v1/import/csv. The chunks are discarded afterwards, whatever the outcome.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "resumes"
+                    "import"
                 ],
-                "summary": "Get resume",
+                "summary": "Complete chunked upload",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resume ID",
-                        "name": "resumeID",
+                        "description": "Upload ID",
+                        "name": "uploadID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of chunks",
+                        "name": "chunks",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate without importing",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Dry run result",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ImportCSVResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Imported",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ImportCSVResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid chunk count",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
                     "401": {
@@ -1731,7 +1868,19 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Resume not found",
+                        "description": "Upload not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Missing chunks, infected file or invalid rows",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1741,32 +1890,37 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
+                    },
+                    "503": {
+                        "description": "Virus scanner unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/insights/export.csv": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a resume",
-                "tags": [
-                    "resumes"
+                "description": "Returns a CSV file with one row per resume, newest first, for analysis in a spreadsheet. The columns are resume_id, company, job_title, job_url, status, outcome, score, language, created_at and updated_at. The outcome is not_applied before the resume is submitted, then pending, interviewing, rejected or offer. Times are RFC 3339 in UTC. The file starts with a UTF-8 byte order mark, and cells starting with =, +, - or @ are prefixed with an apostrophe.",
+                "produces": [
+                    "text/csv"
                 ],
-                "summary": "Delete resume",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Resume ID",
-                        "name": "resumeID",
-                        "in": "path",
-                        "required": true
-                    }
+                "tags": [
+                    "insights"
                 ],
+                "summary": "Export applications as CSV",
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "CSV spreadsheet",
+                        "schema": {
+                            "type": "file"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -1774,8 +1928,39 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Resume not found",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/languages": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all spoken languages for the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "languages"
+                ],
+                "summary": "List spoken languages",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListSpokenLanguagesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1787,16 +1972,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/resumes/{resumeID}/content": {
-            "patch": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates the status or content of a resume for manual adjustments",
+                "description": "Creates a new spoken language for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1804,32 +1987,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "resumes"
+                    "languages"
                 ],
-                "summary": "Update resume status/content",
+                "summary": "Create spoken language",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Resume ID",
-                        "name": "resumeID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Resume update",
+                        "description": "Spoken language data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateResumeContentRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.CreateSpokenLanguageRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.SpokenLanguageResponse"
                         }
                     },
                     "400": {
@@ -1844,8 +2020,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Resume not found",
+                    "409": {
+                        "description": "Language already exists",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1865,50 +2041,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/resumes/{resumeID}/pdf": {
-            "get": {
+        "/v1/languages/{languageID}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Generates and downloads a PDF file of the resume",
-                "produces": [
-                    "application/pdf"
-                ],
+                "description": "Deletes a spoken language",
                 "tags": [
-                    "resumes"
+                    "languages"
                 ],
-                "summary": "Generate PDF",
+                "summary": "Delete spoken language",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Resume ID",
-                        "name": "resumeID",
+                        "description": "Spoken language ID",
+                        "name": "languageID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "modern",
-                        "description": "Template name",
-                        "name": "template",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "default": false,
-                        "description": "Force regeneration ignoring cache",
-                        "name": "force_regenerate",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "PDF file",
-                        "schema": {
-                            "type": "file"
-                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -1917,13 +2073,44 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Resume not found",
+                        "description": "Language not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "422": {
-                        "description": "Resume not ready for PDF",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the authenticated user's complete profile",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get current user profile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.UserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -1935,16 +2122,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/resumes/{resumeID}/tailor": {
-            "post": {
+            },
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Uses AI to select and rewrite bullets for a specific job description",
+                "description": "Updates the authenticated user's profile fields",
                 "consumes": [
                     "application/json"
                 ],
@@ -1952,23 +2137,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "resumes"
+                    "user"
                 ],
-                "summary": "Tailor resume",
+                "summary": "Update current user profile",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Resume ID",
-                        "name": "resumeID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Tailoring parameters",
+                        "description": "Update data",
                         "name": "request",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.TailorResumeRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateUserRequest"
                         }
                     }
                 ],
@@ -1976,7 +2155,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.UserResponse"
                         }
                     },
                     "400": {
@@ -1991,12 +2170,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Resume not found",
-                        "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
-                        }
-                    },
                     "422": {
                         "description": "Validation failed",
                         "schema": {
@@ -2012,34 +2185,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/skills": {
+        "/v1/projects": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all skills for the authenticated user, optionally filtered by category",
+                "description": "Returns all projects for the authenticated user, ordered by display_order",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "skills"
-                ],
-                "summary": "List skills",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Filter by category",
-                        "name": "category",
-                        "in": "query"
-                    }
+                    "projects"
                 ],
+                "summary": "List projects",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ListSkillsResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListProjectsResponse"
                         }
                     },
                     "401": {
@@ -2055,16 +2220,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/skills/batch": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates or updates multiple skills. Skills are matched by name - existing skills are updated, new ones are created.",
+                "description": "Creates a new project for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -2072,25 +2235,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "skills"
+                    "projects"
                 ],
-                "summary": "Batch upsert skills",
+                "summary": "Create project",
                 "parameters": [
                     {
-                        "description": "Skills data",
+                        "description": "Project data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.BatchUpsertSkillsRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.CreateProjectRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.BatchUpsertSkillsResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ProjectResponse"
                         }
                     },
                     "400": {
@@ -2120,30 +2283,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/skills/{skillID}": {
-            "delete": {
+        "/v1/projects/{projectID}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a skill",
+                "description": "Retrieves a specific project by ID with its bullets",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "skills"
+                    "projects"
                 ],
-                "summary": "Delete skill",
+                "summary": "Get project",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Skill ID",
-                        "name": "skillID",
+                        "description": "Project ID",
+                        "name": "projectID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ProjectResponse"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -2152,7 +2321,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Skill not found",
+                        "description": "Project not found",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -2164,16 +2333,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/tools/parse-job": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Fetches a job posting URL and extracts structured data using Jina Reader",
+                "description": "Updates an existing project",
                 "consumes": [
                     "application/json"
                 ],
@@ -2181,17 +2348,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tools"
+                    "projects"
                 ],
-                "summary": "Parse job URL",
+                "summary": "Update project",
                 "parameters": [
                     {
-                        "description": "Job URL to parse",
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Project data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ParseJobURLRequest"
+                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateProjectRequest"
                         }
                     }
                 ],
@@ -2199,7 +2373,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_adapters_primary_http.ParseJobURLResponse"
+                            "$ref": "#/definitions/internal_adapters_primary_http.ProjectResponse"
                         }
                     },
                     "400": {
@@ -2214,8 +2388,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
                     "422": {
-                        "description": "Failed to parse job posting",
+                        "description": "Validation failed",
                         "schema": {
                             "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
                         }
@@ -2227,372 +2407,8053 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "internal_adapters_primary_http.AnalyzeBulletRequest": {
-            "type": "object",
-            "properties": {
-                "job_description": {
-                    "type": "string",
-                    "example": "We are looking for a Senior Backend Engineer..."
-                }
-            }
-        },
-        "internal_adapters_primary_http.BatchUpsertSkillsRequest": {
-            "type": "object",
-            "properties": {
-                "skills": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.SkillInput"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a project and all its bullets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Delete project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "internal_adapters_primary_http.BatchUpsertSkillsResponse": {
-            "type": "object",
-            "properties": {
-                "created": {
-                    "type": "integer",
-                    "example": 1
-                },
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.SkillResponse"
-                    }
+        "/v1/projects/{projectID}/bullets": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a new bullet to a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Add project bullet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Bullet data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.CreateProjectBulletRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ProjectBulletResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/projects/{projectID}/bullets/{bulletID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a bullet from a project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Delete project bullet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bullet ID",
+                        "name": "bulletID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Project or bullet not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resume-filters": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user's named resume searches by name.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resume-filters"
+                ],
+                "summary": "List saved resume filters",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListSavedResumeFiltersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves resume list filters under a name to run them again later. Names are unique per user, ignoring case, and at most 100 characters. The filters are validated as when listing resumes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resume-filters"
+                ],
+                "summary": "Save resume filter",
+                "parameters": [
+                    {
+                        "description": "Filter name and search",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.SavedResumeFilterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.SavedResumeFilterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or status filter",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Filter name already used",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resume-filters/{filterID}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the name and search of a saved filter.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resume-filters"
+                ],
+                "summary": "Replace saved resume filter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved filter ID",
+                        "name": "filterID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Filter name and search",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.SavedResumeFilterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.SavedResumeFilterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or status filter",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Saved filter not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Filter name already used",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a saved filter. The resumes it matched are kept.",
+                "tags": [
+                    "resume-filters"
+                ],
+                "summary": "Delete saved resume filter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved filter ID",
+                        "name": "filterID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Saved filter not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resume-filters/{filterID}/resumes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a paginated list of the resumes matching a saved filter, like GET /v1/resumes with the saved filters.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resume-filters"
+                ],
+                "summary": "Run saved resume filter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved filter ID",
+                        "name": "filterID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "summary"
+                        ],
+                        "type": "string",
+                        "default": "full",
+                        "description": "summary leaves out the job description, generated content and selection",
+                        "name": "view",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Pagination limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Pagination offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListResumesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid view",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Saved filter not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resume-tags": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user's resume tags by name, each with the number of resumes that have it. Filter resumes by tag with GET /v1/resumes?tag={id}.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resume-tags"
+                ],
+                "summary": "List resume tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListResumeTagsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a tag for organizing resumes, such as \"Backend\", \"2025 Q1\" or \"Dream companies\". Names are unique per user, ignoring case, and at most 50 characters.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resume-tags"
+                ],
+                "summary": "Create resume tag",
+                "parameters": [
+                    {
+                        "description": "Tag name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Tag name already used",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resume-tags/{tagID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the tag from all resumes and deletes it. The resumes are kept.",
+                "tags": [
+                    "resume-tags"
+                ],
+                "summary": "Delete resume tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "tagID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renames a resume tag; resumes keep it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resume-tags"
+                ],
+                "summary": "Rename resume tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "tagID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New tag name",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Tag name already used",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resume-tags/{tagID}/resumes": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds the tag to the resumes listed in add and removes it from those in remove, all at once. Adding a tag a resume already has, or removing one it does not have, is allowed. Every resume must belong to the user, and none may be in both lists.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resume-tags"
+                ],
+                "summary": "Assign resume tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "tagID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resumes to tag and untag",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.AssignResumeTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unknown resumes",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a paginated list of resumes for the authenticated user. The job insight filters only match resumes whose job description stated the corresponding detail.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "List resumes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated remote policies: remote, hybrid, onsite",
+                        "name": "remote",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum annual salary at the top of the advertised range",
+                        "name": "min_salary",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Salary currency (ISO 4217)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by visa sponsorship",
+                        "name": "visa_sponsorship",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tag IDs; resumes must have all of them",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum match score (0-100)",
+                        "name": "score_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text the company name contains, ignoring case",
+                        "name": "company",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created on or after this date (YYYY-MM-DD) or time (RFC 3339)",
+                        "name": "created_after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created on or before this date (YYYY-MM-DD), or before this time (RFC 3339)",
+                        "name": "created_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Target language; 'pt' also matches regional variants such as 'pt-br'",
+                        "name": "language",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only archived (true) or unarchived (false) resumes",
+                        "name": "archived",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include archived resumes, left out by default",
+                        "name": "include_archived",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "recent",
+                            "pinned_first"
+                        ],
+                        "type": "string",
+                        "default": "newest",
+                        "description": "Order: newest, recent (last opened first) or pinned_first",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "full",
+                            "summary"
+                        ],
+                        "type": "string",
+                        "default": "full",
+                        "description": "summary leaves out the job description, generated content and selection",
+                        "name": "view",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Pagination limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Pagination offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListResumesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new resume draft from a job description. Without a target language, the resume targets the language detected in the job description when supported, else the user's default; the detected language is returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Create resume",
+                "parameters": [
+                    {
+                        "description": "Resume data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.CreateResumeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Plan limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes, archives, unarchives, changes the status of or tags up to 100 resumes, reporting the outcome for each. Resumes that are not found or cannot move to the status fail on their own; with transactional, nothing is changed unless the action applies to every resume. Responds 207 when the action was not applied to every resume",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Bulk resume action",
+                "parameters": [
+                    {
+                        "description": "Bulk action",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.BulkResumesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.BulkResumesResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Not applied to every resume",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.BulkResumesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a specific resume with full content",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Get resume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a resume",
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Delete resume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/activity": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the resume's activity log, oldest first: notes left through PATCH /content, status changes, PDF generations and AI runs (tailoring and critiques), so users can track the lifecycle of the application.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Get resume activity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListResumeActivityResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/archive": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Archives a resume: it is kept, with its history, but left out of resume lists unless include_archived or archived is set. Archiving an archived resume keeps the time it was first archived",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Archive resume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/bullets/confirm": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Confirms tailored bullets flagged with needs_review, whose rewrites have numbers, names or technologies not found in the user's profile. A resume cannot be marked reviewed until all its flagged bullets are confirmed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Confirm flagged bullets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Checked bullets",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ConfirmBulletsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error or resume not tailored yet",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/content": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates the status, notes or content of a resume for manual adjustments; notes are also added to its activity log. Also sets the profile link shown as a QR code in its header, the priority of its header contacts, the display of its sections, the pages it may span and the experiences tailoring includes or excludes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Update resume status/content",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resume update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateResumeContentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Flagged bullets must be confirmed first",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/critique": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Has the AI review the resume's current generated content as the hiring manager for the job: strengths, weaknesses, red flags and suggested edits, with an overall score and an interview verdict. Each critique is stored with a hash of the reviewed content so improvements can be tracked across versions.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Critique resume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeCritiqueResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Resume not tailored yet",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "AI service unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/critiques": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all critiques of a resume, newest first. Critiques with different content_hash values reviewed different versions of the resume.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "List resume critiques",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListResumeCritiquesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/diff": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compares each tailored bullet with the master bullet it was tailored from, and the resume's summary with the profile summary, as word-level segments that were kept (equal), removed (delete) or added (insert). Bullets are compared before any translation. Joining the equal and delete segments gives the original text; joining the equal and insert segments gives the tailored one.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Diff tailored resume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeDiffResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Resume not tailored yet",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Exports the tailored resume in the requested format (markdown, text, html, europass, latex)",
+                "produces": [
+                    "text/markdown",
+                    "text/plain",
+                    "text/html",
+                    "application/xml",
+                    "application/x-tex"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Export resume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "markdown",
+                            "text",
+                            "html",
+                            "europass",
+                            "latex"
+                        ],
+                        "type": "string",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Send as attachment instead of inline",
+                        "name": "download",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exported document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Unsupported format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Resume not ready for export",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/feedback": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks tailored bullets of the resume as accepted, edited or rejected, replacing earlier feedback on the same bullets. Edited bullets need edited_content. The user's recent feedback across all resumes is summed up into writing preferences (such as avoiding buzzwords or preferring metrics) that steer later tailoring. Returns all of the resume's feedback.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Submit bullet feedback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Bullet verdicts",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.SubmitBulletFeedbackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListBulletFeedbackResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation error or resume not tailored yet",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/fit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "When the resume is estimated to overflow the pages its PDF keeps, has the AI shorten its longest bullets and summary under length budgets, keeping their job keywords, and estimates the fit again, for up to three rounds. Rewrites that are not shorter, drop a keyword or fail bullet validation are discarded. A resume that already fits is returned unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Trim resume to fit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rendering to fit; defaults to the user's preferences",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.FitResumeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.FitResumeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Resume not tailored yet, or invalid font size or density",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "AI service unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/fit-check": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Estimates the lines the resume takes when rendered with a template, font size and density, and whether they fit the pages the PDF keeps, without generating it. Text is wrapped at an average character width, so the estimate may be off by a few lines.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Check page fit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template name (jake, europass, academic, functional or a custom template); defaults to the user's preferred template",
+                        "name": "template",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Base font size in pt, 9 to 12; defaults to the user's preferred size",
+                        "name": "font_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "comfortable",
+                        "description": "Spacing preset: comfortable, compact or ultra",
+                        "name": "density",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.FitCheckResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Font size is not an integer",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Resume not tailored yet, or invalid font size or density",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/html": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the rendered resume template as self-contained HTML (inline CSS) for self-hosting or manual tweaks",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Export HTML",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Send as attachment instead of inline",
+                        "name": "download",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "HTML document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Resume not ready for export",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/pdf": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Generates and downloads a PDF file of the resume",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Generate PDF",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Template name (jake, europass, academic, functional or a custom template); defaults to the user's preferred template",
+                        "name": "template",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Force regeneration ignoring cache",
+                        "name": "force_regenerate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Stamp a DRAFT watermark if the resume is not reviewed yet",
+                        "name": "watermark",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "comfortable",
+                        "description": "Spacing preset: comfortable, compact or ultra",
+                        "name": "density",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PDF file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Resume not ready for PDF, or invalid density",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Plan limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "PDF service unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/pin": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Pins a resume, to list it first with sort=pinned_first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Pin resume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/preview-html": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renders the resume as HTML with draft edits (summary, bullets, font size, section order) applied, without saving them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Preview HTML",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Draft edits",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.PreviewResumeHTMLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "HTML document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Resume not ready or invalid edits",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/regenerate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Generates one section of a tailored resume again without tailoring it all over: the summary, the bullets of one experience, or the skills list. The AI skips cached replies, so the section comes out different, and follows the optional instructions; the job analysis from tailoring is reused. Skills are chosen from the profile without the AI and take no instructions. Bullet rewrites that fail validation keep their current content.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Regenerate resume section",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "summary, skills or experience:{experienceID}",
+                        "name": "section",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Instructions for the AI",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.RegenerateSectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Resume not tailored yet, or invalid section or instructions",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Generated content rejected by the content filter",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "AI service unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/retailor": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Tailors a resume again and reports how the settings of the new run differ from the run it replaces. With same_settings=true the seed, bullet limit, highlighted skills and writing voice of the last tailoring run are reused, and cached AI replies may be served, to reproduce it; otherwise the resume is tailored with the default options and fresh AI replies, to contrast the runs. Prompt versions and models changed since cannot be restored, and models apply seeds on a best effort basis, so the differences tell why a reproduced run still differs.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Tailor resume again",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Reuse the settings of the last tailoring run",
+                        "name": "same_settings",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.RetailorResumeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Resume not tailored yet, or tailored before its settings were recorded",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Plan limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Generated content rejected by the content filter",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "AI service unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Tailoring ran out of time",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/summaries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the professional summaries generated with a resume from different angles (technical, leadership and impact), marking the one picked as its summary. Resumes tailored before variants were generated, or whose summary fell back to the profile's, have none.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "List summary variants",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.SummaryVariantsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Resume not tailored yet",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/summaries/select": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Makes one of the summary variants generated with a resume its summary, replacing the current summary, edits included. The other variants are kept to switch again later.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Select summary variant",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Variant to select",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.SelectSummaryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unknown angle or resume not tailored yet",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/tailor": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Uses AI to select and rewrite bullets for a specific job description, listing the skills the job mentions with highlighted skills first. The summary and bullets can be written in a tone (confident, humble, energetic) for a target reader (technical_hiring_manager, hr_screener), kept with the content and reused when sections are regenerated. The response explains why each bullet was selected or dropped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Tailor resume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tailoring parameters",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.TailorResumeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Plan limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "502": {
+                        "description": "Generated content rejected by the content filter",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "AI service unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "504": {
+                        "description": "Tailoring ran out of time",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/tailor/estimate": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Estimates the AI tokens and cost of tailoring the resume now, by operation, from the size of its job description, the bullets tailoring would choose from and the configured models and prices, without calling the AI. Also returns the user's tailoring quota, to check it before tailoring. Estimates assume no cached replies or retries.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Estimate tailoring cost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Bullets to select; defaults to the user's preferred limit",
+                        "name": "max_bullets_per_job",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.TailorEstimateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "max_bullets_per_job is not a positive integer",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "No bullets available for tailoring",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/unarchive": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restores an archived resume to resume lists",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Unarchive resume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/resumes/{resumeID}/unpin": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Unpins a resume",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resumes"
+                ],
+                "summary": "Unpin resume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resume ID",
+                        "name": "resumeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resume not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/skills": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all skills for the authenticated user, optionally filtered by category",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "skills"
+                ],
+                "summary": "List skills",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by category",
+                        "name": "category",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListSkillsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/skills/batch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates or updates multiple skills. Skills are matched by name - existing skills are updated, new ones are created.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Batch upsert skills",
+                "parameters": [
+                    {
+                        "description": "Skills data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.BatchUpsertSkillsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.BatchUpsertSkillsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/skills/{skillID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a skill",
+                "tags": [
+                    "skills"
+                ],
+                "summary": "Delete skill",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Skill ID",
+                        "name": "skillID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Skill not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/suggest": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the companies and job titles of the user's resumes and experiences, and the user's skills and resume tags, that contain q, ignoring case. Suggestions are grouped by type (company, job_title, skill, tag); within a type, values starting with q come first, then the most similar. Skills and tags include their ID. A blank q returns no suggestions.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suggest"
+                ],
+                "summary": "Suggest completions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Text typed so far (at most 100 characters)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Maximum suggestions per type (1-20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.SuggestResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tools/parse-job": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Fetches a job posting URL and extracts structured data using Jina Reader",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tools"
+                ],
+                "summary": "Parse job URL",
+                "parameters": [
+                    {
+                        "description": "Job URL to parse",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ParseJobURLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ParseJobURLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or URL not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Failed to parse job posting",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Job parser unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/identities": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the sign-in methods linked to the user besides the one they signed up with, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "List linked sign-in methods",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ListIdentitiesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Links the sign-in method of an ID token, such as GitHub for a user who signed up with Google, so that the user can sign in to the same account with either",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Link sign-in method",
+                "parameters": [
+                    {
+                        "description": "ID token of the sign-in method",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.LinkIdentityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.IdentityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Sign-in method already linked to this or another account",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid or expired ID token",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/identities/{identityID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a linked sign-in method. The sign-up method cannot be unlinked.",
+                "tags": [
+                    "user"
+                ],
+                "summary": "Unlink sign-in method",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Identity ID",
+                        "name": "identityID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Identity not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/limits": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user's plan and, for each metered action (resume_created, tailor, pdf_regeneration), the limit, usage and remaining quota in the current period. A limit of 0 means unlimited and is reported with remaining -1.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get current user plan limits",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.UserLimitsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/preferences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the defaults used when creating, tailoring and rendering resumes, plus email opt-ins. Users who never saved preferences get the system defaults.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get current user preferences",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.PreferencesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates the given preference fields; omitted fields are left unchanged. default_template is jake, europass, academic or functional, default_target_language is en or pt-br, default_max_bullets is 1-50, default_font_size is 9-12 (0 keeps the template default), date_format is locale, mon_yyyy, mm/yyyy or yyyy-mm, timezone is an IANA name, and filename_pattern names downloaded files using the tokens {name}, {company}, {job_title}, {target}, {language} and {date}.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Update current user preferences",
+                "parameters": [
+                    {
+                        "description": "Preferences to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.UpdatePreferencesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.PreferencesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/profile-bundle": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the whole master profile (profile fields, experiences with bullets, education, projects with bullets, skills and spoken languages) as one versioned document, for keeping it under version control and editing it in a text editor. Sent as YAML with format=yaml or an Accept header of application/yaml, as JSON otherwise.",
+                "produces": [
+                    "application/json",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get profile bundle",
+                "parameters": [
+                    {
+                        "enum": [
+                            "json",
+                            "yaml"
+                        ],
+                        "type": "string",
+                        "description": "Document format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_services.ProfileBundle"
+                        }
+                    },
+                    "400": {
+                        "description": "Unsupported format",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the whole master profile with a document read from GET /v1/users/me/profile-bundle, as JSON or, with a Content-Type of application/yaml, as YAML. Records are matched by ID: records with one of the user's IDs are updated, others are created, and the user's records missing from the document are deleted. Records are ordered as listed; owner IDs, display orders and timestamps are ignored. The whole document is validated before anything is saved, and errors name the path of the field, such as experiences[0].bullets[1].content. Returns the saved profile in the format of the request.",
+                "consumes": [
+                    "application/json",
+                    "application/yaml"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Replace profile bundle",
+                "parameters": [
+                    {
+                        "description": "Profile bundle",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_services.ProfileBundle"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_services.ProfileBundle"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the session making the request and the latest sign-in activity on other devices",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "List sign-in sessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.SessionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "501": {
+                        "description": "Not supported by the identity provider",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/sessions/revoke": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes the user's refresh tokens at the identity provider and rejects every token issued until now, including the one making the request",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Revoke all sessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.RevokeSessionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "501": {
+                        "description": "Not supported by the identity provider",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userID}/avatar": {
+            "get": {
+                "description": "Serves the user's profile picture, cached from the identity provider as a square JPEG",
+                "produces": [
+                    "image/jpeg"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get user profile picture",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Profile picture",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "No cached picture",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/experiences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a page of experiences for the authenticated user; pass meta.next_cursor as cursor for the next page",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "experiences"
+                ],
+                "summary": "List experiences (v2)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by experience type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.Envelope-array_internal_adapters_primary_http_ExperienceV2Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid cursor",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new experience for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "experiences"
+                ],
+                "summary": "Create experience (v2)",
+                "parameters": [
+                    {
+                        "description": "Experience data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.CreateExperienceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.Envelope-internal_adapters_primary_http_ExperienceV2Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/experiences/{experienceID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a specific experience with all its bullets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "experiences"
+                ],
+                "summary": "Get experience (v2)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Experience ID",
+                        "name": "experienceID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.Envelope-internal_adapters_primary_http_ExperienceV2Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    },
+                    "404": {
+                        "description": "Experience not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates an existing experience",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "experiences"
+                ],
+                "summary": "Update experience (v2)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Experience ID",
+                        "name": "experienceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Experience data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.UpdateExperienceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.Envelope-internal_adapters_primary_http_ExperienceV2Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    },
+                    "404": {
+                        "description": "Experience not found",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/internal_adapters_primary_http.ErrorEnvelope"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.AICallSettings": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Count is the number of calls made with these settings.",
+                    "type": "integer"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "operation": {
+                    "description": "Operation names the prompt template, e.g. \"tailor_bullet\".",
+                    "type": "string"
+                },
+                "prompt_version": {
+                    "description": "PromptVersion is the version of the template, bumped whenever its\nwording changes.",
+                    "type": "string"
+                },
+                "temperature": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.AIRunSettings": {
+            "type": "object",
+            "properties": {
+                "calls": {
+                    "description": "Calls are the settings of the AI calls, in the order first made.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.AICallSettings"
+                    }
+                },
+                "include_highlighted_skills": {
+                    "type": "boolean"
+                },
+                "max_bullets": {
+                    "description": "MaxBullets and IncludeHighlightedSkills are the options of a\ntailoring run; the writing voice is kept with the content.",
+                    "type": "integer"
+                },
+                "seed": {
+                    "description": "Seed is the seed given to the model. Providers apply it on a best\neffort basis, so a seeded run may still differ. Nil when not seeded.",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.AcademicEntry": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Grants, e.g. \"USD 250,000\"",
+                    "type": "string"
+                },
+                "authors": {
+                    "description": "Publications, in byline order",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "description": "Publications use it as the publication date",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Date"
+                        }
+                    ]
+                },
+                "id": {
+                    "type": "string"
+                },
+                "organization": {
+                    "type": "string"
+                },
+                "section": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.AcademicSection"
+                },
+                "start_date": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Date"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "description": "e.g. a DOI link",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.AcademicSection": {
+            "type": "string",
+            "enum": [
+                "publications",
+                "grants",
+                "teaching",
+                "service"
+            ],
+            "x-enum-varnames": [
+                "AcademicPublications",
+                "AcademicGrants",
+                "AcademicTeaching",
+                "AcademicService"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.Bullet": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "experience_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "impact_score": {
+                    "type": "integer"
+                },
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.BulletDecision": {
+            "type": "object",
+            "properties": {
+                "bullet_id": {
+                    "type": "string"
+                },
+                "outcome": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.BulletOutcome"
+                },
+                "reason": {
+                    "description": "Reason is the AI's short explanation; empty when it gave none or\nnever saw the bullet.",
+                    "type": "string"
+                },
+                "rejected": {
+                    "description": "Rejected holds the issues of the AI rewrite of a selected bullet that\nfailed validation, so the bullet kept its original content.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.BulletIssue"
+                    }
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.BulletFeedback": {
+            "type": "object",
+            "properties": {
+                "bullet_id": {
+                    "type": "string"
+                },
+                "comment": {
+                    "description": "Comment is the user's optional explanation, e.g. \"too many buzzwords\".",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "edited_content": {
+                    "description": "EditedContent is the user's rewrite; set only for edited bullets.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "resume_id": {
+                    "type": "string"
+                },
+                "tailored_content": {
+                    "description": "TailoredContent is the tailored text the verdict is about.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "verdict": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.FeedbackVerdict"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.BulletIssue": {
+            "type": "string",
+            "enum": [
+                "empty",
+                "too_long",
+                "first_person",
+                "profanity",
+                "implausible_claim",
+                "missing_keyword",
+                "invented_number"
+            ],
+            "x-enum-varnames": [
+                "BulletIssueEmpty",
+                "BulletIssueTooLong",
+                "BulletIssueFirstPerson",
+                "BulletIssueProfanity",
+                "BulletIssueImplausibleClaim",
+                "BulletIssueMissingKeyword",
+                "BulletIssueInventedNumber"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.BulletOutcome": {
+            "type": "string",
+            "enum": [
+                "selected",
+                "dropped",
+                "not_ranked",
+                "excluded"
+            ],
+            "x-enum-varnames": [
+                "BulletOutcomeSelected",
+                "BulletOutcomeDropped",
+                "BulletOutcomeNotRanked",
+                "BulletOutcomeExcluded"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.ContactField": {
+            "type": "string",
+            "enum": [
+                "phone",
+                "email",
+                "linkedin",
+                "github",
+                "portfolio"
+            ],
+            "x-enum-varnames": [
+                "ContactPhone",
+                "ContactEmail",
+                "ContactLinkedIn",
+                "ContactGitHub",
+                "ContactPortfolio"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.CritiqueVerdict": {
+            "type": "string",
+            "enum": [
+                "interview",
+                "maybe",
+                "reject"
+            ],
+            "x-enum-varnames": [
+                "CritiqueVerdictInterview",
+                "CritiqueVerdictMaybe",
+                "CritiqueVerdictReject"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.Date": {
+            "type": "object",
+            "properties": {
+                "precision": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.DatePrecision"
+                },
+                "time.Time": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.DateFormat": {
+            "type": "string",
+            "enum": [
+                "locale",
+                "mon_yyyy",
+                "mm/yyyy",
+                "yyyy-mm"
+            ],
+            "x-enum-varnames": [
+                "DateFormatLocale",
+                "DateFormatMonthYear",
+                "DateFormatNumeric",
+                "DateFormatISO"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.DatePrecision": {
+            "type": "string",
+            "enum": [
+                "day",
+                "month"
+            ],
+            "x-enum-varnames": [
+                "DatePrecisionDay",
+                "DatePrecisionMonth"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.Education": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "degree": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Date"
+                },
+                "field_of_study": {
+                    "type": "string"
+                },
+                "gpa": {
+                    "type": "string"
+                },
+                "honors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "institution": {
+                    "type": "string"
+                },
+                "is_expected": {
+                    "description": "EndDate is an expected graduation date",
+                    "type": "boolean"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Date"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.Experience": {
+            "type": "object",
+            "properties": {
+                "bullets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Bullet"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Date"
+                },
+                "exclude_from_tailoring": {
+                    "description": "ExcludeFromTailoring leaves the experience's bullets out of tailoring,\ne.g. for unrelated early-career jobs; see Resume.TailorsExperience.",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_current": {
+                    "type": "boolean"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "organization": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Date"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ExperienceType"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.ExperienceType": {
+            "type": "string",
+            "enum": [
+                "work",
+                "education",
+                "certification",
+                "project",
+                "freelance",
+                "volunteer",
+                "open_source",
+                "hackathon",
+                "side_project",
+                "event_organization",
+                "publication",
+                "award"
+            ],
+            "x-enum-varnames": [
+                "ExperienceTypeWork",
+                "ExperienceTypeEducation",
+                "ExperienceTypeCertification",
+                "ExperienceTypeProject",
+                "ExperienceTypeFreelance",
+                "ExperienceTypeVolunteer",
+                "ExperienceTypeOpenSource",
+                "ExperienceTypeHackathon",
+                "ExperienceTypeSideProject",
+                "ExperienceTypeEventOrganization",
+                "ExperienceTypePublication",
+                "ExperienceTypeAward"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.FeedbackVerdict": {
+            "type": "string",
+            "enum": [
+                "accepted",
+                "edited",
+                "rejected"
+            ],
+            "x-enum-varnames": [
+                "FeedbackVerdictAccepted",
+                "FeedbackVerdictEdited",
+                "FeedbackVerdictRejected"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.FilenamePattern": {
+            "type": "string",
+            "enum": [
+                "{name}_Resume_{target}"
+            ],
+            "x-enum-varnames": [
+                "DefaultFilenamePattern"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.JobInsights": {
+            "type": "object",
+            "properties": {
+                "benefits": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "remote_policy": {
+                    "description": "RemotePolicy is empty when the description does not say.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.RemotePolicy"
+                        }
+                    ]
+                },
+                "salary": {
+                    "description": "Salary is nil when the description states no pay.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.SalaryRange"
+                        }
+                    ]
+                },
+                "visa_sponsorship": {
+                    "description": "VisaSponsorship is nil when sponsorship is not mentioned.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.JobRequirements": {
+            "type": "object",
+            "properties": {
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "preferred_skills": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "required_skills": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "seniority_level": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "years_experience": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.LanguageProficiency": {
+            "type": "string",
+            "enum": [
+                "native",
+                "fluent",
+                "advanced",
+                "intermediate",
+                "basic"
+            ],
+            "x-enum-varnames": [
+                "ProficiencyNative",
+                "ProficiencyFluent",
+                "ProficiencyAdvanced",
+                "ProficiencyIntermediate",
+                "ProficiencyBasic"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.Persona": {
+            "type": "string",
+            "enum": [
+                "technical_hiring_manager",
+                "hr_screener"
+            ],
+            "x-enum-varnames": [
+                "PersonaTechnicalHiringManager",
+                "PersonaHRScreener"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.Plan": {
+            "type": "string",
+            "enum": [
+                "free",
+                "pro"
+            ],
+            "x-enum-varnames": [
+                "PlanFree",
+                "PlanPro"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.Project": {
+            "type": "object",
+            "properties": {
+                "bullets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ProjectBullet"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Date"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "repository_url": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Date"
+                },
+                "tech_stack": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.ProjectBullet": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.QRCodeTarget": {
+            "type": "string",
+            "enum": [
+                "",
+                "portfolio",
+                "website",
+                "linkedin",
+                "github"
+            ],
+            "x-enum-varnames": [
+                "QRCodeNone",
+                "QRCodePortfolio",
+                "QRCodeWebsite",
+                "QRCodeLinkedIn",
+                "QRCodeGitHub"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.RemotePolicy": {
+            "type": "string",
+            "enum": [
+                "remote",
+                "hybrid",
+                "onsite"
+            ],
+            "x-enum-varnames": [
+                "RemotePolicyRemote",
+                "RemotePolicyHybrid",
+                "RemotePolicyOnsite"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.Resume": {
+            "type": "object",
+            "properties": {
+                "archived_at": {
+                    "description": "ArchivedAt is when the user archived the resume, nil unless archived.\nArchived resumes are kept, and lists can leave them out.",
+                    "type": "string"
+                },
+                "company_name": {
+                    "type": "string"
+                },
+                "contact_priority": {
+                    "description": "ContactPriority orders the contact details in the header, from the\nhighest priority to the lowest; see ContactOrder.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ContactField"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "excluded_experience_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "generated_content": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeContent"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "included_experience_ids": {
+                    "description": "IncludedExperienceIDs and ExcludedExperienceIDs override, for this\nresume, whether experiences are tailored; see TailorsExperience.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "job_description": {
+                    "type": "string"
+                },
+                "job_insights": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.JobInsights"
+                },
+                "job_title": {
+                    "type": "string"
+                },
+                "job_url": {
+                    "type": "string"
+                },
+                "last_accessed_at": {
+                    "description": "LastAccessedAt is when the user last opened the resume, nil if never.\nIt is recorded on reads, not by updating the resume.",
+                    "type": "string"
+                },
+                "max_pages": {
+                    "description": "MaxPages is the number of pages the resume may span, from 1 to\nMaxResumePages; see PageLimit.",
+                    "type": "integer"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "pdf_url": {
+                    "type": "string"
+                },
+                "pinned": {
+                    "description": "Pinned resumes can be listed first; see ResumeSortPinnedFirst.",
+                    "type": "boolean"
+                },
+                "qr_code": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.QRCodeTarget"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "sections": {
+                    "description": "Sections holds display options for the resume's sections.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.SectionConfig"
+                        }
+                    ]
+                },
+                "selected_bullets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "selection": {
+                    "description": "Selection explains the bullets chosen when the resume was last\ntailored; nil before tailoring.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.SelectionExplanation"
+                        }
+                    ]
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeStatus"
+                },
+                "tag_ids": {
+                    "description": "TagIDs are the IDs of the user's tags on the resume. They are\nchanged through tag assignments, not by updating the resume.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "target_language": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeActivity": {
+            "type": "object",
+            "properties": {
+                "ai_settings": {
+                    "description": "AISettings are the settings of an AI run; nil for other events and\nruns recorded before settings were kept.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.AIRunSettings"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "detail": {
+                    "description": "Detail describes other events: the template of a PDF generation, or\nthe operation of an AI run (AIRunTailor, AIRunCritique, AIRunFit,\nAIRunRegenerate).",
+                    "type": "string"
+                },
+                "from_status": {
+                    "description": "FromStatus and ToStatus are the statuses before and after a status\nchange.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeStatus"
+                        }
+                    ]
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "description": "Note is the text of a note.",
+                    "type": "string"
+                },
+                "resume_id": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeStatus"
+                },
+                "type": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeActivityType"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeActivityType": {
+            "type": "string",
+            "enum": [
+                "note",
+                "status_change",
+                "pdf_generated",
+                "ai_run"
+            ],
+            "x-enum-varnames": [
+                "ResumeActivityNote",
+                "ResumeActivityStatusChange",
+                "ResumeActivityPDFGenerated",
+                "ResumeActivityAIRun"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeAnalysis": {
+            "type": "object",
+            "properties": {
+                "improvement_areas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "matched_keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "missing_keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "recommendations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "strength_areas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeContent": {
+            "type": "object",
+            "properties": {
+                "ai_settings": {
+                    "description": "AISettings are the settings the content was tailored with, to tailor\nit again the same way. Nil for content tailored before they were\nkept.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.AIRunSettings"
+                        }
+                    ]
+                },
+                "analysis": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeAnalysis"
+                },
+                "experiences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.TailoredExperience"
+                    }
+                },
+                "job": {
+                    "description": "Job is the AI analysis of the job description the content was\ntailored to, kept to regenerate parts of the content without\nanalyzing the job again. Nil for content tailored before it was kept.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.JobRequirements"
+                        }
+                    ]
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "source_language": {
+                    "description": "SourceLanguage is the language of the bullets' tailored content when\nthey were translated to the resume's target language.",
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "summary_angle": {
+                    "type": "string"
+                },
+                "summary_variants": {
+                    "description": "SummaryVariants are the summaries the AI wrote from different\nangles; Summary is the text of the one at SummaryAngle. Empty for\ncontent tailored before variants were kept, or when the user's own\nsummary was used.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.SummaryVariant"
+                    }
+                },
+                "themes": {
+                    "description": "Themes group the tailored bullets by the skill they demonstrate, for\nthe functional template. Empty when the AI did not group them.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.SkillTheme"
+                    }
+                },
+                "voice": {
+                    "description": "Voice is the tone and target reader the content was written with,\nkept to write regenerated parts the same way. Nil when tailored\nwith the defaults.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.WritingVoice"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeCritique": {
+            "type": "object",
+            "properties": {
+                "content_hash": {
+                    "description": "ContentHash identifies the version of the generated content that was\nreviewed; see ResumeContentHash.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "red_flags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resume_id": {
+                    "type": "string"
+                },
+                "score": {
+                    "description": "Score is the reviewer's overall rating of the resume for the job (0-100).",
+                    "type": "integer"
+                },
+                "strengths": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "suggested_edits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.SuggestedEdit"
+                    }
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "verdict": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.CritiqueVerdict"
+                },
+                "weaknesses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeSearch": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "description": "Archived keeps archived (true) or unarchived (false) resumes. Without\nit, archived resumes are left out unless IncludeArchived is set.",
+                    "type": "boolean"
+                },
+                "company": {
+                    "description": "Company keeps resumes whose company name contains this text,\nignoring case.",
+                    "type": "string"
+                },
+                "created_after": {
+                    "description": "CreatedAfter and CreatedBefore bound the creation time, as dates\n(YYYY-MM-DD) or RFC 3339 timestamps. A date covers the whole day.",
+                    "type": "string"
+                },
+                "created_before": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "include_archived": {
+                    "type": "boolean"
+                },
+                "language": {
+                    "description": "Language keeps resumes in this target language; \"pt\" also matches\nregional variants such as \"pt-br\".",
+                    "type": "string"
+                },
+                "min_salary": {
+                    "type": "integer"
+                },
+                "remote": {
+                    "description": "Job insight filters.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "score_min": {
+                    "description": "MinScore keeps resumes with at least this match score.",
+                    "type": "integer"
+                },
+                "sort": {
+                    "description": "Sort orders the resumes: \"newest\" (the default), \"recent\" or\n\"pinned_first\"; see ResumeSort.",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tag": {
+                    "description": "TagIDs keeps resumes that have all of these tags.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "visa_sponsorship": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeStatus": {
+            "type": "string",
+            "enum": [
+                "draft",
+                "generated",
+                "reviewed",
+                "submitted",
+                "interview",
+                "rejected",
+                "accepted"
+            ],
+            "x-enum-varnames": [
+                "ResumeStatusDraft",
+                "ResumeStatusGenerated",
+                "ResumeStatusReviewed",
+                "ResumeStatusSubmitted",
+                "ResumeStatusInterview",
+                "ResumeStatusRejected",
+                "ResumeStatusAccepted"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeTag": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is unique per user, ignoring case.",
+                    "type": "string"
+                },
+                "resume_count": {
+                    "description": "ResumeCount is the number of resumes with the tag; only set when\nlisting tags.",
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.SalaryPeriod": {
+            "type": "string",
+            "enum": [
+                "year",
+                "month",
+                "hour"
+            ],
+            "x-enum-varnames": [
+                "SalaryPeriodYear",
+                "SalaryPeriodMonth",
+                "SalaryPeriodHour"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.SalaryRange": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "description": "Currency is an ISO 4217 code, e.g. \"USD\"; empty when not stated.",
+                    "type": "string"
+                },
+                "max": {
+                    "type": "integer"
+                },
+                "min": {
+                    "type": "integer"
+                },
+                "period": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.SalaryPeriod"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.SavedResumeFilter": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is unique per user, ignoring case.",
+                    "type": "string"
+                },
+                "search": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeSearch"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.SectionConfig": {
+            "type": "object",
+            "properties": {
+                "skills_display": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.SkillsDisplay"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.SelectionExplanation": {
+            "type": "object",
+            "properties": {
+                "bullets": {
+                    "description": "Bullets holds a decision for each of the user's bullets, selected\nones first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.BulletDecision"
+                    }
+                },
+                "reasoning": {
+                    "description": "Reasoning is the AI's explanation of its overall selection strategy.",
+                    "type": "string"
+                },
+                "validation": {
+                    "description": "Validation summarizes the validation of the rewritten bullets; nil\nfor resumes tailored before bullets were validated.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.TailorValidation"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.Skill": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_highlighted": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "proficiency_level": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "years_of_experience": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.SkillTheme": {
+            "type": "object",
+            "properties": {
+                "bullet_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.SkillsDisplay": {
+            "type": "string",
+            "enum": [
+                "list",
+                "bars",
+                "years"
+            ],
+            "x-enum-varnames": [
+                "SkillsDisplayList",
+                "SkillsDisplayBars",
+                "SkillsDisplayYears"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.SpokenLanguage": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "proficiency": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.LanguageProficiency"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.SuggestedEdit": {
+            "type": "object",
+            "properties": {
+                "original": {
+                    "description": "Original is the current text; empty when the edit adds new content.",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "section": {
+                    "description": "Section is the part of the resume to change, e.g. \"summary\" or\n\"experience: Backend Engineer at Acme\".",
+                    "type": "string"
+                },
+                "suggested": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.SummaryVariant": {
+            "type": "object",
+            "properties": {
+                "angle": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.TailorValidation": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "description": "Checked is the number of bullets whose rewrites were validated.",
+                    "type": "integer"
+                },
+                "rejected": {
+                    "description": "Rejected is the number of bullets that kept their original content\nbecause every rewrite failed validation.",
+                    "type": "integer"
+                },
+                "retried": {
+                    "description": "Retried is the number of bullets rewritten again after their first\nrewrite failed validation.",
+                    "type": "integer"
+                },
+                "tense_fixed": {
+                    "description": "TenseFixed is the number of bullets whose opening verb was put in the\ntense of their role: past for ended roles, present for current ones.",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.TailoredBullet": {
+            "type": "object",
+            "properties": {
+                "bullet_id": {
+                    "type": "string"
+                },
+                "needs_review": {
+                    "description": "NeedsReview is set when the tailored or translated content has terms\nnot found in the user's profile, listed in UnverifiedTerms. The user\nmust confirm such bullets before the resume is marked reviewed.",
+                    "type": "boolean"
+                },
+                "original_content": {
+                    "type": "string"
+                },
+                "tailored_content": {
+                    "type": "string"
+                },
+                "translated_content": {
+                    "description": "TranslatedContent is the tailored content in the resume's target\nlanguage, set when it differs from the profile language. Tailored\ncontent then stays in the profile language for verification.",
+                    "type": "string"
+                },
+                "unverified_terms": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.TailoredExperience": {
+            "type": "object",
+            "properties": {
+                "bullets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.TailoredBullet"
+                    }
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "experience_id": {
+                    "type": "string"
+                },
+                "is_current": {
+                    "type": "boolean"
+                },
+                "organization": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.Tone": {
+            "type": "string",
+            "enum": [
+                "confident",
+                "humble",
+                "energetic"
+            ],
+            "x-enum-varnames": [
+                "ToneConfident",
+                "ToneHumble",
+                "ToneEnergetic"
+            ]
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.User": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "firebase_uid": {
+                    "type": "string"
+                },
+                "github_url": {
+                    "type": "string"
+                },
+                "headline": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "linkedin_url": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "picture_url": {
+                    "type": "string"
+                },
+                "plan": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Plan"
+                },
+                "portfolio_url": {
+                    "type": "string"
+                },
+                "preferred_language": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.UserPreferences": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "date_format": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.DateFormat"
+                },
+                "default_font_size": {
+                    "description": "DefaultFontSize is the base font size in pt for rendered resumes; 0\nkeeps each template's own default.",
+                    "type": "integer"
+                },
+                "default_max_bullets": {
+                    "description": "DefaultMaxBullets is used when a resume is tailored without a bullet limit.",
+                    "type": "integer"
+                },
+                "default_target_language": {
+                    "description": "DefaultTargetLanguage is used when a resume is created without a language.",
+                    "type": "string"
+                },
+                "default_template": {
+                    "description": "DefaultTemplate is used when a PDF is requested without a template.",
+                    "type": "string"
+                },
+                "email_product_updates": {
+                    "type": "boolean"
+                },
+                "email_usage_alerts": {
+                    "type": "boolean"
+                },
+                "filename_pattern": {
+                    "description": "FilenamePattern names downloaded resume files.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.FilenamePattern"
+                        }
+                    ]
+                },
+                "timezone": {
+                    "description": "Timezone is an IANA time zone name, e.g. \"America/Sao_Paulo\".",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_domain.WritingVoice": {
+            "type": "object",
+            "properties": {
+                "persona": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Persona"
+                },
+                "tone": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Tone"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_services.ProfileBundle": {
+            "type": "object",
+            "properties": {
+                "education": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Education"
+                    }
+                },
+                "experiences": {
+                    "description": "Experiences and Projects include their bullets.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Experience"
+                    }
+                },
+                "profile": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_services.ProfileBundleProfile"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Project"
+                    }
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Skill"
+                    }
+                },
+                "spoken_languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.SpokenLanguage"
+                    }
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_services.ProfileBundleProfile": {
+            "type": "object",
+            "properties": {
+                "github_url": {
+                    "type": "string"
+                },
+                "headline": {
+                    "type": "string"
+                },
+                "linkedin_url": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "portfolio_url": {
+                    "type": "string"
+                },
+                "preferred_language": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_SeltikHD_chameleon-vitae_internal_core_services.UserArchive": {
+            "type": "object",
+            "properties": {
+                "academic_entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.AcademicEntry"
+                    }
+                },
+                "bullet_feedback": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.BulletFeedback"
+                    }
+                },
+                "critiques": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeCritique"
+                    }
+                },
+                "education": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Education"
+                    }
+                },
+                "experiences": {
+                    "description": "Experiences and Projects include their bullets.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Experience"
+                    }
+                },
+                "exported_at": {
+                    "type": "string"
+                },
+                "picture_source_url": {
+                    "description": "PictureSourceURL is the provider's URL of the user's picture, which\nthe user's JSON leaves out.",
+                    "type": "string"
+                },
+                "preferences": {
+                    "description": "Preferences is nil for users who never saved theirs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.UserPreferences"
+                        }
+                    ]
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Project"
+                    }
+                },
+                "resume_activity": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeActivity"
+                    }
+                },
+                "resume_tags": {
+                    "description": "Resumes include the IDs of their tags.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.ResumeTag"
+                    }
+                },
+                "resumes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Resume"
+                    }
+                },
+                "saved_filters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.SavedResumeFilter"
+                    }
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.Skill"
+                    }
+                },
+                "spoken_languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.SpokenLanguage"
+                    }
+                },
+                "user": {
+                    "$ref": "#/definitions/github_com_SeltikHD_chameleon-vitae_internal_core_domain.User"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_adapters_primary_http.AICallEstimateResponse": {
+            "type": "object",
+            "properties": {
+                "cost_usd": {
+                    "type": "number",
+                    "example": 0.0037
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "input_tokens": {
+                    "type": "integer",
+                    "example": 5400
+                },
+                "model": {
+                    "type": "string",
+                    "example": "llama-3.3-70b-versatile"
+                },
+                "operation": {
+                    "type": "string",
+                    "example": "tailor_bullet"
+                },
+                "output_tokens": {
+                    "type": "integer",
+                    "example": 600
+                }
+            }
+        },
+        "internal_adapters_primary_http.AICallSettingsDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "model": {
+                    "type": "string",
+                    "example": "llama-3.3-70b-versatile"
+                },
+                "operation": {
+                    "type": "string",
+                    "example": "tailor_bullet"
+                },
+                "prompt_version": {
+                    "type": "string",
+                    "example": "v1"
+                },
+                "temperature": {
+                    "type": "number",
+                    "example": 0.7
+                }
+            }
+        },
+        "internal_adapters_primary_http.AIRunSettingsDTO": {
+            "type": "object",
+            "properties": {
+                "calls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.AICallSettingsDTO"
+                    }
+                },
+                "include_highlighted_skills": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "max_bullets": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "seed": {
+                    "type": "integer",
+                    "example": 20260115
+                }
+            }
+        },
+        "internal_adapters_primary_http.AcademicEntryResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "USD 250,000"
+                },
+                "authors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "J. Doe",
+                        "A. Smith"
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-08"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "organization": {
+                    "type": "string",
+                    "example": "ACM SIGCOMM 2024"
+                },
+                "section": {
+                    "type": "string",
+                    "enum": [
+                        "publications",
+                        "grants",
+                        "teaching",
+                        "service"
+                    ],
+                    "example": "publications"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2023-09"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Scalable Consensus for Edge Networks"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://doi.org/10.1145/0000000"
+                }
+            }
+        },
+        "internal_adapters_primary_http.AnalyzeBulletRequest": {
+            "type": "object",
+            "properties": {
+                "job_description": {
+                    "type": "string",
+                    "example": "We are looking for a Senior Backend Engineer..."
+                }
+            }
+        },
+        "internal_adapters_primary_http.AssignResumeTagRequest": {
+            "type": "object",
+            "properties": {
+                "add": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440000"
+                    ]
+                },
+                "remove": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440001"
+                    ]
+                }
+            }
+        },
+        "internal_adapters_primary_http.BatchUpsertSkillsRequest": {
+            "type": "object",
+            "properties": {
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.SkillInput"
+                    }
+                }
+            }
+        },
+        "internal_adapters_primary_http.BatchUpsertSkillsResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.SkillResponse"
+                    }
+                },
+                "updated": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "internal_adapters_primary_http.BulkResumeResultDTO": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ErrorBody"
+                },
+                "resume_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "applied",
+                        "failed",
+                        "aborted"
+                    ],
+                    "example": "applied"
+                }
+            }
+        },
+        "internal_adapters_primary_http.BulkResumesRequest": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "delete",
+                        "archive",
+                        "unarchive",
+                        "change_status",
+                        "add_tag"
+                    ],
+                    "example": "archive"
+                },
+                "resume_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440000"
+                    ]
+                },
+                "status": {
+                    "type": "string",
+                    "example": "rejected"
+                },
+                "tag_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "transactional": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_adapters_primary_http.BulkResumesResponse": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.BulkResumeResultDTO"
+                    }
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "internal_adapters_primary_http.BulletDecisionDTO": {
+            "type": "object",
+            "properties": {
+                "bullet_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "outcome": {
+                    "type": "string",
+                    "enum": [
+                        "selected",
+                        "dropped",
+                        "not_ranked",
+                        "excluded"
+                    ],
+                    "example": "selected"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Shows Kubernetes experience the job requires"
+                },
+                "rejected": {
+                    "description": "Rejected lists why the AI rewrite of a selected bullet was rejected,\nleaving its original content.",
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "empty",
+                            "too_long",
+                            "first_person",
+                            "profanity",
+                            "implausible_claim",
+                            "missing_keyword",
+                            "invented_number"
+                        ]
+                    },
+                    "example": [
+                        "invented_number"
+                    ]
+                }
+            }
+        },
+        "internal_adapters_primary_http.BulletDiffResponse": {
+            "type": "object",
+            "properties": {
+                "bullet_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "changed": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "experience_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "original": {
+                    "type": "string",
+                    "example": "Cut latency with caching"
+                },
+                "segments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.DiffSegmentResponse"
+                    }
+                },
+                "tailored": {
+                    "type": "string",
+                    "example": "Cut p99 latency by 40% with caching"
+                }
+            }
+        },
+        "internal_adapters_primary_http.BulletFeedbackDTO": {
+            "type": "object",
+            "properties": {
+                "bullet_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "comment": {
+                    "type": "string",
+                    "example": "Too many buzzwords"
+                },
+                "edited_content": {
+                    "type": "string",
+                    "example": "Cut deploy time by **40%** by moving CI to Go"
+                },
+                "verdict": {
+                    "type": "string",
+                    "enum": [
+                        "accepted",
+                        "edited",
+                        "rejected"
+                    ],
+                    "example": "edited"
+                }
+            }
+        },
+        "internal_adapters_primary_http.BulletFeedbackResponse": {
+            "type": "object",
+            "properties": {
+                "bullet_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "comment": {
+                    "type": "string",
+                    "example": "Too many buzzwords"
+                },
+                "edited_content": {
+                    "type": "string",
+                    "example": "Cut deploy time by **40%** by moving CI to Go"
+                },
+                "tailored_content": {
+                    "type": "string",
+                    "example": "Leveraged cutting-edge tooling to improve deployments"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "verdict": {
+                    "type": "string",
+                    "enum": [
+                        "accepted",
+                        "edited",
+                        "rejected"
+                    ],
+                    "example": "edited"
+                }
+            }
+        },
+        "internal_adapters_primary_http.BulletResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Reduced API latency by 40%"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "experience_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "impact_score": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "performance",
+                        "optimization"
+                    ]
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                }
+            }
+        },
+        "internal_adapters_primary_http.BulletV2Response": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Reduced API latency by 40%"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "experience_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "impact_score": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "performance",
+                        "optimization"
+                    ]
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ConfirmBulletsRequest": {
+            "type": "object",
+            "properties": {
+                "bullet_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440000"
+                    ]
+                }
+            }
+        },
+        "internal_adapters_primary_http.ContentDiffResponse": {
+            "type": "object",
+            "properties": {
+                "changed": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "original": {
+                    "type": "string",
+                    "example": "Cut latency with caching"
+                },
+                "segments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.DiffSegmentResponse"
+                    }
+                },
+                "tailored": {
+                    "type": "string",
+                    "example": "Cut p99 latency by 40% with caching"
+                }
+            }
+        },
+        "internal_adapters_primary_http.CreateAcademicEntryRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "USD 250,000"
+                },
+                "authors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "J. Doe",
+                        "A. Smith"
+                    ]
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-08"
+                },
+                "organization": {
+                    "type": "string",
+                    "example": "ACM SIGCOMM 2024"
+                },
+                "section": {
+                    "type": "string",
+                    "enum": [
+                        "publications",
+                        "grants",
+                        "teaching",
+                        "service"
+                    ],
+                    "example": "publications"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2023-09"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Scalable Consensus for Edge Networks"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://doi.org/10.1145/0000000"
+                }
+            }
+        },
+        "internal_adapters_primary_http.CreateBulletRequest": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Reduced API latency by 40%"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "performance",
+                        "optimization"
+                    ]
+                }
+            }
+        },
+        "internal_adapters_primary_http.CreateEducationRequest": {
+            "type": "object",
+            "properties": {
+                "degree": {
+                    "type": "string",
+                    "example": "Bachelor of Science"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2022-05-15"
+                },
+                "field_of_study": {
+                    "type": "string",
+                    "example": "Computer Science"
+                },
+                "gpa": {
+                    "type": "string",
+                    "example": "3.85/4.0"
+                },
+                "honors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Magna Cum Laude",
+                        "Dean's List"
+                    ]
+                },
+                "institution": {
+                    "type": "string",
+                    "example": "Massachusetts Institute of Technology"
+                },
+                "is_expected": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Cambridge, MA"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2018-09-01"
+                }
+            }
+        },
+        "internal_adapters_primary_http.CreateExperienceRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Led backend development..."
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-06-30"
+                },
+                "exclude_from_tailoring": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "is_current": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Remote"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "organization": {
+                    "type": "string",
+                    "example": "Tech Company Inc."
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2022-01-15"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Senior Software Engineer"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "work"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://techcompany.com"
+                }
+            }
+        },
+        "internal_adapters_primary_http.CreateProjectBulletRequest": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "example": "Implemented new feature"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "internal_adapters_primary_http.CreateProjectRequest": {
+            "type": "object",
+            "properties": {
+                "bullets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Implemented feature X",
+                        "Optimized performance by 50%"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "example": "AI-powered resume engineering tool"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-06-30"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chameleon Vitae"
+                },
+                "repository_url": {
+                    "type": "string",
+                    "example": "https://github.com/SeltikHD/chameleon-vitae"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2024-01-01"
+                },
+                "tech_stack": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Go",
+                        "Vue.js",
+                        "PostgreSQL"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://chameleon-vitae.dev"
+                }
+            }
+        },
+        "internal_adapters_primary_http.CreateResumeRequest": {
+            "type": "object",
+            "properties": {
+                "company_name": {
+                    "type": "string",
+                    "example": "Awesome Corp"
+                },
+                "job_description": {
+                    "type": "string",
+                    "example": "## Senior Backend Engineer..."
+                },
+                "job_title": {
+                    "type": "string",
+                    "example": "Senior Backend Engineer"
+                },
+                "job_url": {
+                    "type": "string",
+                    "example": "https://linkedin.com/jobs/12345"
+                },
+                "target_language": {
+                    "type": "string",
+                    "example": "en"
+                }
+            }
+        },
+        "internal_adapters_primary_http.CreateSpokenLanguageRequest": {
+            "type": "object",
+            "properties": {
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "language": {
+                    "type": "string",
+                    "example": "Portuguese"
+                },
+                "proficiency": {
+                    "type": "string",
+                    "example": "native"
+                }
+            }
+        },
+        "internal_adapters_primary_http.CurrentSessionResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2026-03-01T12:00:00Z"
+                },
+                "issued_at": {
+                    "type": "string",
+                    "example": "2026-03-01T11:00:00Z"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "google.com"
+                }
+            }
+        },
+        "internal_adapters_primary_http.DiffSegmentResponse": {
+            "type": "object",
+            "properties": {
+                "op": {
+                    "type": "string",
+                    "enum": [
+                        "equal",
+                        "delete",
+                        "insert"
+                    ],
+                    "example": "insert"
+                },
+                "text": {
+                    "type": "string",
+                    "example": "by 40% "
+                }
+            }
+        },
+        "internal_adapters_primary_http.EducationResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "degree": {
+                    "type": "string",
+                    "example": "Bachelor of Science"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2022-05-15"
+                },
+                "field_of_study": {
+                    "type": "string",
+                    "example": "Computer Science"
+                },
+                "gpa": {
+                    "type": "string",
+                    "example": "3.85/4.0"
+                },
+                "honors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Magna Cum Laude",
+                        "Dean's List"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "institution": {
+                    "type": "string",
+                    "example": "Massachusetts Institute of Technology"
+                },
+                "is_expected": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Cambridge, MA"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2018-09-01"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                }
+            }
+        },
+        "internal_adapters_primary_http.Envelope-array_internal_adapters_primary_http_ExperienceV2Response": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.ExperienceV2Response"
+                    }
+                },
+                "error": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ErrorBody"
+                },
+                "meta": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "internal_adapters_primary_http.Envelope-internal_adapters_primary_http_ExperienceV2Response": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ExperienceV2Response"
+                },
+                "error": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ErrorBody"
+                },
+                "meta": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "internal_adapters_primary_http.ErrorBody": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "VALIDATION_ERROR"
+                },
+                "details": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.ErrorDetail"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Validation failed"
+                },
+                "request_id": {
+                    "description": "RequestID identifies the request in the server logs; it is set on\nunexpected errors.",
+                    "type": "string",
+                    "example": "host/abc123-000001"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ErrorDetail": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "email"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Invalid email format"
+                },
+                "row": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "internal_adapters_primary_http.ErrorEnvelope": {
+            "type": "object"
+        },
+        "internal_adapters_primary_http.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ErrorBody"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ExperienceResponse": {
+            "type": "object",
+            "properties": {
+                "bullets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.BulletResponse"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Led backend development..."
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-06-30"
+                },
+                "exclude_from_tailoring": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_current": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Remote"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "organization": {
+                    "type": "string",
+                    "example": "Tech Company Inc."
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2022-01-15"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Senior Software Engineer"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "work"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://techcompany.com"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ExperienceV2Response": {
+            "type": "object",
+            "properties": {
+                "bullets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.BulletV2Response"
+                    }
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Led backend development..."
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-06-30"
+                },
+                "exclude_from_tailoring": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_current": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Remote"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "organization": {
+                    "type": "string",
+                    "example": "Tech Company Inc."
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2022-01-15"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Senior Software Engineer"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "work"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://techcompany.com"
+                }
+            }
+        },
+        "internal_adapters_primary_http.FitCheckResponse": {
+            "type": "object",
+            "properties": {
+                "density": {
+                    "type": "string",
+                    "example": "comfortable"
+                },
+                "estimated_pages": {
+                    "type": "number",
+                    "example": 1.1
+                },
+                "fits": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "font_size": {
+                    "type": "integer",
+                    "example": 11
+                },
+                "lines": {
+                    "type": "integer",
+                    "example": 46
+                },
+                "lines_per_page": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "max_pages": {
+                    "description": "MaxPages is the number of pages the PDF keeps; 0 means no limit.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "overflow_lines": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.SectionFitResult"
+                    }
+                },
+                "template": {
+                    "type": "string",
+                    "example": "jake"
+                }
+            }
+        },
+        "internal_adapters_primary_http.FitResumeRequest": {
+            "type": "object",
+            "properties": {
+                "density": {
+                    "type": "string",
+                    "example": "comfortable"
+                },
+                "font_size": {
+                    "type": "integer",
+                    "example": 11
+                },
+                "template": {
+                    "type": "string",
+                    "example": "jake"
+                }
+            }
+        },
+        "internal_adapters_primary_http.FitResumeResponse": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.FitCheckResponse"
+                },
+                "before": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.FitCheckResponse"
+                },
+                "resume": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                },
+                "rounds": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "shortened": {
+                    "description": "Shortened lists the IDs of the shortened bullets, and \"summary\" when\nthe summary was shortened.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440000",
+                        "summary"
+                    ]
+                }
+            }
+        },
+        "internal_adapters_primary_http.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "service": {
+                    "type": "string",
+                    "example": "chameleon-vitae"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "healthy"
+                }
+            }
+        },
+        "internal_adapters_primary_http.IdentityResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-03-01T10:00:00Z"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "github.com"
+                },
+                "provider_user_id": {
+                    "type": "string",
+                    "example": "def456uvw"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ImpersonateRequest": {
+            "type": "object",
+            "properties": {
+                "scope": {
+                    "description": "Scope is read (GET requests only, the default) or full.",
+                    "type": "string",
+                    "enum": [
+                        "read",
+                        "full"
+                    ],
+                    "example": "read"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ImpersonationResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2026-03-01T10:15:00Z"
+                },
+                "impersonation_id": {
+                    "type": "string",
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+                },
+                "scope": {
+                    "type": "string",
+                    "example": "read"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "imp.NTUwZTg0MDAt...."
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ImportCSVResponse": {
+            "type": "object",
+            "properties": {
+                "bullets": {
+                    "type": "integer",
+                    "example": 17
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.ExperienceResponse"
+                    }
+                },
+                "dry_run": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "experiences": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "internal_adapters_primary_http.ImportUploadResponse": {
+            "type": "object",
+            "properties": {
+                "max_chunk_size": {
+                    "type": "integer",
+                    "example": 1048576
+                },
+                "max_chunks": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "max_file_size": {
+                    "type": "integer",
+                    "example": 5242880
+                },
+                "upload_id": {
+                    "type": "string",
+                    "example": "Q4ZJ7XKD2M5NB6WVRHT3ACYE2L"
+                }
+            }
+        },
+        "internal_adapters_primary_http.JobInsightsDTO": {
+            "type": "object",
+            "properties": {
+                "benefits": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Health insurance",
+                        "Stock options"
+                    ]
+                },
+                "remote_policy": {
+                    "type": "string",
+                    "enum": [
+                        "remote",
+                        "hybrid",
+                        "onsite"
+                    ],
+                    "example": "hybrid"
+                },
+                "salary": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.SalaryRangeDTO"
+                },
+                "visa_sponsorship": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_adapters_primary_http.LinkIdentityRequest": {
+            "type": "object",
+            "properties": {
+                "id_token": {
+                    "description": "IDToken is an ID token from signing in with the method to link.",
+                    "type": "string",
+                    "example": "eyJhbGciOiJSUzI1NiIs..."
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListAcademicEntriesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.AcademicEntryResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListBulletFeedbackResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.BulletFeedbackResponse"
+                    }
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListBulletsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.BulletResponse"
+                    }
                 },
-                "updated": {
+                "total": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListEducationResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.EducationResponse"
+                    }
+                },
+                "total": {
                     "type": "integer",
                     "example": 2
                 }
             }
         },
-        "internal_adapters_primary_http.BulletResponse": {
+        "internal_adapters_primary_http.ListExperiencesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.ExperienceResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 15
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListIdentitiesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.IdentityResponse"
+                    }
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListProjectsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.ProjectResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListResumeActivityResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.ResumeActivityResponse"
+                    }
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListResumeCritiquesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.ResumeCritiqueResponse"
+                    }
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListResumeTagsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.ResumeTagResponse"
+                    }
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListResumesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListSavedResumeFiltersResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.SavedResumeFilterResponse"
+                    }
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListSkillsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.SkillResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 25
+                }
+            }
+        },
+        "internal_adapters_primary_http.ListSpokenLanguagesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.SpokenLanguageResponse"
+                    }
+                }
+            }
+        },
+        "internal_adapters_primary_http.ParseJobMetadata": {
+            "type": "object",
+            "properties": {
+                "fetched_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "source": {
+                    "type": "string",
+                    "example": "linkedin.com"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ParseJobURLRequest": {
+            "type": "object",
+            "properties": {
+                "url": {
+                    "type": "string",
+                    "example": "https://linkedin.com/jobs/view/12345"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ParseJobURLResponse": {
+            "type": "object",
+            "properties": {
+                "markdown": {
+                    "type": "string",
+                    "example": "## Senior Backend Engineer..."
+                },
+                "metadata": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ParseJobMetadata"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Senior Backend Engineer at Awesome Corp"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://linkedin.com/jobs/view/12345"
+                }
+            }
+        },
+        "internal_adapters_primary_http.PreferencesResponse": {
+            "type": "object",
+            "properties": {
+                "date_format": {
+                    "type": "string",
+                    "example": "locale"
+                },
+                "default_font_size": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "default_max_bullets": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "default_target_language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "default_template": {
+                    "type": "string",
+                    "example": "jake"
+                },
+                "email_product_updates": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "email_usage_alerts": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "filename_pattern": {
+                    "type": "string",
+                    "example": "{name}_Resume_{target}"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "UTC"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-01-01T00:00:00Z"
+                }
+            }
+        },
+        "internal_adapters_primary_http.PreviewResumeHTMLRequest": {
+            "type": "object",
+            "properties": {
+                "bullets": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "density": {
+                    "type": "string",
+                    "example": "compact"
+                },
+                "font_size": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "section_order": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "summary",
+                        "experience",
+                        "skills",
+                        "education"
+                    ]
+                },
+                "summary": {
+                    "type": "string",
+                    "example": "Backend engineer with 6 years of Go."
+                }
+            }
+        },
+        "internal_adapters_primary_http.ProjectBulletResponse": {
             "type": "object",
             "properties": {
                 "content": {
                     "type": "string",
-                    "example": "Reduced API latency by 40%"
+                    "example": "Implemented AI-driven bullet selection algorithm"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "project_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ProjectResponse": {
+            "type": "object",
+            "properties": {
+                "bullets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.ProjectBulletResponse"
+                    }
                 },
                 "created_at": {
                     "type": "string",
                     "example": "2026-01-09T10:00:00Z"
                 },
+                "description": {
+                    "type": "string",
+                    "example": "AI-powered resume engineering tool"
+                },
                 "display_order": {
                     "type": "integer",
                     "example": 0
                 },
-                "experience_id": {
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-06-30"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chameleon Vitae"
+                },
+                "repository_url": {
+                    "type": "string",
+                    "example": "https://github.com/SeltikHD/chameleon-vitae"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2024-01-01"
+                },
+                "tech_stack": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Go",
+                        "Vue.js",
+                        "PostgreSQL"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://chameleon-vitae.dev"
+                }
+            }
+        },
+        "internal_adapters_primary_http.QuotaResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "tailor"
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "period": {
+                    "type": "string",
+                    "example": "day"
+                },
+                "remaining": {
+                    "type": "integer",
+                    "example": 17
+                },
+                "resets_at": {
+                    "type": "string",
+                    "example": "2026-01-10T00:00:00Z"
+                },
+                "used": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "internal_adapters_primary_http.RegenerateSectionRequest": {
+            "type": "object",
+            "properties": {
+                "instructions": {
+                    "type": "string",
+                    "example": "Mention the on-call rotation"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ResumeActivityResponse": {
+            "type": "object",
+            "properties": {
+                "ai_settings": {
+                    "description": "AISettings are the settings an ai_run was made with.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_adapters_primary_http.AIRunSettingsDTO"
+                        }
+                    ]
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "tailor"
+                },
+                "from_status": {
+                    "type": "string",
+                    "example": "reviewed"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Recruiter call scheduled for Monday"
+                },
+                "to_status": {
+                    "type": "string",
+                    "example": "submitted"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "note",
+                        "status_change",
+                        "pdf_generated",
+                        "ai_run"
+                    ],
+                    "example": "status_change"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ResumeAnalysisDTO": {
+            "type": "object",
+            "properties": {
+                "matched_keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "missing_keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "recommendations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "internal_adapters_primary_http.ResumeContentDTO": {
+            "type": "object",
+            "properties": {
+                "ai_settings": {
+                    "description": "AISettings are the settings the content was tailored with; POST\n/resumes/{id}/retailor reuses them.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_adapters_primary_http.AIRunSettingsDTO"
+                        }
+                    ]
+                },
+                "analysis": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ResumeAnalysisDTO"
+                },
+                "experiences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.TailoredExperienceDTO"
+                    }
+                },
+                "metrics_font_size": {
+                    "type": "integer",
+                    "example": 11
+                },
+                "persona": {
+                    "type": "string",
+                    "example": "technical_hiring_manager"
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "source_language": {
+                    "description": "SourceLanguage is the language of tailored_content when bullets were\ntranslated to the target language.",
+                    "type": "string",
+                    "example": "pt-br"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "summary_angle": {
+                    "description": "SummaryAngle is the angle of the summary variant picked as summary;\nthe variants are listed by GET /resumes/{id}/summaries.",
+                    "type": "string",
+                    "example": "technical"
+                },
+                "summary_metrics": {
+                    "description": "SummaryMetrics and the metrics of each bullet are returned with single\nresumes, estimated at metrics_font_size.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_adapters_primary_http.TextMetricsDTO"
+                        }
+                    ]
+                },
+                "themes": {
+                    "description": "Themes group the bullets by skill for the functional template.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.SkillThemeDTO"
+                    }
+                },
+                "tone": {
+                    "description": "Tone and Persona are the voice the content was tailored with;\nomitted for the defaults.",
+                    "type": "string",
+                    "example": "confident"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ResumeCritiqueResponse": {
+            "type": "object",
+            "properties": {
+                "content_hash": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "red_flags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resume_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "score": {
+                    "type": "integer",
+                    "example": 72
+                },
+                "strengths": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "suggested_edits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.SuggestedEditDTO"
+                    }
+                },
+                "summary": {
+                    "type": "string",
+                    "example": "Strong backend profile, but leadership is not evidenced."
+                },
+                "verdict": {
+                    "type": "string",
+                    "enum": [
+                        "interview",
+                        "maybe",
+                        "reject"
+                    ],
+                    "example": "interview"
+                },
+                "weaknesses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "internal_adapters_primary_http.ResumeDiffResponse": {
+            "type": "object",
+            "properties": {
+                "bullets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.BulletDiffResponse"
+                    }
+                },
+                "resume_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "summary": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ContentDiffResponse"
+                }
+            }
+        },
+        "internal_adapters_primary_http.ResumeResponse": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "archived_at": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                    "example": "2026-03-01T10:00:00Z"
                 },
-                "id": {
+                "company_name": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
-                },
-                "impact_score": {
-                    "type": "integer",
-                    "example": 75
+                    "example": "Awesome Corp"
                 },
-                "keywords": {
+                "contact_priority": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     },
                     "example": [
-                        "performance",
-                        "optimization"
+                        "email",
+                        "phone",
+                        "github"
                     ]
                 },
-                "metadata": {
-                    "type": "object",
-                    "additionalProperties": {}
-                },
-                "updated_at": {
+                "created_at": {
                     "type": "string",
                     "example": "2026-01-09T10:00:00Z"
-                }
-            }
-        },
-        "internal_adapters_primary_http.CreateBulletRequest": {
-            "type": "object",
-            "properties": {
-                "content": {
+                },
+                "detected_language": {
+                    "description": "DetectedLanguage is the language detected in the job description,\nsuch as \"pt\"; only returned on creation.",
                     "type": "string",
-                    "example": "Reduced API latency by 40%"
+                    "example": "en"
                 },
-                "display_order": {
-                    "type": "integer",
-                    "example": 0
+                "excluded_experience_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "keywords": {
+                "generated_content": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ResumeContentDTO"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "included_experience_ids": {
                     "type": "array",
                     "items": {
                         "type": "string"
-                    },
-                    "example": [
-                        "performance",
-                        "optimization"
-                    ]
-                }
-            }
-        },
-        "internal_adapters_primary_http.CreateEducationRequest": {
-            "type": "object",
-            "properties": {
-                "degree": {
+                    }
+                },
+                "job_description": {
+                    "type": "string"
+                },
+                "job_insights": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.JobInsightsDTO"
+                },
+                "job_title": {
                     "type": "string",
-                    "example": "Bachelor of Science"
+                    "example": "Senior Backend Engineer"
                 },
-                "display_order": {
-                    "type": "integer",
-                    "example": 0
+                "job_url": {
+                    "type": "string",
+                    "example": "https://linkedin.com/jobs/..."
                 },
-                "end_date": {
+                "last_accessed_at": {
                     "type": "string",
-                    "example": "2022-05-15"
+                    "example": "2026-03-01T10:00:00Z"
                 },
-                "field_of_study": {
+                "max_pages": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "pdf_url": {
                     "type": "string",
-                    "example": "Computer Science"
+                    "example": "https://storage.../resume.pdf"
                 },
-                "gpa": {
+                "pinned": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "qr_code": {
                     "type": "string",
-                    "example": "3.85/4.0"
+                    "example": "portfolio"
                 },
-                "honors": {
+                "score": {
+                    "type": "integer",
+                    "example": 85
+                },
+                "sections": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.SectionConfigDTO"
+                },
+                "selected_bullets": {
                     "type": "array",
                     "items": {
                         "type": "string"
-                    },
-                    "example": [
-                        "Magna Cum Laude",
-                        "Dean's List"
-                    ]
+                    }
                 },
-                "institution": {
+                "selection": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.SelectionExplanationDTO"
+                },
+                "status": {
                     "type": "string",
-                    "example": "Massachusetts Institute of Technology"
+                    "example": "draft"
                 },
-                "location": {
+                "tag_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "target_language": {
                     "type": "string",
-                    "example": "Cambridge, MA"
+                    "example": "en"
                 },
-                "start_date": {
+                "updated_at": {
                     "type": "string",
-                    "example": "2018-09-01"
+                    "example": "2026-01-09T10:00:00Z"
                 }
             }
         },
-        "internal_adapters_primary_http.CreateExperienceRequest": {
+        "internal_adapters_primary_http.ResumeSearchDTO": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string",
-                    "example": "Led backend development..."
-                },
-                "end_date": {
-                    "type": "string",
-                    "example": "2024-06-30"
-                },
-                "is_current": {
+                "archived": {
                     "type": "boolean",
                     "example": false
                 },
-                "location": {
+                "company": {
                     "type": "string",
-                    "example": "Remote"
+                    "example": "acme"
                 },
-                "metadata": {
-                    "type": "object",
-                    "additionalProperties": {}
+                "created_after": {
+                    "type": "string",
+                    "example": "2025-01-01"
                 },
-                "organization": {
+                "created_before": {
                     "type": "string",
-                    "example": "Tech Company Inc."
+                    "example": "2025-03-31"
                 },
-                "start_date": {
+                "currency": {
                     "type": "string",
-                    "example": "2022-01-15"
+                    "example": "USD"
                 },
-                "title": {
+                "include_archived": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "language": {
                     "type": "string",
-                    "example": "Senior Software Engineer"
+                    "example": "pt"
                 },
-                "type": {
+                "min_salary": {
+                    "type": "integer",
+                    "example": 120000
+                },
+                "remote": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "remote"
+                    ]
+                },
+                "score_min": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "sort": {
                     "type": "string",
-                    "example": "work"
+                    "enum": [
+                        "newest",
+                        "recent",
+                        "pinned_first"
+                    ],
+                    "example": "pinned_first"
                 },
-                "url": {
+                "status": {
                     "type": "string",
-                    "example": "https://techcompany.com"
+                    "example": "submitted"
+                },
+                "tag": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440000"
+                    ]
+                },
+                "visa_sponsorship": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "internal_adapters_primary_http.CreateProjectBulletRequest": {
+        "internal_adapters_primary_http.ResumeTagRequest": {
             "type": "object",
             "properties": {
-                "content": {
+                "name": {
                     "type": "string",
-                    "example": "Implemented new feature"
-                },
-                "display_order": {
-                    "type": "integer",
-                    "example": 0
+                    "example": "Dream companies"
                 }
             }
         },
-        "internal_adapters_primary_http.CreateProjectRequest": {
+        "internal_adapters_primary_http.ResumeTagResponse": {
             "type": "object",
             "properties": {
-                "bullets": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Implemented feature X",
-                        "Optimized performance by 50%"
-                    ]
-                },
-                "description": {
+                "created_at": {
                     "type": "string",
-                    "example": "AI-powered resume engineering tool"
-                },
-                "display_order": {
-                    "type": "integer",
-                    "example": 0
+                    "example": "2026-01-09T10:00:00Z"
                 },
-                "end_date": {
+                "id": {
                     "type": "string",
-                    "example": "2024-06-30"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
                 "name": {
                     "type": "string",
-                    "example": "Chameleon Vitae"
+                    "example": "Dream companies"
                 },
-                "repository_url": {
-                    "type": "string",
-                    "example": "https://github.com/SeltikHD/chameleon-vitae"
+                "resume_count": {
+                    "type": "integer",
+                    "example": 3
                 },
-                "start_date": {
+                "updated_at": {
                     "type": "string",
-                    "example": "2024-01-01"
-                },
-                "tech_stack": {
+                    "example": "2026-01-09T10:00:00Z"
+                }
+            }
+        },
+        "internal_adapters_primary_http.RetailorResumeResponse": {
+            "type": "object",
+            "properties": {
+                "differences": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     },
                     "example": [
-                        "Go",
-                        "Vue.js",
-                        "PostgreSQL"
+                        "tailor_bullet prompt: v1 -\u003e v2"
                     ]
                 },
-                "url": {
-                    "type": "string",
-                    "example": "https://chameleon-vitae.dev"
+                "previous_settings": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.AIRunSettingsDTO"
+                },
+                "resume": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
                 }
             }
         },
-        "internal_adapters_primary_http.CreateResumeRequest": {
+        "internal_adapters_primary_http.RevokeSessionsResponse": {
             "type": "object",
             "properties": {
-                "company_name": {
+                "revoked_at": {
                     "type": "string",
-                    "example": "Awesome Corp"
-                },
-                "job_description": {
+                    "example": "2026-03-01T11:30:00Z"
+                }
+            }
+        },
+        "internal_adapters_primary_http.SalaryRangeDTO": {
+            "type": "object",
+            "properties": {
+                "currency": {
                     "type": "string",
-                    "example": "## Senior Backend Engineer..."
+                    "example": "USD"
                 },
-                "job_title": {
-                    "type": "string",
-                    "example": "Senior Backend Engineer"
+                "max": {
+                    "type": "integer",
+                    "example": 120000
                 },
-                "job_url": {
-                    "type": "string",
-                    "example": "https://linkedin.com/jobs/12345"
+                "min": {
+                    "type": "integer",
+                    "example": 90000
                 },
-                "target_language": {
+                "period": {
                     "type": "string",
-                    "example": "en"
+                    "enum": [
+                        "year",
+                        "month",
+                        "hour"
+                    ],
+                    "example": "year"
                 }
             }
         },
-        "internal_adapters_primary_http.CreateSpokenLanguageRequest": {
+        "internal_adapters_primary_http.SavedResumeFilterRequest": {
             "type": "object",
             "properties": {
-                "display_order": {
-                    "type": "integer",
-                    "example": 0
-                },
-                "language": {
+                "name": {
                     "type": "string",
-                    "example": "Portuguese"
+                    "example": "Strong matches this quarter"
                 },
-                "proficiency": {
-                    "type": "string",
-                    "example": "native"
+                "search": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ResumeSearchDTO"
                 }
             }
         },
-        "internal_adapters_primary_http.EducationResponse": {
+        "internal_adapters_primary_http.SavedResumeFilterResponse": {
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "string",
                     "example": "2026-01-09T10:00:00Z"
                 },
-                "degree": {
-                    "type": "string",
-                    "example": "Bachelor of Science"
-                },
-                "display_order": {
-                    "type": "integer",
-                    "example": 0
-                },
-                "end_date": {
-                    "type": "string",
-                    "example": "2022-05-15"
-                },
-                "field_of_study": {
-                    "type": "string",
-                    "example": "Computer Science"
-                },
-                "gpa": {
-                    "type": "string",
-                    "example": "3.85/4.0"
-                },
-                "honors": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Magna Cum Laude",
-                        "Dean's List"
-                    ]
-                },
                 "id": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "institution": {
-                    "type": "string",
-                    "example": "Massachusetts Institute of Technology"
-                },
-                "location": {
+                "name": {
                     "type": "string",
-                    "example": "Cambridge, MA"
+                    "example": "Strong matches this quarter"
                 },
-                "start_date": {
-                    "type": "string",
-                    "example": "2018-09-01"
+                "search": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.ResumeSearchDTO"
                 },
                 "updated_at": {
                     "type": "string",
@@ -2600,665 +10461,774 @@ const docTemplate = `{
                 }
             }
         },
-        "internal_adapters_primary_http.ErrorBody": {
+        "internal_adapters_primary_http.ScoreBulletResponse": {
             "type": "object",
             "properties": {
-                "code": {
+                "content": {
                     "type": "string",
-                    "example": "VALIDATION_ERROR"
+                    "example": "Reduced API latency by 40%"
                 },
-                "details": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.ErrorDetail"
-                    }
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "message": {
+                "impact_score": {
+                    "type": "integer",
+                    "example": 85
+                },
+                "score_reasoning": {
                     "type": "string",
-                    "example": "Validation failed"
+                    "example": "Contains quantifiable metrics..."
                 }
             }
         },
-        "internal_adapters_primary_http.ErrorDetail": {
+        "internal_adapters_primary_http.SectionConfigDTO": {
             "type": "object",
             "properties": {
-                "field": {
+                "skills_display": {
                     "type": "string",
-                    "example": "email"
+                    "example": "bars"
+                }
+            }
+        },
+        "internal_adapters_primary_http.SectionFitResult": {
+            "type": "object",
+            "properties": {
+                "lines": {
+                    "type": "integer",
+                    "example": 28
                 },
-                "message": {
+                "name": {
                     "type": "string",
-                    "example": "Invalid email format"
+                    "example": "experience"
                 }
             }
         },
-        "internal_adapters_primary_http.ErrorResponse": {
+        "internal_adapters_primary_http.SelectSummaryRequest": {
             "type": "object",
             "properties": {
-                "error": {
-                    "$ref": "#/definitions/internal_adapters_primary_http.ErrorBody"
+                "angle": {
+                    "type": "string",
+                    "enum": [
+                        "technical",
+                        "leadership",
+                        "impact"
+                    ],
+                    "example": "leadership"
                 }
             }
         },
-        "internal_adapters_primary_http.ExperienceResponse": {
+        "internal_adapters_primary_http.SelectionExplanationDTO": {
             "type": "object",
             "properties": {
                 "bullets": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.BulletResponse"
+                        "$ref": "#/definitions/internal_adapters_primary_http.BulletDecisionDTO"
                     }
                 },
-                "created_at": {
-                    "type": "string",
-                    "example": "2026-01-09T10:00:00Z"
-                },
-                "description": {
+                "reasoning": {
                     "type": "string",
-                    "example": "Led backend development..."
-                },
-                "display_order": {
-                    "type": "integer",
-                    "example": 0
+                    "example": "Prioritized distributed systems work matching the required skills"
                 },
-                "end_date": {
-                    "type": "string",
-                    "example": "2024-06-30"
+                "validation": {
+                    "description": "Validation summarizes how the AI rewrites of the selected bullets fared\nin validation; absent for resumes tailored before bullets were\nvalidated.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/internal_adapters_primary_http.TailorValidationDTO"
+                        }
+                    ]
+                }
+            }
+        },
+        "internal_adapters_primary_http.SessionsResponse": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.CurrentSessionResponse"
                 },
-                "id": {
+                "last_refresh_at": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
-                },
-                "is_current": {
-                    "type": "boolean",
-                    "example": false
+                    "example": "2026-03-01T11:00:00Z"
                 },
-                "location": {
+                "last_sign_in_at": {
                     "type": "string",
-                    "example": "Remote"
+                    "example": "2026-03-01T10:00:00Z"
                 },
-                "metadata": {
-                    "type": "object",
-                    "additionalProperties": {}
+                "providers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "google.com",
+                        "github.com"
+                    ]
                 },
-                "organization": {
+                "revoked_at": {
                     "type": "string",
-                    "example": "Tech Company Inc."
-                },
-                "start_date": {
+                    "example": "2026-02-01T10:00:00Z"
+                }
+            }
+        },
+        "internal_adapters_primary_http.SkillInput": {
+            "type": "object",
+            "properties": {
+                "category": {
                     "type": "string",
-                    "example": "2022-01-15"
+                    "example": "Programming Languages"
                 },
-                "title": {
-                    "type": "string",
-                    "example": "Senior Software Engineer"
+                "is_highlighted": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "type": {
+                "name": {
                     "type": "string",
-                    "example": "work"
+                    "example": "Go"
                 },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2026-01-09T10:00:00Z"
+                "proficiency_level": {
+                    "type": "integer",
+                    "example": 85
                 },
-                "url": {
-                    "type": "string",
-                    "example": "https://techcompany.com"
+                "years_of_experience": {
+                    "type": "number",
+                    "example": 3.5
                 }
             }
         },
-        "internal_adapters_primary_http.HealthResponse": {
+        "internal_adapters_primary_http.SkillResponse": {
             "type": "object",
             "properties": {
-                "service": {
+                "category": {
                     "type": "string",
-                    "example": "chameleon-vitae"
+                    "example": "Programming Languages"
                 },
-                "status": {
+                "created_at": {
                     "type": "string",
-                    "example": "healthy"
+                    "example": "2026-01-09T10:00:00Z"
+                },
+                "display_order": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "is_highlighted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Go"
+                },
+                "proficiency_level": {
+                    "type": "integer",
+                    "example": 85
+                },
+                "years_of_experience": {
+                    "type": "number",
+                    "example": 3.5
                 }
             }
         },
-        "internal_adapters_primary_http.ListEducationResponse": {
+        "internal_adapters_primary_http.SkillThemeDTO": {
             "type": "object",
             "properties": {
-                "data": {
+                "bullet_ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.EducationResponse"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440000"
+                    ]
                 },
-                "total": {
-                    "type": "integer",
-                    "example": 2
+                "name": {
+                    "type": "string",
+                    "example": "Data Analysis"
                 }
             }
         },
-        "internal_adapters_primary_http.ListExperiencesResponse": {
+        "internal_adapters_primary_http.SpokenLanguageResponse": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.ExperienceResponse"
-                    }
-                },
-                "limit": {
-                    "type": "integer",
-                    "example": 50
+                "created_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
                 },
-                "offset": {
+                "display_order": {
                     "type": "integer",
                     "example": 0
                 },
-                "total": {
-                    "type": "integer",
-                    "example": 15
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "language": {
+                    "type": "string",
+                    "example": "English"
+                },
+                "proficiency": {
+                    "type": "string",
+                    "example": "native"
                 }
             }
         },
-        "internal_adapters_primary_http.ListProjectsResponse": {
+        "internal_adapters_primary_http.StorageOrphansResponse": {
             "type": "object",
             "properties": {
-                "data": {
+                "count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "files": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.ProjectResponse"
+                        "$ref": "#/definitions/internal_adapters_primary_http.StoredFileResponse"
                     }
                 },
-                "total": {
+                "reclaimable_bytes": {
                     "type": "integer",
-                    "example": 5
+                    "example": 144639
                 }
             }
         },
-        "internal_adapters_primary_http.ListResumesResponse": {
+        "internal_adapters_primary_http.StoredFileResponse": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.ResumeResponse"
-                    }
-                },
-                "limit": {
-                    "type": "integer",
-                    "example": 20
-                },
-                "offset": {
-                    "type": "integer",
-                    "example": 0
+                "key": {
+                    "type": "string",
+                    "example": "resumes/550e8400-e29b-41d4-a716-446655440000/550e8400-e29b-41d4-a716-446655440001.pdf"
                 },
-                "total": {
+                "size": {
                     "type": "integer",
-                    "example": 10
+                    "example": 48213
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-01-09T10:00:00Z"
                 }
             }
         },
-        "internal_adapters_primary_http.ListSkillsResponse": {
+        "internal_adapters_primary_http.SubmitBulletFeedbackRequest": {
             "type": "object",
             "properties": {
-                "data": {
+                "bullets": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.SkillResponse"
+                        "$ref": "#/definitions/internal_adapters_primary_http.BulletFeedbackDTO"
                     }
-                },
-                "total": {
-                    "type": "integer",
-                    "example": 25
                 }
             }
         },
-        "internal_adapters_primary_http.ListSpokenLanguagesResponse": {
+        "internal_adapters_primary_http.SuggestResponse": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.SpokenLanguageResponse"
+                        "$ref": "#/definitions/internal_adapters_primary_http.SuggestionResponse"
                     }
                 }
             }
         },
-        "internal_adapters_primary_http.ParseJobMetadata": {
+        "internal_adapters_primary_http.SuggestedEditDTO": {
             "type": "object",
             "properties": {
-                "fetched_at": {
+                "original": {
                     "type": "string",
-                    "example": "2026-01-09T10:00:00Z"
+                    "example": "Backend engineer with Go experience."
                 },
-                "source": {
+                "reason": {
                     "type": "string",
-                    "example": "linkedin.com"
+                    "example": "The role asks for leadership experience."
+                },
+                "section": {
+                    "type": "string",
+                    "example": "summary"
+                },
+                "suggested": {
+                    "type": "string",
+                    "example": "Backend engineer with **6 years** of Go, leading a team of 4."
                 }
             }
         },
-        "internal_adapters_primary_http.ParseJobURLRequest": {
+        "internal_adapters_primary_http.SuggestionResponse": {
             "type": "object",
             "properties": {
-                "url": {
+                "id": {
                     "type": "string",
-                    "example": "https://linkedin.com/jobs/view/12345"
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "company"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "Acme Corp"
                 }
             }
         },
-        "internal_adapters_primary_http.ParseJobURLResponse": {
+        "internal_adapters_primary_http.SummaryVariantDTO": {
             "type": "object",
             "properties": {
-                "markdown": {
-                    "type": "string",
-                    "example": "## Senior Backend Engineer..."
-                },
-                "metadata": {
-                    "$ref": "#/definitions/internal_adapters_primary_http.ParseJobMetadata"
+                "active": {
+                    "description": "Active reports whether the variant is the resume's summary.",
+                    "type": "boolean",
+                    "example": false
                 },
-                "title": {
+                "angle": {
                     "type": "string",
-                    "example": "Senior Backend Engineer at Awesome Corp"
+                    "enum": [
+                        "technical",
+                        "leadership",
+                        "impact"
+                    ],
+                    "example": "leadership"
                 },
-                "url": {
+                "summary": {
                     "type": "string",
-                    "example": "https://linkedin.com/jobs/view/12345"
+                    "example": "Backend engineer leading **payments** teams for **7+ years**."
                 }
             }
         },
-        "internal_adapters_primary_http.ProjectBulletResponse": {
+        "internal_adapters_primary_http.SummaryVariantsResponse": {
             "type": "object",
             "properties": {
-                "content": {
+                "summary": {
+                    "description": "Summary is the resume's summary, which differs from the active\nvariant's after edits.",
                     "type": "string",
-                    "example": "Implemented AI-driven bullet selection algorithm"
+                    "example": "Backend engineer with **7+ years** building payment systems."
                 },
-                "created_at": {
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.SummaryVariantDTO"
+                    }
+                }
+            }
+        },
+        "internal_adapters_primary_http.SyncUserRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
                     "type": "string",
-                    "example": "2026-01-09T10:00:00Z"
+                    "example": "user@example.com"
                 },
-                "display_order": {
-                    "type": "integer",
-                    "example": 0
+                "firebase_uid": {
+                    "type": "string",
+                    "example": "abc123xyz"
                 },
-                "id": {
+                "name": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "John Doe"
                 },
-                "project_id": {
+                "picture": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                    "example": "https://example.com/photo.jpg"
                 }
             }
         },
-        "internal_adapters_primary_http.ProjectResponse": {
+        "internal_adapters_primary_http.SyncUserResponse": {
             "type": "object",
             "properties": {
-                "bullets": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.ProjectBulletResponse"
-                    }
-                },
                 "created_at": {
                     "type": "string",
                     "example": "2026-01-09T10:00:00Z"
                 },
-                "description": {
+                "email": {
                     "type": "string",
-                    "example": "AI-powered resume engineering tool"
-                },
-                "display_order": {
-                    "type": "integer",
-                    "example": 0
+                    "example": "user@example.com"
                 },
-                "end_date": {
+                "firebase_uid": {
                     "type": "string",
-                    "example": "2024-06-30"
+                    "example": "abc123xyz"
                 },
                 "id": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Chameleon Vitae"
-                },
-                "repository_url": {
-                    "type": "string",
-                    "example": "https://github.com/SeltikHD/chameleon-vitae"
-                },
-                "start_date": {
-                    "type": "string",
-                    "example": "2024-01-01"
-                },
-                "tech_stack": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Go",
-                        "Vue.js",
-                        "PostgreSQL"
-                    ]
-                },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2026-01-09T10:00:00Z"
+                "linked": {
+                    "description": "Linked is true when the sign-in method was linked to an existing\naccount with the same verified email.",
+                    "type": "boolean",
+                    "example": false
                 },
-                "url": {
+                "name": {
                     "type": "string",
-                    "example": "https://chameleon-vitae.dev"
+                    "example": "John Doe"
                 }
             }
         },
-        "internal_adapters_primary_http.ResumeAnalysisDTO": {
+        "internal_adapters_primary_http.TailorEstimateResponse": {
             "type": "object",
             "properties": {
-                "matched_keywords": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "bullets": {
+                    "type": "integer",
+                    "example": 42
                 },
-                "missing_keywords": {
+                "calls": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/internal_adapters_primary_http.AICallEstimateResponse"
                     }
                 },
-                "recommendations": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "cost_usd": {
+                    "description": "CostUSD is omitted when the price of a model is not configured.",
+                    "type": "number",
+                    "example": 0.0061
+                },
+                "input_tokens": {
+                    "type": "integer",
+                    "example": 11200
+                },
+                "max_bullets": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "output_tokens": {
+                    "type": "integer",
+                    "example": 1900
+                },
+                "quota": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.QuotaResponse"
+                },
+                "within_quota": {
+                    "description": "WithinQuota is false when tailoring would exceed the plan's limit;\nQuota is omitted when plan limits are off.",
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "internal_adapters_primary_http.ResumeContentDTO": {
+        "internal_adapters_primary_http.TailorResumeRequest": {
             "type": "object",
             "properties": {
-                "analysis": {
-                    "$ref": "#/definitions/internal_adapters_primary_http.ResumeAnalysisDTO"
+                "include_highlighted_skills": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "experiences": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.TailoredExperienceDTO"
-                    }
+                "max_bullets_per_job": {
+                    "type": "integer",
+                    "example": 15
                 },
-                "skills": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "persona": {
+                    "type": "string",
+                    "enum": [
+                        "technical_hiring_manager",
+                        "hr_screener"
+                    ],
+                    "example": "technical_hiring_manager"
                 },
-                "summary": {
-                    "type": "string"
+                "seed": {
+                    "description": "Seed is given to the model with every AI call, for a run that can be\nreproduced; omit it to leave the calls unseeded.",
+                    "type": "integer",
+                    "example": 20260115
+                },
+                "tone": {
+                    "description": "Tone and Persona set the voice of the summary and bullets; empty\nkeeps the defaults.",
+                    "type": "string",
+                    "enum": [
+                        "confident",
+                        "humble",
+                        "energetic"
+                    ],
+                    "example": "confident"
                 }
             }
         },
-        "internal_adapters_primary_http.ResumeResponse": {
+        "internal_adapters_primary_http.TailorValidationDTO": {
             "type": "object",
             "properties": {
-                "company_name": {
-                    "type": "string",
-                    "example": "Awesome Corp"
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "2026-01-09T10:00:00Z"
+                "checked": {
+                    "type": "integer",
+                    "example": 5
                 },
-                "generated_content": {
-                    "$ref": "#/definitions/internal_adapters_primary_http.ResumeContentDTO"
+                "rejected": {
+                    "type": "integer",
+                    "example": 0
                 },
-                "id": {
-                    "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                "retried": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "job_description": {
+                "tense_fixed": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "internal_adapters_primary_http.TailoredBulletDTO": {
+            "type": "object",
+            "properties": {
+                "bullet_id": {
                     "type": "string"
                 },
-                "job_title": {
-                    "type": "string",
-                    "example": "Senior Backend Engineer"
+                "metrics": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.TextMetricsDTO"
                 },
-                "job_url": {
-                    "type": "string",
-                    "example": "https://linkedin.com/jobs/..."
+                "needs_review": {
+                    "description": "NeedsReview is set when the rewrite has terms not found in the\nprofile, listed in unverified_terms; the user must confirm the bullet\nbefore the resume can be marked reviewed.",
+                    "type": "boolean",
+                    "example": true
                 },
-                "notes": {
+                "original_content": {
                     "type": "string"
                 },
-                "pdf_url": {
-                    "type": "string",
-                    "example": "https://storage.../resume.pdf"
+                "tailored_content": {
+                    "type": "string"
                 },
-                "score": {
-                    "type": "integer",
-                    "example": 85
+                "translated_content": {
+                    "description": "TranslatedContent is the tailored content in the target language, as\nrendered on the resume; omitted when no translation was needed.",
+                    "type": "string"
                 },
-                "selected_bullets": {
+                "unverified_terms": {
                     "type": "array",
                     "items": {
                         "type": "string"
-                    }
-                },
-                "status": {
-                    "type": "string",
-                    "example": "draft"
-                },
-                "target_language": {
-                    "type": "string",
-                    "example": "en"
-                },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2026-01-09T10:00:00Z"
+                    },
+                    "example": [
+                        "Kubernetes",
+                        "45%"
+                    ]
                 }
             }
         },
-        "internal_adapters_primary_http.ScoreBulletResponse": {
+        "internal_adapters_primary_http.TailoredExperienceDTO": {
             "type": "object",
             "properties": {
-                "content": {
-                    "type": "string",
-                    "example": "Reduced API latency by 40%"
+                "bullets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.TailoredBulletDTO"
+                    }
                 },
-                "id": {
-                    "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                "end_date": {
+                    "type": "string"
                 },
-                "impact_score": {
-                    "type": "integer",
-                    "example": 85
+                "experience_id": {
+                    "type": "string"
                 },
-                "score_reasoning": {
-                    "type": "string",
-                    "example": "Contains quantifiable metrics..."
+                "is_current": {
+                    "type": "boolean"
+                },
+                "organization": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "internal_adapters_primary_http.SkillInput": {
+        "internal_adapters_primary_http.TextMetricsDTO": {
             "type": "object",
             "properties": {
-                "category": {
+                "action_verb": {
+                    "description": "ActionVerb is the action verb the text opens with; omitted when it\ndoes not open with one.",
                     "type": "string",
-                    "example": "Programming Languages"
-                },
-                "is_highlighted": {
-                    "type": "boolean",
-                    "example": true
+                    "example": "Led"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Go"
+                "characters": {
+                    "type": "integer",
+                    "example": 142
                 },
-                "proficiency_level": {
+                "lines": {
+                    "description": "Lines estimates the lines the text takes on the rendered page.",
                     "type": "integer",
-                    "example": 85
+                    "example": 2
                 },
-                "years_of_experience": {
+                "reading_ease": {
+                    "description": "ReadingEase is the Flesch reading ease, about 0 (very hard) to 100\n(very easy).",
                     "type": "number",
-                    "example": 3.5
+                    "example": 38.5
                 }
             }
         },
-        "internal_adapters_primary_http.SkillResponse": {
+        "internal_adapters_primary_http.TimelineEntryResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "type": "string",
-                    "example": "Programming Languages"
-                },
-                "created_at": {
+                "end": {
                     "type": "string",
-                    "example": "2026-01-09T10:00:00Z"
-                },
-                "display_order": {
-                    "type": "integer",
-                    "example": 0
+                    "example": "2024-06"
                 },
-                "id": {
+                "experience_id": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "is_highlighted": {
+                "full_time": {
                     "type": "boolean",
                     "example": true
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Go"
+                "is_current": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "proficiency_level": {
+                "months": {
                     "type": "integer",
-                    "example": 85
+                    "example": 30
                 },
-                "years_of_experience": {
-                    "type": "number",
-                    "example": 3.5
-                }
-            }
-        },
-        "internal_adapters_primary_http.SpokenLanguageResponse": {
-            "type": "object",
-            "properties": {
-                "created_at": {
+                "organization": {
                     "type": "string",
-                    "example": "2026-01-09T10:00:00Z"
-                },
-                "display_order": {
-                    "type": "integer",
-                    "example": 0
+                    "example": "Tech Company Inc."
                 },
-                "id": {
+                "start": {
                     "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                    "example": "2022-01"
                 },
-                "language": {
+                "title": {
                     "type": "string",
-                    "example": "English"
+                    "example": "Senior Software Engineer"
                 },
-                "proficiency": {
+                "type": {
                     "type": "string",
-                    "example": "native"
+                    "example": "work"
                 }
             }
         },
-        "internal_adapters_primary_http.SyncUserRequest": {
+        "internal_adapters_primary_http.TimelineGapResponse": {
             "type": "object",
             "properties": {
-                "email": {
+                "end": {
                     "type": "string",
-                    "example": "user@example.com"
+                    "example": "2024-12"
                 },
-                "firebase_uid": {
-                    "type": "string",
-                    "example": "abc123xyz"
+                "months": {
+                    "type": "integer",
+                    "example": 6
                 },
-                "name": {
-                    "type": "string",
-                    "example": "John Doe"
+                "ongoing": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "picture": {
+                "start": {
                     "type": "string",
-                    "example": "https://example.com/photo.jpg"
+                    "example": "2024-07"
                 }
             }
         },
-        "internal_adapters_primary_http.SyncUserResponse": {
+        "internal_adapters_primary_http.TimelineOverlapResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string",
-                    "example": "2026-01-09T10:00:00Z"
-                },
-                "email": {
+                "end": {
                     "type": "string",
-                    "example": "user@example.com"
+                    "example": "2023-06"
                 },
-                "firebase_uid": {
-                    "type": "string",
-                    "example": "abc123xyz"
+                "experience_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "id": {
-                    "type": "string",
-                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                "months": {
+                    "type": "integer",
+                    "example": 6
                 },
-                "name": {
+                "start": {
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "2023-01"
                 }
             }
         },
-        "internal_adapters_primary_http.TailorResumeRequest": {
+        "internal_adapters_primary_http.TimelineResponse": {
             "type": "object",
             "properties": {
-                "max_bullets_per_job": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.TimelineEntryResponse"
+                    }
+                },
+                "gap_threshold_months": {
                     "type": "integer",
-                    "example": 15
+                    "example": 3
+                },
+                "gaps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.TimelineGapResponse"
+                    }
+                },
+                "overlaps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.TimelineOverlapResponse"
+                    }
+                },
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.TimelineSuggestionResponse"
+                    }
                 }
             }
         },
-        "internal_adapters_primary_http.TailoredBulletDTO": {
+        "internal_adapters_primary_http.TimelineSuggestionResponse": {
             "type": "object",
             "properties": {
-                "bullet_id": {
-                    "type": "string"
+                "experience_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "original_content": {
-                    "type": "string"
+                "kind": {
+                    "type": "string",
+                    "example": "explain_gap"
                 },
-                "tailored_content": {
-                    "type": "string"
+                "message": {
+                    "type": "string",
+                    "example": "Add an entry for the 6-month gap between Jul 2024 and Dec 2024, such as a sabbatical, studies or freelance work."
                 }
             }
         },
-        "internal_adapters_primary_http.TailoredExperienceDTO": {
+        "internal_adapters_primary_http.UpdateAcademicEntryRequest": {
             "type": "object",
             "properties": {
-                "bullets": {
+                "amount": {
+                    "type": "string",
+                    "example": "USD 500,000"
+                },
+                "authors": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/internal_adapters_primary_http.TailoredBulletDTO"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "J. Doe"
+                    ]
                 },
-                "end_date": {
+                "description": {
                     "type": "string"
                 },
-                "experience_id": {
-                    "type": "string"
+                "display_order": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "is_current": {
-                    "type": "boolean"
+                "end_date": {
+                    "type": "string",
+                    "example": "2029-01"
                 },
                 "organization": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "National Science Foundation"
+                },
+                "section": {
+                    "type": "string",
+                    "example": "grants"
                 },
                 "start_date": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "2024-01"
                 },
                 "title": {
+                    "type": "string",
+                    "example": "NSF CAREER Award"
+                },
+                "url": {
                     "type": "string"
                 }
             }
@@ -3322,6 +11292,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "MIT"
                 },
+                "is_expected": {
+                    "type": "boolean",
+                    "example": true
+                },
                 "location": {
                     "type": "string",
                     "example": "Cambridge, MA"
@@ -3347,6 +11321,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "2024-06-30"
                 },
+                "exclude_from_tailoring": {
+                    "type": "boolean",
+                    "example": true
+                },
                 "is_current": {
                     "type": "boolean",
                     "example": false
@@ -3381,6 +11359,47 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_adapters_primary_http.UpdatePreferencesRequest": {
+            "type": "object",
+            "properties": {
+                "date_format": {
+                    "type": "string",
+                    "example": "mm/yyyy"
+                },
+                "default_font_size": {
+                    "type": "integer",
+                    "example": 11
+                },
+                "default_max_bullets": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "default_target_language": {
+                    "type": "string",
+                    "example": "pt-br"
+                },
+                "default_template": {
+                    "type": "string",
+                    "example": "europass"
+                },
+                "email_product_updates": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "email_usage_alerts": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "filename_pattern": {
+                    "type": "string",
+                    "example": "{name}_{company}_{date}"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "America/Sao_Paulo"
+                }
+            }
+        },
         "internal_adapters_primary_http.UpdateProjectRequest": {
             "type": "object",
             "properties": {
@@ -3429,10 +11448,50 @@ const docTemplate = `{
         "internal_adapters_primary_http.UpdateResumeContentRequest": {
             "type": "object",
             "properties": {
+                "contact_priority": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "email",
+                        "phone",
+                        "github"
+                    ]
+                },
+                "excluded_experience_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440001"
+                    ]
+                },
+                "included_experience_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440000"
+                    ]
+                },
+                "max_pages": {
+                    "type": "integer",
+                    "example": 2
+                },
                 "notes": {
                     "type": "string",
                     "example": "Made adjustments to summary"
                 },
+                "qr_code": {
+                    "type": "string",
+                    "example": "portfolio"
+                },
+                "sections": {
+                    "$ref": "#/definitions/internal_adapters_primary_http.SectionConfigDTO"
+                },
                 "status": {
                     "type": "string",
                     "example": "reviewed"
@@ -3484,6 +11543,21 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_adapters_primary_http.UserLimitsResponse": {
+            "type": "object",
+            "properties": {
+                "plan": {
+                    "type": "string",
+                    "example": "free"
+                },
+                "quotas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/internal_adapters_primary_http.QuotaResponse"
+                    }
+                }
+            }
+        },
         "internal_adapters_primary_http.UserResponse": {
             "type": "object",
             "properties": {
@@ -3525,7 +11599,7 @@ const docTemplate = `{
                 },
                 "phone": {
                     "type": "string",
-                    "example": "+1-555-123-4567"
+                    "example": "+15551234567"
                 },
                 "picture_url": {
                     "type": "string",
@@ -3556,7 +11630,7 @@ const docTemplate = `{
     },
     "securityDefinitions": {
         "BearerAuth": {
-            "description": "Firebase JWT token. Format: \"Bearer {token}\"",
+            "description": "ID token of the configured Firebase or OIDC provider, or an impersonation token issued to an admin. Format: \"Bearer {token}\"",
             "type": "apiKey",
             "name": "Authorization",
             "in": "header"
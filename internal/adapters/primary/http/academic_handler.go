@@ -0,0 +1,327 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// AcademicHandler handles HTTP requests for academic CV entries.
+type AcademicHandler struct {
+	academicService *services.AcademicService
+}
+
+// NewAcademicHandler creates a new AcademicHandler.
+func NewAcademicHandler(academicService *services.AcademicService) *AcademicHandler {
+	return &AcademicHandler{
+		academicService: academicService,
+	}
+}
+
+// List returns the academic entries of the authenticated user.
+//
+//	@Summary		List academic entries
+//	@Description	Returns the publications, grants, teaching and service entries of the authenticated user, ordered by display_order and then most recent first
+//	@Tags			academic
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			section	query		string	false	"Only entries of this section"	Enums(publications, grants, teaching, service)
+//	@Success		200		{object}	ListAcademicEntriesResponse
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		422		{object}	ErrorResponse	"Unknown section"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/academic [get]
+func (h *AcademicHandler) List(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	entries, err := h.academicService.ListAcademicEntries(r.Context(), authUser.ID, r.URL.Query().Get("section"))
+	if err != nil {
+		if handleValidationError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to list academic entries")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve academic entries")
+		return
+	}
+
+	data := make([]AcademicEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		data = append(data, mapAcademicEntryToResponse(&entry))
+	}
+
+	respondJSON(w, http.StatusOK, ListAcademicEntriesResponse{
+		Data:  data,
+		Total: len(data),
+	})
+}
+
+// Create creates a new academic entry.
+//
+//	@Summary		Create academic entry
+//	@Description	Creates a publication, grant, teaching or service entry for the academic CV template. Dates are YYYY-MM-DD or YYYY-MM; publications use end_date as the publication date.
+//	@Tags			academic
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		CreateAcademicEntryRequest	true	"Academic entry data"
+//	@Success		201		{object}	AcademicEntryResponse
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		422		{object}	ErrorResponse	"Validation failed"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/academic [post]
+func (h *AcademicHandler) Create(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req CreateAcademicEntryRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	startDate, endDate, ok := parseAcademicDates(w, req.StartDate, req.EndDate)
+	if !ok {
+		return
+	}
+
+	entry, err := h.academicService.CreateAcademicEntry(r.Context(), services.CreateAcademicEntryRequest{
+		UserID:       authUser.ID,
+		Section:      req.Section,
+		Title:        req.Title,
+		Organization: req.Organization,
+		Authors:      req.Authors,
+		Amount:       req.Amount,
+		URL:          req.URL,
+		Description:  req.Description,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		DisplayOrder: req.DisplayOrder,
+	})
+	if err != nil {
+		if handleValidationError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to create academic entry")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to create academic entry")
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, mapAcademicEntryToResponse(entry))
+}
+
+// Get retrieves a single academic entry by ID.
+//
+//	@Summary		Get academic entry
+//	@Description	Retrieves a specific academic entry by ID
+//	@Tags			academic
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			entryID	path		string	true	"Academic entry ID"
+//	@Success		200		{object}	AcademicEntryResponse
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404		{object}	ErrorResponse	"Academic entry not found"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/academic/{entryID} [get]
+func (h *AcademicHandler) Get(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	entryID := chi.URLParam(r, "entryID")
+	if entryID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Academic entry ID is required")
+		return
+	}
+
+	entry, err := h.academicService.GetAcademicEntry(r.Context(), entryID, authUser.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAcademicEntryNotFound) {
+			respondError(w, http.StatusNotFound, "ACADEMIC_ENTRY_NOT_FOUND", "Academic entry not found")
+			return
+		}
+		log.Error().Err(err).Str("entry_id", entryID).Msg("Failed to get academic entry")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve academic entry")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapAcademicEntryToResponse(entry))
+}
+
+// Update updates an existing academic entry.
+//
+//	@Summary		Update academic entry
+//	@Description	Updates an existing academic entry; omitted fields are left unchanged and empty strings clear optional ones
+//	@Tags			academic
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			entryID	path		string						true	"Academic entry ID"
+//	@Param			request	body		UpdateAcademicEntryRequest	true	"Academic entry data"
+//	@Success		200		{object}	AcademicEntryResponse
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404		{object}	ErrorResponse	"Academic entry not found"
+//	@Failure		422		{object}	ErrorResponse	"Validation failed"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/academic/{entryID} [put]
+func (h *AcademicHandler) Update(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	entryID := chi.URLParam(r, "entryID")
+	if entryID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Academic entry ID is required")
+		return
+	}
+
+	var req UpdateAcademicEntryRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	startDate, endDate, ok := parseAcademicDates(w, req.StartDate, req.EndDate)
+	if !ok {
+		return
+	}
+
+	entry, err := h.academicService.UpdateAcademicEntry(r.Context(), services.UpdateAcademicEntryRequest{
+		EntryID:      entryID,
+		UserID:       authUser.ID,
+		Section:      req.Section,
+		Title:        req.Title,
+		Organization: req.Organization,
+		Authors:      req.Authors,
+		Amount:       req.Amount,
+		URL:          req.URL,
+		Description:  req.Description,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		DisplayOrder: req.DisplayOrder,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrAcademicEntryNotFound) {
+			respondError(w, http.StatusNotFound, "ACADEMIC_ENTRY_NOT_FOUND", "Academic entry not found")
+			return
+		}
+		if handleValidationError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("entry_id", entryID).Msg("Failed to update academic entry")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to update academic entry")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapAcademicEntryToResponse(entry))
+}
+
+// Delete removes an academic entry.
+//
+//	@Summary		Delete academic entry
+//	@Description	Deletes an academic entry
+//	@Tags			academic
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			entryID	path	string	true	"Academic entry ID"
+//	@Success		204		"No Content"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404		{object}	ErrorResponse	"Academic entry not found"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/academic/{entryID} [delete]
+func (h *AcademicHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	entryID := chi.URLParam(r, "entryID")
+	if entryID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Academic entry ID is required")
+		return
+	}
+
+	if err := h.academicService.DeleteAcademicEntry(r.Context(), entryID, authUser.ID); err != nil {
+		if errors.Is(err, domain.ErrAcademicEntryNotFound) {
+			respondError(w, http.StatusNotFound, "ACADEMIC_ENTRY_NOT_FOUND", "Academic entry not found")
+			return
+		}
+		log.Error().Err(err).Str("entry_id", entryID).Msg("Failed to delete academic entry")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete academic entry")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseAcademicDates parses the optional start and end dates of an academic
+// entry request, responding with a validation error when one is malformed.
+func parseAcademicDates(w http.ResponseWriter, start, end *string) (startDate, endDate *domain.Date, ok bool) {
+	if start != nil {
+		d, err := domain.ParseDate(*start)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid start_date format, expected YYYY-MM-DD or YYYY-MM")
+			return nil, nil, false
+		}
+		startDate = &d
+	}
+	if end != nil {
+		d, err := domain.ParseDate(*end)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid end_date format, expected YYYY-MM-DD or YYYY-MM")
+			return nil, nil, false
+		}
+		endDate = &d
+	}
+	return startDate, endDate, true
+}
+
+// mapAcademicEntryToResponse maps a domain academic entry to a response DTO.
+func mapAcademicEntryToResponse(entry *domain.AcademicEntry) AcademicEntryResponse {
+	resp := AcademicEntryResponse{
+		ID:           entry.ID,
+		Section:      string(entry.Section),
+		Title:        entry.Title,
+		Organization: entry.Organization,
+		Authors:      entry.Authors,
+		Amount:       entry.Amount,
+		URL:          entry.URL,
+		Description:  entry.Description,
+		DisplayOrder: entry.DisplayOrder,
+		CreatedAt:    entry.CreatedAt,
+		UpdatedAt:    entry.UpdatedAt,
+	}
+
+	if entry.StartDate != nil {
+		s := entry.StartDate.String()
+		resp.StartDate = &s
+	}
+	if entry.EndDate != nil {
+		s := entry.EndDate.String()
+		resp.EndDate = &s
+	}
+
+	if resp.Authors == nil {
+		resp.Authors = make([]string, 0)
+	}
+
+	return resp
+}
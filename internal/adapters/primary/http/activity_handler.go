@@ -0,0 +1,80 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// ActivityHandler handles resume activity log HTTP requests.
+type ActivityHandler struct {
+	activityService *services.ActivityService
+}
+
+// NewActivityHandler creates a new ActivityHandler.
+func NewActivityHandler(activityService *services.ActivityService) *ActivityHandler {
+	return &ActivityHandler{
+		activityService: activityService,
+	}
+}
+
+// List returns the activity log of a resume.
+//
+//	@Summary		Get resume activity
+//	@Description	Returns the resume's activity log, oldest first: notes left through PATCH /content, status changes, PDF generations and AI runs (tailoring and critiques), so users can track the lifecycle of the application.
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Success		200			{object}	ListResumeActivityResponse
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/activity [get]
+func (h *ActivityHandler) List(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	activities, err := h.activityService.ListActivity(r.Context(), resumeID, authUser.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to list resume activity")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list resume activity")
+		return
+	}
+
+	resp := ListResumeActivityResponse{
+		Data: make([]ResumeActivityResponse, 0, len(activities)),
+	}
+	for _, a := range activities {
+		resp.Data = append(resp.Data, ResumeActivityResponse{
+			ID:         a.ID,
+			Type:       string(a.Type),
+			Note:       a.Note,
+			FromStatus: string(a.FromStatus),
+			ToStatus:   string(a.ToStatus),
+			Detail:     a.Detail,
+			CreatedAt:  a.CreatedAt,
+			AISettings: mapAIRunSettingsToDTO(a.AISettings),
+		})
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,253 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/pkg/filename"
+)
+
+// AdminHandler handles administration HTTP requests.
+type AdminHandler struct {
+	storageJanitor *services.StorageJanitorService
+	impersonation  *services.ImpersonationService
+	backup         *services.UserBackupService
+}
+
+// NewAdminHandler creates a new AdminHandler. Impersonation is not
+// available when impersonation is nil.
+func NewAdminHandler(storageJanitor *services.StorageJanitorService, impersonation *services.ImpersonationService, backup *services.UserBackupService) *AdminHandler {
+	return &AdminHandler{
+		storageJanitor: storageJanitor,
+		impersonation:  impersonation,
+		backup:         backup,
+	}
+}
+
+// ListStorageOrphans reports the stored PDFs left behind by deleted resumes.
+//
+//	@Summary		List orphaned storage files
+//	@Description	Dry run of the periodic storage cleanup: lists the cached resume PDFs whose resume no longer exists, and the space deleting them would reclaim, without deleting anything. Restricted to the users in server.adminUserIds.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	StorageOrphansResponse
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403	{object}	ErrorResponse	"Not an admin"
+//	@Failure		500	{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/admin/storage/orphans [get]
+func (h *AdminHandler) ListStorageOrphans(w http.ResponseWriter, r *http.Request) {
+	result, err := h.storageJanitor.CleanupOrphans(r.Context(), true)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to find orphaned storage files")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to find orphaned storage files")
+		return
+	}
+
+	resp := StorageOrphansResponse{
+		Files:            make([]StoredFileResponse, 0, len(result.Orphans)),
+		Count:            len(result.Orphans),
+		ReclaimableBytes: result.ReclaimableBytes,
+	}
+	for _, file := range result.Orphans {
+		resp.Files = append(resp.Files, StoredFileResponse{
+			Key:       file.Key,
+			Size:      file.Size,
+			UpdatedAt: file.UpdatedAt,
+		})
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// Impersonate issues a token for acting as a user.
+//
+//	@Summary		Impersonate user
+//	@Description	Issues a short-lived token for acting as the user, for support debugging. Tokens of the read scope, the default, only allow GET requests. Issuing the token and every request made with it are recorded in the audit log, and responses to those requests carry the X-Impersonated-By header. Admin endpoints cannot be called while impersonating.
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			userID	path		string					true	"ID of the user to impersonate"
+//	@Param			request	body		ImpersonateRequest		false	"Token scope"
+//	@Success		201		{object}	ImpersonationResponse
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403		{object}	ErrorResponse	"Not an admin"
+//	@Failure		404		{object}	ErrorResponse	"User not found"
+//	@Failure		422		{object}	ErrorResponse	"Invalid scope, or the admin's own account"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Failure		501		{object}	ErrorResponse	"Impersonation not configured"
+//	@Router			/v1/admin/impersonate/{userID} [post]
+func (h *AdminHandler) Impersonate(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+	if h.impersonation == nil {
+		respondError(w, http.StatusNotImplemented, "IMPERSONATION_NOT_CONFIGURED", "Impersonation is not configured")
+		return
+	}
+
+	userID := chi.URLParam(r, "userID")
+	if userID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "User ID is required")
+		return
+	}
+
+	var req ImpersonateRequest
+	if r.Body != nil && r.ContentLength > 0 {
+		if err := decodeJSON(r, &req); err != nil {
+			respondDecodeError(w, err)
+			return
+		}
+	}
+
+	grant, err := h.impersonation.Impersonate(r.Context(), authUser.ID, userID, domain.ImpersonationScope(req.Scope))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			respondError(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+		case errors.Is(err, domain.ErrInvalidImpersonationScope), errors.Is(err, domain.ErrCannotImpersonateSelf):
+			respondError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
+		default:
+			log.Error().Err(err).Str("admin_id", authUser.ID).Str("user_id", userID).Msg("Failed to start impersonation")
+			respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to start impersonation")
+		}
+		return
+	}
+
+	log.Warn().
+		Str("admin_id", authUser.ID).
+		Str("user_id", userID).
+		Str("scope", string(grant.Impersonation.Scope)).
+		Str("impersonation_id", grant.Impersonation.ID).
+		Msg("Admin started impersonating user")
+
+	respondJSON(w, http.StatusCreated, ImpersonationResponse{
+		Token:           grant.Token,
+		ImpersonationID: grant.Impersonation.ID,
+		UserID:          grant.Impersonation.UserID,
+		Scope:           string(grant.Impersonation.Scope),
+		ExpiresAt:       grant.Impersonation.ExpiresAt,
+	})
+}
+
+// ExportUser downloads the archive of a user's data.
+//
+//	@Summary		Export user
+//	@Description	Downloads a portable archive of all the data of a user: profile, preferences, experiences, skills, languages, education, academic entries, projects, resumes with their tags, critiques, feedback and activity, and saved filters. Import it with POST /v1/admin/users/import or the import-user command. Cached PDFs, bullet embeddings, usage counts, linked sign-in methods and the plan are not included. Exports are recorded in the audit log.
+//	@Tags			admin
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			userID	path		string	true	"ID of the user to export"
+//	@Success		200		{object}	services.UserArchive
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403		{object}	ErrorResponse	"Not an admin"
+//	@Failure		404		{object}	ErrorResponse	"User not found"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/admin/users/{userID}/export [get]
+func (h *AdminHandler) ExportUser(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	userID := chi.URLParam(r, "userID")
+	if userID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "User ID is required")
+		return
+	}
+
+	archive, err := h.backup.ExportUser(r.Context(), authUser.ID, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			respondError(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		log.Error().Err(err).Str("admin_id", authUser.ID).Str("user_id", userID).Msg("Failed to export user")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to export user")
+		return
+	}
+
+	log.Warn().
+		Str("admin_id", authUser.ID).
+		Str("user_id", userID).
+		Int("experiences", len(archive.Experiences)).
+		Int("resumes", len(archive.Resumes)).
+		Msg("Admin exported user")
+
+	name := "user-" + userID + "-" + archive.ExportedAt.Format(time.DateOnly) + ".json"
+	w.Header().Set("Content-Disposition", filename.ContentDisposition("attachment", name))
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	respondJSON(w, http.StatusOK, archive)
+}
+
+// ImportUser creates a user from an archive.
+//
+//	@Summary		Import user
+//	@Description	Creates a user with the data of an archive made by GET /v1/admin/users/{userID}/export or the export-user command, possibly on another instance. IDs are kept unless remap_ids is true, which gives the user and all their records new IDs so that an archive can be imported next to the user it was exported from. firebase_uid replaces the sign-in of the archived user. Resume PDFs are generated again on download. Imports are recorded in the audit log.
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			remap_ids		query		bool				false	"Give the user and their records new IDs"
+//	@Param			firebase_uid	query		string				false	"Sign-in of the imported user, instead of the archived one"
+//	@Param			request			body		services.UserArchive	true	"User archive"
+//	@Success		201				{object}	UserResponse
+//	@Failure		400				{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401				{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403				{object}	ErrorResponse	"Not an admin"
+//	@Failure		409				{object}	ErrorResponse	"The user or their sign-in already exists"
+//	@Failure		413				{object}	ErrorResponse	"Archive too large"
+//	@Failure		422				{object}	ErrorResponse	"Unsupported archive version, or no sign-in"
+//	@Failure		500				{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/admin/users/import [post]
+func (h *AdminHandler) ImportUser(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var archive services.UserArchive
+	if err := decodeJSON(r, &archive); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	user, err := h.backup.ImportUser(r.Context(), services.ImportUserRequest{
+		Archive:     &archive,
+		AdminID:     authUser.ID,
+		RemapIDs:    r.URL.Query().Get("remap_ids") == "true",
+		FirebaseUID: r.URL.Query().Get("firebase_uid"),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserAlreadyExists):
+			respondError(w, http.StatusConflict, "USER_ALREADY_EXISTS", "The user or their sign-in already exists; import with remap_ids or another firebase_uid")
+		case errors.Is(err, domain.ErrUnsupportedArchiveVersion), errors.Is(err, domain.ErrInvalidFirebaseUID):
+			respondError(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", err.Error())
+		default:
+			log.Error().Err(err).Str("admin_id", authUser.ID).Str("user_id", archive.User.ID).Msg("Failed to import user")
+			respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to import user")
+		}
+		return
+	}
+
+	log.Warn().
+		Str("admin_id", authUser.ID).
+		Str("user_id", user.ID).
+		Str("archived_user_id", archive.User.ID).
+		Msg("Admin imported user")
+
+	respondJSON(w, http.StatusCreated, mapUserToResponse(user))
+}
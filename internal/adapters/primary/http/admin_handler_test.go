@@ -0,0 +1,198 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http/mocks"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/pkg/signedurl"
+)
+
+func TestAdminHandlerImpersonate(t *testing.T) {
+	// setup returns a handler letting admin-1 impersonate user-123.
+	setup := func(t *testing.T) (*AdminHandler, *mocks.InMemoryAuditRepository) {
+		t.Helper()
+		userRepo := mocks.NewInMemoryUserRepository()
+		user := createTestUser("firebase-123")
+		user.ID = "user-123"
+		userRepo.Seed(user)
+
+		signer, err := signedurl.New([]byte(strings.Repeat("k", signedurl.MinKeySize)))
+		require.NoError(t, err)
+		audit := mocks.NewInMemoryAuditRepository()
+		impersonations := services.NewImpersonationService(userRepo, audit, signer, 15*time.Minute)
+		return NewAdminHandler(nil, impersonations, nil), audit
+	}
+
+	impersonate := func(t *testing.T, handler *AdminHandler, userID string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodPost, "/v1/admin/impersonate/"+userID, map[string]string{"userID": userID}, body)
+		ctx := setupTestContext("admin-1", "firebase-admin", "admin@example.com")
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.Impersonate)
+	}
+
+	t.Run("issues a read token and audits it", func(t *testing.T) {
+		handler, audit := setup(t)
+		before := time.Now()
+
+		rr := impersonate(t, handler, "user-123", nil)
+
+		assertStatusCode(t, http.StatusCreated, rr)
+		var resp ImpersonationResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.True(t, strings.HasPrefix(resp.Token, services.ImpersonationTokenPrefix))
+		assert.Equal(t, "user-123", resp.UserID)
+		assert.Equal(t, "read", resp.Scope)
+		assert.WithinDuration(t, before.Add(15*time.Minute), resp.ExpiresAt, 2*time.Second)
+
+		events := audit.Events()
+		require.Len(t, events, 1)
+		assert.Equal(t, domain.AuditImpersonationStarted, events[0].Action)
+		assert.Equal(t, "admin-1", events[0].ActorID)
+		assert.Equal(t, "user-123", events[0].SubjectID)
+		assert.Equal(t, resp.ImpersonationID, events[0].SessionID)
+	})
+
+	t.Run("issues a full token", func(t *testing.T) {
+		handler, _ := setup(t)
+
+		rr := impersonate(t, handler, "user-123", ImpersonateRequest{Scope: "full"})
+
+		assertStatusCode(t, http.StatusCreated, rr)
+		var resp ImpersonationResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, "full", resp.Scope)
+	})
+
+	t.Run("error - unknown user", func(t *testing.T) {
+		handler, audit := setup(t)
+
+		assertErrorResponse(t, impersonate(t, handler, "user-999", nil), http.StatusNotFound, "USER_NOT_FOUND")
+		assert.Empty(t, audit.Events())
+	})
+
+	t.Run("error - invalid scope", func(t *testing.T) {
+		handler, _ := setup(t)
+
+		rr := impersonate(t, handler, "user-123", ImpersonateRequest{Scope: "admin"})
+		assertErrorResponse(t, rr, http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - own account", func(t *testing.T) {
+		handler, _ := setup(t)
+
+		assertErrorResponse(t, impersonate(t, handler, "admin-1", nil), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - not configured", func(t *testing.T) {
+		handler := NewAdminHandler(nil, nil, nil)
+
+		assertErrorResponse(t, impersonate(t, handler, "user-123", nil), http.StatusNotImplemented, "IMPERSONATION_NOT_CONFIGURED")
+	})
+}
+
+func TestAdminHandlerUserBackup(t *testing.T) {
+	// setup returns a handler with user-123 signed in as firebase-123. Only
+	// the user and audit repositories are needed for archives without data.
+	setup := func(t *testing.T) (*AdminHandler, *mocks.InMemoryUserRepository, *mocks.InMemoryAuditRepository) {
+		t.Helper()
+		userRepo := mocks.NewInMemoryUserRepository()
+		user := createTestUser("firebase-123")
+		user.ID = "user-123"
+		userRepo.Seed(user)
+
+		audit := mocks.NewInMemoryAuditRepository()
+		backup := services.NewUserBackupService(userRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, audit)
+		return NewAdminHandler(nil, nil, backup), userRepo, audit
+	}
+
+	asAdmin := func(req *http.Request) *http.Request {
+		ctx := setupTestContext("admin-1", "firebase-admin", "admin@example.com")
+		return req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+	}
+
+	importUser := func(t *testing.T, handler *AdminHandler, query string, archive any) *httptest.ResponseRecorder {
+		t.Helper()
+		req := asAdmin(newJSONRequest(t, http.MethodPost, "/v1/admin/users/import"+query, archive))
+		return executeRequest(t, req, handler.ImportUser)
+	}
+
+	archive := func(userID, firebaseUID string) services.UserArchive {
+		return services.UserArchive{
+			Version: services.UserArchiveVersion,
+			User:    domain.User{ID: userID, FirebaseUID: firebaseUID, PreferredLanguage: "en"},
+		}
+	}
+
+	t.Run("export - unknown user", func(t *testing.T) {
+		handler, _, audit := setup(t)
+
+		req := asAdmin(newRequestWithChiContext(t, http.MethodGet, "/v1/admin/users/user-999/export", map[string]string{"userID": "user-999"}, nil))
+		assertErrorResponse(t, executeRequest(t, req, handler.ExportUser), http.StatusNotFound, "USER_NOT_FOUND")
+		assert.Empty(t, audit.Events())
+	})
+
+	t.Run("import keeping IDs", func(t *testing.T) {
+		handler, userRepo, audit := setup(t)
+
+		rr := importUser(t, handler, "", archive("user-456", "firebase-456"))
+
+		assertStatusCode(t, http.StatusCreated, rr)
+		var resp UserResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, "user-456", resp.ID)
+
+		_, err := userRepo.GetByFirebaseUID(context.Background(), "firebase-456")
+		require.NoError(t, err)
+
+		events := audit.Events()
+		require.Len(t, events, 1)
+		assert.Equal(t, domain.AuditUserImported, events[0].Action)
+		assert.Equal(t, "admin-1", events[0].ActorID)
+		assert.Equal(t, "user-456", events[0].SubjectID)
+	})
+
+	t.Run("import next to the archived user", func(t *testing.T) {
+		handler, _, _ := setup(t)
+
+		rr := importUser(t, handler, "?remap_ids=true&firebase_uid=firebase-copy", archive("user-123", "firebase-123"))
+
+		assertStatusCode(t, http.StatusCreated, rr)
+		var resp UserResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.NotEqual(t, "user-123", resp.ID)
+		assert.Equal(t, "firebase-copy", resp.FirebaseUID)
+	})
+
+	t.Run("error - existing user", func(t *testing.T) {
+		handler, _, audit := setup(t)
+
+		assertErrorResponse(t, importUser(t, handler, "", archive("user-123", "firebase-other")), http.StatusConflict, "USER_ALREADY_EXISTS")
+		assertErrorResponse(t, importUser(t, handler, "?remap_ids=true", archive("user-123", "firebase-123")), http.StatusConflict, "USER_ALREADY_EXISTS")
+		assert.Empty(t, audit.Events())
+	})
+
+	t.Run("error - unsupported version", func(t *testing.T) {
+		handler, _, _ := setup(t)
+
+		old := archive("user-456", "firebase-456")
+		old.Version = 0
+		assertErrorResponse(t, importUser(t, handler, "", old), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - invalid body", func(t *testing.T) {
+		handler, _, _ := setup(t)
+
+		assertErrorResponse(t, importUser(t, handler, "", map[string]any{"unknown": true}), http.StatusBadRequest, "INVALID_REQUEST")
+	})
+}
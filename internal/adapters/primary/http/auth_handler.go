@@ -1,10 +1,12 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
 )
 
@@ -23,7 +25,7 @@ func NewAuthHandler(userService *services.UserService) *AuthHandler {
 // SyncUser synchronizes a Firebase user with the local database.
 //
 //	@Summary		Sync user from Firebase
-//	@Description	Synchronizes a Firebase authenticated user with the local PostgreSQL database. Creates a new user if not exists, updates if exists (upsert behavior).
+//	@Description	Synchronizes a Firebase authenticated user with the local PostgreSQL database. Creates a new user if not exists, updates if exists (upsert behavior). A new sign-in method whose email, verified by a trusted provider, matches an existing account is linked to it.
 //	@Tags			auth
 //	@Accept			json
 //	@Produce		json
@@ -32,6 +34,7 @@ func NewAuthHandler(userService *services.UserService) *AuthHandler {
 //	@Success		201				{object}	SyncUserResponse
 //	@Failure		400				{object}	ErrorResponse	"Invalid request body"
 //	@Failure		401				{object}	ErrorResponse	"Invalid or expired token"
+//	@Failure		409				{object}	ErrorResponse	"Another account uses the email, unverified or from an untrusted provider"
 //	@Failure		500				{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/auth/sync [post]
 func (h *AuthHandler) SyncUser(w http.ResponseWriter, r *http.Request) {
@@ -55,6 +58,10 @@ func (h *AuthHandler) SyncUser(w http.ResponseWriter, r *http.Request) {
 	result, err := h.userService.SyncUser(r.Context(), services.SyncUserRequest{
 		IDToken: token,
 	})
+	if errors.Is(err, domain.ErrAccountExists) {
+		respondError(w, http.StatusConflict, "ACCOUNT_EXISTS", "An account with this email already exists; sign in to it and link this sign-in method")
+		return
+	}
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to sync user")
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Failed to verify token or sync user")
@@ -68,6 +75,7 @@ func (h *AuthHandler) SyncUser(w http.ResponseWriter, r *http.Request) {
 		Email:       result.User.Email,
 		Name:        result.User.Name,
 		CreatedAt:   result.User.CreatedAt,
+		Linked:      result.Linked,
 	}
 
 	status := http.StatusOK
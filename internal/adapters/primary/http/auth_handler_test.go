@@ -3,7 +3,9 @@ package http
 import (
 	"context"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -87,6 +89,83 @@ func TestAuthHandlerSyncUser(t *testing.T) {
 			expectedStatus: http.StatusUnauthorized,
 			expectedCode:   "UNAUTHORIZED",
 		},
+		{
+			name:       "error - token of a revoked session",
+			authHeader: "Bearer revoked-token",
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository, authProvider *mocks.MockAuthProvider) {
+				revokedAt := time.Now().UTC()
+				user, _ := domain.NewUser("firebase-revoked-123")
+				user.ID = "user-revoked-123"
+				user.SessionsRevokedAt = &revokedAt
+				userRepo.Seed(user)
+
+				authProvider.AddToken("revoked-token", &ports.AuthClaims{
+					UserID:   "firebase-revoked-123",
+					IssuedAt: revokedAt.Add(-time.Hour).Unix(),
+				})
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "UNAUTHORIZED",
+		},
+		{
+			name:       "success - links a new sign-in method with a verified matching email",
+			authHeader: "Bearer github-token",
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository, authProvider *mocks.MockAuthProvider) {
+				user, _ := domain.NewUser("firebase-google-123")
+				user.ID = "user-123"
+				user.SetEmail("user@example.com")
+				userRepo.Seed(user)
+
+				authProvider.AddToken("github-token", &ports.AuthClaims{
+					UserID:        "firebase-github-456",
+					Email:         "User@Example.com",
+					EmailVerified: true,
+					Provider:      "github.com",
+				})
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp SyncUserResponse) {
+				assert.Equal(t, "user-123", resp.ID)
+				assert.Equal(t, "firebase-google-123", resp.FirebaseUID)
+				assert.True(t, resp.Linked)
+			},
+		},
+		{
+			name:       "error - unverified email of an existing account",
+			authHeader: "Bearer unverified-token",
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository, authProvider *mocks.MockAuthProvider) {
+				user, _ := domain.NewUser("firebase-google-123")
+				user.ID = "user-123"
+				user.SetEmail("user@example.com")
+				userRepo.Seed(user)
+
+				authProvider.AddToken("unverified-token", &ports.AuthClaims{
+					UserID: "firebase-password-456",
+					Email:  "user@example.com",
+				})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "ACCOUNT_EXISTS",
+		},
+		{
+			name:       "error - verified email of an existing account from an untrusted provider",
+			authHeader: "Bearer oidc-token",
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository, authProvider *mocks.MockAuthProvider) {
+				user, _ := domain.NewUser("firebase-google-123")
+				user.ID = "user-123"
+				user.SetEmail("user@example.com")
+				userRepo.Seed(user)
+
+				authProvider.AddToken("oidc-token", &ports.AuthClaims{
+					UserID:        "oidc-456",
+					Email:         "user@example.com",
+					EmailVerified: true,
+					Provider:      "oidc",
+				})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "ACCOUNT_EXISTS",
+		},
 	}
 
 	for _, tt := range tests {
@@ -98,6 +177,7 @@ func TestAuthHandlerSyncUser(t *testing.T) {
 
 			// Create service and handler
 			userService := services.NewUserService(userRepo, authProvider)
+			userService.SetTrustedEmailProviders([]string{"google.com", "github.com"})
 			handler := NewAuthHandler(userService)
 
 			// Create request
@@ -130,6 +210,76 @@ func TestAuthHandlerSyncUser(t *testing.T) {
 	}
 }
 
+func TestAuthHandlerSyncUserCachesPicture(t *testing.T) {
+	const sourceURL = "https://lh3.googleusercontent.com/a/photo"
+
+	tests := []struct {
+		name       string
+		setupMocks func(userRepo *mocks.InMemoryUserRepository, fetcher *mocks.MockAvatarFetcher)
+		cached     bool
+	}{
+		{
+			name: "new user",
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository, fetcher *mocks.MockAvatarFetcher) {
+				fetcher.AddPicture(sourceURL, []byte("jpeg bytes"))
+			},
+			cached: true,
+		},
+		{
+			name: "existing user with a new picture",
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository, fetcher *mocks.MockAvatarFetcher) {
+				user, _ := domain.NewUser("firebase-123")
+				user.ID = "user-123"
+				user.PictureURL = strPtr("https://lh3.googleusercontent.com/a/old")
+				userRepo.Seed(user)
+				fetcher.AddPicture(sourceURL, []byte("jpeg bytes"))
+			},
+			cached: true,
+		},
+		{
+			name:       "picture that cannot be fetched keeps the provider URL",
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository, fetcher *mocks.MockAvatarFetcher) {},
+			cached:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := mocks.NewInMemoryUserRepository()
+			authProvider := mocks.NewMockAuthProvider()
+			authProvider.AddToken("valid-token", &ports.AuthClaims{
+				UserID:  "firebase-123",
+				Email:   "user@example.com",
+				Picture: sourceURL,
+			})
+			storage := mocks.NewInMemoryFileStorage()
+			fetcher := mocks.NewMockAvatarFetcher()
+			tt.setupMocks(userRepo, fetcher)
+
+			userService := services.NewUserService(userRepo, authProvider)
+			userService.SetAvatarCache(fetcher, storage, avatarURL)
+			handler := NewAuthHandler(userService)
+
+			req := newJSONRequest(t, http.MethodPost, "/v1/auth/sync", nil)
+			req.Header.Set("Authorization", "Bearer valid-token")
+			rr := executeRequest(t, req, handler.SyncUser)
+			require.Less(t, rr.Code, 300)
+
+			saved, err := userRepo.GetByFirebaseUID(context.Background(), "firebase-123")
+			require.NoError(t, err)
+			assert.Equal(t, sourceURL, derefStr(saved.PictureSourceURL))
+
+			if tt.cached {
+				assert.True(t, strings.HasPrefix(derefStr(saved.PictureURL), avatarURL(saved.ID)+"?v="), derefStr(saved.PictureURL))
+				assert.Equal(t, 1, storage.Len())
+			} else {
+				assert.Equal(t, sourceURL, derefStr(saved.PictureURL))
+				assert.Equal(t, 0, storage.Len())
+			}
+		})
+	}
+}
+
 func TestNewAuthHandler(t *testing.T) {
 	userRepo := mocks.NewInMemoryUserRepository()
 	authProvider := mocks.NewMockAuthProvider()
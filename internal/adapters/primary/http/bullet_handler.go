@@ -3,6 +3,7 @@ package http
 import (
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog/log"
@@ -41,7 +42,7 @@ func NewBulletHandler(bulletService *services.BulletService) *BulletHandler {
 //	@Failure		500				{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/experiences/{experienceID}/bullets [post]
 func (h *BulletHandler) Create(w http.ResponseWriter, r *http.Request) {
-	_, ok := GetAuthenticatedUser(r.Context())
+	authUser, ok := GetAuthenticatedUser(r.Context())
 	if !ok {
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
 		return
@@ -55,7 +56,7 @@ func (h *BulletHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	var req CreateBulletRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
@@ -66,6 +67,7 @@ func (h *BulletHandler) Create(w http.ResponseWriter, r *http.Request) {
 	}
 	createReq := services.CreateBulletRequest{
 		ExperienceID: experienceID,
+		UserID:       authUser.ID,
 		Content:      req.Content,
 		Keywords:     req.Keywords,
 		DisplayOrder: displayOrder,
@@ -107,7 +109,7 @@ func (h *BulletHandler) Create(w http.ResponseWriter, r *http.Request) {
 //	@Failure		500			{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/bullets/{bulletID} [put]
 func (h *BulletHandler) Update(w http.ResponseWriter, r *http.Request) {
-	_, ok := GetAuthenticatedUser(r.Context())
+	authUser, ok := GetAuthenticatedUser(r.Context())
 	if !ok {
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
 		return
@@ -121,13 +123,14 @@ func (h *BulletHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	var req UpdateBulletRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
 	// Build update request
 	updateReq := services.UpdateBulletRequest{
 		BulletID:     bulletID,
+		UserID:       authUser.ID,
 		Content:      req.Content,
 		Keywords:     req.Keywords,
 		DisplayOrder: req.DisplayOrder,
@@ -164,7 +167,7 @@ func (h *BulletHandler) Update(w http.ResponseWriter, r *http.Request) {
 //	@Failure		500			{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/bullets/{bulletID} [delete]
 func (h *BulletHandler) Delete(w http.ResponseWriter, r *http.Request) {
-	_, ok := GetAuthenticatedUser(r.Context())
+	authUser, ok := GetAuthenticatedUser(r.Context())
 	if !ok {
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
 		return
@@ -176,7 +179,7 @@ func (h *BulletHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.bulletService.DeleteBullet(r.Context(), bulletID); err != nil {
+	if err := h.bulletService.DeleteBullet(r.Context(), bulletID, authUser.ID); err != nil {
 		if errors.Is(err, domain.ErrBulletNotFound) {
 			respondError(w, http.StatusNotFound, "BULLET_NOT_FOUND", "Bullet not found")
 			return
@@ -204,9 +207,10 @@ func (h *BulletHandler) Delete(w http.ResponseWriter, r *http.Request) {
 //	@Failure		401			{object}	ErrorResponse	"Unauthorized"
 //	@Failure		404			{object}	ErrorResponse	"Bullet not found"
 //	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Failure		503			{object}	ErrorResponse	"AI service unavailable"
 //	@Router			/v1/bullets/{bulletID}/score [post]
 func (h *BulletHandler) RecalculateScore(w http.ResponseWriter, r *http.Request) {
-	_, ok := GetAuthenticatedUser(r.Context())
+	authUser, ok := GetAuthenticatedUser(r.Context())
 	if !ok {
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
 		return
@@ -220,12 +224,13 @@ func (h *BulletHandler) RecalculateScore(w http.ResponseWriter, r *http.Request)
 
 	var req AnalyzeBulletRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
 	analyzeReq := services.AnalyzeBulletImpactRequest{
 		BulletID:       bulletID,
+		UserID:         authUser.ID,
 		JobDescription: req.JobDescription,
 	}
 
@@ -235,6 +240,9 @@ func (h *BulletHandler) RecalculateScore(w http.ResponseWriter, r *http.Request)
 			respondError(w, http.StatusNotFound, "BULLET_NOT_FOUND", "Bullet not found")
 			return
 		}
+		if handleUpstreamError(w, err) {
+			return
+		}
 		log.Error().Err(err).Str("bullet_id", bulletID).Msg("Failed to recalculate bullet score")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to recalculate score")
 		return
@@ -249,6 +257,55 @@ func (h *BulletHandler) RecalculateScore(w http.ResponseWriter, r *http.Request)
 	respondJSON(w, http.StatusOK, response)
 }
 
+// Search searches the authenticated user's bullets.
+//
+//	@Summary		Search bullets
+//	@Description	Searches bullets by keyword and, when an embeddings provider is configured, by semantic similarity. Results from both are fused into a single ranking.
+//	@Tags			bullets
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			q		query		string	true	"Search query"
+//	@Param			limit	query		int		false	"Maximum results (default 20, max 100)"
+//	@Success		200		{object}	ListBulletsResponse
+//	@Failure		400		{object}	ErrorResponse	"Missing query"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/bullets/search [get]
+func (h *BulletHandler) Search(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Query parameter q is required")
+		return
+	}
+
+	bullets, err := h.bulletService.SearchBullets(r.Context(), services.SearchBulletsRequest{
+		UserID: authUser.ID,
+		Query:  query,
+		Limit:  parseIntParam(r, "limit", services.DefaultSearchLimit),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to search bullets")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to search bullets")
+		return
+	}
+
+	data := make([]BulletResponse, 0, len(bullets))
+	for _, bullet := range bullets {
+		data = append(data, mapBulletToResponse(&bullet))
+	}
+
+	respondJSON(w, http.StatusOK, ListBulletsResponse{
+		Data:  data,
+		Total: len(data),
+	})
+}
+
 // mapBulletToResponse maps a domain Bullet to a BulletResponse.
 func mapBulletToResponse(b *domain.Bullet) BulletResponse {
 	return BulletResponse{
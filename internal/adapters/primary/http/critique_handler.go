@@ -0,0 +1,149 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// CritiqueHandler handles resume critique HTTP requests.
+type CritiqueHandler struct {
+	critiqueService *services.CritiqueService
+}
+
+// NewCritiqueHandler creates a new CritiqueHandler.
+func NewCritiqueHandler(critiqueService *services.CritiqueService) *CritiqueHandler {
+	return &CritiqueHandler{
+		critiqueService: critiqueService,
+	}
+}
+
+// Create critiques a tailored resume.
+//
+//	@Summary		Critique resume
+//	@Description	Has the AI review the resume's current generated content as the hiring manager for the job: strengths, weaknesses, red flags and suggested edits, with an overall score and an interview verdict. Each critique is stored with a hash of the reviewed content so improvements can be tracked across versions.
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Success		201			{object}	ResumeCritiqueResponse
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		422			{object}	ErrorResponse	"Resume not tailored yet"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Failure		503			{object}	ErrorResponse	"AI service unavailable"
+//	@Router			/v1/resumes/{resumeID}/critique [post]
+func (h *CritiqueHandler) Create(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	critique, err := h.critiqueService.CritiqueResume(r.Context(), services.CritiqueResumeRequest{
+		ResumeID: resumeID,
+		UserID:   authUser.ID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before critique")
+			return
+		}
+		if handleUpstreamError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to critique resume")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to critique resume")
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, mapCritiqueToResponse(critique))
+}
+
+// List returns the critiques of a resume.
+//
+//	@Summary		List resume critiques
+//	@Description	Returns all critiques of a resume, newest first. Critiques with different content_hash values reviewed different versions of the resume.
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Success		200			{object}	ListResumeCritiquesResponse
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/critiques [get]
+func (h *CritiqueHandler) List(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	critiques, err := h.critiqueService.ListCritiques(r.Context(), resumeID, authUser.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to list critiques")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list critiques")
+		return
+	}
+
+	resp := ListResumeCritiquesResponse{
+		Data: make([]ResumeCritiqueResponse, 0, len(critiques)),
+	}
+	for i := range critiques {
+		resp.Data = append(resp.Data, mapCritiqueToResponse(&critiques[i]))
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// mapCritiqueToResponse maps a domain ResumeCritique to a ResumeCritiqueResponse.
+func mapCritiqueToResponse(critique *domain.ResumeCritique) ResumeCritiqueResponse {
+	resp := ResumeCritiqueResponse{
+		ID:             critique.ID,
+		ResumeID:       critique.ResumeID,
+		ContentHash:    critique.ContentHash,
+		Score:          critique.Score.Int(),
+		Verdict:        string(critique.Verdict),
+		Summary:        critique.Summary,
+		Strengths:      critique.Strengths,
+		Weaknesses:     critique.Weaknesses,
+		RedFlags:       critique.RedFlags,
+		SuggestedEdits: make([]SuggestedEditDTO, 0, len(critique.SuggestedEdits)),
+		CreatedAt:      critique.CreatedAt,
+	}
+	for _, edit := range critique.SuggestedEdits {
+		resp.SuggestedEdits = append(resp.SuggestedEdits, SuggestedEditDTO{
+			Section:   edit.Section,
+			Original:  edit.Original,
+			Suggested: edit.Suggested,
+			Reason:    edit.Reason,
+		})
+	}
+	return resp
+}
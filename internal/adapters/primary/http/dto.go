@@ -2,6 +2,8 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"time"
 )
@@ -20,6 +22,7 @@ type HealthResponse struct {
 type ErrorDetail struct {
 	Field   string `json:"field" example:"email"`
 	Message string `json:"message" example:"Invalid email format"`
+	Row     int    `json:"row,omitempty" example:"3"`
 }
 
 // ErrorResponse represents the standard error response format.
@@ -32,6 +35,10 @@ type ErrorBody struct {
 	Code    string        `json:"code" example:"VALIDATION_ERROR"`
 	Message string        `json:"message" example:"Validation failed"`
 	Details []ErrorDetail `json:"details,omitempty"`
+
+	// RequestID identifies the request in the server logs; it is set on
+	// unexpected errors.
+	RequestID string `json:"request_id,omitempty" example:"host/abc123-000001"`
 }
 
 // SuccessResponse represents a generic success response with data.
@@ -67,6 +74,10 @@ type SyncUserResponse struct {
 	Email       *string   `json:"email,omitempty" example:"user@example.com"`
 	Name        *string   `json:"name,omitempty" example:"John Doe"`
 	CreatedAt   time.Time `json:"created_at" example:"2026-01-09T10:00:00Z"`
+
+	// Linked is true when the sign-in method was linked to an existing
+	// account with the same verified email.
+	Linked bool `json:"linked,omitempty" example:"false"`
 }
 
 // ===============================
@@ -83,7 +94,7 @@ type UserResponse struct {
 	Headline          *string   `json:"headline,omitempty" example:"Senior Software Engineer"`
 	Summary           *string   `json:"summary,omitempty" example:"Experienced developer..."`
 	Location          *string   `json:"location,omitempty" example:"San Francisco, CA"`
-	Phone             *string   `json:"phone,omitempty" example:"+1-555-123-4567"`
+	Phone             *string   `json:"phone,omitempty" example:"+15551234567"`
 	Website           *string   `json:"website,omitempty" example:"https://johndoe.dev"`
 	LinkedInURL       *string   `json:"linkedin_url,omitempty" example:"https://linkedin.com/in/johndoe"`
 	GitHubURL         *string   `json:"github_url,omitempty" example:"https://github.com/johndoe"`
@@ -107,56 +118,103 @@ type UpdateUserRequest struct {
 	PreferredLanguage *string `json:"preferred_language,omitempty" example:"en"`
 }
 
+// SessionsResponse describes the authenticated user's sign-in sessions. The
+// provider does not track devices, so other sessions are summarized by the
+// latest sign-in and token refresh.
+type SessionsResponse struct {
+	Current       CurrentSessionResponse `json:"current"`
+	Providers     []string               `json:"providers" example:"google.com,github.com"`
+	LastSignInAt  *time.Time             `json:"last_sign_in_at,omitempty" example:"2026-03-01T10:00:00Z"`
+	LastRefreshAt *time.Time             `json:"last_refresh_at,omitempty" example:"2026-03-01T11:00:00Z"`
+	RevokedAt     *time.Time             `json:"revoked_at,omitempty" example:"2026-02-01T10:00:00Z"`
+}
+
+// CurrentSessionResponse describes the session making the request.
+type CurrentSessionResponse struct {
+	Provider  string    `json:"provider,omitempty" example:"google.com"`
+	IssuedAt  time.Time `json:"issued_at" example:"2026-03-01T11:00:00Z"`
+	ExpiresAt time.Time `json:"expires_at" example:"2026-03-01T12:00:00Z"`
+}
+
+// RevokeSessionsResponse is the response after revoking all sessions.
+type RevokeSessionsResponse struct {
+	RevokedAt time.Time `json:"revoked_at" example:"2026-03-01T11:30:00Z"`
+}
+
+// LinkIdentityRequest represents the request for linking a sign-in method.
+type LinkIdentityRequest struct {
+	// IDToken is an ID token from signing in with the method to link.
+	IDToken string `json:"id_token" example:"eyJhbGciOiJSUzI1NiIs..."`
+}
+
+// IdentityResponse represents a sign-in method linked to the user.
+type IdentityResponse struct {
+	ID             string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ProviderUserID string    `json:"provider_user_id" example:"def456uvw"`
+	Provider       string    `json:"provider,omitempty" example:"github.com"`
+	Email          *string   `json:"email,omitempty" example:"user@example.com"`
+	CreatedAt      time.Time `json:"created_at" example:"2026-03-01T10:00:00Z"`
+}
+
+// ListIdentitiesResponse represents the sign-in methods linked to the user
+// besides the one they signed up with, oldest first.
+type ListIdentitiesResponse struct {
+	Data []IdentityResponse `json:"data"`
+}
+
 // ===============================
 // Experience DTOs
 // ===============================
 
 // ExperienceResponse represents an experience in API responses.
 type ExperienceResponse struct {
-	ID           string           `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Type         string           `json:"type" example:"work"`
-	Title        string           `json:"title" example:"Senior Software Engineer"`
-	Organization string           `json:"organization" example:"Tech Company Inc."`
-	Location     *string          `json:"location,omitempty" example:"Remote"`
-	StartDate    string           `json:"start_date" example:"2022-01-15"`
-	EndDate      *string          `json:"end_date,omitempty" example:"2024-06-30"`
-	IsCurrent    bool             `json:"is_current" example:"false"`
-	Description  *string          `json:"description,omitempty" example:"Led backend development..."`
-	URL          *string          `json:"url,omitempty" example:"https://techcompany.com"`
-	Metadata     map[string]any   `json:"metadata,omitempty"`
-	DisplayOrder int              `json:"display_order" example:"0"`
-	Bullets      []BulletResponse `json:"bullets,omitempty"`
-	CreatedAt    time.Time        `json:"created_at" example:"2026-01-09T10:00:00Z"`
-	UpdatedAt    time.Time        `json:"updated_at" example:"2026-01-09T10:00:00Z"`
+	ID                   string           `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Type                 string           `json:"type" example:"work"`
+	Title                string           `json:"title" example:"Senior Software Engineer"`
+	Organization         string           `json:"organization" example:"Tech Company Inc."`
+	Location             *string          `json:"location,omitempty" example:"Remote"`
+	StartDate            string           `json:"start_date" example:"2022-01-15"`
+	EndDate              *string          `json:"end_date,omitempty" example:"2024-06-30"`
+	IsCurrent            bool             `json:"is_current" example:"false"`
+	Description          *string          `json:"description,omitempty" example:"Led backend development..."`
+	URL                  *string          `json:"url,omitempty" example:"https://techcompany.com"`
+	Metadata             map[string]any   `json:"metadata,omitempty"`
+	DisplayOrder         int              `json:"display_order" example:"0"`
+	ExcludeFromTailoring bool             `json:"exclude_from_tailoring" example:"false"`
+	Bullets              []BulletResponse `json:"bullets,omitempty"`
+	CreatedAt            time.Time        `json:"created_at" example:"2026-01-09T10:00:00Z"`
+	UpdatedAt            time.Time        `json:"updated_at" example:"2026-01-09T10:00:00Z"`
 }
 
 // CreateExperienceRequest represents the request body for creating an experience.
 type CreateExperienceRequest struct {
-	Type         string         `json:"type" example:"work"`
-	Title        string         `json:"title" example:"Senior Software Engineer"`
-	Organization string         `json:"organization" example:"Tech Company Inc."`
-	Location     *string        `json:"location,omitempty" example:"Remote"`
-	StartDate    string         `json:"start_date" example:"2022-01-15"`
-	EndDate      *string        `json:"end_date,omitempty" example:"2024-06-30"`
-	IsCurrent    bool           `json:"is_current" example:"false"`
-	Description  *string        `json:"description,omitempty" example:"Led backend development..."`
-	URL          *string        `json:"url,omitempty" example:"https://techcompany.com"`
-	Metadata     map[string]any `json:"metadata,omitempty"`
+	Type                 string         `json:"type" example:"work"`
+	Title                string         `json:"title" example:"Senior Software Engineer"`
+	Organization         string         `json:"organization" example:"Tech Company Inc."`
+	Location             *string        `json:"location,omitempty" example:"Remote"`
+	StartDate            string         `json:"start_date" example:"2022-01-15"`
+	EndDate              *string        `json:"end_date,omitempty" example:"2024-06-30"`
+	IsCurrent            bool           `json:"is_current" example:"false"`
+	Description          *string        `json:"description,omitempty" example:"Led backend development..."`
+	URL                  *string        `json:"url,omitempty" example:"https://techcompany.com"`
+	Metadata             map[string]any `json:"metadata,omitempty"`
+	ExcludeFromTailoring bool           `json:"exclude_from_tailoring,omitempty" example:"false"`
 }
 
 // UpdateExperienceRequest represents the request body for updating an experience.
 type UpdateExperienceRequest struct {
-	Type         *string        `json:"type,omitempty" example:"work"`
-	Title        *string        `json:"title,omitempty" example:"Senior Software Engineer"`
-	Organization *string        `json:"organization,omitempty" example:"Tech Company Inc."`
-	Location     *string        `json:"location,omitempty" example:"Remote"`
-	StartDate    *string        `json:"start_date,omitempty" example:"2022-01-15"`
-	EndDate      *string        `json:"end_date,omitempty" example:"2024-06-30"`
-	IsCurrent    *bool          `json:"is_current,omitempty" example:"false"`
-	Description  *string        `json:"description,omitempty" example:"Led backend development..."`
-	URL          *string        `json:"url,omitempty" example:"https://techcompany.com"`
-	Metadata     map[string]any `json:"metadata,omitempty"`
-	DisplayOrder *int           `json:"display_order,omitempty" example:"1"`
+	Type                 *string        `json:"type,omitempty" example:"work"`
+	Title                *string        `json:"title,omitempty" example:"Senior Software Engineer"`
+	Organization         *string        `json:"organization,omitempty" example:"Tech Company Inc."`
+	Location             *string        `json:"location,omitempty" example:"Remote"`
+	StartDate            *string        `json:"start_date,omitempty" example:"2022-01-15"`
+	EndDate              *string        `json:"end_date,omitempty" example:"2024-06-30"`
+	IsCurrent            *bool          `json:"is_current,omitempty" example:"false"`
+	Description          *string        `json:"description,omitempty" example:"Led backend development..."`
+	URL                  *string        `json:"url,omitempty" example:"https://techcompany.com"`
+	Metadata             map[string]any `json:"metadata,omitempty"`
+	DisplayOrder         *int           `json:"display_order,omitempty" example:"1"`
+	ExcludeFromTailoring *bool          `json:"exclude_from_tailoring,omitempty" example:"true"`
 }
 
 // ListExperiencesResponse represents the paginated list of experiences.
@@ -167,6 +225,93 @@ type ListExperiencesResponse struct {
 	Offset int                  `json:"offset" example:"0"`
 }
 
+// ExperienceV2Response represents an experience in v2 API responses. Unlike
+// ExperienceResponse, absent optional fields are null and empty collections
+// are empty rather than omitted.
+type ExperienceV2Response struct {
+	ID                   string             `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Type                 string             `json:"type" example:"work"`
+	Title                string             `json:"title" example:"Senior Software Engineer"`
+	Organization         string             `json:"organization" example:"Tech Company Inc."`
+	Location             *string            `json:"location" example:"Remote"`
+	StartDate            string             `json:"start_date" example:"2022-01-15"`
+	EndDate              *string            `json:"end_date" example:"2024-06-30"`
+	IsCurrent            bool               `json:"is_current" example:"false"`
+	Description          *string            `json:"description" example:"Led backend development..."`
+	URL                  *string            `json:"url" example:"https://techcompany.com"`
+	Metadata             map[string]any     `json:"metadata"`
+	DisplayOrder         int                `json:"display_order" example:"0"`
+	ExcludeFromTailoring bool               `json:"exclude_from_tailoring" example:"false"`
+	Bullets              []BulletV2Response `json:"bullets"`
+	CreatedAt            time.Time          `json:"created_at" example:"2026-01-09T10:00:00Z"`
+	UpdatedAt            time.Time          `json:"updated_at" example:"2026-01-09T10:00:00Z"`
+}
+
+// BulletV2Response represents a bullet in v2 API responses.
+type BulletV2Response struct {
+	ID           string         `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ExperienceID string         `json:"experience_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Content      string         `json:"content" example:"Reduced API latency by 40%"`
+	ImpactScore  int            `json:"impact_score" example:"75"`
+	Keywords     []string       `json:"keywords" example:"performance,optimization"`
+	Metadata     map[string]any `json:"metadata"`
+	DisplayOrder int            `json:"display_order" example:"0"`
+	CreatedAt    time.Time      `json:"created_at" example:"2026-01-09T10:00:00Z"`
+	UpdatedAt    time.Time      `json:"updated_at" example:"2026-01-09T10:00:00Z"`
+}
+
+// ListExperiencesV2Response represents a page of experiences; its envelope
+// holds NextCursor in meta. NextCursor is null on the last page.
+type ListExperiencesV2Response struct {
+	Data       []ExperienceV2Response `json:"data"`
+	NextCursor *string                `json:"next_cursor" example:"bzo1MA"`
+}
+
+// TimelineEntryResponse represents an experience on the timeline.
+type TimelineEntryResponse struct {
+	ExperienceID string `json:"experience_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Type         string `json:"type" example:"work"`
+	Title        string `json:"title" example:"Senior Software Engineer"`
+	Organization string `json:"organization" example:"Tech Company Inc."`
+	Start        string `json:"start" example:"2022-01"`
+	End          string `json:"end" example:"2024-06"`
+	Months       int    `json:"months" example:"30"`
+	IsCurrent    bool   `json:"is_current" example:"false"`
+	FullTime     bool   `json:"full_time" example:"true"`
+}
+
+// TimelineGapResponse represents a period without any recorded activity.
+type TimelineGapResponse struct {
+	Start   string `json:"start" example:"2024-07"`
+	End     string `json:"end" example:"2024-12"`
+	Months  int    `json:"months" example:"6"`
+	Ongoing bool   `json:"ongoing" example:"false"`
+}
+
+// TimelineOverlapResponse represents two full-time roles held at the same time.
+type TimelineOverlapResponse struct {
+	ExperienceIDs []string `json:"experience_ids"`
+	Start         string   `json:"start" example:"2023-01"`
+	End           string   `json:"end" example:"2023-06"`
+	Months        int      `json:"months" example:"6"`
+}
+
+// TimelineSuggestionResponse represents an actionable timeline hint.
+type TimelineSuggestionResponse struct {
+	Kind          string   `json:"kind" example:"explain_gap"`
+	Message       string   `json:"message" example:"Add an entry for the 6-month gap between Jul 2024 and Dec 2024, such as a sabbatical, studies or freelance work."`
+	ExperienceIDs []string `json:"experience_ids,omitempty"`
+}
+
+// TimelineResponse represents the experience timeline.
+type TimelineResponse struct {
+	GapThresholdMonths int                          `json:"gap_threshold_months" example:"3"`
+	Entries            []TimelineEntryResponse      `json:"entries"`
+	Gaps               []TimelineGapResponse        `json:"gaps"`
+	Overlaps           []TimelineOverlapResponse    `json:"overlaps"`
+	Suggestions        []TimelineSuggestionResponse `json:"suggestions"`
+}
+
 // ===============================
 // Bullet DTOs
 // ===============================
@@ -184,6 +329,12 @@ type BulletResponse struct {
 	UpdatedAt    time.Time      `json:"updated_at" example:"2026-01-09T10:00:00Z"`
 }
 
+// ListBulletsResponse represents a list of bullets.
+type ListBulletsResponse struct {
+	Data  []BulletResponse `json:"data"`
+	Total int              `json:"total" example:"12"`
+}
+
 // CreateBulletRequest represents the request body for creating a bullet.
 type CreateBulletRequest struct {
 	Content      string   `json:"content" example:"Reduced API latency by 40%"`
@@ -224,6 +375,7 @@ type EducationResponse struct {
 	Location     *string   `json:"location,omitempty" example:"Cambridge, MA"`
 	StartDate    *string   `json:"start_date,omitempty" example:"2018-09-01"`
 	EndDate      *string   `json:"end_date,omitempty" example:"2022-05-15"`
+	IsExpected   bool      `json:"is_expected" example:"false"`
 	GPA          *string   `json:"gpa,omitempty" example:"3.85/4.0"`
 	Honors       []string  `json:"honors,omitempty" example:"Magna Cum Laude,Dean's List"`
 	DisplayOrder int       `json:"display_order" example:"0"`
@@ -239,6 +391,7 @@ type CreateEducationRequest struct {
 	Location     *string  `json:"location,omitempty" example:"Cambridge, MA"`
 	StartDate    *string  `json:"start_date,omitempty" example:"2018-09-01"`
 	EndDate      *string  `json:"end_date,omitempty" example:"2022-05-15"`
+	IsExpected   bool     `json:"is_expected,omitempty" example:"false"`
 	GPA          *string  `json:"gpa,omitempty" example:"3.85/4.0"`
 	Honors       []string `json:"honors,omitempty" example:"Magna Cum Laude,Dean's List"`
 	DisplayOrder int      `json:"display_order,omitempty" example:"0"`
@@ -252,6 +405,7 @@ type UpdateEducationRequest struct {
 	Location     *string  `json:"location,omitempty" example:"Cambridge, MA"`
 	StartDate    *string  `json:"start_date,omitempty" example:"2022-09-01"`
 	EndDate      *string  `json:"end_date,omitempty" example:"2024-05-15"`
+	IsExpected   *bool    `json:"is_expected,omitempty" example:"true"`
 	GPA          *string  `json:"gpa,omitempty" example:"3.95/4.0"`
 	Honors       []string `json:"honors,omitempty" example:"Summa Cum Laude"`
 	DisplayOrder *int     `json:"display_order,omitempty" example:"1"`
@@ -263,6 +417,63 @@ type ListEducationResponse struct {
 	Total int                 `json:"total" example:"2"`
 }
 
+// ===============================
+// Academic DTOs
+// ===============================
+
+// AcademicEntryResponse represents an academic CV entry in API responses.
+type AcademicEntryResponse struct {
+	ID           string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Section      string    `json:"section" example:"publications" enums:"publications,grants,teaching,service"`
+	Title        string    `json:"title" example:"Scalable Consensus for Edge Networks"`
+	Organization *string   `json:"organization,omitempty" example:"ACM SIGCOMM 2024"`
+	Authors      []string  `json:"authors" example:"J. Doe,A. Smith"`
+	Amount       *string   `json:"amount,omitempty" example:"USD 250,000"`
+	URL          *string   `json:"url,omitempty" example:"https://doi.org/10.1145/0000000"`
+	Description  *string   `json:"description,omitempty"`
+	StartDate    *string   `json:"start_date,omitempty" example:"2023-09"`
+	EndDate      *string   `json:"end_date,omitempty" example:"2024-08"`
+	DisplayOrder int       `json:"display_order" example:"0"`
+	CreatedAt    time.Time `json:"created_at" example:"2026-01-09T10:00:00Z"`
+	UpdatedAt    time.Time `json:"updated_at" example:"2026-01-09T10:00:00Z"`
+}
+
+// CreateAcademicEntryRequest represents the request body for creating an
+// academic entry.
+type CreateAcademicEntryRequest struct {
+	Section      string   `json:"section" example:"publications" enums:"publications,grants,teaching,service"`
+	Title        string   `json:"title" example:"Scalable Consensus for Edge Networks"`
+	Organization *string  `json:"organization,omitempty" example:"ACM SIGCOMM 2024"`
+	Authors      []string `json:"authors,omitempty" example:"J. Doe,A. Smith"`
+	Amount       *string  `json:"amount,omitempty" example:"USD 250,000"`
+	URL          *string  `json:"url,omitempty" example:"https://doi.org/10.1145/0000000"`
+	Description  *string  `json:"description,omitempty"`
+	StartDate    *string  `json:"start_date,omitempty" example:"2023-09"`
+	EndDate      *string  `json:"end_date,omitempty" example:"2024-08"`
+	DisplayOrder int      `json:"display_order,omitempty" example:"0"`
+}
+
+// UpdateAcademicEntryRequest represents the request body for updating an
+// academic entry. Empty strings clear optional fields.
+type UpdateAcademicEntryRequest struct {
+	Section      *string  `json:"section,omitempty" example:"grants"`
+	Title        *string  `json:"title,omitempty" example:"NSF CAREER Award"`
+	Organization *string  `json:"organization,omitempty" example:"National Science Foundation"`
+	Authors      []string `json:"authors,omitempty" example:"J. Doe"`
+	Amount       *string  `json:"amount,omitempty" example:"USD 500,000"`
+	URL          *string  `json:"url,omitempty"`
+	Description  *string  `json:"description,omitempty"`
+	StartDate    *string  `json:"start_date,omitempty" example:"2024-01"`
+	EndDate      *string  `json:"end_date,omitempty" example:"2029-01"`
+	DisplayOrder *int     `json:"display_order,omitempty" example:"1"`
+}
+
+// ListAcademicEntriesResponse represents the list of academic entries.
+type ListAcademicEntriesResponse struct {
+	Data  []AcademicEntryResponse `json:"data"`
+	Total int                     `json:"total" example:"5"`
+}
+
 // ===============================
 // Project DTOs
 // ===============================
@@ -403,20 +614,87 @@ type ListSpokenLanguagesResponse struct {
 
 // ResumeResponse represents a resume in API responses.
 type ResumeResponse struct {
-	ID               string            `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	JobTitle         string            `json:"job_title,omitempty" example:"Senior Backend Engineer"`
-	CompanyName      string            `json:"company_name,omitempty" example:"Awesome Corp"`
-	JobURL           string            `json:"job_url,omitempty" example:"https://linkedin.com/jobs/..."`
-	JobDescription   string            `json:"job_description,omitempty"`
-	TargetLanguage   string            `json:"target_language" example:"en"`
-	SelectedBullets  []string          `json:"selected_bullets,omitempty"`
-	GeneratedContent *ResumeContentDTO `json:"generated_content,omitempty"`
-	PDFURL           string            `json:"pdf_url,omitempty" example:"https://storage.../resume.pdf"`
-	Score            int               `json:"score" example:"85"`
-	Notes            string            `json:"notes,omitempty"`
-	Status           string            `json:"status" example:"draft"`
-	CreatedAt        time.Time         `json:"created_at" example:"2026-01-09T10:00:00Z"`
-	UpdatedAt        time.Time         `json:"updated_at" example:"2026-01-09T10:00:00Z"`
+	ID                    string                   `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	JobTitle              string                   `json:"job_title,omitempty" example:"Senior Backend Engineer"`
+	CompanyName           string                   `json:"company_name,omitempty" example:"Awesome Corp"`
+	JobURL                string                   `json:"job_url,omitempty" example:"https://linkedin.com/jobs/..."`
+	JobDescription        string                   `json:"job_description,omitempty"`
+	JobInsights           *JobInsightsDTO          `json:"job_insights,omitempty"`
+	TargetLanguage        string                   `json:"target_language" example:"en"`
+	SelectedBullets       []string                 `json:"selected_bullets,omitempty"`
+	Selection             *SelectionExplanationDTO `json:"selection,omitempty"`
+	GeneratedContent      *ResumeContentDTO        `json:"generated_content,omitempty"`
+	PDFURL                string                   `json:"pdf_url,omitempty" example:"https://storage.../resume.pdf"`
+	Score                 int                      `json:"score" example:"85"`
+	Notes                 string                   `json:"notes,omitempty"`
+	QRCode                string                   `json:"qr_code,omitempty" example:"portfolio"`
+	ContactPriority       []string                 `json:"contact_priority,omitempty" example:"email,phone,github"`
+	Sections              SectionConfigDTO         `json:"sections"`
+	MaxPages              int                      `json:"max_pages" example:"1"`
+	IncludedExperienceIDs []string                 `json:"included_experience_ids,omitempty"`
+	ExcludedExperienceIDs []string                 `json:"excluded_experience_ids,omitempty"`
+	TagIDs                []string                 `json:"tag_ids,omitempty"`
+	Status                string                   `json:"status" example:"draft"`
+	Archived              bool                     `json:"archived" example:"false"`
+	ArchivedAt            *time.Time               `json:"archived_at,omitempty" example:"2026-03-01T10:00:00Z"`
+	Pinned                bool                     `json:"pinned" example:"false"`
+	LastAccessedAt        *time.Time               `json:"last_accessed_at,omitempty" example:"2026-03-01T10:00:00Z"`
+	CreatedAt             time.Time                `json:"created_at" example:"2026-01-09T10:00:00Z"`
+	UpdatedAt             time.Time                `json:"updated_at" example:"2026-01-09T10:00:00Z"`
+
+	// DetectedLanguage is the language detected in the job description,
+	// such as "pt"; only returned on creation.
+	DetectedLanguage string `json:"detected_language,omitempty" example:"en"`
+}
+
+// JobInsightsDTO represents the salary, benefits, remote policy and visa
+// details found in a job description. Missing fields were not mentioned.
+type JobInsightsDTO struct {
+	Salary          *SalaryRangeDTO `json:"salary,omitempty"`
+	Benefits        []string        `json:"benefits" example:"Health insurance,Stock options"`
+	RemotePolicy    string          `json:"remote_policy,omitempty" example:"hybrid" enums:"remote,hybrid,onsite"`
+	VisaSponsorship *bool           `json:"visa_sponsorship,omitempty" example:"true"`
+}
+
+// SelectionExplanationDTO explains the bullets chosen when a resume was last
+// tailored.
+type SelectionExplanationDTO struct {
+	Reasoning string              `json:"reasoning" example:"Prioritized distributed systems work matching the required skills"`
+	Bullets   []BulletDecisionDTO `json:"bullets"`
+
+	// Validation summarizes how the AI rewrites of the selected bullets fared
+	// in validation; absent for resumes tailored before bullets were
+	// validated.
+	Validation *TailorValidationDTO `json:"validation,omitempty"`
+}
+
+// TailorValidationDTO counts the bullets whose rewrites were validated,
+// rewritten again after failing, and rejected for failing again, and those
+// whose opening verb was put in the tense of their role.
+type TailorValidationDTO struct {
+	Checked    int `json:"checked" example:"5"`
+	Retried    int `json:"retried" example:"1"`
+	Rejected   int `json:"rejected" example:"0"`
+	TenseFixed int `json:"tense_fixed" example:"2"`
+}
+
+// BulletDecisionDTO represents what tailoring did with a bullet and why.
+type BulletDecisionDTO struct {
+	BulletID string `json:"bullet_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Outcome  string `json:"outcome" example:"selected" enums:"selected,dropped,not_ranked,excluded"`
+	Reason   string `json:"reason,omitempty" example:"Shows Kubernetes experience the job requires"`
+
+	// Rejected lists why the AI rewrite of a selected bullet was rejected,
+	// leaving its original content.
+	Rejected []string `json:"rejected,omitempty" example:"invented_number" enums:"empty,too_long,first_person,profanity,implausible_claim,missing_keyword,invented_number"`
+}
+
+// SalaryRangeDTO represents an advertised salary range.
+type SalaryRangeDTO struct {
+	Min      *int   `json:"min,omitempty" example:"90000"`
+	Max      *int   `json:"max,omitempty" example:"120000"`
+	Currency string `json:"currency,omitempty" example:"USD"`
+	Period   string `json:"period" example:"year" enums:"year,month,hour"`
 }
 
 // ResumeContentDTO represents the AI-generated resume content.
@@ -425,6 +703,54 @@ type ResumeContentDTO struct {
 	Experiences []TailoredExperienceDTO `json:"experiences"`
 	Skills      []string                `json:"skills"`
 	Analysis    *ResumeAnalysisDTO      `json:"analysis,omitempty"`
+
+	// SourceLanguage is the language of tailored_content when bullets were
+	// translated to the target language.
+	SourceLanguage string `json:"source_language,omitempty" example:"pt-br"`
+
+	// Themes group the bullets by skill for the functional template.
+	Themes []SkillThemeDTO `json:"themes,omitempty"`
+
+	// SummaryAngle is the angle of the summary variant picked as summary;
+	// the variants are listed by GET /resumes/{id}/summaries.
+	SummaryAngle string `json:"summary_angle,omitempty" example:"technical"`
+
+	// Tone and Persona are the voice the content was tailored with;
+	// omitted for the defaults.
+	Tone    string `json:"tone,omitempty" example:"confident"`
+	Persona string `json:"persona,omitempty" example:"technical_hiring_manager"`
+
+	// AISettings are the settings the content was tailored with; POST
+	// /resumes/{id}/retailor reuses them.
+	AISettings *AIRunSettingsDTO `json:"ai_settings,omitempty"`
+
+	// SummaryMetrics and the metrics of each bullet are returned with single
+	// resumes, estimated at metrics_font_size.
+	SummaryMetrics  *TextMetricsDTO `json:"summary_metrics,omitempty"`
+	MetricsFontSize int             `json:"metrics_font_size,omitempty" example:"11"`
+}
+
+// TextMetricsDTO describes a piece of tailored content, to guide manual
+// edits toward a one-page resume.
+type TextMetricsDTO struct {
+	Characters int `json:"characters" example:"142"`
+
+	// Lines estimates the lines the text takes on the rendered page.
+	Lines int `json:"lines" example:"2"`
+
+	// ReadingEase is the Flesch reading ease, about 0 (very hard) to 100
+	// (very easy).
+	ReadingEase float64 `json:"reading_ease" example:"38.5"`
+
+	// ActionVerb is the action verb the text opens with; omitted when it
+	// does not open with one.
+	ActionVerb string `json:"action_verb,omitempty" example:"Led"`
+}
+
+// SkillThemeDTO represents a skill theme of a functional resume.
+type SkillThemeDTO struct {
+	Name      string   `json:"name" example:"Data Analysis"`
+	BulletIDs []string `json:"bullet_ids" example:"550e8400-e29b-41d4-a716-446655440000"`
 }
 
 // TailoredExperienceDTO represents a tailored experience entry.
@@ -443,6 +769,47 @@ type TailoredBulletDTO struct {
 	BulletID        string `json:"bullet_id"`
 	OriginalContent string `json:"original_content"`
 	TailoredContent string `json:"tailored_content"`
+
+	// TranslatedContent is the tailored content in the target language, as
+	// rendered on the resume; omitted when no translation was needed.
+	TranslatedContent string `json:"translated_content,omitempty"`
+
+	// NeedsReview is set when the rewrite has terms not found in the
+	// profile, listed in unverified_terms; the user must confirm the bullet
+	// before the resume can be marked reviewed.
+	NeedsReview     bool     `json:"needs_review,omitempty" example:"true"`
+	UnverifiedTerms []string `json:"unverified_terms,omitempty" example:"Kubernetes,45%"`
+
+	Metrics *TextMetricsDTO `json:"metrics,omitempty"`
+}
+
+// ConfirmBulletsRequest lists tailored bullets the user checked.
+type ConfirmBulletsRequest struct {
+	BulletIDs []string `json:"bullet_ids" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// SummaryVariantDTO represents a professional summary written from one
+// angle.
+type SummaryVariantDTO struct {
+	Angle   string `json:"angle" example:"leadership" enums:"technical,leadership,impact"`
+	Summary string `json:"summary" example:"Backend engineer leading **payments** teams for **7+ years**."`
+
+	// Active reports whether the variant is the resume's summary.
+	Active bool `json:"active" example:"false"`
+}
+
+// SummaryVariantsResponse lists the summary variants of a resume.
+type SummaryVariantsResponse struct {
+	// Summary is the resume's summary, which differs from the active
+	// variant's after edits.
+	Summary  string              `json:"summary" example:"Backend engineer with **7+ years** building payment systems."`
+	Variants []SummaryVariantDTO `json:"variants"`
+}
+
+// SelectSummaryRequest represents the summary variant to make a resume's
+// summary.
+type SelectSummaryRequest struct {
+	Angle string `json:"angle" example:"leadership" enums:"technical,leadership,impact"`
 }
 
 // ResumeAnalysisDTO contains the AI analysis of how well the resume matches.
@@ -476,7 +843,17 @@ type CreateResumeRequest struct {
 
 // TailorResumeRequest represents the request for tailoring a resume.
 type TailorResumeRequest struct {
-	MaxBulletsPerJob int `json:"max_bullets_per_job,omitempty" example:"15"`
+	MaxBulletsPerJob         int  `json:"max_bullets_per_job,omitempty" example:"15"`
+	IncludeHighlightedSkills bool `json:"include_highlighted_skills,omitempty" example:"true"`
+
+	// Tone and Persona set the voice of the summary and bullets; empty
+	// keeps the defaults.
+	Tone    string `json:"tone,omitempty" example:"confident" enums:"confident,humble,energetic"`
+	Persona string `json:"persona,omitempty" example:"technical_hiring_manager" enums:"technical_hiring_manager,hr_screener"`
+
+	// Seed is given to the model with every AI call, for a run that can be
+	// reproduced; omit it to leave the calls unseeded.
+	Seed *int64 `json:"seed,omitempty" example:"20260115"`
 }
 
 // TailorResumeResponse represents the response after tailoring a resume.
@@ -489,6 +866,35 @@ type TailorResumeResponse struct {
 	Analysis         *TailorAnalysis `json:"analysis,omitempty"`
 }
 
+// AICallEstimateResponse represents the estimated AI calls of one operation
+// of a tailoring run.
+type AICallEstimateResponse struct {
+	Operation    string   `json:"operation" example:"tailor_bullet"`
+	Model        string   `json:"model" example:"llama-3.3-70b-versatile"`
+	Count        int      `json:"count" example:"10"`
+	InputTokens  int      `json:"input_tokens" example:"5400"`
+	OutputTokens int      `json:"output_tokens" example:"600"`
+	CostUSD      *float64 `json:"cost_usd,omitempty" example:"0.0037"`
+}
+
+// TailorEstimateResponse represents the approximate tokens and cost of
+// tailoring a resume, with the user's tailoring quota.
+type TailorEstimateResponse struct {
+	Bullets      int                      `json:"bullets" example:"42"`
+	MaxBullets   int                      `json:"max_bullets" example:"10"`
+	Calls        []AICallEstimateResponse `json:"calls"`
+	InputTokens  int                      `json:"input_tokens" example:"11200"`
+	OutputTokens int                      `json:"output_tokens" example:"1900"`
+
+	// CostUSD is omitted when the price of a model is not configured.
+	CostUSD *float64 `json:"cost_usd,omitempty" example:"0.0061"`
+
+	// WithinQuota is false when tailoring would exceed the plan's limit;
+	// Quota is omitted when plan limits are off.
+	WithinQuota bool           `json:"within_quota" example:"true"`
+	Quota       *QuotaResponse `json:"quota,omitempty"`
+}
+
 // TailorAnalysis contains the analysis result from tailoring.
 type TailorAnalysis struct {
 	MatchedKeywords []string `json:"matched_keywords" example:"golang,microservices"`
@@ -497,9 +903,31 @@ type TailorAnalysis struct {
 }
 
 // UpdateResumeContentRequest represents the request for updating resume content.
+// At least one of status, notes, qr_code, contact_priority, sections,
+// max_pages, included_experience_ids and excluded_experience_ids is required.
 type UpdateResumeContentRequest struct {
-	Status string  `json:"status" example:"reviewed"`
-	Notes  *string `json:"notes,omitempty" example:"Made adjustments to summary"`
+	Status                string            `json:"status,omitempty" example:"reviewed"`
+	Notes                 *string           `json:"notes,omitempty" example:"Made adjustments to summary"`
+	QRCode                *string           `json:"qr_code,omitempty" example:"portfolio"`
+	ContactPriority       []string          `json:"contact_priority,omitempty" example:"email,phone,github"`
+	Sections              *SectionConfigDTO `json:"sections,omitempty"`
+	MaxPages              *int              `json:"max_pages,omitempty" example:"2"`
+	IncludedExperienceIDs []string          `json:"included_experience_ids,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ExcludedExperienceIDs []string          `json:"excluded_experience_ids,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+}
+
+// SectionConfigDTO represents a resume's section display options.
+type SectionConfigDTO struct {
+	SkillsDisplay string `json:"skills_display,omitempty" example:"bars"`
+}
+
+// PreviewResumeHTMLRequest represents unsaved edits to preview on a resume.
+type PreviewResumeHTMLRequest struct {
+	Summary      *string           `json:"summary,omitempty" example:"Backend engineer with 6 years of Go."`
+	Bullets      map[string]string `json:"bullets,omitempty"`
+	FontSize     *int              `json:"font_size,omitempty" example:"10"`
+	SectionOrder []string          `json:"section_order,omitempty" example:"summary,experience,skills,education"`
+	Density      string            `json:"density,omitempty" example:"compact"`
 }
 
 // ListResumesResponse represents the paginated list of resumes.
@@ -510,6 +938,333 @@ type ListResumesResponse struct {
 	Offset int              `json:"offset" example:"0"`
 }
 
+// BulkResumesRequest represents the request for applying an action to many
+// resumes. Status is required for change_status and tag_id for add_tag.
+type BulkResumesRequest struct {
+	Action        string   `json:"action" example:"archive" enums:"delete,archive,unarchive,change_status,add_tag"`
+	ResumeIDs     []string `json:"resume_ids" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status        string   `json:"status,omitempty" example:"rejected"`
+	TagID         string   `json:"tag_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Transactional bool     `json:"transactional,omitempty" example:"true"`
+}
+
+// BulkResumeResultDTO represents the outcome of a bulk action for one resume:
+// applied, failed with an error, or aborted because a transactional action
+// failed for another resume.
+type BulkResumeResultDTO struct {
+	ResumeID string     `json:"resume_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status   string     `json:"status" example:"applied" enums:"applied,failed,aborted"`
+	Error    *ErrorBody `json:"error,omitempty"`
+}
+
+// BulkResumesResponse represents the outcome of a bulk action, in the order
+// of the requested resumes.
+type BulkResumesResponse struct {
+	Data    []BulkResumeResultDTO `json:"data"`
+	Applied int                   `json:"applied" example:"9"`
+	Failed  int                   `json:"failed" example:"1"`
+}
+
+// SuggestedEditDTO represents an edit proposed by a resume critique.
+type SuggestedEditDTO struct {
+	Section   string `json:"section" example:"summary"`
+	Original  string `json:"original,omitempty" example:"Backend engineer with Go experience."`
+	Suggested string `json:"suggested" example:"Backend engineer with **6 years** of Go, leading a team of 4."`
+	Reason    string `json:"reason" example:"The role asks for leadership experience."`
+}
+
+// ResumeCritiqueResponse represents a hiring-manager review of a resume.
+type ResumeCritiqueResponse struct {
+	ID             string             `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ResumeID       string             `json:"resume_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	ContentHash    string             `json:"content_hash" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	Score          int                `json:"score" example:"72"`
+	Verdict        string             `json:"verdict" example:"interview" enums:"interview,maybe,reject"`
+	Summary        string             `json:"summary" example:"Strong backend profile, but leadership is not evidenced."`
+	Strengths      []string           `json:"strengths"`
+	Weaknesses     []string           `json:"weaknesses"`
+	RedFlags       []string           `json:"red_flags"`
+	SuggestedEdits []SuggestedEditDTO `json:"suggested_edits"`
+	CreatedAt      time.Time          `json:"created_at" example:"2026-01-09T10:00:00Z"`
+}
+
+// ListResumeCritiquesResponse represents a resume's critiques, newest first.
+type ListResumeCritiquesResponse struct {
+	Data []ResumeCritiqueResponse `json:"data"`
+}
+
+// BulletFeedbackDTO represents a user's verdict on one tailored bullet.
+type BulletFeedbackDTO struct {
+	BulletID      string  `json:"bullet_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Verdict       string  `json:"verdict" example:"edited" enums:"accepted,edited,rejected"`
+	EditedContent *string `json:"edited_content,omitempty" example:"Cut deploy time by **40%** by moving CI to Go"`
+	Comment       *string `json:"comment,omitempty" example:"Too many buzzwords"`
+}
+
+// SubmitBulletFeedbackRequest represents feedback on a resume's tailored bullets.
+type SubmitBulletFeedbackRequest struct {
+	Bullets []BulletFeedbackDTO `json:"bullets"`
+}
+
+// BulletFeedbackResponse represents stored feedback on a tailored bullet.
+type BulletFeedbackResponse struct {
+	BulletID        string    `json:"bullet_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Verdict         string    `json:"verdict" example:"edited" enums:"accepted,edited,rejected"`
+	TailoredContent string    `json:"tailored_content" example:"Leveraged cutting-edge tooling to improve deployments"`
+	EditedContent   *string   `json:"edited_content,omitempty" example:"Cut deploy time by **40%** by moving CI to Go"`
+	Comment         *string   `json:"comment,omitempty" example:"Too many buzzwords"`
+	UpdatedAt       time.Time `json:"updated_at" example:"2026-01-09T10:00:00Z"`
+}
+
+// ListBulletFeedbackResponse represents a resume's bullet feedback, most
+// recently updated first.
+type ListBulletFeedbackResponse struct {
+	Data []BulletFeedbackResponse `json:"data"`
+}
+
+// ResumeActivityResponse represents an entry of a resume's activity log.
+type ResumeActivityResponse struct {
+	ID         string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Type       string    `json:"type" example:"status_change" enums:"note,status_change,pdf_generated,ai_run"`
+	Note       string    `json:"note,omitempty" example:"Recruiter call scheduled for Monday"`
+	FromStatus string    `json:"from_status,omitempty" example:"reviewed"`
+	ToStatus   string    `json:"to_status,omitempty" example:"submitted"`
+	Detail     string    `json:"detail,omitempty" example:"tailor"`
+	CreatedAt  time.Time `json:"created_at" example:"2026-01-09T10:00:00Z"`
+
+	// AISettings are the settings an ai_run was made with.
+	AISettings *AIRunSettingsDTO `json:"ai_settings,omitempty"`
+}
+
+// ListResumeActivityResponse represents a resume's activity log, oldest first.
+type ListResumeActivityResponse struct {
+	Data []ResumeActivityResponse `json:"data"`
+}
+
+// DiffSegmentResponse represents a run of words a rewrite kept, removed or
+// added.
+type DiffSegmentResponse struct {
+	Op   string `json:"op" example:"insert" enums:"equal,delete,insert"`
+	Text string `json:"text" example:"by 40% "`
+}
+
+// ContentDiffResponse represents the word-level changes between an original
+// text and its tailored version.
+type ContentDiffResponse struct {
+	Original string                `json:"original" example:"Cut latency with caching"`
+	Tailored string                `json:"tailored" example:"Cut p99 latency by 40% with caching"`
+	Changed  bool                  `json:"changed" example:"true"`
+	Segments []DiffSegmentResponse `json:"segments"`
+}
+
+// BulletDiffResponse represents the changes tailoring made to a bullet.
+type BulletDiffResponse struct {
+	BulletID     string `json:"bullet_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ExperienceID string `json:"experience_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	ContentDiffResponse
+}
+
+// ResumeDiffResponse represents what tailoring changed in a resume.
+type ResumeDiffResponse struct {
+	ResumeID string               `json:"resume_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Summary  ContentDiffResponse  `json:"summary"`
+	Bullets  []BulletDiffResponse `json:"bullets"`
+}
+
+// FitCheckResponse estimates how much of the page a resume fills with a
+// template, font size and density. Heights are in lines of body text.
+type FitCheckResponse struct {
+	Template string `json:"template" example:"jake"`
+	FontSize int    `json:"font_size" example:"11"`
+	Density  string `json:"density" example:"comfortable"`
+
+	// MaxPages is the number of pages the PDF keeps; 0 means no limit.
+	MaxPages       int                `json:"max_pages" example:"1"`
+	Lines          int                `json:"lines" example:"46"`
+	LinesPerPage   int                `json:"lines_per_page" example:"42"`
+	EstimatedPages float64            `json:"estimated_pages" example:"1.1"`
+	Fits           bool               `json:"fits" example:"false"`
+	OverflowLines  int                `json:"overflow_lines" example:"4"`
+	Sections       []SectionFitResult `json:"sections"`
+}
+
+// SectionFitResult is the estimated height of one section.
+type SectionFitResult struct {
+	Name  string `json:"name" example:"experience"`
+	Lines int    `json:"lines" example:"28"`
+}
+
+// FitResumeRequest represents the rendering a resume is trimmed to fit.
+// Empty fields keep the user's preferences.
+type FitResumeRequest struct {
+	Template string `json:"template,omitempty" example:"jake"`
+	FontSize *int   `json:"font_size,omitempty" example:"11"`
+	Density  string `json:"density,omitempty" example:"comfortable"`
+}
+
+// FitResumeResponse represents a resume after trimming its content to fit
+// its page limit.
+type FitResumeResponse struct {
+	Resume ResumeResponse   `json:"resume"`
+	Before FitCheckResponse `json:"before"`
+	After  FitCheckResponse `json:"after"`
+
+	// Shortened lists the IDs of the shortened bullets, and "summary" when
+	// the summary was shortened.
+	Shortened []string `json:"shortened" example:"550e8400-e29b-41d4-a716-446655440000,summary"`
+	Rounds    int      `json:"rounds" example:"1"`
+}
+
+// AICallSettingsDTO represents the settings of the calls an AI run made for
+// one operation.
+type AICallSettingsDTO struct {
+	Operation     string  `json:"operation" example:"tailor_bullet"`
+	PromptVersion string  `json:"prompt_version" example:"v1"`
+	Model         string  `json:"model" example:"llama-3.3-70b-versatile"`
+	Temperature   float64 `json:"temperature" example:"0.7"`
+	Count         int     `json:"count" example:"8"`
+}
+
+// AIRunSettingsDTO represents the settings an AI run was made with.
+type AIRunSettingsDTO struct {
+	Seed                     *int64              `json:"seed,omitempty" example:"20260115"`
+	Calls                    []AICallSettingsDTO `json:"calls"`
+	MaxBullets               int                 `json:"max_bullets,omitempty" example:"10"`
+	IncludeHighlightedSkills bool                `json:"include_highlighted_skills,omitempty" example:"true"`
+}
+
+// RetailorResumeResponse represents a resume tailored again, with how the
+// settings of the run differ from the previous run's.
+type RetailorResumeResponse struct {
+	Resume           ResumeResponse    `json:"resume"`
+	PreviousSettings *AIRunSettingsDTO `json:"previous_settings,omitempty"`
+	Differences      []string          `json:"differences" example:"tailor_bullet prompt: v1 -> v2"`
+}
+
+// RegenerateSectionRequest represents the user's instructions for
+// regenerating a resume section.
+type RegenerateSectionRequest struct {
+	Instructions string `json:"instructions,omitempty" example:"Mention the on-call rotation"`
+}
+
+// ResumeTagRequest represents the request for creating or renaming a resume tag.
+type ResumeTagRequest struct {
+	Name string `json:"name" example:"Dream companies"`
+}
+
+// AssignResumeTagRequest represents the request for adding a tag to and
+// removing it from many resumes at once.
+type AssignResumeTagRequest struct {
+	Add    []string `json:"add,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Remove []string `json:"remove,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+}
+
+// ResumeTagResponse represents a user-defined resume tag.
+type ResumeTagResponse struct {
+	ID          string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name        string    `json:"name" example:"Dream companies"`
+	ResumeCount int       `json:"resume_count" example:"3"`
+	CreatedAt   time.Time `json:"created_at" example:"2026-01-09T10:00:00Z"`
+	UpdatedAt   time.Time `json:"updated_at" example:"2026-01-09T10:00:00Z"`
+}
+
+// ListResumeTagsResponse represents a user's resume tags, by name.
+type ListResumeTagsResponse struct {
+	Data []ResumeTagResponse `json:"data"`
+}
+
+// ResumeSearchDTO represents the filters of a resume list search. The names
+// match the query parameters of GET /v1/resumes; lists are arrays instead
+// of comma-separated values.
+type ResumeSearchDTO struct {
+	Status          string   `json:"status,omitempty" example:"submitted"`
+	Remote          []string `json:"remote,omitempty" example:"remote"`
+	MinSalary       *int     `json:"min_salary,omitempty" example:"120000"`
+	Currency        string   `json:"currency,omitempty" example:"USD"`
+	VisaSponsorship *bool    `json:"visa_sponsorship,omitempty" example:"true"`
+	Tag             []string `json:"tag,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ScoreMin        *int     `json:"score_min,omitempty" example:"80"`
+	Company         string   `json:"company,omitempty" example:"acme"`
+	CreatedAfter    string   `json:"created_after,omitempty" example:"2025-01-01"`
+	CreatedBefore   string   `json:"created_before,omitempty" example:"2025-03-31"`
+	Language        string   `json:"language,omitempty" example:"pt"`
+	Archived        *bool    `json:"archived,omitempty" example:"false"`
+	IncludeArchived bool     `json:"include_archived,omitempty" example:"true"`
+	Sort            string   `json:"sort,omitempty" example:"pinned_first" enums:"newest,recent,pinned_first"`
+}
+
+// SavedResumeFilterRequest represents the request for saving or replacing a
+// named resume search.
+type SavedResumeFilterRequest struct {
+	Name   string          `json:"name" example:"Strong matches this quarter"`
+	Search ResumeSearchDTO `json:"search"`
+}
+
+// SavedResumeFilterResponse represents a named resume search.
+type SavedResumeFilterResponse struct {
+	ID        string          `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name      string          `json:"name" example:"Strong matches this quarter"`
+	Search    ResumeSearchDTO `json:"search"`
+	CreatedAt time.Time       `json:"created_at" example:"2026-01-09T10:00:00Z"`
+	UpdatedAt time.Time       `json:"updated_at" example:"2026-01-09T10:00:00Z"`
+}
+
+// ListSavedResumeFiltersResponse represents a user's saved filters, by name.
+type ListSavedResumeFiltersResponse struct {
+	Data []SavedResumeFilterResponse `json:"data"`
+}
+
+// ===============================
+// Suggest DTOs
+// ===============================
+
+// SuggestionResponse represents a type-ahead suggestion.
+type SuggestionResponse struct {
+	Type  string `json:"type" example:"company"`
+	Value string `json:"value" example:"Acme Corp"`
+	ID    string `json:"id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// SuggestResponse represents type-ahead suggestions, grouped by type.
+type SuggestResponse struct {
+	Data []SuggestionResponse `json:"data"`
+}
+
+// ===============================
+// Admin DTOs
+// ===============================
+
+// StoredFileResponse represents a stored file.
+type StoredFileResponse struct {
+	Key       string    `json:"key" example:"resumes/550e8400-e29b-41d4-a716-446655440000/550e8400-e29b-41d4-a716-446655440001.pdf"`
+	Size      int64     `json:"size" example:"48213"`
+	UpdatedAt time.Time `json:"updated_at" example:"2026-01-09T10:00:00Z"`
+}
+
+// StorageOrphansResponse represents the stored files left behind by deleted
+// resumes.
+type StorageOrphansResponse struct {
+	Files            []StoredFileResponse `json:"files"`
+	Count            int                  `json:"count" example:"3"`
+	ReclaimableBytes int64                `json:"reclaimable_bytes" example:"144639"`
+}
+
+// ImpersonateRequest represents the request for impersonating a user.
+type ImpersonateRequest struct {
+	// Scope is read (GET requests only, the default) or full.
+	Scope string `json:"scope,omitempty" example:"read" enums:"read,full"`
+}
+
+// ImpersonationResponse represents an issued impersonation token. It is sent
+// as a Bearer token like the tokens of the identity provider.
+type ImpersonationResponse struct {
+	Token           string    `json:"token" example:"imp.NTUwZTg0MDAt...."`
+	ImpersonationID string    `json:"impersonation_id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	UserID          string    `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Scope           string    `json:"scope" example:"read"`
+	ExpiresAt       time.Time `json:"expires_at" example:"2026-03-01T10:15:00Z"`
+}
+
 // ===============================
 // Tools DTOs
 // ===============================
@@ -533,13 +1288,98 @@ type ParseJobMetadata struct {
 	FetchedAt time.Time `json:"fetched_at" example:"2026-01-09T10:00:00Z"`
 }
 
+// ===============================
+// Import DTOs
+// ===============================
+
+// ImportCSVResponse represents the result of a CSV import.
+type ImportCSVResponse struct {
+	DryRun      bool                 `json:"dry_run" example:"false"`
+	Experiences int                  `json:"experiences" example:"4"`
+	Bullets     int                  `json:"bullets" example:"17"`
+	Data        []ExperienceResponse `json:"data"`
+}
+
+// ImportUploadResponse represents a started chunked upload and its limits.
+type ImportUploadResponse struct {
+	UploadID     string `json:"upload_id" example:"Q4ZJ7XKD2M5NB6WVRHT3ACYE2L"`
+	MaxChunkSize int64  `json:"max_chunk_size" example:"1048576"`
+	MaxChunks    int    `json:"max_chunks" example:"100"`
+	MaxFileSize  int64  `json:"max_file_size" example:"5242880"`
+}
+
+// ===============================
+// Quota DTOs
+// ===============================
+
+// QuotaResponse represents the usage of one metered action in the current period.
+type QuotaResponse struct {
+	Action    string    `json:"action" example:"tailor"`
+	Period    string    `json:"period" example:"day"`
+	Limit     int       `json:"limit" example:"20"`
+	Used      int       `json:"used" example:"3"`
+	Remaining int       `json:"remaining" example:"17"`
+	ResetsAt  time.Time `json:"resets_at" example:"2026-01-10T00:00:00Z"`
+}
+
+// UserLimitsResponse represents the user's plan and remaining quota.
+type UserLimitsResponse struct {
+	Plan   string          `json:"plan" example:"free"`
+	Quotas []QuotaResponse `json:"quotas"`
+}
+
+// ===============================
+// Preferences DTOs
+// ===============================
+
+// PreferencesResponse represents a user's preferences in API responses.
+type PreferencesResponse struct {
+	DefaultTemplate       string    `json:"default_template" example:"jake"`
+	DefaultTargetLanguage string    `json:"default_target_language" example:"en"`
+	DefaultMaxBullets     int       `json:"default_max_bullets" example:"15"`
+	DefaultFontSize       int       `json:"default_font_size" example:"0"`
+	DateFormat            string    `json:"date_format" example:"locale"`
+	Timezone              string    `json:"timezone" example:"UTC"`
+	FilenamePattern       string    `json:"filename_pattern" example:"{name}_Resume_{target}"`
+	EmailProductUpdates   bool      `json:"email_product_updates" example:"false"`
+	EmailUsageAlerts      bool      `json:"email_usage_alerts" example:"false"`
+	UpdatedAt             time.Time `json:"updated_at" example:"2026-01-01T00:00:00Z"`
+}
+
+// UpdatePreferencesRequest represents the request to update preferences.
+type UpdatePreferencesRequest struct {
+	DefaultTemplate       *string `json:"default_template,omitempty" example:"europass"`
+	DefaultTargetLanguage *string `json:"default_target_language,omitempty" example:"pt-br"`
+	DefaultMaxBullets     *int    `json:"default_max_bullets,omitempty" example:"12"`
+	DefaultFontSize       *int    `json:"default_font_size,omitempty" example:"11"`
+	DateFormat            *string `json:"date_format,omitempty" example:"mm/yyyy"`
+	Timezone              *string `json:"timezone,omitempty" example:"America/Sao_Paulo"`
+	FilenamePattern       *string `json:"filename_pattern,omitempty" example:"{name}_{company}_{date}"`
+	EmailProductUpdates   *bool   `json:"email_product_updates,omitempty" example:"true"`
+	EmailUsageAlerts      *bool   `json:"email_usage_alerts,omitempty" example:"true"`
+}
+
 // ===============================
 // Helper Functions
 // ===============================
 
-// respondJSON writes a JSON response with the given status code.
+// respondJSON writes a JSON response with the given status code, in the
+// format chosen for the route or request: in an Envelope for enveloped
+// routes, and errors as problem details for clients accepting them.
 func respondJSON(w http.ResponseWriter, status int, data any) {
-	w.Header().Set("Content-Type", "application/json")
+	contentType := "application/json"
+	if format := responseFormat(w); format != nil && data != nil {
+		errResp, isError := data.(ErrorResponse)
+		switch {
+		case isError && format.problem:
+			contentType = problemContentType
+			data = problem(status, errResp.Error, format.instance)
+		case format.envelope:
+			data = envelope(data)
+		}
+	}
+
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(status)
 	if data != nil {
 		_ = json.NewEncoder(w).Encode(data)
@@ -567,6 +1407,24 @@ func respondErrorWithDetails(w http.ResponseWriter, status int, code, message st
 	})
 }
 
+// respondDecodeError writes the response to a request body decodeJSON
+// failed to decode: 413 when it is over the body limit of its route,
+// 400 otherwise.
+func respondDecodeError(w http.ResponseWriter, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		respondBodyTooLarge(w, maxBytesErr.Limit)
+		return
+	}
+	respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+}
+
+// respondBodyTooLarge writes the response to a request body over limit bytes.
+func respondBodyTooLarge(w http.ResponseWriter, limit int64) {
+	respondError(w, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE",
+		fmt.Sprintf("Request body exceeds the limit of %d bytes", limit))
+}
+
 // decodeJSON decodes a JSON request body into the target struct.
 func decodeJSON(r *http.Request, target any) error {
 	if r.Body == nil {
@@ -66,7 +66,7 @@ func (h *EducationHandler) List(w http.ResponseWriter, r *http.Request) {
 // Create creates a new education entry.
 //
 //	@Summary		Create education entry
-//	@Description	Creates a new education entry for the authenticated user
+//	@Description	Creates a new education entry for the authenticated user. Dates are YYYY-MM-DD or YYYY-MM; set is_expected when end_date is an expected graduation date, rendered as Expected Jun 2025 on resumes.
 //	@Tags			education
 //	@Accept			json
 //	@Produce		json
@@ -87,7 +87,7 @@ func (h *EducationHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	var req CreateEducationRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
@@ -106,7 +106,7 @@ func (h *EducationHandler) Create(w http.ResponseWriter, r *http.Request) {
 	if req.StartDate != nil {
 		d, err := domain.ParseDate(*req.StartDate)
 		if err != nil {
-			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid start_date format")
+			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid start_date format, expected YYYY-MM-DD or YYYY-MM")
 			return
 		}
 		startDate = &d
@@ -114,7 +114,7 @@ func (h *EducationHandler) Create(w http.ResponseWriter, r *http.Request) {
 	if req.EndDate != nil {
 		d, err := domain.ParseDate(*req.EndDate)
 		if err != nil {
-			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid end_date format")
+			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid end_date format, expected YYYY-MM-DD or YYYY-MM")
 			return
 		}
 		endDate = &d
@@ -134,6 +134,7 @@ func (h *EducationHandler) Create(w http.ResponseWriter, r *http.Request) {
 		Location:     req.Location,
 		StartDate:    startDate,
 		EndDate:      endDate,
+		IsExpected:   req.IsExpected,
 		GPA:          req.GPA,
 		Honors:       req.Honors,
 		DisplayOrder: req.DisplayOrder,
@@ -178,7 +179,7 @@ func (h *EducationHandler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	education, err := h.educationService.GetEducation(r.Context(), educationID)
+	education, err := h.educationService.GetEducation(r.Context(), educationID, authUser.ID)
 	if err != nil {
 		if errors.Is(err, domain.ErrEducationNotFound) {
 			respondError(w, http.StatusNotFound, "EDUCATION_NOT_FOUND", "Education entry not found")
@@ -189,12 +190,6 @@ func (h *EducationHandler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership.
-	if education.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "EDUCATION_NOT_FOUND", "Education entry not found")
-		return
-	}
-
 	respondJSON(w, http.StatusOK, mapEducationToResponse(education))
 }
 
@@ -228,24 +223,9 @@ func (h *EducationHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership.
-	existing, err := h.educationService.GetEducation(r.Context(), educationID)
-	if err != nil {
-		if errors.Is(err, domain.ErrEducationNotFound) {
-			respondError(w, http.StatusNotFound, "EDUCATION_NOT_FOUND", "Education entry not found")
-			return
-		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve education")
-		return
-	}
-	if existing.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "EDUCATION_NOT_FOUND", "Education entry not found")
-		return
-	}
-
 	var req UpdateEducationRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
@@ -254,7 +234,7 @@ func (h *EducationHandler) Update(w http.ResponseWriter, r *http.Request) {
 	if req.StartDate != nil {
 		d, err := domain.ParseDate(*req.StartDate)
 		if err != nil {
-			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid start_date format")
+			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid start_date format, expected YYYY-MM-DD or YYYY-MM")
 			return
 		}
 		startDate = &d
@@ -262,7 +242,7 @@ func (h *EducationHandler) Update(w http.ResponseWriter, r *http.Request) {
 	if req.EndDate != nil {
 		d, err := domain.ParseDate(*req.EndDate)
 		if err != nil {
-			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid end_date format")
+			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid end_date format, expected YYYY-MM-DD or YYYY-MM")
 			return
 		}
 		endDate = &d
@@ -270,12 +250,14 @@ func (h *EducationHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	svcReq := services.UpdateEducationRequest{
 		EducationID:  educationID,
+		UserID:       authUser.ID,
 		Institution:  req.Institution,
 		Degree:       req.Degree,
 		FieldOfStudy: req.FieldOfStudy,
 		Location:     req.Location,
 		StartDate:    startDate,
 		EndDate:      endDate,
+		IsExpected:   req.IsExpected,
 		GPA:          req.GPA,
 		Honors:       req.Honors,
 		DisplayOrder: req.DisplayOrder,
@@ -283,6 +265,10 @@ func (h *EducationHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	education, err := h.educationService.UpdateEducation(r.Context(), svcReq)
 	if err != nil {
+		if errors.Is(err, domain.ErrEducationNotFound) {
+			respondError(w, http.StatusNotFound, "EDUCATION_NOT_FOUND", "Education entry not found")
+			return
+		}
 		if handleValidationError(w, err) {
 			return
 		}
@@ -320,22 +306,11 @@ func (h *EducationHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership.
-	existing, err := h.educationService.GetEducation(r.Context(), educationID)
-	if err != nil {
+	if err := h.educationService.DeleteEducation(r.Context(), educationID, authUser.ID); err != nil {
 		if errors.Is(err, domain.ErrEducationNotFound) {
 			respondError(w, http.StatusNotFound, "EDUCATION_NOT_FOUND", "Education entry not found")
 			return
 		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve education")
-		return
-	}
-	if existing.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "EDUCATION_NOT_FOUND", "Education entry not found")
-		return
-	}
-
-	if err := h.educationService.DeleteEducation(r.Context(), educationID); err != nil {
 		log.Error().Err(err).Str("education_id", educationID).Msg("Failed to delete education")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete education")
 		return
@@ -351,6 +326,7 @@ func mapEducationToResponse(education *domain.Education) EducationResponse {
 		Institution:  education.Institution,
 		Degree:       education.Degree,
 		Location:     education.Location,
+		IsExpected:   education.IsExpected,
 		GPA:          education.GPA,
 		Honors:       education.Honors,
 		DisplayOrder: education.DisplayOrder,
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Envelope is the body of every JSON response of the v2 API. Successful
+// responses carry their payload in Data, with the rest of list responses,
+// such as totals and cursors, in Meta. Failed responses carry a null Data
+// and the Error.
+type Envelope[T any] struct {
+	Data  T              `json:"data"`
+	Meta  map[string]any `json:"meta,omitempty"`
+	Error *ErrorBody     `json:"error,omitempty"`
+}
+
+// ErrorEnvelope is the body of failed v2 responses.
+type ErrorEnvelope = Envelope[*struct{}]
+
+// Enveloped is a middleware that writes the JSON responses of handlers in an
+// Envelope, so that handlers are shared with routes responding bare objects.
+func Enveloped(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w, format := withFormat(w)
+		format.envelope = true
+		next.ServeHTTP(w, r)
+	})
+}
+
+// envelope returns the Envelope of a response body. Error responses fill
+// Error; bodies with a "data" field, such as lists, fill Data with it and
+// Meta with their other fields; other bodies are the Data.
+func envelope(body any) Envelope[any] {
+	switch b := body.(type) {
+	case ErrorResponse:
+		return Envelope[any]{Error: &b.Error}
+	}
+
+	raw, err := json.Marshal(body)
+	if err != nil {
+		// Let the encoder report the error.
+		return Envelope[any]{Data: body}
+	}
+
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(raw, &fields) != nil {
+		return Envelope[any]{Data: json.RawMessage(raw)}
+	}
+	data, ok := fields["data"]
+	if !ok {
+		return Envelope[any]{Data: json.RawMessage(raw)}
+	}
+
+	env := Envelope[any]{Data: data}
+	delete(fields, "data")
+	if len(fields) > 0 {
+		env.Meta = make(map[string]any, len(fields))
+		for name, value := range fields {
+			env.Meta[name] = value
+		}
+	}
+	return env
+}
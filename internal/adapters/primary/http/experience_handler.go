@@ -2,14 +2,18 @@ package http
 
 import (
 	"errors"
+	"fmt"
+	"math"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog/log"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/pkg/circuitbreaker"
 )
 
 // ExperienceHandler handles experience-related HTTP requests.
@@ -39,23 +43,79 @@ func NewExperienceHandler(experienceService *services.ExperienceService) *Experi
 //	@Failure		500		{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/experiences [get]
 func (h *ExperienceHandler) List(w http.ResponseWriter, r *http.Request) {
-	authUser, ok := GetAuthenticatedUser(r.Context())
+	limit := parseIntParam(r, "limit", 50)
+	offset := parseIntParam(r, "offset", 0)
+
+	result, ok := h.list(w, r, limit, offset)
 	if !ok {
-		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
 		return
 	}
 
-	// Parse query parameters
-	expType := r.URL.Query().Get("type")
-	limit := parseIntParam(r, "limit", 50)
-	offset := parseIntParam(r, "offset", 0)
+	data := make([]ExperienceResponse, 0, len(result.Experiences))
+	for _, exp := range result.Experiences {
+		data = append(data, mapExperienceToResponse(&exp))
+	}
+
+	respondJSON(w, http.StatusOK, ListExperiencesResponse{
+		Data:   data,
+		Total:  result.Total,
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
+// ListV2 returns the experiences of the authenticated user, a page at a time.
+//
+//	@Summary		List experiences (v2)
+//	@Description	Returns a page of experiences for the authenticated user; pass meta.next_cursor as cursor for the next page
+//	@Tags			experiences
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			type	query		string	false	"Filter by experience type"
+//	@Param			limit	query		int		false	"Page size"	default(50)
+//	@Param			cursor	query		string	false	"Cursor of the page"
+//	@Success		200		{object}	Envelope[[]ExperienceV2Response]
+//	@Failure		400		{object}	ErrorEnvelope	"Invalid cursor"
+//	@Failure		401		{object}	ErrorEnvelope	"Unauthorized"
+//	@Failure		500		{object}	ErrorEnvelope	"Internal server error"
+//	@Router			/v2/experiences [get]
+func (h *ExperienceHandler) ListV2(w http.ResponseWriter, r *http.Request) {
+	offset, limit, ok := parseCursorPage(w, r, 50)
+	if !ok {
+		return
+	}
+
+	result, ok := h.list(w, r, limit, offset)
+	if !ok {
+		return
+	}
+
+	data := make([]ExperienceV2Response, 0, len(result.Experiences))
+	for _, exp := range result.Experiences {
+		data = append(data, mapExperienceToV2Response(&exp))
+	}
+
+	respondJSON(w, http.StatusOK, ListExperiencesV2Response{
+		Data:       data,
+		NextCursor: nextCursor(offset, len(data), result.Total),
+	})
+}
+
+// list lists the experiences of the authenticated user. It responds with
+// an error and returns false on failure.
+func (h *ExperienceHandler) list(w http.ResponseWriter, r *http.Request, limit, offset int) (*services.ListExperiencesResponse, bool) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return nil, false
+	}
 
 	req := services.ListExperiencesRequest{
 		UserID: authUser.ID,
 		Limit:  limit,
 		Offset: offset,
 	}
-	if expType != "" {
+	if expType := r.URL.Query().Get("type"); expType != "" {
 		req.Type = &expType
 	}
 
@@ -63,20 +123,9 @@ func (h *ExperienceHandler) List(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to list experiences")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve experiences")
-		return
-	}
-
-	data := make([]ExperienceResponse, 0, len(result.Experiences))
-	for _, exp := range result.Experiences {
-		data = append(data, mapExperienceToResponse(&exp))
+		return nil, false
 	}
-
-	respondJSON(w, http.StatusOK, ListExperiencesResponse{
-		Data:   data,
-		Total:  result.Total,
-		Limit:  limit,
-		Offset: offset,
-	})
+	return result, true
 }
 
 // Get returns a specific experience by ID.
@@ -93,37 +142,56 @@ func (h *ExperienceHandler) List(w http.ResponseWriter, r *http.Request) {
 //	@Failure		500				{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/experiences/{experienceID} [get]
 func (h *ExperienceHandler) Get(w http.ResponseWriter, r *http.Request) {
+	if experience, ok := h.get(w, r); ok {
+		respondJSON(w, http.StatusOK, mapExperienceToResponse(experience))
+	}
+}
+
+// GetV2 returns a specific experience by ID.
+//
+//	@Summary		Get experience (v2)
+//	@Description	Returns a specific experience with all its bullets
+//	@Tags			experiences
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			experienceID	path		string	true	"Experience ID"
+//	@Success		200				{object}	Envelope[ExperienceV2Response]
+//	@Failure		401				{object}	ErrorEnvelope	"Unauthorized"
+//	@Failure		404				{object}	ErrorEnvelope	"Experience not found"
+//	@Failure		500				{object}	ErrorEnvelope	"Internal server error"
+//	@Router			/v2/experiences/{experienceID} [get]
+func (h *ExperienceHandler) GetV2(w http.ResponseWriter, r *http.Request) {
+	if experience, ok := h.get(w, r); ok {
+		respondJSON(w, http.StatusOK, mapExperienceToV2Response(experience))
+	}
+}
+
+// get returns the requested experience of the authenticated user. It
+// responds with an error and returns false on failure.
+func (h *ExperienceHandler) get(w http.ResponseWriter, r *http.Request) (*domain.Experience, bool) {
 	authUser, ok := GetAuthenticatedUser(r.Context())
 	if !ok {
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
-		return
+		return nil, false
 	}
 
 	experienceID := chi.URLParam(r, "experienceID")
 	if experienceID == "" {
 		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Experience ID is required")
-		return
+		return nil, false
 	}
 
-	experience, err := h.experienceService.GetExperienceWithBullets(r.Context(), experienceID)
+	experience, err := h.experienceService.GetExperienceWithBullets(r.Context(), experienceID, authUser.ID)
 	if err != nil {
 		if errors.Is(err, domain.ErrExperienceNotFound) {
 			respondError(w, http.StatusNotFound, "EXPERIENCE_NOT_FOUND", "Experience not found")
-			return
+			return nil, false
 		}
 		log.Error().Err(err).Str("experience_id", experienceID).Msg("Failed to get experience")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve experience")
-		return
+		return nil, false
 	}
-
-	// Verify ownership
-	if experience.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "EXPERIENCE_NOT_FOUND", "Experience not found")
-		return
-	}
-
-	response := mapExperienceToResponse(experience)
-	respondJSON(w, http.StatusOK, response)
+	return experience, true
 }
 
 // Create creates a new experience.
@@ -142,29 +210,59 @@ func (h *ExperienceHandler) Get(w http.ResponseWriter, r *http.Request) {
 //	@Failure		500		{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/experiences [post]
 func (h *ExperienceHandler) Create(w http.ResponseWriter, r *http.Request) {
+	if experience, ok := h.create(w, r); ok {
+		respondJSON(w, http.StatusCreated, mapExperienceToResponse(experience))
+	}
+}
+
+// CreateV2 creates a new experience.
+//
+//	@Summary		Create experience (v2)
+//	@Description	Creates a new experience for the authenticated user
+//	@Tags			experiences
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		CreateExperienceRequest	true	"Experience data"
+//	@Success		201		{object}	Envelope[ExperienceV2Response]
+//	@Failure		400		{object}	ErrorEnvelope	"Invalid request body"
+//	@Failure		401		{object}	ErrorEnvelope	"Unauthorized"
+//	@Failure		422		{object}	ErrorEnvelope	"Validation failed"
+//	@Failure		500		{object}	ErrorEnvelope	"Internal server error"
+//	@Router			/v2/experiences [post]
+func (h *ExperienceHandler) CreateV2(w http.ResponseWriter, r *http.Request) {
+	if experience, ok := h.create(w, r); ok {
+		respondJSON(w, http.StatusCreated, mapExperienceToV2Response(experience))
+	}
+}
+
+// create creates the requested experience for the authenticated user. It
+// responds with an error and returns false on failure.
+func (h *ExperienceHandler) create(w http.ResponseWriter, r *http.Request) (*domain.Experience, bool) {
 	authUser, ok := GetAuthenticatedUser(r.Context())
 	if !ok {
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
-		return
+		return nil, false
 	}
 
 	var req CreateExperienceRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
-		return
+		respondDecodeError(w, err)
+		return nil, false
 	}
 
 	// Build service request
 	createReq := services.CreateExperienceRequest{
-		UserID:       authUser.ID,
-		Type:         req.Type,
-		Title:        req.Title,
-		Organization: req.Organization,
-		Location:     req.Location,
-		StartDate:    req.StartDate,
-		IsCurrent:    req.IsCurrent,
-		Description:  req.Description,
-		URL:          req.URL,
+		UserID:               authUser.ID,
+		Type:                 req.Type,
+		Title:                req.Title,
+		Organization:         req.Organization,
+		Location:             req.Location,
+		StartDate:            req.StartDate,
+		IsCurrent:            req.IsCurrent,
+		Description:          req.Description,
+		URL:                  req.URL,
+		ExcludeFromTailoring: req.ExcludeFromTailoring,
 	}
 	if req.EndDate != nil {
 		createReq.EndDate = req.EndDate
@@ -173,15 +271,13 @@ func (h *ExperienceHandler) Create(w http.ResponseWriter, r *http.Request) {
 	experience, err := h.experienceService.CreateExperience(r.Context(), createReq)
 	if err != nil {
 		if handleValidationError(w, err) {
-			return
+			return nil, false
 		}
 		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to create experience")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to create experience")
-		return
+		return nil, false
 	}
-
-	response := mapExperienceToResponse(experience)
-	respondJSON(w, http.StatusCreated, response)
+	return experience, true
 }
 
 // Update updates an existing experience.
@@ -202,66 +298,86 @@ func (h *ExperienceHandler) Create(w http.ResponseWriter, r *http.Request) {
 //	@Failure		500				{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/experiences/{experienceID} [put]
 func (h *ExperienceHandler) Update(w http.ResponseWriter, r *http.Request) {
+	if experience, ok := h.update(w, r); ok {
+		respondJSON(w, http.StatusOK, mapExperienceToResponse(experience))
+	}
+}
+
+// UpdateV2 updates an existing experience.
+//
+//	@Summary		Update experience (v2)
+//	@Description	Updates an existing experience
+//	@Tags			experiences
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			experienceID	path		string					true	"Experience ID"
+//	@Param			request			body		UpdateExperienceRequest	true	"Experience data"
+//	@Success		200				{object}	Envelope[ExperienceV2Response]
+//	@Failure		400				{object}	ErrorEnvelope	"Invalid request body"
+//	@Failure		401				{object}	ErrorEnvelope	"Unauthorized"
+//	@Failure		404				{object}	ErrorEnvelope	"Experience not found"
+//	@Failure		422				{object}	ErrorEnvelope	"Validation failed"
+//	@Failure		500				{object}	ErrorEnvelope	"Internal server error"
+//	@Router			/v2/experiences/{experienceID} [put]
+func (h *ExperienceHandler) UpdateV2(w http.ResponseWriter, r *http.Request) {
+	if experience, ok := h.update(w, r); ok {
+		respondJSON(w, http.StatusOK, mapExperienceToV2Response(experience))
+	}
+}
+
+// update applies the requested changes to an experience of the
+// authenticated user. It responds with an error and returns false on failure.
+func (h *ExperienceHandler) update(w http.ResponseWriter, r *http.Request) (*domain.Experience, bool) {
 	authUser, ok := GetAuthenticatedUser(r.Context())
 	if !ok {
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
-		return
+		return nil, false
 	}
 
 	experienceID := chi.URLParam(r, "experienceID")
 	if experienceID == "" {
 		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Experience ID is required")
-		return
+		return nil, false
 	}
 
 	var req UpdateExperienceRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
-		return
-	}
-
-	// Verify ownership first
-	existing, err := h.experienceService.GetExperience(r.Context(), experienceID)
-	if err != nil {
-		if errors.Is(err, domain.ErrExperienceNotFound) {
-			respondError(w, http.StatusNotFound, "EXPERIENCE_NOT_FOUND", "Experience not found")
-			return
-		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to verify experience")
-		return
-	}
-	if existing.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "EXPERIENCE_NOT_FOUND", "Experience not found")
-		return
+		respondDecodeError(w, err)
+		return nil, false
 	}
 
 	// Build update request
 	updateReq := services.UpdateExperienceRequest{
-		ExperienceID: experienceID,
-		Type:         req.Type,
-		Title:        req.Title,
-		Organization: req.Organization,
-		Location:     req.Location,
-		StartDate:    req.StartDate,
-		EndDate:      req.EndDate,
-		IsCurrent:    req.IsCurrent,
-		Description:  req.Description,
-		URL:          req.URL,
-		DisplayOrder: req.DisplayOrder,
+		ExperienceID:         experienceID,
+		UserID:               authUser.ID,
+		Type:                 req.Type,
+		Title:                req.Title,
+		Organization:         req.Organization,
+		Location:             req.Location,
+		StartDate:            req.StartDate,
+		EndDate:              req.EndDate,
+		IsCurrent:            req.IsCurrent,
+		Description:          req.Description,
+		URL:                  req.URL,
+		DisplayOrder:         req.DisplayOrder,
+		ExcludeFromTailoring: req.ExcludeFromTailoring,
 	}
 
 	experience, err := h.experienceService.UpdateExperience(r.Context(), updateReq)
 	if err != nil {
+		if errors.Is(err, domain.ErrExperienceNotFound) {
+			respondError(w, http.StatusNotFound, "EXPERIENCE_NOT_FOUND", "Experience not found")
+			return nil, false
+		}
 		if handleValidationError(w, err) {
-			return
+			return nil, false
 		}
 		log.Error().Err(err).Str("experience_id", experienceID).Msg("Failed to update experience")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to update experience")
-		return
+		return nil, false
 	}
-
-	response := mapExperienceToResponse(experience)
-	respondJSON(w, http.StatusOK, response)
+	return experience, true
 }
 
 // Delete removes an experience and all its bullets.
@@ -289,22 +405,11 @@ func (h *ExperienceHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership first
-	existing, err := h.experienceService.GetExperience(r.Context(), experienceID)
-	if err != nil {
+	if err := h.experienceService.DeleteExperience(r.Context(), experienceID, authUser.ID); err != nil {
 		if errors.Is(err, domain.ErrExperienceNotFound) {
 			respondError(w, http.StatusNotFound, "EXPERIENCE_NOT_FOUND", "Experience not found")
 			return
 		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to verify experience")
-		return
-	}
-	if existing.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "EXPERIENCE_NOT_FOUND", "Experience not found")
-		return
-	}
-
-	if err := h.experienceService.DeleteExperience(r.Context(), experienceID); err != nil {
 		log.Error().Err(err).Str("experience_id", experienceID).Msg("Failed to delete experience")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete experience")
 		return
@@ -316,19 +421,20 @@ func (h *ExperienceHandler) Delete(w http.ResponseWriter, r *http.Request) {
 // mapExperienceToResponse maps a domain Experience to an ExperienceResponse.
 func mapExperienceToResponse(exp *domain.Experience) ExperienceResponse {
 	response := ExperienceResponse{
-		ID:           exp.ID,
-		Type:         string(exp.Type),
-		Title:        exp.Title,
-		Organization: exp.Organization,
-		Location:     exp.Location,
-		StartDate:    exp.StartDate.String(),
-		IsCurrent:    exp.IsCurrent,
-		Description:  exp.Description,
-		URL:          exp.URL,
-		Metadata:     exp.Metadata,
-		DisplayOrder: exp.DisplayOrder,
-		CreatedAt:    exp.CreatedAt,
-		UpdatedAt:    exp.UpdatedAt,
+		ID:                   exp.ID,
+		Type:                 string(exp.Type),
+		Title:                exp.Title,
+		Organization:         exp.Organization,
+		Location:             exp.Location,
+		StartDate:            exp.StartDate.String(),
+		IsCurrent:            exp.IsCurrent,
+		Description:          exp.Description,
+		URL:                  exp.URL,
+		Metadata:             exp.Metadata,
+		DisplayOrder:         exp.DisplayOrder,
+		CreatedAt:            exp.CreatedAt,
+		UpdatedAt:            exp.UpdatedAt,
+		ExcludeFromTailoring: exp.ExcludeFromTailoring,
 	}
 
 	if exp.EndDate != nil && !exp.EndDate.IsZero() {
@@ -345,6 +451,47 @@ func mapExperienceToResponse(exp *domain.Experience) ExperienceResponse {
 	return response
 }
 
+// mapExperienceToV2Response maps a domain Experience to an
+// ExperienceV2Response.
+func mapExperienceToV2Response(exp *domain.Experience) ExperienceV2Response {
+	v1 := mapExperienceToResponse(exp)
+
+	metadata := exp.Metadata
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	bullets := make([]BulletV2Response, 0, len(v1.Bullets))
+	for _, b := range v1.Bullets {
+		bullet := BulletV2Response(b)
+		if bullet.Keywords == nil {
+			bullet.Keywords = []string{}
+		}
+		if bullet.Metadata == nil {
+			bullet.Metadata = map[string]any{}
+		}
+		bullets = append(bullets, bullet)
+	}
+
+	return ExperienceV2Response{
+		ID:                   v1.ID,
+		Type:                 v1.Type,
+		Title:                v1.Title,
+		Organization:         v1.Organization,
+		Location:             v1.Location,
+		StartDate:            v1.StartDate,
+		EndDate:              v1.EndDate,
+		IsCurrent:            v1.IsCurrent,
+		Description:          v1.Description,
+		URL:                  v1.URL,
+		Metadata:             metadata,
+		DisplayOrder:         v1.DisplayOrder,
+		ExcludeFromTailoring: v1.ExcludeFromTailoring,
+		Bullets:              bullets,
+		CreatedAt:            v1.CreatedAt,
+		UpdatedAt:            v1.UpdatedAt,
+	}
+}
+
 // parseIntParam parses an integer query parameter with a default value.
 func parseIntParam(r *http.Request, name string, defaultVal int) int {
 	val := r.URL.Query().Get(name)
@@ -374,3 +521,40 @@ func handleValidationError(w http.ResponseWriter, err error) bool {
 	}
 	return false
 }
+
+// handleUpstreamError checks if the error comes from an external service whose
+// circuit breaker is open and responds with 503, hinting when to retry.
+func handleUpstreamError(w http.ResponseWriter, err error) bool {
+	if !errors.Is(err, domain.ErrUpstreamUnavailable) {
+		return false
+	}
+
+	var openErr *circuitbreaker.OpenError
+	if errors.As(err, &openErr) && openErr.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(openErr.RetryAfter.Seconds()))))
+	}
+
+	log.Warn().Err(err).Msg("Upstream service unavailable")
+	respondError(w, http.StatusServiceUnavailable, "UPSTREAM_UNAVAILABLE", "An external service is temporarily unavailable, please retry later")
+	return true
+}
+
+// handleQuotaError checks if the error is a reached plan limit and responds
+// with 429, hinting when the quota resets.
+func handleQuotaError(w http.ResponseWriter, err error) bool {
+	if !errors.Is(err, domain.ErrQuotaExceeded) {
+		return false
+	}
+
+	message := "Plan limit reached"
+	var quotaErr *domain.QuotaExceededError
+	if errors.As(err, &quotaErr) {
+		if wait := time.Until(quotaErr.ResetsAt); wait > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		}
+		message = fmt.Sprintf("Plan limit of %d %s per %s reached", quotaErr.Limit, quotaErr.Action, quotaErr.Period)
+	}
+
+	respondError(w, http.StatusTooManyRequests, "QUOTA_EXCEEDED", message)
+	return true
+}
@@ -2,14 +2,20 @@ package http
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http/mocks"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/pkg/circuitbreaker"
 )
 
 func TestExperienceHandlerList(t *testing.T) {
@@ -226,6 +232,45 @@ func TestExperienceHandlerGet(t *testing.T) {
 	}
 }
 
+func TestExperienceHandlerCreateMonthPrecision(t *testing.T) {
+	expRepo := mocks.NewInMemoryExperienceRepository()
+	handler := NewExperienceHandler(services.NewExperienceService(expRepo, mocks.NewInMemoryBulletRepository()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	t.Run("success - month-only dates round trip", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodPost, "/v1/experiences", map[string]any{
+			"type":         "work",
+			"title":        "Backend Engineer",
+			"organization": "Acme",
+			"start_date":   "2021-03",
+			"end_date":     "2021-03-20",
+		})
+		req = req.WithContext(ctx)
+
+		rr := executeRequest(t, req, handler.Create)
+		assertStatusCode(t, http.StatusCreated, rr)
+
+		var resp ExperienceResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, "2021-03", resp.StartDate)
+		require.NotNil(t, resp.EndDate)
+		assert.Equal(t, "2021-03-20", *resp.EndDate)
+	})
+
+	t.Run("error - invalid date", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodPost, "/v1/experiences", map[string]any{
+			"type":         "work",
+			"title":        "Backend Engineer",
+			"organization": "Acme",
+			"start_date":   "03/2021",
+		})
+		req = req.WithContext(ctx)
+
+		rr := executeRequest(t, req, handler.Create)
+		assertErrorResponse(t, rr, http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+}
+
 func TestNewExperienceHandler(t *testing.T) {
 	expRepo := mocks.NewInMemoryExperienceRepository()
 	bulletRepo := mocks.NewInMemoryBulletRepository()
@@ -235,3 +280,22 @@ func TestNewExperienceHandler(t *testing.T) {
 	require.NotNil(t, handler)
 	require.NotNil(t, handler.experienceService)
 }
+
+func TestHandleUpstreamError(t *testing.T) {
+	t.Run("open breaker responds 503 with Retry-After", func(t *testing.T) {
+		err := fmt.Errorf("groq: %w: %w", domain.ErrUpstreamUnavailable,
+			&circuitbreaker.OpenError{Name: "groq", RetryAfter: 1500 * time.Millisecond})
+
+		rec := httptest.NewRecorder()
+		require.True(t, handleUpstreamError(rec, err))
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+		assert.Contains(t, rec.Body.String(), "UPSTREAM_UNAVAILABLE")
+	})
+
+	t.Run("ignores other errors", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		assert.False(t, handleUpstreamError(rec, errors.New("boom")))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// FeedbackHandler handles tailored bullet feedback HTTP requests.
+type FeedbackHandler struct {
+	feedbackService *services.FeedbackService
+}
+
+// NewFeedbackHandler creates a new FeedbackHandler.
+func NewFeedbackHandler(feedbackService *services.FeedbackService) *FeedbackHandler {
+	return &FeedbackHandler{
+		feedbackService: feedbackService,
+	}
+}
+
+// Submit stores feedback on a resume's tailored bullets.
+//
+//	@Summary		Submit bullet feedback
+//	@Description	Marks tailored bullets of the resume as accepted, edited or rejected, replacing earlier feedback on the same bullets. Edited bullets need edited_content. The user's recent feedback across all resumes is summed up into writing preferences (such as avoiding buzzwords or preferring metrics) that steer later tailoring. Returns all of the resume's feedback.
+//	@Tags			resumes
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string						true	"Resume ID"
+//	@Param			request		body		SubmitBulletFeedbackRequest	true	"Bullet verdicts"
+//	@Success		200			{object}	ListBulletFeedbackResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid request"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		422			{object}	ErrorResponse	"Validation error or resume not tailored yet"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/feedback [post]
+func (h *FeedbackHandler) Submit(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	var req SubmitBulletFeedbackRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	submitReq := services.SubmitFeedbackRequest{
+		ResumeID: resumeID,
+		UserID:   authUser.ID,
+		Bullets:  make([]services.BulletFeedbackInput, 0, len(req.Bullets)),
+	}
+	for _, b := range req.Bullets {
+		submitReq.Bullets = append(submitReq.Bullets, services.BulletFeedbackInput{
+			BulletID:      b.BulletID,
+			Verdict:       domain.FeedbackVerdict(b.Verdict),
+			EditedContent: b.EditedContent,
+			Comment:       b.Comment,
+		})
+	}
+
+	feedback, err := h.feedbackService.SubmitFeedback(r.Context(), submitReq)
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before feedback")
+			return
+		}
+		if handleValidationError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to submit bullet feedback")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to submit feedback")
+		return
+	}
+
+	resp := ListBulletFeedbackResponse{
+		Data: make([]BulletFeedbackResponse, 0, len(feedback)),
+	}
+	for _, f := range feedback {
+		resp.Data = append(resp.Data, BulletFeedbackResponse{
+			BulletID:        f.BulletID,
+			Verdict:         string(f.Verdict),
+			TailoredContent: f.TailoredContent,
+			EditedContent:   f.EditedContent,
+			Comment:         f.Comment,
+			UpdatedAt:       f.UpdatedAt,
+		})
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
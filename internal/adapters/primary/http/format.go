@@ -0,0 +1,47 @@
+package http
+
+import "net/http"
+
+// formatWriter carries how respondJSON formats the responses of a handler,
+// as chosen by middlewares for the route or the request.
+type formatWriter struct {
+	http.ResponseWriter
+
+	// envelope writes responses in an Envelope.
+	envelope bool
+
+	// problem writes errors as RFC 7807 problem details about instance.
+	problem  bool
+	instance string
+}
+
+// Unwrap returns the wrapped writer, for http.ResponseController.
+func (w *formatWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// withFormat returns the formatWriter of w, and the writer to pass on to
+// the next handler: w when it already carries a format, else a new
+// formatWriter wrapping it.
+func withFormat(w http.ResponseWriter) (http.ResponseWriter, *formatWriter) {
+	if fw := responseFormat(w); fw != nil {
+		return w, fw
+	}
+	fw := &formatWriter{ResponseWriter: w}
+	return fw, fw
+}
+
+// responseFormat returns the formatWriter of w, looking through the writers
+// wrapping it, or nil when responses to w are written as is.
+func responseFormat(w http.ResponseWriter) *formatWriter {
+	for {
+		switch rw := w.(type) {
+		case *formatWriter:
+			return rw
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = rw.Unwrap()
+		default:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// ImportHandler handles bulk import HTTP requests.
+type ImportHandler struct {
+	importService *services.ImportService
+}
+
+// NewImportHandler creates a new ImportHandler.
+func NewImportHandler(importService *services.ImportService) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+	}
+}
+
+// ImportCSV imports experiences and bullets from a CSV file.
+//
+//	@Summary		Import experiences from CSV
+//	@Description	Imports experiences and bullets from a CSV sent as the request body. Each row holds one bullet; rows with the same type, title, organization and start_date form one experience. Columns: type, title*, organization*, location, start_date*, end_date, is_current, description, url, bullet, keywords (separated by ";"), impact_score. The import is all-or-nothing: any invalid row rejects the whole file with row-level errors.
+//	@Tags			import
+//	@Accept			text/csv
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			dry_run	query		bool	false	"Validate without importing"
+//	@Success		200		{object}	ImportCSVResponse	"Dry run result"
+//	@Success		201		{object}	ImportCSVResponse	"Imported"
+//	@Failure		400		{object}	ErrorResponse		"Empty request body"
+//	@Failure		401		{object}	ErrorResponse		"Unauthorized"
+//	@Failure		413		{object}	ErrorResponse		"File too large"
+//	@Failure		422		{object}	ErrorResponse		"Infected file or invalid rows"
+//	@Failure		500		{object}	ErrorResponse		"Internal server error"
+//	@Failure		503		{object}	ErrorResponse		"Virus scanner unavailable"
+//	@Router			/v1/import/csv [post]
+func (h *ImportHandler) ImportCSV(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	if r.Body == nil || r.ContentLength == 0 {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "CSV file is required")
+		return
+	}
+
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+
+	result, err := h.importService.ImportCSV(r.Context(), services.ImportCSVRequest{
+		UserID: authUser.ID,
+		Data:   r.Body,
+		DryRun: dryRun,
+	})
+	if err != nil {
+		if handleImportError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to import CSV")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to import CSV")
+		return
+	}
+
+	respondImportResult(w, result)
+}
+
+// StartUpload starts a chunked upload for files too large for one request.
+//
+//	@Summary		Start chunked upload
+//	@Description	Starts a chunked upload. Send the file in chunks with PUT /v1/import/uploads/{uploadID}/chunks/{index}, then import it with POST /v1/import/uploads/{uploadID}/complete.
+//	@Tags			import
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		201	{object}	ImportUploadResponse	"Upload started"
+//	@Failure		401	{object}	ErrorResponse			"Unauthorized"
+//	@Failure		500	{object}	ErrorResponse			"Internal server error"
+//	@Router			/v1/import/uploads [post]
+func (h *ImportHandler) StartUpload(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	upload, err := h.importService.StartUpload(r.Context())
+	if err != nil {
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to start upload")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to start upload")
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, ImportUploadResponse{
+		UploadID:     upload.ID,
+		MaxChunkSize: upload.MaxChunkSize,
+		MaxChunks:    upload.MaxChunks,
+		MaxFileSize:  upload.MaxFileSize,
+	})
+}
+
+// UploadChunk stores one chunk of a chunked upload.
+//
+//	@Summary		Upload chunk
+//	@Description	Stores one chunk of a chunked upload, sent as the raw request body. Chunks may be sent in any order; sending an index again replaces the chunk.
+//	@Tags			import
+//	@Accept			application/octet-stream
+//	@Security		BearerAuth
+//	@Param			uploadID	path	string	true	"Upload ID"
+//	@Param			index		path	int		true	"0-based chunk index"
+//	@Success		204			"Chunk stored"
+//	@Failure		400			{object}	ErrorResponse	"Invalid chunk"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Upload not found"
+//	@Failure		413			{object}	ErrorResponse	"Chunk too large"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/import/uploads/{uploadID}/chunks/{index} [put]
+func (h *ImportHandler) UploadChunk(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	index, err := strconv.Atoi(chi.URLParam(r, "index"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "INVALID_CHUNK", "Chunk index must be a number")
+		return
+	}
+
+	err = h.importService.UploadChunk(r.Context(), services.UploadChunkRequest{
+		UserID:   authUser.ID,
+		UploadID: chi.URLParam(r, "uploadID"),
+		Index:    index,
+		Data:     r.Body,
+	})
+	if err != nil {
+		if handleImportError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to store upload chunk")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to store chunk")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CompleteUpload assembles a chunked upload and imports it.
+//
+//	@Summary		Complete chunked upload
+//	@Description	Assembles chunks 0 to chunks-1 and imports the file like POST /v1/import/csv. The chunks are discarded afterwards, whatever the outcome.
+//	@Tags			import
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			uploadID	path		string	true	"Upload ID"
+//	@Param			chunks		query		int		true	"Number of chunks"
+//	@Param			dry_run		query		bool	false	"Validate without importing"
+//	@Success		200			{object}	ImportCSVResponse	"Dry run result"
+//	@Success		201			{object}	ImportCSVResponse	"Imported"
+//	@Failure		400			{object}	ErrorResponse		"Invalid chunk count"
+//	@Failure		401			{object}	ErrorResponse		"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse		"Upload not found"
+//	@Failure		413			{object}	ErrorResponse		"File too large"
+//	@Failure		422			{object}	ErrorResponse		"Missing chunks, infected file or invalid rows"
+//	@Failure		500			{object}	ErrorResponse		"Internal server error"
+//	@Failure		503			{object}	ErrorResponse		"Virus scanner unavailable"
+//	@Router			/v1/import/uploads/{uploadID}/complete [post]
+func (h *ImportHandler) CompleteUpload(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	chunks, err := strconv.Atoi(r.URL.Query().Get("chunks"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "INVALID_CHUNK", "Chunk count is required")
+		return
+	}
+
+	result, err := h.importService.CompleteUpload(r.Context(), services.CompleteUploadRequest{
+		UserID:   authUser.ID,
+		UploadID: chi.URLParam(r, "uploadID"),
+		Chunks:   chunks,
+		DryRun:   r.URL.Query().Get("dry_run") == "true",
+	})
+	if err != nil {
+		if handleImportError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to import upload")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to import upload")
+		return
+	}
+
+	respondImportResult(w, result)
+}
+
+// respondImportResult writes an import result, or its row errors as 422.
+func respondImportResult(w http.ResponseWriter, result *services.ImportResult) {
+	if len(result.Errors) > 0 {
+		details := make([]ErrorDetail, 0, len(result.Errors))
+		for _, rowErr := range result.Errors {
+			details = append(details, ErrorDetail{
+				Row:     rowErr.Row,
+				Field:   rowErr.Column,
+				Message: rowErr.Message,
+			})
+		}
+		respondErrorWithDetails(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "CSV contains invalid rows", details)
+		return
+	}
+
+	data := make([]ExperienceResponse, 0, len(result.Experiences))
+	for _, exp := range result.Experiences {
+		data = append(data, mapExperienceToResponse(&exp))
+	}
+
+	status := http.StatusCreated
+	if result.DryRun {
+		status = http.StatusOK
+	}
+
+	respondJSON(w, status, ImportCSVResponse{
+		DryRun:      result.DryRun,
+		Experiences: len(result.Experiences),
+		Bullets:     result.Bullets,
+		Data:        data,
+	})
+}
+
+// handleImportError maps file upload and scanning errors to responses.
+func handleImportError(w http.ResponseWriter, err error) bool {
+	if handleUpstreamError(w, err) {
+		return true
+	}
+
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.Is(err, domain.ErrFileTooLarge):
+		message := "File is too large"
+		var tooLarge *domain.FileTooLargeError
+		if errors.As(err, &tooLarge) {
+			message = fmt.Sprintf("File exceeds the limit of %d bytes", tooLarge.Limit)
+		}
+		respondError(w, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE", message)
+	case errors.As(err, &maxBytesErr):
+		respondError(w, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE", fmt.Sprintf("Request exceeds the limit of %d bytes", maxBytesErr.Limit))
+	case errors.Is(err, domain.ErrFileInfected):
+		log.Warn().Err(err).Msg("Rejected infected upload")
+		respondError(w, http.StatusUnprocessableEntity, "FILE_INFECTED", "File was rejected by the virus scanner")
+	case errors.Is(err, domain.ErrUploadNotFound):
+		respondError(w, http.StatusNotFound, "UPLOAD_NOT_FOUND", "Upload not found")
+	case errors.Is(err, domain.ErrInvalidChunk):
+		respondError(w, http.StatusBadRequest, "INVALID_CHUNK", err.Error())
+	case errors.Is(err, domain.ErrIncompleteUpload):
+		respondError(w, http.StatusUnprocessableEntity, "UPLOAD_INCOMPLETE", err.Error())
+	case errors.Is(err, domain.ErrVirusScanUnavailable):
+		log.Error().Err(err).Msg("Virus scanner unavailable")
+		respondError(w, http.StatusServiceUnavailable, "VIRUS_SCAN_UNAVAILABLE", "Virus scanning is temporarily unavailable")
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,273 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http/mocks"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+const validImportCSV = `type,title,organization,start_date,end_date,is_current,bullet,keywords,impact_score
+work,Software Engineer,Tech Corp,2020-01-15,,true,Built Go APIs,go;api,80
+work,Software Engineer,Tech Corp,2020-01-15,,true,Led a team of 4,,
+volunteer,Mentor,Code Club,2018-03-01,2019-06-30,false,,,
+`
+
+func TestImportHandlerImportCSV(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		query          string
+		authenticated  bool
+		expectedStatus int
+		expectedCode   string
+		stored         int
+	}{
+		{
+			name:           "success - imports grouped experiences",
+			body:           validImportCSV,
+			authenticated:  true,
+			expectedStatus: http.StatusCreated,
+			stored:         2,
+		},
+		{
+			name:           "success - dry run does not import",
+			body:           validImportCSV,
+			query:          "?dry_run=true",
+			authenticated:  true,
+			expectedStatus: http.StatusOK,
+			stored:         0,
+		},
+		{
+			name:           "error - row errors reject the whole file",
+			body:           "title,organization,start_date,impact_score,bullet\nEngineer,Corp,2020-01-01,,Did things\nEngineer,,01/2020,,\nEngineer,Corp,2020-01-01,200,Too much\n",
+			authenticated:  true,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "VALIDATION_ERROR",
+			stored:         0,
+		},
+		{
+			name:           "error - empty body",
+			authenticated:  true,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "INVALID_REQUEST",
+		},
+		{
+			name:           "error - user not authenticated",
+			body:           validImportCSV,
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   "UNAUTHORIZED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expRepo := mocks.NewInMemoryExperienceRepository()
+			handler := NewImportHandler(services.NewImportService(expRepo))
+
+			req, err := http.NewRequest(http.MethodPost, "/v1/import/csv"+tt.query, strings.NewReader(tt.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "text/csv")
+			if tt.authenticated {
+				req = req.WithContext(setupTestContext("user-123", "firebase-123", "test@example.com"))
+			}
+
+			rr := executeRequest(t, req, handler.ImportCSV)
+
+			if tt.expectedCode != "" {
+				assertErrorResponse(t, rr, tt.expectedStatus, tt.expectedCode)
+			} else {
+				assertStatusCode(t, tt.expectedStatus, rr)
+
+				var resp ImportCSVResponse
+				parseJSONResponse(t, rr, &resp)
+				assert.Equal(t, 2, resp.Experiences)
+				assert.Equal(t, 2, resp.Bullets)
+				require.Len(t, resp.Data, 2)
+				assert.Len(t, resp.Data[0].Bullets, 2)
+				assert.True(t, resp.Data[0].IsCurrent)
+			}
+
+			experiences, _, err := expRepo.ListByUserIDWithBullets(req.Context(), "user-123", ports.DefaultListOptions())
+			require.NoError(t, err)
+			assert.Len(t, experiences, tt.stored)
+		})
+	}
+}
+
+func TestImportHandlerRowErrors(t *testing.T) {
+	handler := NewImportHandler(services.NewImportService(mocks.NewInMemoryExperienceRepository()))
+
+	body := "title,organization,start_date,impact_score,bullet\nEngineer,,01/2020,,\nEngineer,Corp,2020-01-01,200,Too much\n"
+	req, err := http.NewRequest(http.MethodPost, "/v1/import/csv", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "text/csv")
+	req = req.WithContext(setupTestContext("user-123", "firebase-123", "test@example.com"))
+
+	rr := executeRequest(t, req, handler.ImportCSV)
+	assertStatusCode(t, http.StatusUnprocessableEntity, rr)
+
+	var errResp ErrorResponse
+	parseJSONResponse(t, rr, &errResp)
+	require.Len(t, errResp.Error.Details, 2)
+	assert.Equal(t, ErrorDetail{Row: 2, Field: "start_date", Message: "must be a date in YYYY-MM-DD or YYYY-MM format"}, errResp.Error.Details[0])
+	assert.Equal(t, 3, errResp.Error.Details[1].Row)
+	assert.Equal(t, "impact_score", errResp.Error.Details[1].Field)
+}
+
+// stubScanner flags files containing "EICAR" as infected.
+type stubScanner struct{}
+
+func (stubScanner) Scan(ctx context.Context, content io.Reader) (*ports.ScanResult, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(string(data), "EICAR") {
+		return &ports.ScanResult{Infected: true, Signature: "Eicar-Signature"}, nil
+	}
+	return &ports.ScanResult{}, nil
+}
+
+func (stubScanner) Close() error { return nil }
+
+func TestImportHandlerFileChecks(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:           "error - infected file",
+			body:           validImportCSV + "work,EICAR,Corp,2020-01-01,,,,,\n",
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "FILE_INFECTED",
+		},
+		{
+			name:           "error - file too large",
+			body:           strings.Repeat("x", services.MaxImportFileSize+1),
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedCode:   "FILE_TOO_LARGE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expRepo := mocks.NewInMemoryExperienceRepository()
+			importService := services.NewImportService(expRepo)
+			importService.SetVirusScanner(stubScanner{})
+			handler := NewImportHandler(importService)
+
+			req, err := http.NewRequest(http.MethodPost, "/v1/import/csv", strings.NewReader(tt.body))
+			require.NoError(t, err)
+			req = req.WithContext(setupTestContext("user-123", "firebase-123", "test@example.com"))
+
+			rr := executeRequest(t, req, handler.ImportCSV)
+			assertErrorResponse(t, rr, tt.expectedStatus, tt.expectedCode)
+
+			experiences, _, err := expRepo.ListByUserIDWithBullets(req.Context(), "user-123", ports.DefaultListOptions())
+			require.NoError(t, err)
+			assert.Empty(t, experiences)
+		})
+	}
+}
+
+func TestImportHandlerChunkedUpload(t *testing.T) {
+	expRepo := mocks.NewInMemoryExperienceRepository()
+	storage := mocks.NewInMemoryFileStorage()
+	importService := services.NewImportService(expRepo)
+	importService.SetUploadStorage(storage)
+	importService.SetVirusScanner(stubScanner{})
+	handler := NewImportHandler(importService)
+
+	router := chi.NewRouter()
+	router.Post("/v1/import/uploads", handler.StartUpload)
+	router.Put("/v1/import/uploads/{uploadID}/chunks/{index}", handler.UploadChunk)
+	router.Post("/v1/import/uploads/{uploadID}/complete", handler.CompleteUpload)
+
+	send := func(method, path, body string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest(method, path, strings.NewReader(body))
+		require.NoError(t, err)
+		req = req.WithContext(setupTestContext("user-123", "firebase-123", "test@example.com"))
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+
+	start := func() string {
+		rr := send(http.MethodPost, "/v1/import/uploads", "")
+		assertStatusCode(t, http.StatusCreated, rr)
+
+		var resp ImportUploadResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, int64(services.MaxImportChunkSize), resp.MaxChunkSize)
+		return resp.UploadID
+	}
+
+	t.Run("success - assembles chunks sent out of order", func(t *testing.T) {
+		uploadID := start()
+		half := len(validImportCSV) / 2
+
+		rr := send(http.MethodPut, "/v1/import/uploads/"+uploadID+"/chunks/1", validImportCSV[half:])
+		assertStatusCode(t, http.StatusNoContent, rr)
+		rr = send(http.MethodPut, "/v1/import/uploads/"+uploadID+"/chunks/0", validImportCSV[:half])
+		assertStatusCode(t, http.StatusNoContent, rr)
+
+		rr = send(http.MethodPost, "/v1/import/uploads/"+uploadID+"/complete?chunks=2", "")
+		assertStatusCode(t, http.StatusCreated, rr)
+
+		var resp ImportCSVResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, 2, resp.Experiences)
+		assert.Equal(t, 0, storage.Len(), "chunks are discarded")
+	})
+
+	t.Run("error - missing chunk", func(t *testing.T) {
+		uploadID := start()
+		send(http.MethodPut, "/v1/import/uploads/"+uploadID+"/chunks/0", validImportCSV)
+
+		rr := send(http.MethodPost, "/v1/import/uploads/"+uploadID+"/complete?chunks=2", "")
+		assertErrorResponse(t, rr, http.StatusUnprocessableEntity, "UPLOAD_INCOMPLETE")
+	})
+
+	t.Run("error - infected upload", func(t *testing.T) {
+		uploadID := start()
+		send(http.MethodPut, "/v1/import/uploads/"+uploadID+"/chunks/0", validImportCSV)
+		send(http.MethodPut, "/v1/import/uploads/"+uploadID+"/chunks/1", "work,EICAR,Corp,2020-01-01,,,,,\n")
+
+		rr := send(http.MethodPost, "/v1/import/uploads/"+uploadID+"/complete?chunks=2", "")
+		assertErrorResponse(t, rr, http.StatusUnprocessableEntity, "FILE_INFECTED")
+		assert.Equal(t, 0, storage.Len(), "chunks are discarded")
+	})
+
+	t.Run("error - chunk too large", func(t *testing.T) {
+		uploadID := start()
+		rr := send(http.MethodPut, "/v1/import/uploads/"+uploadID+"/chunks/0", strings.Repeat("x", services.MaxImportChunkSize+1))
+		assertErrorResponse(t, rr, http.StatusRequestEntityTooLarge, "FILE_TOO_LARGE")
+	})
+
+	t.Run("error - chunk index out of range", func(t *testing.T) {
+		uploadID := start()
+		rr := send(http.MethodPut, "/v1/import/uploads/"+uploadID+"/chunks/100", "data")
+		assertErrorResponse(t, rr, http.StatusBadRequest, "INVALID_CHUNK")
+	})
+
+	t.Run("error - unknown upload", func(t *testing.T) {
+		rr := send(http.MethodPut, "/v1/import/uploads/..%2F..%2Fetc/chunks/0", "data")
+		assertErrorResponse(t, rr, http.StatusNotFound, "UPLOAD_NOT_FOUND")
+
+		rr = send(http.MethodPost, "/v1/import/uploads/"+start()+"/complete?chunks=1", "")
+		assertErrorResponse(t, rr, http.StatusNotFound, "UPLOAD_NOT_FOUND")
+	})
+}
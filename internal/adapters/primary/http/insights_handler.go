@@ -0,0 +1,105 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/pkg/csvexport"
+	"github.com/SeltikHD/chameleon-vitae/pkg/filename"
+)
+
+// applicationsCSVHeader lists the columns of the applications export.
+var applicationsCSVHeader = []string{
+	"resume_id", "company", "job_title", "job_url", "status", "outcome",
+	"score", "language", "created_at", "updated_at",
+}
+
+// InsightsHandler handles job search insight HTTP requests.
+type InsightsHandler struct {
+	resumeService *services.ResumeService
+}
+
+// NewInsightsHandler creates a new InsightsHandler.
+func NewInsightsHandler(resumeService *services.ResumeService) *InsightsHandler {
+	return &InsightsHandler{
+		resumeService: resumeService,
+	}
+}
+
+// ExportCSV returns all of the user's resumes as a CSV spreadsheet.
+//
+//	@Summary		Export applications as CSV
+//	@Description	Returns a CSV file with one row per resume, newest first, for analysis in a spreadsheet. The columns are resume_id, company, job_title, job_url, status, outcome, score, language, created_at and updated_at. The outcome is not_applied before the resume is submitted, then pending, interviewing, rejected or offer. Times are RFC 3339 in UTC. The file starts with a UTF-8 byte order mark, and cells starting with =, +, - or @ are prefixed with an apostrophe.
+//	@Tags			insights
+//	@Produce		text/csv
+//	@Security		BearerAuth
+//	@Success		200	{file}		file			"CSV spreadsheet"
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/insights/export.csv [get]
+func (h *InsightsHandler) ExportCSV(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumes, err := h.resumeService.ListAllResumes(r.Context(), authUser.ID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to list resumes for export")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to export resumes")
+		return
+	}
+
+	name := "applications-" + time.Now().UTC().Format(time.DateOnly) + ".csv"
+	w.Header().Set("Content-Type", csvexport.ContentType)
+	w.Header().Set("Content-Disposition", filename.ContentDisposition("attachment", name))
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.WriteHeader(http.StatusOK)
+
+	if err := writeApplicationsCSV(w, resumes); err != nil {
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to write CSV export")
+	}
+}
+
+// writeApplicationsCSV writes one row per resume.
+func writeApplicationsCSV(w io.Writer, resumes []domain.Resume) error {
+	csv, err := csvexport.NewWriter(w, applicationsCSVHeader...)
+	if err != nil {
+		return err
+	}
+
+	for i := range resumes {
+		resume := &resumes[i]
+		if err := csv.Write(
+			resume.ID,
+			stringValue(resume.CompanyName),
+			stringValue(resume.JobTitle),
+			stringValue(resume.JobURL),
+			string(resume.Status),
+			string(resume.Outcome()),
+			strconv.Itoa(resume.Score.Int()),
+			resume.TargetLanguage,
+			resume.CreatedAt.UTC().Format(time.RFC3339),
+			resume.UpdatedAt.UTC().Format(time.RFC3339),
+		); err != nil {
+			return err
+		}
+	}
+
+	return csv.Flush()
+}
+
+// stringValue returns the string s points to, or "" when s is nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
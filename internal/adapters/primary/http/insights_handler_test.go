@@ -0,0 +1,40 @@
+package http
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestWriteApplicationsCSV(t *testing.T) {
+	company := "=Acme, Inc."
+	title := "Engenheiro de Software"
+	created := time.Date(2025, 1, 15, 9, 30, 0, 0, time.UTC)
+
+	resumes := []domain.Resume{
+		{
+			ID:             "r-1",
+			CompanyName:    &company,
+			JobTitle:       &title,
+			Status:         domain.ResumeStatusInterview,
+			Score:          85,
+			TargetLanguage: "pt-br",
+			CreatedAt:      created,
+			UpdatedAt:      created.Add(48 * time.Hour),
+		},
+		{ID: "r-2", Status: domain.ResumeStatusDraft, TargetLanguage: "en", CreatedAt: created, UpdatedAt: created},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeApplicationsCSV(&buf, resumes))
+
+	assert.Equal(t, "\ufeffresume_id,company,job_title,job_url,status,outcome,score,language,created_at,updated_at\n"+
+		"r-1,\"'=Acme, Inc.\",Engenheiro de Software,,interview,interviewing,85,pt-br,2025-01-15T09:30:00Z,2025-01-17T09:30:00Z\n"+
+		"r-2,,,,draft,not_applied,0,en,2025-01-15T09:30:00Z,2025-01-15T09:30:00Z\n",
+		buf.String())
+}
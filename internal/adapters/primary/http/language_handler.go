@@ -83,7 +83,7 @@ func (h *SpokenLanguageHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	var req CreateSpokenLanguageRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
@@ -126,7 +126,7 @@ func (h *SpokenLanguageHandler) Create(w http.ResponseWriter, r *http.Request) {
 //	@Failure		500			{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/languages/{languageID} [delete]
 func (h *SpokenLanguageHandler) Delete(w http.ResponseWriter, r *http.Request) {
-	_, ok := GetAuthenticatedUser(r.Context())
+	authUser, ok := GetAuthenticatedUser(r.Context())
 	if !ok {
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
 		return
@@ -138,7 +138,7 @@ func (h *SpokenLanguageHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.skillService.DeleteSpokenLanguage(r.Context(), languageID); err != nil {
+	if err := h.skillService.DeleteSpokenLanguage(r.Context(), languageID, authUser.ID); err != nil {
 		if errors.Is(err, domain.ErrSpokenLanguageNotFound) {
 			respondError(w, http.StatusNotFound, "LANGUAGE_NOT_FOUND", "Language not found")
 			return
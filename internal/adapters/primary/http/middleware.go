@@ -2,7 +2,12 @@ package http
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
 	"net/http"
+	"runtime/debug"
+	"slices"
 	"strings"
 	"time"
 
@@ -10,7 +15,10 @@ import (
 	"github.com/go-chi/httprate"
 	"github.com/rs/zerolog/log"
 
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/pkg/signedurl"
 )
 
 // contextKey is a type for context keys to avoid collisions.
@@ -23,8 +31,16 @@ const (
 
 	// ClaimsContextKey is the key for storing auth claims in context.
 	ClaimsContextKey contextKey = "claims"
+
+	// ImpersonationContextKey is the key for storing the impersonation of
+	// requests made by an admin as a user.
+	ImpersonationContextKey contextKey = "impersonation"
 )
 
+// ImpersonatedByHeader flags the responses to impersonated requests with the
+// ID of the impersonating admin.
+const ImpersonatedByHeader = "X-Impersonated-By"
+
 // AuthenticatedUser represents the authenticated user info stored in context.
 type AuthenticatedUser struct {
 	ID          string
@@ -44,6 +60,13 @@ func GetAuthClaims(ctx context.Context) (*ports.AuthClaims, bool) {
 	return claims, ok
 }
 
+// GetImpersonation retrieves the impersonation of a request made by an admin
+// as a user from the request context.
+func GetImpersonation(ctx context.Context) (*domain.Impersonation, bool) {
+	impersonation, ok := ctx.Value(ImpersonationContextKey).(*domain.Impersonation)
+	return impersonation, ok
+}
+
 // AuthMiddlewareConfig holds configuration for the auth middleware.
 type AuthMiddlewareConfig struct {
 	AuthProvider ports.AuthProvider
@@ -95,6 +118,11 @@ func (r *Router) AuthMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
+		if strings.HasPrefix(token, services.ImpersonationTokenPrefix) && r.services.ImpersonationService != nil {
+			r.serveImpersonated(w, req, next, token)
+			return
+		}
+
 		// Verify the token
 		claims, err := r.authMiddleware.authProvider.VerifyToken(req.Context(), token)
 		if err != nil {
@@ -111,6 +139,11 @@ func (r *Router) AuthMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
+		if user.SessionRevoked(claims.IssuedAt) {
+			respondError(w, http.StatusUnauthorized, "SESSION_REVOKED", "Session was revoked, please sign in again")
+			return
+		}
+
 		// Store authenticated user info in context
 		authUser := &AuthenticatedUser{
 			ID:          user.ID,
@@ -120,47 +153,303 @@ func (r *Router) AuthMiddleware(next http.Handler) http.Handler {
 			authUser.Email = *user.Email
 		}
 
-		// Add user and claims to context
+		setAccessLogUserID(req.Context(), authUser.ID)
+
+		// Add user and claims to context, and scope data access to the user
 		ctx := context.WithValue(req.Context(), UserContextKey, authUser)
 		ctx = context.WithValue(ctx, ClaimsContextKey, claims)
+		ctx = ports.WithCurrentUser(ctx, authUser.ID)
 
 		// Continue to next handler
 		next.ServeHTTP(w, req.WithContext(ctx))
 	})
 }
 
-// ZerologLogger is a middleware that logs requests using zerolog.
-func ZerologLogger(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		start := time.Now()
-
-		// Wrap response writer to capture status code
-		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
-
-		defer func() {
-			// Log after request completes
-			log.Info().
-				Str("method", r.Method).
-				Str("path", r.URL.Path).
-				Str("remote_addr", r.RemoteAddr).
-				Int("status", ww.Status()).
-				Int("bytes", ww.BytesWritten()).
-				Dur("latency", time.Since(start)).
-				Str("request_id", middleware.GetReqID(r.Context())).
-				Msg("HTTP request")
-		}()
-
-		next.ServeHTTP(ww, r)
-	})
+// serveImpersonated authenticates a request made by an admin as a user with
+// an impersonation token. Responses carry ImpersonatedByHeader, and every
+// request is recorded in the audit log, including those the token's scope
+// denies.
+func (r *Router) serveImpersonated(w http.ResponseWriter, req *http.Request, next http.Handler, token string) {
+	impersonations := r.services.ImpersonationService
+
+	impersonation, err := impersonations.Verify(token)
+	if errors.Is(err, domain.ErrImpersonationExpired) {
+		respondError(w, http.StatusUnauthorized, "IMPERSONATION_EXPIRED", "Impersonation token has expired")
+		return
+	}
+	if err != nil {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or expired token")
+		return
+	}
+
+	// Tokens stop working as soon as their admin is removed.
+	if !slices.Contains(r.config.AdminUserIDs, impersonation.AdminID) {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Impersonation is no longer allowed")
+		return
+	}
+
+	user, err := r.authMiddleware.userRepo.GetByID(req.Context(), impersonation.UserID)
+	if err != nil {
+		log.Debug().Err(err).Str("user_id", impersonation.UserID).Msg("Impersonated user not found")
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not found")
+		return
+	}
+
+	w.Header().Set(ImpersonatedByHeader, impersonation.AdminID)
+	ww := middleware.NewWrapResponseWriter(w, req.ProtoMajor)
+	defer func() {
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		err := impersonations.RecordRequest(context.WithoutCancel(req.Context()), impersonation, req.Method, req.URL.Path, status)
+		if err != nil {
+			log.Error().Err(err).
+				Str("admin_id", impersonation.AdminID).
+				Str("user_id", impersonation.UserID).
+				Msg("Failed to audit impersonated request")
+		}
+	}()
+
+	if !impersonation.Allows(req.Method) {
+		respondError(ww, http.StatusForbidden, "IMPERSONATION_READ_ONLY", "Impersonation token only allows reading")
+		return
+	}
+
+	authUser := &AuthenticatedUser{
+		ID:          user.ID,
+		FirebaseUID: user.FirebaseUID,
+	}
+	if user.Email != nil {
+		authUser.Email = *user.Email
+	}
+
+	setAccessLogUserID(req.Context(), authUser.ID)
+	log.Info().
+		Str("admin_id", impersonation.AdminID).
+		Str("user_id", authUser.ID).
+		Str("method", req.Method).
+		Str("path", req.URL.Path).
+		Msg("Impersonated request")
+
+	ctx := context.WithValue(req.Context(), UserContextKey, authUser)
+	ctx = context.WithValue(ctx, ImpersonationContextKey, impersonation)
+	ctx = ports.WithCurrentUser(ctx, authUser.ID)
+	next.ServeHTTP(ww, req.WithContext(ctx))
+}
+
+// AccessLogConfig configures the access log middleware.
+type AccessLogConfig struct {
+	// ExcludePaths are never logged (exact match), e.g. health checks.
+	ExcludePaths []string
+
+	// SampleRates maps path prefixes to the fraction of requests logged,
+	// from 0 (none) to 1 (all). The longest matching prefix wins.
+	SampleRates map[string]float64
+
+	// DefaultSampleRate is the fraction of requests logged for paths
+	// without a matching prefix in SampleRates.
+	DefaultSampleRate float64
+}
+
+// DefaultAccessLogConfig logs every request except health checks.
+func DefaultAccessLogConfig() AccessLogConfig {
+	return AccessLogConfig{
+		ExcludePaths:      []string{"/health", "/ping"},
+		DefaultSampleRate: 1,
+	}
+}
+
+// accessLogKey is the context key for the access log entry of a request.
+const accessLogKey contextKey = "access_log"
+
+// accessLogEntry carries fields set further down the middleware chain
+// (such as the authenticated user) back to the access logger.
+type accessLogEntry struct {
+	userID string
+}
+
+// setAccessLogUserID records the authenticated user for the access log.
+func setAccessLogUserID(ctx context.Context, userID string) {
+	if entry, ok := ctx.Value(accessLogKey).(*accessLogEntry); ok {
+		entry.userID = userID
+	}
+}
+
+// AccessLogger returns a middleware that writes one structured zerolog line
+// per request. Requests are sampled per path, but server errors are always logged.
+func AccessLogger(cfg AccessLogConfig) func(http.Handler) http.Handler {
+	return accessLogger(cfg, rand.Float64)
+}
+
+// accessLogger builds the access log middleware with the given random source.
+func accessLogger(cfg AccessLogConfig, random func() float64) func(http.Handler) http.Handler {
+	excluded := make(map[string]struct{}, len(cfg.ExcludePaths))
+	for _, path := range cfg.ExcludePaths {
+		excluded[path] = struct{}{}
+	}
+
+	sampleRate := func(path string) float64 {
+		rate, matched := cfg.DefaultSampleRate, 0
+		for prefix, r := range cfg.SampleRates {
+			if strings.HasPrefix(path, prefix) && len(prefix) > matched {
+				rate, matched = r, len(prefix)
+			}
+		}
+		return rate
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, skip := excluded[r.URL.Path]; skip {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			start := time.Now()
+			entry := &accessLogEntry{}
+
+			// Wrap response writer to capture status code
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			defer func() {
+				status := ww.Status()
+				if status == 0 {
+					status = http.StatusOK
+				}
+
+				if status < http.StatusInternalServerError && random() >= sampleRate(r.URL.Path) {
+					return
+				}
+
+				event := log.Info()
+				switch {
+				case status >= http.StatusInternalServerError:
+					event = log.Error()
+				case status >= http.StatusBadRequest:
+					event = log.Warn()
+				}
+
+				event.
+					Str("method", r.Method).
+					Str("path", r.URL.Path).
+					Int("status", status).
+					Dur("duration", time.Since(start)).
+					Int("bytes", ww.BytesWritten()).
+					Str("request_id", middleware.GetReqID(r.Context())).
+					Str("remote_addr", r.RemoteAddr)
+				if entry.userID != "" {
+					event.Str("user_id", entry.userID)
+				}
+				event.Msg("HTTP request")
+			}()
+
+			next.ServeHTTP(ww, r.WithContext(context.WithValue(r.Context(), accessLogKey, entry)))
+		})
+	}
+}
+
+// Recoverer returns a middleware that turns panics into 500 responses
+// carrying the request ID, logs them with their stack trace and, when
+// reporter is not nil, reports them. It also passes the request ID on to the
+// errors services report. It must run after the access logger so the reports
+// name the authenticated user.
+func Recoverer(reporter ports.ErrorReporter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				rvr := recover()
+				if rvr == nil {
+					return
+				}
+				if rvr == http.ErrAbortHandler {
+					// Let net/http abort the response as intended.
+					panic(rvr)
+				}
+
+				requestID := middleware.GetReqID(r.Context())
+				stack := string(debug.Stack())
+				event := ports.ErrorEvent{
+					Message:    fmt.Sprintf("panic: %v", rvr),
+					Stack:      stack,
+					RequestID:  requestID,
+					Method:     r.Method,
+					URL:        r.URL.String(),
+					OccurredAt: time.Now().UTC(),
+				}
+				if entry, ok := r.Context().Value(accessLogKey).(*accessLogEntry); ok {
+					event.UserID = entry.userID
+				}
+
+				log.Error().
+					Str("panic", fmt.Sprint(rvr)).
+					Str("stack", stack).
+					Str("method", r.Method).
+					Str("path", r.URL.Path).
+					Str("request_id", requestID).
+					Msg("Recovered from panic")
+
+				if reporter != nil {
+					reporter.Report(context.WithoutCancel(r.Context()), event)
+				}
+
+				if r.Header.Get("Connection") != "Upgrade" {
+					respondJSON(w, http.StatusInternalServerError, ErrorResponse{
+						Error: ErrorBody{
+							Code:      "INTERNAL_ERROR",
+							Message:   "An unexpected error occurred",
+							RequestID: requestID,
+						},
+					})
+				}
+			}()
+
+			ctx := ports.WithRequestID(r.Context(), middleware.GetReqID(r.Context()))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// BodyLimit returns a middleware that limits request bodies to limit bytes,
+// or to the limit of the longest path prefix in prefixLimits matching the
+// request. Requests declaring a larger body are rejected with 413 up front;
+// larger bodies sent without a length fail with *http.MaxBytesError when
+// read past the limit.
+func BodyLimit(limit int64, prefixLimits map[string]int64) func(http.Handler) http.Handler {
+	limitFor := func(path string) int64 {
+		size, matched := limit, 0
+		for prefix, l := range prefixLimits {
+			if strings.HasPrefix(path, prefix) && len(prefix) > matched {
+				size, matched = l, len(prefix)
+			}
+		}
+		return size
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			size := limitFor(r.URL.Path)
+			if r.ContentLength > size {
+				respondBodyTooLarge(w, size)
+				return
+			}
+			if r.Body != nil {
+				r.Body = http.MaxBytesReader(w, r.Body, size)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
 }
 
 // ContentTypeJSON ensures JSON content type for POST/PUT/PATCH requests.
+// CSV and raw binary bodies are also accepted on the import endpoints, and
+// YAML on the profile bundle.
 func ContentTypeJSON(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Only check content type for requests with body
 		if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
 			contentType := r.Header.Get("Content-Type")
-			if contentType != "" && !strings.HasPrefix(contentType, "application/json") {
+			if contentType != "" && !strings.HasPrefix(contentType, "application/json") && !isImportUpload(r, contentType) && !isYAMLBundle(r, contentType) {
 				respondError(w, http.StatusUnsupportedMediaType, "UNSUPPORTED_MEDIA_TYPE", "Content-Type must be application/json")
 				return
 			}
@@ -170,6 +459,63 @@ func ContentTypeJSON(next http.Handler) http.Handler {
 	})
 }
 
+// isImportUpload reports whether the request is a CSV body or an upload
+// chunk sent to an import endpoint of any API version.
+func isImportUpload(r *http.Request, contentType string) bool {
+	return (strings.HasPrefix(contentType, "text/csv") || strings.HasPrefix(contentType, "application/octet-stream")) &&
+		(strings.HasPrefix(r.URL.Path, "/v1/import/") || strings.HasPrefix(r.URL.Path, "/v2/import/"))
+}
+
+// isYAMLBundle reports whether the request is a YAML profile bundle sent to
+// the profile bundle endpoint of any API version.
+func isYAMLBundle(r *http.Request, contentType string) bool {
+	return isYAML(contentType) && strings.HasSuffix(r.URL.Path, "/users/me/profile-bundle")
+}
+
+// SignedURL returns a middleware that only lets through requests carrying a
+// valid, unexpired signature for their path, with prefix removed. It guards
+// the local storage files, which are served without authentication.
+func SignedURL(signer *signedurl.Signer, prefix string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := strings.TrimPrefix(r.URL.Path, prefix)
+
+			if err := signer.Verify(key, r.URL.Query()); err != nil {
+				if errors.Is(err, signedurl.ErrExpired) {
+					respondError(w, http.StatusForbidden, "URL_EXPIRED", "This link has expired")
+					return
+				}
+				respondError(w, http.StatusForbidden, "INVALID_SIGNATURE", "Invalid or missing URL signature")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// AdminOnly returns a middleware that only lets through authenticated users
+// whose ID is in adminUserIDs, and never impersonated requests. It must run
+// after the auth middleware. Admin handlers work across users, so data access
+// is no longer scoped to the admin.
+func AdminOnly(adminUserIDs []string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authUser, ok := GetAuthenticatedUser(r.Context())
+			if !ok {
+				respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+				return
+			}
+			if _, impersonated := GetImpersonation(r.Context()); impersonated || !slices.Contains(adminUserIDs, authUser.ID) {
+				respondError(w, http.StatusForbidden, "FORBIDDEN", "Admin access required")
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ports.WithCurrentUser(r.Context(), "")))
+		})
+	}
+}
+
 // CORS returns a middleware that handles CORS headers.
 func CORS(allowedOrigins []string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -191,6 +537,7 @@ func CORS(allowedOrigins []string) func(http.Handler) http.Handler {
 				w.Header().Set("Access-Control-Allow-Headers", "Accept, Authorization, Content-Type, X-Request-ID")
 				w.Header().Set("Access-Control-Allow-Credentials", "true")
 				w.Header().Set("Access-Control-Max-Age", "300")
+				w.Header().Set("Access-Control-Expose-Headers", "Deprecation, Sunset, Link, "+ImpersonatedByHeader)
 			}
 
 			// Handle preflight requests
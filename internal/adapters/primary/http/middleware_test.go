@@ -0,0 +1,507 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http/mocks"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/pkg/signedurl"
+)
+
+// captureLogs redirects the global logger to a buffer for the duration of the test.
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	original := log.Logger
+	log.Logger = zerolog.New(&buf)
+	t.Cleanup(func() { log.Logger = original })
+
+	return &buf
+}
+
+// logLines decodes every JSON log line written to buf.
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &fields))
+		lines = append(lines, fields)
+	}
+	return lines
+}
+
+func TestAccessLogger(t *testing.T) {
+	handler := func(status int) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			setAccessLogUserID(r.Context(), "user-123")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte("hello"))
+		})
+	}
+
+	t.Run("logs request fields", func(t *testing.T) {
+		buf := captureLogs(t)
+		mw := accessLogger(DefaultAccessLogConfig(), func() float64 { return 0 })
+
+		mw(handler(http.StatusCreated)).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v1/bullets", nil))
+
+		lines := logLines(t, buf)
+		require.Len(t, lines, 1)
+		assert.Equal(t, "info", lines[0]["level"])
+		assert.Equal(t, "POST", lines[0]["method"])
+		assert.Equal(t, "/v1/bullets", lines[0]["path"])
+		assert.EqualValues(t, http.StatusCreated, lines[0]["status"])
+		assert.EqualValues(t, 5, lines[0]["bytes"])
+		assert.Equal(t, "user-123", lines[0]["user_id"])
+		assert.Contains(t, lines[0], "duration")
+	})
+
+	t.Run("skips excluded paths", func(t *testing.T) {
+		buf := captureLogs(t)
+		mw := accessLogger(DefaultAccessLogConfig(), func() float64 { return 0 })
+
+		mw(handler(http.StatusOK)).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+
+		assert.Empty(t, logLines(t, buf))
+	})
+
+	t.Run("samples by longest path prefix", func(t *testing.T) {
+		buf := captureLogs(t)
+		cfg := AccessLogConfig{
+			SampleRates: map[string]float64{
+				"/v1":         1,
+				"/v1/bullets": 0.1,
+			},
+			DefaultSampleRate: 1,
+		}
+		mw := accessLogger(cfg, func() float64 { return 0.5 })
+
+		mw(handler(http.StatusOK)).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/bullets/search", nil))
+		mw(handler(http.StatusOK)).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/skills", nil))
+
+		lines := logLines(t, buf)
+		require.Len(t, lines, 1)
+		assert.Equal(t, "/v1/skills", lines[0]["path"])
+	})
+
+	t.Run("always logs server errors", func(t *testing.T) {
+		buf := captureLogs(t)
+		mw := accessLogger(AccessLogConfig{DefaultSampleRate: 0}, func() float64 { return 0.5 })
+
+		mw(handler(http.StatusInternalServerError)).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/me", nil))
+
+		lines := logLines(t, buf)
+		require.Len(t, lines, 1)
+		assert.Equal(t, "error", lines[0]["level"])
+	})
+}
+
+func TestContentTypeJSON(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		contentType    string
+		expectedStatus int
+	}{
+		{"json body", http.MethodPost, "/v1/experiences", "application/json", http.StatusOK},
+		{"csv import", http.MethodPost, "/v1/import/csv", "text/csv", http.StatusOK},
+		{"binary upload chunk", http.MethodPut, "/v1/import/uploads/ID/chunks/0", "application/octet-stream", http.StatusOK},
+		{"binary body outside imports", http.MethodPost, "/v1/experiences", "application/octet-stream", http.StatusUnsupportedMediaType},
+		{"form body", http.MethodPost, "/v1/import/csv", "application/x-www-form-urlencoded", http.StatusUnsupportedMediaType},
+		{"yaml profile bundle", http.MethodPut, "/v1/users/me/profile-bundle", "application/yaml", http.StatusOK},
+		{"yaml body elsewhere", http.MethodPatch, "/v1/me", "application/yaml", http.StatusUnsupportedMediaType},
+	}
+
+	handler := ContentTypeJSON(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader("body"))
+			req.Header.Set("Content-Type", tt.contentType)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestBodyLimit(t *testing.T) {
+	handler := BodyLimit(16, map[string]int64{"/v1/import": 64})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]string
+			if err := decodeJSON(r, &req); err != nil {
+				respondDecodeError(w, err)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+
+	small := `{"a":"b"}`
+	large := `{"a":"` + strings.Repeat("x", 32) + `"}`
+	tests := []struct {
+		name           string
+		path           string
+		body           string
+		chunked        bool
+		expectedStatus int
+	}{
+		{"json within limit", "/v1/experiences", small, false, http.StatusOK},
+		{"json over limit", "/v1/experiences", large, false, http.StatusRequestEntityTooLarge},
+		{"json over limit without length", "/v1/experiences", large, true, http.StatusRequestEntityTooLarge},
+		{"import within its limit", "/v1/import/json", large, false, http.StatusOK},
+		{"import over its limit", "/v1/import/json", large + strings.Repeat(" ", 64), false, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusRequestEntityTooLarge {
+				assertErrorResponse(t, rr, http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE")
+			}
+		})
+	}
+}
+
+// fakeErrorReporter records the reported events.
+type fakeErrorReporter struct {
+	events []ports.ErrorEvent
+}
+
+func (f *fakeErrorReporter) Report(_ context.Context, event ports.ErrorEvent) {
+	f.events = append(f.events, event)
+}
+
+func (f *fakeErrorReporter) Close() error { return nil }
+
+func TestRecoverer(t *testing.T) {
+	logs := captureLogs(t)
+	reporter := &fakeErrorReporter{}
+	var contextRequestID string
+
+	handler := middleware.RequestID(
+		accessLogger(AccessLogConfig{}, func() float64 { return 1 })(
+			Recoverer(reporter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				contextRequestID = ports.RequestIDFromContext(r.Context())
+				setAccessLogUserID(r.Context(), "user-123")
+				panic("boom")
+			})),
+		),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/resumes", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, "INTERNAL_ERROR", resp.Error.Code)
+	assert.NotEmpty(t, resp.Error.RequestID)
+	assert.Equal(t, resp.Error.RequestID, contextRequestID)
+
+	require.Len(t, reporter.events, 1)
+	event := reporter.events[0]
+	assert.Equal(t, "panic: boom", event.Message)
+	assert.Equal(t, resp.Error.RequestID, event.RequestID)
+	assert.Equal(t, "user-123", event.UserID)
+	assert.Equal(t, "/v1/resumes", event.URL)
+	assert.Contains(t, event.Stack, "goroutine")
+
+	lines := logLines(t, logs)
+	require.NotEmpty(t, lines)
+	assert.Equal(t, "Recovered from panic", lines[0]["message"])
+	assert.Equal(t, "boom", lines[0]["panic"])
+	assert.Equal(t, resp.Error.RequestID, lines[0]["request_id"])
+	assert.NotEmpty(t, lines[0]["stack"])
+}
+
+func TestAdminOnly(t *testing.T) {
+	handler := AdminOnly([]string{"admin-1"})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Run("admin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/admin/storage/orphans", nil)
+		req = req.WithContext(setupTestContext("admin-1", "firebase-admin", "admin@example.com"))
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/admin/storage/orphans", nil)
+		req = req.WithContext(setupTestContext("user-1", "firebase-user", "user@example.com"))
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assertErrorResponse(t, rr, http.StatusForbidden, "FORBIDDEN")
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/admin/storage/orphans", nil)
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assertErrorResponse(t, rr, http.StatusUnauthorized, "UNAUTHORIZED")
+	})
+
+	t.Run("admin impersonating the admin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/admin/storage/orphans", nil)
+		ctx := setupTestContext("admin-1", "firebase-admin", "admin@example.com")
+		ctx = context.WithValue(ctx, ImpersonationContextKey, &domain.Impersonation{AdminID: "admin-2", UserID: "admin-1"})
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req.WithContext(ctx))
+		assertErrorResponse(t, rr, http.StatusForbidden, "FORBIDDEN")
+	})
+}
+
+func TestAuthMiddlewareImpersonation(t *testing.T) {
+	userRepo := mocks.NewInMemoryUserRepository()
+	user, _ := domain.NewUser("firebase-123")
+	user.ID = "user-123"
+	userRepo.Seed(user)
+
+	signer, err := signedurl.New([]byte(strings.Repeat("k", signedurl.MinKeySize)))
+	require.NoError(t, err)
+	audit := mocks.NewInMemoryAuditRepository()
+	impersonations := services.NewImpersonationService(userRepo, audit, signer, 15*time.Minute)
+
+	router := &Router{
+		config:   RouterConfig{AdminUserIDs: []string{"admin-1"}},
+		services: Services{ImpersonationService: impersonations},
+	}
+	router.SetAuthMiddleware(mocks.NewMockAuthProvider(), userRepo)
+	handler := router.AuthMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authUser, _ := GetAuthenticatedUser(r.Context())
+		w.Write([]byte(authUser.ID))
+	}))
+
+	serve := func(method, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/v1/resumes", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("acts as the user and audits the request", func(t *testing.T) {
+		grant, err := impersonations.Impersonate(context.Background(), "admin-1", "user-123", "")
+		require.NoError(t, err)
+
+		rr := serve(http.MethodGet, grant.Token)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "user-123", rr.Body.String())
+		assert.Equal(t, "admin-1", rr.Header().Get(ImpersonatedByHeader))
+
+		events := audit.Events()
+		require.NotEmpty(t, events)
+		last := events[len(events)-1]
+		assert.Equal(t, domain.AuditImpersonatedRequest, last.Action)
+		assert.Equal(t, "admin-1", last.ActorID)
+		assert.Equal(t, "user-123", last.SubjectID)
+		assert.Equal(t, grant.Impersonation.ID, last.SessionID)
+		assert.Equal(t, "GET /v1/resumes 200", last.Detail)
+	})
+
+	t.Run("read scope denies writes", func(t *testing.T) {
+		grant, err := impersonations.Impersonate(context.Background(), "admin-1", "user-123", domain.ImpersonationScopeRead)
+		require.NoError(t, err)
+
+		rr := serve(http.MethodPost, grant.Token)
+		assertErrorResponse(t, rr, http.StatusForbidden, "IMPERSONATION_READ_ONLY")
+		assert.Equal(t, "admin-1", rr.Header().Get(ImpersonatedByHeader))
+
+		events := audit.Events()
+		assert.Equal(t, "POST /v1/resumes 403", events[len(events)-1].Detail)
+	})
+
+	t.Run("full scope allows writes", func(t *testing.T) {
+		grant, err := impersonations.Impersonate(context.Background(), "admin-1", "user-123", domain.ImpersonationScopeFull)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, serve(http.MethodPost, grant.Token).Code)
+	})
+
+	t.Run("rejects tampered tokens", func(t *testing.T) {
+		grant, err := impersonations.Impersonate(context.Background(), "admin-1", "user-123", "")
+		require.NoError(t, err)
+
+		// Swap the scope of the signed payload.
+		parts := strings.Split(strings.TrimPrefix(grant.Token, services.ImpersonationTokenPrefix), ".")
+		payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+		require.NoError(t, err)
+		parts[0] = base64.RawURLEncoding.EncodeToString([]byte(strings.Replace(string(payload), ":read", ":full", 1)))
+		tampered := services.ImpersonationTokenPrefix + strings.Join(parts, ".")
+
+		rr := serve(http.MethodPost, tampered)
+		assertErrorResponse(t, rr, http.StatusUnauthorized, "UNAUTHORIZED")
+		assert.Empty(t, rr.Header().Get(ImpersonatedByHeader))
+	})
+
+	t.Run("rejects tokens of removed admins", func(t *testing.T) {
+		grant, err := impersonations.Impersonate(context.Background(), "admin-2", "user-123", "")
+		require.NoError(t, err)
+
+		assertErrorResponse(t, serve(http.MethodGet, grant.Token), http.StatusUnauthorized, "UNAUTHORIZED")
+	})
+
+	t.Run("rejects expired tokens", func(t *testing.T) {
+		expired := services.NewImpersonationService(userRepo, audit, signer, -time.Minute)
+		grant, err := expired.Impersonate(context.Background(), "admin-1", "user-123", "")
+		require.NoError(t, err)
+
+		assertErrorResponse(t, serve(http.MethodGet, grant.Token), http.StatusUnauthorized, "IMPERSONATION_EXPIRED")
+	})
+}
+
+func TestAuthMiddlewareRevokedSession(t *testing.T) {
+	revokedAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	userRepo := mocks.NewInMemoryUserRepository()
+	user, _ := domain.NewUser("firebase-123")
+	user.ID = "user-123"
+	user.SessionsRevokedAt = &revokedAt
+	userRepo.Seed(user)
+
+	authProvider := mocks.NewMockAuthProvider()
+	authProvider.AddToken("old-token", &ports.AuthClaims{UserID: "firebase-123", IssuedAt: revokedAt.Add(-time.Minute).Unix()})
+	authProvider.AddToken("new-token", &ports.AuthClaims{UserID: "firebase-123", IssuedAt: revokedAt.Add(time.Minute).Unix()})
+
+	router := &Router{}
+	router.SetAuthMiddleware(authProvider, userRepo)
+	handler := router.AuthMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	t.Run("token issued before the revocation", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/me", nil)
+		req.Header.Set("Authorization", "Bearer old-token")
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assertErrorResponse(t, rr, http.StatusUnauthorized, "SESSION_REVOKED")
+	})
+
+	t.Run("token issued after the revocation", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/me", nil)
+		req.Header.Set("Authorization", "Bearer new-token")
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+}
+
+func TestAuthMiddlewareCurrentUser(t *testing.T) {
+	userRepo := mocks.NewInMemoryUserRepository()
+	user, _ := domain.NewUser("firebase-123")
+	user.ID = "user-123"
+	userRepo.Seed(user)
+
+	authProvider := mocks.NewMockAuthProvider()
+	authProvider.AddToken("token", &ports.AuthClaims{UserID: "firebase-123"})
+
+	router := &Router{}
+	router.SetAuthMiddleware(authProvider, userRepo)
+
+	var currentUser string
+	record := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		currentUser = ports.CurrentUserFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("scopes data access to the user", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/me", nil)
+		req.Header.Set("Authorization", "Bearer token")
+
+		rr := httptest.NewRecorder()
+		router.AuthMiddleware(record).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "user-123", currentUser)
+	})
+
+	t.Run("admin endpoints work across users", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/admin/storage/orphans", nil)
+		req.Header.Set("Authorization", "Bearer token")
+
+		rr := httptest.NewRecorder()
+		router.AuthMiddleware(AdminOnly([]string{"user-123"})(record)).ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, currentUser)
+	})
+}
+
+func TestSignedURL(t *testing.T) {
+	signer, err := signedurl.New([]byte(strings.Repeat("k", signedurl.MinKeySize)))
+	require.NoError(t, err)
+
+	handler := SignedURL(signer, "/files/")(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tampered := signer.Sign("resumes/user-1/a.pdf", time.Minute)
+
+	tests := []struct {
+		name           string
+		path           string
+		query          url.Values
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"valid signature", "/files/resumes/user-1/a.pdf", signer.Sign("resumes/user-1/a.pdf", time.Minute), http.StatusOK, ""},
+		{"expired", "/files/resumes/user-1/a.pdf", signer.Sign("resumes/user-1/a.pdf", -time.Minute), http.StatusForbidden, "URL_EXPIRED"},
+		{"signed for another path", "/files/resumes/user-2/b.pdf", tampered, http.StatusForbidden, "INVALID_SIGNATURE"},
+		{"missing signature", "/files/resumes/user-1/a.pdf", url.Values{}, http.StatusForbidden, "INVALID_SIGNATURE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.path+"?"+tt.query.Encode(), nil))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedCode != "" {
+				var body ErrorResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+				assert.Equal(t, tt.expectedCode, body.Error.Code)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package mocks
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
@@ -32,13 +34,13 @@ func (r *InMemoryExperienceRepository) Create(ctx context.Context, experience *d
 	return nil
 }
 
-// GetByID retrieves an experience by ID.
-func (r *InMemoryExperienceRepository) GetByID(ctx context.Context, id string) (*domain.Experience, error) {
+// GetByIDForUser retrieves a user's experience by ID.
+func (r *InMemoryExperienceRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.Experience, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	exp, exists := r.experiences[id]
-	if !exists {
+	if !exists || exp.UserID != userID {
 		return nil, domain.ErrExperienceNotFound
 	}
 
@@ -46,9 +48,9 @@ func (r *InMemoryExperienceRepository) GetByID(ctx context.Context, id string) (
 	return &clone, nil
 }
 
-// GetByIDWithBullets retrieves an experience with all its bullets.
-func (r *InMemoryExperienceRepository) GetByIDWithBullets(ctx context.Context, id string) (*domain.Experience, error) {
-	return r.GetByID(ctx, id)
+// GetByIDWithBulletsForUser retrieves a user's experience with all its bullets.
+func (r *InMemoryExperienceRepository) GetByIDWithBulletsForUser(ctx context.Context, id, userID string) (*domain.Experience, error) {
+	return r.GetByIDForUser(ctx, id, userID)
 }
 
 // ListByUserIDWithBullets lists all experiences for a user.
@@ -97,12 +99,12 @@ func (r *InMemoryExperienceRepository) Update(ctx context.Context, experience *d
 	return nil
 }
 
-// Delete removes an experience and all its bullets.
-func (r *InMemoryExperienceRepository) Delete(ctx context.Context, id string) error {
+// Delete removes a user's experience and all its bullets.
+func (r *InMemoryExperienceRepository) Delete(ctx context.Context, id, userID string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, exists := r.experiences[id]; !exists {
+	if exp, exists := r.experiences[id]; !exists || exp.UserID != userID {
 		return domain.ErrExperienceNotFound
 	}
 
@@ -110,13 +112,13 @@ func (r *InMemoryExperienceRepository) Delete(ctx context.Context, id string) er
 	return nil
 }
 
-// UpdateDisplayOrder updates the display order of experiences.
-func (r *InMemoryExperienceRepository) UpdateDisplayOrder(ctx context.Context, orders []ports.DisplayOrderUpdate) error {
+// UpdateDisplayOrder updates the display order of a user's experiences.
+func (r *InMemoryExperienceRepository) UpdateDisplayOrder(ctx context.Context, userID string, orders []ports.DisplayOrderUpdate) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	for _, order := range orders {
-		if exp, exists := r.experiences[order.ID]; exists {
+		if exp, exists := r.experiences[order.ID]; exists && exp.UserID == userID {
 			exp.DisplayOrder = order.DisplayOrder
 		}
 	}
@@ -124,6 +126,21 @@ func (r *InMemoryExperienceRepository) UpdateDisplayOrder(ctx context.Context, o
 	return nil
 }
 
+// CreateBatch creates experiences; bullets are stored on the experience.
+func (r *InMemoryExperienceRepository) CreateBatch(ctx context.Context, experiences []domain.Experience) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range experiences {
+		if experiences[i].ID == "" {
+			experiences[i].ID = fmt.Sprintf("exp-import-%d", len(r.experiences)+1)
+		}
+		clone := experiences[i]
+		r.experiences[clone.ID] = &clone
+	}
+	return nil
+}
+
 // Seed adds experiences for testing.
 func (r *InMemoryExperienceRepository) Seed(experiences ...*domain.Experience) {
 	r.mu.Lock()
@@ -148,12 +165,27 @@ var _ ports.ExperienceRepository = (*InMemoryExperienceRepository)(nil)
 type InMemoryBulletRepository struct {
 	mu      sync.RWMutex
 	bullets map[string]*domain.Bullet
+
+	// scoredFor holds the content the impact score of each bullet was
+	// computed or given for.
+	scoredFor map[string]string
 }
 
 // NewInMemoryBulletRepository creates a new in-memory bullet repository.
 func NewInMemoryBulletRepository() *InMemoryBulletRepository {
 	return &InMemoryBulletRepository{
-		bullets: make(map[string]*domain.Bullet),
+		bullets:   make(map[string]*domain.Bullet),
+		scoredFor: make(map[string]string),
+	}
+}
+
+// store saves a copy of bullet. The caller must hold the write lock.
+func (r *InMemoryBulletRepository) store(bullet *domain.Bullet) {
+	clone := *bullet
+	clone.ImpactScored = false
+	r.bullets[bullet.ID] = &clone
+	if bullet.ImpactScored {
+		r.scoredFor[bullet.ID] = bullet.Content
 	}
 }
 
@@ -162,13 +194,13 @@ func (r *InMemoryBulletRepository) Create(ctx context.Context, bullet *domain.Bu
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	clone := *bullet
-	r.bullets[bullet.ID] = &clone
+	r.store(bullet)
 	return nil
 }
 
-// GetByID retrieves a bullet by ID.
-func (r *InMemoryBulletRepository) GetByID(ctx context.Context, id string) (*domain.Bullet, error) {
+// GetByIDForUser retrieves a bullet by ID.
+// This mock does not know the owners of experiences, so it ignores userID.
+func (r *InMemoryBulletRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.Bullet, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -237,13 +269,13 @@ func (r *InMemoryBulletRepository) Update(ctx context.Context, bullet *domain.Bu
 		return domain.ErrBulletNotFound
 	}
 
-	clone := *bullet
-	r.bullets[bullet.ID] = &clone
+	r.store(bullet)
 	return nil
 }
 
 // Delete removes a bullet.
-func (r *InMemoryBulletRepository) Delete(ctx context.Context, id string) error {
+// This mock does not know the owners of experiences, so it ignores userID.
+func (r *InMemoryBulletRepository) Delete(ctx context.Context, id, userID string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -252,6 +284,7 @@ func (r *InMemoryBulletRepository) Delete(ctx context.Context, id string) error
 	}
 
 	delete(r.bullets, id)
+	delete(r.scoredFor, id)
 	return nil
 }
 
@@ -260,6 +293,11 @@ func (r *InMemoryBulletRepository) SearchByKeywords(ctx context.Context, userID
 	return r.ListByUserID(ctx, userID)
 }
 
+// SearchSemantic returns no results; the in-memory repository stores no embeddings.
+func (r *InMemoryBulletRepository) SearchSemantic(ctx context.Context, userID string, queryEmbedding []float32, k int) ([]domain.Bullet, error) {
+	return []domain.Bullet{}, nil
+}
+
 // GetHighImpactBullets retrieves bullets with impact score >= threshold.
 func (r *InMemoryBulletRepository) GetHighImpactBullets(ctx context.Context, userID string, minScore int, limit int) ([]domain.Bullet, error) {
 	r.mu.RLock()
@@ -279,13 +317,54 @@ func (r *InMemoryBulletRepository) GetHighImpactBullets(ctx context.Context, use
 	return result, nil
 }
 
+// ListForRescoring lists bullets whose impact score was not computed or
+// given for their current content, least recently updated first.
+func (r *InMemoryBulletRepository) ListForRescoring(ctx context.Context, limit int) ([]domain.Bullet, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []domain.Bullet
+	for id, bullet := range r.bullets {
+		if content, ok := r.scoredFor[id]; !ok || content != bullet.Content {
+			result = append(result, *bullet)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].UpdatedAt.Before(result[j].UpdatedAt)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
+
+// UpdateImpactScores saves the impact scores of bullets whose content is
+// unchanged.
+func (r *InMemoryBulletRepository) UpdateImpactScores(ctx context.Context, bullets []domain.Bullet) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	updated := 0
+	for _, bullet := range bullets {
+		stored, exists := r.bullets[bullet.ID]
+		if !exists || stored.Content != bullet.Content {
+			continue
+		}
+		stored.ImpactScore = bullet.ImpactScore
+		r.scoredFor[bullet.ID] = stored.Content
+		updated++
+	}
+
+	return updated, nil
+}
+
 // Seed adds bullets for testing.
 func (r *InMemoryBulletRepository) Seed(bullets ...*domain.Bullet) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	for _, bullet := range bullets {
-		clone := *bullet
-		r.bullets[bullet.ID] = &clone
+		r.store(bullet)
 	}
 }
 
@@ -294,6 +373,7 @@ func (r *InMemoryBulletRepository) Reset() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.bullets = make(map[string]*domain.Bullet)
+	r.scoredFor = make(map[string]string)
 }
 
 // Verify interface compliance.
@@ -3,7 +3,12 @@ package mocks
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"sync"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
@@ -15,6 +20,7 @@ type InMemoryUserRepository struct {
 	users map[string]*domain.User
 	// byFirebaseUID maps Firebase UID to user ID for quick lookup.
 	byFirebaseUID map[string]string
+	identities    []domain.UserIdentity
 }
 
 // NewInMemoryUserRepository creates a new in-memory user repository.
@@ -42,6 +48,12 @@ func (r *InMemoryUserRepository) Create(ctx context.Context, user *domain.User)
 	return nil
 }
 
+// CreateWithEvents creates a new user. The events are dropped, there is no
+// outbox in memory.
+func (r *InMemoryUserRepository) CreateWithEvents(ctx context.Context, user *domain.User, _ []domain.OutboxEvent) error {
+	return r.Create(ctx, user)
+}
+
 // GetByID retrieves a user by ID.
 func (r *InMemoryUserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
 	r.mu.RLock()
@@ -63,6 +75,13 @@ func (r *InMemoryUserRepository) GetByFirebaseUID(ctx context.Context, firebaseU
 	defer r.mu.RUnlock()
 
 	id, exists := r.byFirebaseUID[firebaseUID]
+	if !exists {
+		for _, identity := range r.identities {
+			if identity.ProviderUserID == firebaseUID {
+				id, exists = identity.UserID, true
+			}
+		}
+	}
 	if !exists {
 		return nil, domain.ErrUserNotFound
 	}
@@ -76,6 +95,29 @@ func (r *InMemoryUserRepository) GetByFirebaseUID(ctx context.Context, firebaseU
 	return &clone, nil
 }
 
+// GetByEmail retrieves the oldest user with an email, compared
+// case-insensitively.
+func (r *InMemoryUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var oldest *domain.User
+	for _, user := range r.users {
+		if user.Email == nil || !strings.EqualFold(*user.Email, email) {
+			continue
+		}
+		if oldest == nil || user.CreatedAt.Before(oldest.CreatedAt) {
+			oldest = user
+		}
+	}
+	if oldest == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	clone := *oldest
+	return &clone, nil
+}
+
 // Update updates an existing user.
 func (r *InMemoryUserRepository) Update(ctx context.Context, user *domain.User) error {
 	r.mu.Lock()
@@ -102,6 +144,9 @@ func (r *InMemoryUserRepository) Delete(ctx context.Context, id string) error {
 
 	delete(r.byFirebaseUID, user.FirebaseUID)
 	delete(r.users, id)
+	r.identities = slices.DeleteFunc(r.identities, func(identity domain.UserIdentity) bool {
+		return identity.UserID == id
+	})
 	return nil
 }
 
@@ -122,6 +167,7 @@ func (r *InMemoryUserRepository) Reset() {
 	defer r.mu.Unlock()
 	r.users = make(map[string]*domain.User)
 	r.byFirebaseUID = make(map[string]string)
+	r.identities = nil
 }
 
 // Seed adds users for testing.
@@ -135,9 +181,209 @@ func (r *InMemoryUserRepository) Seed(users ...*domain.User) {
 	}
 }
 
+// LinkIdentity links an identity to its user.
+func (r *InMemoryUserRepository) LinkIdentity(ctx context.Context, identity *domain.UserIdentity) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.users[identity.UserID]; !exists {
+		return domain.ErrUserNotFound
+	}
+	if identity.ID == "" {
+		identity.ID = uuid.New().String()
+	}
+
+	r.identities = append(r.identities, *identity)
+	return nil
+}
+
+// ListIdentities lists the identities linked to a user, oldest first.
+func (r *InMemoryUserRepository) ListIdentities(ctx context.Context, userID string) ([]domain.UserIdentity, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	identities := []domain.UserIdentity{}
+	for _, identity := range r.identities {
+		if identity.UserID == userID {
+			identities = append(identities, identity)
+		}
+	}
+	return identities, nil
+}
+
+// UnlinkIdentity removes an identity linked to a user.
+func (r *InMemoryUserRepository) UnlinkIdentity(ctx context.Context, id, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, identity := range r.identities {
+		if identity.ID == id && identity.UserID == userID {
+			r.identities = slices.Delete(r.identities, i, i+1)
+			return nil
+		}
+	}
+	return domain.ErrIdentityNotFound
+}
+
 // Verify interface compliance.
 var _ ports.UserRepository = (*InMemoryUserRepository)(nil)
 
+// InMemoryAuditRepository is an in-memory mock implementation of AuditRepository.
+type InMemoryAuditRepository struct {
+	mu     sync.RWMutex
+	events []domain.AuditEvent
+}
+
+// NewInMemoryAuditRepository creates a new in-memory audit repository.
+func NewInMemoryAuditRepository() *InMemoryAuditRepository {
+	return &InMemoryAuditRepository{}
+}
+
+// Record appends an event to the audit log.
+func (r *InMemoryAuditRepository) Record(ctx context.Context, event *domain.AuditEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now().UTC()
+	}
+	r.events = append(r.events, *event)
+	return nil
+}
+
+// Events returns the recorded events, oldest first.
+func (r *InMemoryAuditRepository) Events() []domain.AuditEvent {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.events)
+}
+
+// Verify interface compliance.
+var _ ports.AuditRepository = (*InMemoryAuditRepository)(nil)
+
+// usageEvent is a recorded usage event.
+type usageEvent struct {
+	userID    string
+	action    domain.UsageAction
+	createdAt time.Time
+}
+
+// InMemoryUsageRepository is an in-memory mock implementation of UsageRepository.
+type InMemoryUsageRepository struct {
+	mu     sync.RWMutex
+	events []usageEvent
+}
+
+// NewInMemoryUsageRepository creates a new in-memory usage repository.
+func NewInMemoryUsageRepository() *InMemoryUsageRepository {
+	return &InMemoryUsageRepository{}
+}
+
+// Record records a usage event at the current time.
+func (r *InMemoryUsageRepository) Record(ctx context.Context, userID string, action domain.UsageAction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, usageEvent{userID: userID, action: action, createdAt: time.Now().UTC()})
+	return nil
+}
+
+// CountSince counts a user's events for an action at or after since.
+func (r *InMemoryUsageRepository) CountSince(ctx context.Context, userID string, action domain.UsageAction, since time.Time) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, e := range r.events {
+		if e.userID == userID && e.action == action && !e.createdAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Verify interface compliance.
+var _ ports.UsageRepository = (*InMemoryUsageRepository)(nil)
+
+// InMemorySuggestionRepository is an in-memory mock implementation of
+// SuggestionRepository.
+type InMemorySuggestionRepository struct {
+	mu          sync.RWMutex
+	suggestions map[string][]domain.Suggestion
+}
+
+// NewInMemorySuggestionRepository creates a new in-memory suggestion repository.
+func NewInMemorySuggestionRepository() *InMemorySuggestionRepository {
+	return &InMemorySuggestionRepository{suggestions: make(map[string][]domain.Suggestion)}
+}
+
+// Suggest returns up to limit of the user's values of each type that
+// contain the query, ignoring case, in the order they were seeded.
+func (r *InMemorySuggestionRepository) Suggest(ctx context.Context, userID, query string, limit int) ([]domain.Suggestion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	query = strings.ToLower(query)
+	perType := make(map[domain.SuggestionType]int)
+	result := []domain.Suggestion{}
+	for _, s := range r.suggestions[userID] {
+		if strings.Contains(strings.ToLower(s.Value), query) && perType[s.Type] < limit {
+			perType[s.Type]++
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+// Seed adds values of a user for testing.
+func (r *InMemorySuggestionRepository) Seed(userID string, suggestions ...domain.Suggestion) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.suggestions[userID] = append(r.suggestions[userID], suggestions...)
+}
+
+// Verify interface compliance.
+var _ ports.SuggestionRepository = (*InMemorySuggestionRepository)(nil)
+
+// InMemoryPreferencesRepository is an in-memory mock implementation of PreferencesRepository.
+type InMemoryPreferencesRepository struct {
+	mu    sync.RWMutex
+	prefs map[string]*domain.UserPreferences
+}
+
+// NewInMemoryPreferencesRepository creates a new in-memory preferences repository.
+func NewInMemoryPreferencesRepository() *InMemoryPreferencesRepository {
+	return &InMemoryPreferencesRepository{
+		prefs: make(map[string]*domain.UserPreferences),
+	}
+}
+
+// GetByUserID retrieves a user's preferences.
+func (r *InMemoryPreferencesRepository) GetByUserID(ctx context.Context, userID string) (*domain.UserPreferences, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	prefs, ok := r.prefs[userID]
+	if !ok {
+		return nil, domain.ErrPreferencesNotFound
+	}
+	copied := *prefs
+	return &copied, nil
+}
+
+// Upsert creates or replaces a user's preferences.
+func (r *InMemoryPreferencesRepository) Upsert(ctx context.Context, prefs *domain.UserPreferences) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	copied := *prefs
+	r.prefs[prefs.UserID] = &copied
+	return nil
+}
+
+// Verify interface compliance.
+var _ ports.PreferencesRepository = (*InMemoryPreferencesRepository)(nil)
+
 // MockAuthProvider is a mock implementation of AuthProvider for testing.
 type MockAuthProvider struct {
 	mu        sync.RWMutex
@@ -190,3 +436,63 @@ func (p *MockAuthProvider) Close() error {
 
 // Verify interface compliance.
 var _ ports.AuthProvider = (*MockAuthProvider)(nil)
+
+// MockSessionManager is a mock implementation of SessionManager for testing.
+type MockSessionManager struct {
+	mu       sync.RWMutex
+	accounts map[string]*ports.AuthAccount
+	revoked  map[string]int
+}
+
+// NewMockSessionManager creates a new mock session manager.
+func NewMockSessionManager() *MockSessionManager {
+	return &MockSessionManager{
+		accounts: make(map[string]*ports.AuthAccount),
+		revoked:  make(map[string]int),
+	}
+}
+
+// GetAccount returns the account added for a provider user ID.
+func (m *MockSessionManager) GetAccount(ctx context.Context, providerUserID string) (*ports.AuthAccount, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	account, exists := m.accounts[providerUserID]
+	if !exists {
+		return nil, domain.ErrUserNotFound
+	}
+	clone := *account
+	return &clone, nil
+}
+
+// RevokeSessions records a revocation and sets the account's ValidSince.
+func (m *MockSessionManager) RevokeSessions(ctx context.Context, providerUserID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	account, exists := m.accounts[providerUserID]
+	if !exists {
+		return domain.ErrUserNotFound
+	}
+	account.ValidSince = time.Now().UTC()
+	m.revoked[providerUserID]++
+	return nil
+}
+
+// AddAccount adds the account of a provider user ID.
+func (m *MockSessionManager) AddAccount(providerUserID string, account *ports.AuthAccount) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.accounts[providerUserID] = account
+}
+
+// Revocations returns how many times the sessions of a provider user ID
+// were revoked.
+func (m *MockSessionManager) Revocations(providerUserID string) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.revoked[providerUserID]
+}
+
+// Verify interface compliance.
+var _ ports.SessionManager = (*MockSessionManager)(nil)
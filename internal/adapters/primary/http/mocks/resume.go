@@ -0,0 +1,213 @@
+package mocks
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// InMemoryResumeRepository is an in-memory mock implementation of ResumeRepository.
+type InMemoryResumeRepository struct {
+	mu      sync.RWMutex
+	resumes map[string]*domain.Resume
+}
+
+// NewInMemoryResumeRepository creates a new in-memory resume repository.
+func NewInMemoryResumeRepository() *InMemoryResumeRepository {
+	return &InMemoryResumeRepository{
+		resumes: make(map[string]*domain.Resume),
+	}
+}
+
+// Create creates a new resume.
+func (r *InMemoryResumeRepository) Create(ctx context.Context, resume *domain.Resume) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+	resume.CreatedAt = now
+	resume.UpdatedAt = now
+	clone := *resume
+	r.resumes[resume.ID] = &clone
+	return nil
+}
+
+// GetByIDForUser retrieves a user's resume by ID.
+func (r *InMemoryResumeRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.Resume, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	resume, exists := r.resumes[id]
+	if !exists || resume.UserID != userID {
+		return nil, domain.ErrResumeNotFound
+	}
+	clone := *resume
+	return &clone, nil
+}
+
+// ListByUserID lists all resumes for a user, newest first.
+func (r *InMemoryResumeRepository) ListByUserID(ctx context.Context, userID string, opts ports.ListOptions) ([]domain.Resume, int, error) {
+	return r.ListByUserIDFiltered(ctx, userID, ports.ResumeFilter{}, opts)
+}
+
+// ListByUserIDFiltered lists resumes matching the status and archived
+// filters, in the filter's order, summarized when asked; the other criteria
+// are ignored.
+func (r *InMemoryResumeRepository) ListByUserIDFiltered(ctx context.Context, userID string, filter ports.ResumeFilter, opts ports.ListOptions) ([]domain.Resume, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []domain.Resume
+	for _, resume := range r.resumes {
+		if resume.UserID != userID {
+			continue
+		}
+		if filter.Status != nil && resume.Status != *filter.Status {
+			continue
+		}
+		if filter.Archived != nil && resume.IsArchived() != *filter.Archived {
+			continue
+		}
+		listed := *resume
+		if filter.Summary {
+			listed.JobDescription = ""
+			listed.GeneratedContent = nil
+			listed.Selection = nil
+		}
+		result = append(result, listed)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		switch filter.Sort {
+		case domain.ResumeSortRecent:
+			if (a.LastAccessedAt == nil) != (b.LastAccessedAt == nil) {
+				return a.LastAccessedAt != nil
+			}
+			if a.LastAccessedAt != nil && !a.LastAccessedAt.Equal(*b.LastAccessedAt) {
+				return a.LastAccessedAt.After(*b.LastAccessedAt)
+			}
+		case domain.ResumeSortPinnedFirst:
+			if a.Pinned != b.Pinned {
+				return a.Pinned
+			}
+		}
+		return a.CreatedAt.After(b.CreatedAt)
+	})
+
+	total := len(result)
+	start := min(opts.Offset, total)
+	end := total
+	if opts.Limit > 0 {
+		end = min(start+opts.Limit, total)
+	}
+	return result[start:end], total, nil
+}
+
+// Update updates an existing resume.
+func (r *InMemoryResumeRepository) Update(ctx context.Context, resume *domain.Resume) error {
+	return r.UpdateMany(ctx, []*domain.Resume{resume}, nil)
+}
+
+// UpdateWithEvents updates an existing resume. The events are dropped, there
+// is no outbox in memory.
+func (r *InMemoryResumeRepository) UpdateWithEvents(ctx context.Context, resume *domain.Resume, _ []domain.OutboxEvent) error {
+	return r.Update(ctx, resume)
+}
+
+// UpdateMany updates existing resumes, all or none. The events are dropped.
+func (r *InMemoryResumeRepository) UpdateMany(ctx context.Context, resumes []*domain.Resume, _ []domain.OutboxEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, resume := range resumes {
+		if _, exists := r.resumes[resume.ID]; !exists {
+			return domain.ErrResumeNotFound
+		}
+	}
+	for _, resume := range resumes {
+		resume.UpdatedAt = time.Now().UTC()
+		clone := *resume
+		r.resumes[resume.ID] = &clone
+	}
+	return nil
+}
+
+// MarkAccessed records that a resume was opened at the given time.
+func (r *InMemoryResumeRepository) MarkAccessed(ctx context.Context, id string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if resume, exists := r.resumes[id]; exists && (resume.LastAccessedAt == nil || resume.LastAccessedAt.Before(at)) {
+		resume.LastAccessedAt = &at
+	}
+	return nil
+}
+
+// Delete removes a user's resume.
+func (r *InMemoryResumeRepository) Delete(ctx context.Context, id, userID string) error {
+	return r.DeleteMany(ctx, []string{id}, userID)
+}
+
+// DeleteMany removes a user's resumes, all or none.
+func (r *InMemoryResumeRepository) DeleteMany(ctx context.Context, ids []string, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range ids {
+		if resume, exists := r.resumes[id]; !exists || resume.UserID != userID {
+			return domain.ErrResumeNotFound
+		}
+	}
+	for _, id := range ids {
+		delete(r.resumes, id)
+	}
+	return nil
+}
+
+// OwnersByID returns the user IDs of the resumes with the given IDs.
+func (r *InMemoryResumeRepository) OwnersByID(ctx context.Context, ids []string) (map[string]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	owners := make(map[string]string, len(ids))
+	for _, id := range ids {
+		if resume, exists := r.resumes[id]; exists {
+			owners[id] = resume.UserID
+		}
+	}
+	return owners, nil
+}
+
+// UpdatePDFURLs replaces the PDF URLs of resumes that have one.
+func (r *InMemoryResumeRepository) UpdatePDFURLs(ctx context.Context, updates []ports.PDFURLUpdate) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	updated := 0
+	for _, update := range updates {
+		if resume, exists := r.resumes[update.ResumeID]; exists && resume.PDFURL != nil {
+			url := update.URL
+			resume.PDFURL = &url
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// Seed adds resumes for testing.
+func (r *InMemoryResumeRepository) Seed(resumes ...*domain.Resume) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, resume := range resumes {
+		clone := *resume
+		r.resumes[resume.ID] = &clone
+	}
+}
+
+// Verify interface compliance.
+var _ ports.ResumeRepository = (*InMemoryResumeRepository)(nil)
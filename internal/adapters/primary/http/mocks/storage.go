@@ -0,0 +1,151 @@
+package mocks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// InMemoryFileStorage is an in-memory mock implementation of FileStorage.
+type InMemoryFileStorage struct {
+	mu    sync.RWMutex
+	files map[string][]byte
+}
+
+// NewInMemoryFileStorage creates a new in-memory file storage.
+func NewInMemoryFileStorage() *InMemoryFileStorage {
+	return &InMemoryFileStorage{
+		files: make(map[string][]byte),
+	}
+}
+
+// Upload stores a file.
+func (s *InMemoryFileStorage) Upload(ctx context.Context, req ports.UploadRequest) (*ports.UploadResult, error) {
+	data, err := io.ReadAll(req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[req.Key] = data
+
+	return &ports.UploadResult{
+		Key:  req.Key,
+		URL:  "memory://" + req.Key,
+		Size: int64(len(data)),
+	}, nil
+}
+
+// Download returns a file by its key.
+func (s *InMemoryFileStorage) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, exists := s.files[key]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", domain.ErrFileNotFound, key)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// Delete removes a file.
+func (s *InMemoryFileStorage) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.files, key)
+	return nil
+}
+
+// GetURL returns the URL of a file.
+func (s *InMemoryFileStorage) GetURL(ctx context.Context, key string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, exists := s.files[key]; !exists {
+		return "", fmt.Errorf("%w: %s", domain.ErrFileNotFound, key)
+	}
+	return "memory://" + key, nil
+}
+
+// SignURL returns the URL of a file without checking that it exists.
+func (s *InMemoryFileStorage) SignURL(key string) (string, error) {
+	return "memory://" + key, nil
+}
+
+// List returns the files whose key starts with prefix, sorted by key.
+// Update times are not tracked.
+func (s *InMemoryFileStorage) List(ctx context.Context, prefix string) ([]ports.FileInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var files []ports.FileInfo
+	for key, data := range s.files {
+		if strings.HasPrefix(key, prefix) {
+			files = append(files, ports.FileInfo{Key: key, Size: int64(len(data))})
+		}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Key < files[j].Key })
+	return files, nil
+}
+
+// Close releases any resources held by the storage.
+func (s *InMemoryFileStorage) Close() error {
+	return nil
+}
+
+// Len returns the number of stored files.
+func (s *InMemoryFileStorage) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.files)
+}
+
+// Ensure InMemoryFileStorage implements FileStorage.
+var _ ports.FileStorage = (*InMemoryFileStorage)(nil)
+
+// MockAvatarFetcher is a mock implementation of AvatarFetcher that returns
+// the pictures added with AddPicture, as they are.
+type MockAvatarFetcher struct {
+	mu       sync.RWMutex
+	pictures map[string][]byte
+}
+
+// NewMockAvatarFetcher creates a new mock avatar fetcher.
+func NewMockAvatarFetcher() *MockAvatarFetcher {
+	return &MockAvatarFetcher{
+		pictures: make(map[string][]byte),
+	}
+}
+
+// FetchAvatar returns the picture added for url. Other URLs are not allowed.
+func (f *MockAvatarFetcher) FetchAvatar(ctx context.Context, url string) ([]byte, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	picture, exists := f.pictures[url]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", domain.ErrURLNotAllowed, url)
+	}
+	return picture, nil
+}
+
+// AddPicture adds the picture returned for url.
+func (f *MockAvatarFetcher) AddPicture(url string, picture []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.pictures[url] = picture
+}
+
+// Ensure MockAvatarFetcher implements AvatarFetcher.
+var _ ports.AvatarFetcher = (*MockAvatarFetcher)(nil)
@@ -0,0 +1,118 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// PreferencesHandler handles user preferences HTTP requests.
+type PreferencesHandler struct {
+	preferencesService *services.PreferencesService
+}
+
+// NewPreferencesHandler creates a new PreferencesHandler.
+func NewPreferencesHandler(preferencesService *services.PreferencesService) *PreferencesHandler {
+	return &PreferencesHandler{
+		preferencesService: preferencesService,
+	}
+}
+
+// GetPreferences returns the authenticated user's preferences.
+//
+//	@Summary		Get current user preferences
+//	@Description	Returns the defaults used when creating, tailoring and rendering resumes, plus email opt-ins. Users who never saved preferences get the system defaults.
+//	@Tags			user
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	PreferencesResponse
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/users/me/preferences [get]
+func (h *PreferencesHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	prefs, err := h.preferencesService.GetPreferences(r.Context(), authUser.ID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to get preferences")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve preferences")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapPreferencesToResponse(prefs))
+}
+
+// UpdatePreferences updates the authenticated user's preferences.
+//
+//	@Summary		Update current user preferences
+//	@Description	Updates the given preference fields; omitted fields are left unchanged. default_template is jake, europass, academic or functional, default_target_language is en or pt-br, default_max_bullets is 1-50, default_font_size is 9-12 (0 keeps the template default), date_format is locale, mon_yyyy, mm/yyyy or yyyy-mm, timezone is an IANA name, and filename_pattern names downloaded files using the tokens {name}, {company}, {job_title}, {target}, {language} and {date}.
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		UpdatePreferencesRequest	true	"Preferences to change"
+//	@Success		200		{object}	PreferencesResponse
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		422		{object}	ErrorResponse	"Validation failed"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/users/me/preferences [patch]
+func (h *PreferencesHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req UpdatePreferencesRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	prefs, err := h.preferencesService.UpdatePreferences(r.Context(), services.UpdatePreferencesRequest{
+		UserID:                authUser.ID,
+		DefaultTemplate:       req.DefaultTemplate,
+		DefaultTargetLanguage: req.DefaultTargetLanguage,
+		DefaultMaxBullets:     req.DefaultMaxBullets,
+		DefaultFontSize:       req.DefaultFontSize,
+		DateFormat:            req.DateFormat,
+		Timezone:              req.Timezone,
+		FilenamePattern:       req.FilenamePattern,
+		EmailProductUpdates:   req.EmailProductUpdates,
+		EmailUsageAlerts:      req.EmailUsageAlerts,
+	})
+	if err != nil {
+		if handleValidationError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to update preferences")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to update preferences")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapPreferencesToResponse(prefs))
+}
+
+// mapPreferencesToResponse maps domain UserPreferences to a PreferencesResponse.
+func mapPreferencesToResponse(prefs *domain.UserPreferences) PreferencesResponse {
+	return PreferencesResponse{
+		DefaultTemplate:       prefs.DefaultTemplate,
+		DefaultTargetLanguage: prefs.DefaultTargetLanguage,
+		DefaultMaxBullets:     prefs.DefaultMaxBullets,
+		DefaultFontSize:       prefs.DefaultFontSize,
+		DateFormat:            string(prefs.DateFormat),
+		Timezone:              prefs.Timezone,
+		FilenamePattern:       string(prefs.FilenamePattern),
+		EmailProductUpdates:   prefs.EmailProductUpdates,
+		EmailUsageAlerts:      prefs.EmailUsageAlerts,
+		UpdatedAt:             prefs.UpdatedAt,
+	}
+}
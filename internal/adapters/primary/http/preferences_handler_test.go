@@ -0,0 +1,100 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http/mocks"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestPreferencesHandler(t *testing.T) {
+	prefsRepo := mocks.NewInMemoryPreferencesRepository()
+	handler := NewPreferencesHandler(services.NewPreferencesService(prefsRepo))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	t.Run("success - returns defaults when nothing is saved", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/users/me/preferences", nil)
+		req = req.WithContext(ctx)
+
+		rr := executeRequest(t, req, handler.GetPreferences)
+		assertStatusCode(t, http.StatusOK, rr)
+
+		var resp PreferencesResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, "jake", resp.DefaultTemplate)
+		assert.Equal(t, "en", resp.DefaultTargetLanguage)
+		assert.Equal(t, 15, resp.DefaultMaxBullets)
+		assert.Equal(t, "locale", resp.DateFormat)
+		assert.Equal(t, "UTC", resp.Timezone)
+		assert.Equal(t, "{name}_Resume_{target}", resp.FilenamePattern)
+	})
+
+	t.Run("success - partial update keeps other fields", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodPatch, "/v1/users/me/preferences", map[string]any{
+			"default_template":   "europass",
+			"date_format":        "mm/yyyy",
+			"timezone":           "America/Sao_Paulo",
+			"email_usage_alerts": true,
+		})
+		req = req.WithContext(ctx)
+
+		rr := executeRequest(t, req, handler.UpdatePreferences)
+		assertStatusCode(t, http.StatusOK, rr)
+
+		req = newJSONRequest(t, http.MethodGet, "/v1/users/me/preferences", nil)
+		req = req.WithContext(ctx)
+		rr = executeRequest(t, req, handler.GetPreferences)
+
+		var resp PreferencesResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, "europass", resp.DefaultTemplate)
+		assert.Equal(t, "mm/yyyy", resp.DateFormat)
+		assert.Equal(t, "America/Sao_Paulo", resp.Timezone)
+		assert.True(t, resp.EmailUsageAlerts)
+		assert.Equal(t, 15, resp.DefaultMaxBullets)
+	})
+
+	t.Run("error - invalid values", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodPatch, "/v1/users/me/preferences", map[string]any{
+			"default_max_bullets": 0,
+			"timezone":            "Mars/Olympus_Mons",
+		})
+		req = req.WithContext(ctx)
+
+		rr := executeRequest(t, req, handler.UpdatePreferences)
+		assertErrorResponse(t, rr, http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+
+		var resp ErrorResponse
+		parseJSONResponse(t, rr, &resp)
+		require.Len(t, resp.Error.Details, 2)
+	})
+
+	t.Run("error - invalid filename pattern", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodPatch, "/v1/users/me/preferences", map[string]any{
+			"filename_pattern": "{name}_{salary}",
+		})
+		req = req.WithContext(ctx)
+
+		rr := executeRequest(t, req, handler.UpdatePreferences)
+		assertErrorResponse(t, rr, http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - invalid JSON", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodPatch, "/v1/users/me/preferences", "not an object")
+		req = req.WithContext(ctx)
+
+		rr := executeRequest(t, req, handler.UpdatePreferences)
+		assertErrorResponse(t, rr, http.StatusBadRequest, "INVALID_REQUEST")
+	})
+
+	t.Run("error - user not authenticated", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/users/me/preferences", nil)
+
+		rr := executeRequest(t, req, handler.GetPreferences)
+		assertErrorResponse(t, rr, http.StatusUnauthorized, "UNAUTHORIZED")
+	})
+}
@@ -0,0 +1,83 @@
+package http
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// problemContentType is the media type of RFC 7807 problem details.
+const problemContentType = "application/problem+json"
+
+// problemTypePrefix prefixes the error code in the type of problems, so that
+// every error code is a distinct problem type.
+const problemTypePrefix = "urn:chameleon-vitae:error:"
+
+// Problem is an error response in the RFC 7807 problem details format,
+// written instead of ErrorResponse to clients accepting
+// application/problem+json.
+type Problem struct {
+	Type     string `json:"type" example:"urn:chameleon-vitae:error:VALIDATION_ERROR"`
+	Title    string `json:"title" example:"Unprocessable Entity"`
+	Status   int    `json:"status" example:"422"`
+	Detail   string `json:"detail" example:"Validation failed"`
+	Instance string `json:"instance" example:"/v1/experiences"`
+
+	// Code is the error code of ErrorResponse.
+	Code string `json:"code" example:"VALIDATION_ERROR"`
+
+	// Errors are the field errors of validation failures.
+	Errors []ErrorDetail `json:"errors,omitempty"`
+
+	// RequestID identifies the request in the server logs; it is set on
+	// unexpected errors.
+	RequestID string `json:"request_id,omitempty" example:"host/abc123-000001"`
+}
+
+// ProblemDetails is a middleware that writes error responses as RFC 7807
+// problem details to clients accepting application/problem+json.
+func ProblemDetails(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if acceptsProblem(r.Header.Get("Accept")) {
+			var format *formatWriter
+			w, format = withFormat(w)
+			format.problem = true
+			format.instance = r.URL.RequestURI()
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// acceptsProblem reports whether an Accept header accepts
+// application/problem+json explicitly, with a non-zero quality.
+func acceptsProblem(accept string) bool {
+	for mediaRange := range strings.SplitSeq(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil || mediaType != problemContentType {
+			continue
+		}
+		if q, ok := params["q"]; ok {
+			if quality, err := strconv.ParseFloat(q, 64); err != nil || quality == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// problem returns the problem details of an error response with the given
+// status, about instance.
+func problem(status int, body ErrorBody, instance string) Problem {
+	return Problem{
+		Type:      problemTypePrefix + body.Code,
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    body.Message,
+		Instance:  instance,
+		Code:      body.Code,
+		Errors:    body.Details,
+		RequestID: body.RequestID,
+	}
+}
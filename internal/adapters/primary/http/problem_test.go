@@ -0,0 +1,93 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptsProblem(t *testing.T) {
+	tests := []struct {
+		accept   string
+		expected bool
+	}{
+		{"", false},
+		{"application/json", false},
+		{"*/*", false},
+		{"application/problem+json", true},
+		{"application/json, application/problem+json;q=0.9", true},
+		{"application/problem+json;q=0", false},
+		{"Application/Problem+JSON", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			assert.Equal(t, tt.expected, acceptsProblem(tt.accept))
+		})
+	}
+}
+
+func TestProblemDetails(t *testing.T) {
+	failing := func(w http.ResponseWriter, r *http.Request) {
+		respondErrorWithDetails(w, http.StatusUnprocessableEntity, "VALIDATION_ERROR", "Validation failed",
+			[]ErrorDetail{{Field: "title", Message: "is required"}})
+	}
+
+	t.Run("problem details when accepted", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/experiences?dry_run=true", nil)
+		req.Header.Set("Accept", "application/problem+json")
+		rr := httptest.NewRecorder()
+		ProblemDetails(http.HandlerFunc(failing)).ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"type": "urn:chameleon-vitae:error:VALIDATION_ERROR",
+			"title": "Unprocessable Entity",
+			"status": 422,
+			"detail": "Validation failed",
+			"instance": "/v1/experiences?dry_run=true",
+			"code": "VALIDATION_ERROR",
+			"errors": [{"field": "title", "message": "is required"}]
+		}`, rr.Body.String())
+	})
+
+	t.Run("error response otherwise", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/experiences", nil)
+		rr := httptest.NewRecorder()
+		ProblemDetails(http.HandlerFunc(failing)).ServeHTTP(rr, req)
+
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+		assertErrorResponse(t, rr, http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("success responses are unchanged", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v2/health", nil)
+		req.Header.Set("Accept", "application/problem+json")
+		rr := httptest.NewRecorder()
+		ProblemDetails(Enveloped(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			respondJSON(w, http.StatusOK, HealthResponse{Status: "healthy"})
+		}))).ServeHTTP(rr, req)
+
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+		var resp Envelope[HealthResponse]
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, "healthy", resp.Data.Status)
+	})
+
+	t.Run("problem details replace the envelope", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/v2/experiences", nil)
+		req.Header.Set("Accept", "application/problem+json")
+		rr := httptest.NewRecorder()
+		ProblemDetails(Enveloped(http.HandlerFunc(failing))).ServeHTTP(rr, req)
+
+		var resp Problem
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, 422, resp.Status)
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "title", resp.Errors[0].Field)
+	})
+}
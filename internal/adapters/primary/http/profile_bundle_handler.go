@@ -0,0 +1,203 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/pkg/filename"
+	"github.com/SeltikHD/chameleon-vitae/pkg/yamljson"
+)
+
+// ProfileBundleHandler handles the master profile as a single document.
+type ProfileBundleHandler struct {
+	bundleService *services.ProfileBundleService
+}
+
+// NewProfileBundleHandler creates a new ProfileBundleHandler.
+func NewProfileBundleHandler(bundleService *services.ProfileBundleService) *ProfileBundleHandler {
+	return &ProfileBundleHandler{
+		bundleService: bundleService,
+	}
+}
+
+// GetBundle returns the authenticated user's master profile as one document.
+//
+//	@Summary		Get profile bundle
+//	@Description	Returns the whole master profile (profile fields, experiences with bullets, education, projects with bullets, skills and spoken languages) as one versioned document, for keeping it under version control and editing it in a text editor. Sent as YAML with format=yaml or an Accept header of application/yaml, as JSON otherwise.
+//	@Tags			user
+//	@Produce		json,application/yaml
+//	@Security		BearerAuth
+//	@Param			format	query		string	false	"Document format"	Enums(json, yaml)
+//	@Success		200		{object}	services.ProfileBundle
+//	@Failure		400		{object}	ErrorResponse	"Unsupported format"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/users/me/profile-bundle [get]
+func (h *ProfileBundleHandler) GetBundle(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	asYAML, err := bundleFormat(r)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "INVALID_FORMAT", "Unsupported bundle format")
+		return
+	}
+
+	bundle, err := h.bundleService.GetBundle(r.Context(), authUser.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			respondError(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to get profile bundle")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve profile bundle")
+		return
+	}
+
+	respondBundle(w, authUser.ID, bundle, asYAML)
+}
+
+// ReplaceBundle replaces the authenticated user's master profile.
+//
+//	@Summary		Replace profile bundle
+//	@Description	Replaces the whole master profile with a document read from GET /v1/users/me/profile-bundle, as JSON or, with a Content-Type of application/yaml, as YAML. Records are matched by ID: records with one of the user's IDs are updated, others are created, and the user's records missing from the document are deleted. Records are ordered as listed; owner IDs, display orders and timestamps are ignored. The whole document is validated before anything is saved, and errors name the path of the field, such as experiences[0].bullets[1].content. Returns the saved profile in the format of the request.
+//	@Tags			user
+//	@Accept			json,application/yaml
+//	@Produce		json,application/yaml
+//	@Security		BearerAuth
+//	@Param			request	body		services.ProfileBundle	true	"Profile bundle"
+//	@Success		200		{object}	services.ProfileBundle
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		413		{object}	ErrorResponse	"Request body too large"
+//	@Failure		422		{object}	ErrorResponse	"Validation failed"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/users/me/profile-bundle [put]
+func (h *ProfileBundleHandler) ReplaceBundle(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	asYAML := isYAML(r.Header.Get("Content-Type"))
+
+	var bundle services.ProfileBundle
+	if asYAML {
+		if !decodeYAMLBundle(w, r, &bundle) {
+			return
+		}
+	} else if err := decodeJSON(r, &bundle); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	saved, err := h.bundleService.ReplaceBundle(r.Context(), authUser.ID, &bundle)
+	if err != nil {
+		if handleValidationError(w, err) {
+			return
+		}
+		if errors.Is(err, domain.ErrUserNotFound) {
+			respondError(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to replace profile bundle")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to save profile bundle")
+		return
+	}
+
+	respondBundle(w, authUser.ID, saved, asYAML)
+}
+
+// bundleFormat reports whether the profile bundle is requested as YAML,
+// with the "format" query parameter or else the Accept header.
+func bundleFormat(r *http.Request) (asYAML bool, err error) {
+	switch r.URL.Query().Get("format") {
+	case "yaml":
+		return true, nil
+	case "json":
+		return false, nil
+	case "":
+		return isYAML(r.Header.Get("Accept")), nil
+	default:
+		return false, errors.New("unsupported format")
+	}
+}
+
+// isYAML reports whether a Content-Type or Accept header names YAML.
+func isYAML(header string) bool {
+	for _, mediaType := range []string{"application/yaml", "application/x-yaml", "text/yaml"} {
+		if strings.Contains(header, mediaType) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeYAMLBundle decodes a YAML request body into bundle, writing the
+// error response and returning false when it is invalid.
+func decodeYAMLBundle(w http.ResponseWriter, r *http.Request, bundle *services.ProfileBundle) bool {
+	if r.Body == nil {
+		respondDecodeError(w, ErrEmptyRequestBody)
+		return false
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		respondDecodeError(w, err)
+		return false
+	}
+
+	data, err := yamljson.ToJSON(body)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid YAML: "+err.Error())
+		return false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(bundle); err != nil {
+		respondDecodeError(w, err)
+		return false
+	}
+	return true
+}
+
+// respondBundle writes a profile bundle as YAML or JSON.
+func respondBundle(w http.ResponseWriter, userID string, bundle *services.ProfileBundle, asYAML bool) {
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	if !asYAML {
+		respondJSON(w, http.StatusOK, bundle)
+		return
+	}
+
+	data, err := json.Marshal(bundle)
+	if err == nil {
+		data, err = yamljson.FromJSON(data)
+	}
+	if err != nil {
+		log.Error().Err(err).Str("user_id", userID).Msg("Failed to write profile bundle as YAML")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve profile bundle")
+		return
+	}
+
+	w.Header().Set("Content-Type", yamljson.ContentType)
+	w.Header().Set("Content-Disposition", filename.ContentDisposition("inline", "profile.yaml"))
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(data)))
+	w.WriteHeader(http.StatusOK)
+
+	if _, writeErr := w.Write(data); writeErr != nil {
+		log.Error().Err(writeErr).Str("user_id", userID).Msg("Failed to write profile bundle response")
+	}
+}
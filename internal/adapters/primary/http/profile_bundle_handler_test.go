@@ -0,0 +1,79 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileBundleHandler(t *testing.T) {
+	// Requests rejected before reaching the service need none.
+	handler := NewProfileBundleHandler(nil)
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	putYAML := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPut, "/v1/users/me/profile-bundle", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/yaml")
+		return req.WithContext(ctx)
+	}
+
+	t.Run("error - unauthenticated", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/users/me/profile-bundle", nil)
+
+		rr := executeRequest(t, req, handler.GetBundle)
+		assertErrorResponse(t, rr, http.StatusUnauthorized, "UNAUTHORIZED")
+	})
+
+	t.Run("error - unsupported format", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/users/me/profile-bundle?format=xml", nil)
+		req = req.WithContext(ctx)
+
+		rr := executeRequest(t, req, handler.GetBundle)
+		assertErrorResponse(t, rr, http.StatusBadRequest, "INVALID_FORMAT")
+	})
+
+	t.Run("error - invalid YAML", func(t *testing.T) {
+		rr := executeRequest(t, putYAML("version: [1"), handler.ReplaceBundle)
+		assertErrorResponse(t, rr, http.StatusBadRequest, "INVALID_REQUEST")
+	})
+
+	t.Run("error - unknown field in YAML", func(t *testing.T) {
+		rr := executeRequest(t, putYAML("version: 1\nresumes: []\n"), handler.ReplaceBundle)
+		assertErrorResponse(t, rr, http.StatusBadRequest, "INVALID_REQUEST")
+	})
+}
+
+func TestBundleFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		accept string
+		yaml   bool
+		err    bool
+	}{
+		{name: "default", yaml: false},
+		{name: "yaml query", query: "?format=yaml", yaml: true},
+		{name: "json query over accept", query: "?format=json", accept: "application/yaml", yaml: false},
+		{name: "yaml accept", accept: "application/yaml", yaml: true},
+		{name: "legacy yaml accept", accept: "application/x-yaml, */*", yaml: true},
+		{name: "unsupported", query: "?format=toml", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/users/me/profile-bundle"+tt.query, nil)
+			req.Header.Set("Accept", tt.accept)
+
+			asYAML, err := bundleFormat(req)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.yaml, asYAML)
+		})
+	}
+}
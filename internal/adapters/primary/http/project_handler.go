@@ -87,7 +87,7 @@ func (h *ProjectHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	var req CreateProjectRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
@@ -102,7 +102,7 @@ func (h *ProjectHandler) Create(w http.ResponseWriter, r *http.Request) {
 	if req.StartDate != nil {
 		d, err := domain.ParseDate(*req.StartDate)
 		if err != nil {
-			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid start_date format")
+			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid start_date format, expected YYYY-MM-DD or YYYY-MM")
 			return
 		}
 		startDate = &d
@@ -110,7 +110,7 @@ func (h *ProjectHandler) Create(w http.ResponseWriter, r *http.Request) {
 	if req.EndDate != nil {
 		d, err := domain.ParseDate(*req.EndDate)
 		if err != nil {
-			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid end_date format")
+			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid end_date format, expected YYYY-MM-DD or YYYY-MM")
 			return
 		}
 		endDate = &d
@@ -185,7 +185,7 @@ func (h *ProjectHandler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	project, err := h.projectService.GetProject(r.Context(), projectID)
+	project, err := h.projectService.GetProject(r.Context(), projectID, authUser.ID)
 	if err != nil {
 		if errors.Is(err, domain.ErrProjectNotFound) {
 			respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
@@ -196,12 +196,6 @@ func (h *ProjectHandler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership.
-	if project.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
-		return
-	}
-
 	respondJSON(w, http.StatusOK, mapProjectToResponse(project))
 }
 
@@ -235,24 +229,9 @@ func (h *ProjectHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership.
-	existing, err := h.projectService.GetProject(r.Context(), projectID)
-	if err != nil {
-		if errors.Is(err, domain.ErrProjectNotFound) {
-			respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
-			return
-		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve project")
-		return
-	}
-	if existing.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
-		return
-	}
-
 	var req UpdateProjectRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
@@ -261,7 +240,7 @@ func (h *ProjectHandler) Update(w http.ResponseWriter, r *http.Request) {
 	if req.StartDate != nil {
 		d, err := domain.ParseDate(*req.StartDate)
 		if err != nil {
-			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid start_date format")
+			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid start_date format, expected YYYY-MM-DD or YYYY-MM")
 			return
 		}
 		startDate = &d
@@ -269,7 +248,7 @@ func (h *ProjectHandler) Update(w http.ResponseWriter, r *http.Request) {
 	if req.EndDate != nil {
 		d, err := domain.ParseDate(*req.EndDate)
 		if err != nil {
-			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid end_date format")
+			respondError(w, http.StatusBadRequest, "VALIDATION_ERROR", "Invalid end_date format, expected YYYY-MM-DD or YYYY-MM")
 			return
 		}
 		endDate = &d
@@ -277,6 +256,7 @@ func (h *ProjectHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	svcReq := services.UpdateProjectRequest{
 		ProjectID:     projectID,
+		UserID:        authUser.ID,
 		Name:          req.Name,
 		Description:   req.Description,
 		TechStack:     req.TechStack,
@@ -289,6 +269,10 @@ func (h *ProjectHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	project, err := h.projectService.UpdateProject(r.Context(), svcReq)
 	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
+			return
+		}
 		if handleValidationError(w, err) {
 			return
 		}
@@ -326,22 +310,11 @@ func (h *ProjectHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership.
-	existing, err := h.projectService.GetProject(r.Context(), projectID)
-	if err != nil {
+	if err := h.projectService.DeleteProject(r.Context(), projectID, authUser.ID); err != nil {
 		if errors.Is(err, domain.ErrProjectNotFound) {
 			respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
 			return
 		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve project")
-		return
-	}
-	if existing.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
-		return
-	}
-
-	if err := h.projectService.DeleteProject(r.Context(), projectID); err != nil {
 		log.Error().Err(err).Str("project_id", projectID).Msg("Failed to delete project")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete project")
 		return
@@ -379,24 +352,9 @@ func (h *ProjectHandler) AddBullet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership.
-	project, err := h.projectService.GetProject(r.Context(), projectID)
-	if err != nil {
-		if errors.Is(err, domain.ErrProjectNotFound) {
-			respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
-			return
-		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve project")
-		return
-	}
-	if project.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
-		return
-	}
-
 	var req CreateProjectBulletRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
@@ -407,12 +365,17 @@ func (h *ProjectHandler) AddBullet(w http.ResponseWriter, r *http.Request) {
 
 	svcReq := services.AddProjectBulletRequest{
 		ProjectID:    projectID,
+		UserID:       authUser.ID,
 		Content:      req.Content,
 		DisplayOrder: req.DisplayOrder,
 	}
 
 	bullet, err := h.projectService.AddProjectBullet(r.Context(), svcReq)
 	if err != nil {
+		if errors.Is(err, domain.ErrProjectNotFound) {
+			respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
+			return
+		}
 		log.Error().Err(err).Str("project_id", projectID).Msg("Failed to add project bullet")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to add bullet")
 		return
@@ -449,22 +412,7 @@ func (h *ProjectHandler) DeleteBullet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership.
-	project, err := h.projectService.GetProject(r.Context(), projectID)
-	if err != nil {
-		if errors.Is(err, domain.ErrProjectNotFound) {
-			respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
-			return
-		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve project")
-		return
-	}
-	if project.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "PROJECT_NOT_FOUND", "Project not found")
-		return
-	}
-
-	if err := h.projectService.DeleteProjectBullet(r.Context(), bulletID); err != nil {
+	if err := h.projectService.DeleteProjectBullet(r.Context(), bulletID, authUser.ID); err != nil {
 		if errors.Is(err, domain.ErrProjectBulletNotFound) {
 			respondError(w, http.StatusNotFound, "BULLET_NOT_FOUND", "Bullet not found")
 			return
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// QuotaHandler handles plan limit HTTP requests.
+type QuotaHandler struct {
+	quotaService *services.QuotaService
+}
+
+// NewQuotaHandler creates a new QuotaHandler.
+func NewQuotaHandler(quotaService *services.QuotaService) *QuotaHandler {
+	return &QuotaHandler{
+		quotaService: quotaService,
+	}
+}
+
+// GetMyLimits returns the authenticated user's plan and remaining quota.
+//
+//	@Summary		Get current user plan limits
+//	@Description	Returns the user's plan and, for each metered action (resume_created, tailor, pdf_regeneration), the limit, usage and remaining quota in the current period. A limit of 0 means unlimited and is reported with remaining -1.
+//	@Tags			user
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	UserLimitsResponse
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	ErrorResponse	"User not found"
+//	@Failure		500	{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/users/me/limits [get]
+func (h *QuotaHandler) GetMyLimits(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	limits, err := h.quotaService.GetLimits(r.Context(), authUser.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			respondError(w, http.StatusNotFound, "USER_NOT_FOUND", "User not found")
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to get plan limits")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve plan limits")
+		return
+	}
+
+	quotas := make([]QuotaResponse, 0, len(limits.Quotas))
+	for _, q := range limits.Quotas {
+		quotas = append(quotas, QuotaResponse{
+			Action:    string(q.Action),
+			Period:    string(q.Period),
+			Limit:     q.Limit,
+			Used:      q.Used,
+			Remaining: q.Remaining,
+			ResetsAt:  q.ResetsAt,
+		})
+	}
+
+	respondJSON(w, http.StatusOK, UserLimitsResponse{
+		Plan:   string(limits.Plan),
+		Quotas: quotas,
+	})
+}
@@ -0,0 +1,103 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http/mocks"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestQuotaHandlerGetMyLimits(t *testing.T) {
+	userRepo := mocks.NewInMemoryUserRepository()
+	usageRepo := mocks.NewInMemoryUsageRepository()
+
+	user, _ := domain.NewUser("firebase-123")
+	user.ID = "user-123"
+	userRepo.Seed(user)
+
+	ctx := context.Background()
+	require.NoError(t, usageRepo.Record(ctx, "user-123", domain.UsageTailor))
+	require.NoError(t, usageRepo.Record(ctx, "user-123", domain.UsageTailor))
+	require.NoError(t, usageRepo.Record(ctx, "other-user", domain.UsageTailor))
+
+	quotaService := services.NewQuotaService(userRepo, usageRepo, map[domain.Plan]domain.PlanLimits{
+		domain.PlanFree: {ResumesPerMonth: 5, TailorsPerDay: 3},
+	})
+	handler := NewQuotaHandler(quotaService)
+
+	t.Run("success - returns plan and remaining quota", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/users/me/limits", nil)
+		req = req.WithContext(setupTestContext("user-123", "firebase-123", "test@example.com"))
+
+		rr := executeRequest(t, req, handler.GetMyLimits)
+		assertStatusCode(t, http.StatusOK, rr)
+
+		var resp UserLimitsResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, "free", resp.Plan)
+		require.Len(t, resp.Quotas, 3)
+
+		byAction := make(map[string]QuotaResponse)
+		for _, q := range resp.Quotas {
+			byAction[q.Action] = q
+		}
+
+		assert.Equal(t, QuotaResponse{Action: "tailor", Period: "day", Limit: 3, Used: 2, Remaining: 1}, withoutReset(byAction["tailor"]))
+		assert.Equal(t, 5, byAction["resume_created"].Remaining)
+		assert.Equal(t, 0, byAction["pdf_regeneration"].Limit)
+		assert.Equal(t, -1, byAction["pdf_regeneration"].Remaining)
+		assert.True(t, byAction["tailor"].ResetsAt.After(time.Now()))
+	})
+
+	t.Run("error - user not authenticated", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/users/me/limits", nil)
+
+		rr := executeRequest(t, req, handler.GetMyLimits)
+		assertErrorResponse(t, rr, http.StatusUnauthorized, "UNAUTHORIZED")
+	})
+
+	t.Run("error - user not found", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/users/me/limits", nil)
+		req = req.WithContext(setupTestContext("missing", "firebase-missing", "missing@example.com"))
+
+		rr := executeRequest(t, req, handler.GetMyLimits)
+		assertErrorResponse(t, rr, http.StatusNotFound, "USER_NOT_FOUND")
+	})
+}
+
+func TestHandleQuotaError(t *testing.T) {
+	t.Run("maps quota errors to 429", func(t *testing.T) {
+		err := fmt.Errorf("create resume: %w", &domain.QuotaExceededError{
+			Action:   domain.UsageResumeCreated,
+			Limit:    10,
+			Period:   domain.QuotaPeriodMonth,
+			ResetsAt: time.Now().Add(90 * time.Second),
+		})
+
+		rr := httptest.NewRecorder()
+		require.True(t, handleQuotaError(rr, err))
+		assertErrorResponse(t, rr, http.StatusTooManyRequests, "QUOTA_EXCEEDED")
+		assert.Equal(t, "90", rr.Header().Get("Retry-After"))
+	})
+
+	t.Run("ignores other errors", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		assert.False(t, handleQuotaError(rr, errors.New("boom")))
+	})
+}
+
+// withoutReset clears ResetsAt so quotas can be compared by value.
+func withoutReset(q QuotaResponse) QuotaResponse {
+	q.ResetsAt = time.Time{}
+	return q
+}
@@ -1,15 +1,21 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog/log"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/pkg/filename"
 )
 
 // ResumeHandler handles resume-related HTTP requests.
@@ -27,16 +33,32 @@ func NewResumeHandler(resumeService *services.ResumeService) *ResumeHandler {
 // List returns all resumes for the authenticated user.
 //
 //	@Summary		List resumes
-//	@Description	Returns a paginated list of resumes for the authenticated user
+//	@Description	Returns a paginated list of resumes for the authenticated user. The job insight filters only match resumes whose job description stated the corresponding detail.
 //	@Tags			resumes
 //	@Produce		json
 //	@Security		BearerAuth
-//	@Param			status	query		string	false	"Filter by status"
-//	@Param			limit	query		int		false	"Pagination limit"	default(20)
-//	@Param			offset	query		int		false	"Pagination offset"	default(0)
-//	@Success		200		{object}	ListResumesResponse
-//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
-//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Param			status				query		string	false	"Filter by status"
+//	@Param			remote				query		string	false	"Comma-separated remote policies: remote, hybrid, onsite"
+//	@Param			min_salary			query		int		false	"Minimum annual salary at the top of the advertised range"
+//	@Param			currency			query		string	false	"Salary currency (ISO 4217)"
+//	@Param			visa_sponsorship	query		bool	false	"Filter by visa sponsorship"
+//	@Param			tag					query		string	false	"Comma-separated tag IDs; resumes must have all of them"
+//	@Param			score_min			query		int		false	"Minimum match score (0-100)"
+//	@Param			company				query		string	false	"Text the company name contains, ignoring case"
+//	@Param			created_after		query		string	false	"Created on or after this date (YYYY-MM-DD) or time (RFC 3339)"
+//	@Param			created_before		query		string	false	"Created on or before this date (YYYY-MM-DD), or before this time (RFC 3339)"
+//	@Param			language			query		string	false	"Target language; 'pt' also matches regional variants such as 'pt-br'"
+//	@Param			archived			query		bool	false	"Only archived (true) or unarchived (false) resumes"
+//	@Param			include_archived	query		bool	false	"Include archived resumes, left out by default"	default(false)
+//	@Param			sort				query		string	false	"Order: newest, recent (last opened first) or pinned_first"	Enums(newest, recent, pinned_first)	default(newest)
+//	@Param			view				query		string	false	"summary leaves out the job description, generated content and selection"	Enums(full, summary)	default(full)
+//	@Param			limit				query		int		false	"Pagination limit"	default(20)
+//	@Param			offset				query		int		false	"Pagination offset"	default(0)
+//	@Success		200					{object}	ListResumesResponse
+//	@Failure		400					{object}	ErrorResponse	"Invalid filter"
+//	@Failure		401					{object}	ErrorResponse	"Unauthorized"
+//	@Failure		422					{object}	ErrorResponse	"Validation failed"
+//	@Failure		500					{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/resumes [get]
 func (h *ResumeHandler) List(w http.ResponseWriter, r *http.Request) {
 	authUser, ok := GetAuthenticatedUser(r.Context())
@@ -45,37 +67,131 @@ func (h *ResumeHandler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	status := r.URL.Query().Get("status")
-	limit := parseIntParam(r, "limit", 20)
-	offset := parseIntParam(r, "offset", 0)
+	search, err := parseResumeSearch(r.URL.Query())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+	summary, err := parseResumeView(r.URL.Query())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
 
 	listReq := services.ListResumesRequest{
-		UserID: authUser.ID,
-		Limit:  limit,
-		Offset: offset,
-	}
-	if status != "" {
-		listReq.Status = &status
+		UserID:       authUser.ID,
+		Limit:        parseIntParam(r, "limit", 20),
+		Offset:       parseIntParam(r, "offset", 0),
+		Summary:      summary,
+		ResumeSearch: search,
 	}
 
 	result, err := h.resumeService.ListResumes(r.Context(), listReq)
 	if err != nil {
+		if handleResumeListError(w, err) {
+			return
+		}
 		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to list resumes")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve resumes")
 		return
 	}
 
+	respondJSON(w, http.StatusOK, newListResumesResponse(result, listReq))
+}
+
+// parseResumeSearch reads the resume list filters from query parameters.
+func parseResumeSearch(query url.Values) (domain.ResumeSearch, error) {
+	search := domain.ResumeSearch{
+		Status:         query.Get("status"),
+		SalaryCurrency: query.Get("currency"),
+		Company:        query.Get("company"),
+		CreatedAfter:   query.Get("created_after"),
+		CreatedBefore:  query.Get("created_before"),
+		Language:       query.Get("language"),
+		Sort:           query.Get("sort"),
+	}
+
+	if remote := query.Get("remote"); remote != "" {
+		search.RemotePolicies = strings.Split(remote, ",")
+	}
+	if minSalary := query.Get("min_salary"); minSalary != "" {
+		value, err := strconv.Atoi(minSalary)
+		if err != nil {
+			return search, errors.New("min_salary must be an integer")
+		}
+		search.MinSalary = &value
+	}
+	if visa := query.Get("visa_sponsorship"); visa != "" {
+		value, err := strconv.ParseBool(visa)
+		if err != nil {
+			return search, errors.New("visa_sponsorship must be true or false")
+		}
+		search.VisaSponsorship = &value
+	}
+	if tags := query.Get("tag"); tags != "" {
+		search.TagIDs = strings.Split(tags, ",")
+	}
+	if minScore := query.Get("score_min"); minScore != "" {
+		value, err := strconv.Atoi(minScore)
+		if err != nil {
+			return search, errors.New("score_min must be an integer")
+		}
+		search.MinScore = &value
+	}
+	if archived := query.Get("archived"); archived != "" {
+		value, err := strconv.ParseBool(archived)
+		if err != nil {
+			return search, errors.New("archived must be true or false")
+		}
+		search.Archived = &value
+	}
+	if include := query.Get("include_archived"); include != "" {
+		value, err := strconv.ParseBool(include)
+		if err != nil {
+			return search, errors.New("include_archived must be true or false")
+		}
+		search.IncludeArchived = value
+	}
+
+	return search, nil
+}
+
+// parseResumeView reads the view query parameter of resume lists, and
+// reports whether the summary view was asked for.
+func parseResumeView(query url.Values) (bool, error) {
+	switch query.Get("view") {
+	case "", "full":
+		return false, nil
+	case "summary":
+		return true, nil
+	default:
+		return false, errors.New("view must be 'summary' or 'full'")
+	}
+}
+
+// handleResumeListError responds to the invalid filter errors of listing
+// resumes, and reports whether it did.
+func handleResumeListError(w http.ResponseWriter, err error) bool {
+	if errors.Is(err, domain.ErrInvalidResumeStatus) {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid status filter")
+		return true
+	}
+	return handleValidationError(w, err)
+}
+
+// newListResumesResponse maps a page of resumes to a ListResumesResponse.
+func newListResumesResponse(result *services.ListResumesResponse, req services.ListResumesRequest) ListResumesResponse {
 	data := make([]ResumeResponse, 0, len(result.Resumes))
 	for _, resume := range result.Resumes {
 		data = append(data, mapResumeToResponse(&resume))
 	}
 
-	respondJSON(w, http.StatusOK, ListResumesResponse{
+	return ListResumesResponse{
 		Data:   data,
 		Total:  result.Total,
-		Limit:  limit,
-		Offset: offset,
-	})
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
 }
 
 // Get returns a specific resume by ID.
@@ -104,7 +220,7 @@ func (h *ResumeHandler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resume, err := h.resumeService.GetResume(r.Context(), resumeID)
+	resume, err := h.resumeService.GetResume(r.Context(), resumeID, authUser.ID)
 	if err != nil {
 		if errors.Is(err, domain.ErrResumeNotFound) {
 			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
@@ -115,20 +231,14 @@ func (h *ResumeHandler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership.
-	if resume.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
-		return
-	}
-
-	response := mapResumeToResponse(resume)
+	response := h.mapResumeWithMetrics(r.Context(), resume)
 	respondJSON(w, http.StatusOK, response)
 }
 
 // Create creates a new resume draft from a job description.
 //
 //	@Summary		Create resume
-//	@Description	Creates a new resume draft from a job description
+//	@Description	Creates a new resume draft from a job description. Without a target language, the resume targets the language detected in the job description when supported, else the user's default; the detected language is returned.
 //	@Tags			resumes
 //	@Accept			json
 //	@Produce		json
@@ -138,6 +248,7 @@ func (h *ResumeHandler) Get(w http.ResponseWriter, r *http.Request) {
 //	@Failure		400		{object}	ErrorResponse	"Invalid request body"
 //	@Failure		401		{object}	ErrorResponse	"Unauthorized"
 //	@Failure		422		{object}	ErrorResponse	"Validation failed"
+//	@Failure		429		{object}	ErrorResponse	"Plan limit reached"
 //	@Failure		500		{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/resumes [post]
 func (h *ResumeHandler) Create(w http.ResponseWriter, r *http.Request) {
@@ -149,7 +260,7 @@ func (h *ResumeHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	var req CreateResumeRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
@@ -169,24 +280,28 @@ func (h *ResumeHandler) Create(w http.ResponseWriter, r *http.Request) {
 		createReq.JobURL = &req.JobURL
 	}
 
-	resume, err := h.resumeService.CreateResume(r.Context(), createReq)
+	result, err := h.resumeService.CreateResume(r.Context(), createReq)
 	if err != nil {
 		if handleValidationError(w, err) {
 			return
 		}
+		if handleQuotaError(w, err) {
+			return
+		}
 		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to create resume")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to create resume")
 		return
 	}
 
-	response := mapResumeToResponse(resume)
+	response := mapResumeToResponse(result.Resume)
+	response.DetectedLanguage = result.DetectedLanguage
 	respondJSON(w, http.StatusCreated, response)
 }
 
 // Tailor triggers AI to analyze the job and generate tailored content.
 //
 //	@Summary		Tailor resume
-//	@Description	Uses AI to select and rewrite bullets for a specific job description
+//	@Description	Uses AI to select and rewrite bullets for a specific job description, listing the skills the job mentions with highlighted skills first. The summary and bullets can be written in a tone (confident, humble, energetic) for a target reader (technical_hiring_manager, hr_screener), kept with the content and reused when sections are regenerated. The response explains why each bullet was selected or dropped.
 //	@Tags			resumes
 //	@Accept			json
 //	@Produce		json
@@ -198,7 +313,11 @@ func (h *ResumeHandler) Create(w http.ResponseWriter, r *http.Request) {
 //	@Failure		401			{object}	ErrorResponse	"Unauthorized"
 //	@Failure		404			{object}	ErrorResponse	"Resume not found"
 //	@Failure		422			{object}	ErrorResponse	"Validation failed"
+//	@Failure		429			{object}	ErrorResponse	"Plan limit reached"
 //	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Failure		502			{object}	ErrorResponse	"Generated content rejected by the content filter"
+//	@Failure		503			{object}	ErrorResponse	"AI service unavailable"
+//	@Failure		504			{object}	ErrorResponse	"Tailoring ran out of time"
 //	@Router			/v1/resumes/{resumeID}/tailor [post]
 func (h *ResumeHandler) Tailor(w http.ResponseWriter, r *http.Request) {
 	authUser, ok := GetAuthenticatedUser(r.Context())
@@ -213,36 +332,32 @@ func (h *ResumeHandler) Tailor(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership first.
-	existing, err := h.resumeService.GetResume(r.Context(), resumeID)
-	if err != nil {
-		if errors.Is(err, domain.ErrResumeNotFound) {
-			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
-			return
-		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to verify resume")
-		return
-	}
-	if existing.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
-		return
-	}
-
 	var req TailorResumeRequest
 	if r.Body != nil && r.ContentLength > 0 {
 		if err := decodeJSON(r, &req); err != nil {
-			respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+			respondDecodeError(w, err)
 			return
 		}
 	}
 
 	tailorReq := services.TailorResumeRequest{
-		ResumeID:   resumeID,
-		MaxBullets: req.MaxBulletsPerJob,
+		ResumeID:                 resumeID,
+		UserID:                   authUser.ID,
+		MaxBullets:               req.MaxBulletsPerJob,
+		IncludeHighlightedSkills: req.IncludeHighlightedSkills,
+		Voice: domain.WritingVoice{
+			Tone:    domain.Tone(req.Tone),
+			Persona: domain.Persona(req.Persona),
+		},
+		Seed: req.Seed,
 	}
 
 	resume, err := h.resumeService.TailorResume(r.Context(), tailorReq)
 	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
 		if handleValidationError(w, err) {
 			return
 		}
@@ -250,19 +365,133 @@ func (h *ResumeHandler) Tailor(w http.ResponseWriter, r *http.Request) {
 			respondError(w, http.StatusUnprocessableEntity, "NO_BULLETS", "No bullets available for tailoring")
 			return
 		}
+		if handleQuotaError(w, err) {
+			return
+		}
+		if handleUpstreamError(w, err) {
+			return
+		}
+		if errors.Is(err, domain.ErrContentRejected) {
+			log.Warn().Err(err).Str("resume_id", resumeID).Msg("Tailored content rejected by the content filter")
+			respondError(w, http.StatusBadGateway, "CONTENT_REJECTED", "The generated content was rejected by the content filter, please retry")
+			return
+		}
+		if errors.Is(err, domain.ErrTailorTimeout) {
+			log.Warn().Err(err).Str("resume_id", resumeID).Msg("Tailoring ran out of time")
+			respondError(w, http.StatusGatewayTimeout, "TAILOR_TIMEOUT", "Tailoring took too long, please retry")
+			return
+		}
+		if r.Context().Err() != nil {
+			// The client went away; there is nobody to respond to.
+			log.Info().Str("resume_id", resumeID).Msg("Tailoring aborted by client")
+			return
+		}
 		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to tailor resume")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to tailor resume")
 		return
 	}
+	logTailorValidation(resumeID, resume.Selection)
 
-	response := mapResumeToResponse(resume)
+	response := h.mapResumeWithMetrics(r.Context(), resume)
 	respondJSON(w, http.StatusOK, response)
 }
 
+// TailorEstimate estimates the tokens and cost of tailoring a resume.
+//
+//	@Summary		Estimate tailoring cost
+//	@Description	Estimates the AI tokens and cost of tailoring the resume now, by operation, from the size of its job description, the bullets tailoring would choose from and the configured models and prices, without calling the AI. Also returns the user's tailoring quota, to check it before tailoring. Estimates assume no cached replies or retries.
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID			path		string	true	"Resume ID"
+//	@Param			max_bullets_per_job	query		int		false	"Bullets to select; defaults to the user's preferred limit"
+//	@Success		200					{object}	TailorEstimateResponse
+//	@Failure		400					{object}	ErrorResponse	"max_bullets_per_job is not a positive integer"
+//	@Failure		401					{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404					{object}	ErrorResponse	"Resume not found"
+//	@Failure		422					{object}	ErrorResponse	"No bullets available for tailoring"
+//	@Failure		500					{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/tailor/estimate [get]
+func (h *ResumeHandler) TailorEstimate(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	estimateReq := services.EstimateTailoringRequest{ResumeID: resumeID, UserID: authUser.ID}
+	if maxBullets := r.URL.Query().Get("max_bullets_per_job"); maxBullets != "" {
+		value, err := strconv.Atoi(maxBullets)
+		if err != nil || value <= 0 {
+			respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "max_bullets_per_job must be a positive integer")
+			return
+		}
+		estimateReq.MaxBullets = value
+	}
+
+	estimate, err := h.resumeService.EstimateTailoring(r.Context(), estimateReq)
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		if errors.Is(err, domain.ErrNoBulletsAvailable) {
+			respondError(w, http.StatusUnprocessableEntity, "NO_BULLETS", "No bullets available for tailoring")
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to estimate tailoring")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to estimate tailoring")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapTailoringEstimateToResponse(estimate))
+}
+
+// mapTailoringEstimateToResponse converts a TailoringEstimate to its response.
+func mapTailoringEstimateToResponse(estimate *services.TailoringEstimate) TailorEstimateResponse {
+	response := TailorEstimateResponse{
+		Bullets:      estimate.Bullets,
+		MaxBullets:   estimate.MaxBullets,
+		Calls:        make([]AICallEstimateResponse, 0, len(estimate.Calls)),
+		InputTokens:  estimate.InputTokens,
+		OutputTokens: estimate.OutputTokens,
+		CostUSD:      estimate.CostUSD,
+		WithinQuota:  true,
+	}
+	for _, call := range estimate.Calls {
+		response.Calls = append(response.Calls, AICallEstimateResponse{
+			Operation:    call.Operation,
+			Model:        call.Model,
+			Count:        call.Count,
+			InputTokens:  call.InputTokens,
+			OutputTokens: call.OutputTokens,
+			CostUSD:      call.CostUSD,
+		})
+	}
+	if q := estimate.Quota; q != nil {
+		response.WithinQuota = q.Limit == 0 || q.Remaining > 0
+		response.Quota = &QuotaResponse{
+			Action:    string(q.Action),
+			Period:    string(q.Period),
+			Limit:     q.Limit,
+			Used:      q.Used,
+			Remaining: q.Remaining,
+			ResetsAt:  q.ResetsAt,
+		}
+	}
+	return response
+}
+
 // UpdateStatus updates the status of a resume.
 //
 //	@Summary		Update resume status/content
-//	@Description	Updates the status or content of a resume for manual adjustments
+//	@Description	Updates the status, notes or content of a resume for manual adjustments; notes are also added to its activity log. Also sets the profile link shown as a QR code in its header, the priority of its header contacts, the display of its sections, the pages it may span and the experiences tailoring includes or excludes
 //	@Tags			resumes
 //	@Accept			json
 //	@Produce		json
@@ -273,6 +502,7 @@ func (h *ResumeHandler) Tailor(w http.ResponseWriter, r *http.Request) {
 //	@Failure		400			{object}	ErrorResponse	"Invalid request body"
 //	@Failure		401			{object}	ErrorResponse	"Unauthorized"
 //	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		409			{object}	ErrorResponse	"Flagged bullets must be confirmed first"
 //	@Failure		422			{object}	ErrorResponse	"Validation failed"
 //	@Failure		500			{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/resumes/{resumeID}/content [patch]
@@ -289,73 +519,75 @@ func (h *ResumeHandler) UpdateStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership first.
-	existing, err := h.resumeService.GetResume(r.Context(), resumeID)
-	if err != nil {
-		if errors.Is(err, domain.ErrResumeNotFound) {
-			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
-			return
-		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to verify resume")
-		return
-	}
-	if existing.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
-		return
-	}
-
 	var req UpdateResumeContentRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
-	if req.Status == "" {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Status is required")
+	if req.Status == "" && req.Notes == nil && req.QRCode == nil && req.ContactPriority == nil && req.Sections == nil &&
+		req.MaxPages == nil && req.IncludedExperienceIDs == nil && req.ExcludedExperienceIDs == nil {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "One of status, notes, qr_code, contact_priority, sections, max_pages, included_experience_ids or excluded_experience_ids is required")
 		return
 	}
 
 	updateReq := services.UpdateResumeStatusRequest{
-		ResumeID:  resumeID,
-		NewStatus: req.Status,
-		Notes:     req.Notes,
+		ResumeID:              resumeID,
+		UserID:                authUser.ID,
+		NewStatus:             req.Status,
+		Notes:                 req.Notes,
+		QRCode:                req.QRCode,
+		ContactPriority:       req.ContactPriority,
+		MaxPages:              req.MaxPages,
+		IncludedExperienceIDs: req.IncludedExperienceIDs,
+		ExcludedExperienceIDs: req.ExcludedExperienceIDs,
+	}
+	if req.Sections != nil {
+		updateReq.Sections = &domain.SectionConfig{
+			SkillsDisplay: domain.SkillsDisplay(req.Sections.SkillsDisplay),
+		}
 	}
 
 	resume, err := h.resumeService.UpdateResumeStatus(r.Context(), updateReq)
 	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
 		if handleValidationError(w, err) {
 			return
 		}
+		if errors.Is(err, domain.ErrBulletsNeedReview) {
+			respondError(w, http.StatusConflict, "BULLETS_NEED_REVIEW", "Confirm the tailored bullets flagged for review first")
+			return
+		}
 		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to update resume status")
 		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to update resume")
 		return
 	}
 
-	response := mapResumeToResponse(resume)
+	response := h.mapResumeWithMetrics(r.Context(), resume)
 	respondJSON(w, http.StatusOK, response)
 }
 
-// GeneratePDF generates a PDF of the resume.
-//
-//	@Summary		Generate PDF
+// ConfirmBullets confirms tailored bullets flagged for review.
 //
-// GeneratePDF generates and returns a PDF file of the resume.
-//
-//	@Summary		Generate PDF
-//	@Description	Generates and downloads a PDF file of the resume
+//	@Summary		Confirm flagged bullets
+//	@Description	Confirms tailored bullets flagged with needs_review, whose rewrites have numbers, names or technologies not found in the user's profile. A resume cannot be marked reviewed until all its flagged bullets are confirmed.
 //	@Tags			resumes
-//	@Produce		application/pdf
+//	@Accept			json
+//	@Produce		json
 //	@Security		BearerAuth
-//	@Param			resumeID			path		string	true	"Resume ID"
-//	@Param			template			query		string	false	"Template name"	default(modern)
-//	@Param			force_regenerate	query		bool	false	"Force regeneration ignoring cache"	default(false)
-//	@Success		200					{file}		binary	"PDF file"
-//	@Failure		401					{object}	ErrorResponse	"Unauthorized"
-//	@Failure		404					{object}	ErrorResponse	"Resume not found"
-//	@Failure		422					{object}	ErrorResponse	"Resume not ready for PDF"
-//	@Failure		500					{object}	ErrorResponse	"Internal server error"
-//	@Router			/v1/resumes/{resumeID}/pdf [get]
-func (h *ResumeHandler) GeneratePDF(w http.ResponseWriter, r *http.Request) {
+//	@Param			resumeID	path		string					true	"Resume ID"
+//	@Param			request		body		ConfirmBulletsRequest	true	"Checked bullets"
+//	@Success		200			{object}	ResumeResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		422			{object}	ErrorResponse	"Validation error or resume not tailored yet"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/bullets/confirm [post]
+func (h *ResumeHandler) ConfirmBullets(w http.ResponseWriter, r *http.Request) {
 	authUser, ok := GetAuthenticatedUser(r.Context())
 	if !ok {
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
@@ -368,73 +600,113 @@ func (h *ResumeHandler) GeneratePDF(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership first.
-	existing, err := h.resumeService.GetResume(r.Context(), resumeID)
+	var req ConfirmBulletsRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	resume, err := h.resumeService.ConfirmBullets(r.Context(), services.ConfirmBulletsRequest{
+		ResumeID:  resumeID,
+		UserID:    authUser.ID,
+		BulletIDs: req.BulletIDs,
+	})
 	if err != nil {
 		if errors.Is(err, domain.ErrResumeNotFound) {
 			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
 			return
 		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to verify resume")
-		return
-	}
-	if existing.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before confirming bullets")
+			return
+		}
+		if handleValidationError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to confirm bullets")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to confirm bullets")
 		return
 	}
 
-	template := r.URL.Query().Get("template")
-	if template == "" {
-		template = "modern"
-	}
+	respondJSON(w, http.StatusOK, mapResumeToResponse(resume))
+}
 
-	// Check for force_regenerate query parameter.
-	forceRegenerate := r.URL.Query().Get("force_regenerate") == "true"
+// ListSummaries lists the summary variants of a tailored resume.
+//
+//	@Summary		List summary variants
+//	@Description	Lists the professional summaries generated with a resume from different angles (technical, leadership and impact), marking the one picked as its summary. Resumes tailored before variants were generated, or whose summary fell back to the profile's, have none.
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Success		200			{object}	SummaryVariantsResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid request"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		422			{object}	ErrorResponse	"Resume not tailored yet"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/summaries [get]
+func (h *ResumeHandler) ListSummaries(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
 
-	pdfReq := services.DownloadPDFRequest{
-		ResumeID:        resumeID,
-		TemplateName:    template,
-		ForceRegenerate: forceRegenerate,
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
 	}
 
-	result, err := h.resumeService.DownloadPDF(r.Context(), pdfReq)
+	resume, err := h.resumeService.GetResume(r.Context(), resumeID, authUser.ID)
 	if err != nil {
-		if errors.Is(err, domain.ErrResumeNotReady) {
-			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before PDF")
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
 			return
 		}
-		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to generate PDF")
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to generate PDF")
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to get resume")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to list summaries")
+		return
+	}
+	content := resume.GeneratedContent
+	if content == nil {
+		respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before listing its summaries")
 		return
 	}
 
-	// Set headers for binary PDF download.
-	w.Header().Set("Content-Type", result.ContentType)
-	w.Header().Set("Content-Disposition", "attachment; filename=\""+result.Filename+"\"")
-	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(result.Content)))
-	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
-	w.WriteHeader(http.StatusOK)
-
-	// Write raw PDF bytes directly to the response.
-	_, writeErr := w.Write(result.Content)
-	if writeErr != nil {
-		log.Error().Err(writeErr).Str("resume_id", resumeID).Msg("Failed to write PDF response")
+	response := SummaryVariantsResponse{
+		Summary:  content.Summary,
+		Variants: make([]SummaryVariantDTO, 0, len(content.SummaryVariants)),
+	}
+	for _, variant := range content.SummaryVariants {
+		response.Variants = append(response.Variants, SummaryVariantDTO{
+			Angle:   variant.Angle,
+			Summary: variant.Summary,
+			Active:  variant.Angle == content.SummaryAngle,
+		})
 	}
+	respondJSON(w, http.StatusOK, response)
 }
 
-// Delete removes a resume.
+// SelectSummary makes a summary variant the summary of a resume.
 //
-//	@Summary		Delete resume
-//	@Description	Deletes a resume
+//	@Summary		Select summary variant
+//	@Description	Makes one of the summary variants generated with a resume its summary, replacing the current summary, edits included. The other variants are kept to switch again later.
 //	@Tags			resumes
+//	@Accept			json
+//	@Produce		json
 //	@Security		BearerAuth
-//	@Param			resumeID	path	string	true	"Resume ID"
-//	@Success		204			"No content"
+//	@Param			resumeID	path		string					true	"Resume ID"
+//	@Param			request		body		SelectSummaryRequest	true	"Variant to select"
+//	@Success		200			{object}	ResumeResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid request body"
 //	@Failure		401			{object}	ErrorResponse	"Unauthorized"
 //	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		422			{object}	ErrorResponse	"Unknown angle or resume not tailored yet"
 //	@Failure		500			{object}	ErrorResponse	"Internal server error"
-//	@Router			/v1/resumes/{resumeID} [delete]
-func (h *ResumeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+//	@Router			/v1/resumes/{resumeID}/summaries/select [post]
+func (h *ResumeHandler) SelectSummary(w http.ResponseWriter, r *http.Request) {
 	authUser, ok := GetAuthenticatedUser(r.Context())
 	if !ok {
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
@@ -447,44 +719,1010 @@ func (h *ResumeHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Verify ownership first.
-	existing, err := h.resumeService.GetResume(r.Context(), resumeID)
+	var req SelectSummaryRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	resume, err := h.resumeService.SelectSummary(r.Context(), services.SelectSummaryRequest{
+		ResumeID: resumeID,
+		UserID:   authUser.ID,
+		Angle:    req.Angle,
+	})
 	if err != nil {
 		if errors.Is(err, domain.ErrResumeNotFound) {
 			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
 			return
 		}
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to verify resume")
-		return
-	}
-	if existing.UserID != authUser.ID {
-		respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
-		return
-	}
-
-	if err := h.resumeService.DeleteResume(r.Context(), resumeID); err != nil {
-		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to delete resume")
-		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete resume")
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before selecting a summary")
+			return
+		}
+		if handleValidationError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to select summary")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to select summary")
 		return
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	respondJSON(w, http.StatusOK, h.mapResumeWithMetrics(r.Context(), resume))
 }
 
-// mapResumeToResponse maps a domain Resume to a ResumeResponse.
-func mapResumeToResponse(resume *domain.Resume) ResumeResponse {
-	resp := ResumeResponse{
-		ID:              resume.ID,
-		JobDescription:  resume.JobDescription,
-		TargetLanguage:  resume.TargetLanguage,
-		SelectedBullets: resume.SelectedBullets,
-		Score:           resume.Score.Int(),
-		Status:          string(resume.Status),
-		CreatedAt:       resume.CreatedAt,
-		UpdatedAt:       resume.UpdatedAt,
-	}
-
-	if resume.JobTitle != nil {
+// Diff shows what tailoring changed in a resume, word by word.
+//
+//	@Summary		Diff tailored resume
+//	@Description	Compares each tailored bullet with the master bullet it was tailored from, and the resume's summary with the profile summary, as word-level segments that were kept (equal), removed (delete) or added (insert). Bullets are compared before any translation. Joining the equal and delete segments gives the original text; joining the equal and insert segments gives the tailored one.
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Success		200			{object}	ResumeDiffResponse
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		422			{object}	ErrorResponse	"Resume not tailored yet"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/diff [get]
+func (h *ResumeHandler) Diff(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	diff, err := h.resumeService.DiffResume(r.Context(), resumeID, authUser.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before comparing it")
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to diff resume")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to compare resume")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapResumeDiffToResponse(diff))
+}
+
+// FitCheck estimates whether a resume fits its page limit.
+//
+//	@Summary		Check page fit
+//	@Description	Estimates the lines the resume takes when rendered with a template, font size and density, and whether they fit the pages the PDF keeps, without generating it. Text is wrapped at an average character width, so the estimate may be off by a few lines.
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Param			template	query		string	false	"Template name (jake, europass, academic, functional or a custom template); defaults to the user's preferred template"
+//	@Param			font_size	query		int		false	"Base font size in pt, 9 to 12; defaults to the user's preferred size"
+//	@Param			density		query		string	false	"Spacing preset: comfortable, compact or ultra"	default(comfortable)
+//	@Success		200			{object}	FitCheckResponse
+//	@Failure		400			{object}	ErrorResponse	"Font size is not an integer"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		422			{object}	ErrorResponse	"Resume not tailored yet, or invalid font size or density"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/fit-check [get]
+func (h *ResumeHandler) FitCheck(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	query := r.URL.Query()
+	fitReq := services.FitCheckRequest{
+		ResumeID:     resumeID,
+		UserID:       authUser.ID,
+		TemplateName: query.Get("template"),
+		Density:      query.Get("density"),
+	}
+	if fontSize := query.Get("font_size"); fontSize != "" {
+		value, err := strconv.Atoi(fontSize)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "font_size must be an integer")
+			return
+		}
+		fitReq.FontSize = &value
+	}
+
+	fit, err := h.resumeService.CheckFit(r.Context(), fitReq)
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before checking its fit")
+			return
+		}
+		if handleValidationError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to check resume fit")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to check resume fit")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapFitCheckToResponse(fit))
+}
+
+// Fit shortens a resume's content to fit its page limit.
+//
+//	@Summary		Trim resume to fit
+//	@Description	When the resume is estimated to overflow the pages its PDF keeps, has the AI shorten its longest bullets and summary under length budgets, keeping their job keywords, and estimates the fit again, for up to three rounds. Rewrites that are not shorter, drop a keyword or fail bullet validation are discarded. A resume that already fits is returned unchanged.
+//	@Tags			resumes
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string				true	"Resume ID"
+//	@Param			request		body		FitResumeRequest	false	"Rendering to fit; defaults to the user's preferences"
+//	@Success		200			{object}	FitResumeResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		422			{object}	ErrorResponse	"Resume not tailored yet, or invalid font size or density"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Failure		503			{object}	ErrorResponse	"AI service unavailable"
+//	@Router			/v1/resumes/{resumeID}/fit [post]
+func (h *ResumeHandler) Fit(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	var req FitResumeRequest
+	if r.Body != nil && r.ContentLength > 0 {
+		if err := decodeJSON(r, &req); err != nil {
+			respondDecodeError(w, err)
+			return
+		}
+	}
+
+	result, err := h.resumeService.FitResume(r.Context(), services.FitCheckRequest{
+		ResumeID:     resumeID,
+		UserID:       authUser.ID,
+		TemplateName: req.Template,
+		FontSize:     req.FontSize,
+		Density:      req.Density,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before fitting it")
+			return
+		}
+		if handleValidationError(w, err) {
+			return
+		}
+		if handleUpstreamError(w, err) {
+			return
+		}
+		if r.Context().Err() != nil {
+			// The client went away; there is nobody to respond to.
+			log.Info().Str("resume_id", resumeID).Msg("Resume fitting aborted by client")
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to fit resume")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to fit resume")
+		return
+	}
+
+	shortened := result.Shortened
+	if shortened == nil {
+		shortened = []string{}
+	}
+	respondJSON(w, http.StatusOK, FitResumeResponse{
+		Resume:    h.mapResumeWithMetrics(r.Context(), result.Resume),
+		Before:    mapFitCheckToResponse(result.Before),
+		After:     mapFitCheckToResponse(result.After),
+		Shortened: shortened,
+		Rounds:    result.Rounds,
+	})
+}
+
+// Regenerate generates one section of a tailored resume again.
+//
+//	@Summary		Regenerate resume section
+//	@Description	Generates one section of a tailored resume again without tailoring it all over: the summary, the bullets of one experience, or the skills list. The AI skips cached replies, so the section comes out different, and follows the optional instructions; the job analysis from tailoring is reused. Skills are chosen from the profile without the AI and take no instructions. Bullet rewrites that fail validation keep their current content.
+//	@Tags			resumes
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string						true	"Resume ID"
+//	@Param			section		query		string						true	"summary, skills or experience:{experienceID}"
+//	@Param			request		body		RegenerateSectionRequest	false	"Instructions for the AI"
+//	@Success		200			{object}	ResumeResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		422			{object}	ErrorResponse	"Resume not tailored yet, or invalid section or instructions"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Failure		502			{object}	ErrorResponse	"Generated content rejected by the content filter"
+//	@Failure		503			{object}	ErrorResponse	"AI service unavailable"
+//	@Router			/v1/resumes/{resumeID}/regenerate [post]
+func (h *ResumeHandler) Regenerate(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	var req RegenerateSectionRequest
+	if r.Body != nil && r.ContentLength > 0 {
+		if err := decodeJSON(r, &req); err != nil {
+			respondDecodeError(w, err)
+			return
+		}
+	}
+
+	section := r.URL.Query().Get("section")
+	resume, err := h.resumeService.RegenerateSection(r.Context(), services.RegenerateSectionRequest{
+		ResumeID:     resumeID,
+		UserID:       authUser.ID,
+		Section:      section,
+		Instructions: req.Instructions,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before regenerating a section")
+			return
+		}
+		if handleValidationError(w, err) {
+			return
+		}
+		if handleUpstreamError(w, err) {
+			return
+		}
+		if errors.Is(err, domain.ErrContentRejected) {
+			log.Warn().Err(err).Str("resume_id", resumeID).Msg("Regenerated content rejected by the content filter")
+			respondError(w, http.StatusBadGateway, "CONTENT_REJECTED", "The generated content was rejected by the content filter, please retry")
+			return
+		}
+		if r.Context().Err() != nil {
+			// The client went away; there is nobody to respond to.
+			log.Info().Str("resume_id", resumeID).Msg("Section regeneration aborted by client")
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Str("section", section).Msg("Failed to regenerate resume section")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to regenerate resume section")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, h.mapResumeWithMetrics(r.Context(), resume))
+}
+
+// Retailor tailors a resume again to reproduce or contrast its last run.
+//
+//	@Summary		Tailor resume again
+//	@Description	Tailors a resume again and reports how the settings of the new run differ from the run it replaces. With same_settings=true the seed, bullet limit, highlighted skills and writing voice of the last tailoring run are reused, and cached AI replies may be served, to reproduce it; otherwise the resume is tailored with the default options and fresh AI replies, to contrast the runs. Prompt versions and models changed since cannot be restored, and models apply seeds on a best effort basis, so the differences tell why a reproduced run still differs.
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID		path		string	true	"Resume ID"
+//	@Param			same_settings	query		bool	false	"Reuse the settings of the last tailoring run"
+//	@Success		200				{object}	RetailorResumeResponse
+//	@Failure		401				{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404				{object}	ErrorResponse	"Resume not found"
+//	@Failure		422				{object}	ErrorResponse	"Resume not tailored yet, or tailored before its settings were recorded"
+//	@Failure		429				{object}	ErrorResponse	"Plan limit reached"
+//	@Failure		500				{object}	ErrorResponse	"Internal server error"
+//	@Failure		502				{object}	ErrorResponse	"Generated content rejected by the content filter"
+//	@Failure		503				{object}	ErrorResponse	"AI service unavailable"
+//	@Failure		504				{object}	ErrorResponse	"Tailoring ran out of time"
+//	@Router			/v1/resumes/{resumeID}/retailor [post]
+func (h *ResumeHandler) Retailor(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	result, err := h.resumeService.RetailorResume(r.Context(), services.RetailorResumeRequest{
+		ResumeID:     resumeID,
+		UserID:       authUser.ID,
+		SameSettings: r.URL.Query().Get("same_settings") == "true",
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before tailoring it again")
+			return
+		}
+		if handleValidationError(w, err) {
+			return
+		}
+		if errors.Is(err, domain.ErrNoBulletsAvailable) {
+			respondError(w, http.StatusUnprocessableEntity, "NO_BULLETS", "No bullets available for tailoring")
+			return
+		}
+		if handleQuotaError(w, err) {
+			return
+		}
+		if handleUpstreamError(w, err) {
+			return
+		}
+		if errors.Is(err, domain.ErrContentRejected) {
+			log.Warn().Err(err).Str("resume_id", resumeID).Msg("Tailored content rejected by the content filter")
+			respondError(w, http.StatusBadGateway, "CONTENT_REJECTED", "The generated content was rejected by the content filter, please retry")
+			return
+		}
+		if errors.Is(err, domain.ErrTailorTimeout) {
+			log.Warn().Err(err).Str("resume_id", resumeID).Msg("Tailoring ran out of time")
+			respondError(w, http.StatusGatewayTimeout, "TAILOR_TIMEOUT", "Tailoring took too long, please retry")
+			return
+		}
+		if r.Context().Err() != nil {
+			// The client went away; there is nobody to respond to.
+			log.Info().Str("resume_id", resumeID).Msg("Tailoring aborted by client")
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to tailor resume again")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to tailor resume")
+		return
+	}
+	logTailorValidation(resumeID, result.Resume.Selection)
+
+	differences := result.Differences
+	if differences == nil {
+		differences = []string{}
+	}
+	respondJSON(w, http.StatusOK, RetailorResumeResponse{
+		Resume:           h.mapResumeWithMetrics(r.Context(), result.Resume),
+		PreviousSettings: mapAIRunSettingsToDTO(result.PreviousSettings),
+		Differences:      differences,
+	})
+}
+
+// mapFitCheckToResponse converts a FitCheck to its response, rounding the
+// pages to one decimal.
+func mapFitCheckToResponse(fit *services.FitCheck) FitCheckResponse {
+	response := FitCheckResponse{
+		Template:       fit.Template,
+		FontSize:       fit.FontSize,
+		Density:        string(fit.Density),
+		MaxPages:       fit.MaxPages,
+		Lines:          fit.Lines,
+		LinesPerPage:   fit.LinesPerPage,
+		EstimatedPages: math.Round(fit.Pages*10) / 10,
+		Fits:           fit.Fits,
+		OverflowLines:  fit.OverflowLines,
+		Sections:       make([]SectionFitResult, 0, len(fit.Sections)),
+	}
+	for _, section := range fit.Sections {
+		response.Sections = append(response.Sections, SectionFitResult{Name: section.Name, Lines: section.Lines})
+	}
+	return response
+}
+
+// mapResumeDiffToResponse converts a ResumeDiff to its response.
+func mapResumeDiffToResponse(diff *services.ResumeDiff) ResumeDiffResponse {
+	response := ResumeDiffResponse{
+		ResumeID: diff.ResumeID,
+		Summary:  mapContentDiffToResponse(diff.Summary),
+		Bullets:  make([]BulletDiffResponse, 0, len(diff.Bullets)),
+	}
+	for _, bullet := range diff.Bullets {
+		response.Bullets = append(response.Bullets, BulletDiffResponse{
+			BulletID:            bullet.BulletID,
+			ExperienceID:        bullet.ExperienceID,
+			ContentDiffResponse: mapContentDiffToResponse(bullet.ContentDiff),
+		})
+	}
+	return response
+}
+
+// mapContentDiffToResponse converts a ContentDiff to its response.
+func mapContentDiffToResponse(diff services.ContentDiff) ContentDiffResponse {
+	response := ContentDiffResponse{
+		Original: diff.Original,
+		Tailored: diff.Tailored,
+		Changed:  diff.Changed,
+		Segments: make([]DiffSegmentResponse, 0, len(diff.Segments)),
+	}
+	for _, segment := range diff.Segments {
+		response.Segments = append(response.Segments, DiffSegmentResponse{
+			Op:   string(segment.Op),
+			Text: segment.Text,
+		})
+	}
+	return response
+}
+
+// GeneratePDF generates a PDF of the resume.
+//
+//	@Summary		Generate PDF
+//
+// GeneratePDF generates and returns a PDF file of the resume.
+//
+//	@Summary		Generate PDF
+//	@Description	Generates and downloads a PDF file of the resume
+//	@Tags			resumes
+//	@Produce		application/pdf
+//	@Security		BearerAuth
+//	@Param			resumeID			path		string	true	"Resume ID"
+//	@Param			template			query		string	false	"Template name (jake, europass, academic, functional or a custom template); defaults to the user's preferred template"
+//	@Param			force_regenerate	query		bool	false	"Force regeneration ignoring cache"	default(false)
+//	@Param			watermark			query		bool	false	"Stamp a DRAFT watermark if the resume is not reviewed yet"	default(false)
+//	@Param			density				query		string	false	"Spacing preset: comfortable, compact or ultra"	default(comfortable)
+//	@Success		200					{file}		binary	"PDF file"
+//	@Failure		401					{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404					{object}	ErrorResponse	"Resume not found"
+//	@Failure		422					{object}	ErrorResponse	"Resume not ready for PDF, or invalid density"
+//	@Failure		429					{object}	ErrorResponse	"Plan limit reached"
+//	@Failure		500					{object}	ErrorResponse	"Internal server error"
+//	@Failure		503					{object}	ErrorResponse	"PDF service unavailable"
+//	@Router			/v1/resumes/{resumeID}/pdf [get]
+func (h *ResumeHandler) GeneratePDF(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	// An empty template falls back to the user's preferred template.
+	template := r.URL.Query().Get("template")
+
+	// Check for force_regenerate query parameter.
+	forceRegenerate := r.URL.Query().Get("force_regenerate") == "true"
+
+	pdfReq := services.DownloadPDFRequest{
+		ResumeID:        resumeID,
+		UserID:          authUser.ID,
+		TemplateName:    template,
+		ForceRegenerate: forceRegenerate,
+		Watermark:       r.URL.Query().Get("watermark") == "true",
+		Density:         r.URL.Query().Get("density"),
+	}
+
+	result, err := h.resumeService.DownloadPDF(r.Context(), pdfReq)
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before PDF")
+			return
+		}
+		if handleValidationError(w, err) {
+			return
+		}
+		if handleQuotaError(w, err) {
+			return
+		}
+		if handleUpstreamError(w, err) {
+			return
+		}
+		if errors.Is(err, domain.ErrPDFServiceUnavailable) {
+			log.Error().Err(err).Str("resume_id", resumeID).Msg("PDF engine unavailable")
+			respondError(w, http.StatusServiceUnavailable, "PDF_SERVICE_UNAVAILABLE", "PDF service is temporarily unavailable")
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to generate PDF")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to generate PDF")
+		return
+	}
+
+	// Set headers for binary PDF download.
+	w.Header().Set("Content-Type", result.ContentType)
+	w.Header().Set("Content-Disposition", filename.ContentDisposition("attachment", result.Filename))
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(result.Content)))
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.WriteHeader(http.StatusOK)
+
+	// Write raw PDF bytes directly to the response.
+	_, writeErr := w.Write(result.Content)
+	if writeErr != nil {
+		log.Error().Err(writeErr).Str("resume_id", resumeID).Msg("Failed to write PDF response")
+	}
+}
+
+// ExportHTML returns the rendered resume as a self-contained HTML document.
+//
+//	@Summary		Export HTML
+//	@Description	Returns the rendered resume template as self-contained HTML (inline CSS) for self-hosting or manual tweaks
+//	@Tags			resumes
+//	@Produce		text/html
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Param			download	query		bool	false	"Send as attachment instead of inline"	default(false)
+//	@Success		200			{string}	string	"HTML document"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		422			{object}	ErrorResponse	"Resume not ready for export"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/html [get]
+func (h *ResumeHandler) ExportHTML(w http.ResponseWriter, r *http.Request) {
+	h.serveExport(w, r, services.ExportFormatHTML)
+}
+
+// PreviewHTML renders the resume with unsaved edits applied.
+//
+//	@Summary		Preview HTML
+//	@Description	Renders the resume as HTML with draft edits (summary, bullets, font size, section order) applied, without saving them
+//	@Tags			resumes
+//	@Accept			json
+//	@Produce		text/html
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string						true	"Resume ID"
+//	@Param			request		body		PreviewResumeHTMLRequest	true	"Draft edits"
+//	@Success		200			{string}	string						"HTML document"
+//	@Failure		400			{object}	ErrorResponse				"Invalid request"
+//	@Failure		401			{object}	ErrorResponse				"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse				"Resume not found"
+//	@Failure		422			{object}	ErrorResponse				"Resume not ready or invalid edits"
+//	@Failure		500			{object}	ErrorResponse				"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/preview-html [post]
+func (h *ResumeHandler) PreviewHTML(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	var req PreviewResumeHTMLRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	result, err := h.resumeService.PreviewHTML(r.Context(), services.PreviewHTMLRequest{
+		ResumeID:     resumeID,
+		UserID:       authUser.ID,
+		Summary:      req.Summary,
+		Bullets:      req.Bullets,
+		FontSize:     req.FontSize,
+		SectionOrder: req.SectionOrder,
+		Density:      req.Density,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before preview")
+			return
+		}
+		if handleValidationError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to preview resume")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to preview resume")
+		return
+	}
+
+	w.Header().Set("Content-Type", result.ContentType)
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(result.Content)))
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.WriteHeader(http.StatusOK)
+
+	if _, writeErr := w.Write(result.Content); writeErr != nil {
+		log.Error().Err(writeErr).Str("resume_id", resumeID).Msg("Failed to write preview response")
+	}
+}
+
+// Export returns the resume in the requested document format.
+//
+//	@Summary		Export resume
+//	@Description	Exports the tailored resume in the requested format (markdown, text, html, europass, latex)
+//	@Tags			resumes
+//	@Produce		text/markdown,text/plain,text/html,application/xml,application/x-tex
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Param			format		query		string	true	"Export format"	Enums(markdown, text, html, europass, latex)
+//	@Param			download	query		bool	false	"Send as attachment instead of inline"	default(false)
+//	@Success		200			{string}	string	"Exported document"
+//	@Failure		400			{object}	ErrorResponse	"Unsupported format"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		422			{object}	ErrorResponse	"Resume not ready for export"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/export [get]
+func (h *ResumeHandler) Export(w http.ResponseWriter, r *http.Request) {
+	format, err := services.ParseExportFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "INVALID_FORMAT", "Unsupported export format")
+		return
+	}
+
+	h.serveExport(w, r, format)
+}
+
+// serveExport verifies ownership, runs the export and writes the document.
+// Documents are served inline unless the "download" query parameter is true.
+func (h *ResumeHandler) serveExport(w http.ResponseWriter, r *http.Request, format services.ExportFormat) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	result, err := h.resumeService.ExportResume(r.Context(), services.ExportResumeRequest{
+		ResumeID: resumeID,
+		UserID:   authUser.ID,
+		Format:   format,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		if errors.Is(err, domain.ErrResumeNotReady) {
+			respondError(w, http.StatusUnprocessableEntity, "RESUME_NOT_READY", "Resume content must be generated before export")
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to export resume")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to export resume")
+		return
+	}
+
+	disposition := "inline"
+	if r.URL.Query().Get("download") == "true" {
+		disposition = "attachment"
+	}
+
+	w.Header().Set("Content-Type", result.ContentType)
+	w.Header().Set("Content-Disposition", filename.ContentDisposition(disposition, result.Filename))
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", len(result.Content)))
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.WriteHeader(http.StatusOK)
+
+	if _, writeErr := w.Write(result.Content); writeErr != nil {
+		log.Error().Err(writeErr).Str("resume_id", resumeID).Msg("Failed to write export response")
+	}
+}
+
+// Bulk applies an action to many resumes at once.
+//
+//	@Summary		Bulk resume action
+//	@Description	Deletes, archives, unarchives, changes the status of or tags up to 100 resumes, reporting the outcome for each. Resumes that are not found or cannot move to the status fail on their own; with transactional, nothing is changed unless the action applies to every resume. Responds 207 when the action was not applied to every resume
+//	@Tags			resumes
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		BulkResumesRequest	true	"Bulk action"
+//	@Success		200		{object}	BulkResumesResponse
+//	@Success		207		{object}	BulkResumesResponse	"Not applied to every resume"
+//	@Failure		400		{object}	ErrorResponse		"Invalid request body"
+//	@Failure		401		{object}	ErrorResponse		"Unauthorized"
+//	@Failure		404		{object}	ErrorResponse		"Resume tag not found"
+//	@Failure		422		{object}	ErrorResponse		"Validation failed"
+//	@Failure		500		{object}	ErrorResponse		"Internal server error"
+//	@Router			/v1/resumes/bulk [post]
+func (h *ResumeHandler) Bulk(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req BulkResumesRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	result, err := h.resumeService.BulkResumes(r.Context(), services.BulkResumesRequest{
+		UserID:        authUser.ID,
+		Action:        req.Action,
+		ResumeIDs:     req.ResumeIDs,
+		Status:        req.Status,
+		TagID:         req.TagID,
+		Transactional: req.Transactional,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeTagNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_TAG_NOT_FOUND", "Resume tag not found")
+			return
+		}
+		if handleValidationError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Str("action", req.Action).Msg("Failed to apply bulk resume action")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to apply bulk action")
+		return
+	}
+
+	resp := BulkResumesResponse{
+		Data:    make([]BulkResumeResultDTO, 0, len(result.Results)),
+		Applied: result.Applied,
+	}
+	for _, item := range result.Results {
+		dto := BulkResumeResultDTO{ResumeID: item.ResumeID, Status: "applied"}
+		switch {
+		case errors.Is(item.Err, domain.ErrBulkActionAborted):
+			dto.Status = "aborted"
+		case item.Err != nil:
+			dto.Status = "failed"
+			dto.Error = bulkResumeError(item.Err)
+			resp.Failed++
+			if dto.Error.Code == "INTERNAL_ERROR" {
+				log.Error().Err(item.Err).Str("resume_id", item.ResumeID).Str("action", req.Action).Msg("Failed to apply bulk resume action")
+			}
+		}
+		resp.Data = append(resp.Data, dto)
+	}
+
+	status := http.StatusOK
+	if resp.Applied < len(resp.Data) {
+		status = http.StatusMultiStatus
+	}
+	respondJSON(w, status, resp)
+}
+
+// bulkResumeError returns the error reported for a resume a bulk action
+// failed for.
+func bulkResumeError(err error) *ErrorBody {
+	switch {
+	case errors.Is(err, domain.ErrResumeNotFound):
+		return &ErrorBody{Code: "RESUME_NOT_FOUND", Message: "Resume not found"}
+	case errors.Is(err, domain.ErrInvalidStatusTransition):
+		return &ErrorBody{Code: "INVALID_STATUS_TRANSITION", Message: "The resume cannot move to this status"}
+	case errors.Is(err, domain.ErrBulletsNeedReview):
+		return &ErrorBody{Code: "BULLETS_NEED_REVIEW", Message: "Confirm the tailored bullets flagged for review first"}
+	default:
+		return &ErrorBody{Code: "INTERNAL_ERROR", Message: "Failed to apply the action to this resume"}
+	}
+}
+
+// Archive archives a resume.
+//
+//	@Summary		Archive resume
+//	@Description	Archives a resume: it is kept, with its history, but left out of resume lists unless include_archived or archived is set. Archiving an archived resume keeps the time it was first archived
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Success		200			{object}	ResumeResponse
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/archive [post]
+func (h *ResumeHandler) Archive(w http.ResponseWriter, r *http.Request) {
+	h.setFlag(w, r, "archived", true, h.resumeService.SetResumeArchived)
+}
+
+// Unarchive restores an archived resume.
+//
+//	@Summary		Unarchive resume
+//	@Description	Restores an archived resume to resume lists
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Success		200			{object}	ResumeResponse
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/unarchive [post]
+func (h *ResumeHandler) Unarchive(w http.ResponseWriter, r *http.Request) {
+	h.setFlag(w, r, "archived", false, h.resumeService.SetResumeArchived)
+}
+
+// Pin pins a resume.
+//
+//	@Summary		Pin resume
+//	@Description	Pins a resume, to list it first with sort=pinned_first
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Success		200			{object}	ResumeResponse
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/pin [post]
+func (h *ResumeHandler) Pin(w http.ResponseWriter, r *http.Request) {
+	h.setFlag(w, r, "pinned", true, h.resumeService.SetResumePinned)
+}
+
+// Unpin unpins a resume.
+//
+//	@Summary		Unpin resume
+//	@Description	Unpins a resume
+//	@Tags			resumes
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			resumeID	path		string	true	"Resume ID"
+//	@Success		200			{object}	ResumeResponse
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID}/unpin [post]
+func (h *ResumeHandler) Unpin(w http.ResponseWriter, r *http.Request) {
+	h.setFlag(w, r, "pinned", false, h.resumeService.SetResumePinned)
+}
+
+// setFlag sets a flag of the resume of the request, such as archived or
+// pinned, with the service method set.
+func (h *ResumeHandler) setFlag(w http.ResponseWriter, r *http.Request, flag string, value bool,
+	set func(ctx context.Context, resumeID, userID string, value bool) (*domain.Resume, error)) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	resume, err := set(r.Context(), resumeID, authUser.ID, value)
+	if err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Bool(flag, value).Msg("Failed to update resume flag")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to update resume")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapResumeToResponse(resume))
+}
+
+// Delete removes a resume.
+//
+//	@Summary		Delete resume
+//	@Description	Deletes a resume
+//	@Tags			resumes
+//	@Security		BearerAuth
+//	@Param			resumeID	path	string	true	"Resume ID"
+//	@Success		204			"No content"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Resume not found"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resumes/{resumeID} [delete]
+func (h *ResumeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	resumeID := chi.URLParam(r, "resumeID")
+	if resumeID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Resume ID is required")
+		return
+	}
+
+	if err := h.resumeService.DeleteResume(r.Context(), resumeID, authUser.ID); err != nil {
+		if errors.Is(err, domain.ErrResumeNotFound) {
+			respondError(w, http.StatusNotFound, "RESUME_NOT_FOUND", "Resume not found")
+			return
+		}
+		log.Error().Err(err).Str("resume_id", resumeID).Msg("Failed to delete resume")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete resume")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// mapResumeWithMetrics maps a single resume to its response, with the
+// metrics of its tailored content.
+func (h *ResumeHandler) mapResumeWithMetrics(ctx context.Context, resume *domain.Resume) ResumeResponse {
+	resp := mapResumeToResponse(resume)
+	metrics := h.resumeService.MeasureContent(ctx, resume)
+	if metrics == nil || resp.GeneratedContent == nil {
+		return resp
+	}
+
+	resp.GeneratedContent.MetricsFontSize = metrics.FontSize
+	resp.GeneratedContent.SummaryMetrics = mapTextMetricsToDTO(metrics.Summary)
+	for i := range resp.GeneratedContent.Experiences {
+		bullets := resp.GeneratedContent.Experiences[i].Bullets
+		for j := range bullets {
+			if bulletMetrics, ok := metrics.Bullets[bullets[j].BulletID]; ok {
+				bullets[j].Metrics = mapTextMetricsToDTO(bulletMetrics)
+			}
+		}
+	}
+	return resp
+}
+
+// mapTextMetricsToDTO maps text metrics to their DTO, rounding the reading
+// ease to one decimal.
+func mapTextMetricsToDTO(metrics services.TextMetrics) *TextMetricsDTO {
+	return &TextMetricsDTO{
+		Characters:  metrics.Characters,
+		Lines:       metrics.Lines,
+		ReadingEase: math.Round(metrics.ReadingEase*10) / 10,
+		ActionVerb:  metrics.ActionVerb,
+	}
+}
+
+// mapResumeToResponse maps a domain Resume to a ResumeResponse.
+func mapResumeToResponse(resume *domain.Resume) ResumeResponse {
+	resp := ResumeResponse{
+		ID:                    resume.ID,
+		JobDescription:        resume.JobDescription,
+		TargetLanguage:        resume.TargetLanguage,
+		SelectedBullets:       resume.SelectedBullets,
+		Score:                 resume.Score.Int(),
+		QRCode:                string(resume.QRCode),
+		Sections:              SectionConfigDTO{SkillsDisplay: string(resume.Sections.SkillsDisplay)},
+		MaxPages:              resume.PageLimit(),
+		IncludedExperienceIDs: resume.IncludedExperienceIDs,
+		ExcludedExperienceIDs: resume.ExcludedExperienceIDs,
+		TagIDs:                resume.TagIDs,
+		Archived:              resume.IsArchived(),
+		ArchivedAt:            resume.ArchivedAt,
+		Pinned:                resume.Pinned,
+		LastAccessedAt:        resume.LastAccessedAt,
+		Status:                string(resume.Status),
+		CreatedAt:             resume.CreatedAt,
+		UpdatedAt:             resume.UpdatedAt,
+	}
+
+	if resume.JobTitle != nil {
 		resp.JobTitle = *resume.JobTitle
 	}
 	if resume.CompanyName != nil {
@@ -499,13 +1737,107 @@ func mapResumeToResponse(resume *domain.Resume) ResumeResponse {
 	if resume.Notes != nil {
 		resp.Notes = *resume.Notes
 	}
+	for _, f := range resume.ContactPriority {
+		resp.ContactPriority = append(resp.ContactPriority, string(f))
+	}
 	if resume.GeneratedContent != nil {
 		resp.GeneratedContent = mapResumeContentToDTO(resume.GeneratedContent)
 	}
+	if resume.JobInsights != nil {
+		resp.JobInsights = mapJobInsightsToDTO(resume.JobInsights)
+	}
+	if resume.Selection != nil {
+		resp.Selection = mapSelectionToDTO(resume.Selection)
+	}
 
 	return resp
 }
 
+// logTailorValidation logs how many bullet rewrites failed validation, to
+// track the failure rates of the AI.
+func logTailorValidation(resumeID string, selection *domain.SelectionExplanation) {
+	if selection == nil || selection.Validation == nil || selection.Validation.Checked == 0 {
+		return
+	}
+	v := selection.Validation
+	event := log.Info()
+	if v.Retried > 0 {
+		event = log.Warn()
+	}
+	event.Str("resume_id", resumeID).
+		Int("checked", v.Checked).
+		Int("retried", v.Retried).
+		Int("rejected", v.Rejected).
+		Int("tense_fixed", v.TenseFixed).
+		Float64("failure_rate", float64(v.Retried)/float64(v.Checked)).
+		Float64("rejection_rate", float64(v.Rejected)/float64(v.Checked)).
+		Msg("Validated tailored bullets")
+}
+
+// mapSelectionToDTO maps a domain SelectionExplanation to its DTO.
+func mapSelectionToDTO(selection *domain.SelectionExplanation) *SelectionExplanationDTO {
+	dto := &SelectionExplanationDTO{
+		Reasoning: selection.Reasoning,
+		Bullets:   make([]BulletDecisionDTO, 0, len(selection.Bullets)),
+	}
+	for _, d := range selection.Bullets {
+		decision := BulletDecisionDTO{
+			BulletID: d.BulletID,
+			Outcome:  string(d.Outcome),
+			Reason:   d.Reason,
+		}
+		for _, issue := range d.Rejected {
+			decision.Rejected = append(decision.Rejected, string(issue))
+		}
+		dto.Bullets = append(dto.Bullets, decision)
+	}
+	if v := selection.Validation; v != nil {
+		dto.Validation = &TailorValidationDTO{Checked: v.Checked, Retried: v.Retried, Rejected: v.Rejected, TenseFixed: v.TenseFixed}
+	}
+	return dto
+}
+
+// mapJobInsightsToDTO maps domain JobInsights to JobInsightsDTO.
+func mapJobInsightsToDTO(insights *domain.JobInsights) *JobInsightsDTO {
+	dto := &JobInsightsDTO{
+		Benefits:        insights.Benefits,
+		RemotePolicy:    string(insights.RemotePolicy),
+		VisaSponsorship: insights.VisaSponsorship,
+	}
+	if insights.Salary != nil {
+		dto.Salary = &SalaryRangeDTO{
+			Min:      insights.Salary.Min,
+			Max:      insights.Salary.Max,
+			Currency: insights.Salary.Currency,
+			Period:   string(insights.Salary.Period),
+		}
+	}
+	return dto
+}
+
+// mapAIRunSettingsToDTO maps AI run settings to their DTO; nil maps to nil.
+func mapAIRunSettingsToDTO(settings *domain.AIRunSettings) *AIRunSettingsDTO {
+	if settings == nil {
+		return nil
+	}
+	dto := &AIRunSettingsDTO{
+		Seed:                     settings.Seed,
+		Calls:                    make([]AICallSettingsDTO, 0, len(settings.Calls)),
+		MaxBullets:               settings.MaxBullets,
+		IncludeHighlightedSkills: settings.IncludeHighlightedSkills,
+	}
+	for _, call := range settings.Calls {
+		dto.Calls = append(dto.Calls, AICallSettingsDTO{
+			Operation:     call.Operation,
+			PromptVersion: call.PromptVersion,
+			Model:         call.Model,
+			Temperature:   call.Temperature,
+			Count:         call.Count,
+		})
+	}
+	return dto
+}
+
 // mapResumeContentToDTO maps domain ResumeContent to ResumeContentDTO.
 func mapResumeContentToDTO(content *domain.ResumeContent) *ResumeContentDTO {
 	if content == nil {
@@ -517,9 +1849,12 @@ func mapResumeContentToDTO(content *domain.ResumeContent) *ResumeContentDTO {
 		bullets := make([]TailoredBulletDTO, 0, len(exp.Bullets))
 		for _, b := range exp.Bullets {
 			bullets = append(bullets, TailoredBulletDTO{
-				BulletID:        b.BulletID,
-				OriginalContent: b.OriginalContent,
-				TailoredContent: b.TailoredContent,
+				BulletID:          b.BulletID,
+				OriginalContent:   b.OriginalContent,
+				TailoredContent:   b.TailoredContent,
+				TranslatedContent: b.TranslatedContent,
+				NeedsReview:       b.NeedsReview,
+				UnverifiedTerms:   b.UnverifiedTerms,
 			})
 		}
 		experiences = append(experiences, TailoredExperienceDTO{
@@ -534,9 +1869,19 @@ func mapResumeContentToDTO(content *domain.ResumeContent) *ResumeContentDTO {
 	}
 
 	dto := &ResumeContentDTO{
-		Summary:     content.Summary,
-		Experiences: experiences,
-		Skills:      content.Skills,
+		Summary:        content.Summary,
+		Experiences:    experiences,
+		Skills:         content.Skills,
+		SourceLanguage: content.SourceLanguage,
+		SummaryAngle:   content.SummaryAngle,
+	}
+	if content.Voice != nil {
+		dto.Tone = string(content.Voice.Tone)
+		dto.Persona = string(content.Voice.Persona)
+	}
+	dto.AISettings = mapAIRunSettingsToDTO(content.AISettings)
+	for _, theme := range content.Themes {
+		dto.Themes = append(dto.Themes, SkillThemeDTO{Name: theme.Name, BulletIDs: theme.BulletIDs})
 	}
 
 	if content.Analysis != nil {
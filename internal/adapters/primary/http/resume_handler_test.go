@@ -0,0 +1,1049 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http/mocks"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestResumeHandlerBulk(t *testing.T) {
+	setup := func() (*ResumeHandler, *mocks.InMemoryResumeRepository) {
+		withStatus := func(resume *domain.Resume, status domain.ResumeStatus) *domain.Resume {
+			resume.Status = status
+			return resume
+		}
+		resumeRepo := mocks.NewInMemoryResumeRepository()
+		resumeRepo.Seed(
+			withStatus(createTestResume("resume-1", "user-123"), domain.ResumeStatusSubmitted),
+			withStatus(createTestResume("resume-2", "user-123"), domain.ResumeStatusInterview),
+			createTestResume("resume-3", "user-123"),
+			withStatus(createTestResume("resume-other", "user-456"), domain.ResumeStatusSubmitted),
+		)
+		resumeService := services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage())
+		return NewResumeHandler(resumeService), resumeRepo
+	}
+
+	bulk := func(t *testing.T, handler *ResumeHandler, body BulkResumesRequest) (int, BulkResumesResponse) {
+		t.Helper()
+		req := newJSONRequest(t, http.MethodPost, "/v1/resumes/bulk", body)
+		req = req.WithContext(setupTestContext("user-123", "firebase-123", "test@example.com"))
+
+		rr := executeRequest(t, req, handler.Bulk)
+		var resp BulkResumesResponse
+		if rr.Code < http.StatusBadRequest {
+			parseJSONResponse(t, rr, &resp)
+		}
+		return rr.Code, resp
+	}
+
+	t.Run("archive reports each resume", func(t *testing.T) {
+		handler, resumeRepo := setup()
+
+		code, resp := bulk(t, handler, BulkResumesRequest{
+			Action:    "archive",
+			ResumeIDs: []string{"resume-1", "resume-other", "resume-404"},
+		})
+		assert.Equal(t, http.StatusMultiStatus, code)
+		assert.Equal(t, 1, resp.Applied)
+		assert.Equal(t, 2, resp.Failed)
+		require.Len(t, resp.Data, 3)
+		assert.Equal(t, "applied", resp.Data[0].Status)
+		assert.Equal(t, "failed", resp.Data[1].Status)
+		assert.Equal(t, "RESUME_NOT_FOUND", resp.Data[1].Error.Code)
+
+		resume, err := resumeRepo.GetByIDForUser(context.Background(), "resume-1", "user-123")
+		require.NoError(t, err)
+		assert.True(t, resume.IsArchived())
+	})
+
+	t.Run("transactional status change applies to none on failure", func(t *testing.T) {
+		handler, resumeRepo := setup()
+
+		code, resp := bulk(t, handler, BulkResumesRequest{
+			Action:        "change_status",
+			ResumeIDs:     []string{"resume-1", "resume-2", "resume-3"},
+			Status:        "rejected",
+			Transactional: true,
+		})
+		assert.Equal(t, http.StatusMultiStatus, code)
+		assert.Zero(t, resp.Applied)
+		require.Len(t, resp.Data, 3)
+		assert.Equal(t, "aborted", resp.Data[0].Status)
+		assert.Equal(t, "aborted", resp.Data[1].Status)
+		assert.Equal(t, "INVALID_STATUS_TRANSITION", resp.Data[2].Error.Code)
+
+		resume, err := resumeRepo.GetByIDForUser(context.Background(), "resume-1", "user-123")
+		require.NoError(t, err)
+		assert.Equal(t, domain.ResumeStatusSubmitted, resume.Status)
+	})
+
+	t.Run("transactional delete", func(t *testing.T) {
+		handler, resumeRepo := setup()
+
+		code, resp := bulk(t, handler, BulkResumesRequest{
+			Action:        "delete",
+			ResumeIDs:     []string{"resume-1", "resume-2"},
+			Transactional: true,
+		})
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 2, resp.Applied)
+
+		_, err := resumeRepo.GetByIDForUser(context.Background(), "resume-2", "user-123")
+		assert.ErrorIs(t, err, domain.ErrResumeNotFound)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		handler, _ := setup()
+
+		for _, body := range []BulkResumesRequest{
+			{Action: "shred", ResumeIDs: []string{"resume-1"}},
+			{Action: "change_status", ResumeIDs: []string{"resume-1"}},
+			{Action: "archive"},
+			{Action: "archive", ResumeIDs: []string{"resume-1", "resume-1"}},
+		} {
+			code, _ := bulk(t, handler, body)
+			assert.Equal(t, http.StatusUnprocessableEntity, code, "%+v", body)
+		}
+	})
+}
+
+func TestResumeHandlerArchive(t *testing.T) {
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(createTestResume("resume-1", "user-123"), createTestResume("resume-2", "user-123"))
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	setArchived := func(t *testing.T, handlerFunc http.HandlerFunc, resumeID string) ResumeResponse {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodPost, "/v1/resumes/"+resumeID+"/archive", map[string]string{"resumeID": resumeID}, nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+
+		rr := executeRequest(t, req, handlerFunc)
+		assertStatusCode(t, http.StatusOK, rr)
+		var resp ResumeResponse
+		parseJSONResponse(t, rr, &resp)
+		return resp
+	}
+	list := func(t *testing.T, query string) []string {
+		t.Helper()
+		req := newJSONRequest(t, http.MethodGet, "/v1/resumes"+query, nil)
+		rr := executeRequest(t, req.WithContext(ctx), handler.List)
+		assertStatusCode(t, http.StatusOK, rr)
+		var resp ListResumesResponse
+		parseJSONResponse(t, rr, &resp)
+		ids := make([]string, 0, len(resp.Data))
+		for _, resume := range resp.Data {
+			ids = append(ids, resume.ID)
+		}
+		return ids
+	}
+
+	archived := setArchived(t, handler.Archive, "resume-1")
+	assert.True(t, archived.Archived)
+	assert.NotNil(t, archived.ArchivedAt)
+
+	assert.Equal(t, []string{"resume-2"}, list(t, ""))
+	assert.ElementsMatch(t, []string{"resume-1", "resume-2"}, list(t, "?include_archived=true"))
+	assert.Equal(t, []string{"resume-1"}, list(t, "?archived=true"))
+
+	unarchived := setArchived(t, handler.Unarchive, "resume-1")
+	assert.False(t, unarchived.Archived)
+	assert.Nil(t, unarchived.ArchivedAt)
+	assert.ElementsMatch(t, []string{"resume-1", "resume-2"}, list(t, ""))
+
+	t.Run("resume of another user", func(t *testing.T) {
+		other := setupTestContext("user-456", "firebase-456", "other@example.com")
+		req := newRequestWithChiContext(t, http.MethodPost, "/v1/resumes/resume-1/archive", map[string]string{"resumeID": "resume-1"}, nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, other.Value(UserContextKey)))
+
+		rr := executeRequest(t, req, handler.Archive)
+		assertErrorResponse(t, rr, http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+}
+
+func TestResumeHandlerDiff(t *testing.T) {
+	tailored := createTestResume("resume-1", "user-123")
+	tailored.GeneratedContent = &domain.ResumeContent{
+		Summary: "Backend engineer focused on payments",
+		Experiences: []domain.TailoredExperience{{
+			ExperienceID: "exp-1",
+			Bullets: []domain.TailoredBullet{
+				{BulletID: "bullet-1", OriginalContent: "Cut latency with caching", TailoredContent: "Cut p99 latency with caching"},
+				{BulletID: "bullet-2", OriginalContent: "Led five engineers", TailoredContent: "Led five engineers"},
+			},
+		}},
+	}
+
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(tailored, createTestResume("resume-2", "user-123"))
+	userRepo := mocks.NewInMemoryUserRepository()
+	user := createTestUser("firebase-123")
+	user.ID = "user-123"
+	summary := "Backend engineer"
+	user.Summary = &summary
+	userRepo.Seed(user)
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, userRepo,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	diff := func(t *testing.T, resumeID string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodGet, "/v1/resumes/"+resumeID+"/diff", map[string]string{"resumeID": resumeID}, nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.Diff)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		rr := diff(t, "resume-1")
+		assertStatusCode(t, http.StatusOK, rr)
+
+		var resp ResumeDiffResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, "resume-1", resp.ResumeID)
+
+		assert.True(t, resp.Summary.Changed)
+		assert.Equal(t, []DiffSegmentResponse{
+			{Op: "equal", Text: "Backend engineer"},
+			{Op: "insert", Text: " focused on payments"},
+		}, resp.Summary.Segments)
+
+		require.Len(t, resp.Bullets, 2)
+		assert.Equal(t, "bullet-1", resp.Bullets[0].BulletID)
+		assert.Equal(t, "exp-1", resp.Bullets[0].ExperienceID)
+		assert.True(t, resp.Bullets[0].Changed)
+		assert.Equal(t, []DiffSegmentResponse{
+			{Op: "equal", Text: "Cut "},
+			{Op: "insert", Text: "p99 "},
+			{Op: "equal", Text: "latency with caching"},
+		}, resp.Bullets[0].Segments)
+		assert.False(t, resp.Bullets[1].Changed)
+	})
+
+	t.Run("error - not tailored yet", func(t *testing.T) {
+		assertErrorResponse(t, diff(t, "resume-2"), http.StatusUnprocessableEntity, "RESUME_NOT_READY")
+	})
+
+	t.Run("error - unknown resume", func(t *testing.T) {
+		assertErrorResponse(t, diff(t, "resume-999"), http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+}
+
+func TestResumeHandlerCreateDetectsLanguage(t *testing.T) {
+	userRepo := mocks.NewInMemoryUserRepository()
+	user := createTestUser("firebase-123")
+	user.ID = "user-123"
+	userRepo.Seed(user)
+	handler := NewResumeHandler(services.NewResumeService(mocks.NewInMemoryResumeRepository(), userRepo,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	create := func(t *testing.T, body CreateResumeRequest) ResumeResponse {
+		t.Helper()
+		req := newJSONRequest(t, http.MethodPost, "/v1/resumes", body)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		rr := executeRequest(t, req, handler.Create)
+		assertStatusCode(t, http.StatusCreated, rr)
+
+		var resp ResumeResponse
+		parseJSONResponse(t, rr, &resp)
+		return resp
+	}
+
+	portuguese := "Estamos em busca de uma pessoa desenvolvedora backend para o nosso time, com experiência em Go e PostgreSQL."
+
+	t.Run("targets the detected language", func(t *testing.T) {
+		resp := create(t, CreateResumeRequest{JobDescription: portuguese})
+		assert.Equal(t, "pt", resp.DetectedLanguage)
+		assert.Equal(t, "pt-br", resp.TargetLanguage)
+	})
+
+	t.Run("explicit target language wins", func(t *testing.T) {
+		resp := create(t, CreateResumeRequest{JobDescription: portuguese, TargetLanguage: "en"})
+		assert.Equal(t, "pt", resp.DetectedLanguage)
+		assert.Equal(t, "en", resp.TargetLanguage)
+	})
+
+	t.Run("unsupported language keeps the default", func(t *testing.T) {
+		resp := create(t, CreateResumeRequest{JobDescription: "Buscamos un ingeniero backend para nuestro equipo, con experiencia en Go y las bases de datos del producto."})
+		assert.Equal(t, "es", resp.DetectedLanguage)
+		assert.Equal(t, "en", resp.TargetLanguage)
+	})
+
+	t.Run("undetected language keeps the default", func(t *testing.T) {
+		resp := create(t, CreateResumeRequest{JobDescription: "Go, Kubernetes, AWS"})
+		assert.Empty(t, resp.DetectedLanguage)
+		assert.Equal(t, "en", resp.TargetLanguage)
+	})
+}
+
+func TestResumeHandlerTailorVoice(t *testing.T) {
+	// The voice is checked before anything is loaded.
+	handler := NewResumeHandler(services.NewResumeService(mocks.NewInMemoryResumeRepository(), mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	tailor := func(t *testing.T, body TailorResumeRequest) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodPost, "/v1/resumes/resume-1/tailor", map[string]string{"resumeID": "resume-1"}, body)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.Tailor)
+	}
+
+	t.Run("error - unknown tone", func(t *testing.T) {
+		assertErrorResponse(t, tailor(t, TailorResumeRequest{Tone: "sarcastic"}), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - unknown persona", func(t *testing.T) {
+		assertErrorResponse(t, tailor(t, TailorResumeRequest{Tone: "humble", Persona: "ceo"}), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("valid voice reaches the resume", func(t *testing.T) {
+		rr := tailor(t, TailorResumeRequest{Tone: "confident", Persona: "hr_screener"})
+		assertErrorResponse(t, rr, http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+}
+
+func TestResumeHandlerFitCheck(t *testing.T) {
+	// Requests failing before the profile is loaded need no other repositories.
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(createTestResume("resume-1", "user-123"))
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	fitCheck := func(t *testing.T, resumeID, query string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodGet, "/v1/resumes/"+resumeID+"/fit-check"+query, map[string]string{"resumeID": resumeID}, nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.FitCheck)
+	}
+
+	t.Run("error - font size not a number", func(t *testing.T) {
+		assertErrorResponse(t, fitCheck(t, "resume-1", "?font_size=large"), http.StatusBadRequest, "INVALID_REQUEST")
+	})
+
+	t.Run("error - font size out of range", func(t *testing.T) {
+		assertErrorResponse(t, fitCheck(t, "resume-1", "?font_size=14"), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - unknown density", func(t *testing.T) {
+		assertErrorResponse(t, fitCheck(t, "resume-1", "?density=tight"), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - not tailored yet", func(t *testing.T) {
+		assertErrorResponse(t, fitCheck(t, "resume-1", ""), http.StatusUnprocessableEntity, "RESUME_NOT_READY")
+	})
+
+	t.Run("error - unknown resume", func(t *testing.T) {
+		assertErrorResponse(t, fitCheck(t, "resume-999", ""), http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+}
+
+func TestResumeHandlerFit(t *testing.T) {
+	// Requests failing before the AI is asked need no other dependencies.
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(createTestResume("resume-1", "user-123"))
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	fit := func(t *testing.T, resumeID string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodPost, "/v1/resumes/"+resumeID+"/fit", map[string]string{"resumeID": resumeID}, body)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.Fit)
+	}
+
+	t.Run("error - invalid body", func(t *testing.T) {
+		assertErrorResponse(t, fit(t, "resume-1", map[string]any{"font_size": "large"}), http.StatusBadRequest, "INVALID_REQUEST")
+	})
+
+	t.Run("error - font size out of range", func(t *testing.T) {
+		fontSize := 8
+		assertErrorResponse(t, fit(t, "resume-1", FitResumeRequest{FontSize: &fontSize}), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - unknown density", func(t *testing.T) {
+		assertErrorResponse(t, fit(t, "resume-1", FitResumeRequest{Density: "tight"}), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - not tailored yet", func(t *testing.T) {
+		assertErrorResponse(t, fit(t, "resume-1", nil), http.StatusUnprocessableEntity, "RESUME_NOT_READY")
+	})
+
+	t.Run("error - unknown resume", func(t *testing.T) {
+		assertErrorResponse(t, fit(t, "resume-999", nil), http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+}
+
+func TestResumeHandlerRegenerate(t *testing.T) {
+	// Requests failing before the AI is asked need no other dependencies.
+	tailored := createTestResume("resume-2", "user-123")
+	tailored.GeneratedContent = &domain.ResumeContent{
+		Summary:     "Backend engineer focused on payments.",
+		Experiences: []domain.TailoredExperience{{ExperienceID: "exp-1"}},
+	}
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(createTestResume("resume-1", "user-123"), tailored)
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	regenerate := func(t *testing.T, resumeID, section string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		path := "/v1/resumes/" + resumeID + "/regenerate?section=" + url.QueryEscape(section)
+		req := newRequestWithChiContext(t, http.MethodPost, path, map[string]string{"resumeID": resumeID}, body)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.Regenerate)
+	}
+
+	t.Run("error - invalid body", func(t *testing.T) {
+		assertErrorResponse(t, regenerate(t, "resume-2", "summary", map[string]any{"instructions": 5}), http.StatusBadRequest, "INVALID_REQUEST")
+	})
+
+	t.Run("error - unknown section", func(t *testing.T) {
+		assertErrorResponse(t, regenerate(t, "resume-2", "education", nil), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - experience ID missing", func(t *testing.T) {
+		assertErrorResponse(t, regenerate(t, "resume-2", "experience:", nil), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - instructions for skills", func(t *testing.T) {
+		body := RegenerateSectionRequest{Instructions: "List Go first"}
+		assertErrorResponse(t, regenerate(t, "resume-2", "skills", body), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - instructions too long", func(t *testing.T) {
+		body := RegenerateSectionRequest{Instructions: strings.Repeat("x", services.MaxRegenerateInstructionsLength+1)}
+		assertErrorResponse(t, regenerate(t, "resume-2", "summary", body), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - experience not on the resume", func(t *testing.T) {
+		assertErrorResponse(t, regenerate(t, "resume-2", "experience:exp-9", nil), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - not tailored yet", func(t *testing.T) {
+		assertErrorResponse(t, regenerate(t, "resume-1", "summary", nil), http.StatusUnprocessableEntity, "RESUME_NOT_READY")
+	})
+
+	t.Run("error - unknown resume", func(t *testing.T) {
+		assertErrorResponse(t, regenerate(t, "resume-999", "summary", nil), http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+}
+
+func TestResumeHandlerTailorEstimate(t *testing.T) {
+	// Requests failing before the estimate need no other dependencies.
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(createTestResume("resume-1", "user-123"))
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	estimate := func(t *testing.T, resumeID, query string) *httptest.ResponseRecorder {
+		t.Helper()
+		path := "/v1/resumes/" + resumeID + "/tailor/estimate?" + query
+		req := newRequestWithChiContext(t, http.MethodGet, path, map[string]string{"resumeID": resumeID}, nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.TailorEstimate)
+	}
+
+	t.Run("success - quota pre-check", func(t *testing.T) {
+		cost := 0.0061
+		result := &services.TailoringEstimate{
+			TailoringEstimate: &ports.TailoringEstimate{
+				Calls:        []ports.AICallEstimate{{Operation: "tailor_bullet", Model: "llama", Count: 10, InputTokens: 5400, OutputTokens: 600, CostUSD: &cost}},
+				InputTokens:  5400,
+				OutputTokens: 600,
+				CostUSD:      &cost,
+			},
+			Bullets:    42,
+			MaxBullets: 10,
+		}
+		response := mapTailoringEstimateToResponse(result)
+		assert.True(t, response.WithinQuota)
+		assert.Nil(t, response.Quota)
+		assert.Equal(t, &cost, response.CostUSD)
+		assert.Equal(t, "tailor_bullet", response.Calls[0].Operation)
+
+		result.Quota = &services.Quota{Action: domain.UsageTailor, Limit: 20, Used: 20, Remaining: 0}
+		response = mapTailoringEstimateToResponse(result)
+		assert.False(t, response.WithinQuota)
+		assert.Equal(t, 20, response.Quota.Used)
+
+		result.Quota = &services.Quota{Action: domain.UsageTailor, Remaining: -1}
+		assert.True(t, mapTailoringEstimateToResponse(result).WithinQuota)
+	})
+
+	t.Run("error - invalid bullet limit", func(t *testing.T) {
+		assertErrorResponse(t, estimate(t, "resume-1", "max_bullets_per_job=many"), http.StatusBadRequest, "INVALID_REQUEST")
+		assertErrorResponse(t, estimate(t, "resume-1", "max_bullets_per_job=0"), http.StatusBadRequest, "INVALID_REQUEST")
+	})
+
+	t.Run("error - unknown resume", func(t *testing.T) {
+		assertErrorResponse(t, estimate(t, "resume-999", ""), http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+}
+
+func TestResumeHandlerRetailor(t *testing.T) {
+	// Requests failing before the AI is asked need no other dependencies.
+	seed := int64(7)
+	settings := &domain.AIRunSettings{
+		Seed:       &seed,
+		MaxBullets: 10,
+		Calls:      []domain.AICallSettings{{Operation: "tailor_bullet", PromptVersion: "v1", Model: "llama", Temperature: 0.7, Count: 8}},
+	}
+	unrecorded := createTestResume("resume-2", "user-123")
+	unrecorded.GeneratedContent = &domain.ResumeContent{Summary: "Backend engineer focused on payments."}
+	recorded := createTestResume("resume-3", "user-123")
+	recorded.GeneratedContent = &domain.ResumeContent{Summary: "Backend engineer focused on payments.", AISettings: settings}
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(createTestResume("resume-1", "user-123"), unrecorded, recorded)
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	retailor := func(t *testing.T, resumeID, sameSettings string) *httptest.ResponseRecorder {
+		t.Helper()
+		path := "/v1/resumes/" + resumeID + "/retailor?same_settings=" + sameSettings
+		req := newRequestWithChiContext(t, http.MethodPost, path, map[string]string{"resumeID": resumeID}, nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.Retailor)
+	}
+
+	t.Run("success - settings exposed with the content", func(t *testing.T) {
+		dto := mapResumeContentToDTO(recorded.GeneratedContent)
+		require.NotNil(t, dto.AISettings)
+		assert.Equal(t, &seed, dto.AISettings.Seed)
+		assert.Equal(t, 10, dto.AISettings.MaxBullets)
+		assert.Equal(t, []AICallSettingsDTO{{Operation: "tailor_bullet", PromptVersion: "v1", Model: "llama", Temperature: 0.7, Count: 8}}, dto.AISettings.Calls)
+
+		assert.Nil(t, mapResumeContentToDTO(unrecorded.GeneratedContent).AISettings)
+	})
+
+	t.Run("error - settings not recorded", func(t *testing.T) {
+		assertErrorResponse(t, retailor(t, "resume-2", "true"), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - not tailored yet", func(t *testing.T) {
+		assertErrorResponse(t, retailor(t, "resume-1", "true"), http.StatusUnprocessableEntity, "RESUME_NOT_READY")
+		assertErrorResponse(t, retailor(t, "resume-1", "false"), http.StatusUnprocessableEntity, "RESUME_NOT_READY")
+	})
+
+	t.Run("error - unknown resume", func(t *testing.T) {
+		assertErrorResponse(t, retailor(t, "resume-999", "true"), http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+}
+
+func TestResumeHandlerSummaries(t *testing.T) {
+	tailored := createTestResume("resume-1", "user-123")
+	tailored.GeneratedContent = &domain.ResumeContent{
+		Summary: "Backend engineer focused on payments.",
+		SummaryVariants: []domain.SummaryVariant{
+			{Angle: domain.SummaryAngleTechnical, Summary: "Backend engineer focused on payments."},
+			{Angle: domain.SummaryAngleLeadership, Summary: "Engineer leading the payments team."},
+		},
+		SummaryAngle: domain.SummaryAngleTechnical,
+	}
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(tailored, createTestResume("resume-2", "user-123"))
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	list := func(t *testing.T, resumeID string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodGet, "/v1/resumes/"+resumeID+"/summaries", map[string]string{"resumeID": resumeID}, nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.ListSummaries)
+	}
+	selectSummary := func(t *testing.T, resumeID string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodPost, "/v1/resumes/"+resumeID+"/summaries/select", map[string]string{"resumeID": resumeID}, body)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.SelectSummary)
+	}
+
+	t.Run("lists variants", func(t *testing.T) {
+		rr := list(t, "resume-1")
+		assertStatusCode(t, http.StatusOK, rr)
+
+		var resp SummaryVariantsResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, "Backend engineer focused on payments.", resp.Summary)
+		assert.Equal(t, []SummaryVariantDTO{
+			{Angle: "technical", Summary: "Backend engineer focused on payments.", Active: true},
+			{Angle: "leadership", Summary: "Engineer leading the payments team."},
+		}, resp.Variants)
+	})
+
+	t.Run("selects a variant", func(t *testing.T) {
+		rr := selectSummary(t, "resume-1", SelectSummaryRequest{Angle: "leadership"})
+		assertStatusCode(t, http.StatusOK, rr)
+
+		var resp ResumeResponse
+		parseJSONResponse(t, rr, &resp)
+		require.NotNil(t, resp.GeneratedContent)
+		assert.Equal(t, "Engineer leading the payments team.", resp.GeneratedContent.Summary)
+		assert.Equal(t, "leadership", resp.GeneratedContent.SummaryAngle)
+
+		stored, err := resumeRepo.GetByIDForUser(context.Background(), "resume-1", "user-123")
+		require.NoError(t, err)
+		assert.Equal(t, "leadership", stored.GeneratedContent.SummaryAngle)
+	})
+
+	t.Run("error - unknown angle", func(t *testing.T) {
+		assertErrorResponse(t, selectSummary(t, "resume-1", SelectSummaryRequest{Angle: "impact"}), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - angle missing", func(t *testing.T) {
+		assertErrorResponse(t, selectSummary(t, "resume-1", SelectSummaryRequest{}), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - not tailored yet", func(t *testing.T) {
+		assertErrorResponse(t, list(t, "resume-2"), http.StatusUnprocessableEntity, "RESUME_NOT_READY")
+		assertErrorResponse(t, selectSummary(t, "resume-2", SelectSummaryRequest{Angle: "technical"}), http.StatusUnprocessableEntity, "RESUME_NOT_READY")
+	})
+
+	t.Run("error - unknown resume", func(t *testing.T) {
+		assertErrorResponse(t, list(t, "resume-999"), http.StatusNotFound, "RESUME_NOT_FOUND")
+		assertErrorResponse(t, selectSummary(t, "resume-999", SelectSummaryRequest{Angle: "technical"}), http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+}
+
+func TestResumeHandlerGetMetrics(t *testing.T) {
+	tailored := createTestResume("resume-1", "user-123")
+	tailored.GeneratedContent = &domain.ResumeContent{
+		Summary: "Backend engineer focused on payments.",
+		Experiences: []domain.TailoredExperience{{
+			ExperienceID: "exp-1",
+			Bullets: []domain.TailoredBullet{
+				{BulletID: "bullet-1", OriginalContent: "Built the API", TailoredContent: "Led a team of five engineers"},
+				{BulletID: "bullet-2", OriginalContent: "Responsible for " + strings.Repeat("payments ", 20)},
+			},
+		}},
+	}
+
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(tailored, createTestResume("resume-2", "user-123"))
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	get := func(t *testing.T, resumeID string) ResumeResponse {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodGet, "/v1/resumes/"+resumeID, map[string]string{"resumeID": resumeID}, nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		rr := executeRequest(t, req, handler.Get)
+		assertStatusCode(t, http.StatusOK, rr)
+
+		var resp ResumeResponse
+		parseJSONResponse(t, rr, &resp)
+		return resp
+	}
+
+	t.Run("tailored content", func(t *testing.T) {
+		content := get(t, "resume-1").GeneratedContent
+		require.NotNil(t, content)
+		assert.Equal(t, 11, content.MetricsFontSize)
+
+		require.NotNil(t, content.SummaryMetrics)
+		assert.Equal(t, 37, content.SummaryMetrics.Characters)
+		assert.Equal(t, 1, content.SummaryMetrics.Lines)
+		assert.Empty(t, content.SummaryMetrics.ActionVerb)
+
+		bullets := content.Experiences[0].Bullets
+		require.NotNil(t, bullets[0].Metrics)
+		assert.Equal(t, 28, bullets[0].Metrics.Characters)
+		assert.Equal(t, "Led", bullets[0].Metrics.ActionVerb)
+		assert.Greater(t, bullets[0].Metrics.ReadingEase, 50.0)
+
+		// Bullets without tailored content are measured as the original.
+		require.NotNil(t, bullets[1].Metrics)
+		assert.Equal(t, 195, bullets[1].Metrics.Characters)
+		assert.Equal(t, 3, bullets[1].Metrics.Lines)
+		assert.Empty(t, bullets[1].Metrics.ActionVerb)
+	})
+
+	t.Run("not tailored yet", func(t *testing.T) {
+		assert.Nil(t, get(t, "resume-2").GeneratedContent)
+	})
+}
+
+func TestResumeHandlerListSort(t *testing.T) {
+	older := createTestResume("resume-1", "user-123")
+	older.CreatedAt = older.CreatedAt.Add(-time.Hour)
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(older, createTestResume("resume-2", "user-123"), createTestResume("resume-3", "user-123"))
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	call := func(t *testing.T, handlerFunc http.HandlerFunc, method, resumeID string) ResumeResponse {
+		t.Helper()
+		req := newRequestWithChiContext(t, method, "/v1/resumes/"+resumeID, map[string]string{"resumeID": resumeID}, nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+
+		rr := executeRequest(t, req, handlerFunc)
+		assertStatusCode(t, http.StatusOK, rr)
+		var resp ResumeResponse
+		parseJSONResponse(t, rr, &resp)
+		return resp
+	}
+	list := func(t *testing.T, sort string) []string {
+		t.Helper()
+		req := newJSONRequest(t, http.MethodGet, "/v1/resumes?sort="+sort, nil)
+		rr := executeRequest(t, req.WithContext(ctx), handler.List)
+		assertStatusCode(t, http.StatusOK, rr)
+		var resp ListResumesResponse
+		parseJSONResponse(t, rr, &resp)
+		ids := make([]string, 0, len(resp.Data))
+		for _, resume := range resp.Data {
+			ids = append(ids, resume.ID)
+		}
+		return ids
+	}
+
+	pinned := call(t, handler.Pin, http.MethodPost, "resume-1")
+	assert.True(t, pinned.Pinned)
+	assert.Equal(t, "resume-1", list(t, "pinned_first")[0])
+	assert.Equal(t, "resume-1", list(t, "newest")[2])
+
+	viewed := call(t, handler.Get, http.MethodGet, "resume-3")
+	require.NotNil(t, viewed.LastAccessedAt)
+	assert.Equal(t, []string{"resume-3"}, list(t, "recent")[:1])
+
+	unpinned := call(t, handler.Unpin, http.MethodPost, "resume-1")
+	assert.False(t, unpinned.Pinned)
+
+	t.Run("invalid sort", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/resumes?sort=oldest", nil)
+		rr := executeRequest(t, req.WithContext(ctx), handler.List)
+		assertErrorResponse(t, rr, http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+}
+
+func TestResumeHandlerListSummary(t *testing.T) {
+	resume := createTestResume("resume-1", "user-123")
+	resume.GeneratedContent = &domain.ResumeContent{}
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(resume)
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	list := func(t *testing.T, view string) map[string]any {
+		t.Helper()
+		req := newJSONRequest(t, http.MethodGet, "/v1/resumes?view="+view, nil)
+		rr := executeRequest(t, req.WithContext(ctx), handler.List)
+		assertStatusCode(t, http.StatusOK, rr)
+		var resp map[string]any
+		parseJSONResponse(t, rr, &resp)
+		data := resp["data"].([]any)
+		require.Len(t, data, 1)
+		return data[0].(map[string]any)
+	}
+
+	full := list(t, "full")
+	assert.Contains(t, full, "job_description")
+	assert.Contains(t, full, "generated_content")
+
+	summary := list(t, "summary")
+	assert.NotContains(t, summary, "job_description")
+	assert.NotContains(t, summary, "generated_content")
+	assert.Equal(t, "resume-1", summary["id"])
+	assert.Equal(t, "Software Engineer at Tech Corp", summary["job_title"])
+
+	t.Run("invalid view", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/resumes?view=compact", nil)
+		rr := executeRequest(t, req.WithContext(ctx), handler.List)
+		assertErrorResponse(t, rr, http.StatusBadRequest, "INVALID_REQUEST")
+	})
+}
+
+func TestResumeHandlerListSignsPDFURLs(t *testing.T) {
+	withPDF := createTestResume("resume-1", "user-123")
+	withPDF.PDFURL = strPtr("memory://expired")
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(withPDF, createTestResume("resume-2", "user-123"))
+	// The storage is empty: lists sign URLs without looking the PDFs up.
+	handler := NewResumeHandler(services.NewResumeService(resumeRepo, mocks.NewInMemoryUserRepository(),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, mocks.NewInMemoryFileStorage()))
+
+	req := newJSONRequest(t, http.MethodGet, "/v1/resumes", nil)
+	rr := executeRequest(t, req.WithContext(setupTestContext("user-123", "firebase-123", "test@example.com")), handler.List)
+	assertStatusCode(t, http.StatusOK, rr)
+
+	var resp ListResumesResponse
+	parseJSONResponse(t, rr, &resp)
+	pdfURLs := make(map[string]string)
+	for _, resume := range resp.Data {
+		pdfURLs[resume.ID] = resume.PDFURL
+	}
+	assert.Equal(t, map[string]string{
+		"resume-1": "memory://resumes/user-123/resume-1.pdf",
+		"resume-2": "",
+	}, pdfURLs)
+}
+
+// The empty profile repositories stub the sections a resume is rendered with,
+// for a user with no skills, languages, education or projects.
+type (
+	emptySkillRepository     struct{ ports.SkillRepository }
+	emptyLanguageRepository  struct{ ports.SpokenLanguageRepository }
+	emptyEducationRepository struct{ ports.EducationRepository }
+	emptyProjectRepository   struct{ ports.ProjectRepository }
+)
+
+func (emptySkillRepository) ListByUserID(context.Context, string) ([]domain.Skill, error) {
+	return nil, nil
+}
+
+func (emptyLanguageRepository) ListByUserID(context.Context, string) ([]domain.SpokenLanguage, error) {
+	return nil, nil
+}
+
+func (emptyEducationRepository) ListByUserID(context.Context, string) ([]domain.Education, error) {
+	return nil, nil
+}
+
+func (emptyProjectRepository) ListByUserIDWithBullets(context.Context, string) ([]domain.Project, error) {
+	return nil, nil
+}
+
+// newRenderingResumeService creates a resume service that can render the
+// resumes of resumeRepo for the users of userRepo.
+func newRenderingResumeService(resumeRepo ports.ResumeRepository, userRepo ports.UserRepository) *services.ResumeService {
+	return services.NewResumeService(resumeRepo, userRepo, nil, nil,
+		emptySkillRepository{}, emptyLanguageRepository{}, emptyEducationRepository{}, emptyProjectRepository{},
+		nil, nil, nil, mocks.NewInMemoryFileStorage())
+}
+
+// createGeneratedResume creates a resume whose content has been generated.
+func createGeneratedResume(id, userID string) *domain.Resume {
+	resume := createTestResume(id, userID)
+	resume.Status = domain.ResumeStatusGenerated
+	resume.GeneratedContent = &domain.ResumeContent{
+		Summary: "Backend engineer",
+		Experiences: []domain.TailoredExperience{{
+			ExperienceID: "exp-1",
+			Title:        "Software Engineer",
+			Organization: "Acme",
+			Bullets: []domain.TailoredBullet{
+				{BulletID: "bullet-1", TailoredContent: "Cut latency with caching"},
+			},
+		}},
+	}
+	return resume
+}
+
+func TestResumeHandlerPreviewHTML(t *testing.T) {
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(
+		createGeneratedResume("resume-1", "user-123"),
+		createTestResume("resume-2", "user-123"),
+		createGeneratedResume("resume-3", "user-456"),
+	)
+	userRepo := mocks.NewInMemoryUserRepository()
+	user := createTestUser("firebase-123")
+	user.ID = "user-123"
+	userRepo.Seed(user)
+	handler := NewResumeHandler(newRenderingResumeService(resumeRepo, userRepo))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	preview := func(t *testing.T, resumeID string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodPost, "/v1/resumes/"+resumeID+"/preview-html", map[string]string{"resumeID": resumeID}, body)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.PreviewHTML)
+	}
+
+	t.Run("success - renders the draft edits", func(t *testing.T) {
+		rr := preview(t, "resume-1", map[string]any{
+			"summary":   "Staff engineer",
+			"bullets":   map[string]string{"bullet-1": "Cut p99 latency by 40%"},
+			"font_size": 10,
+		})
+		assertStatusCode(t, http.StatusOK, rr)
+		assert.Equal(t, "text/html; charset=utf-8", rr.Header().Get("Content-Type"))
+		assert.Equal(t, "no-cache, no-store, must-revalidate", rr.Header().Get("Cache-Control"))
+
+		body := rr.Body.String()
+		assert.Contains(t, body, "Staff engineer")
+		assert.Contains(t, body, "Cut p99 latency by 40%")
+		assert.NotContains(t, body, "Cut latency with caching")
+		assert.Contains(t, body, "font-size: 10pt;")
+	})
+
+	t.Run("success - edits are not saved", func(t *testing.T) {
+		saved, err := resumeRepo.GetByIDForUser(context.Background(), "resume-1", "user-123")
+		require.NoError(t, err)
+		assert.Equal(t, "Backend engineer", saved.GeneratedContent.Summary)
+		assert.Equal(t, "Cut latency with caching", saved.GeneratedContent.Experiences[0].Bullets[0].TailoredContent)
+	})
+
+	t.Run("error - invalid edits", func(t *testing.T) {
+		rr := preview(t, "resume-1", map[string]any{"bullets": map[string]string{"bullet-9": "Shipped"}})
+		assertErrorResponse(t, rr, http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+
+		rr = preview(t, "resume-1", map[string]any{"font_size": 14})
+		assertErrorResponse(t, rr, http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - invalid body", func(t *testing.T) {
+		req := newRequestWithChiContext(t, http.MethodPost, "/v1/resumes/resume-1/preview-html", map[string]string{"resumeID": "resume-1"}, nil)
+		req.Body = io.NopCloser(strings.NewReader("{"))
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		assertErrorResponse(t, executeRequest(t, req, handler.PreviewHTML), http.StatusBadRequest, "INVALID_REQUEST")
+	})
+
+	t.Run("error - not generated yet", func(t *testing.T) {
+		assertErrorResponse(t, preview(t, "resume-2", map[string]any{}), http.StatusUnprocessableEntity, "RESUME_NOT_READY")
+	})
+
+	t.Run("error - another user's resume", func(t *testing.T) {
+		assertErrorResponse(t, preview(t, "resume-3", map[string]any{}), http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+
+	t.Run("error - unknown resume", func(t *testing.T) {
+		assertErrorResponse(t, preview(t, "resume-999", map[string]any{}), http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+}
+
+// htmlPDFEngine is a PDF engine whose PDFs are the HTML they were rendered from.
+type htmlPDFEngine struct {
+	ports.PDFEngine
+	renders []ports.GeneratePDFRequest
+}
+
+func (e *htmlPDFEngine) GeneratePDF(_ context.Context, req ports.GeneratePDFRequest) (*ports.PDFResult, error) {
+	e.renders = append(e.renders, req)
+	return &ports.PDFResult{Content: io.NopCloser(strings.NewReader(req.HTML)), Size: int64(len(req.HTML))}, nil
+}
+
+// fixedQRCodeEncoder encodes every link as the same image.
+type fixedQRCodeEncoder struct{}
+
+func (fixedQRCodeEncoder) DataURI(string) (string, error) {
+	return "data:image/png;base64,UVJDT0RF", nil
+}
+
+func TestResumeHandlerGeneratedPDFIsDownloaded(t *testing.T) {
+	resume := createGeneratedResume("resume-1", "user-123")
+	resume.QRCode = domain.QRCodePortfolio
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(resume)
+	userRepo := mocks.NewInMemoryUserRepository()
+	user := createTestUser("firebase-123")
+	user.ID = "user-123"
+	user.PortfolioURL = strPtr("https://ana.dev")
+	userRepo.Seed(user)
+	storage := mocks.NewInMemoryFileStorage()
+	engine := &htmlPDFEngine{}
+
+	resumeService := services.NewResumeService(resumeRepo, userRepo, nil, nil,
+		emptySkillRepository{}, emptyLanguageRepository{}, emptyEducationRepository{}, emptyProjectRepository{},
+		nil, engine, nil, storage)
+	resumeService.SetQRCodeEncoder(fixedQRCodeEncoder{})
+	handler := NewResumeHandler(resumeService)
+
+	generated, err := resumeService.GeneratePDF(context.Background(), services.GeneratePDFRequest{
+		ResumeID: "resume-1",
+		UserID:   "user-123",
+	})
+	require.NoError(t, err)
+	require.Len(t, engine.renders, 1)
+	assert.Equal(t, domain.TemplateJake, engine.renders[0].TemplateName)
+	assert.Contains(t, engine.renders[0].HTML, "data:image/png;base64,UVJDT0RF")
+	require.NotNil(t, generated.PDFURL)
+	assert.Equal(t, "memory://resumes/user-123/resume-1.pdf", *generated.PDFURL)
+
+	// Downloading serves the generated PDF from the cache.
+	req := newRequestWithChiContext(t, http.MethodGet, "/v1/resumes/resume-1/pdf", map[string]string{"resumeID": "resume-1"}, nil)
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+	req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+	rr := executeRequest(t, req, handler.GeneratePDF)
+	assertStatusCode(t, http.StatusOK, rr)
+	assert.Len(t, engine.renders, 1)
+	assert.Equal(t, engine.renders[0].HTML, rr.Body.String())
+}
+
+func TestResumeHandlerExport(t *testing.T) {
+	resumeRepo := mocks.NewInMemoryResumeRepository()
+	resumeRepo.Seed(
+		createGeneratedResume("resume-1", "user-123"),
+		createTestResume("resume-2", "user-123"),
+		createGeneratedResume("resume-3", "user-456"),
+	)
+	userRepo := mocks.NewInMemoryUserRepository()
+	user := createTestUser("firebase-123")
+	user.ID = "user-123"
+	userRepo.Seed(user)
+	handler := NewResumeHandler(newRenderingResumeService(resumeRepo, userRepo))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	export := func(t *testing.T, resumeID, query string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodGet, "/v1/resumes/"+resumeID+"/export?"+query, map[string]string{"resumeID": resumeID}, nil)
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.Export)
+	}
+
+	tests := []struct {
+		format          string
+		wantContentType string
+		wantExt         string
+		wantBody        string
+	}{
+		{format: "html", wantContentType: "text/html; charset=utf-8", wantExt: "html", wantBody: "<!DOCTYPE html>"},
+		{format: "md", wantContentType: "text/markdown; charset=utf-8", wantExt: "md", wantBody: "Cut latency with caching"},
+		{format: "text", wantContentType: "text/plain; charset=us-ascii", wantExt: "txt", wantBody: "Cut latency with caching"},
+		{format: "europass", wantContentType: "application/xml; charset=utf-8", wantExt: "xml", wantBody: "<?xml"},
+		{format: "latex", wantContentType: "application/x-tex; charset=utf-8", wantExt: "tex", wantBody: `\begin{document}`},
+	}
+
+	for _, tt := range tests {
+		t.Run("success - "+tt.format, func(t *testing.T) {
+			rr := export(t, "resume-1", "format="+tt.format)
+			assertStatusCode(t, http.StatusOK, rr)
+			assert.Equal(t, tt.wantContentType, rr.Header().Get("Content-Type"))
+			assert.Equal(t, `inline; filename="Test_User_Resume_Software_Engineer_at_Tech_Corp.`+tt.wantExt+`"`, rr.Header().Get("Content-Disposition"))
+			assert.Equal(t, "no-cache, no-store, must-revalidate", rr.Header().Get("Cache-Control"))
+			assert.Contains(t, rr.Body.String(), tt.wantBody)
+		})
+	}
+
+	t.Run("success - download as attachment", func(t *testing.T) {
+		rr := export(t, "resume-1", "format=markdown&download=true")
+		assertStatusCode(t, http.StatusOK, rr)
+		assert.Equal(t, `attachment; filename="Test_User_Resume_Software_Engineer_at_Tech_Corp.md"`, rr.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("error - unknown format", func(t *testing.T) {
+		assertErrorResponse(t, export(t, "resume-1", "format=docx"), http.StatusBadRequest, "INVALID_FORMAT")
+		assertErrorResponse(t, export(t, "resume-1", ""), http.StatusBadRequest, "INVALID_FORMAT")
+	})
+
+	t.Run("error - not generated yet", func(t *testing.T) {
+		assertErrorResponse(t, export(t, "resume-2", "format=html"), http.StatusUnprocessableEntity, "RESUME_NOT_READY")
+	})
+
+	t.Run("error - another user's resume", func(t *testing.T) {
+		assertErrorResponse(t, export(t, "resume-3", "format=html"), http.StatusNotFound, "RESUME_NOT_FOUND")
+	})
+}
@@ -0,0 +1,268 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// ResumeTagHandler handles resume tag HTTP requests.
+type ResumeTagHandler struct {
+	tagService *services.ResumeTagService
+}
+
+// NewResumeTagHandler creates a new ResumeTagHandler.
+func NewResumeTagHandler(tagService *services.ResumeTagService) *ResumeTagHandler {
+	return &ResumeTagHandler{
+		tagService: tagService,
+	}
+}
+
+// List returns the authenticated user's resume tags.
+//
+//	@Summary		List resume tags
+//	@Description	Returns the user's resume tags by name, each with the number of resumes that have it. Filter resumes by tag with GET /v1/resumes?tag={id}.
+//	@Tags			resume-tags
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	ListResumeTagsResponse
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resume-tags [get]
+func (h *ResumeTagHandler) List(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	tags, err := h.tagService.ListTags(r.Context(), authUser.ID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to list resume tags")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve resume tags")
+		return
+	}
+
+	resp := ListResumeTagsResponse{
+		Data: make([]ResumeTagResponse, 0, len(tags)),
+	}
+	for i := range tags {
+		resp.Data = append(resp.Data, mapResumeTagToResponse(&tags[i]))
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// Create creates a resume tag.
+//
+//	@Summary		Create resume tag
+//	@Description	Creates a tag for organizing resumes, such as "Backend", "2025 Q1" or "Dream companies". Names are unique per user, ignoring case, and at most 50 characters.
+//	@Tags			resume-tags
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		ResumeTagRequest	true	"Tag name"
+//	@Success		201		{object}	ResumeTagResponse
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		409		{object}	ErrorResponse	"Tag name already used"
+//	@Failure		422		{object}	ErrorResponse	"Validation failed"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resume-tags [post]
+func (h *ResumeTagHandler) Create(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req ResumeTagRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	tag, err := h.tagService.CreateTag(r.Context(), authUser.ID, req.Name)
+	if err != nil {
+		if h.handleTagError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to create resume tag")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to create resume tag")
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, mapResumeTagToResponse(tag))
+}
+
+// Update renames a resume tag.
+//
+//	@Summary		Rename resume tag
+//	@Description	Renames a resume tag; resumes keep it.
+//	@Tags			resume-tags
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			tagID	path		string				true	"Tag ID"
+//	@Param			request	body		ResumeTagRequest	true	"New tag name"
+//	@Success		200		{object}	ResumeTagResponse
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404		{object}	ErrorResponse	"Tag not found"
+//	@Failure		409		{object}	ErrorResponse	"Tag name already used"
+//	@Failure		422		{object}	ErrorResponse	"Validation failed"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resume-tags/{tagID} [patch]
+func (h *ResumeTagHandler) Update(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	tagID := chi.URLParam(r, "tagID")
+	if tagID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Tag ID is required")
+		return
+	}
+
+	var req ResumeTagRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	tag, err := h.tagService.RenameTag(r.Context(), tagID, authUser.ID, req.Name)
+	if err != nil {
+		if h.handleTagError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("tag_id", tagID).Msg("Failed to rename resume tag")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to update resume tag")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapResumeTagToResponse(tag))
+}
+
+// Delete deletes a resume tag.
+//
+//	@Summary		Delete resume tag
+//	@Description	Removes the tag from all resumes and deletes it. The resumes are kept.
+//	@Tags			resume-tags
+//	@Security		BearerAuth
+//	@Param			tagID	path	string	true	"Tag ID"
+//	@Success		204		"No Content"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404		{object}	ErrorResponse	"Tag not found"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resume-tags/{tagID} [delete]
+func (h *ResumeTagHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	tagID := chi.URLParam(r, "tagID")
+	if tagID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Tag ID is required")
+		return
+	}
+
+	if err := h.tagService.DeleteTag(r.Context(), tagID, authUser.ID); err != nil {
+		if h.handleTagError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("tag_id", tagID).Msg("Failed to delete resume tag")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete resume tag")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Assign adds a tag to and removes it from many resumes at once.
+//
+//	@Summary		Assign resume tag
+//	@Description	Adds the tag to the resumes listed in add and removes it from those in remove, all at once. Adding a tag a resume already has, or removing one it does not have, is allowed. Every resume must belong to the user, and none may be in both lists.
+//	@Tags			resume-tags
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			tagID	path		string					true	"Tag ID"
+//	@Param			request	body		AssignResumeTagRequest	true	"Resumes to tag and untag"
+//	@Success		200		{object}	ResumeTagResponse
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404		{object}	ErrorResponse	"Tag not found"
+//	@Failure		422		{object}	ErrorResponse	"Unknown resumes"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resume-tags/{tagID}/resumes [post]
+func (h *ResumeTagHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	tagID := chi.URLParam(r, "tagID")
+	if tagID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Tag ID is required")
+		return
+	}
+
+	var req AssignResumeTagRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	tag, err := h.tagService.AssignTag(r.Context(), services.AssignTagRequest{
+		TagID:  tagID,
+		UserID: authUser.ID,
+		Add:    req.Add,
+		Remove: req.Remove,
+	})
+	if err != nil {
+		if h.handleTagError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("tag_id", tagID).Msg("Failed to assign resume tag")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to assign resume tag")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapResumeTagToResponse(tag))
+}
+
+// handleTagError responds to the not found, conflict and validation errors
+// of tag operations, and reports whether it did.
+func (h *ResumeTagHandler) handleTagError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, domain.ErrResumeTagNotFound):
+		respondError(w, http.StatusNotFound, "RESUME_TAG_NOT_FOUND", "Resume tag not found")
+		return true
+	case errors.Is(err, domain.ErrResumeTagAlreadyExists):
+		respondError(w, http.StatusConflict, "RESUME_TAG_EXISTS", "A resume tag with this name already exists")
+		return true
+	default:
+		return handleValidationError(w, err)
+	}
+}
+
+// mapResumeTagToResponse maps a domain ResumeTag to a ResumeTagResponse.
+func mapResumeTagToResponse(tag *domain.ResumeTag) ResumeTagResponse {
+	return ResumeTagResponse{
+		ID:          tag.ID,
+		Name:        tag.Name,
+		ResumeCount: tag.ResumeCount,
+		CreatedAt:   tag.CreatedAt,
+		UpdatedAt:   tag.UpdatedAt,
+	}
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/pkg/signedurl"
 )
 
 // RouterConfig holds configuration for the HTTP router.
@@ -26,14 +28,43 @@ type RouterConfig struct {
 	// RequestTimeout is the maximum duration for processing a request.
 	RequestTimeout time.Duration
 
-	// MaxRequestSize is the maximum size of request body in bytes.
+	// MaxRequestSize is the maximum size of request body in bytes, for
+	// routes without a limit in RequestSizeLimits.
 	MaxRequestSize int64
 
+	// RequestSizeLimits maps path prefixes to the maximum size of their
+	// request bodies in bytes, so that uploads may be larger than JSON
+	// bodies. The longest matching prefix wins.
+	RequestSizeLimits map[string]int64
+
 	// AllowedOrigins for CORS configuration.
 	AllowedOrigins []string
 
 	// BaseURL is the base URL for the API (used in Swagger).
 	BaseURL string
+
+	// AccessLog configures request logging.
+	AccessLog AccessLogConfig
+
+	// Files serves stored files under /files when set, behind FileURLSigner.
+	Files http.Handler
+
+	// FileURLSigner verifies the signed URLs of stored files. Files are not
+	// served without it.
+	FileURLSigner *signedurl.Signer
+
+	// AdminUserIDs are the IDs of the users allowed to call the admin
+	// endpoints.
+	AdminUserIDs []string
+
+	// Deprecations maps path prefixes, such as "/v1", to their deprecation,
+	// announced in the headers of their responses. The longest matching
+	// prefix wins.
+	Deprecations map[string]Deprecation
+
+	// ErrorReporter, when set, receives the panics recovered while serving
+	// requests.
+	ErrorReporter ports.ErrorReporter
 }
 
 // DefaultRouterConfig returns sensible defaults for the router.
@@ -42,21 +73,47 @@ func DefaultRouterConfig() RouterConfig {
 		EnableSwagger:   true,
 		EnableProfiling: false,
 		RequestTimeout:  60 * time.Second,
-		MaxRequestSize:  10 * 1024 * 1024, // 10MB
-		AllowedOrigins:  []string{"*"},
-		BaseURL:         "http://localhost:8080",
+		MaxRequestSize:  1024 * 1024, // 1MB
+		RequestSizeLimits: map[string]int64{
+			"/v1/import":             10 * 1024 * 1024, // 10MB
+			"/v2/import":             10 * 1024 * 1024,
+			"/v1/admin/users/import": 50 * 1024 * 1024, // 50MB, user archives
+		},
+		AllowedOrigins: []string{"*"},
+		BaseURL:        "http://localhost:8080",
+		AccessLog:      DefaultAccessLogConfig(),
 	}
 }
 
 // Services holds all service dependencies for the HTTP handlers.
 type Services struct {
-	UserService       *services.UserService
-	ExperienceService *services.ExperienceService
-	BulletService     *services.BulletService
-	SkillService      *services.SkillService
-	ResumeService     *services.ResumeService
-	EducationService  *services.EducationService
-	ProjectService    *services.ProjectService
+	UserService        *services.UserService
+	ExperienceService  *services.ExperienceService
+	BulletService      *services.BulletService
+	SkillService       *services.SkillService
+	ResumeService      *services.ResumeService
+	EducationService   *services.EducationService
+	AcademicService    *services.AcademicService
+	ProjectService     *services.ProjectService
+	ImportService      *services.ImportService
+	QuotaService       *services.QuotaService
+	PreferencesService *services.PreferencesService
+	TimelineService    *services.TimelineService
+	CritiqueService    *services.CritiqueService
+	FeedbackService    *services.FeedbackService
+	ActivityService    *services.ActivityService
+	ResumeTagService   *services.ResumeTagService
+	SavedFilterService *services.SavedFilterService
+	SuggestService     *services.SuggestService
+	StorageJanitor     *services.StorageJanitorService
+
+	// ImpersonationService, when set, accepts the impersonation tokens of
+	// admins in the auth middleware.
+	ImpersonationService *services.ImpersonationService
+
+	UserBackupService *services.UserBackupService
+
+	ProfileBundleService *services.ProfileBundleService
 }
 
 // Router wraps the Chi router and handlers.
@@ -69,16 +126,30 @@ type Router struct {
 	authMiddleware *authMiddleware
 
 	// Handlers
-	authHandler       *AuthHandler
-	userHandler       *UserHandler
-	experienceHandler *ExperienceHandler
-	bulletHandler     *BulletHandler
-	skillHandler      *SkillHandler
-	languageHandler   *SpokenLanguageHandler
-	resumeHandler     *ResumeHandler
-	toolsHandler      *ToolsHandler
-	educationHandler  *EducationHandler
-	projectHandler    *ProjectHandler
+	authHandler        *AuthHandler
+	userHandler        *UserHandler
+	experienceHandler  *ExperienceHandler
+	bulletHandler      *BulletHandler
+	skillHandler       *SkillHandler
+	languageHandler    *SpokenLanguageHandler
+	resumeHandler      *ResumeHandler
+	toolsHandler       *ToolsHandler
+	educationHandler   *EducationHandler
+	academicHandler    *AcademicHandler
+	projectHandler     *ProjectHandler
+	importHandler      *ImportHandler
+	quotaHandler       *QuotaHandler
+	preferencesHandler *PreferencesHandler
+	bundleHandler      *ProfileBundleHandler
+	timelineHandler    *TimelineHandler
+	critiqueHandler    *CritiqueHandler
+	feedbackHandler    *FeedbackHandler
+	activityHandler    *ActivityHandler
+	resumeTagHandler   *ResumeTagHandler
+	savedFilterHandler *SavedFilterHandler
+	suggestHandler     *SuggestHandler
+	insightsHandler    *InsightsHandler
+	adminHandler       *AdminHandler
 }
 
 // NewRouter creates a new HTTP router with the given configuration and services.
@@ -104,17 +175,23 @@ func (r *Router) setupMiddleware() {
 	// Real IP extraction (for proxied requests)
 	r.mux.Use(middleware.RealIP)
 
-	// Structured logging with zerolog
-	r.mux.Use(ZerologLogger)
+	// Structured access logging with zerolog
+	r.mux.Use(AccessLogger(r.config.AccessLog))
 
-	// Panic recovery with pretty stack traces
-	r.mux.Use(middleware.Recoverer)
+	// RFC 7807 problem details for clients accepting them
+	r.mux.Use(ProblemDetails)
+
+	// Panic recovery with logged and reported stack traces
+	r.mux.Use(Recoverer(r.config.ErrorReporter))
 
 	// Request timeout
 	r.mux.Use(middleware.Timeout(r.config.RequestTimeout))
 
-	// Request size limit
-	r.mux.Use(middleware.RequestSize(r.config.MaxRequestSize))
+	// Request size limits: small for JSON, large for uploads
+	r.mux.Use(BodyLimit(r.config.MaxRequestSize, r.config.RequestSizeLimits))
+
+	// Deprecation and Sunset headers on deprecated API versions
+	r.mux.Use(Deprecations(r.config.Deprecations))
 
 	// Strip trailing slashes
 	r.mux.Use(middleware.StripSlashes)
@@ -140,7 +217,21 @@ func (r *Router) setupHandlers() {
 	r.resumeHandler = NewResumeHandler(r.services.ResumeService)
 	r.toolsHandler = NewToolsHandler(r.services.ResumeService) // Tools use ResumeService for job parsing
 	r.educationHandler = NewEducationHandler(r.services.EducationService)
+	r.academicHandler = NewAcademicHandler(r.services.AcademicService)
 	r.projectHandler = NewProjectHandler(r.services.ProjectService)
+	r.importHandler = NewImportHandler(r.services.ImportService)
+	r.quotaHandler = NewQuotaHandler(r.services.QuotaService)
+	r.preferencesHandler = NewPreferencesHandler(r.services.PreferencesService)
+	r.bundleHandler = NewProfileBundleHandler(r.services.ProfileBundleService)
+	r.timelineHandler = NewTimelineHandler(r.services.TimelineService)
+	r.critiqueHandler = NewCritiqueHandler(r.services.CritiqueService)
+	r.feedbackHandler = NewFeedbackHandler(r.services.FeedbackService)
+	r.activityHandler = NewActivityHandler(r.services.ActivityService)
+	r.resumeTagHandler = NewResumeTagHandler(r.services.ResumeTagService)
+	r.savedFilterHandler = NewSavedFilterHandler(r.services.SavedFilterService)
+	r.suggestHandler = NewSuggestHandler(r.services.SuggestService)
+	r.insightsHandler = NewInsightsHandler(r.services.ResumeService)
+	r.adminHandler = NewAdminHandler(r.services.StorageJanitor, r.services.ImpersonationService, r.services.UserBackupService)
 }
 
 // setupRoutes configures all API routes.
@@ -168,119 +259,233 @@ func (r *Router) setupRoutes() {
 		r.mux.Mount("/debug", middleware.Profiler())
 	}
 
-	// API v1 routes
+	// Stored files (unauthenticated, signed URLs)
+	if r.config.Files != nil && r.config.FileURLSigner != nil {
+		r.mux.With(SignedURL(r.config.FileURLSigner, "/files/")).
+			Handle("/files/*", http.StripPrefix("/files", r.config.Files))
+	}
+
+	// API routes: v1 is frozen, breaking changes ship in v2
 	r.mux.Route("/v1", func(v1 chi.Router) {
-		// Authentication routes (some unauthenticated)
-		v1.Route("/auth", func(auth chi.Router) {
-			auth.Post("/sync", r.authHandler.SyncUser)
-		})
+		r.apiRoutes(v1, r.v1Handlers())
+	})
+	r.mux.Route("/v2", func(v2 chi.Router) {
+		v2.Use(Enveloped)
+		r.apiRoutes(v2, r.v2Handlers())
+	})
+
+	// Not found handler
+	r.mux.NotFound(r.notFoundHandler)
 
-		// Protected routes (require authentication)
-		v1.Group(func(protected chi.Router) {
-			protected.Use(r.AuthMiddleware)
+	// Method not allowed handler
+	r.mux.MethodNotAllowed(r.methodNotAllowedHandler)
+}
 
-			// User profile
-			protected.Get("/me", r.userHandler.GetMe)
-			protected.Patch("/me", r.userHandler.UpdateMe)
+// apiRoutes configures the routes of an API version, with the handlers of
+// the routes whose contract differs between versions.
+func (r *Router) apiRoutes(api chi.Router, h apiHandlers) {
+	// Authentication routes (some unauthenticated)
+	api.Route("/auth", func(auth chi.Router) {
+		auth.Post("/sync", r.authHandler.SyncUser)
+	})
 
-			// Experiences
-			protected.Route("/experiences", func(exp chi.Router) {
-				exp.Get("/", r.experienceHandler.List)
-				exp.Post("/", r.experienceHandler.Create)
+	// Profile pictures (unauthenticated, used in img tags)
+	api.Get("/users/{userID}/avatar", r.userHandler.GetAvatar)
+
+	// Protected routes (require authentication)
+	api.Group(func(protected chi.Router) {
+		protected.Use(r.AuthMiddleware)
+
+		// User profile
+		protected.Get("/me", r.userHandler.GetMe)
+		protected.Patch("/me", r.userHandler.UpdateMe)
+		protected.Get("/users/me/limits", r.quotaHandler.GetMyLimits)
+		protected.Get("/users/me/preferences", r.preferencesHandler.GetPreferences)
+		protected.Patch("/users/me/preferences", r.preferencesHandler.UpdatePreferences)
+		protected.Get("/users/me/profile-bundle", r.bundleHandler.GetBundle)
+		protected.Put("/users/me/profile-bundle", r.bundleHandler.ReplaceBundle)
+		protected.Get("/users/me/sessions", r.userHandler.GetSessions)
+		protected.Post("/users/me/sessions/revoke", r.userHandler.RevokeSessions)
+		protected.Get("/users/me/identities", r.userHandler.ListIdentities)
+		protected.Post("/users/me/identities", r.userHandler.LinkIdentity)
+		protected.Delete("/users/me/identities/{identityID}", r.userHandler.UnlinkIdentity)
+
+		// Experiences
+		protected.Route("/experiences", func(exp chi.Router) {
+			exp.Get("/", h.listExperiences)
+			exp.Post("/", h.createExperience)
+			exp.Get("/timeline", r.timelineHandler.GetTimeline)
+
+			exp.Route("/{experienceID}", func(expByID chi.Router) {
+				expByID.Get("/", h.getExperience)
+				expByID.Put("/", h.updateExperience)
+				expByID.Delete("/", r.experienceHandler.Delete)
+
+				// Bullets under experience
+				expByID.Post("/bullets", r.bulletHandler.Create)
+			})
+		})
 
-				exp.Route("/{experienceID}", func(expByID chi.Router) {
-					expByID.Get("/", r.experienceHandler.Get)
-					expByID.Put("/", r.experienceHandler.Update)
-					expByID.Delete("/", r.experienceHandler.Delete)
+		// Bullets (direct access)
+		protected.Route("/bullets", func(bullet chi.Router) {
+			bullet.Get("/search", r.bulletHandler.Search)
 
-					// Bullets under experience
-					expByID.Post("/bullets", r.bulletHandler.Create)
-				})
+			bullet.Route("/{bulletID}", func(bulletByID chi.Router) {
+				bulletByID.Put("/", r.bulletHandler.Update)
+				bulletByID.Delete("/", r.bulletHandler.Delete)
+				bulletByID.Post("/score", r.bulletHandler.RecalculateScore)
 			})
+		})
+
+		// Skills
+		protected.Route("/skills", func(skill chi.Router) {
+			skill.Get("/", r.skillHandler.List)
+			skill.Post("/batch", r.skillHandler.BatchUpsert)
 
-			// Bullets (direct access)
-			protected.Route("/bullets", func(bullet chi.Router) {
-				bullet.Route("/{bulletID}", func(bulletByID chi.Router) {
-					bulletByID.Put("/", r.bulletHandler.Update)
-					bulletByID.Delete("/", r.bulletHandler.Delete)
-					bulletByID.Post("/score", r.bulletHandler.RecalculateScore)
-				})
+			skill.Route("/{skillID}", func(skillByID chi.Router) {
+				skillByID.Delete("/", r.skillHandler.Delete)
 			})
+		})
 
-			// Skills
-			protected.Route("/skills", func(skill chi.Router) {
-				skill.Get("/", r.skillHandler.List)
-				skill.Post("/batch", r.skillHandler.BatchUpsert)
+		// Spoken Languages
+		protected.Route("/languages", func(lang chi.Router) {
+			lang.Get("/", r.languageHandler.List)
+			lang.Post("/", r.languageHandler.Create)
 
-				skill.Route("/{skillID}", func(skillByID chi.Router) {
-					skillByID.Delete("/", r.skillHandler.Delete)
-				})
+			lang.Route("/{languageID}", func(langByID chi.Router) {
+				langByID.Delete("/", r.languageHandler.Delete)
 			})
+		})
 
-			// Spoken Languages
-			protected.Route("/languages", func(lang chi.Router) {
-				lang.Get("/", r.languageHandler.List)
-				lang.Post("/", r.languageHandler.Create)
+		// Education
+		protected.Route("/education", func(edu chi.Router) {
+			edu.Get("/", r.educationHandler.List)
+			edu.Post("/", r.educationHandler.Create)
 
-				lang.Route("/{languageID}", func(langByID chi.Router) {
-					langByID.Delete("/", r.languageHandler.Delete)
-				})
+			edu.Route("/{educationID}", func(eduByID chi.Router) {
+				eduByID.Get("/", r.educationHandler.Get)
+				eduByID.Put("/", r.educationHandler.Update)
+				eduByID.Delete("/", r.educationHandler.Delete)
 			})
+		})
 
-			// Education
-			protected.Route("/education", func(edu chi.Router) {
-				edu.Get("/", r.educationHandler.List)
-				edu.Post("/", r.educationHandler.Create)
+		// Academic CV entries
+		protected.Route("/academic", func(acad chi.Router) {
+			acad.Get("/", r.academicHandler.List)
+			acad.Post("/", r.academicHandler.Create)
 
-				edu.Route("/{educationID}", func(eduByID chi.Router) {
-					eduByID.Get("/", r.educationHandler.Get)
-					eduByID.Put("/", r.educationHandler.Update)
-					eduByID.Delete("/", r.educationHandler.Delete)
-				})
+			acad.Route("/{entryID}", func(acadByID chi.Router) {
+				acadByID.Get("/", r.academicHandler.Get)
+				acadByID.Put("/", r.academicHandler.Update)
+				acadByID.Delete("/", r.academicHandler.Delete)
 			})
+		})
 
-			// Projects
-			protected.Route("/projects", func(proj chi.Router) {
-				proj.Get("/", r.projectHandler.List)
-				proj.Post("/", r.projectHandler.Create)
+		// Projects
+		protected.Route("/projects", func(proj chi.Router) {
+			proj.Get("/", r.projectHandler.List)
+			proj.Post("/", r.projectHandler.Create)
 
-				proj.Route("/{projectID}", func(projByID chi.Router) {
-					projByID.Get("/", r.projectHandler.Get)
-					projByID.Put("/", r.projectHandler.Update)
-					projByID.Delete("/", r.projectHandler.Delete)
+			proj.Route("/{projectID}", func(projByID chi.Router) {
+				projByID.Get("/", r.projectHandler.Get)
+				projByID.Put("/", r.projectHandler.Update)
+				projByID.Delete("/", r.projectHandler.Delete)
 
-					// Project bullets
-					projByID.Post("/bullets", r.projectHandler.AddBullet)
-					projByID.Delete("/bullets/{bulletID}", r.projectHandler.DeleteBullet)
-				})
+				// Project bullets
+				projByID.Post("/bullets", r.projectHandler.AddBullet)
+				projByID.Delete("/bullets/{bulletID}", r.projectHandler.DeleteBullet)
 			})
+		})
 
-			// Resumes
-			protected.Route("/resumes", func(resume chi.Router) {
-				resume.Get("/", r.resumeHandler.List)
-				resume.Post("/", r.resumeHandler.Create)
-
-				resume.Route("/{resumeID}", func(resumeByID chi.Router) {
-					resumeByID.Get("/", r.resumeHandler.Get)
-					resumeByID.Delete("/", r.resumeHandler.Delete)
-					resumeByID.Post("/tailor", r.resumeHandler.Tailor)
-					resumeByID.Patch("/content", r.resumeHandler.UpdateStatus)
-					resumeByID.Get("/pdf", r.resumeHandler.GeneratePDF)
-				})
+		// Resumes
+		protected.Route("/resumes", func(resume chi.Router) {
+			resume.Get("/", r.resumeHandler.List)
+			resume.Post("/", r.resumeHandler.Create)
+			resume.Post("/bulk", r.resumeHandler.Bulk)
+
+			resume.Route("/{resumeID}", func(resumeByID chi.Router) {
+				resumeByID.Get("/", r.resumeHandler.Get)
+				resumeByID.Delete("/", r.resumeHandler.Delete)
+				resumeByID.Post("/archive", r.resumeHandler.Archive)
+				resumeByID.Post("/unarchive", r.resumeHandler.Unarchive)
+				resumeByID.Post("/pin", r.resumeHandler.Pin)
+				resumeByID.Post("/unpin", r.resumeHandler.Unpin)
+				resumeByID.Post("/tailor", r.resumeHandler.Tailor)
+				resumeByID.Get("/tailor/estimate", r.resumeHandler.TailorEstimate)
+				resumeByID.Patch("/content", r.resumeHandler.UpdateStatus)
+				resumeByID.Get("/pdf", r.resumeHandler.GeneratePDF)
+				resumeByID.Get("/html", r.resumeHandler.ExportHTML)
+				resumeByID.Get("/export", r.resumeHandler.Export)
+				resumeByID.Post("/preview-html", r.resumeHandler.PreviewHTML)
+				resumeByID.Post("/critique", r.critiqueHandler.Create)
+				resumeByID.Get("/critiques", r.critiqueHandler.List)
+				resumeByID.Post("/feedback", r.feedbackHandler.Submit)
+				resumeByID.Post("/bullets/confirm", r.resumeHandler.ConfirmBullets)
+				resumeByID.Get("/summaries", r.resumeHandler.ListSummaries)
+				resumeByID.Post("/summaries/select", r.resumeHandler.SelectSummary)
+				resumeByID.Get("/diff", r.resumeHandler.Diff)
+				resumeByID.Get("/fit-check", r.resumeHandler.FitCheck)
+				resumeByID.Post("/fit", r.resumeHandler.Fit)
+				resumeByID.Post("/regenerate", r.resumeHandler.Regenerate)
+				resumeByID.Post("/retailor", r.resumeHandler.Retailor)
+				resumeByID.Get("/activity", r.activityHandler.List)
 			})
+		})
 
-			// Tools
-			protected.Route("/tools", func(tools chi.Router) {
-				tools.Post("/parse-job", r.toolsHandler.ParseJobURL)
+		// Resume tags
+		protected.Route("/resume-tags", func(tag chi.Router) {
+			tag.Get("/", r.resumeTagHandler.List)
+			tag.Post("/", r.resumeTagHandler.Create)
+
+			tag.Route("/{tagID}", func(tagByID chi.Router) {
+				tagByID.Patch("/", r.resumeTagHandler.Update)
+				tagByID.Delete("/", r.resumeTagHandler.Delete)
+				tagByID.Post("/resumes", r.resumeTagHandler.Assign)
 			})
 		})
-	})
 
-	// Not found handler
-	r.mux.NotFound(r.notFoundHandler)
+		// Saved resume filters
+		protected.Route("/resume-filters", func(filter chi.Router) {
+			filter.Get("/", r.savedFilterHandler.List)
+			filter.Post("/", r.savedFilterHandler.Create)
 
-	// Method not allowed handler
-	r.mux.MethodNotAllowed(r.methodNotAllowedHandler)
+			filter.Route("/{filterID}", func(filterByID chi.Router) {
+				filterByID.Put("/", r.savedFilterHandler.Update)
+				filterByID.Delete("/", r.savedFilterHandler.Delete)
+				filterByID.Get("/resumes", r.savedFilterHandler.Run)
+			})
+		})
+
+		// Type-ahead suggestions
+		protected.Get("/suggest", r.suggestHandler.Suggest)
+
+		// Insights
+		protected.Route("/insights", func(insights chi.Router) {
+			insights.Get("/export.csv", r.insightsHandler.ExportCSV)
+		})
+
+		// Administration
+		protected.Route("/admin", func(admin chi.Router) {
+			admin.Use(AdminOnly(r.config.AdminUserIDs))
+			admin.Get("/storage/orphans", r.adminHandler.ListStorageOrphans)
+			admin.Post("/impersonate/{userID}", r.adminHandler.Impersonate)
+			admin.Get("/users/{userID}/export", r.adminHandler.ExportUser)
+			admin.Post("/users/import", r.adminHandler.ImportUser)
+		})
+
+		// Tools
+		protected.Route("/tools", func(tools chi.Router) {
+			tools.Post("/parse-job", r.toolsHandler.ParseJobURL)
+		})
+
+		// Bulk import
+		protected.Route("/import", func(imp chi.Router) {
+			imp.Post("/csv", r.importHandler.ImportCSV)
+			imp.Post("/uploads", r.importHandler.StartUpload)
+			imp.Put("/uploads/{uploadID}/chunks/{index}", r.importHandler.UploadChunk)
+			imp.Post("/uploads/{uploadID}/complete", r.importHandler.CompleteUpload)
+		})
+	})
 }
 
 // ServeHTTP implements http.Handler.
@@ -111,6 +111,7 @@ func TestDefaultRouterConfig(t *testing.T) {
 
 	assert.True(t, cfg.EnableSwagger)
 	assert.False(t, cfg.EnableProfiling)
-	assert.Equal(t, int64(10*1024*1024), cfg.MaxRequestSize)
+	assert.Equal(t, int64(1024*1024), cfg.MaxRequestSize)
+	assert.Equal(t, int64(10*1024*1024), cfg.RequestSizeLimits["/v1/import"])
 	assert.NotEmpty(t, cfg.AllowedOrigins)
 }
@@ -0,0 +1,318 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// SavedFilterHandler handles saved resume filter HTTP requests.
+type SavedFilterHandler struct {
+	filterService *services.SavedFilterService
+}
+
+// NewSavedFilterHandler creates a new SavedFilterHandler.
+func NewSavedFilterHandler(filterService *services.SavedFilterService) *SavedFilterHandler {
+	return &SavedFilterHandler{
+		filterService: filterService,
+	}
+}
+
+// List returns the authenticated user's saved filters.
+//
+//	@Summary		List saved resume filters
+//	@Description	Returns the user's named resume searches by name.
+//	@Tags			resume-filters
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	ListSavedResumeFiltersResponse
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resume-filters [get]
+func (h *SavedFilterHandler) List(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	filters, err := h.filterService.ListSavedFilters(r.Context(), authUser.ID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to list saved filters")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve saved filters")
+		return
+	}
+
+	resp := ListSavedResumeFiltersResponse{
+		Data: make([]SavedResumeFilterResponse, 0, len(filters)),
+	}
+	for i := range filters {
+		resp.Data = append(resp.Data, mapSavedFilterToResponse(&filters[i]))
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// Create saves a named resume search.
+//
+//	@Summary		Save resume filter
+//	@Description	Saves resume list filters under a name to run them again later. Names are unique per user, ignoring case, and at most 100 characters. The filters are validated as when listing resumes.
+//	@Tags			resume-filters
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		SavedResumeFilterRequest	true	"Filter name and search"
+//	@Success		201		{object}	SavedResumeFilterResponse
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body or status filter"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		409		{object}	ErrorResponse	"Filter name already used"
+//	@Failure		422		{object}	ErrorResponse	"Validation failed"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resume-filters [post]
+func (h *SavedFilterHandler) Create(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req SavedResumeFilterRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	filter, err := h.filterService.CreateSavedFilter(r.Context(), services.SaveFilterRequest{
+		UserID: authUser.ID,
+		Name:   req.Name,
+		Search: mapResumeSearchFromDTO(req.Search),
+	})
+	if err != nil {
+		if h.handleFilterError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to create saved filter")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to create saved filter")
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, mapSavedFilterToResponse(filter))
+}
+
+// Update replaces a saved filter.
+//
+//	@Summary		Replace saved resume filter
+//	@Description	Replaces the name and search of a saved filter.
+//	@Tags			resume-filters
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			filterID	path		string						true	"Saved filter ID"
+//	@Param			request		body		SavedResumeFilterRequest	true	"Filter name and search"
+//	@Success		200			{object}	SavedResumeFilterResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid request body or status filter"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Saved filter not found"
+//	@Failure		409			{object}	ErrorResponse	"Filter name already used"
+//	@Failure		422			{object}	ErrorResponse	"Validation failed"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resume-filters/{filterID} [put]
+func (h *SavedFilterHandler) Update(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	filterID := chi.URLParam(r, "filterID")
+	if filterID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Filter ID is required")
+		return
+	}
+
+	var req SavedResumeFilterRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+
+	filter, err := h.filterService.ReplaceSavedFilter(r.Context(), filterID, services.SaveFilterRequest{
+		UserID: authUser.ID,
+		Name:   req.Name,
+		Search: mapResumeSearchFromDTO(req.Search),
+	})
+	if err != nil {
+		if h.handleFilterError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("filter_id", filterID).Msg("Failed to update saved filter")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to update saved filter")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapSavedFilterToResponse(filter))
+}
+
+// Delete deletes a saved filter.
+//
+//	@Summary		Delete saved resume filter
+//	@Description	Deletes a saved filter. The resumes it matched are kept.
+//	@Tags			resume-filters
+//	@Security		BearerAuth
+//	@Param			filterID	path	string	true	"Saved filter ID"
+//	@Success		204			"No Content"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Saved filter not found"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resume-filters/{filterID} [delete]
+func (h *SavedFilterHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	filterID := chi.URLParam(r, "filterID")
+	if filterID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Filter ID is required")
+		return
+	}
+
+	if err := h.filterService.DeleteSavedFilter(r.Context(), filterID, authUser.ID); err != nil {
+		if h.handleFilterError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("filter_id", filterID).Msg("Failed to delete saved filter")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to delete saved filter")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Run lists the resumes matching a saved filter.
+//
+//	@Summary		Run saved resume filter
+//	@Description	Returns a paginated list of the resumes matching a saved filter, like GET /v1/resumes with the saved filters.
+//	@Tags			resume-filters
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			filterID	path		string	true	"Saved filter ID"
+//	@Param			view		query		string	false	"summary leaves out the job description, generated content and selection"	Enums(full, summary)	default(full)
+//	@Param			limit		query		int		false	"Pagination limit"	default(20)
+//	@Param			offset		query		int		false	"Pagination offset"	default(0)
+//	@Success		200			{object}	ListResumesResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid view"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Saved filter not found"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/resume-filters/{filterID}/resumes [get]
+func (h *SavedFilterHandler) Run(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	filterID := chi.URLParam(r, "filterID")
+	if filterID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Filter ID is required")
+		return
+	}
+	summary, err := parseResumeView(r.URL.Query())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", err.Error())
+		return
+	}
+
+	runReq := services.RunSavedFilterRequest{
+		FilterID: filterID,
+		UserID:   authUser.ID,
+		Limit:    parseIntParam(r, "limit", 20),
+		Offset:   parseIntParam(r, "offset", 0),
+		Summary:  summary,
+	}
+
+	result, err := h.filterService.RunSavedFilter(r.Context(), runReq)
+	if err != nil {
+		if h.handleFilterError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("filter_id", filterID).Msg("Failed to run saved filter")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve resumes")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, newListResumesResponse(result, services.ListResumesRequest{
+		Limit:  runReq.Limit,
+		Offset: runReq.Offset,
+	}))
+}
+
+// handleFilterError responds to the not found, conflict and invalid filter
+// errors of saved filter operations, and reports whether it did.
+func (h *SavedFilterHandler) handleFilterError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, domain.ErrSavedFilterNotFound):
+		respondError(w, http.StatusNotFound, "SAVED_FILTER_NOT_FOUND", "Saved filter not found")
+		return true
+	case errors.Is(err, domain.ErrSavedFilterAlreadyExists):
+		respondError(w, http.StatusConflict, "SAVED_FILTER_EXISTS", "A saved filter with this name already exists")
+		return true
+	default:
+		return handleResumeListError(w, err)
+	}
+}
+
+// mapResumeSearchFromDTO maps a ResumeSearchDTO to a domain ResumeSearch.
+func mapResumeSearchFromDTO(dto ResumeSearchDTO) domain.ResumeSearch {
+	return domain.ResumeSearch{
+		Status:          dto.Status,
+		RemotePolicies:  dto.Remote,
+		MinSalary:       dto.MinSalary,
+		SalaryCurrency:  dto.Currency,
+		VisaSponsorship: dto.VisaSponsorship,
+		TagIDs:          dto.Tag,
+		MinScore:        dto.ScoreMin,
+		Company:         dto.Company,
+		CreatedAfter:    dto.CreatedAfter,
+		CreatedBefore:   dto.CreatedBefore,
+		Language:        dto.Language,
+		Archived:        dto.Archived,
+		IncludeArchived: dto.IncludeArchived,
+		Sort:            dto.Sort,
+	}
+}
+
+// mapSavedFilterToResponse maps a domain SavedResumeFilter to a
+// SavedResumeFilterResponse.
+func mapSavedFilterToResponse(filter *domain.SavedResumeFilter) SavedResumeFilterResponse {
+	search := filter.Search
+	return SavedResumeFilterResponse{
+		ID:   filter.ID,
+		Name: filter.Name,
+		Search: ResumeSearchDTO{
+			Status:          search.Status,
+			Remote:          search.RemotePolicies,
+			MinSalary:       search.MinSalary,
+			Currency:        search.SalaryCurrency,
+			VisaSponsorship: search.VisaSponsorship,
+			Tag:             search.TagIDs,
+			ScoreMin:        search.MinScore,
+			Company:         search.Company,
+			CreatedAfter:    search.CreatedAfter,
+			CreatedBefore:   search.CreatedBefore,
+			Language:        search.Language,
+			Archived:        search.Archived,
+			IncludeArchived: search.IncludeArchived,
+			Sort:            search.Sort,
+		},
+		CreatedAt: filter.CreatedAt,
+		UpdatedAt: filter.UpdatedAt,
+	}
+}
@@ -93,7 +93,7 @@ func (h *SkillHandler) BatchUpsert(w http.ResponseWriter, r *http.Request) {
 
 	var req BatchUpsertSkillsRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
@@ -177,7 +177,7 @@ func (h *SkillHandler) BatchUpsert(w http.ResponseWriter, r *http.Request) {
 //	@Failure		500		{object}	ErrorResponse	"Internal server error"
 //	@Router			/v1/skills/{skillID} [delete]
 func (h *SkillHandler) Delete(w http.ResponseWriter, r *http.Request) {
-	_, ok := GetAuthenticatedUser(r.Context())
+	authUser, ok := GetAuthenticatedUser(r.Context())
 	if !ok {
 		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
 		return
@@ -189,7 +189,7 @@ func (h *SkillHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.skillService.DeleteSkill(r.Context(), skillID); err != nil {
+	if err := h.skillService.DeleteSkill(r.Context(), skillID, authUser.ID); err != nil {
 		if errors.Is(err, domain.ErrSkillNotFound) {
 			respondError(w, http.StatusNotFound, "SKILL_NOT_FOUND", "Skill not found")
 			return
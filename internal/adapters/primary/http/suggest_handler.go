@@ -0,0 +1,70 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// SuggestHandler handles type-ahead suggestion HTTP requests.
+type SuggestHandler struct {
+	suggestService *services.SuggestService
+}
+
+// NewSuggestHandler creates a new SuggestHandler.
+func NewSuggestHandler(suggestService *services.SuggestService) *SuggestHandler {
+	return &SuggestHandler{
+		suggestService: suggestService,
+	}
+}
+
+// Suggest returns type-ahead suggestions from the user's own data.
+//
+//	@Summary		Suggest completions
+//	@Description	Returns the companies and job titles of the user's resumes and experiences, and the user's skills and resume tags, that contain q, ignoring case. Suggestions are grouped by type (company, job_title, skill, tag); within a type, values starting with q come first, then the most similar. Skills and tags include their ID. A blank q returns no suggestions.
+//	@Tags			suggest
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			q		query		string	true	"Text typed so far (at most 100 characters)"
+//	@Param			limit	query		int		false	"Maximum suggestions per type (1-20)"	default(5)
+//	@Success		200		{object}	SuggestResponse
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		422		{object}	ErrorResponse	"Validation failed"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/suggest [get]
+func (h *SuggestHandler) Suggest(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	suggestions, err := h.suggestService.Suggest(r.Context(), services.SuggestRequest{
+		UserID: authUser.ID,
+		Query:  r.URL.Query().Get("q"),
+		Limit:  parseIntParam(r, "limit", services.DefaultSuggestionsPerType),
+	})
+	if err != nil {
+		if handleValidationError(w, err) {
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to look up suggestions")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve suggestions")
+		return
+	}
+
+	resp := SuggestResponse{
+		Data: make([]SuggestionResponse, 0, len(suggestions)),
+	}
+	for _, suggestion := range suggestions {
+		resp.Data = append(resp.Data, SuggestionResponse{
+			Type:  string(suggestion.Type),
+			Value: suggestion.Value,
+			ID:    suggestion.ID,
+		})
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
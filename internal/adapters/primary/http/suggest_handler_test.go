@@ -0,0 +1,79 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http/mocks"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestSuggestHandlerSuggest(t *testing.T) {
+	suggestionRepo := mocks.NewInMemorySuggestionRepository()
+	suggestionRepo.Seed("user-123",
+		domain.Suggestion{Type: domain.SuggestionCompany, Value: "Acme"},
+		domain.Suggestion{Type: domain.SuggestionJobTitle, Value: "Backend Engineer"},
+		domain.Suggestion{Type: domain.SuggestionSkill, Value: "Go", ID: "skill-1"},
+		domain.Suggestion{Type: domain.SuggestionSkill, Value: "Google Cloud", ID: "skill-2"},
+		domain.Suggestion{Type: domain.SuggestionTag, Value: "golang", ID: "tag-1"},
+	)
+	suggestionRepo.Seed("user-456", domain.Suggestion{Type: domain.SuggestionSkill, Value: "Gopher", ID: "skill-9"})
+	handler := NewSuggestHandler(services.NewSuggestService(suggestionRepo))
+
+	suggest := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newJSONRequest(t, http.MethodGet, "/v1/suggest?"+query, nil)
+		req = req.WithContext(setupTestContext("user-123", "firebase-123", "test@example.com"))
+		return executeRequest(t, req, handler.Suggest)
+	}
+
+	t.Run("success - returns the user's matching values", func(t *testing.T) {
+		rr := suggest(t, "q=GO")
+		assertStatusCode(t, http.StatusOK, rr)
+
+		var resp SuggestResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, []SuggestionResponse{
+			{Type: "skill", Value: "Go", ID: "skill-1"},
+			{Type: "skill", Value: "Google Cloud", ID: "skill-2"},
+			{Type: "tag", Value: "golang", ID: "tag-1"},
+		}, resp.Data)
+	})
+
+	t.Run("success - limit caps each type", func(t *testing.T) {
+		rr := suggest(t, "q=go&limit=1")
+		assertStatusCode(t, http.StatusOK, rr)
+
+		var resp SuggestResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, []SuggestionResponse{
+			{Type: "skill", Value: "Go", ID: "skill-1"},
+			{Type: "tag", Value: "golang", ID: "tag-1"},
+		}, resp.Data)
+	})
+
+	t.Run("success - blank query has no suggestions", func(t *testing.T) {
+		rr := suggest(t, "q=%20%20")
+		assertStatusCode(t, http.StatusOK, rr)
+		assert.JSONEq(t, `{"data":[]}`, rr.Body.String())
+	})
+
+	t.Run("error - limit out of range", func(t *testing.T) {
+		assertErrorResponse(t, suggest(t, "q=go&limit=21"), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+		assertErrorResponse(t, suggest(t, "q=go&limit=-1"), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - query too long", func(t *testing.T) {
+		assertErrorResponse(t, suggest(t, "q="+strings.Repeat("a", 101)), http.StatusUnprocessableEntity, "VALIDATION_ERROR")
+	})
+
+	t.Run("error - not authenticated", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/suggest?q=go", nil)
+		assertErrorResponse(t, executeRequest(t, req, handler.Suggest), http.StatusUnauthorized, "UNAUTHORIZED")
+	})
+}
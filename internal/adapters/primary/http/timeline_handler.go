@@ -0,0 +1,114 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// maxGapThresholdMonths bounds the gap_months query parameter.
+const maxGapThresholdMonths = 24
+
+// TimelineHandler handles experience timeline HTTP requests.
+type TimelineHandler struct {
+	timelineService *services.TimelineService
+}
+
+// NewTimelineHandler creates a new TimelineHandler.
+func NewTimelineHandler(timelineService *services.TimelineService) *TimelineHandler {
+	return &TimelineHandler{
+		timelineService: timelineService,
+	}
+}
+
+// GetTimeline returns the authenticated user's experience timeline.
+//
+//	@Summary		Get experience timeline
+//	@Description	Returns all experiences normalized to months and sorted by start date, with gaps longer than gap_months between activities (including an ongoing gap up to the current month) and full-time roles that overlap by more than one month. Awards, publications, certifications, hackathons and events neither fill gaps nor overlap. Work experiences with metadata.employment_type set to part_time are not counted as full-time. Each finding comes with a suggestion.
+//	@Tags			experiences
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			gap_months	query		int	false	"Flag gaps longer than this many months (1-24)"	default(3)
+//	@Success		200			{object}	TimelineResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid gap_months"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/experiences/timeline [get]
+func (h *TimelineHandler) GetTimeline(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	gapMonths := parseIntParam(r, "gap_months", domain.DefaultGapThresholdMonths)
+	if gapMonths < 1 || gapMonths > maxGapThresholdMonths {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "gap_months must be between 1 and 24")
+		return
+	}
+
+	timeline, err := h.timelineService.GetTimeline(r.Context(), services.GetTimelineRequest{
+		UserID:             authUser.ID,
+		GapThresholdMonths: gapMonths,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to build timeline")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to build timeline")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, mapTimelineToResponse(timeline, gapMonths))
+}
+
+// mapTimelineToResponse maps a domain Timeline to a TimelineResponse.
+func mapTimelineToResponse(timeline *domain.Timeline, gapMonths int) TimelineResponse {
+	resp := TimelineResponse{
+		GapThresholdMonths: gapMonths,
+		Entries:            make([]TimelineEntryResponse, 0, len(timeline.Entries)),
+		Gaps:               make([]TimelineGapResponse, 0, len(timeline.Gaps)),
+		Overlaps:           make([]TimelineOverlapResponse, 0, len(timeline.Overlaps)),
+		Suggestions:        make([]TimelineSuggestionResponse, 0, len(timeline.Suggestions)),
+	}
+
+	for _, e := range timeline.Entries {
+		resp.Entries = append(resp.Entries, TimelineEntryResponse{
+			ExperienceID: e.ExperienceID,
+			Type:         string(e.Type),
+			Title:        e.Title,
+			Organization: e.Organization,
+			Start:        e.Start.String(),
+			End:          e.End.String(),
+			Months:       e.Months(),
+			IsCurrent:    e.IsCurrent,
+			FullTime:     e.FullTime,
+		})
+	}
+	for _, g := range timeline.Gaps {
+		resp.Gaps = append(resp.Gaps, TimelineGapResponse{
+			Start:   g.Start.String(),
+			End:     g.End.String(),
+			Months:  g.Months,
+			Ongoing: g.Ongoing,
+		})
+	}
+	for _, o := range timeline.Overlaps {
+		resp.Overlaps = append(resp.Overlaps, TimelineOverlapResponse{
+			ExperienceIDs: o.ExperienceIDs[:],
+			Start:         o.Start.String(),
+			End:           o.End.String(),
+			Months:        o.Months,
+		})
+	}
+	for _, s := range timeline.Suggestions {
+		resp.Suggestions = append(resp.Suggestions, TimelineSuggestionResponse{
+			Kind:          string(s.Kind),
+			Message:       s.Message,
+			ExperienceIDs: s.ExperienceIDs,
+		})
+	}
+
+	return resp
+}
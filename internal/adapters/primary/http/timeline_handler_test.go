@@ -0,0 +1,69 @@
+package http
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http/mocks"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestTimelineHandlerGetTimeline(t *testing.T) {
+	expRepo := mocks.NewInMemoryExperienceRepository()
+
+	first := createTestExperience("exp-1", "user-123")
+	end := domain.NewMonthDate(2020, 12)
+	first.EndDate = &end
+	expRepo.Seed(first)
+
+	second := createTestExperience("exp-2", "user-123")
+	second.StartDate = domain.NewMonthDate(2022, 1)
+	second.IsCurrent = true
+	expRepo.Seed(second)
+
+	expRepo.Seed(createTestExperience("exp-other", "other-user"))
+
+	handler := NewTimelineHandler(services.NewTimelineService(expRepo))
+	ctx := setupTestContext("user-123", "firebase-123", "test@example.com")
+
+	t.Run("success - returns entries, gaps and suggestions", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/experiences/timeline", nil)
+		req = req.WithContext(ctx)
+
+		rr := executeRequest(t, req, handler.GetTimeline)
+		assertStatusCode(t, http.StatusOK, rr)
+
+		var resp TimelineResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, 3, resp.GapThresholdMonths)
+		require.Len(t, resp.Entries, 2)
+		assert.Equal(t, "exp-1", resp.Entries[0].ExperienceID)
+		assert.Equal(t, "2020-01", resp.Entries[0].Start)
+		assert.Equal(t, "2020-12", resp.Entries[0].End)
+
+		require.Len(t, resp.Gaps, 1)
+		assert.Equal(t, TimelineGapResponse{Start: "2021-01", End: "2021-12", Months: 12}, resp.Gaps[0])
+		require.Len(t, resp.Suggestions, 1)
+		assert.Equal(t, "explain_gap", resp.Suggestions[0].Kind)
+		assert.Empty(t, resp.Overlaps)
+	})
+
+	t.Run("error - invalid gap_months", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/experiences/timeline?gap_months=0", nil)
+		req = req.WithContext(ctx)
+
+		rr := executeRequest(t, req, handler.GetTimeline)
+		assertErrorResponse(t, rr, http.StatusBadRequest, "INVALID_REQUEST")
+	})
+
+	t.Run("error - user not authenticated", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v1/experiences/timeline", nil)
+
+		rr := executeRequest(t, req, handler.GetTimeline)
+		assertErrorResponse(t, rr, http.StatusUnauthorized, "UNAUTHORIZED")
+	})
+}
@@ -1,11 +1,13 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
 )
 
@@ -31,10 +33,11 @@ func NewToolsHandler(resumeService *services.ResumeService) *ToolsHandler {
 //	@Security		BearerAuth
 //	@Param			request	body		ParseJobURLRequest	true	"Job URL to parse"
 //	@Success		200		{object}	ParseJobURLResponse
-//	@Failure		400		{object}	ErrorResponse	"Invalid request body"
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body or URL not allowed"
 //	@Failure		401		{object}	ErrorResponse	"Unauthorized"
 //	@Failure		422		{object}	ErrorResponse	"Failed to parse job posting"
 //	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Failure		503		{object}	ErrorResponse	"Job parser unavailable"
 //	@Router			/v1/tools/parse-job [post]
 func (h *ToolsHandler) ParseJobURL(w http.ResponseWriter, r *http.Request) {
 	_, ok := GetAuthenticatedUser(r.Context())
@@ -45,7 +48,7 @@ func (h *ToolsHandler) ParseJobURL(w http.ResponseWriter, r *http.Request) {
 
 	var req ParseJobURLRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
@@ -60,6 +63,14 @@ func (h *ToolsHandler) ParseJobURL(w http.ResponseWriter, r *http.Request) {
 
 	result, err := h.resumeService.ParseJobURL(r.Context(), parseReq)
 	if err != nil {
+		if errors.Is(err, domain.ErrURLNotAllowed) {
+			log.Warn().Err(err).Str("url", req.URL).Msg("Rejected job URL")
+			respondError(w, http.StatusBadRequest, "URL_NOT_ALLOWED", "URL is not allowed to be fetched")
+			return
+		}
+		if handleUpstreamError(w, err) {
+			return
+		}
 		log.Error().Err(err).Str("url", req.URL).Msg("Failed to parse job URL")
 		respondError(w, http.StatusUnprocessableEntity, "PARSE_FAILED", "Failed to parse job posting")
 		return
@@ -71,7 +82,7 @@ func (h *ToolsHandler) ParseJobURL(w http.ResponseWriter, r *http.Request) {
 		Markdown: result.Content,
 		Metadata: &ParseJobMetadata{
 			Source:    extractDomain(req.URL),
-			FetchedAt: time.Now(),
+			FetchedAt: time.Now().UTC(),
 		},
 	}
 
@@ -2,8 +2,11 @@ package http
 
 import (
 	"errors"
+	"io"
 	"net/http"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog/log"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
@@ -79,7 +82,7 @@ func (h *UserHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
 
 	var req UpdateUserRequest
 	if err := decodeJSON(r, &req); err != nil {
-		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request body")
+		respondDecodeError(w, err)
 		return
 	}
 
@@ -115,6 +118,271 @@ func (h *UserHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
 	respondJSON(w, http.StatusOK, response)
 }
 
+// GetSessions returns the authenticated user's sign-in sessions.
+//
+//	@Summary		List sign-in sessions
+//	@Description	Returns the session making the request and the latest sign-in activity on other devices
+//	@Tags			user
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	SessionsResponse
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	ErrorResponse	"Internal server error"
+//	@Failure		501	{object}	ErrorResponse	"Not supported by the identity provider"
+//	@Router			/v1/users/me/sessions [get]
+func (h *UserHandler) GetSessions(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	sessions, err := h.userService.GetSessions(r.Context(), authUser.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrSessionsNotSupported) {
+			respondError(w, http.StatusNotImplemented, "SESSIONS_NOT_SUPPORTED", "Session management is not supported by the identity provider")
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to get sessions")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve sessions")
+		return
+	}
+
+	response := SessionsResponse{
+		Providers:     sessions.Account.Providers,
+		LastSignInAt:  optionalTime(sessions.Account.LastSignInAt),
+		LastRefreshAt: optionalTime(sessions.Account.LastRefreshAt),
+		RevokedAt:     sessions.RevokedAt,
+	}
+	if response.Providers == nil {
+		response.Providers = []string{}
+	}
+	if claims, ok := GetAuthClaims(r.Context()); ok {
+		response.Current = CurrentSessionResponse{
+			Provider:  claims.Provider,
+			IssuedAt:  time.Unix(claims.IssuedAt, 0).UTC(),
+			ExpiresAt: time.Unix(claims.ExpiresAt, 0).UTC(),
+		}
+	}
+
+	respondJSON(w, http.StatusOK, response)
+}
+
+// RevokeSessions signs the authenticated user out on every device.
+//
+//	@Summary		Revoke all sessions
+//	@Description	Revokes the user's refresh tokens at the identity provider and rejects every token issued until now, including the one making the request
+//	@Tags			user
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	RevokeSessionsResponse
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	ErrorResponse	"Internal server error"
+//	@Failure		501	{object}	ErrorResponse	"Not supported by the identity provider"
+//	@Router			/v1/users/me/sessions/revoke [post]
+func (h *UserHandler) RevokeSessions(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	revokedAt, err := h.userService.RevokeSessions(r.Context(), authUser.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrSessionsNotSupported) {
+			respondError(w, http.StatusNotImplemented, "SESSIONS_NOT_SUPPORTED", "Session management is not supported by the identity provider")
+			return
+		}
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to revoke sessions")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to revoke sessions")
+		return
+	}
+
+	log.Info().Str("user_id", authUser.ID).Msg("User revoked all sessions")
+	respondJSON(w, http.StatusOK, RevokeSessionsResponse{RevokedAt: revokedAt})
+}
+
+// optionalTime returns a pointer to t, or nil for the zero time.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// ListIdentities returns the sign-in methods linked to the authenticated user.
+//
+//	@Summary		List linked sign-in methods
+//	@Description	Returns the sign-in methods linked to the user besides the one they signed up with, oldest first
+//	@Tags			user
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	ListIdentitiesResponse
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/users/me/identities [get]
+func (h *UserHandler) ListIdentities(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	identities, err := h.userService.ListIdentities(r.Context(), authUser.ID)
+	if err != nil {
+		log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to list identities")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve sign-in methods")
+		return
+	}
+
+	resp := ListIdentitiesResponse{
+		Data: make([]IdentityResponse, 0, len(identities)),
+	}
+	for i := range identities {
+		resp.Data = append(resp.Data, mapIdentityToResponse(&identities[i]))
+	}
+
+	respondJSON(w, http.StatusOK, resp)
+}
+
+// LinkIdentity links a sign-in method to the authenticated user.
+//
+//	@Summary		Link sign-in method
+//	@Description	Links the sign-in method of an ID token, such as GitHub for a user who signed up with Google, so that the user can sign in to the same account with either
+//	@Tags			user
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		LinkIdentityRequest	true	"ID token of the sign-in method"
+//	@Success		201		{object}	IdentityResponse
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		409		{object}	ErrorResponse	"Sign-in method already linked to this or another account"
+//	@Failure		422		{object}	ErrorResponse	"Invalid or expired ID token"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/users/me/identities [post]
+func (h *UserHandler) LinkIdentity(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	var req LinkIdentityRequest
+	if err := decodeJSON(r, &req); err != nil {
+		respondDecodeError(w, err)
+		return
+	}
+	if req.IDToken == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "ID token is required")
+		return
+	}
+
+	identity, err := h.userService.LinkIdentity(r.Context(), authUser.ID, req.IDToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidIdentityToken):
+			respondError(w, http.StatusUnprocessableEntity, "INVALID_TOKEN", "ID token is invalid or expired")
+		case errors.Is(err, domain.ErrIdentityAlreadyLinked):
+			respondError(w, http.StatusConflict, "IDENTITY_ALREADY_LINKED", "Sign-in method is already linked to this account")
+		case errors.Is(err, domain.ErrIdentityInUse):
+			respondError(w, http.StatusConflict, "IDENTITY_IN_USE", "Sign-in method belongs to another account")
+		default:
+			log.Error().Err(err).Str("user_id", authUser.ID).Msg("Failed to link identity")
+			respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to link sign-in method")
+		}
+		return
+	}
+
+	log.Info().Str("user_id", authUser.ID).Str("provider", identity.Provider).Msg("User linked sign-in method")
+	respondJSON(w, http.StatusCreated, mapIdentityToResponse(identity))
+}
+
+// UnlinkIdentity removes a sign-in method linked to the authenticated user.
+//
+//	@Summary		Unlink sign-in method
+//	@Description	Removes a linked sign-in method. The sign-up method cannot be unlinked.
+//	@Tags			user
+//	@Security		BearerAuth
+//	@Param			identityID	path	string	true	"Identity ID"
+//	@Success		204			"No Content"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404			{object}	ErrorResponse	"Identity not found"
+//	@Failure		500			{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/users/me/identities/{identityID} [delete]
+func (h *UserHandler) UnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+	authUser, ok := GetAuthenticatedUser(r.Context())
+	if !ok {
+		respondError(w, http.StatusUnauthorized, "UNAUTHORIZED", "User not authenticated")
+		return
+	}
+
+	identityID := chi.URLParam(r, "identityID")
+	if identityID == "" {
+		respondError(w, http.StatusBadRequest, "INVALID_REQUEST", "Identity ID is required")
+		return
+	}
+
+	if err := h.userService.UnlinkIdentity(r.Context(), authUser.ID, identityID); err != nil {
+		if errors.Is(err, domain.ErrIdentityNotFound) {
+			respondError(w, http.StatusNotFound, "IDENTITY_NOT_FOUND", "Identity not found")
+			return
+		}
+		log.Error().Err(err).Str("identity_id", identityID).Msg("Failed to unlink identity")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to unlink sign-in method")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// mapIdentityToResponse maps a domain UserIdentity to an IdentityResponse.
+func mapIdentityToResponse(identity *domain.UserIdentity) IdentityResponse {
+	return IdentityResponse{
+		ID:             identity.ID,
+		ProviderUserID: identity.ProviderUserID,
+		Provider:       identity.Provider,
+		Email:          identity.Email,
+		CreatedAt:      identity.CreatedAt,
+	}
+}
+
+// GetAvatar serves a user's cached profile picture. It needs no
+// authentication, so that picture URLs work in img tags.
+//
+//	@Summary		Get user profile picture
+//	@Description	Serves the user's profile picture, cached from the identity provider as a square JPEG
+//	@Tags			user
+//	@Produce		jpeg
+//	@Param			userID	path		string	true	"User ID"
+//	@Success		200		{file}		binary	"Profile picture"
+//	@Failure		404		{object}	ErrorResponse	"No cached picture"
+//	@Failure		500		{object}	ErrorResponse	"Internal server error"
+//	@Router			/v1/users/{userID}/avatar [get]
+func (h *UserHandler) GetAvatar(w http.ResponseWriter, r *http.Request) {
+	userID := chi.URLParam(r, "userID")
+
+	avatar, err := h.userService.GetAvatar(r.Context(), userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrFileNotFound) {
+			respondError(w, http.StatusNotFound, "AVATAR_NOT_FOUND", "Profile picture not found")
+			return
+		}
+		log.Error().Err(err).Str("user_id", userID).Msg("Failed to get avatar")
+		respondError(w, http.StatusInternalServerError, "INTERNAL_ERROR", "Failed to retrieve profile picture")
+		return
+	}
+	defer avatar.Close()
+
+	// Picture URLs change with the picture, so they can be cached for long.
+	w.Header().Set("Content-Type", "image/jpeg")
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, avatar); err != nil {
+		log.Error().Err(err).Str("user_id", userID).Msg("Failed to write avatar response")
+	}
+}
+
 // mapUserToResponse maps a domain User to a UserResponse.
 func mapUserToResponse(user *domain.User) UserResponse {
 	return UserResponse{
@@ -1,15 +1,19 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http/mocks"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
 )
 
@@ -171,6 +175,94 @@ func TestUserHandlerUpdateMe(t *testing.T) {
 				assert.Equal(t, "Updated Headline", derefStr(resp.Headline))
 			},
 		},
+		{
+			name: "success - normalizes profile links",
+			setupAuth: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, UserContextKey, &AuthenticatedUser{
+					ID:          "user-123",
+					FirebaseUID: "firebase-123",
+					Email:       "test@example.com",
+				})
+			},
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository) {
+				user, _ := domain.NewUser("firebase-123")
+				user.ID = "user-123"
+				userRepo.Seed(user)
+			},
+			requestBody: UpdateUserRequest{
+				LinkedInURL: strPtr("br.linkedin.com/in/Jane-Doe/?trk=public_profile"),
+				GitHubURL:   strPtr("GitHub.com/jane?utm_source=cv"),
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp UserResponse) {
+				assert.Equal(t, "https://www.linkedin.com/in/jane-doe", derefStr(resp.LinkedInURL))
+				assert.Equal(t, "https://github.com/jane", derefStr(resp.GitHubURL))
+			},
+		},
+		{
+			name: "success - stores phone numbers in E.164",
+			setupAuth: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, UserContextKey, &AuthenticatedUser{
+					ID:          "user-123",
+					FirebaseUID: "firebase-123",
+					Email:       "test@example.com",
+				})
+			},
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository) {
+				user, _ := domain.NewUser("firebase-123")
+				user.ID = "user-123"
+				user.PreferredLanguage = "pt-br"
+				userRepo.Seed(user)
+			},
+			requestBody: UpdateUserRequest{
+				Phone: strPtr("(11) 99999-9999"),
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp UserResponse) {
+				assert.Equal(t, "+5511999999999", derefStr(resp.Phone))
+			},
+		},
+		{
+			name: "error - invalid phone number",
+			setupAuth: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, UserContextKey, &AuthenticatedUser{
+					ID:          "user-123",
+					FirebaseUID: "firebase-123",
+					Email:       "test@example.com",
+				})
+			},
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository) {
+				user, _ := domain.NewUser("firebase-123")
+				user.ID = "user-123"
+				userRepo.Seed(user)
+			},
+			requestBody: UpdateUserRequest{
+				Phone: strPtr("555-0100"),
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name: "error - invalid profile links",
+			setupAuth: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, UserContextKey, &AuthenticatedUser{
+					ID:          "user-123",
+					FirebaseUID: "firebase-123",
+					Email:       "test@example.com",
+				})
+			},
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository) {
+				user, _ := domain.NewUser("firebase-123")
+				user.ID = "user-123"
+				userRepo.Seed(user)
+			},
+			requestBody: UpdateUserRequest{
+				LinkedInURL: strPtr("https://www.linkedin.com/company/acme"),
+				Website:     strPtr("ftp://example.com"),
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "VALIDATION_ERROR",
+		},
 		{
 			name: "error - user not authenticated",
 			setupAuth: func(ctx context.Context) context.Context {
@@ -238,6 +330,272 @@ func TestUserHandlerUpdateMe(t *testing.T) {
 	}
 }
 
+func TestUserHandlerSessions(t *testing.T) {
+	signedIn := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	// setup returns a handler for user-123, whose sessions are managed when
+	// managed is set.
+	setup := func(managed bool) (*UserHandler, *mocks.InMemoryUserRepository, *mocks.MockSessionManager) {
+		userRepo := mocks.NewInMemoryUserRepository()
+		user, _ := domain.NewUser("firebase-123")
+		user.ID = "user-123"
+		userRepo.Seed(user)
+
+		sessions := mocks.NewMockSessionManager()
+		sessions.AddAccount("firebase-123", &ports.AuthAccount{
+			Providers:    []string{"google.com", "github.com"},
+			CreatedAt:    signedIn.AddDate(-1, 0, 0),
+			LastSignInAt: signedIn,
+		})
+
+		userService := services.NewUserService(userRepo, mocks.NewMockAuthProvider())
+		if managed {
+			userService.SetSessionManager(sessions)
+		}
+		return NewUserHandler(userService), userRepo, sessions
+	}
+
+	t.Run("lists the sessions", func(t *testing.T) {
+		handler, _, _ := setup(true)
+
+		req := newJSONRequest(t, http.MethodGet, "/v1/users/me/sessions", nil)
+		ctx := setupTestContext("user-123", "firebase-123", "user@example.com")
+		ctx = context.WithValue(ctx, ClaimsContextKey, &ports.AuthClaims{
+			UserID:    "firebase-123",
+			Provider:  "github.com",
+			IssuedAt:  signedIn.Add(time.Hour).Unix(),
+			ExpiresAt: signedIn.Add(2 * time.Hour).Unix(),
+		})
+		rr := executeRequest(t, req.WithContext(ctx), handler.GetSessions)
+
+		assertStatusCode(t, http.StatusOK, rr)
+		var resp SessionsResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, []string{"google.com", "github.com"}, resp.Providers)
+		require.NotNil(t, resp.LastSignInAt)
+		assert.True(t, signedIn.Equal(*resp.LastSignInAt))
+		assert.Nil(t, resp.LastRefreshAt)
+		assert.Nil(t, resp.RevokedAt)
+		assert.Equal(t, "github.com", resp.Current.Provider)
+		assert.True(t, signedIn.Add(time.Hour).Equal(resp.Current.IssuedAt))
+	})
+
+	t.Run("revokes the sessions", func(t *testing.T) {
+		handler, userRepo, sessions := setup(true)
+
+		req := newJSONRequest(t, http.MethodPost, "/v1/users/me/sessions/revoke", nil)
+		req = req.WithContext(setupTestContext("user-123", "firebase-123", "user@example.com"))
+		rr := executeRequest(t, req, handler.RevokeSessions)
+
+		assertStatusCode(t, http.StatusOK, rr)
+		var resp RevokeSessionsResponse
+		parseJSONResponse(t, rr, &resp)
+		assert.Equal(t, 1, sessions.Revocations("firebase-123"))
+
+		user, err := userRepo.GetByID(context.Background(), "user-123")
+		require.NoError(t, err)
+		require.NotNil(t, user.SessionsRevokedAt)
+		assert.True(t, resp.RevokedAt.Equal(*user.SessionsRevokedAt))
+		assert.True(t, user.SessionRevoked(resp.RevokedAt.Add(-time.Second).Unix()))
+		assert.False(t, user.SessionRevoked(resp.RevokedAt.Unix()))
+	})
+
+	t.Run("error - not supported by the provider", func(t *testing.T) {
+		handler, _, _ := setup(false)
+
+		for _, route := range []struct {
+			method string
+			serve  http.HandlerFunc
+		}{
+			{http.MethodGet, handler.GetSessions},
+			{http.MethodPost, handler.RevokeSessions},
+		} {
+			req := newJSONRequest(t, route.method, "/v1/users/me/sessions", nil)
+			req = req.WithContext(setupTestContext("user-123", "firebase-123", "user@example.com"))
+			rr := executeRequest(t, req, route.serve)
+
+			assertErrorResponse(t, rr, http.StatusNotImplemented, "SESSIONS_NOT_SUPPORTED")
+		}
+	})
+}
+
+func TestUserHandlerIdentities(t *testing.T) {
+	// setup returns a handler for user-123, signed up with Google, and
+	// user-456, signed up with GitHub.
+	setup := func() (*UserHandler, *mocks.InMemoryUserRepository, *mocks.MockAuthProvider) {
+		userRepo := mocks.NewInMemoryUserRepository()
+		user, _ := domain.NewUser("firebase-google-123")
+		user.ID = "user-123"
+		other, _ := domain.NewUser("firebase-github-456")
+		other.ID = "user-456"
+		userRepo.Seed(user, other)
+
+		authProvider := mocks.NewMockAuthProvider()
+		authProvider.AddToken("github-token", &ports.AuthClaims{
+			UserID:   "firebase-github-789",
+			Email:    "user@example.com",
+			Provider: "github.com",
+		})
+		authProvider.AddToken("other-token", &ports.AuthClaims{UserID: "firebase-github-456"})
+		authProvider.AddToken("own-token", &ports.AuthClaims{UserID: "firebase-google-123"})
+
+		return NewUserHandler(services.NewUserService(userRepo, authProvider)), userRepo, authProvider
+	}
+
+	link := func(t *testing.T, handler *UserHandler, idToken string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newJSONRequest(t, http.MethodPost, "/v1/users/me/identities", LinkIdentityRequest{IDToken: idToken})
+		req = req.WithContext(setupTestContext("user-123", "firebase-google-123", "user@example.com"))
+		return executeRequest(t, req, handler.LinkIdentity)
+	}
+
+	unlink := func(t *testing.T, handler *UserHandler, identityID string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := newRequestWithChiContext(t, http.MethodDelete, "/v1/users/me/identities/"+identityID, map[string]string{"identityID": identityID}, nil)
+		ctx := setupTestContext("user-123", "firebase-google-123", "user@example.com")
+		req = req.WithContext(context.WithValue(req.Context(), UserContextKey, ctx.Value(UserContextKey)))
+		return executeRequest(t, req, handler.UnlinkIdentity)
+	}
+
+	t.Run("links, lists and unlinks a sign-in method", func(t *testing.T) {
+		handler, userRepo, _ := setup()
+
+		rr := link(t, handler, "github-token")
+		assertStatusCode(t, http.StatusCreated, rr)
+		var linked IdentityResponse
+		parseJSONResponse(t, rr, &linked)
+		assert.Equal(t, "firebase-github-789", linked.ProviderUserID)
+		assert.Equal(t, "github.com", linked.Provider)
+		assert.Equal(t, "user@example.com", derefStr(linked.Email))
+
+		user, err := userRepo.GetByFirebaseUID(context.Background(), "firebase-github-789")
+		require.NoError(t, err)
+		assert.Equal(t, "user-123", user.ID)
+
+		req := newJSONRequest(t, http.MethodGet, "/v1/users/me/identities", nil)
+		req = req.WithContext(setupTestContext("user-123", "firebase-google-123", "user@example.com"))
+		rr = executeRequest(t, req, handler.ListIdentities)
+		assertStatusCode(t, http.StatusOK, rr)
+		var list ListIdentitiesResponse
+		parseJSONResponse(t, rr, &list)
+		require.Len(t, list.Data, 1)
+		assert.Equal(t, linked.ID, list.Data[0].ID)
+
+		assertStatusCode(t, http.StatusNoContent, unlink(t, handler, linked.ID))
+
+		_, err = userRepo.GetByFirebaseUID(context.Background(), "firebase-github-789")
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+
+	t.Run("error - conflicts", func(t *testing.T) {
+		handler, _, _ := setup()
+
+		assertErrorResponse(t, link(t, handler, "own-token"), http.StatusConflict, "IDENTITY_ALREADY_LINKED")
+		assertErrorResponse(t, link(t, handler, "other-token"), http.StatusConflict, "IDENTITY_IN_USE")
+
+		assertStatusCode(t, http.StatusCreated, link(t, handler, "github-token"))
+		assertErrorResponse(t, link(t, handler, "github-token"), http.StatusConflict, "IDENTITY_ALREADY_LINKED")
+	})
+
+	t.Run("error - invalid token", func(t *testing.T) {
+		handler, _, _ := setup()
+
+		assertErrorResponse(t, link(t, handler, "expired-token"), http.StatusUnprocessableEntity, "INVALID_TOKEN")
+		assertErrorResponse(t, link(t, handler, ""), http.StatusBadRequest, "INVALID_REQUEST")
+	})
+
+	t.Run("error - unlinks another user's identity", func(t *testing.T) {
+		handler, userRepo, _ := setup()
+		identity, _ := domain.NewUserIdentity("user-456", "firebase-google-999", "google.com", "")
+		require.NoError(t, userRepo.LinkIdentity(context.Background(), identity))
+
+		assertErrorResponse(t, unlink(t, handler, identity.ID), http.StatusNotFound, "IDENTITY_NOT_FOUND")
+	})
+}
+
+// avatarURL returns the URL the tests serve a user's cached picture on.
+func avatarURL(userID string) string {
+	return "https://api.example.com/v1/users/" + userID + "/avatar"
+}
+
+func TestUserHandlerGetAvatar(t *testing.T) {
+	const sourceURL = "https://lh3.googleusercontent.com/a/photo"
+	picture := []byte("jpeg bytes")
+
+	tests := []struct {
+		name           string
+		setupMocks     func(userRepo *mocks.InMemoryUserRepository, storage *mocks.InMemoryFileStorage, fetcher *mocks.MockAvatarFetcher)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "success - serves the cached picture",
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository, storage *mocks.InMemoryFileStorage, fetcher *mocks.MockAvatarFetcher) {
+				_, err := storage.Upload(context.Background(), ports.UploadRequest{
+					Key:     "avatars/user-123/avatar.jpg",
+					Content: bytes.NewReader(picture),
+				})
+				require.NoError(t, err)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "success - caches a missing picture again",
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository, storage *mocks.InMemoryFileStorage, fetcher *mocks.MockAvatarFetcher) {
+				user, _ := domain.NewUser("firebase-123")
+				user.ID = "user-123"
+				user.PictureSourceURL = strPtr(sourceURL)
+				userRepo.Seed(user)
+				fetcher.AddPicture(sourceURL, picture)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "error - user without a picture",
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository, storage *mocks.InMemoryFileStorage, fetcher *mocks.MockAvatarFetcher) {
+				user, _ := domain.NewUser("firebase-123")
+				user.ID = "user-123"
+				userRepo.Seed(user)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "AVATAR_NOT_FOUND",
+		},
+		{
+			name: "error - unknown user",
+			setupMocks: func(userRepo *mocks.InMemoryUserRepository, storage *mocks.InMemoryFileStorage, fetcher *mocks.MockAvatarFetcher) {
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "AVATAR_NOT_FOUND",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := mocks.NewInMemoryUserRepository()
+			storage := mocks.NewInMemoryFileStorage()
+			fetcher := mocks.NewMockAvatarFetcher()
+			tt.setupMocks(userRepo, storage, fetcher)
+
+			userService := services.NewUserService(userRepo, mocks.NewMockAuthProvider())
+			userService.SetAvatarCache(fetcher, storage, avatarURL)
+			handler := NewUserHandler(userService)
+
+			req := newRequestWithChiContext(t, http.MethodGet, "/v1/users/user-123/avatar", map[string]string{"userID": "user-123"}, nil)
+			rr := executeRequest(t, req, handler.GetAvatar)
+
+			assertStatusCode(t, tt.expectedStatus, rr)
+			if tt.expectedCode != "" {
+				assertErrorResponse(t, rr, tt.expectedStatus, tt.expectedCode)
+				return
+			}
+			assert.Equal(t, "image/jpeg", rr.Header().Get("Content-Type"))
+			assert.Contains(t, rr.Header().Get("Cache-Control"), "max-age")
+			assert.Equal(t, picture, rr.Body.Bytes())
+			assert.Equal(t, 1, storage.Len())
+		})
+	}
+}
+
 // Helper functions
 func strPtr(s string) *string {
 	return &s
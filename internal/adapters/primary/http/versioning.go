@@ -0,0 +1,153 @@
+package http
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// API versions are mounted side by side: /v1 is frozen, and breaking changes
+// to request and response contracts ship under /v2. Routes whose contract is
+// unchanged share their handler across versions; the others differ only in
+// how their requests are read and their responses written, around shared
+// handler code.
+
+// apiHandlers are the handlers of the routes whose contract differs between
+// API versions.
+type apiHandlers struct {
+	listExperiences  http.HandlerFunc
+	getExperience    http.HandlerFunc
+	createExperience http.HandlerFunc
+	updateExperience http.HandlerFunc
+}
+
+// v1Handlers returns the handlers of the frozen v1 contract.
+func (r *Router) v1Handlers() apiHandlers {
+	return apiHandlers{
+		listExperiences:  r.experienceHandler.List,
+		getExperience:    r.experienceHandler.Get,
+		createExperience: r.experienceHandler.Create,
+		updateExperience: r.experienceHandler.Update,
+	}
+}
+
+// v2Handlers returns the handlers of the v2 contract: lists are paginated
+// with cursors, and absent optional fields are null rather than omitted.
+func (r *Router) v2Handlers() apiHandlers {
+	return apiHandlers{
+		listExperiences:  r.experienceHandler.ListV2,
+		getExperience:    r.experienceHandler.GetV2,
+		createExperience: r.experienceHandler.CreateV2,
+		updateExperience: r.experienceHandler.UpdateV2,
+	}
+}
+
+// Deprecation announces that the routes under a path prefix are deprecated.
+type Deprecation struct {
+	// At is when the routes were, or will be, deprecated.
+	At time.Time
+
+	// Sunset, when set, is when the routes will stop responding.
+	Sunset time.Time
+
+	// Successor, when set, is the path prefix replacing the deprecated one,
+	// e.g. "/v2" for "/v1". Responses link to the successor of their path.
+	Successor string
+}
+
+// Deprecations returns a middleware that sets the Deprecation (RFC 9745) and
+// Sunset (RFC 8594) headers on the responses of routes under a deprecated
+// path prefix, with a successor-version link when there is one. The longest
+// matching prefix wins.
+func Deprecations(deprecations map[string]Deprecation) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if len(deprecations) == 0 {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var (
+				prefix      string
+				deprecation Deprecation
+				matched     bool
+			)
+			for p, d := range deprecations {
+				if strings.HasPrefix(r.URL.Path, p) && (!matched || len(p) > len(prefix)) {
+					prefix, deprecation, matched = p, d, true
+				}
+			}
+
+			if matched {
+				w.Header().Set("Deprecation", "@"+strconv.FormatInt(deprecation.At.Unix(), 10))
+				if !deprecation.Sunset.IsZero() {
+					w.Header().Set("Sunset", deprecation.Sunset.UTC().Format(http.TimeFormat))
+				}
+				if deprecation.Successor != "" {
+					successor := deprecation.Successor + strings.TrimPrefix(r.URL.Path, prefix)
+					w.Header().Add("Link", "<"+successor+`>; rel="successor-version"`)
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// errInvalidCursor is returned for cursors not issued by the server.
+var errInvalidCursor = errors.New("invalid cursor")
+
+// Cursors are opaque to clients. They currently encode the offset of the
+// next page, so that lists can move to keyset pagination without changing
+// the contract.
+const cursorPrefix = "o:"
+
+// encodeCursor returns the cursor of the page starting at offset.
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+// decodeCursor returns the offset of the page of a cursor; the empty cursor
+// is the first page.
+func decodeCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+	value, ok := strings.CutPrefix(string(raw), cursorPrefix)
+	if !ok {
+		return 0, errInvalidCursor
+	}
+	offset, err := strconv.Atoi(value)
+	if err != nil || offset < 0 {
+		return 0, errInvalidCursor
+	}
+	return offset, nil
+}
+
+// nextCursor returns the cursor of the page after the one at offset with
+// count items, or nil when it was the last page.
+func nextCursor(offset, count, total int) *string {
+	if count == 0 || offset+count >= total {
+		return nil
+	}
+	cursor := encodeCursor(offset + count)
+	return &cursor
+}
+
+// parseCursorPage parses the cursor and limit query parameters of a v2 list,
+// responding with 400 and returning false for an invalid cursor.
+func parseCursorPage(w http.ResponseWriter, r *http.Request, defaultLimit int) (offset, limit int, ok bool) {
+	offset, err := decodeCursor(r.URL.Query().Get("cursor"))
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "INVALID_CURSOR", "Invalid pagination cursor")
+		return 0, 0, false
+	}
+	return offset, parseIntParam(r, "limit", defaultLimit), true
+}
@@ -0,0 +1,192 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/primary/http/mocks"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestDeprecations(t *testing.T) {
+	deprecatedAt := time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC)
+	sunsetAt := time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	handler := Deprecations(map[string]Deprecation{
+		"/v1":         {At: deprecatedAt, Sunset: sunsetAt, Successor: "/v2"},
+		"/v1/resumes": {At: deprecatedAt},
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name        string
+		path        string
+		deprecation string
+		sunset      string
+		link        string
+	}{
+		{"deprecated version", "/v1/experiences/exp-1", "@1780272000", "Fri, 01 Jan 2027 00:00:00 GMT", `</v2/experiences/exp-1>; rel="successor-version"`},
+		{"longest prefix wins", "/v1/resumes", "@1780272000", "", ""},
+		{"current version", "/v2/experiences", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.deprecation, rr.Header().Get("Deprecation"))
+			assert.Equal(t, tt.sunset, rr.Header().Get("Sunset"))
+			assert.Equal(t, tt.link, rr.Header().Get("Link"))
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+	offset, err := decodeCursor(encodeCursor(50))
+	require.NoError(t, err)
+	assert.Equal(t, 50, offset)
+
+	offset, err = decodeCursor("")
+	require.NoError(t, err)
+	assert.Zero(t, offset)
+
+	for _, cursor := range []string{"not base64!", encodeCursor(-1), "bzphYmM"} {
+		_, err := decodeCursor(cursor)
+		assert.ErrorIs(t, err, errInvalidCursor, cursor)
+	}
+
+	assert.Equal(t, encodeCursor(20), *nextCursor(0, 20, 45))
+	assert.Nil(t, nextCursor(40, 5, 45))
+	assert.Nil(t, nextCursor(60, 0, 45))
+}
+
+func TestExperienceHandlerListV2(t *testing.T) {
+	expRepo := mocks.NewInMemoryExperienceRepository()
+	expRepo.Seed(createTestExperience("exp-1", "user-123"))
+	handler := NewExperienceHandler(services.NewExperienceService(expRepo, mocks.NewInMemoryBulletRepository()))
+
+	t.Run("absent fields are null", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v2/experiences", nil)
+		req = req.WithContext(setupTestContext("user-123", "firebase-123", "test@example.com"))
+
+		rr := executeRequest(t, req, handler.ListV2)
+		assertStatusCode(t, http.StatusOK, rr)
+
+		var resp map[string]any
+		parseJSONResponse(t, rr, &resp)
+		assert.Contains(t, resp, "next_cursor")
+		assert.Nil(t, resp["next_cursor"])
+		assert.NotContains(t, resp, "total")
+
+		data := resp["data"].([]any)
+		require.Len(t, data, 1)
+		experience := data[0].(map[string]any)
+		assert.Contains(t, experience, "location")
+		assert.Nil(t, experience["location"])
+		assert.Equal(t, []any{}, experience["bullets"])
+		assert.Equal(t, map[string]any{}, experience["metadata"])
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		req := newJSONRequest(t, http.MethodGet, "/v2/experiences?cursor=bogus", nil)
+		req = req.WithContext(setupTestContext("user-123", "firebase-123", "test@example.com"))
+
+		rr := executeRequest(t, req, handler.ListV2)
+		assertErrorResponse(t, rr, http.StatusBadRequest, "INVALID_CURSOR")
+	})
+}
+
+func TestRouterAPIVersions(t *testing.T) {
+	userRepo := mocks.NewInMemoryUserRepository()
+	user := createTestUser("firebase-123")
+	user.ID = "user-123"
+	userRepo.Seed(user)
+
+	authProvider := mocks.NewMockAuthProvider()
+	authProvider.AddToken("token", &ports.AuthClaims{UserID: "firebase-123"})
+
+	expRepo := mocks.NewInMemoryExperienceRepository()
+	expRepo.Seed(createTestExperience("exp-1", "user-123"))
+
+	config := DefaultRouterConfig()
+	config.EnableSwagger = false
+	config.Deprecations = map[string]Deprecation{
+		"/v1": {At: time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC), Successor: "/v2"},
+	}
+
+	router := NewRouter(config, Services{
+		UserService:       services.NewUserService(userRepo, authProvider),
+		ExperienceService: services.NewExperienceService(expRepo, mocks.NewInMemoryBulletRepository()),
+	})
+	router.SetAuthMiddleware(authProvider, userRepo)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer token")
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+
+	v1 := get("/v1/experiences")
+	assert.Equal(t, http.StatusOK, v1.Code)
+	assert.Equal(t, "@1780272000", v1.Header().Get("Deprecation"))
+	assert.Equal(t, `</v2/experiences>; rel="successor-version"`, v1.Header().Get("Link"))
+	var v1Resp ListExperiencesResponse
+	parseJSONResponse(t, v1, &v1Resp)
+	assert.Equal(t, 1, v1Resp.Total)
+
+	v2 := get("/v2/experiences")
+	assert.Equal(t, http.StatusOK, v2.Code)
+	assert.Empty(t, v2.Header().Get("Deprecation"))
+	var v2Resp Envelope[[]ExperienceV2Response]
+	parseJSONResponse(t, v2, &v2Resp)
+	require.Len(t, v2Resp.Data, 1)
+	assert.Equal(t, map[string]any{"next_cursor": nil}, v2Resp.Meta)
+	assert.Nil(t, v2Resp.Error)
+
+	// Routes without contract changes are shared between versions, and
+	// enveloped in v2.
+	me := get("/v2/me")
+	assert.Equal(t, http.StatusOK, me.Code)
+	var meResp Envelope[UserResponse]
+	parseJSONResponse(t, me, &meResp)
+	assert.Equal(t, "user-123", meResp.Data.ID)
+
+	missing := get("/v2/experiences/exp-404")
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+	var missingResp map[string]any
+	parseJSONResponse(t, missing, &missingResp)
+	assert.Nil(t, missingResp["data"])
+	assert.Equal(t, "EXPERIENCE_NOT_FOUND", missingResp["error"].(map[string]any)["code"])
+}
+
+func TestEnvelope(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     any
+		expected string
+	}{
+		{"object", HealthResponse{Status: "healthy", Service: "chameleon-vitae"}, `{"data":{"status":"healthy","service":"chameleon-vitae"}}`},
+		{"list", ListBulletsResponse{Data: []BulletResponse{}, Total: 0}, `{"data":[],"meta":{"total":0}}`},
+		{"message", SuccessResponse{Data: "ok", Message: "Done"}, `{"data":"ok","meta":{"message":"Done"}}`},
+		{"array", []string{"a"}, `{"data":["a"]}`},
+		{"error", ErrorResponse{Error: ErrorBody{Code: "NOT_FOUND", Message: "Not found"}}, `{"data":null,"error":{"code":"NOT_FOUND","message":"Not found"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			respondJSON(&formatWriter{ResponseWriter: rr, envelope: true}, http.StatusOK, tt.body)
+			assert.JSONEq(t, tt.expected, rr.Body.String())
+		})
+	}
+}
@@ -0,0 +1,233 @@
+// Package avatar provides an adapter that downloads profile pictures from
+// identity providers and turns them into square JPEG thumbnails.
+//
+// Provider picture URLs expire and count as hotlinks, so the thumbnails are
+// cached in file storage and served from the API instead.
+package avatar
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	// Decoders of the formats providers serve pictures in.
+	_ "image/gif"
+	_ "image/png"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// jpegQuality is the quality thumbnails are encoded with.
+const jpegQuality = 85
+
+// Config holds avatar fetcher configuration.
+type Config struct {
+	// AllowedDomains lists the hosts pictures are downloaded from; subdomains
+	// match too. Pictures are only fetched over https.
+	AllowedDomains []string
+
+	// Size is the width and height of the thumbnails in pixels. Smaller
+	// pictures are not enlarged.
+	Size int
+
+	// MaxBytes bounds the size of downloaded pictures.
+	MaxBytes int64
+
+	// MaxDimension bounds the width and height of downloaded pictures in
+	// pixels: a small compressed file can decode to an image too large for
+	// memory.
+	MaxDimension int
+
+	// Timeout bounds a download.
+	Timeout time.Duration
+
+	// HTTPClient is the client pictures are downloaded with. When nil, a
+	// client with Timeout is used.
+	HTTPClient *http.Client
+}
+
+// DefaultConfig returns a Config with sensible defaults: the picture hosts
+// of Google, GitHub and Gravatar.
+func DefaultConfig() Config {
+	return Config{
+		AllowedDomains: []string{"googleusercontent.com", "githubusercontent.com", "gravatar.com"},
+		Size:           256,
+		MaxBytes:       5 * 1024 * 1024,
+		MaxDimension:   4096,
+		Timeout:        10 * time.Second,
+	}
+}
+
+// Fetcher implements ports.AvatarFetcher over HTTP.
+type Fetcher struct {
+	config Config
+	client *http.Client
+}
+
+var _ ports.AvatarFetcher = (*Fetcher)(nil)
+
+// New creates a new avatar fetcher.
+func New(cfg Config) (*Fetcher, error) {
+	defaults := DefaultConfig()
+	if cfg.AllowedDomains == nil {
+		cfg.AllowedDomains = defaults.AllowedDomains
+	}
+	if len(cfg.AllowedDomains) == 0 {
+		return nil, fmt.Errorf("avatar: at least one allowed domain is required")
+	}
+	for i, d := range cfg.AllowedDomains {
+		cfg.AllowedDomains[i] = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(d), "."))
+	}
+	if cfg.Size <= 0 {
+		cfg.Size = defaults.Size
+	}
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = defaults.MaxBytes
+	}
+	if cfg.MaxDimension <= 0 {
+		cfg.MaxDimension = defaults.MaxDimension
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+
+	client := &http.Client{Timeout: cfg.Timeout}
+	if cfg.HTTPClient != nil {
+		// Copied, so checking redirects does not change the shared client.
+		shared := *cfg.HTTPClient
+		client = &shared
+	}
+	f := &Fetcher{config: cfg, client: client}
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 5 {
+			return errors.New("avatar: too many redirects")
+		}
+		return f.check(req.URL)
+	}
+
+	return f, nil
+}
+
+// FetchAvatar downloads the picture at rawURL and returns it as a square
+// JPEG thumbnail, cropped to its center.
+func (f *Fetcher) FetchAvatar(ctx context.Context, rawURL string) ([]byte, error) {
+	pictureURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("avatar: invalid URL: %w", err)
+	}
+	if err := f.check(pictureURL); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pictureURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("avatar: failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "image/jpeg, image/png, image/gif")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("avatar: download failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("avatar: download failed with status %d", resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, f.config.MaxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("avatar: download failed: %w", err)
+	}
+	if int64(len(content)) > f.config.MaxBytes {
+		return nil, fmt.Errorf("avatar: picture is larger than %d bytes", f.config.MaxBytes)
+	}
+
+	// The header is checked first, so that oversized pictures are never
+	// decoded.
+	header, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("avatar: failed to decode picture: %w", err)
+	}
+	if header.Width > f.config.MaxDimension || header.Height > f.config.MaxDimension {
+		return nil, fmt.Errorf("avatar: picture is larger than %dx%d pixels", f.config.MaxDimension, f.config.MaxDimension)
+	}
+
+	picture, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("avatar: failed to decode picture: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, thumbnail(picture, f.config.Size), &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, fmt.Errorf("avatar: failed to encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// check validates a picture URL against the allowlist.
+func (f *Fetcher) check(u *url.URL) error {
+	if u.Scheme != "https" {
+		return fmt.Errorf("avatar: URL must use https: %w", domain.ErrURLNotAllowed)
+	}
+	if u.User != nil {
+		return fmt.Errorf("avatar: URL must not contain credentials: %w", domain.ErrURLNotAllowed)
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	for _, d := range f.config.AllowedDomains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return nil
+		}
+	}
+	return fmt.Errorf("avatar: domain %q is not in the allowlist: %w", host, domain.ErrURLNotAllowed)
+}
+
+// thumbnail crops the center square of src and scales it down to size
+// pixels by averaging the source pixels of each thumbnail pixel. Transparent
+// areas are composited on white, as JPEG has no alpha channel.
+func thumbnail(src image.Image, size int) *image.RGBA {
+	b := src.Bounds()
+	side := min(b.Dx(), b.Dy())
+	size = min(size, side)
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := range size {
+		sy0, sy1 := y0+y*side/size, y0+(y+1)*side/size
+		for x := range size {
+			sx0, sx1 := x0+x*side/size, x0+(x+1)*side/size
+
+			var r, g, bl, a, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca)
+					n++
+				}
+			}
+
+			// Colors are alpha-premultiplied, so adding the missing alpha
+			// composites them on white.
+			white := 0xffff*n - a
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8((r + white) / n >> 8),
+				G: uint8((g + white) / n >> 8),
+				B: uint8((bl + white) / n >> 8),
+				A: 0xff,
+			})
+		}
+	}
+	return dst
+}
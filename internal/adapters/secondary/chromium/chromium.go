@@ -0,0 +1,247 @@
+// Package chromium provides a PDF generation adapter using a locally
+// installed headless Chromium driven through the DevTools protocol.
+// It is an alternative to Gotenberg for small installs that don't want
+// to run an extra container.
+package chromium
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chromedp/cdproto/page"
+	"github.com/chromedp/chromedp"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// Config holds local Chromium configuration.
+type Config struct {
+	// ExecPath is the path to the Chromium/Chrome binary.
+	// When empty, well-known locations and $PATH are searched.
+	ExecPath string
+
+	// Timeout is the maximum time allowed for a single conversion.
+	Timeout time.Duration
+
+	// NoSandbox disables the Chromium sandbox (required when running as root in containers).
+	NoSandbox bool
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		Timeout: 60 * time.Second,
+	}
+}
+
+// Ensure Client implements ports.PDFEngine.
+var _ ports.PDFEngine = (*Client)(nil)
+
+// Client implements ports.PDFEngine using a local headless Chromium.
+type Client struct {
+	config    Config
+	templates []ports.PDFTemplate
+
+	mu            sync.Mutex
+	allocCancel   context.CancelFunc
+	browserCtx    context.Context
+	browserCancel context.CancelFunc
+}
+
+// New creates a new local Chromium client.
+// The browser is started lazily on first use.
+func New(cfg Config) (*Client, error) {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultConfig().Timeout
+	}
+
+	return &Client{
+		config:    cfg,
+		templates: ports.DefaultPDFTemplates(),
+	}, nil
+}
+
+// GeneratePDF generates a PDF from HTML content.
+func (c *Client) GeneratePDF(ctx context.Context, req ports.GeneratePDFRequest) (*ports.PDFResult, error) {
+	htmlContent := req.HTML
+	if req.CSS != "" {
+		htmlContent = injectCSS(htmlContent, req.CSS)
+	}
+	if req.Watermark != "" {
+		htmlContent = injectCSS(htmlContent, ports.WatermarkCSS(req.Watermark))
+	}
+
+	opts := req.Options
+	if opts.PaperWidth == 0 {
+		opts = ports.DefaultPDFOptions()
+	}
+	if opts.Scale == 0 {
+		opts.Scale = 1.0
+	}
+
+	tabCtx, cancel, err := c.newTab(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("chromium: %w: %w", domain.ErrPDFServiceUnavailable, err)
+	}
+	defer cancel()
+
+	var pdf []byte
+	err = chromedp.Run(tabCtx,
+		chromedp.Navigate("about:blank"),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			frameTree, err := page.GetFrameTree().Do(ctx)
+			if err != nil {
+				return err
+			}
+			return page.SetDocumentContent(frameTree.Frame.ID, htmlContent).Do(ctx)
+		}),
+		chromedp.WaitReady("body", chromedp.ByQuery),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			var err error
+			pdf, _, err = page.PrintToPDF().
+				WithPrintBackground(true).
+				WithPreferCSSPageSize(false).
+				WithPaperWidth(opts.PaperWidth).
+				WithPaperHeight(opts.PaperHeight).
+				WithMarginTop(opts.MarginTop).
+				WithMarginBottom(opts.MarginBottom).
+				WithMarginLeft(opts.MarginLeft).
+				WithMarginRight(opts.MarginRight).
+				WithScale(opts.Scale).
+				WithPageRanges(opts.PageRanges()).
+				Do(ctx)
+			return err
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("chromium: conversion failed: %w", err)
+	}
+
+	filename := "resume.pdf"
+	if req.TemplateName != "" {
+		filename = fmt.Sprintf("resume_%s.pdf", req.TemplateName)
+	}
+
+	return &ports.PDFResult{
+		Content:  io.NopCloser(bytes.NewReader(pdf)),
+		Size:     int64(len(pdf)),
+		Filename: filename,
+	}, nil
+}
+
+// GetTemplates returns available resume templates.
+func (c *Client) GetTemplates(ctx context.Context) ([]ports.PDFTemplate, error) {
+	return c.templates, nil
+}
+
+// HealthCheck checks if the browser can be started and open a tab.
+func (c *Client) HealthCheck(ctx context.Context) error {
+	tabCtx, cancel, err := c.newTab(ctx)
+	if err != nil {
+		return fmt.Errorf("chromium: health check failed: %w", err)
+	}
+	defer cancel()
+
+	if err := chromedp.Run(tabCtx, chromedp.Navigate("about:blank")); err != nil {
+		return fmt.Errorf("chromium: health check failed: %w", err)
+	}
+
+	return nil
+}
+
+// Close shuts down the browser if it was started.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shutdownLocked()
+	return nil
+}
+
+// newTab opens a new browser tab bound to the caller's context and the conversion timeout.
+func (c *Client) newTab(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	browserCtx, err := c.ensureBrowser()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tabCtx, tabCancel := chromedp.NewContext(browserCtx)
+	tabCtx, timeoutCancel := context.WithTimeout(tabCtx, c.config.Timeout)
+
+	// Tabs derive from the long-lived browser context, so propagate
+	// cancellation from the request context explicitly.
+	stop := context.AfterFunc(ctx, tabCancel)
+
+	return tabCtx, func() {
+		stop()
+		timeoutCancel()
+		tabCancel()
+	}, nil
+}
+
+// ensureBrowser starts the shared browser process if it isn't running.
+func (c *Client) ensureBrowser() (context.Context, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.browserCtx != nil && c.browserCtx.Err() == nil {
+		return c.browserCtx, nil
+	}
+	c.shutdownLocked()
+
+	opts := append([]chromedp.ExecAllocatorOption{}, chromedp.DefaultExecAllocatorOptions[:]...)
+	if c.config.ExecPath != "" {
+		opts = append(opts, chromedp.ExecPath(c.config.ExecPath))
+	}
+	if c.config.NoSandbox {
+		opts = append(opts, chromedp.NoSandbox)
+	}
+
+	allocCtx, allocCancel := chromedp.NewExecAllocator(context.Background(), opts...)
+	browserCtx, browserCancel := chromedp.NewContext(allocCtx)
+
+	// Running with no actions starts the browser.
+	if err := chromedp.Run(browserCtx); err != nil {
+		browserCancel()
+		allocCancel()
+		return nil, fmt.Errorf("failed to start browser: %w", err)
+	}
+
+	c.allocCancel = allocCancel
+	c.browserCtx = browserCtx
+	c.browserCancel = browserCancel
+
+	return browserCtx, nil
+}
+
+// shutdownLocked stops the browser. The caller must hold c.mu.
+func (c *Client) shutdownLocked() {
+	if c.browserCancel != nil {
+		c.browserCancel()
+	}
+	if c.allocCancel != nil {
+		c.allocCancel()
+	}
+	c.browserCtx = nil
+	c.browserCancel = nil
+	c.allocCancel = nil
+}
+
+// injectCSS injects CSS into HTML head.
+func injectCSS(html, css string) string {
+	styleTag := fmt.Sprintf("<style>%s</style>", css)
+
+	// Try to inject before </head>.
+	if idx := strings.Index(html, "</head>"); idx != -1 {
+		return html[:idx] + styleTag + html[idx:]
+	}
+
+	// Fallback: prepend to HTML.
+	return styleTag + html
+}
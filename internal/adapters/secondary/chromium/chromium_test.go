@@ -0,0 +1,83 @@
+package chromium_test
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/chromium"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// findChromium returns a local Chromium binary or skips the test.
+func findChromium(t *testing.T) string {
+	t.Helper()
+	for _, name := range []string{"chromium", "chromium-browser", "google-chrome", "google-chrome-stable"} {
+		if path, err := exec.LookPath(name); err == nil {
+			return path
+		}
+	}
+	t.Skip("Skipping: no local Chromium binary found")
+	return ""
+}
+
+func TestNew(t *testing.T) {
+	client, err := chromium.New(chromium.Config{})
+	require.NoError(t, err)
+	require.NotNil(t, client)
+	assert.NoError(t, client.Close())
+}
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := chromium.DefaultConfig()
+	assert.NotZero(t, cfg.Timeout)
+	assert.Empty(t, cfg.ExecPath)
+}
+
+func TestGetTemplates(t *testing.T) {
+	client, err := chromium.New(chromium.Config{})
+	require.NoError(t, err)
+
+	templates, err := client.GetTemplates(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, ports.DefaultPDFTemplates(), templates)
+}
+
+func TestHealthCheckMissingBinary(t *testing.T) {
+	client, err := chromium.New(chromium.Config{ExecPath: "/nonexistent/chromium"})
+	require.NoError(t, err)
+	defer client.Close()
+
+	err = client.HealthCheck(context.Background())
+	assert.Error(t, err)
+}
+
+func TestGeneratePDF(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping browser test in short mode")
+	}
+	execPath := findChromium(t)
+
+	client, err := chromium.New(chromium.Config{ExecPath: execPath, NoSandbox: true})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.HealthCheck(context.Background()))
+
+	result, err := client.GeneratePDF(context.Background(), ports.GeneratePDFRequest{
+		HTML:         "<html><head></head><body><h1>Test Resume</h1></body></html>",
+		CSS:          "h1 { color: #333; }",
+		TemplateName: "jake",
+	})
+	require.NoError(t, err)
+	defer result.Content.Close()
+
+	content, err := io.ReadAll(result.Content)
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF", string(content[:4]))
+	assert.Equal(t, "resume_jake.pdf", result.Filename)
+}
@@ -0,0 +1,196 @@
+// Package clamav provides a virus scanning adapter using the ClamAV daemon.
+//
+// Files are streamed to clamd with the INSTREAM command, so the daemon does
+// not need access to the API's filesystem.
+package clamav
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/circuitbreaker"
+)
+
+const (
+	defaultNetwork = "tcp"
+	defaultAddress = "localhost:3310"
+
+	// chunkSize is the size of the INSTREAM chunks sent to clamd.
+	chunkSize = 64 * 1024
+)
+
+// Config holds ClamAV daemon configuration.
+type Config struct {
+	// Network is "tcp" or "unix".
+	Network string
+
+	// Address is the clamd address: host:port for tcp, a socket path for unix.
+	Address string
+
+	// Timeout bounds a whole scan, from connecting to reading the verdict.
+	Timeout time.Duration
+
+	// CircuitBreaker configures the breaker that fails fast while clamd is down.
+	CircuitBreaker circuitbreaker.Config
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		Network:        defaultNetwork,
+		Address:        defaultAddress,
+		Timeout:        30 * time.Second,
+		CircuitBreaker: circuitbreaker.DefaultConfig(),
+	}
+}
+
+// Client implements ports.VirusScanner using clamd.
+type Client struct {
+	config  Config
+	dialer  net.Dialer
+	breaker *circuitbreaker.Breaker
+}
+
+var _ ports.VirusScanner = (*Client)(nil)
+
+// New creates a new ClamAV client.
+func New(cfg Config) (*Client, error) {
+	defaults := DefaultConfig()
+	if cfg.Network == "" {
+		cfg.Network = defaults.Network
+	}
+	if cfg.Network != "tcp" && cfg.Network != "unix" {
+		return nil, fmt.Errorf("clamav: network must be \"tcp\" or \"unix\"")
+	}
+	if cfg.Address == "" {
+		cfg.Address = defaults.Address
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+
+	return &Client{
+		config:  cfg,
+		breaker: circuitbreaker.New("clamav", cfg.CircuitBreaker),
+	}, nil
+}
+
+// Scan streams the content to clamd and returns its verdict.
+func (c *Client) Scan(ctx context.Context, content io.Reader) (*ports.ScanResult, error) {
+	done, err := c.breaker.Allow()
+	if err != nil {
+		return nil, fmt.Errorf("clamav: %w: %w: %w", domain.ErrVirusScanUnavailable, domain.ErrUpstreamUnavailable, err)
+	}
+
+	result, err := c.scan(ctx, content)
+	done(err != nil && ctx.Err() == nil && !errors.Is(err, domain.ErrFileTooLarge))
+	if err != nil {
+		if errors.Is(err, domain.ErrFileTooLarge) || ctx.Err() != nil {
+			return nil, fmt.Errorf("clamav: %w", err)
+		}
+		return nil, fmt.Errorf("clamav: %w: %w", domain.ErrVirusScanUnavailable, err)
+	}
+
+	return result, nil
+}
+
+// Close releases any resources held by the client.
+func (c *Client) Close() error {
+	// Every scan uses its own connection.
+	return nil
+}
+
+// scan runs one INSTREAM session.
+func (c *Client) scan(ctx context.Context, content io.Reader) (*ports.ScanResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	conn, err := c.dialer.DialContext(ctx, c.config.Network, c.config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, fmt.Errorf("failed to set deadline: %w", err)
+		}
+	}
+
+	// Unblock reads and writes if the caller gives up first.
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if err := writeStream(conn, content); err != nil {
+		return nil, err
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read reply: %w", err)
+	}
+
+	return parseReply(reply)
+}
+
+// writeStream sends the INSTREAM command followed by length-prefixed chunks
+// and the zero-length terminator.
+func writeStream(w io.Writer, content io.Reader) error {
+	if _, err := io.WriteString(w, "zINSTREAM\x00"); err != nil {
+		return fmt.Errorf("failed to send command: %w", err)
+	}
+
+	buf := make([]byte, 4+chunkSize)
+	for {
+		n, readErr := content.Read(buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+			if _, err := w.Write(buf[:4+n]); err != nil {
+				return fmt.Errorf("failed to send chunk: %w", err)
+			}
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return fmt.Errorf("failed to read content: %w", readErr)
+		}
+	}
+
+	if _, err := w.Write([]byte{0, 0, 0, 0}); err != nil {
+		return fmt.Errorf("failed to send terminator: %w", err)
+	}
+	return nil
+}
+
+// parseReply interprets a clamd reply such as "stream: OK" or
+// "stream: Eicar-Signature FOUND".
+func parseReply(reply string) (*ports.ScanResult, error) {
+	reply = strings.TrimSpace(strings.TrimRight(reply, "\x00"))
+	verdict := strings.TrimSpace(strings.TrimPrefix(reply, "stream:"))
+
+	switch {
+	case verdict == "OK":
+		return &ports.ScanResult{}, nil
+	case strings.HasSuffix(verdict, " FOUND"):
+		return &ports.ScanResult{
+			Infected:  true,
+			Signature: strings.TrimSuffix(verdict, " FOUND"),
+		}, nil
+	case strings.Contains(verdict, "size limit exceeded"):
+		return nil, fmt.Errorf("%w: %s", domain.ErrFileTooLarge, verdict)
+	case reply == "":
+		return nil, errors.New("empty reply")
+	default:
+		return nil, fmt.Errorf("scan failed: %s", verdict)
+	}
+}
@@ -0,0 +1,147 @@
+// Package clamav_test contains unit tests for the ClamAV adapter.
+package clamav_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/clamav"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/pkg/circuitbreaker"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := clamav.DefaultConfig()
+
+	assert.Equal(t, "tcp", cfg.Network)
+	assert.Equal(t, "localhost:3310", cfg.Address)
+	assert.NotZero(t, cfg.Timeout)
+}
+
+func TestNew(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		_, err := clamav.New(clamav.Config{})
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects unknown network", func(t *testing.T) {
+		_, err := clamav.New(clamav.Config{Network: "udp"})
+		assert.Error(t, err)
+	})
+}
+
+// fakeClamd starts a clamd stand-in that decodes one INSTREAM session per
+// connection, passes the streamed content to reply and sends back its answer.
+func fakeClamd(t *testing.T, reply func(content []byte) string) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+
+				command := make([]byte, len("zINSTREAM\x00"))
+				if _, err := io.ReadFull(conn, command); err != nil || string(command) != "zINSTREAM\x00" {
+					return
+				}
+
+				var content bytes.Buffer
+				for {
+					var size uint32
+					if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+						return
+					}
+					if size == 0 {
+						break
+					}
+					if _, err := io.CopyN(&content, conn, int64(size)); err != nil {
+						return
+					}
+				}
+
+				_, _ = io.WriteString(conn, reply(content.Bytes())+"\x00")
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func TestScan(t *testing.T) {
+	address := fakeClamd(t, func(content []byte) string {
+		if bytes.Contains(content, []byte("EICAR")) {
+			return "stream: Eicar-Signature FOUND"
+		}
+		return "stream: OK"
+	})
+
+	client, err := clamav.New(clamav.Config{Address: address, Timeout: 5 * time.Second})
+	require.NoError(t, err)
+
+	t.Run("clean file", func(t *testing.T) {
+		// Larger than one chunk, to exercise chunking.
+		content := strings.Repeat("title,organization,start_date\n", 5000)
+
+		result, err := client.Scan(context.Background(), strings.NewReader(content))
+		require.NoError(t, err)
+		assert.False(t, result.Infected)
+		assert.Empty(t, result.Signature)
+	})
+
+	t.Run("infected file", func(t *testing.T) {
+		result, err := client.Scan(context.Background(), strings.NewReader("X5O!P%@AP EICAR test"))
+		require.NoError(t, err)
+		assert.True(t, result.Infected)
+		assert.Equal(t, "Eicar-Signature", result.Signature)
+	})
+}
+
+func TestScanErrors(t *testing.T) {
+	t.Run("size limit", func(t *testing.T) {
+		address := fakeClamd(t, func([]byte) string { return "INSTREAM size limit exceeded. ERROR" })
+		client, err := clamav.New(clamav.Config{Address: address})
+		require.NoError(t, err)
+
+		_, err = client.Scan(context.Background(), strings.NewReader("data"))
+		assert.ErrorIs(t, err, domain.ErrFileTooLarge)
+	})
+
+	t.Run("daemon down", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		address := listener.Addr().String()
+		require.NoError(t, listener.Close())
+
+		client, err := clamav.New(clamav.Config{
+			Address:        address,
+			CircuitBreaker: circuitbreaker.Config{FailureThreshold: 1, OpenTimeout: time.Minute},
+		})
+		require.NoError(t, err)
+
+		_, err = client.Scan(context.Background(), strings.NewReader("data"))
+		assert.ErrorIs(t, err, domain.ErrVirusScanUnavailable)
+		assert.NotErrorIs(t, err, domain.ErrUpstreamUnavailable)
+
+		// The breaker is now open and fails fast.
+		_, err = client.Scan(context.Background(), strings.NewReader("data"))
+		assert.ErrorIs(t, err, domain.ErrVirusScanUnavailable)
+		assert.ErrorIs(t, err, domain.ErrUpstreamUnavailable)
+	})
+}
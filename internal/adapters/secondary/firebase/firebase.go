@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	firebase "firebase.google.com/go/v4"
 	"firebase.google.com/go/v4/auth"
@@ -148,11 +149,54 @@ func (a *Adapter) GetUser(ctx context.Context, uid string) (*auth.UserRecord, er
 	return a.client.GetUser(ctx, uid)
 }
 
+// GetAccount returns the sign-in activity of a Firebase user. Firebase does
+// not track sessions per device, so only the latest activity is known.
+func (a *Adapter) GetAccount(ctx context.Context, uid string) (*ports.AuthAccount, error) {
+	record, err := a.client.GetUser(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get firebase user: %w", err)
+	}
+
+	account := &ports.AuthAccount{}
+	for _, info := range record.ProviderUserInfo {
+		account.Providers = append(account.Providers, info.ProviderID)
+	}
+	if record.UserMetadata != nil {
+		account.CreatedAt = millisToTime(record.UserMetadata.CreationTimestamp)
+		account.LastSignInAt = millisToTime(record.UserMetadata.LastLogInTimestamp)
+		account.LastRefreshAt = millisToTime(record.UserMetadata.LastRefreshTimestamp)
+	}
+	account.ValidSince = millisToTime(record.TokensValidAfterMillis)
+
+	return account, nil
+}
+
+// RevokeSessions revokes the refresh tokens of a Firebase user.
+func (a *Adapter) RevokeSessions(ctx context.Context, uid string) error {
+	if err := a.client.RevokeRefreshTokens(ctx, uid); err != nil {
+		return fmt.Errorf("failed to revoke firebase refresh tokens: %w", err)
+	}
+	return nil
+}
+
+// millisToTime converts a Firebase timestamp in milliseconds since the epoch
+// to a UTC time, or the zero time for 0.
+func millisToTime(millis int64) time.Time {
+	if millis == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis).UTC()
+}
+
 // Close releases resources held by the adapter.
 func (a *Adapter) Close() error {
 	// The Firebase Admin SDK doesn't require explicit cleanup.
 	return nil
 }
 
-// Compile-time check that Adapter implements ports.AuthProvider.
-var _ ports.AuthProvider = (*Adapter)(nil)
+// Compile-time checks that Adapter implements ports.AuthProvider and
+// ports.SessionManager.
+var (
+	_ ports.AuthProvider   = (*Adapter)(nil)
+	_ ports.SessionManager = (*Adapter)(nil)
+)
@@ -0,0 +1,203 @@
+// Package gcs provides a file storage adapter using Google Cloud Storage.
+package gcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// ErrMissingBucket is returned when the bucket name is not provided.
+var ErrMissingBucket = errors.New("gcs bucket is required")
+
+// Config holds the configuration for the GCS storage adapter.
+type Config struct {
+	// Bucket is the name of the bucket files are stored in (required).
+	Bucket string
+
+	// CredentialsFile is the path to the service account JSON file.
+	// If empty, the SDK will try to use Application Default Credentials.
+	// Signing URLs requires a service account.
+	CredentialsFile string
+
+	// URLTTL is how long signed URLs stay valid.
+	URLTTL time.Duration
+
+	// Options are extra client options, e.g. to reach an emulator.
+	Options []option.ClientOption
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		URLTTL: 15 * time.Minute,
+	}
+}
+
+// Storage implements FileStorage using a GCS bucket. Objects are private and
+// shared through V4 signed URLs.
+type Storage struct {
+	client *storage.Client
+	bucket *storage.BucketHandle
+	urlTTL time.Duration
+}
+
+// New creates a new GCS storage adapter.
+func New(ctx context.Context, cfg Config) (*Storage, error) {
+	if cfg.Bucket == "" {
+		return nil, ErrMissingBucket
+	}
+	if cfg.URLTTL == 0 {
+		cfg.URLTTL = DefaultConfig().URLTTL
+	}
+
+	opts := cfg.Options
+	if cfg.CredentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(cfg.CredentialsFile))
+	}
+
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize gcs client: %w", err)
+	}
+
+	return &Storage{
+		client: client,
+		bucket: client.Bucket(cfg.Bucket),
+		urlTTL: cfg.URLTTL,
+	}, nil
+}
+
+// Upload stores a file in the bucket.
+func (s *Storage) Upload(ctx context.Context, req ports.UploadRequest) (*ports.UploadResult, error) {
+	key := req.Key
+	if key == "" {
+		key = uuid.New().String()
+	}
+
+	// Cancelling the context aborts the upload instead of storing a partial
+	// object.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	writer := s.bucket.Object(key).NewWriter(ctx)
+	writer.ContentType = req.ContentType
+	writer.Metadata = req.Metadata
+
+	if _, err := io.Copy(writer, req.Content); err != nil {
+		cancel()
+		_ = writer.Close()
+		return nil, fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write object: %w", err)
+	}
+
+	url, err := s.signedURL(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ports.UploadResult{
+		Key:  key,
+		URL:  url,
+		Size: writer.Attrs().Size,
+	}, nil
+}
+
+// Download retrieves a file from the bucket.
+func (s *Storage) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	reader, err := s.bucket.Object(key).NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrFileNotFound, key)
+		}
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return reader, nil
+}
+
+// Delete removes a file from the bucket.
+func (s *Storage) Delete(ctx context.Context, key string) error {
+	if err := s.bucket.Object(key).Delete(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil // File already deleted
+		}
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+// GetURL returns a signed URL for accessing a file.
+func (s *Storage) GetURL(ctx context.Context, key string) (string, error) {
+	if _, err := s.bucket.Object(key).Attrs(ctx); err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return "", fmt.Errorf("%w: %s", domain.ErrFileNotFound, key)
+		}
+		return "", fmt.Errorf("failed to get object: %w", err)
+	}
+
+	return s.signedURL(key)
+}
+
+// SignURL returns a signed URL for accessing a file without checking that it exists.
+func (s *Storage) SignURL(key string) (string, error) {
+	return s.signedURL(key)
+}
+
+// List returns the files whose key starts with prefix.
+func (s *Storage) List(ctx context.Context, prefix string) ([]ports.FileInfo, error) {
+	var files []ports.FileInfo
+
+	it := s.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", err)
+		}
+		files = append(files, ports.FileInfo{
+			Key:       attrs.Name,
+			Size:      attrs.Size,
+			UpdatedAt: attrs.Updated,
+		})
+	}
+
+	return files, nil
+}
+
+// signedURL returns a V4 signed GET URL for a key.
+func (s *Storage) signedURL(key string) (string, error) {
+	url, err := s.bucket.SignedURL(key, &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  "GET",
+		Expires: time.Now().Add(s.urlTTL),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign url: %w", err)
+	}
+
+	return url, nil
+}
+
+// Close releases the GCS client.
+func (s *Storage) Close() error {
+	return s.client.Close()
+}
+
+// Ensure Storage implements FileStorage.
+var _ ports.FileStorage = (*Storage)(nil)
@@ -0,0 +1,36 @@
+// Package gcs_test contains unit tests for the GCS storage adapter.
+package gcs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/gcs"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := gcs.DefaultConfig()
+
+	assert.Empty(t, cfg.Bucket)
+	assert.NotZero(t, cfg.URLTTL)
+}
+
+func TestNew(t *testing.T) {
+	t.Run("requires a bucket", func(t *testing.T) {
+		_, err := gcs.New(context.Background(), gcs.Config{})
+		assert.ErrorIs(t, err, gcs.ErrMissingBucket)
+	})
+
+	t.Run("creates a client", func(t *testing.T) {
+		s, err := gcs.New(context.Background(), gcs.Config{
+			Bucket:  "resumes",
+			Options: []option.ClientOption{option.WithoutAuthentication()},
+		})
+		require.NoError(t, err)
+		assert.NoError(t, s.Close())
+	})
+}
@@ -9,9 +9,12 @@ import (
 	"mime/multipart"
 	"net/http"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/circuitbreaker"
 )
 
 const (
@@ -26,13 +29,30 @@ type Config struct {
 
 	// Timeout is the HTTP request timeout.
 	Timeout time.Duration
+
+	// MaxRetries is the maximum number of retries on transient errors.
+	MaxRetries int
+
+	// HealthCheckInterval is how long a successful health check is trusted
+	// before GeneratePDF runs a new pre-flight check.
+	HealthCheckInterval time.Duration
+
+	// CircuitBreaker configures the breaker that fails fast while Gotenberg is down.
+	CircuitBreaker circuitbreaker.Config
+
+	// HTTPClient, when set, is used for Gotenberg requests instead of a client
+	// built from Timeout, e.g. one sharing a pkg/httpclient transport.
+	HTTPClient *http.Client
 }
 
 // DefaultConfig returns a Config with sensible defaults.
 func DefaultConfig() Config {
 	return Config{
-		URL:     "http://localhost:3000",
-		Timeout: 60 * time.Second,
+		URL:                 "http://localhost:3000",
+		Timeout:             60 * time.Second,
+		MaxRetries:          3,
+		HealthCheckInterval: 30 * time.Second,
+		CircuitBreaker:      circuitbreaker.DefaultConfig(),
 	}
 }
 
@@ -41,6 +61,10 @@ type Client struct {
 	config     Config
 	httpClient *http.Client
 	templates  []ports.PDFTemplate
+	breaker    *circuitbreaker.Breaker
+
+	healthMu        sync.Mutex
+	lastHealthyTime time.Time
 }
 
 // New creates a new Gotenberg client.
@@ -51,13 +75,23 @@ func New(cfg Config) (*Client, error) {
 	if cfg.Timeout == 0 {
 		cfg.Timeout = DefaultConfig().Timeout
 	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultConfig().MaxRetries
+	}
+	if cfg.HealthCheckInterval == 0 {
+		cfg.HealthCheckInterval = DefaultConfig().HealthCheckInterval
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
 
 	client := &Client{
-		config: cfg,
-		httpClient: &http.Client{
-			Timeout: cfg.Timeout,
-		},
-		templates: defaultTemplates(),
+		config:     cfg,
+		httpClient: httpClient,
+		templates:  ports.DefaultPDFTemplates(),
+		breaker:    circuitbreaker.New("gotenberg", cfg.CircuitBreaker),
 	}
 
 	return client, nil
@@ -75,6 +109,9 @@ func (c *Client) GeneratePDF(ctx context.Context, req ports.GeneratePDFRequest)
 		// Inject CSS into HTML if provided.
 		htmlContent = injectCSS(htmlContent, req.CSS)
 	}
+	if req.Watermark != "" {
+		htmlContent = injectCSS(htmlContent, ports.WatermarkCSS(req.Watermark))
+	}
 
 	htmlPart, err := writer.CreateFormFile("files", "index.html")
 	if err != nil {
@@ -102,6 +139,9 @@ func (c *Client) GeneratePDF(ctx context.Context, req ports.GeneratePDFRequest)
 		"printBackground":   "true",
 		"preferCssPageSize": "false",
 	}
+	if pages := opts.PageRanges(); pages != "" {
+		formFields["nativePageRanges"] = pages
+	}
 
 	for key, value := range formFields {
 		if err := writer.WriteField(key, value); err != nil {
@@ -113,24 +153,55 @@ func (c *Client) GeneratePDF(ctx context.Context, req ports.GeneratePDFRequest)
 		return nil, fmt.Errorf("gotenberg: failed to close writer: %w", err)
 	}
 
-	// Create request.
-	url := c.config.URL + chromiumEndpoint
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &buf)
+	// Fail fast when Gotenberg is known to be down.
+	done, err := c.breaker.Allow()
 	if err != nil {
-		return nil, fmt.Errorf("gotenberg: failed to create request: %w", err)
+		return nil, breakerOpenError(err)
 	}
-	httpReq.Header.Set("Content-Type", writer.FormDataContentType())
-
-	// Execute request.
-	resp, err := c.httpClient.Do(httpReq)
+	err = c.ensureHealthy(ctx)
+	done(err != nil && ctx.Err() == nil)
 	if err != nil {
-		return nil, fmt.Errorf("gotenberg: request failed: %w", err)
+		return nil, fmt.Errorf("gotenberg: %w: %w", domain.ErrPDFServiceUnavailable, err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		defer resp.Body.Close()
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("gotenberg: conversion failed (status %d): %s", resp.StatusCode, string(body))
+	body := buf.Bytes()
+	contentType := writer.FormDataContentType()
+
+	var (
+		resp    *http.Response
+		lastErr error
+	)
+	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			// Exponential backoff.
+			backoff := time.Duration(1<<uint(attempt-1)) * time.Second
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		done, err := c.breaker.Allow()
+		if err != nil {
+			c.markUnhealthy()
+			return nil, breakerOpenError(err)
+		}
+
+		var retryable bool
+		resp, retryable, lastErr = c.doConvert(ctx, body, contentType)
+		done(retryable)
+		if lastErr == nil {
+			break
+		}
+		if !retryable {
+			return nil, lastErr
+		}
+	}
+
+	if lastErr != nil {
+		c.markUnhealthy()
+		return nil, fmt.Errorf("gotenberg: max retries exceeded: %w: %w", domain.ErrPDFServiceUnavailable, lastErr)
 	}
 
 	// Generate filename.
@@ -146,6 +217,60 @@ func (c *Client) GeneratePDF(ctx context.Context, req ports.GeneratePDFRequest)
 	}, nil
 }
 
+// doConvert sends a single conversion request.
+// It reports whether a failure is transient and worth retrying.
+func (c *Client) doConvert(ctx context.Context, body []byte, contentType string) (*http.Response, bool, error) {
+	url := c.config.URL + chromiumEndpoint
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, false, fmt.Errorf("gotenberg: failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		// Don't retry if the caller gave up.
+		if ctx.Err() != nil {
+			return nil, false, ctx.Err()
+		}
+		return nil, true, fmt.Errorf("gotenberg: request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return nil, retryable, fmt.Errorf("gotenberg: conversion failed (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	return resp, false, nil
+}
+
+// breakerOpenError wraps the error returned while the circuit breaker is open.
+func breakerOpenError(err error) error {
+	return fmt.Errorf("gotenberg: %w: %w: %w", domain.ErrPDFServiceUnavailable, domain.ErrUpstreamUnavailable, err)
+}
+
+// ensureHealthy runs a pre-flight health check unless one succeeded recently.
+func (c *Client) ensureHealthy(ctx context.Context) error {
+	c.healthMu.Lock()
+	lastHealthy := c.lastHealthyTime
+	c.healthMu.Unlock()
+
+	if !lastHealthy.IsZero() && time.Since(lastHealthy) < c.config.HealthCheckInterval {
+		return nil
+	}
+
+	return c.HealthCheck(ctx)
+}
+
+// markUnhealthy forces the next GeneratePDF call to run a pre-flight health check.
+func (c *Client) markUnhealthy() {
+	c.healthMu.Lock()
+	c.lastHealthyTime = time.Time{}
+	c.healthMu.Unlock()
+}
+
 // GetTemplates returns available resume templates.
 func (c *Client) GetTemplates(ctx context.Context) ([]ports.PDFTemplate, error) {
 	return c.templates, nil
@@ -161,14 +286,20 @@ func (c *Client) HealthCheck(ctx context.Context) error {
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		c.markUnhealthy()
 		return fmt.Errorf("gotenberg: health check failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
+		c.markUnhealthy()
 		return fmt.Errorf("gotenberg: unhealthy (status %d)", resp.StatusCode)
 	}
 
+	c.healthMu.Lock()
+	c.lastHealthyTime = time.Now()
+	c.healthMu.Unlock()
+
 	return nil
 }
 
@@ -190,45 +321,3 @@ func injectCSS(html, css string) string {
 	// Fallback: prepend to HTML.
 	return styleTag + html
 }
-
-// defaultTemplates returns the built-in resume templates.
-func defaultTemplates() []ports.PDFTemplate {
-	return []ports.PDFTemplate{
-		{
-			Name:        "jake",
-			DisplayName: "Jake's Resume",
-			Description: "Industry gold standard for developer resumes. Single-page, dense, ATS-friendly format.",
-			PreviewURL:  "/templates/jake/preview.png",
-		},
-		{
-			Name:        "professional",
-			DisplayName: "Professional",
-			Description: "Clean, modern design suitable for most industries. Emphasizes readability and structure.",
-			PreviewURL:  "/templates/professional/preview.png",
-		},
-		{
-			Name:        "minimal",
-			DisplayName: "Minimal",
-			Description: "Simple, elegant layout with plenty of white space. Perfect for creative roles.",
-			PreviewURL:  "/templates/minimal/preview.png",
-		},
-		{
-			Name:        "technical",
-			DisplayName: "Technical",
-			Description: "Optimized for engineering and tech roles. Highlights skills and projects prominently.",
-			PreviewURL:  "/templates/technical/preview.png",
-		},
-		{
-			Name:        "executive",
-			DisplayName: "Executive",
-			Description: "Sophisticated design for senior leadership positions. Emphasizes achievements and impact.",
-			PreviewURL:  "/templates/executive/preview.png",
-		},
-		{
-			Name:        "academic",
-			DisplayName: "Academic",
-			Description: "Traditional CV format for research and academic positions. Supports publications and grants.",
-			PreviewURL:  "/templates/academic/preview.png",
-		},
-	}
-}
@@ -5,13 +5,18 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/gotenberg"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/circuitbreaker"
 )
 
 func TestNew(t *testing.T) {
@@ -33,10 +38,41 @@ func TestNew(t *testing.T) {
 	})
 }
 
+func TestNewUsesProvidedHTTPClient(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		requests.Add(1)
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	client, err := gotenberg.New(gotenberg.Config{
+		URL:        server.URL,
+		HTTPClient: &http.Client{Transport: transport},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, client.HealthCheck(context.Background()))
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
 func TestDefaultConfig(t *testing.T) {
 	cfg := gotenberg.DefaultConfig()
 	assert.Equal(t, "http://localhost:3000", cfg.URL)
 	assert.NotZero(t, cfg.Timeout)
+	assert.Equal(t, 3, cfg.MaxRetries)
+	assert.NotZero(t, cfg.HealthCheckInterval)
 }
 
 func TestClose(t *testing.T) {
@@ -96,6 +132,10 @@ func TestGetTemplateByName(t *testing.T) {
 
 func TestGeneratePDFWithMockServer(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
 		assert.Contains(t, r.Header.Get("Content-Type"), "multipart/form-data")
 		err := r.ParseMultipartForm(10 << 20)
 		if err != nil {
@@ -153,6 +193,74 @@ func TestGeneratePDFWithMockServer(t *testing.T) {
 	})
 }
 
+func TestGeneratePDFWatermark(t *testing.T) {
+	var html string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		file, _, err := r.FormFile("files")
+		if err != nil {
+			http.Error(w, "missing file", http.StatusBadRequest)
+			return
+		}
+		content, _ := io.ReadAll(file)
+		html = string(content)
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write([]byte("%PDF-1.4 mock pdf content"))
+	}))
+	defer server.Close()
+
+	client, err := gotenberg.New(gotenberg.Config{URL: server.URL})
+	require.NoError(t, err)
+
+	result, err := client.GeneratePDF(context.Background(), ports.GeneratePDFRequest{
+		HTML:      "<html><head></head><body><h1>Draft Resume</h1></body></html>",
+		Watermark: `RASCUNHO "v2"</style>`,
+	})
+	require.NoError(t, err)
+	result.Content.Close()
+
+	assert.Contains(t, html, `content: "RASCUNHO \"v2\"\3C /style>";`)
+	assert.Equal(t, 1, strings.Count(html, "</style>"), "the watermark text must not close the style tag")
+	assert.Less(t, strings.Index(html, "body::after"), strings.Index(html, "</head>"))
+}
+
+func TestGeneratePDFPageRanges(t *testing.T) {
+	var pageRanges []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			http.Error(w, "bad form", http.StatusBadRequest)
+			return
+		}
+		pageRanges = append(pageRanges, r.FormValue("nativePageRanges"))
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write([]byte("%PDF-1.4 mock pdf content"))
+	}))
+	defer server.Close()
+
+	client, err := gotenberg.New(gotenberg.Config{URL: server.URL})
+	require.NoError(t, err)
+
+	for _, maxPages := range []int{0, 2} {
+		opts := ports.DefaultPDFOptions()
+		opts.MaxPages = maxPages
+		result, err := client.GeneratePDF(context.Background(), ports.GeneratePDFRequest{
+			HTML:    "<html><body><h1>Resume</h1></body></html>",
+			Options: opts,
+		})
+		require.NoError(t, err)
+		result.Content.Close()
+	}
+
+	assert.Equal(t, []string{"", "1-2"}, pageRanges)
+}
+
 func TestHealthCheck(t *testing.T) {
 	t.Run("healthy server", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -207,3 +315,109 @@ func TestGeneratePDFErrors(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestGeneratePDFRetries(t *testing.T) {
+	t.Run("retries transient failures", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/health" {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			if attempts.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("%PDF-1.4 mock pdf content"))
+		}))
+		defer server.Close()
+
+		client, err := gotenberg.New(gotenberg.Config{URL: server.URL, MaxRetries: 2})
+		require.NoError(t, err)
+
+		result, err := client.GeneratePDF(context.Background(), ports.GeneratePDFRequest{
+			HTML: "<html><body>Test</body></html>",
+		})
+		require.NoError(t, err)
+		result.Content.Close()
+		assert.Equal(t, int32(2), attempts.Load())
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/health" {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			attempts.Add(1)
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		client, err := gotenberg.New(gotenberg.Config{URL: server.URL})
+		require.NoError(t, err)
+
+		_, err = client.GeneratePDF(context.Background(), ports.GeneratePDFRequest{
+			HTML: "<html><body>Test</body></html>",
+		})
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, domain.ErrPDFServiceUnavailable)
+		assert.Equal(t, int32(1), attempts.Load())
+	})
+
+	t.Run("gates on failed health check", func(t *testing.T) {
+		var conversions atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/health" {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			conversions.Add(1)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client, err := gotenberg.New(gotenberg.Config{URL: server.URL})
+		require.NoError(t, err)
+
+		_, err = client.GeneratePDF(context.Background(), ports.GeneratePDFRequest{
+			HTML: "<html><body>Test</body></html>",
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, domain.ErrPDFServiceUnavailable)
+		assert.Zero(t, conversions.Load())
+	})
+}
+
+func TestGeneratePDFCircuitBreaker(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := gotenberg.New(gotenberg.Config{
+		URL: server.URL,
+		CircuitBreaker: circuitbreaker.Config{
+			FailureThreshold: 2,
+			OpenTimeout:      time.Minute,
+		},
+	})
+	require.NoError(t, err)
+
+	req := ports.GeneratePDFRequest{HTML: "<html><body>Test</body></html>"}
+	for range 2 {
+		_, err = client.GeneratePDF(context.Background(), req)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, domain.ErrUpstreamUnavailable)
+	}
+
+	_, err = client.GeneratePDF(context.Background(), req)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrUpstreamUnavailable)
+	assert.ErrorIs(t, err, domain.ErrPDFServiceUnavailable)
+	assert.Equal(t, int32(2), requests.Load(), "open breaker must not reach the server")
+}
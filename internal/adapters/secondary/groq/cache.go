@@ -0,0 +1,105 @@
+package groq
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// temperatureBucket is the granularity used to group temperatures in cache keys.
+const temperatureBucket = 0.1
+
+// cacheEntry is a cached completion.
+type cacheEntry struct {
+	response  string
+	expiresAt time.Time
+}
+
+// responseCache is an in-memory TTL cache of completions that parsed
+// successfully, so identical requests don't re-bill the same completion.
+type responseCache struct {
+	ttl        time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+// newResponseCache creates a cache. A non-positive TTL disables caching.
+func newResponseCache(ttl time.Duration, maxEntries int) *responseCache {
+	if ttl <= 0 {
+		return nil
+	}
+
+	return &responseCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]cacheEntry),
+	}
+}
+
+// cacheKey builds a key from the model, a hash of the prompt and the temperature bucket.
+func cacheKey(model, prompt string, temperature float64) string {
+	sum := sha256.Sum256([]byte(prompt))
+	bucket := int(math.Round(temperature / temperatureBucket))
+	return fmt.Sprintf("%s|%s|%d", model, hex.EncodeToString(sum[:]), bucket)
+}
+
+// get returns a cached response if present and not expired.
+func (c *responseCache) get(key string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return "", false
+	}
+
+	return entry.response, true
+}
+
+// set stores a response, evicting expired entries (and, if still full,
+// the entry closest to expiry) when the cache is at capacity.
+func (c *responseCache) set(key, response string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+
+	if c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		var oldestKey string
+		var oldest time.Time
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+				continue
+			}
+			if oldestKey == "" || entry.expiresAt.Before(oldest) {
+				oldestKey, oldest = k, entry.expiresAt
+			}
+		}
+		if len(c.entries) >= c.maxEntries {
+			delete(c.entries, oldestKey)
+		}
+	}
+
+	c.entries[key] = cacheEntry{
+		response:  response,
+		expiresAt: now.Add(c.ttl),
+	}
+}
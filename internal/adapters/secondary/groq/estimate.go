@@ -0,0 +1,127 @@
+package groq
+
+import (
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// ModelPrice is the price of a model, in USD per million tokens.
+type ModelPrice struct {
+	Input  float64
+	Output float64
+}
+
+// Approximate tokens of the instructions, schema and job analysis of the
+// prompts of a tailoring run, besides the text they are given, and of the
+// fixed part of their replies.
+const (
+	analyzeJobPromptTokens = 300
+	analyzeJobReplyTokens  = 400
+	selectionReplyTokens   = 30
+	selectionReasonTokens  = 25
+	tailorPromptTokens     = 500
+	tailorReplyTokens      = 20
+	summaryPromptTokens    = 650
+	summaryReplyTokens     = 300
+	scorePromptTokens      = 250
+	scoreReplyTokens       = 150
+	themesPromptTokens     = 300
+	themesReplyTokens      = 30
+	themesBulletTokens     = 15
+)
+
+// EstimateTailoring estimates the calls of a tailoring run from the sizes of
+// the job description and bullets: the job analysis, the bullet selection in
+// as many chunks as SelectBullets would use, a rewrite per selected bullet,
+// the summary, the match score and the skill themes. Selected bullets are
+// assumed to be of average length.
+func (c *Client) EstimateTailoring(req ports.EstimateTailoringRequest) *ports.TailoringEstimate {
+	estimate := &ports.TailoringEstimate{}
+	add := func(op aiOperation, model string, count, input, output int) {
+		if count == 0 {
+			return
+		}
+		estimate.Calls = append(estimate.Calls, ports.AICallEstimate{
+			Operation:    op.name,
+			Model:        model,
+			Count:        count,
+			InputTokens:  input,
+			OutputTokens: output,
+			CostUSD:      c.cost(model, input, output),
+		})
+		estimate.InputTokens += input
+		estimate.OutputTokens += output
+	}
+
+	add(opAnalyzeJob, c.config.ModelAnalysis, 1,
+		analyzeJobPromptTokens+estimateTokens(req.JobDescription), analyzeJobReplyTokens)
+
+	selected := min(req.MaxBullets, len(req.Bullets))
+	bulletTokens := 0
+	for _, bullet := range req.Bullets {
+		bulletTokens += estimateTokens(bullet.Content)
+	}
+	average := 0
+	if len(req.Bullets) > 0 {
+		average = bulletTokens / len(req.Bullets)
+	}
+
+	// Chunked selections end with a round among the finalists of each
+	// chunk, counted once.
+	selection := ports.SelectBulletsRequest{JobAnalysis: &ports.JobAnalysis{}, MaxBullets: req.MaxBullets}
+	if len(req.Bullets) > 0 {
+		chunks := c.selectionChunks(selection, req.Bullets)
+		calls, input, output := 0, 0, 0
+		for _, chunk := range chunks {
+			calls++
+			input += estimateTokens(selectionPrompt(selection, chunk))
+			output += selectionReplyTokens + min(req.MaxBullets, len(chunk))*selectionReasonTokens
+		}
+		if len(chunks) > 1 {
+			finalists := make([]domain.Bullet, min(req.MaxBullets*len(chunks), len(req.Bullets)))
+			calls++
+			input += estimateTokens(selectionPrompt(selection, finalists)) + len(finalists)*average
+			output += selectionReplyTokens + selected*selectionReasonTokens
+		}
+		add(opSelectBullets, c.config.ModelAnalysis, calls, input, output)
+	}
+
+	rewrite := average
+	if req.Translate {
+		rewrite *= 2
+	}
+	add(opTailorBullet, c.config.ModelGeneration, selected,
+		selected*(tailorPromptTokens+average), selected*(tailorReplyTokens+rewrite))
+
+	if selected > 0 {
+		add(opGenerateSummary, c.config.ModelGeneration, 1,
+			summaryPromptTokens+selected*average, summaryReplyTokens)
+		add(opScoreMatch, c.config.ModelAnalysis, 1,
+			scorePromptTokens+selected*average, scoreReplyTokens)
+		add(opGroupBulletsByTheme, c.config.ModelAnalysis, 1,
+			themesPromptTokens+selected*average, themesReplyTokens+selected*themesBulletTokens)
+	}
+
+	for _, call := range estimate.Calls {
+		if call.CostUSD == nil {
+			return estimate
+		}
+	}
+	total := 0.0
+	for _, call := range estimate.Calls {
+		total += *call.CostUSD
+	}
+	estimate.CostUSD = &total
+	return estimate
+}
+
+// cost returns the cost in USD of tokens of model, or nil when its price
+// is not known.
+func (c *Client) cost(model string, input, output int) *float64 {
+	price, ok := c.config.Prices[model]
+	if !ok || (price.Input == 0 && price.Output == 0) {
+		return nil
+	}
+	cost := (float64(input)*price.Input + float64(output)*price.Output) / 1e6
+	return &cost
+}
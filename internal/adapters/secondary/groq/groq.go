@@ -10,16 +10,111 @@ import (
 	"log"
 	"net/http"
 	"regexp" // <--- ADDED: Required for the new cleanJSON function
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/circuitbreaker"
 )
 
 const (
 	baseURL          = "https://api.groq.com/openai/v1"
 	defaultMaxTokens = 4096
+
+	// repairTemperature is used when asking the model to fix its own output.
+	repairTemperature = 0.0
+)
+
+// JSON response schemas shown to the model, both in the original prompts
+// and in repair requests.
+const (
+	jobAnalysisSchema = `{
+  "title": "extracted job title",
+  "company": "company name if found",
+  "required_skills": ["list", "of", "required", "skills"],
+  "preferred_skills": ["list", "of", "nice-to-have", "skills"],
+  "keywords": ["important", "keywords", "from", "description"],
+  "seniority_level": "junior/mid/senior/lead/executive",
+  "years_experience": null or number,
+  "summary": "brief 2-3 sentence summary of the role",
+  "salary": null or {"min": number or null, "max": number or null, "currency": "ISO 4217 code", "period": "year/month/hour"},
+  "benefits": ["benefits mentioned, e.g. health insurance, stock options"],
+  "remote_policy": "remote/hybrid/onsite, or null if not stated",
+  "visa_sponsorship": true, false, or null if not mentioned
+}`
+
+	bulletSelectionSchema = `{
+  "selected_bullet_ids": ["id1", "id2", ...],
+  "reasoning": "Brief explanation of selection strategy",
+  "bullet_reasons": {"id1": "One short sentence on why it was chosen or dropped", ...}
+}`
+
+	tailoredBulletSchema = `{
+  "tailored_content": "The optimized bullet string with **markdown** formatting",
+  "keywords": ["list", "of", "keywords", "used"]
+}`
+
+	translatedBulletSchema = `{
+  "tailored_content": "The optimized bullet string with **markdown** formatting",
+  "translated_content": "The same optimized bullet translated, keeping the **markdown** formatting",
+  "keywords": ["list", "of", "keywords", "used"]
+}`
+
+	summarySchema = `{
+  "variants": [
+    {"angle": "technical", "summary": "the summary leading with technical depth, with **bold** highlights"},
+    {"angle": "leadership", "summary": "the summary leading with ownership and leadership, with **bold** highlights"},
+    {"angle": "impact", "summary": "the summary leading with business impact and results, with **bold** highlights"}
+  ]
+}`
+
+	matchScoreSchema = `{
+  "score": 85,
+  "breakdown": {
+    "skills": 90,
+    "experience": 80,
+    "seniority": 85,
+    "keywords": 75
+  },
+  "explanation": "Brief explanation of the score"
+}`
+
+	resumeCritiqueSchema = `{
+  "score": 72,
+  "verdict": "interview/maybe/reject",
+  "summary": "2-3 sentence overall impression",
+  "strengths": ["what makes this candidate stand out"],
+  "weaknesses": ["what is missing or unconvincing"],
+  "red_flags": ["anything that would make you hesitate, or an empty list"],
+  "suggested_edits": [
+    {
+      "section": "summary, skills, or experience: <title> at <organization>",
+      "original": "current text, or empty when adding content",
+      "suggested": "the improved text",
+      "reason": "why this edit helps for this job"
+    }
+  ]
+}`
+
+	bulletThemesSchema = `{
+  "themes": [
+    {"name": "short skill theme name", "bullet_ids": ["id1", "id2"]}
+  ]
+}`
+
+	shortenedTextsSchema = `{
+  "shortened": [
+    {"id": "id1", "text": "the shortened text"}
+  ]
+}`
+
+	bulletImpactSchema = `{
+  "scores": [
+    {"id": "id1", "score": 72}
+  ]
+}`
 )
 
 // Config holds Groq API configuration.
@@ -27,6 +122,9 @@ type Config struct {
 	// APIKey is the Groq API key.
 	APIKey string
 
+	// BaseURL is the OpenAI-compatible API base URL.
+	BaseURL string
+
 	// ModelGeneration is the model used for content generation (summary, tailoring).
 	ModelGeneration string
 
@@ -36,17 +134,58 @@ type Config struct {
 	// MaxRetries is the maximum number of retries on rate limit errors.
 	MaxRetries int
 
+	// MaxRepairAttempts is how many times a reply that isn't valid JSON is
+	// sent back to the model for repair before failing. Negative disables repair.
+	MaxRepairAttempts int
+
 	// Timeout is the HTTP request timeout.
 	Timeout time.Duration
+
+	// CacheTTL enables caching of identical completions for this long.
+	// Zero disables the cache.
+	CacheTTL time.Duration
+
+	// CacheMaxEntries caps the number of cached completions.
+	CacheMaxEntries int
+
+	// MaxConcurrentRequests caps in-flight API requests across all callers.
+	// Excess requests queue, interactive before background. Negative disables the limit.
+	MaxConcurrentRequests int
+
+	// MaxPromptTokens is the estimated size, in tokens, a bullet selection
+	// prompt may reach. Larger bullet libraries are selected in chunks and a
+	// merge round. Negative disables chunking.
+	MaxPromptTokens int
+
+	// Prices are the prices of models by name, for tailoring estimates.
+	// Estimates leave out the cost of models without a price.
+	Prices map[string]ModelPrice
+
+	// CircuitBreaker configures the breaker that fails fast while the API is down.
+	CircuitBreaker circuitbreaker.Config
+
+	// HTTPClient, when set, is used for API requests instead of a client
+	// built from Timeout, e.g. one sharing a pkg/httpclient transport.
+	HTTPClient *http.Client
 }
 
 // DefaultConfig returns a Config with sensible defaults.
 func DefaultConfig() Config {
 	return Config{
-		ModelGeneration: "llama-3.3-70b-versatile",
-		ModelAnalysis:   "meta-llama/llama-4-scout-17b-16e-instruct",
-		MaxRetries:      3,
-		Timeout:         60 * time.Second,
+		BaseURL:               baseURL,
+		ModelGeneration:       "llama-3.3-70b-versatile",
+		ModelAnalysis:         "meta-llama/llama-4-scout-17b-16e-instruct",
+		MaxRetries:            3,
+		MaxRepairAttempts:     2,
+		Timeout:               60 * time.Second,
+		CacheMaxEntries:       1000,
+		MaxConcurrentRequests: 4,
+		MaxPromptTokens:       8000,
+		CircuitBreaker:        circuitbreaker.DefaultConfig(),
+		Prices: map[string]ModelPrice{
+			"llama-3.3-70b-versatile":                   {Input: 0.59, Output: 0.79},
+			"meta-llama/llama-4-scout-17b-16e-instruct": {Input: 0.11, Output: 0.34},
+		},
 	}
 }
 
@@ -54,6 +193,9 @@ func DefaultConfig() Config {
 type Client struct {
 	config     Config
 	httpClient *http.Client
+	cache      *responseCache
+	limiter    *requestLimiter
+	breaker    *circuitbreaker.Breaker
 }
 
 // New creates a new Groq API client.
@@ -62,6 +204,10 @@ func New(cfg Config) (*Client, error) {
 		return nil, fmt.Errorf("groq: API key is required")
 	}
 
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultConfig().BaseURL
+	}
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
 	if cfg.ModelGeneration == "" {
 		cfg.ModelGeneration = DefaultConfig().ModelGeneration
 	}
@@ -71,15 +217,36 @@ func New(cfg Config) (*Client, error) {
 	if cfg.MaxRetries == 0 {
 		cfg.MaxRetries = DefaultConfig().MaxRetries
 	}
+	if cfg.MaxRepairAttempts == 0 {
+		cfg.MaxRepairAttempts = DefaultConfig().MaxRepairAttempts
+	}
 	if cfg.Timeout == 0 {
 		cfg.Timeout = DefaultConfig().Timeout
 	}
+	if cfg.CacheMaxEntries == 0 {
+		cfg.CacheMaxEntries = DefaultConfig().CacheMaxEntries
+	}
+	if cfg.MaxConcurrentRequests == 0 {
+		cfg.MaxConcurrentRequests = DefaultConfig().MaxConcurrentRequests
+	}
+	if cfg.MaxPromptTokens == 0 {
+		cfg.MaxPromptTokens = DefaultConfig().MaxPromptTokens
+	}
+	if cfg.Prices == nil {
+		cfg.Prices = DefaultConfig().Prices
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
 
 	return &Client{
-		config: cfg,
-		httpClient: &http.Client{
-			Timeout: cfg.Timeout,
-		},
+		config:     cfg,
+		httpClient: httpClient,
+		cache:      newResponseCache(cfg.CacheTTL, cfg.CacheMaxEntries),
+		limiter:    newRequestLimiter(cfg.MaxConcurrentRequests),
+		breaker:    circuitbreaker.New("groq", cfg.CircuitBreaker),
 	}, nil
 }
 
@@ -91,23 +258,12 @@ Job Description:
 %s
 
 Provide a JSON response with the following structure:
-{
-  "title": "extracted job title",
-  "company": "company name if found",
-  "required_skills": ["list", "of", "required", "skills"],
-  "preferred_skills": ["list", "of", "nice-to-have", "skills"],
-  "keywords": ["important", "keywords", "from", "description"],
-  "seniority_level": "junior/mid/senior/lead/executive",
-  "years_experience": null or number,
-  "summary": "brief 2-3 sentence summary of the role"
-}
+%s
 
-IMPORTANT: Respond ONLY with valid JSON. Do not include markdown formatting or additional text.`, req.JobDescription)
+Only report salary, benefits, remote policy and visa sponsorship when the description states them; never guess.
+Salary amounts are plain numbers in the stated currency (e.g. "$120k" is 120000).
 
-	response, err := c.chatCompletion(ctx, c.config.ModelAnalysis, prompt, 0.3)
-	if err != nil {
-		return nil, fmt.Errorf("groq: analyze job failed: %w", err)
-	}
+IMPORTANT: Respond ONLY with valid JSON. Do not include markdown formatting or additional text.`, req.JobDescription, jobAnalysisSchema)
 
 	var result struct {
 		Title           string   `json:"title"`
@@ -118,12 +274,26 @@ IMPORTANT: Respond ONLY with valid JSON. Do not include markdown formatting or a
 		SeniorityLevel  string   `json:"seniority_level"`
 		YearsExperience *int     `json:"years_experience"`
 		Summary         string   `json:"summary"`
+
+		Salary          *domain.SalaryRange `json:"salary"`
+		Benefits        []string            `json:"benefits"`
+		RemotePolicy    *string             `json:"remote_policy"`
+		VisaSponsorship *bool               `json:"visa_sponsorship"`
 	}
 
-	// Uses cleanJSON to ensure we get the valid block
-	if err := json.Unmarshal([]byte(cleanJSON(response)), &result); err != nil {
-		log.Printf("json to parse: %s", cleanJSON(response))
-		return nil, fmt.Errorf("groq: failed to parse job analysis: %w", err)
+	if err := c.completeJSON(ctx, opAnalyzeJob, c.config.ModelAnalysis, prompt, 0.3, jobAnalysisSchema, &result); err != nil {
+		return nil, fmt.Errorf("groq: analyze job failed: %w", err)
+	}
+
+	insights := &domain.JobInsights{
+		Salary:          result.Salary,
+		Benefits:        result.Benefits,
+		RemotePolicy:    domain.RemotePolicy(stringPtr(result.RemotePolicy)),
+		VisaSponsorship: result.VisaSponsorship,
+	}
+	insights.Normalize()
+	if insights.IsEmpty() {
+		insights = nil
 	}
 
 	return &ports.JobAnalysis{
@@ -135,18 +305,49 @@ IMPORTANT: Respond ONLY with valid JSON. Do not include markdown formatting or a
 		SeniorityLevel:  result.SeniorityLevel,
 		YearsExperience: result.YearsExperience,
 		Summary:         result.Summary,
+		Insights:        insights,
 	}, nil
 }
 
 // SelectBullets selects the most relevant bullets for a job description.
+// Bullets that do not fit MaxPromptTokens in one prompt are selected in
+// chunks; see selectBullets.
 func (c *Client) SelectBullets(ctx context.Context, req ports.SelectBulletsRequest) (*ports.BulletSelection, error) {
+	selection, err := c.selectBullets(ctx, req, req.AvailableBullets)
+	if err != nil {
+		return nil, fmt.Errorf("groq: select bullets failed: %w", err)
+	}
+	return selection, nil
+}
+
+// selectBulletsOnce asks the model to select among bullets in one prompt.
+func (c *Client) selectBulletsOnce(ctx context.Context, req ports.SelectBulletsRequest, bullets []domain.Bullet) (*ports.BulletSelection, error) {
+	var result struct {
+		SelectedBulletIDs []string          `json:"selected_bullet_ids"`
+		Reasoning         string            `json:"reasoning"`
+		BulletReasons     map[string]string `json:"bullet_reasons"`
+	}
+
+	if err := c.completeJSON(ctx, opSelectBullets, c.config.ModelAnalysis, selectionPrompt(req, bullets), 0.3, bulletSelectionSchema, &result); err != nil {
+		return nil, err
+	}
+
+	return &ports.BulletSelection{
+		SelectedBulletIDs: result.SelectedBulletIDs,
+		Reasoning:         result.Reasoning,
+		BulletReasons:     result.BulletReasons,
+	}, nil
+}
+
+// selectionPrompt returns the bullet selection prompt listing bullets.
+func selectionPrompt(req ports.SelectBulletsRequest, bullets []domain.Bullet) string {
 	var bulletsText strings.Builder
-	for i, bullet := range req.AvailableBullets {
-		fmt.Fprintf(&bulletsText, "%d. [ID: %s] %s\n", i+1, bullet.ID, bullet.Content)
+	for i, bullet := range bullets {
+		bulletsText.WriteString(selectionLine(i, bullet))
 	}
 
 	// Updated Prompt to discourage "Chain of Thought" output
-	prompt := fmt.Sprintf(`You are an expert resume consultant. Select the most relevant experience bullets for this job.
+	return fmt.Sprintf(`You are an expert resume consultant. Select the most relevant experience bullets for this job.
 
 JOB REQUIREMENTS:
 - Title: %s
@@ -156,7 +357,9 @@ JOB REQUIREMENTS:
 - Keywords: %s
 - Summary: %s
 
-AVAILABLE BULLETS:
+CANDIDATE'S HIGHLIGHTED SKILLS: %s
+
+AVAILABLE BULLETS (most recent and highest impact first):
 %s
 
 Select up to %d bullets that best match this job. Prioritize:
@@ -164,53 +367,50 @@ Select up to %d bullets that best match this job. Prioritize:
 2. Quantifiable achievements
 3. Relevant industry experience
 4. Leadership/impact indicators
+5. The candidate's highlighted skills, when they are relevant to the job
 
 IMPORTANT RULES:
 1. Return ONLY the final JSON object.
 2. Do not output draft JSONs or reasoning text outside the JSON.
 3. If no bullets match perfectly, select the closest ones and explain in "reasoning".
+4. In "bullet_reasons", give every available bullet ID one short sentence on why it was chosen or dropped.
 
 Respond with JSON:
-{
-  "selected_bullet_ids": ["id1", "id2", ...],
-  "reasoning": "Brief explanation of selection strategy"
-}`,
+%s`,
 		req.JobAnalysis.Title,
 		req.JobAnalysis.Company,
 		strings.Join(req.JobAnalysis.RequiredSkills, ", "),
 		strings.Join(req.JobAnalysis.PreferredSkills, ", "),
 		strings.Join(req.JobAnalysis.Keywords, ", "),
 		req.JobAnalysis.Summary,
+		orNone(req.HighlightedSkills),
 		bulletsText.String(),
 		req.MaxBullets,
+		bulletSelectionSchema,
 	)
-
-	response, err := c.chatCompletion(ctx, c.config.ModelAnalysis, prompt, 0.3)
-	if err != nil {
-		return nil, fmt.Errorf("groq: select bullets failed: %w", err)
-	}
-
-	var result struct {
-		SelectedBulletIDs []string `json:"selected_bullet_ids"`
-		Reasoning         string   `json:"reasoning"`
-	}
-
-	// cleanedResponse handles cases where the model "thinks" before outputting JSON
-	cleanedResponse := cleanJSON(response)
-
-	if err := json.Unmarshal([]byte(cleanedResponse), &result); err != nil {
-		log.Printf("json to parse: %s", cleanedResponse)
-		return nil, fmt.Errorf("groq: failed to parse bullet selection: %w", err)
-	}
-
-	return &ports.BulletSelection{
-		SelectedBulletIDs: result.SelectedBulletIDs,
-		Reasoning:         result.Reasoning,
-	}, nil
 }
 
 // TailorBullet rewrites a bullet to better match job requirements.
 func (c *Client) TailorBullet(ctx context.Context, req ports.TailorBulletRequest) (*ports.TailoredBulletResult, error) {
+	language := req.TargetLanguage
+	schema := tailoredBulletSchema
+	translation := ""
+	if req.Translates() {
+		language = req.SourceLanguage
+		schema = translatedBulletSchema
+		translation = fmt.Sprintf(`
+TRANSLATION (REQUIRED):
+Also provide "translated_content": the optimized bullet translated to %s. Keep the meaning, metrics and bolded terms; do not translate technology names.
+`, req.TargetLanguage)
+	}
+	preferences := ""
+	if len(req.WritingPreferences) > 0 {
+		preferences = fmt.Sprintf(`
+USER'S WRITING PREFERENCES (learned from their feedback on earlier bullets; follow them over the defaults above):
+- %s
+`, strings.Join(req.WritingPreferences, "\n- "))
+	}
+
 	prompt := fmt.Sprintf(`You are an expert Resume Writer and STAR Method Specialist. Your task is to optimize a specific experience bullet point.
 
 ORIGINAL BULLET:
@@ -227,7 +427,7 @@ TASK INSTRUCTIONS:
    - *If YES (it has a clear action and quantifiable result):* Keep the structure close to the original. Do not rewrite unnecessary parts.
    - *If NO (it is vague, e.g., "Worked on API"):* Rewrite it to include a specific **Action** and a measurable **Result** (e.g., "Architected a REST API handling **10k requests/sec**").
 3. **Keyword Integration:** Naturally weave in the provided keywords if they fit the context.
-4. **Style:** Write strictly in %s.
+4. **Style:** Use a %s style and write strictly in %s.
 
 SMART BOLDING (CRITICAL):
 Apply **bold** markdown syntax to specific high-value terms. Use bolding for:
@@ -235,41 +435,43 @@ Apply **bold** markdown syntax to specific high-value terms. Use bolding for:
 - **Quantifiable Metrics:** (e.g., **30%% reduction**, **500ms**, **$1M revenue**)
 - **Strong Action Verbs:** (e.g., **Orchestrated**, **Deployed**, **Optimized**)
 *Constraint:* Limit to 3-5 bolded terms per bullet to ensure readability.
-
+%s%s%s%s
 IMPORTANT: Return ONLY the final JSON. No markdown blocks, no intro text.
 
 Response format (JSON ONLY):
-{
-  "tailored_content": "The optimized bullet string with **markdown** formatting",
-  "keywords": ["list", "of", "keywords", "used"]
-}`,
+%s`,
 		req.Bullet.Content,
 		req.JobAnalysis.Title,
 		strings.Join(req.JobAnalysis.RequiredSkills, ", "),
 		strings.Join(req.JobAnalysis.Keywords, ", "),
 		req.Style,
+		language,
+		preferences,
+		writingVoice(req.Voice),
+		userInstructions(req.Instructions),
+		translation,
+		schema,
 	)
 
-	response, err := c.chatCompletion(ctx, c.config.ModelGeneration, prompt, 0.7)
-	if err != nil {
-		return nil, fmt.Errorf("groq: tailor bullet failed: %w", err)
-	}
-
 	var result struct {
-		TailoredContent string   `json:"tailored_content"`
-		Keywords        []string `json:"keywords"`
+		TailoredContent   string   `json:"tailored_content"`
+		TranslatedContent string   `json:"translated_content"`
+		Keywords          []string `json:"keywords"`
 	}
 
-	if err := json.Unmarshal([]byte(cleanJSON(response)), &result); err != nil {
-		log.Printf("json to parse: %s", cleanJSON(response))
-		return nil, fmt.Errorf("groq: failed to parse tailored bullet: %w", err)
+	if err := c.completeJSON(ctx, opTailorBullet, c.config.ModelGeneration, prompt, 0.7, schema, &result); err != nil {
+		return nil, fmt.Errorf("groq: tailor bullet failed: %w", err)
 	}
 
-	return &ports.TailoredBulletResult{
+	tailored := &ports.TailoredBulletResult{
 		OriginalID:      req.Bullet.ID,
 		TailoredContent: result.TailoredContent,
 		Keywords:        result.Keywords,
-	}, nil
+	}
+	if req.Translates() {
+		tailored.TranslatedContent = result.TranslatedContent
+	}
+	return tailored, nil
 }
 
 // GenerateSummary generates a professional summary tailored to the job.
@@ -290,6 +492,7 @@ CANDIDATE INFO:
 - Name: %s
 - Headline: %s
 - Current Summary: %s
+- Highlighted Skills: %s
 
 KEY ACHIEVEMENTS (selected for this job):
 %s
@@ -300,12 +503,18 @@ TARGET JOB:
 - Required Skills: %s
 - Summary: %s
 
-Write a compelling 3-4 sentence professional summary that:
-1. Highlights relevant experience and skills
+Write three compelling 3-4 sentence professional summaries, each from a different angle:
+- technical: leads with technical depth, domains and tools
+- leadership: leads with ownership, mentoring and leading teams or initiatives
+- impact: leads with business outcomes and measurable results
+
+Each summary:
+1. Highlights relevant experience and skills, featuring the candidate's highlighted skills where they fit the job
 2. Incorporates key achievements
 3. Aligns with the target job requirements
 4. Uses confident, professional language
 5. Is written in %s
+6. Uses only facts from the candidate info and achievements above, whatever its angle
 
 SMART BOLDING (REQUIRED):
 Apply **bold** markdown syntax to highlight:
@@ -313,42 +522,59 @@ Apply **bold** markdown syntax to highlight:
 - Key technical domains (e.g., **distributed systems**, **machine learning**)
 - Core competencies (e.g., **architecting**, **scaling**, **leading teams**)
 - Notable achievements or metrics (e.g., **Fortune 500**, **$10M revenue**)
-Use sparingly - maximum 4-6 bold terms in the summary to maintain readability.
-
+Use sparingly - maximum 4-6 bold terms in each summary to maintain readability.
+%s%s
 IMPORTANT: Respond ONLY with valid JSON.
 
 Respond with JSON:
-{
-  "summary": "the generated professional summary with **bold** highlights"
-}`,
+%s`,
 		userName,
 		stringPtr(req.User.Headline),
 		stringPtr(req.User.Summary),
+		orNone(req.HighlightedSkills),
 		bulletsContext.String(),
 		req.JobAnalysis.Title,
 		req.JobAnalysis.Company,
 		strings.Join(req.JobAnalysis.RequiredSkills, ", "),
 		req.JobAnalysis.Summary,
 		req.TargetLanguage,
+		writingVoice(req.Voice),
+		userInstructions(req.Instructions),
+		summarySchema,
 	)
 
-	response, err := c.chatCompletion(ctx, c.config.ModelGeneration, prompt, 0.8)
-	if err != nil {
-		return nil, fmt.Errorf("groq: generate summary failed: %w", err)
+	// Summary is read too, in case the model answers with a single
+	// summary.
+	var result struct {
+		Summary  string                  `json:"summary"`
+		Variants []domain.SummaryVariant `json:"variants"`
 	}
 
-	var result struct {
-		Summary string `json:"summary"`
+	if err := c.completeJSON(ctx, opGenerateSummary, c.config.ModelGeneration, prompt, 0.8, summarySchema, &result); err != nil {
+		return nil, fmt.Errorf("groq: generate summary failed: %w", err)
 	}
 
-	if err := json.Unmarshal([]byte(cleanJSON(response)), &result); err != nil {
-		log.Printf("json to parse: %s", cleanJSON(response))
-		return nil, fmt.Errorf("groq: failed to parse summary: %w", err)
+	variants := summaryVariants(result.Variants)
+	if len(variants) == 0 {
+		return &ports.SummaryResult{Summary: result.Summary}, nil
 	}
+	return &ports.SummaryResult{Summary: variants[0].Summary, Variants: variants}, nil
+}
 
-	return &ports.SummaryResult{
-		Summary: result.Summary,
-	}, nil
+// summaryVariants returns the non-empty variants of the known angles, in
+// the order of domain.SummaryAngles, the first of each angle winning.
+func summaryVariants(variants []domain.SummaryVariant) []domain.SummaryVariant {
+	var ordered []domain.SummaryVariant
+	for _, angle := range domain.SummaryAngles {
+		for _, variant := range variants {
+			summary := strings.TrimSpace(variant.Summary)
+			if strings.EqualFold(strings.TrimSpace(variant.Angle), angle) && summary != "" {
+				ordered = append(ordered, domain.SummaryVariant{Angle: angle, Summary: summary})
+				break
+			}
+		}
+	}
+	return ordered
 }
 
 // ScoreMatch calculates a match score between resume and job.
@@ -364,7 +590,7 @@ func (c *Client) ScoreMatch(ctx context.Context, req ports.ScoreMatchRequest) (*
 		for _, exp := range req.Resume.Experiences {
 			fmt.Fprintf(&experiencesText, "%s at %s:\n", exp.Title, exp.Organization)
 			for _, bullet := range exp.Bullets {
-				fmt.Fprintf(&experiencesText, "  - %s\n", bullet.TailoredContent)
+				fmt.Fprintf(&experiencesText, "  - %s\n", bullet.DisplayContent())
 			}
 		}
 	}
@@ -393,16 +619,7 @@ Analyze the match and provide a score from 0-100 based on:
 IMPORTANT: Respond ONLY with valid JSON.
 
 Respond with JSON:
-{
-  "score": 85,
-  "breakdown": {
-    "skills": 90,
-    "experience": 80,
-    "seniority": 85,
-    "keywords": 75
-  },
-  "explanation": "Brief explanation of the score"
-}`,
+%s`,
 		req.JobAnalysis.Title,
 		strings.Join(req.JobAnalysis.RequiredSkills, ", "),
 		strings.Join(req.JobAnalysis.PreferredSkills, ", "),
@@ -410,20 +627,90 @@ Respond with JSON:
 		req.JobAnalysis.Summary,
 		skillsList.String(),
 		experiencesText.String(),
+		matchScoreSchema,
 	)
 
-	response, err := c.chatCompletion(ctx, c.config.ModelAnalysis, prompt, 0.2)
-	if err != nil {
+	var result struct {
+		Score int `json:"score"`
+	}
+
+	if err := c.completeJSON(ctx, opScoreMatch, c.config.ModelAnalysis, prompt, 0.2, matchScoreSchema, &result); err != nil {
 		return nil, fmt.Errorf("groq: score match failed: %w", err)
 	}
 
+	score, err := domain.NewMatchScore(result.Score)
+	if err != nil {
+		return nil, fmt.Errorf("groq: invalid score value: %w", err)
+	}
+
+	return &score, nil
+}
+
+// CritiqueResume reviews a tailored resume from the point of view of the
+// hiring manager for the job.
+func (c *Client) CritiqueResume(ctx context.Context, req ports.CritiqueResumeRequest) (*domain.ResumeCritique, error) {
+	var resumeText strings.Builder
+	if req.Resume != nil {
+		fmt.Fprintf(&resumeText, "Summary: %s\n\n", req.Resume.Summary)
+		for _, exp := range req.Resume.Experiences {
+			end := "Present"
+			if exp.EndDate != nil {
+				end = *exp.EndDate
+			}
+			fmt.Fprintf(&resumeText, "%s at %s (%s - %s):\n", exp.Title, exp.Organization, exp.StartDate, end)
+			for _, bullet := range exp.Bullets {
+				fmt.Fprintf(&resumeText, "  - %s\n", bullet.DisplayContent())
+			}
+		}
+		fmt.Fprintf(&resumeText, "\nSkills: %s\n", strings.Join(req.Resume.Skills, ", "))
+	}
+
+	prompt := fmt.Sprintf(`You are the hiring manager for the position below, screening the resume of an applicant.
+Review it critically and honestly, as you would when deciding who to interview.
+
+POSITION:
+- Title: %s
+- Company: %s
+
+JOB DESCRIPTION:
+%s
+
+RESUME:
+%s
+
+Evaluate the resume for THIS job only:
+1. Strengths: what makes the candidate stand out for the role
+2. Weaknesses: missing requirements, vague or unquantified claims, weak wording
+3. Red flags: anything that would make you hesitate (inconsistencies, unexplained gaps, overstated claims)
+4. Suggested edits: specific rewrites of existing text, or additions, that would improve the resume. Quote the original text exactly. Never invent experience the candidate does not have.
+5. Score the resume from 0-100 and decide whether you would invite the candidate to an interview
+
+Write all text in %s.
+
+IMPORTANT: Respond ONLY with valid JSON.
+
+Respond with JSON:
+%s`,
+		req.JobTitle,
+		req.CompanyName,
+		req.JobDescription,
+		resumeText.String(),
+		req.TargetLanguage,
+		resumeCritiqueSchema,
+	)
+
 	var result struct {
-		Score int `json:"score"`
+		Score          int                    `json:"score"`
+		Verdict        string                 `json:"verdict"`
+		Summary        string                 `json:"summary"`
+		Strengths      []string               `json:"strengths"`
+		Weaknesses     []string               `json:"weaknesses"`
+		RedFlags       []string               `json:"red_flags"`
+		SuggestedEdits []domain.SuggestedEdit `json:"suggested_edits"`
 	}
 
-	if err := json.Unmarshal([]byte(cleanJSON(response)), &result); err != nil {
-		log.Printf("json to parse: %s", cleanJSON(response))
-		return nil, fmt.Errorf("groq: failed to parse match score: %w", err)
+	if err := c.completeJSON(ctx, opCritiqueResume, c.config.ModelAnalysis, prompt, 0.3, resumeCritiqueSchema, &result); err != nil {
+		return nil, fmt.Errorf("groq: critique resume failed: %w", err)
 	}
 
 	score, err := domain.NewMatchScore(result.Score)
@@ -431,7 +718,188 @@ Respond with JSON:
 		return nil, fmt.Errorf("groq: invalid score value: %w", err)
 	}
 
-	return &score, nil
+	critique := &domain.ResumeCritique{
+		Score:          score,
+		Verdict:        domain.CritiqueVerdict(result.Verdict),
+		Summary:        result.Summary,
+		Strengths:      result.Strengths,
+		Weaknesses:     result.Weaknesses,
+		RedFlags:       result.RedFlags,
+		SuggestedEdits: result.SuggestedEdits,
+	}
+	critique.Normalize()
+
+	return critique, nil
+}
+
+// GroupBulletsByTheme groups tailored bullets under the skill themes they
+// demonstrate, such as "Data Analysis" or "Team Leadership", for
+// skills-based resumes.
+func (c *Client) GroupBulletsByTheme(ctx context.Context, req ports.GroupBulletsByThemeRequest) ([]domain.SkillTheme, error) {
+	var bulletsList strings.Builder
+	for _, bullet := range req.Bullets {
+		fmt.Fprintf(&bulletsList, "- [%s] %s\n", bullet.BulletID, bullet.DisplayContent())
+	}
+
+	var jobSkills []string
+	if req.JobAnalysis != nil {
+		jobSkills = append(jobSkills, req.JobAnalysis.RequiredSkills...)
+		jobSkills = append(jobSkills, req.JobAnalysis.PreferredSkills...)
+	}
+
+	prompt := fmt.Sprintf(`You are an expert resume writer preparing a functional, skills-based resume for a career changer.
+Group the achievements below under the transferable skill themes they demonstrate, so that the resume leads with skills instead of job history.
+
+JOB SKILLS:
+%s
+
+ACHIEVEMENTS (format: [ID] text):
+%s
+
+Rules:
+1. Use at most %d themes, most relevant to the job first
+2. Name each theme after a skill in 1-4 words (e.g. "Project Management"), in %s
+3. Put every achievement under exactly one theme, using its ID exactly as given
+4. Order achievements within a theme from most to least impressive
+
+IMPORTANT: Respond ONLY with valid JSON.
+
+Respond with JSON:
+%s`,
+		orNone(jobSkills),
+		bulletsList.String(),
+		req.MaxThemes,
+		req.TargetLanguage,
+		bulletThemesSchema,
+	)
+
+	var result struct {
+		Themes []domain.SkillTheme `json:"themes"`
+	}
+
+	if err := c.completeJSON(ctx, opGroupBulletsByTheme, c.config.ModelAnalysis, prompt, 0.2, bulletThemesSchema, &result); err != nil {
+		return nil, fmt.Errorf("groq: group bullets by theme failed: %w", err)
+	}
+
+	return result.Themes, nil
+}
+
+// ShortenTexts rewrites resume texts, such as bullets and the summary, to
+// fit their length budgets while keeping their facts and job keywords.
+func (c *Client) ShortenTexts(ctx context.Context, req ports.ShortenTextsRequest) (map[string]string, error) {
+	var textsList strings.Builder
+	for _, text := range req.Texts {
+		fmt.Fprintf(&textsList, "- [%s] (at most %d characters) %s\n", text.ID, text.MaxLength, text.Text)
+	}
+
+	prompt := fmt.Sprintf(`You are an expert resume writer trimming a resume so that it fits on one page.
+Shorten each text below to at most the number of characters given, without losing what makes it impressive.
+
+JOB KEYWORDS:
+%s
+
+TEXTS (format: [ID] (at most N characters) text):
+%s
+
+Rules:
+1. Stay within each character limit, counting spaces
+2. Keep every job keyword a text already contains, spelled exactly as given
+3. Keep the facts and numbers; NEVER invent metrics, technologies or results
+4. Cut filler words, redundant phrases and secondary details first
+5. Keep the **markdown** bold formatting of the words you keep
+6. Keep the action verb opening; NEVER use first person (I, my, we)
+7. Write in %s, the language of the texts
+8. Return every text, using its ID exactly as given
+
+IMPORTANT: Respond ONLY with valid JSON.
+
+Respond with JSON:
+%s`,
+		orNone(req.Keywords),
+		textsList.String(),
+		req.Language,
+		shortenedTextsSchema,
+	)
+
+	var result struct {
+		Shortened []struct {
+			ID   string `json:"id"`
+			Text string `json:"text"`
+		} `json:"shortened"`
+	}
+
+	if err := c.completeJSON(ctx, opShortenTexts, c.config.ModelGeneration, prompt, 0.3, shortenedTextsSchema, &result); err != nil {
+		return nil, fmt.Errorf("groq: shorten texts failed: %w", err)
+	}
+
+	shortened := make(map[string]string, len(result.Shortened))
+	for _, text := range result.Shortened {
+		shortened[text.ID] = strings.TrimSpace(text.Text)
+	}
+	return shortened, nil
+}
+
+// ScoreBulletImpact rates how impressive each bullet is on its own, from 0
+// to 100, returning the scores by bullet ID. Bullets the reply leaves out
+// are missing from the scores.
+func (c *Client) ScoreBulletImpact(ctx context.Context, req ports.ScoreBulletImpactRequest) (map[string]int, error) {
+	var bulletsList strings.Builder
+	for _, bullet := range req.Bullets {
+		fmt.Fprintf(&bulletsList, "- [%s] %s\n", bullet.ID, promptBullet(bullet))
+	}
+
+	prompt := fmt.Sprintf(`You are an expert recruiter rating resume achievements, regardless of any particular job.
+Score how impressive each achievement below is, from 0 to 100.
+
+ACHIEVEMENTS (format: [ID] text):
+%s
+Scoring:
+- 80-100: quantified, significant results with clear ownership or leadership
+- 60-79: concrete results or scope, partly quantified
+- 40-59: clear responsibilities with little evidence of impact
+- 0-39: vague, generic or unclear statements
+
+Rules:
+1. Judge each achievement on its own, not against the others
+2. Reward measurable results, scope, ownership and clarity
+3. Score every achievement, using its ID exactly as given
+
+IMPORTANT: Respond ONLY with valid JSON.
+
+Respond with JSON:
+%s`,
+		bulletsList.String(),
+		bulletImpactSchema,
+	)
+
+	var result struct {
+		Scores []struct {
+			ID    string `json:"id"`
+			Score int    `json:"score"`
+		} `json:"scores"`
+	}
+
+	if err := c.completeJSON(ctx, opScoreBulletImpact, c.config.ModelAnalysis, prompt, 0.2, bulletImpactSchema, &result); err != nil {
+		return nil, fmt.Errorf("groq: score bullet impact failed: %w", err)
+	}
+
+	requested := make(map[string]bool, len(req.Bullets))
+	for _, bullet := range req.Bullets {
+		requested[bullet.ID] = true
+	}
+	scores := make(map[string]int, len(result.Scores))
+	for _, score := range result.Scores {
+		if requested[score.ID] {
+			scores[score.ID] = min(max(score.Score, 0), 100)
+		}
+	}
+	return scores, nil
+}
+
+// LimiterStats returns concurrency limiter metrics, including time spent
+// queued per priority.
+func (c *Client) LimiterStats() LimiterStats {
+	return c.limiter.snapshot()
 }
 
 // Close releases any resources held by the AI provider.
@@ -440,22 +908,125 @@ func (c *Client) Close() error {
 	return nil
 }
 
+// aiOperation names a prompt template and its version, recorded with the
+// settings of AI runs. Bump the version whenever the template's wording or
+// schema changes, so that runs made with different prompts can be told
+// apart.
+type aiOperation struct {
+	name    string
+	version string
+}
+
+// Prompt templates of the client.
+var (
+	opAnalyzeJob          = aiOperation{name: "analyze_job", version: "v1"}
+	opSelectBullets       = aiOperation{name: "select_bullets", version: "v1"}
+	opTailorBullet        = aiOperation{name: "tailor_bullet", version: "v1"}
+	opGenerateSummary     = aiOperation{name: "generate_summary", version: "v1"}
+	opScoreMatch          = aiOperation{name: "score_match", version: "v1"}
+	opCritiqueResume      = aiOperation{name: "critique_resume", version: "v1"}
+	opGroupBulletsByTheme = aiOperation{name: "group_bullets_by_theme", version: "v1"}
+	opShortenTexts        = aiOperation{name: "shorten_texts", version: "v1"}
+	opScoreBulletImpact   = aiOperation{name: "score_bullet_impact", version: "v1"}
+)
+
+// chatMessage is a single message of a chat conversation.
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// completeJSON sends the prompt and decodes the JSON reply into out.
+// When the reply can't be parsed, the model is shown its own output along
+// with the parse error and the expected schema and asked for a corrected
+// reply, up to MaxRepairAttempts times. Replies that parse are cached when
+// the response cache is enabled, apart for each seed, and the call is
+// recorded as op with the context's AI run recorder.
+func (c *Client) completeJSON(ctx context.Context, op aiOperation, model, prompt string, temperature float64, schema string, out any) error {
+	key := cacheKey(model, prompt, temperature)
+	if seed, ok := ports.AISeed(ctx); ok {
+		key += "|" + strconv.FormatInt(seed, 10)
+	}
+	call := domain.AICallSettings{Operation: op.name, PromptVersion: op.version, Model: model, Temperature: temperature}
+	if cached, ok := c.cache.get(key); ok && !ports.FreshAIReply(ctx) {
+		if err := json.Unmarshal([]byte(cached), out); err == nil {
+			ports.RecordAICall(ctx, call)
+			return nil
+		}
+	}
+
+	messages := []chatMessage{{Role: "user", Content: prompt}}
+
+	response, err := c.chatCompletion(ctx, model, messages, temperature)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		cleaned := cleanJSON(response)
+		parseErr := json.Unmarshal([]byte(cleaned), out)
+		if parseErr == nil {
+			c.cache.set(key, cleaned)
+			ports.RecordAICall(ctx, call)
+			return nil
+		}
+
+		if attempt >= c.config.MaxRepairAttempts {
+			log.Printf("json to parse: %s", cleaned)
+			return fmt.Errorf("failed to parse response after %d repair attempts: %w", attempt, parseErr)
+		}
+
+		log.Printf("groq: malformed JSON reply, requesting repair (%d/%d): %v", attempt+1, c.config.MaxRepairAttempts, parseErr)
+		messages = append(messages,
+			chatMessage{Role: "assistant", Content: response},
+			chatMessage{Role: "user", Content: repairPrompt(parseErr, schema)},
+		)
+
+		response, err = c.chatCompletion(ctx, model, messages, repairTemperature)
+		if err != nil {
+			return fmt.Errorf("repair request failed: %w", err)
+		}
+	}
+}
+
+// repairPrompt asks the model to fix a reply that failed to parse.
+func repairPrompt(parseErr error, schema string) string {
+	return fmt.Sprintf(`Your previous reply could not be parsed as JSON.
+
+PARSE ERROR:
+%s
+
+EXPECTED SCHEMA:
+%s
+
+Return ONLY the corrected JSON object matching the schema. No markdown blocks, no explanations.`, parseErr, schema)
+}
+
 // chatCompletion sends a chat completion request to Groq API.
-func (c *Client) chatCompletion(ctx context.Context, model, prompt string, temperature float64) (string, error) {
+func (c *Client) chatCompletion(ctx context.Context, model string, messages []chatMessage, temperature float64) (string, error) {
 	reqBody := map[string]any{
-		"model": model,
-		"messages": []map[string]string{
-			{"role": "user", "content": prompt},
-		},
+		"model":       model,
+		"messages":    messages,
 		"max_tokens":  defaultMaxTokens,
 		"temperature": temperature,
 	}
+	if seed, ok := ports.AISeed(ctx); ok {
+		reqBody["seed"] = seed
+	}
 
 	body, err := json.Marshal(reqBody)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal request: %w", err)
 	}
 
+	// Hold a slot for the whole exchange, retries included, so rate-limited
+	// requests don't make room for more of the same.
+	release, err := c.limiter.acquire(ctx, ports.AIPriorityFromContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("waiting for request slot: %w", err)
+	}
+	defer release()
+
 	var lastErr error
 	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
 		if attempt > 0 {
@@ -468,7 +1039,7 @@ func (c *Client) chatCompletion(ctx context.Context, model, prompt string, tempe
 			}
 		}
 
-		req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/chat/completions", bytes.NewReader(body))
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.BaseURL+"/chat/completions", bytes.NewReader(body))
 		if err != nil {
 			return "", fmt.Errorf("failed to create request: %w", err)
 		}
@@ -476,8 +1047,14 @@ func (c *Client) chatCompletion(ctx context.Context, model, prompt string, tempe
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Authorization", "Bearer "+c.config.APIKey)
 
+		done, err := c.breaker.Allow()
+		if err != nil {
+			return "", fmt.Errorf("groq: %w: %w", domain.ErrUpstreamUnavailable, err)
+		}
+
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
+			done(ctx.Err() == nil)
 			lastErr = err
 			continue
 		}
@@ -485,10 +1062,14 @@ func (c *Client) chatCompletion(ctx context.Context, model, prompt string, tempe
 
 		respBody, err := io.ReadAll(resp.Body)
 		if err != nil {
+			done(ctx.Err() == nil)
 			lastErr = fmt.Errorf("failed to read response: %w", err)
 			continue
 		}
 
+		// Rate limiting means the API is up, so only server errors trip the breaker.
+		done(resp.StatusCode >= http.StatusInternalServerError)
+
 		if resp.StatusCode == http.StatusTooManyRequests {
 			lastErr = fmt.Errorf("rate limited (attempt %d/%d)", attempt+1, c.config.MaxRetries+1)
 			continue
@@ -534,6 +1115,60 @@ func stringPtr(s *string) string {
 	return *s
 }
 
+// toneGuidance and personaGuidance describe each tone and target reader to
+// the AI.
+var (
+	toneGuidance = map[domain.Tone]string{
+		domain.ToneConfident: "confident: state achievements assertively and own the results, without hedging words such as \"helped\" or \"tried\"",
+		domain.ToneHumble:    "humble: credit the team where it shared the work and let the results speak, without superlatives or self-praise",
+		domain.ToneEnergetic: "energetic: use lively, vivid action verbs and convey enthusiasm for the work, without exclamation marks",
+	}
+	personaGuidance = map[domain.Persona]string{
+		domain.PersonaTechnicalHiringManager: "a technical hiring manager: lead with technical depth, architecture, tools and the scale of the work, naming technologies precisely",
+		domain.PersonaHRScreener:             "an HR screener: use the job's exact keywords and titles, plain language and business outcomes, spelling out acronyms a non-engineer may not know",
+	}
+)
+
+// writingVoice returns the prompt section with the tone and target reader
+// to write for, or "" for the defaults.
+func writingVoice(voice domain.WritingVoice) string {
+	tone, persona := toneGuidance[voice.Tone], personaGuidance[voice.Persona]
+	if tone == "" && persona == "" {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\nWRITING VOICE (follow it over the style defaults above; it never permits inventing facts):\n")
+	if tone != "" {
+		fmt.Fprintf(&sb, "- Tone: %s\n", tone)
+	}
+	if persona != "" {
+		fmt.Fprintf(&sb, "- Reader: write for %s\n", persona)
+	}
+	return sb.String()
+}
+
+// userInstructions returns the prompt section with the user's own
+// instructions, or "" without any.
+func userInstructions(instructions string) string {
+	if strings.TrimSpace(instructions) == "" {
+		return ""
+	}
+	return fmt.Sprintf(`
+USER'S INSTRUCTIONS (follow them unless they conflict with the rules above; never invent facts for them):
+%s
+`, strings.TrimSpace(instructions))
+}
+
+// orNone joins values into a comma separated list, or returns "none" for
+// an empty list.
+func orNone(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+	return strings.Join(values, ", ")
+}
+
 // cleanJSON extracts the *last* valid JSON content from a string.
 // This handles cases where the LLM "thinks" before answering or uses markdown blocks.
 func cleanJSON(input string) string {
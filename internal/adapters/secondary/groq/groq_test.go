@@ -4,14 +4,22 @@ package groq_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/groq"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
 )
 
@@ -70,30 +78,42 @@ func TestGroqClientInterface(t *testing.T) {
 	_ = client.TailorBullet
 	_ = client.GenerateSummary
 	_ = client.ScoreMatch
+	_ = client.CritiqueResume
+	_ = client.GroupBulletsByTheme
+	_ = client.ShortenTexts
+	_ = client.ScoreBulletImpact
 	_ = client.Close
 }
 
-// TestGroqMockServer tests the client with a mock HTTP server.
-// Note: The current implementation uses a hardcoded baseURL constant,
-// so we cannot easily redirect requests to a mock server.
-// This test demonstrates the pattern for when baseURL is configurable.
-func TestGroqMockServer(t *testing.T) {
-	t.Skip("Groq client uses hardcoded baseURL - needs refactoring for mock testing")
+// newMockServer returns a server that replies with the given completions in order.
+// The decoded request messages of every call are sent to the returned channel.
+func newMockServer(t *testing.T, replies ...string) (*httptest.Server, <-chan []map[string]string) {
+	t.Helper()
+
+	requests := make(chan []map[string]string, len(replies)+1)
+	call := 0
 
-	// This is how the test would work with a configurable baseURL:
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Verify request
+		assert.Equal(t, "/chat/completions", r.URL.Path)
 		assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
 		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
 
-		// Return mock response
+		var body struct {
+			Messages []map[string]string `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests <- body.Messages
+
+		if call >= len(replies) {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		content := replies[call]
+		call++
+
 		response := map[string]any{
 			"choices": []map[string]any{
-				{
-					"message": map[string]any{
-						"content": `{"title": "Software Engineer", "company": "Test Corp", "required_skills": ["Go", "Python"], "preferred_skills": [], "keywords": ["backend"], "seniority_level": "senior", "years_experience": 5, "summary": "Senior backend role"}`,
-					},
-				},
+				{"message": map[string]any{"content": content}},
 			},
 		}
 
@@ -101,13 +121,345 @@ func TestGroqMockServer(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(response)
 	}))
-	defer server.Close()
+	t.Cleanup(server.Close)
 
-	// Would need configurable baseURL in groq.Config
-	// cfg := groq.Config{
-	// 	APIKey:  "test-api-key", // pragma: allowlist secret
-	// 	BaseURL: server.URL,
-	// }
+	return server, requests
+}
+
+// TestGroqMockServer tests the client with a mock HTTP server.
+func TestGroqMockServer(t *testing.T) {
+	server, _ := newMockServer(t,
+		`{"title": "Software Engineer", "company": "Test Corp", "required_skills": ["Go", "Python"], "preferred_skills": [], "keywords": ["backend"], "seniority_level": "senior", "years_experience": 5, "summary": "Senior backend role"}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	analysis, err := client.AnalyzeJob(context.Background(), ports.AnalyzeJobRequest{JobDescription: "Senior Go engineer"})
+	require.NoError(t, err)
+	assert.Equal(t, "Software Engineer", analysis.Title)
+	assert.Equal(t, "Test Corp", analysis.Company)
+	assert.Equal(t, []string{"Go", "Python"}, analysis.RequiredSkills)
+	require.NotNil(t, analysis.YearsExperience)
+	assert.Equal(t, 5, *analysis.YearsExperience)
+}
+
+func TestAnalyzeJobInsights(t *testing.T) {
+	server, _ := newMockServer(t,
+		`{"title": "Backend Engineer", "required_skills": ["Go"], "salary": {"min": 90000, "max": 120000, "currency": "usd", "period": "year"}, "benefits": ["Health insurance"], "remote_policy": "Hybrid", "visa_sponsorship": null}`,
+		`{"title": "Backend Engineer", "required_skills": ["Go"], "salary": null, "benefits": [], "remote_policy": null, "visa_sponsorship": null}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	analysis, err := client.AnalyzeJob(context.Background(), ports.AnalyzeJobRequest{JobDescription: "Hybrid Go role, $90k-$120k"})
+	require.NoError(t, err)
+	require.NotNil(t, analysis.Insights)
+	assert.Equal(t, domain.RemotePolicyHybrid, analysis.Insights.RemotePolicy)
+	assert.Equal(t, "USD", analysis.Insights.Salary.Currency)
+	assert.Equal(t, 120000, *analysis.Insights.Salary.Max)
+	assert.Nil(t, analysis.Insights.VisaSponsorship)
+
+	analysis, err = client.AnalyzeJob(context.Background(), ports.AnalyzeJobRequest{JobDescription: "Go role"})
+	require.NoError(t, err)
+	assert.Nil(t, analysis.Insights)
+}
+
+func TestTailorBulletTranslation(t *testing.T) {
+	server, requests := newMockServer(t,
+		`{"tailored_content": "Criei APIs em **Go**", "translated_content": "Built APIs in **Go**", "keywords": ["Go"]}`,
+		`{"tailored_content": "Built APIs in **Go**", "translated_content": "ignored", "keywords": ["Go"]}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	req := ports.TailorBulletRequest{
+		Bullet:         domain.Bullet{ID: "b-1", Content: "Criei APIs"},
+		JobAnalysis:    &ports.JobAnalysis{Title: "Backend Engineer"},
+		TargetLanguage: "en",
+		SourceLanguage: "pt-br",
+		Style:          "professional",
+	}
+
+	result, err := client.TailorBullet(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "Criei APIs em **Go**", result.TailoredContent)
+	assert.Equal(t, "Built APIs in **Go**", result.TranslatedContent)
+	prompt := (<-requests)[0]["content"]
+	assert.Contains(t, prompt, "write strictly in pt-br")
+	assert.Contains(t, prompt, "translated to en")
+
+	req.SourceLanguage = "en"
+	result, err = client.TailorBullet(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, result.TranslatedContent)
+	assert.NotContains(t, (<-requests)[0]["content"], "TRANSLATION")
+}
+
+func TestCritiqueResume(t *testing.T) {
+	server, requests := newMockServer(t,
+		`{"score": 68, "verdict": "Maybe", "summary": "Solid backend work, little leadership.", "strengths": ["Go in production"], "weaknesses": ["No team lead experience", ""], "red_flags": [], "suggested_edits": [{"section": "summary", "original": "Engineer", "suggested": "Backend engineer leading a team of 4", "reason": "The role is a lead position"}]}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	critique, err := client.CritiqueResume(context.Background(), ports.CritiqueResumeRequest{
+		JobDescription: "Lead Go engineer",
+		JobTitle:       "Tech Lead",
+		Resume:         &domain.ResumeContent{Summary: "Engineer", Skills: []string{"Go"}},
+		TargetLanguage: "en",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 68, critique.Score.Int())
+	assert.Equal(t, domain.CritiqueVerdictMaybe, critique.Verdict)
+	assert.Equal(t, []string{"No team lead experience"}, critique.Weaknesses)
+	assert.Empty(t, critique.RedFlags)
+	require.Len(t, critique.SuggestedEdits, 1)
+	assert.Equal(t, "summary", critique.SuggestedEdits[0].Section)
+
+	prompt := (<-requests)[0]["content"]
+	assert.Contains(t, prompt, "hiring manager")
+	assert.Contains(t, prompt, "Lead Go engineer")
+}
+
+func TestGroupBulletsByTheme(t *testing.T) {
+	server, requests := newMockServer(t,
+		`{"themes": [{"name": "Data Analysis", "bullet_ids": ["b2", "b1"]}, {"name": "Leadership", "bullet_ids": ["b3"]}]}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	themes, err := client.GroupBulletsByTheme(context.Background(), ports.GroupBulletsByThemeRequest{
+		JobAnalysis: &ports.JobAnalysis{RequiredSkills: []string{"SQL"}},
+		Bullets: []domain.TailoredBullet{
+			{BulletID: "b1", TailoredContent: "Built sales dashboards"},
+			{BulletID: "b2", TailoredContent: "Forecast demand with **SQL**"},
+			{BulletID: "b3", TailoredContent: "Led a team of 6 teachers"},
+		},
+		MaxThemes:      3,
+		TargetLanguage: "en",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SkillTheme{
+		{Name: "Data Analysis", BulletIDs: []string{"b2", "b1"}},
+		{Name: "Leadership", BulletIDs: []string{"b3"}},
+	}, themes)
+
+	prompt := (<-requests)[0]["content"]
+	assert.Contains(t, prompt, "[b3] Led a team of 6 teachers")
+	assert.Contains(t, prompt, "at most 3 themes")
+	assert.Contains(t, prompt, "JOB SKILLS:\nSQL")
+}
+
+func TestShortenTexts(t *testing.T) {
+	server, requests := newMockServer(t,
+		`{"shortened": [{"id": "b1", "text": " Cut **AWS** costs 30% "}, {"id": "summary", "text": "Platform engineer"}]}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	shortened, err := client.ShortenTexts(context.Background(), ports.ShortenTextsRequest{
+		Texts: []ports.TextBudget{
+			{ID: "b1", Text: "Reduced monthly **AWS** infrastructure costs by 30% across teams", MaxLength: 40},
+			{ID: "summary", Text: "Platform engineer with eight years of experience", MaxLength: 30},
+		},
+		Keywords: []string{"AWS"},
+		Language: "en",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"b1": "Cut **AWS** costs 30%", "summary": "Platform engineer"}, shortened)
+
+	prompt := (<-requests)[0]["content"]
+	assert.Contains(t, prompt, "[b1] (at most 40 characters) Reduced monthly **AWS**")
+	assert.Contains(t, prompt, "JOB KEYWORDS:\nAWS")
+}
+
+func TestScoreBulletImpact(t *testing.T) {
+	server, requests := newMockServer(t,
+		`{"scores": [{"id": "b1", "score": 85}, {"id": "b2", "score": 140}, {"id": "unknown", "score": 50}]}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	scores, err := client.ScoreBulletImpact(context.Background(), ports.ScoreBulletImpactRequest{
+		Bullets: []domain.Bullet{
+			{ID: "b1", Content: "Cut monthly AWS costs by 30% across 12 teams"},
+			{ID: "b2", Content: "Led the migration of 40 services to Kubernetes"},
+			{ID: "b3", Content: "Worked on various projects"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"b1": 85, "b2": 100}, scores)
+
+	prompt := (<-requests)[0]["content"]
+	assert.Contains(t, prompt, "- [b1] Cut monthly AWS costs by 30%")
+	assert.Contains(t, prompt, "- [b3] Worked on various projects")
+}
+
+func TestHighlightedSkillsInPrompts(t *testing.T) {
+	server, requests := newMockServer(t,
+		`{"selected_bullet_ids": ["b1"], "reasoning": "Kubernetes work", "bullet_reasons": {"b1": "Runs clusters"}}`,
+		`{"summary": "Platform engineer"}`,
+		`{"summary": "Platform engineer"}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	selection, err := client.SelectBullets(context.Background(), ports.SelectBulletsRequest{
+		JobAnalysis:       &ports.JobAnalysis{Title: "SRE"},
+		AvailableBullets:  []domain.Bullet{{ID: "b1", Content: "Ran Kubernetes clusters"}},
+		MaxBullets:        1,
+		HighlightedSkills: []string{"Kubernetes", "Terraform"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"b1": "Runs clusters"}, selection.BulletReasons)
+	assert.Contains(t, (<-requests)[0]["content"], "CANDIDATE'S HIGHLIGHTED SKILLS: Kubernetes, Terraform")
+
+	_, err = client.GenerateSummary(context.Background(), ports.GenerateSummaryRequest{
+		User:              &domain.User{},
+		JobAnalysis:       &ports.JobAnalysis{},
+		HighlightedSkills: []string{"Kubernetes"},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, (<-requests)[0]["content"], "- Highlighted Skills: Kubernetes")
+
+	_, err = client.GenerateSummary(context.Background(), ports.GenerateSummaryRequest{
+		User:        &domain.User{},
+		JobAnalysis: &ports.JobAnalysis{},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, (<-requests)[0]["content"], "- Highlighted Skills: none")
+}
+
+func TestWritingPreferencesInTailorPrompt(t *testing.T) {
+	server, requests := newMockServer(t,
+		`{"tailored_content": "Cut latency by **40%**", "keywords": []}`,
+		`{"tailored_content": "Cut latency by **40%**", "keywords": []}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	req := ports.TailorBulletRequest{
+		Bullet:      domain.Bullet{ID: "b1", Content: "Improved latency"},
+		JobAnalysis: &ports.JobAnalysis{Title: "SRE"},
+		Style:       "professional",
+		WritingPreferences: []string{
+			"Dislikes buzzwords such as leverage.",
+			"Prefers concrete metrics over adjectives.",
+		},
+	}
+	_, err = client.TailorBullet(context.Background(), req)
+	require.NoError(t, err)
+	prompt := (<-requests)[0]["content"]
+	assert.Contains(t, prompt, "USER'S WRITING PREFERENCES")
+	assert.Contains(t, prompt, "- Dislikes buzzwords such as leverage.\n- Prefers concrete metrics over adjectives.")
+
+	req.WritingPreferences = nil
+	_, err = client.TailorBullet(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotContains(t, (<-requests)[0]["content"], "USER'S WRITING PREFERENCES")
+}
+
+func TestRepairMalformedJSON(t *testing.T) {
+	const malformed = `{"summary": "Seasoned **Go** engineer",}`
+
+	t.Run("repairs malformed reply", func(t *testing.T) {
+		server, requests := newMockServer(t, malformed, `{"summary": "Seasoned **Go** engineer"}`)
+
+		client, err := groq.New(groq.Config{
+			APIKey:  "test-api-key", // pragma: allowlist secret
+			BaseURL: server.URL,
+		})
+		require.NoError(t, err)
+
+		result, err := client.GenerateSummary(context.Background(), ports.GenerateSummaryRequest{
+			User:        &domain.User{},
+			JobAnalysis: &ports.JobAnalysis{},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Seasoned **Go** engineer", result.Summary)
+
+		<-requests
+		repair := <-requests
+		require.Len(t, repair, 3)
+		assert.Equal(t, "assistant", repair[1]["role"])
+		assert.Equal(t, malformed, repair[1]["content"])
+		assert.Equal(t, "user", repair[2]["role"])
+		assert.Contains(t, repair[2]["content"], "PARSE ERROR")
+		assert.Contains(t, repair[2]["content"], `"summary"`)
+	})
+
+	t.Run("fails after max repair attempts", func(t *testing.T) {
+		server, requests := newMockServer(t, malformed, malformed, malformed)
+
+		client, err := groq.New(groq.Config{
+			APIKey:            "test-api-key", // pragma: allowlist secret
+			BaseURL:           server.URL,
+			MaxRepairAttempts: 2,
+		})
+		require.NoError(t, err)
+
+		_, err = client.GenerateSummary(context.Background(), ports.GenerateSummaryRequest{
+			User:        &domain.User{},
+			JobAnalysis: &ports.JobAnalysis{},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "after 2 repair attempts")
+		assert.Len(t, requests, 3)
+	})
+
+	t.Run("repair can be disabled", func(t *testing.T) {
+		server, requests := newMockServer(t, malformed)
+
+		client, err := groq.New(groq.Config{
+			APIKey:            "test-api-key", // pragma: allowlist secret
+			BaseURL:           server.URL,
+			MaxRepairAttempts: -1,
+		})
+		require.NoError(t, err)
+
+		_, err = client.GenerateSummary(context.Background(), ports.GenerateSummaryRequest{
+			User:        &domain.User{},
+			JobAnalysis: &ports.JobAnalysis{},
+		})
+		require.Error(t, err)
+		assert.Len(t, requests, 1)
+	})
 }
 
 func TestClose(t *testing.T) {
@@ -154,3 +506,468 @@ func TestAnalyzeJobRequest(t *testing.T) {
 		assert.Error(t, err) // Will error because no real API
 	})
 }
+
+func TestResponseCache(t *testing.T) {
+	const reply = `{"summary": "Seasoned **Go** engineer"}`
+	req := ports.GenerateSummaryRequest{
+		User:        &domain.User{},
+		JobAnalysis: &ports.JobAnalysis{Title: "Go Engineer"},
+	}
+
+	t.Run("serves identical requests from cache", func(t *testing.T) {
+		server, requests := newMockServer(t, reply, reply)
+
+		client, err := groq.New(groq.Config{
+			APIKey:   "test-api-key", // pragma: allowlist secret
+			BaseURL:  server.URL,
+			CacheTTL: time.Minute,
+		})
+		require.NoError(t, err)
+
+		first, err := client.GenerateSummary(context.Background(), req)
+		require.NoError(t, err)
+		second, err := client.GenerateSummary(context.Background(), req)
+		require.NoError(t, err)
+
+		assert.Equal(t, first.Summary, second.Summary)
+		assert.Len(t, requests, 1)
+
+		other := req
+		other.JobAnalysis = &ports.JobAnalysis{Title: "Rust Engineer"}
+		_, err = client.GenerateSummary(context.Background(), other)
+		require.NoError(t, err)
+		assert.Len(t, requests, 2)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		server, requests := newMockServer(t, reply, reply)
+
+		client, err := groq.New(groq.Config{
+			APIKey:  "test-api-key", // pragma: allowlist secret
+			BaseURL: server.URL,
+		})
+		require.NoError(t, err)
+
+		_, err = client.GenerateSummary(context.Background(), req)
+		require.NoError(t, err)
+		_, err = client.GenerateSummary(context.Background(), req)
+		require.NoError(t, err)
+
+		assert.Len(t, requests, 2)
+	})
+
+	t.Run("fresh replies skip the cache", func(t *testing.T) {
+		server, requests := newMockServer(t, reply, `{"summary": "Pragmatic **Go** engineer"}`, reply)
+
+		client, err := groq.New(groq.Config{
+			APIKey:   "test-api-key", // pragma: allowlist secret
+			BaseURL:  server.URL,
+			CacheTTL: time.Minute,
+		})
+		require.NoError(t, err)
+
+		_, err = client.GenerateSummary(context.Background(), req)
+		require.NoError(t, err)
+		fresh, err := client.GenerateSummary(ports.WithFreshAIReply(context.Background()), req)
+		require.NoError(t, err)
+		assert.Equal(t, "Pragmatic **Go** engineer", fresh.Summary)
+		assert.Len(t, requests, 2)
+
+		// The fresh reply replaces the cached one.
+		cached, err := client.GenerateSummary(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, fresh.Summary, cached.Summary)
+		assert.Len(t, requests, 2)
+	})
+}
+
+func TestUserInstructionsInPrompts(t *testing.T) {
+	server, requests := newMockServer(t,
+		`{"summary": "Engineering manager"}`,
+		`{"tailored_content": "Led the on-call rotation", "keywords": []}`,
+		`{"summary": "Engineering manager"}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	job := &ports.JobAnalysis{Title: "Engineering Manager"}
+	_, err = client.GenerateSummary(context.Background(), ports.GenerateSummaryRequest{
+		User:         &domain.User{},
+		JobAnalysis:  job,
+		Instructions: "Open with my management experience",
+	})
+	require.NoError(t, err)
+	_, err = client.TailorBullet(context.Background(), ports.TailorBulletRequest{
+		Bullet:       domain.Bullet{ID: "b1", Content: "Ran on-call"},
+		JobAnalysis:  job,
+		Instructions: "Mention the on-call rotation",
+	})
+	require.NoError(t, err)
+	_, err = client.GenerateSummary(context.Background(), ports.GenerateSummaryRequest{User: &domain.User{}, JobAnalysis: job})
+	require.NoError(t, err)
+
+	assert.Contains(t, (<-requests)[0]["content"], "USER'S INSTRUCTIONS (follow them unless they conflict with the rules above; never invent facts for them):\nOpen with my management experience")
+	assert.Contains(t, (<-requests)[0]["content"], "USER'S INSTRUCTIONS (follow them unless they conflict with the rules above; never invent facts for them):\nMention the on-call rotation")
+	assert.NotContains(t, (<-requests)[0]["content"], "USER'S INSTRUCTIONS")
+}
+
+func TestWritingVoiceInPrompts(t *testing.T) {
+	server, requests := newMockServer(t,
+		`{"summary": "Engineering manager"}`,
+		`{"tailored_content": "Helped run on-call", "keywords": []}`,
+		`{"tailored_content": "Ran on-call", "keywords": []}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	job := &ports.JobAnalysis{Title: "Engineering Manager"}
+	_, err = client.GenerateSummary(context.Background(), ports.GenerateSummaryRequest{
+		User:        &domain.User{},
+		JobAnalysis: job,
+		Voice:       domain.WritingVoice{Tone: domain.ToneHumble, Persona: domain.PersonaHRScreener},
+	})
+	require.NoError(t, err)
+	bullet := ports.TailorBulletRequest{
+		Bullet:      domain.Bullet{ID: "b1", Content: "Ran on-call"},
+		JobAnalysis: job,
+		Voice:       domain.WritingVoice{Persona: domain.PersonaTechnicalHiringManager},
+	}
+	_, err = client.TailorBullet(context.Background(), bullet)
+	require.NoError(t, err)
+	bullet.Voice = domain.WritingVoice{}
+	_, err = client.TailorBullet(context.Background(), bullet)
+	require.NoError(t, err)
+
+	prompt := (<-requests)[0]["content"]
+	assert.Contains(t, prompt, "WRITING VOICE")
+	assert.Contains(t, prompt, "- Tone: humble:")
+	assert.Contains(t, prompt, "- Reader: write for an HR screener:")
+
+	prompt = (<-requests)[0]["content"]
+	assert.NotContains(t, prompt, "- Tone:")
+	assert.Contains(t, prompt, "- Reader: write for a technical hiring manager:")
+
+	assert.NotContains(t, (<-requests)[0]["content"], "WRITING VOICE")
+}
+
+func TestGenerateSummaryVariants(t *testing.T) {
+	server, requests := newMockServer(t,
+		`{"variants": [
+			{"angle": "impact", "summary": "Cut costs by **30%**"},
+			{"angle": "Technical", "summary": " Go engineer "},
+			{"angle": "leadership", "summary": ""},
+			{"angle": "creative", "summary": "Artist"}
+		]}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	result, err := client.GenerateSummary(context.Background(), ports.GenerateSummaryRequest{
+		User:        &domain.User{},
+		JobAnalysis: &ports.JobAnalysis{},
+	})
+	require.NoError(t, err)
+
+	// Known angles in order, empty ones dropped.
+	assert.Equal(t, []domain.SummaryVariant{
+		{Angle: domain.SummaryAngleTechnical, Summary: "Go engineer"},
+		{Angle: domain.SummaryAngleImpact, Summary: "Cut costs by **30%**"},
+	}, result.Variants)
+	assert.Equal(t, "Go engineer", result.Summary)
+
+	prompt := (<-requests)[0]["content"]
+	assert.Contains(t, prompt, "- leadership: leads with ownership")
+}
+
+func TestAISeedAndRunRecording(t *testing.T) {
+	seeds := make(chan any, 3)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		seeds <- body["seed"]
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{{"message": map[string]any{"content": `{"summary": "ok"}`}}},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := groq.New(groq.Config{
+		APIKey:          "test-api-key", // pragma: allowlist secret
+		BaseURL:         server.URL,
+		ModelGeneration: "gen-model",
+		CacheTTL:        time.Minute,
+	})
+	require.NoError(t, err)
+
+	summarize := func(ctx context.Context) {
+		_, err := client.GenerateSummary(ctx, ports.GenerateSummaryRequest{
+			User:        &domain.User{},
+			JobAnalysis: &ports.JobAnalysis{},
+		})
+		require.NoError(t, err)
+	}
+
+	ctx, recorder := ports.WithAIRunRecorder(ports.WithAISeed(context.Background(), 42))
+	summarize(ctx)
+	assert.Equal(t, float64(42), <-seeds)
+
+	// The same seed is served from the cache, and still recorded.
+	summarize(ctx)
+	assert.Equal(t, []domain.AICallSettings{{
+		Operation:     "generate_summary",
+		PromptVersion: "v1",
+		Model:         "gen-model",
+		Temperature:   0.8,
+		Count:         2,
+	}}, recorder.Calls())
+
+	// Another seed is not.
+	summarize(ports.WithAISeed(context.Background(), 7))
+	assert.Equal(t, float64(7), <-seeds)
+
+	// Unseeded requests send no seed.
+	summarize(ports.WithFreshAIReply(context.Background()))
+	assert.Nil(t, <-seeds)
+	assert.Empty(t, seeds)
+}
+
+func TestEstimateTailoring(t *testing.T) {
+	bullets := make([]domain.Bullet, 30)
+	for i := range bullets {
+		bullets[i] = domain.Bullet{ID: fmt.Sprintf("bullet-%02d", i), Content: strings.Repeat("x", 200)}
+	}
+
+	client, err := groq.New(groq.Config{
+		APIKey:          "test-api-key", // pragma: allowlist secret
+		ModelGeneration: "gen-model",
+		ModelAnalysis:   "analysis-model",
+		MaxPromptTokens: 1000,
+		Prices: map[string]groq.ModelPrice{
+			"gen-model":      {Input: 1, Output: 2},
+			"analysis-model": {Input: 0.5, Output: 1},
+		},
+	})
+	require.NoError(t, err)
+
+	req := ports.EstimateTailoringRequest{
+		JobDescription: strings.Repeat("y", 4000),
+		Bullets:        bullets,
+		MaxBullets:     5,
+	}
+	estimate := client.EstimateTailoring(req)
+
+	operations := make([]string, 0, len(estimate.Calls))
+	input, output, cost := 0, 0, 0.0
+	for _, call := range estimate.Calls {
+		operations = append(operations, call.Operation)
+		input += call.InputTokens
+		output += call.OutputTokens
+		require.NotNil(t, call.CostUSD)
+		cost += *call.CostUSD
+	}
+	assert.Equal(t, []string{"analyze_job", "select_bullets", "tailor_bullet", "generate_summary", "score_match", "group_bullets_by_theme"}, operations)
+	assert.Equal(t, input, estimate.InputTokens)
+	assert.Equal(t, output, estimate.OutputTokens)
+	require.NotNil(t, estimate.CostUSD)
+	assert.InDelta(t, cost, *estimate.CostUSD, 1e-12)
+
+	// The job description counts toward the analysis prompt.
+	analysis := estimate.Calls[0]
+	assert.Equal(t, "analysis-model", analysis.Model)
+	assert.Greater(t, analysis.InputTokens, 1000)
+
+	// Bullets that do not fit one prompt are selected in chunks and a
+	// merge round.
+	assert.Greater(t, estimate.Calls[1].Count, 2)
+
+	tailor := estimate.Calls[2]
+	assert.Equal(t, "gen-model", tailor.Model)
+	assert.Equal(t, 5, tailor.Count)
+	assert.InDelta(t, (float64(tailor.InputTokens)*1+float64(tailor.OutputTokens)*2)/1e6, *tailor.CostUSD, 1e-12)
+
+	// Translated rewrites are longer.
+	req.Translate = true
+	assert.Greater(t, client.EstimateTailoring(req).Calls[2].OutputTokens, tailor.OutputTokens)
+
+	// Models without a price leave the cost out.
+	unpriced, err := groq.New(groq.Config{
+		APIKey:          "test-api-key", // pragma: allowlist secret
+		ModelGeneration: "gen-model",
+		Prices:          map[string]groq.ModelPrice{},
+	})
+	require.NoError(t, err)
+	estimate = unpriced.EstimateTailoring(req)
+	assert.Nil(t, estimate.CostUSD)
+	assert.Nil(t, estimate.Calls[0].CostUSD)
+	assert.Positive(t, estimate.InputTokens)
+}
+
+func TestRequestLimiterPriority(t *testing.T) {
+	const reply = `{"summary": "ok"}`
+
+	unblock := make(chan struct{})
+	received := make(chan string, 3)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []map[string]string `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		prompt := body.Messages[0]["content"]
+		for _, title := range []string{"First", "Background", "Interactive"} {
+			if strings.Contains(prompt, title+" Engineer") {
+				received <- title
+			}
+		}
+		<-unblock
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{{"message": map[string]any{"content": reply}}},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := groq.New(groq.Config{
+		APIKey:                "test-api-key", // pragma: allowlist secret
+		BaseURL:               server.URL,
+		MaxConcurrentRequests: 1,
+	})
+	require.NoError(t, err)
+
+	summarize := func(ctx context.Context, title string, done chan<- error) {
+		_, err := client.GenerateSummary(ctx, ports.GenerateSummaryRequest{
+			User:        &domain.User{},
+			JobAnalysis: &ports.JobAnalysis{Title: title + " Engineer"},
+		})
+		done <- err
+	}
+
+	done := make(chan error, 3)
+	go summarize(context.Background(), "First", done)
+	assert.Equal(t, "First", <-received)
+
+	go summarize(ports.WithAIPriority(context.Background(), ports.AIPriorityBackground), "Background", done)
+	require.Eventually(t, func() bool { return client.LimiterStats().Background.Queued == 1 }, time.Second, time.Millisecond)
+
+	go summarize(context.Background(), "Interactive", done)
+	require.Eventually(t, func() bool { return client.LimiterStats().Interactive.Queued == 1 }, time.Second, time.Millisecond)
+
+	close(unblock)
+	for range 3 {
+		require.NoError(t, <-done)
+	}
+
+	// The interactive request jumped the queue ahead of the background one.
+	assert.Equal(t, "Interactive", <-received)
+	assert.Equal(t, "Background", <-received)
+
+	stats := client.LimiterStats()
+	assert.Equal(t, 1, stats.MaxConcurrent)
+	assert.Equal(t, 0, stats.InFlight)
+	assert.Equal(t, int64(2), stats.Interactive.Acquired)
+	assert.Equal(t, int64(1), stats.Interactive.Waited)
+	assert.Equal(t, int64(1), stats.Background.Waited)
+	assert.Positive(t, stats.Background.MaxWait)
+}
+
+func TestSelectBulletsInChunks(t *testing.T) {
+	const maxPromptTokens = 700
+
+	idPattern := regexp.MustCompile(`\[ID: (b\d+)\]`)
+	var mu sync.Mutex
+	var prompts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Messages []map[string]string `json:"messages"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		prompt := body.Messages[0]["content"]
+		mu.Lock()
+		prompts = append(prompts, prompt)
+		mu.Unlock()
+
+		// Select the first two listed bullets, giving a reason for each.
+		var ids []string
+		reasons := make(map[string]string)
+		for _, match := range idPattern.FindAllStringSubmatch(prompt, -1) {
+			ids = append(ids, match[1])
+			reasons[match[1]] = "Seen with " + strconv.Itoa(strings.Count(prompt, "[ID: ")) + " bullets"
+		}
+		reply, _ := json.Marshal(map[string]any{
+			"selected_bullet_ids": ids[:min(2, len(ids))],
+			"reasoning":           "Most relevant",
+			"bullet_reasons":      reasons,
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{{"message": map[string]any{"content": string(reply)}}},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := groq.New(groq.Config{
+		APIKey:          "test-api-key", // pragma: allowlist secret
+		BaseURL:         server.URL,
+		MaxPromptTokens: maxPromptTokens,
+	})
+	require.NoError(t, err)
+
+	bullets := make([]domain.Bullet, 8)
+	for i := range bullets {
+		bullets[i] = domain.Bullet{ID: "b" + strconv.Itoa(i), Content: strings.Repeat("Built Go services. ", 20)}
+	}
+	bullets[7].Content = strings.Repeat("Very long bullet. ", 200)
+
+	selection, err := client.SelectBullets(context.Background(), ports.SelectBulletsRequest{
+		JobAnalysis:      &ports.JobAnalysis{Title: "Backend Engineer"},
+		AvailableBullets: bullets,
+		MaxBullets:       2,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"b0", "b1"}, selection.SelectedBulletIDs)
+	assert.Equal(t, "Most relevant", selection.Reasoning)
+	assert.Len(t, selection.BulletReasons, len(bullets), "dropped bullets keep their chunk reasons")
+
+	require.Greater(t, len(prompts), 2, "bullets are selected in chunks and merged")
+	for _, prompt := range prompts {
+		assert.LessOrEqual(t, utf8.RuneCountInString(prompt)/4, maxPromptTokens)
+		assert.NotContains(t, prompt, strings.Repeat("Very long bullet. ", 40), "long bullets are cut")
+	}
+}
+
+func TestSelectBulletsInOnePrompt(t *testing.T) {
+	server, requests := newMockServer(t,
+		`{"selected_bullet_ids": ["b1"], "reasoning": "Go work", "bullet_reasons": {}}`,
+	)
+
+	client, err := groq.New(groq.Config{
+		APIKey:  "test-api-key", // pragma: allowlist secret
+		BaseURL: server.URL,
+	})
+	require.NoError(t, err)
+
+	_, err = client.SelectBullets(context.Background(), ports.SelectBulletsRequest{
+		JobAnalysis:      &ports.JobAnalysis{Title: "Backend Engineer"},
+		AvailableBullets: []domain.Bullet{{ID: "b1", Content: "Built Go services"}, {ID: "b2", Content: "Ran Kubernetes"}},
+		MaxBullets:       1,
+	})
+	require.NoError(t, err)
+	assert.Len(t, requests, 1)
+}
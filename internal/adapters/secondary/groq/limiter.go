@@ -0,0 +1,190 @@
+package groq
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// priorityCount is the number of request priorities (see ports.AIPriority).
+const priorityCount = 2
+
+// LimiterStats is a snapshot of the request limiter.
+type LimiterStats struct {
+	// MaxConcurrent is the configured concurrency limit (0 when unlimited).
+	MaxConcurrent int `json:"max_concurrent"`
+
+	// InFlight is the number of requests currently holding a slot.
+	InFlight int `json:"in_flight"`
+
+	Interactive PriorityStats `json:"interactive"`
+	Background  PriorityStats `json:"background"`
+}
+
+// PriorityStats holds queue metrics for one priority.
+type PriorityStats struct {
+	// Queued is the number of requests currently waiting for a slot.
+	Queued int `json:"queued"`
+
+	// Acquired counts requests that obtained a slot.
+	Acquired int64 `json:"acquired"`
+
+	// Waited counts requests that had to queue before obtaining a slot.
+	Waited int64 `json:"waited"`
+
+	// Canceled counts requests whose context ended while queued.
+	Canceled int64 `json:"canceled"`
+
+	// TotalWait and MaxWait measure time spent queued.
+	TotalWait time.Duration `json:"total_wait_ns"`
+	MaxWait   time.Duration `json:"max_wait_ns"`
+}
+
+// requestLimiter bounds the number of concurrent API requests. Callers that
+// find every slot taken are queued; interactive callers are served before
+// background ones, first-in first-out within each priority.
+type requestLimiter struct {
+	capacity int
+
+	mu       sync.Mutex
+	inFlight int
+	queues   [priorityCount][]chan struct{}
+	stats    [priorityCount]PriorityStats
+}
+
+// newRequestLimiter creates a limiter. A non-positive capacity disables limiting.
+func newRequestLimiter(capacity int) *requestLimiter {
+	if capacity <= 0 {
+		return nil
+	}
+	return &requestLimiter{capacity: capacity}
+}
+
+// acquire waits for a free slot and returns a function that releases it.
+func (l *requestLimiter) acquire(ctx context.Context, priority ports.AIPriority) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	p := priorityIndex(priority)
+	start := time.Now()
+
+	l.mu.Lock()
+	if l.inFlight < l.capacity && l.queuedLocked() == 0 {
+		l.inFlight++
+		l.stats[p].Acquired++
+		l.mu.Unlock()
+		return l.releaseFunc(), nil
+	}
+
+	ready := make(chan struct{})
+	l.queues[p] = append(l.queues[p], ready)
+	l.mu.Unlock()
+
+	select {
+	case <-ready:
+		wait := time.Since(start)
+
+		l.mu.Lock()
+		stats := &l.stats[p]
+		stats.Acquired++
+		stats.Waited++
+		stats.TotalWait += wait
+		if wait > stats.MaxWait {
+			stats.MaxWait = wait
+		}
+		l.mu.Unlock()
+
+		return l.releaseFunc(), nil
+
+	case <-ctx.Done():
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		l.stats[p].Canceled++
+		if !l.removeLocked(p, ready) {
+			// The slot was handed to us while the context ended; pass it on.
+			l.handOffLocked()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// releaseFunc returns an idempotent function that releases one slot.
+func (l *requestLimiter) releaseFunc() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			l.handOffLocked()
+		})
+	}
+}
+
+// handOffLocked gives a released slot to the next waiter, or frees it when
+// nobody is waiting. l.mu must be held.
+func (l *requestLimiter) handOffLocked() {
+	for p := range l.queues {
+		if len(l.queues[p]) > 0 {
+			next := l.queues[p][0]
+			l.queues[p] = l.queues[p][1:]
+			close(next)
+			return
+		}
+	}
+	l.inFlight--
+}
+
+// removeLocked removes a waiter from its queue, reporting whether it was
+// still queued. l.mu must be held.
+func (l *requestLimiter) removeLocked(p int, ready chan struct{}) bool {
+	for i, w := range l.queues[p] {
+		if w == ready {
+			l.queues[p] = append(l.queues[p][:i], l.queues[p][i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// queuedLocked returns the number of waiters. l.mu must be held.
+func (l *requestLimiter) queuedLocked() int {
+	n := 0
+	for p := range l.queues {
+		n += len(l.queues[p])
+	}
+	return n
+}
+
+// snapshot returns the current limiter statistics.
+func (l *requestLimiter) snapshot() LimiterStats {
+	if l == nil {
+		return LimiterStats{}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	interactive := l.stats[priorityIndex(ports.AIPriorityInteractive)]
+	interactive.Queued = len(l.queues[priorityIndex(ports.AIPriorityInteractive)])
+	background := l.stats[priorityIndex(ports.AIPriorityBackground)]
+	background.Queued = len(l.queues[priorityIndex(ports.AIPriorityBackground)])
+
+	return LimiterStats{
+		MaxConcurrent: l.capacity,
+		InFlight:      l.inFlight,
+		Interactive:   interactive,
+		Background:    background,
+	}
+}
+
+// priorityIndex maps a priority to its queue, treating unknown values as background.
+func priorityIndex(priority ports.AIPriority) int {
+	if priority == ports.AIPriorityInteractive {
+		return 0
+	}
+	return 1
+}
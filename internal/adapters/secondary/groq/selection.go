@@ -0,0 +1,130 @@
+package groq
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// maxPromptBulletRunes caps the characters of a bullet listed in a selection
+// or impact scoring prompt; longer bullets are cut, as their start is enough
+// to judge them.
+const maxPromptBulletRunes = 600
+
+// estimateTokens estimates the tokens of text, at about four characters per
+// token for the Llama tokenizers.
+func estimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + 3) / 4
+}
+
+// promptBullet returns the content of a bullet listed in a prompt, cut to
+// maxPromptBulletRunes.
+func promptBullet(bullet domain.Bullet) string {
+	if utf8.RuneCountInString(bullet.Content) > maxPromptBulletRunes {
+		return string([]rune(bullet.Content)[:maxPromptBulletRunes]) + "…"
+	}
+	return bullet.Content
+}
+
+// selectionLine returns the line listing the i-th bullet of a selection
+// prompt.
+func selectionLine(i int, bullet domain.Bullet) string {
+	return fmt.Sprintf("%d. [ID: %s] %s\n", i+1, bullet.ID, promptBullet(bullet))
+}
+
+// selectionChunks splits bullets, in order, into chunks whose selection
+// prompts fit MaxPromptTokens. Every chunk has at least one bullet.
+func (c *Client) selectionChunks(req ports.SelectBulletsRequest, bullets []domain.Bullet) [][]domain.Bullet {
+	if c.config.MaxPromptTokens < 0 {
+		return [][]domain.Bullet{bullets}
+	}
+
+	budget := c.config.MaxPromptTokens - estimateTokens(selectionPrompt(req, nil))
+	var chunks [][]domain.Bullet
+	var chunk []domain.Bullet
+	used := 0
+	for _, bullet := range bullets {
+		tokens := estimateTokens(selectionLine(len(chunk), bullet))
+		if len(chunk) > 0 && used+tokens > budget {
+			chunks = append(chunks, chunk)
+			chunk, used = nil, 0
+			tokens = estimateTokens(selectionLine(0, bullet))
+		}
+		chunk = append(chunk, bullet)
+		used += tokens
+	}
+	return append(chunks, chunk)
+}
+
+// selectBullets selects among bullets in one prompt when they fit, else
+// selects the best MaxBullets of each chunk concurrently and then selects
+// among those finalists, repeating until a round fits. Reasons given for
+// bullets dropped in a chunk are kept; the reasoning is the final round's.
+func (c *Client) selectBullets(ctx context.Context, req ports.SelectBulletsRequest, bullets []domain.Bullet) (*ports.BulletSelection, error) {
+	chunks := c.selectionChunks(req, bullets)
+	if len(chunks) == 1 {
+		return c.selectBulletsOnce(ctx, req, bullets)
+	}
+
+	selections := make([]*ports.BulletSelection, len(chunks))
+	errs := make([]error, len(chunks))
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		wg.Go(func() {
+			selections[i], errs[i] = c.selectBulletsOnce(ctx, req, chunk)
+		})
+	}
+	wg.Wait()
+
+	byID := make(map[string]domain.Bullet, len(bullets))
+	for _, bullet := range bullets {
+		byID[bullet.ID] = bullet
+	}
+	reasons := make(map[string]string)
+	var finalists []domain.Bullet
+	for i, selection := range selections {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("chunk %d of %d: %w", i+1, len(chunks), errs[i])
+		}
+		maps.Copy(reasons, selection.BulletReasons)
+
+		picked := 0
+		for _, id := range selection.SelectedBulletIDs {
+			bullet, ok := byID[id]
+			if !ok || picked == req.MaxBullets {
+				continue
+			}
+			delete(byID, id)
+			finalists = append(finalists, bullet)
+			picked++
+		}
+	}
+
+	// Chunks too small to narrow the bullets down would never converge;
+	// keep the best finalists in order instead of another round.
+	if len(finalists) >= len(bullets) {
+		finalists = finalists[:min(len(finalists), req.MaxBullets)]
+		ids := make([]string, 0, len(finalists))
+		for _, bullet := range finalists {
+			ids = append(ids, bullet.ID)
+		}
+		return &ports.BulletSelection{
+			SelectedBulletIDs: ids,
+			Reasoning:         selections[0].Reasoning,
+			BulletReasons:     reasons,
+		}, nil
+	}
+
+	final, err := c.selectBullets(ctx, req, finalists)
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(reasons, final.BulletReasons)
+	final.BulletReasons = reasons
+	return final, nil
+}
@@ -0,0 +1,172 @@
+package jina
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// urlGuard enforces the fetch policy for user-supplied job URLs.
+// It validates the scheme, applies the domain allowlist/blocklist and
+// rejects hosts that resolve to private or otherwise non-public addresses.
+type urlGuard struct {
+	allowedDomains       []string
+	blockedDomains       []string
+	allowPrivateNetworks bool
+	resolver             *net.Resolver
+}
+
+// newURLGuard creates a urlGuard from the client configuration.
+func newURLGuard(cfg Config) *urlGuard {
+	return &urlGuard{
+		allowedDomains:       normalizeDomains(cfg.AllowedDomains),
+		blockedDomains:       normalizeDomains(cfg.BlockedDomains),
+		allowPrivateNetworks: cfg.AllowPrivateNetworks,
+		resolver:             net.DefaultResolver,
+	}
+}
+
+// check validates a raw URL against the fetch policy and returns the parsed URL.
+func (g *urlGuard) check(ctx context.Context, rawURL string) (*url.URL, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("jina: invalid URL: %w", err)
+	}
+
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, fmt.Errorf("jina: URL must use http or https scheme: %w", domain.ErrURLNotAllowed)
+	}
+
+	if parsedURL.User != nil {
+		return nil, fmt.Errorf("jina: URL must not contain credentials: %w", domain.ErrURLNotAllowed)
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(parsedURL.Hostname(), "."))
+	if host == "" {
+		return nil, fmt.Errorf("jina: URL must contain a host: %w", domain.ErrURLNotAllowed)
+	}
+
+	if matchesDomain(host, g.blockedDomains) {
+		return nil, fmt.Errorf("jina: domain %q is blocked: %w", host, domain.ErrURLNotAllowed)
+	}
+
+	if len(g.allowedDomains) > 0 && !matchesDomain(host, g.allowedDomains) {
+		return nil, fmt.Errorf("jina: domain %q is not in the allowlist: %w", host, domain.ErrURLNotAllowed)
+	}
+
+	if g.allowPrivateNetworks {
+		return parsedURL, nil
+	}
+
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return nil, fmt.Errorf("jina: host %q is not publicly routable: %w", host, domain.ErrURLNotAllowed)
+	}
+
+	// Literal IP addresses don't need a DNS lookup.
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !isPublicAddr(addr) {
+			return nil, fmt.Errorf("jina: host %q is not publicly routable: %w", host, domain.ErrURLNotAllowed)
+		}
+		return parsedURL, nil
+	}
+
+	addrs, err := g.resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil, fmt.Errorf("jina: failed to resolve host %q: %w", host, err)
+	}
+
+	for _, addr := range addrs {
+		if !isPublicAddr(addr) {
+			return nil, fmt.Errorf("jina: host %q resolves to a non-public address: %w", host, domain.ErrURLNotAllowed)
+		}
+	}
+
+	return parsedURL, nil
+}
+
+// dialControl rejects connections to non-public addresses at dial time.
+// This protects direct fetches (such as robots.txt) against DNS rebinding.
+func (g *urlGuard) dialControl(_, address string, _ syscall.RawConn) error {
+	if g.allowPrivateNetworks {
+		return nil
+	}
+
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("jina: invalid dial address %q: %w", address, err)
+	}
+
+	if !isPublicAddr(addrPort.Addr()) {
+		return fmt.Errorf("jina: refusing to connect to %s: %w", addrPort.Addr(), domain.ErrURLNotAllowed)
+	}
+
+	return nil
+}
+
+// isPublicAddr reports whether an address is publicly routable.
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if !addr.IsValid() ||
+		addr.IsUnspecified() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reservedPrefixes lists non-public ranges not covered by the netip helpers.
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "This" network.
+	netip.MustParsePrefix("100.64.0.0/10"),   // Carrier-grade NAT.
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments.
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1.
+	netip.MustParsePrefix("198.18.0.0/15"),   // Benchmarking.
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2.
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3.
+	netip.MustParsePrefix("240.0.0.0/4"),     // Reserved.
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64.
+	netip.MustParsePrefix("2001:db8::/32"),   // Documentation.
+}
+
+// matchesDomain reports whether host equals or is a subdomain of any domain in the list.
+func matchesDomain(host string, domains []string) bool {
+	for _, d := range domains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeDomains lowercases and trims domain entries, dropping empty ones.
+func normalizeDomains(domains []string) []string {
+	normalized := make([]string, 0, len(domains))
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimSpace(d))
+		d = strings.TrimPrefix(d, "*.")
+		d = strings.Trim(d, ".")
+		if d != "" {
+			normalized = append(normalized, d)
+		}
+	}
+	return normalized
+}
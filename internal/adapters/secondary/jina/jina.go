@@ -7,15 +7,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
 	"strings"
 	"time"
 
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/circuitbreaker"
 )
 
 const (
-	readerBaseURL = "https://r.jina.ai"
+	readerBaseURL    = "https://r.jina.ai"
+	defaultUserAgent = "ChameleonVitaeBot/1.0"
 )
 
 // Config holds Jina API configuration.
@@ -28,13 +30,44 @@ type Config struct {
 
 	// MaxRetries is the maximum number of retries on errors.
 	MaxRetries int
+
+	// BaseURL is the Jina Reader base URL.
+	BaseURL string
+
+	// AllowedDomains restricts fetching to these domains and their subdomains.
+	// An empty list allows any domain not explicitly blocked.
+	AllowedDomains []string
+
+	// BlockedDomains lists domains (and their subdomains) that must never be fetched.
+	BlockedDomains []string
+
+	// AllowPrivateNetworks disables the private/reserved IP range protection.
+	// Only enable this for local development and tests.
+	AllowPrivateNetworks bool
+
+	// IgnoreRobots disables robots.txt checks before fetching a job URL.
+	IgnoreRobots bool
+
+	// UserAgent is the user agent used to fetch and evaluate robots.txt.
+	UserAgent string
+
+	// CircuitBreaker configures the breaker that fails fast while the Reader API is down.
+	CircuitBreaker circuitbreaker.Config
+
+	// HTTPClient, when set, is used for Reader API requests instead of a client
+	// built from Timeout, e.g. one sharing a pkg/httpclient transport.
+	// robots.txt is always fetched with a separate, SSRF-guarded client.
+	HTTPClient *http.Client
 }
 
 // DefaultConfig returns a Config with sensible defaults.
 func DefaultConfig() Config {
 	return Config{
-		Timeout:    30 * time.Second,
-		MaxRetries: 3,
+		Timeout:        30 * time.Second,
+		MaxRetries:     3,
+		BaseURL:        readerBaseURL,
+		UserAgent:      defaultUserAgent,
+		CircuitBreaker: circuitbreaker.DefaultConfig(),
 	}
 }
 
@@ -42,6 +75,9 @@ func DefaultConfig() Config {
 type Client struct {
 	config     Config
 	httpClient *http.Client
+	guard      *urlGuard
+	robots     *robotsChecker
+	breaker    *circuitbreaker.Breaker
 }
 
 // New creates a new Jina API client.
@@ -56,29 +92,51 @@ func New(cfg Config) (*Client, error) {
 	if cfg.MaxRetries == 0 {
 		cfg.MaxRetries = DefaultConfig().MaxRetries
 	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultConfig().BaseURL
+	}
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	if cfg.UserAgent == "" {
+		cfg.UserAgent = DefaultConfig().UserAgent
+	}
+
+	guard := newURLGuard(cfg)
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
 
 	return &Client{
-		config: cfg,
-		httpClient: &http.Client{
-			Timeout: cfg.Timeout,
-		},
+		config:     cfg,
+		httpClient: httpClient,
+		guard:      guard,
+		robots:     newRobotsChecker(cfg.UserAgent, cfg.Timeout, guard),
+		breaker:    circuitbreaker.New("jina", cfg.CircuitBreaker),
 	}, nil
 }
 
 // ParseJobURL fetches and parses a job description from a URL.
 func (c *Client) ParseJobURL(ctx context.Context, jobURL string) (*ports.ParsedJob, error) {
-	// Validate URL.
-	parsedURL, err := url.Parse(jobURL)
+	// Validate URL against the fetch policy (scheme, domain lists, SSRF).
+	parsedURL, err := c.guard.check(ctx, jobURL)
 	if err != nil {
-		return nil, fmt.Errorf("jina: invalid URL: %w", err)
+		return nil, err
 	}
 
-	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
-		return nil, fmt.Errorf("jina: URL must use http or https scheme")
+	// Respect the site's robots.txt.
+	if !c.config.IgnoreRobots {
+		allowed, err := c.robots.allowed(ctx, parsedURL)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, fmt.Errorf("jina: fetching disallowed by robots.txt: %w", domain.ErrURLNotAllowed)
+		}
 	}
 
 	// Build Jina Reader URL.
-	readerURL := fmt.Sprintf("%s/%s", readerBaseURL, jobURL)
+	readerURL := fmt.Sprintf("%s/%s", c.config.BaseURL, jobURL)
 
 	var lastErr error
 	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
@@ -92,7 +150,13 @@ func (c *Client) ParseJobURL(ctx context.Context, jobURL string) (*ports.ParsedJ
 			}
 		}
 
-		result, err := c.doRequest(ctx, readerURL)
+		done, err := c.breaker.Allow()
+		if err != nil {
+			return nil, fmt.Errorf("jina: %w: %w", domain.ErrUpstreamUnavailable, err)
+		}
+
+		result, upstreamFailed, err := c.doRequest(ctx, readerURL)
+		done(upstreamFailed && ctx.Err() == nil)
 		if err != nil {
 			lastErr = err
 			continue
@@ -115,7 +179,7 @@ func (c *Client) ParseJobURL(ctx context.Context, jobURL string) (*ports.ParsedJ
 func (c *Client) HealthCheck(ctx context.Context) error {
 	// Jina doesn't have a dedicated health endpoint.
 	// We'll try to parse a simple URL as a health check.
-	req, err := http.NewRequestWithContext(ctx, http.MethodHead, readerBaseURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c.config.BaseURL, nil)
 	if err != nil {
 		return fmt.Errorf("jina: failed to create health check request: %w", err)
 	}
@@ -141,6 +205,7 @@ func (c *Client) HealthCheck(ctx context.Context) error {
 // Close releases any resources held by the job parser.
 func (c *Client) Close() error {
 	c.httpClient.CloseIdleConnections()
+	c.robots.httpClient.CloseIdleConnections()
 	return nil
 }
 
@@ -152,11 +217,13 @@ type readerResult struct {
 	Metadata      map[string]string
 }
 
-// doRequest performs the HTTP request to Jina Reader.
-func (c *Client) doRequest(ctx context.Context, url string) (*readerResult, error) {
+// doRequest performs the HTTP request to Jina Reader. The boolean reports
+// whether the failure lies with the Reader API itself (network errors and
+// server errors) rather than with the request or the target page.
+func (c *Client) doRequest(ctx context.Context, url string) (*readerResult, bool, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers.
@@ -167,17 +234,17 @@ func (c *Client) doRequest(ctx context.Context, url string) (*readerResult, erro
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, true, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, true, fmt.Errorf("failed to read response: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		return nil, resp.StatusCode >= http.StatusInternalServerError, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
 	}
 
 	// Try to parse as JSON first.
@@ -204,7 +271,7 @@ func (c *Client) doRequest(ctx context.Context, url string) (*readerResult, erro
 			Content:       jsonResp.Data.Content,
 			PublishedDate: jsonResp.Data.PublishTime,
 			Metadata:      metadata,
-		}, nil
+		}, false, nil
 	}
 
 	// Fallback: treat response as plain markdown text.
@@ -215,7 +282,7 @@ func (c *Client) doRequest(ctx context.Context, url string) (*readerResult, erro
 		Title:    title,
 		Content:  content,
 		Metadata: make(map[string]string),
-	}, nil
+	}, false, nil
 }
 
 // extractTitle attempts to extract a title from markdown content.
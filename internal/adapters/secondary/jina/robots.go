@@ -0,0 +1,285 @@
+package jina
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// robotsCacheTTL is how long a parsed robots.txt is reused for a host.
+	robotsCacheTTL = time.Hour
+
+	// robotsCacheMaxEntries caps the number of hosts whose rules are cached.
+	robotsCacheMaxEntries = 1000
+
+	// robotsMaxSize caps the robots.txt body size (RFC 9309 requires at least 500 KiB).
+	robotsMaxSize = 512 * 1024
+)
+
+// robotsRule is a single Allow/Disallow directive.
+type robotsRule struct {
+	allow   bool
+	pattern string
+}
+
+// robotsRules is the set of rules that apply to our user agent on a host.
+type robotsRules struct {
+	rules     []robotsRule
+	fetchedAt time.Time
+}
+
+// allowed reports whether the given path (including query) may be fetched.
+// The longest matching rule wins; on a tie, Allow wins.
+func (r *robotsRules) allowed(path string) bool {
+	bestLen := -1
+	allow := true
+
+	for _, rule := range r.rules {
+		if !matchRobotsPattern(rule.pattern, path) {
+			continue
+		}
+		if len(rule.pattern) > bestLen || (len(rule.pattern) == bestLen && rule.allow) {
+			bestLen = len(rule.pattern)
+			allow = rule.allow
+		}
+	}
+
+	return allow
+}
+
+// robotsChecker fetches, caches and evaluates robots.txt files.
+type robotsChecker struct {
+	userAgent  string
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	cache map[string]*robotsRules
+}
+
+// newRobotsChecker creates a robotsChecker whose connections are vetted by the guard.
+// It never uses a proxy: the guard vets the address dialed, which would be
+// the proxy's rather than the target's.
+func newRobotsChecker(userAgent string, timeout time.Duration, guard *urlGuard) *robotsChecker {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: guard.dialControl,
+	}
+
+	return &robotsChecker{
+		userAgent: userAgent,
+		httpClient: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				DialContext: dialer.DialContext,
+			},
+		},
+		cache: make(map[string]*robotsRules),
+	}
+}
+
+// allowed reports whether the target URL may be fetched according to its host's robots.txt.
+func (c *robotsChecker) allowed(ctx context.Context, target *url.URL) (bool, error) {
+	rules, err := c.rulesFor(ctx, target)
+	if err != nil {
+		return false, err
+	}
+
+	path := target.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if target.RawQuery != "" {
+		path += "?" + target.RawQuery
+	}
+
+	return rules.allowed(path), nil
+}
+
+// rulesFor returns the cached rules for the target's origin, fetching them if needed.
+func (c *robotsChecker) rulesFor(ctx context.Context, target *url.URL) (*robotsRules, error) {
+	origin := target.Scheme + "://" + target.Host
+
+	c.mu.Lock()
+	cached, ok := c.cache[origin]
+	c.mu.Unlock()
+
+	if ok && time.Since(cached.fetchedAt) < robotsCacheTTL {
+		return cached, nil
+	}
+
+	rules, err := c.fetch(ctx, origin)
+	if err != nil {
+		return nil, err
+	}
+
+	c.store(origin, rules)
+
+	return rules, nil
+}
+
+// store caches the rules of an origin, evicting stale rules (and, if still
+// full, the oldest) when the cache is at capacity.
+func (c *robotsChecker) store(origin string, rules *robotsRules) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.cache[origin]; !ok && len(c.cache) >= robotsCacheMaxEntries {
+		var oldestOrigin string
+		var oldest time.Time
+		for o, cached := range c.cache {
+			if time.Since(cached.fetchedAt) >= robotsCacheTTL {
+				delete(c.cache, o)
+				continue
+			}
+			if oldestOrigin == "" || cached.fetchedAt.Before(oldest) {
+				oldestOrigin, oldest = o, cached.fetchedAt
+			}
+		}
+		if len(c.cache) >= robotsCacheMaxEntries {
+			delete(c.cache, oldestOrigin)
+		}
+	}
+
+	c.cache[origin] = rules
+}
+
+// fetch downloads and parses robots.txt for an origin.
+// Following RFC 9309, a 4xx response means no restrictions while a 5xx
+// response means the site is fully disallowed. A network failure is
+// returned as an error, failing the fetch without caching anything, so the
+// next request tries again.
+func (c *robotsChecker) fetch(ctx context.Context, origin string) (*robotsRules, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin+"/robots.txt", nil)
+	if err != nil {
+		return nil, fmt.Errorf("jina: failed to create robots.txt request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("jina: failed to fetch robots.txt: %w", err)
+	}
+	defer resp.Body.Close()
+
+	now := time.Now()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return &robotsRules{
+			rules:     parseRobots(io.LimitReader(resp.Body, robotsMaxSize), c.userAgent),
+			fetchedAt: now,
+		}, nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		return &robotsRules{fetchedAt: now}, nil
+	default:
+		return &robotsRules{
+			rules:     []robotsRule{{allow: false, pattern: "/"}},
+			fetchedAt: now,
+		}, nil
+	}
+}
+
+// parseRobots extracts the rules that apply to userAgent from a robots.txt body.
+// Groups naming the user agent take precedence over the wildcard group.
+func parseRobots(r io.Reader, userAgent string) []robotsRule {
+	token := strings.ToLower(userAgentToken(userAgent))
+
+	var (
+		specific, wildcard []robotsRule
+		groupAgents        []string
+		inRules            bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			// A user-agent line after rules starts a new group.
+			if inRules {
+				groupAgents = nil
+				inRules = false
+			}
+			groupAgents = append(groupAgents, strings.ToLower(value))
+		case "allow", "disallow":
+			inRules = true
+			if value == "" {
+				// An empty Disallow means "allow everything" and adds no rule.
+				continue
+			}
+			rule := robotsRule{allow: key == "allow", pattern: value}
+			for _, agent := range groupAgents {
+				switch {
+				case agent == "*":
+					wildcard = append(wildcard, rule)
+				case token != "" && agent == token:
+					specific = append(specific, rule)
+				}
+			}
+		}
+	}
+
+	if len(specific) > 0 {
+		return specific
+	}
+	return wildcard
+}
+
+// userAgentToken returns the product token of a user agent string (e.g. "Bot" from "Bot/1.0").
+func userAgentToken(userAgent string) string {
+	token, _, _ := strings.Cut(userAgent, "/")
+	return strings.TrimSpace(token)
+}
+
+// matchRobotsPattern reports whether path matches a robots.txt pattern.
+// Supports the "*" wildcard and the "$" end anchor.
+func matchRobotsPattern(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	if anchored {
+		pattern = strings.TrimSuffix(pattern, "$")
+	}
+
+	parts := strings.Split(pattern, "*")
+
+	// The first part must be a prefix of the path.
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	pos := len(parts[0])
+
+	for i := 1; i < len(parts); i++ {
+		part := parts[i]
+		if i == len(parts)-1 && anchored {
+			return strings.HasSuffix(path[pos:], part)
+		}
+		idx := strings.Index(path[pos:], part)
+		if idx < 0 {
+			return false
+		}
+		pos += idx + len(part)
+	}
+
+	if anchored {
+		return pos == len(path)
+	}
+	return true
+}
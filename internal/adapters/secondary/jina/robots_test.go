@@ -0,0 +1,98 @@
+package jina
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRobotsChecker() *robotsChecker {
+	return newRobotsChecker("TestBot/1.0", time.Second, newURLGuard(Config{AllowPrivateNetworks: true}))
+}
+
+func TestRobotsCheckerBypassesProxy(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://proxy.invalid:3128")
+	t.Setenv("HTTP_PROXY", "http://proxy.invalid:3128")
+
+	transport, ok := newTestRobotsChecker().httpClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.Nil(t, transport.Proxy)
+}
+
+func TestRobotsCheckerNetworkFailureIsNotCached(t *testing.T) {
+	var fetches atomic.Int32
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		_, _ = w.Write([]byte("User-agent: *\nDisallow: /private\n"))
+	}))
+	defer site.Close()
+
+	target, err := url.Parse(site.URL + "/careers/1")
+	require.NoError(t, err)
+
+	checker := newTestRobotsChecker()
+
+	// Nothing listens on the closed server's address.
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	unreachable, err := url.Parse(closed.URL + "/careers/1")
+	require.NoError(t, err)
+
+	_, err = checker.allowed(t.Context(), unreachable)
+	require.Error(t, err)
+	assert.Empty(t, checker.cache)
+
+	allowed, err := checker.allowed(t.Context(), target)
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	_, err = checker.allowed(t.Context(), target)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+}
+
+func TestRobotsCheckerCacheIsBounded(t *testing.T) {
+	checker := newTestRobotsChecker()
+	now := time.Now()
+
+	origin := func(i int) string { return fmt.Sprintf("https://site%d.example", i) }
+
+	t.Run("evicts the oldest rules when full", func(t *testing.T) {
+		for i := range robotsCacheMaxEntries {
+			checker.store(origin(i), &robotsRules{fetchedAt: now.Add(time.Duration(i) * time.Second)})
+		}
+
+		checker.store("https://new.example", &robotsRules{fetchedAt: now})
+
+		assert.Len(t, checker.cache, robotsCacheMaxEntries)
+		assert.NotContains(t, checker.cache, origin(0))
+		assert.Contains(t, checker.cache, origin(1))
+		assert.Contains(t, checker.cache, "https://new.example")
+	})
+
+	t.Run("sweeps stale rules first", func(t *testing.T) {
+		stale := now.Add(-2 * robotsCacheTTL)
+		for i := range 10 {
+			checker.cache[origin(i+1)].fetchedAt = stale
+		}
+
+		checker.store("https://newer.example", &robotsRules{fetchedAt: now})
+
+		assert.Len(t, checker.cache, robotsCacheMaxEntries-9)
+		assert.NotContains(t, checker.cache, origin(1))
+		assert.Contains(t, checker.cache, origin(11))
+	})
+
+	t.Run("replacing cached rules evicts nothing", func(t *testing.T) {
+		size := len(checker.cache)
+		checker.store("https://newer.example", &robotsRules{fetchedAt: now})
+		assert.Len(t, checker.cache, size)
+	})
+}
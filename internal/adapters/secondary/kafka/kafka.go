@@ -0,0 +1,154 @@
+// Package kafka provides an event publisher for Kafka.
+//
+// Events are produced as JSON records through the v2 API of a Kafka REST
+// Proxy (Confluent REST Proxy, Redpanda HTTP Proxy), so no Kafka client
+// library or broker connection is needed. Records are keyed by user ID,
+// which keeps the events of a user in order within a partition.
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// Config holds Kafka configuration.
+type Config struct {
+	// RESTProxyURL is the base URL of the Kafka REST Proxy.
+	RESTProxyURL string
+
+	// Topic receives all events; the event type is in the record value.
+	Topic string
+
+	// Timeout bounds producing one event.
+	Timeout time.Duration
+
+	// HTTPClient, when set, is used for requests instead of a client built
+	// from Timeout, e.g. one sharing a pkg/httpclient transport.
+	HTTPClient *http.Client
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		RESTProxyURL: "http://localhost:8082",
+		Topic:        "chameleon-events",
+		Timeout:      10 * time.Second,
+	}
+}
+
+// Client implements ports.EventPublisher using a Kafka REST Proxy.
+type Client struct {
+	config     Config
+	httpClient *http.Client
+
+	// endpoint is the produce URL of the topic.
+	endpoint string
+}
+
+var _ ports.EventPublisher = (*Client)(nil)
+
+// New creates a new Kafka client.
+func New(cfg Config) (*Client, error) {
+	defaults := DefaultConfig()
+	if cfg.RESTProxyURL == "" {
+		cfg.RESTProxyURL = defaults.RESTProxyURL
+	}
+	if cfg.Topic == "" {
+		cfg.Topic = defaults.Topic
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+
+	base, err := url.Parse(cfg.RESTProxyURL)
+	if err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return nil, fmt.Errorf("kafka: REST proxy URL must be an absolute http or https URL")
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	return &Client{
+		config:     cfg,
+		httpClient: httpClient,
+		endpoint:   strings.TrimSuffix(cfg.RESTProxyURL, "/") + "/topics/" + url.PathEscape(cfg.Topic),
+	}, nil
+}
+
+// produceRequest is the body of a v2 produce request with embedded JSON.
+type produceRequest struct {
+	Records []produceRecord `json:"records"`
+}
+
+type produceRecord struct {
+	Key   string             `json:"key"`
+	Value domain.OutboxEvent `json:"value"`
+}
+
+// produceResponse is the part of a v2 produce response the client checks.
+type produceResponse struct {
+	Offsets []struct {
+		ErrorCode *int    `json:"error_code"`
+		Error     *string `json:"error"`
+	} `json:"offsets"`
+}
+
+// Publish produces an event to the topic.
+func (c *Client) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	body, err := json.Marshal(produceRequest{Records: []produceRecord{{Key: event.UserID, Value: event}}})
+	if err != nil {
+		return fmt.Errorf("kafka: encode event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("kafka: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("kafka: produce event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return fmt.Errorf("kafka: read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("kafka: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	// The request succeeds as a whole even when a record is rejected.
+	var produced produceResponse
+	if err := json.Unmarshal(respBody, &produced); err != nil {
+		return fmt.Errorf("kafka: decode response: %w", err)
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil || offset.Error != nil {
+			message := "unknown error"
+			if offset.Error != nil {
+				message = *offset.Error
+			}
+			return fmt.Errorf("kafka: record rejected: %s", message)
+		}
+	}
+	return nil
+}
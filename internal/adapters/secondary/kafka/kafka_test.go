@@ -0,0 +1,93 @@
+// Package kafka_test contains unit tests for the Kafka event publisher.
+package kafka_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/kafka"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		_, err := kafka.New(kafka.Config{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects a relative URL", func(t *testing.T) {
+		_, err := kafka.New(kafka.Config{RESTProxyURL: "kafka-rest:8082"})
+		assert.Error(t, err)
+	})
+}
+
+func TestPublish(t *testing.T) {
+	event := domain.OutboxEvent{
+		ID:        "event-1",
+		Type:      domain.EventResumeTailored,
+		UserID:    "user-1",
+		ResumeID:  "resume-1",
+		Data:      map[string]string{"score": "87"},
+		CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	t.Run("produces a record keyed by user", func(t *testing.T) {
+		var path, contentType string
+		var request struct {
+			Records []struct {
+				Key   string             `json:"key"`
+				Value domain.OutboxEvent `json:"value"`
+			} `json:"records"`
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.Path
+			contentType = r.Header.Get("Content-Type")
+			_ = json.NewDecoder(r.Body).Decode(&request)
+			_, _ = w.Write([]byte(`{"offsets":[{"partition":0,"offset":42,"error_code":null,"error":null}]}`))
+		}))
+		defer server.Close()
+
+		client, err := kafka.New(kafka.Config{RESTProxyURL: server.URL + "/", Topic: "cv-events"})
+		require.NoError(t, err)
+		require.NoError(t, client.Publish(context.Background(), event))
+
+		assert.Equal(t, "/topics/cv-events", path)
+		assert.Equal(t, "application/vnd.kafka.json.v2+json", contentType)
+		require.Len(t, request.Records, 1)
+		assert.Equal(t, "user-1", request.Records[0].Key)
+		assert.Equal(t, "event-1", request.Records[0].Value.ID)
+		assert.Equal(t, domain.EventResumeTailored, request.Records[0].Value.Type)
+	})
+
+	t.Run("fails on rejected records", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"offsets":[{"partition":null,"offset":null,"error_code":40403,"error":"Topic not found"}]}`))
+		}))
+		defer server.Close()
+
+		client, err := kafka.New(kafka.Config{RESTProxyURL: server.URL})
+		require.NoError(t, err)
+
+		err = client.Publish(context.Background(), event)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Topic not found")
+	})
+
+	t.Run("fails on error statuses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client, err := kafka.New(kafka.Config{RESTProxyURL: server.URL})
+		require.NoError(t, err)
+		assert.Error(t, client.Publish(context.Background(), event))
+	})
+}
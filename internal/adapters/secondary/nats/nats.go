@@ -0,0 +1,148 @@
+// Package nats provides an event publisher for NATS JetStream.
+//
+// Events are published as JSON to <prefix>.<event type>, e.g.
+// chameleon.resume.tailored, which a JetStream stream must capture. The
+// Nats-Msg-Id header holds the event ID so the stream drops redelivered
+// events, and each publish waits for the stream to acknowledge it. The client
+// keeps one connection, reconnecting when it drops, until it is closed.
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// clientName identifies this adapter to the server.
+const clientName = "chameleon-vitae"
+
+// Config holds NATS configuration.
+type Config struct {
+	// URL is the server URL, nats://host:port or tls://host:port, with
+	// optional user:password credentials. Several servers of a cluster can
+	// be given separated by commas.
+	URL string
+
+	// Token authenticates with a token instead of credentials.
+	Token string
+
+	// CredsFile is the path of a credentials file holding the user JWT and
+	// nkey seed to authenticate with.
+	CredsFile string
+
+	// SubjectPrefix is prepended to the event types to form subjects.
+	SubjectPrefix string
+
+	// Timeout bounds connecting and each publish, up to the acknowledgement.
+	Timeout time.Duration
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		URL:           "nats://localhost:4222",
+		SubjectPrefix: "chameleon",
+		Timeout:       10 * time.Second,
+	}
+}
+
+// Client implements ports.EventPublisher using NATS JetStream.
+type Client struct {
+	config Config
+	conn   *natsgo.Conn
+	js     jetstream.JetStream
+}
+
+var _ ports.EventPublisher = (*Client)(nil)
+
+// New creates a new NATS client and connects to the server. When the server
+// cannot be reached yet, the client keeps trying in the background and
+// publishes fail until it connects.
+func New(cfg Config) (*Client, error) {
+	defaults := DefaultConfig()
+	if cfg.URL == "" {
+		cfg.URL = defaults.URL
+	}
+	if cfg.SubjectPrefix == "" {
+		cfg.SubjectPrefix = defaults.SubjectPrefix
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+
+	for _, server := range strings.Split(cfg.URL, ",") {
+		serverURL, err := url.Parse(strings.TrimSpace(server))
+		if err != nil || (serverURL.Scheme != "nats" && serverURL.Scheme != "tls") || serverURL.Hostname() == "" {
+			return nil, fmt.Errorf("nats: URL must be nats://host:port or tls://host:port")
+		}
+	}
+	if strings.ContainsAny(cfg.SubjectPrefix, " \t\r\n*>") {
+		return nil, fmt.Errorf("nats: invalid subject prefix %q", cfg.SubjectPrefix)
+	}
+
+	options := []natsgo.Option{
+		natsgo.Name(clientName),
+		natsgo.Timeout(cfg.Timeout),
+		natsgo.RetryOnFailedConnect(true),
+		natsgo.MaxReconnects(-1),
+	}
+	if cfg.Token != "" {
+		options = append(options, natsgo.Token(cfg.Token))
+	}
+	if cfg.CredsFile != "" {
+		options = append(options, natsgo.UserCredentials(cfg.CredsFile))
+	}
+
+	conn, err := natsgo.Connect(cfg.URL, options...)
+	if err != nil {
+		return nil, fmt.Errorf("nats: failed to connect: %w", err)
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("nats: failed to create JetStream context: %w", err)
+	}
+
+	return &Client{config: cfg, conn: conn, js: js}, nil
+}
+
+// Subject returns the subject an event type is published to.
+func (c *Client) Subject(eventType domain.OutboxEventType) string {
+	return c.config.SubjectPrefix + "." + string(eventType)
+}
+
+// Publish publishes an event and waits for the stream to acknowledge it.
+func (c *Client) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("nats: encode event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	msg := natsgo.NewMsg(c.Subject(event.Type))
+	msg.Data = payload
+	if _, err := c.js.PublishMsg(ctx, msg, jetstream.WithMsgID(event.ID)); err != nil {
+		return fmt.Errorf("nats: failed to publish: %w", err)
+	}
+	return nil
+}
+
+// Close drains the connection, waiting for pending publishes, and closes it.
+func (c *Client) Close() error {
+	if err := c.conn.Drain(); err != nil {
+		c.conn.Close()
+		return fmt.Errorf("nats: failed to drain connection: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Package nats_test contains unit tests for the NATS event publisher.
+package nats_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/nats"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// testToken authenticates with the servers of runServer.
+const testToken = "test-token" // pragma: allowlist secret
+
+// runServer starts an in-process JetStream server requiring testToken, with
+// a stream capturing the subjects under prefix, and returns it and the stream.
+func runServer(t *testing.T, prefix string) (*server.Server, jetstream.Stream) {
+	t.Helper()
+
+	srv, err := server.NewServer(&server.Options{
+		Host:          "127.0.0.1",
+		Port:          server.RANDOM_PORT,
+		JetStream:     true,
+		StoreDir:      t.TempDir(),
+		Authorization: testToken,
+		NoLog:         true,
+		NoSigs:        true,
+	})
+	require.NoError(t, err)
+	go srv.Start()
+	t.Cleanup(srv.Shutdown)
+	require.True(t, srv.ReadyForConnections(5*time.Second), "server did not start")
+
+	conn, err := natsgo.Connect(srv.ClientURL(), natsgo.Token(testToken))
+	require.NoError(t, err)
+	t.Cleanup(conn.Close)
+	js, err := jetstream.New(conn)
+	require.NoError(t, err)
+
+	stream, err := js.CreateStream(context.Background(), jetstream.StreamConfig{
+		Name:     "EVENTS",
+		Subjects: []string{prefix + ".>"},
+	})
+	require.NoError(t, err)
+
+	return srv, stream
+}
+
+// newClient creates a client and closes it when the test ends.
+func newClient(t *testing.T, cfg nats.Config) *nats.Client {
+	t.Helper()
+	client, err := nats.New(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestNew(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		// Nothing listens there: the client keeps trying in the background.
+		client := newClient(t, nats.Config{URL: "nats://127.0.0.1:1"})
+		assert.Equal(t, "chameleon.resume.tailored", client.Subject(domain.EventResumeTailored))
+	})
+
+	t.Run("rejects other schemes", func(t *testing.T) {
+		_, err := nats.New(nats.Config{URL: "http://localhost:4222"})
+		assert.Error(t, err)
+
+		_, err = nats.New(nats.Config{URL: "nats://localhost:4222, http://localhost:4223"})
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects wildcard prefixes", func(t *testing.T) {
+		_, err := nats.New(nats.Config{SubjectPrefix: "events.>"})
+		assert.Error(t, err)
+	})
+}
+
+func TestPublish(t *testing.T) {
+	ctx := context.Background()
+	event := domain.OutboxEvent{
+		ID:        "event-1",
+		Type:      domain.EventUserCreated,
+		UserID:    "user-1",
+		CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	t.Run("publishes with the event ID header", func(t *testing.T) {
+		srv, stream := runServer(t, "cv")
+		client := newClient(t, nats.Config{URL: srv.ClientURL(), Token: testToken, SubjectPrefix: "cv", Timeout: 5 * time.Second})
+
+		require.NoError(t, client.Publish(ctx, event))
+
+		msg, err := stream.GetLastMsgForSubject(ctx, "cv.user.created")
+		require.NoError(t, err)
+		assert.Equal(t, "event-1", msg.Header.Get(natsgo.MsgIdHdr))
+
+		var received domain.OutboxEvent
+		require.NoError(t, json.Unmarshal(msg.Data, &received))
+		assert.Equal(t, event.ID, received.ID)
+		assert.Equal(t, event.UserID, received.UserID)
+	})
+
+	t.Run("stream drops redelivered events", func(t *testing.T) {
+		srv, stream := runServer(t, "chameleon")
+		client := newClient(t, nats.Config{URL: srv.ClientURL(), Token: testToken, Timeout: 5 * time.Second})
+
+		for range 3 {
+			require.NoError(t, client.Publish(ctx, event))
+		}
+		other := event
+		other.ID = "event-2"
+		require.NoError(t, client.Publish(ctx, other))
+
+		info, err := stream.Info(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), info.State.Msgs)
+	})
+
+	t.Run("reuses its connection", func(t *testing.T) {
+		srv, _ := runServer(t, "chameleon")
+		before := srv.NumClients()
+		client := newClient(t, nats.Config{URL: srv.ClientURL(), Token: testToken, Timeout: 5 * time.Second})
+
+		for range 5 {
+			require.NoError(t, client.Publish(ctx, event))
+		}
+		assert.Equal(t, before+1, srv.NumClients())
+	})
+
+	t.Run("fails without a stream capturing the subject", func(t *testing.T) {
+		srv, _ := runServer(t, "other")
+		client := newClient(t, nats.Config{URL: srv.ClientURL(), Token: testToken, Timeout: 5 * time.Second})
+
+		err := client.Publish(ctx, event)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "nats: failed to publish")
+	})
+
+	t.Run("fails when the server is unreachable", func(t *testing.T) {
+		client := newClient(t, nats.Config{URL: "nats://127.0.0.1:1", Timeout: 100 * time.Millisecond})
+
+		assert.Error(t, client.Publish(ctx, event))
+	})
+}
@@ -0,0 +1,480 @@
+// Package oidc provides a generic OpenID Connect authentication adapter.
+//
+// It verifies ID tokens issued by any OIDC-compliant identity provider
+// (Keycloak, Auth0, Okta, Azure AD, Google Workspace, Authentik, ...) against
+// the provider's published JSON Web Key Set, as an alternative to Firebase.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// discoveryPath is appended to the issuer URL to fetch the provider metadata.
+const discoveryPath = "/.well-known/openid-configuration"
+
+// unknownKeyRefreshInterval limits how often a token signed with an unknown
+// key ID can trigger a JWKS refresh, so forged tokens cannot flood the provider.
+const unknownKeyRefreshInterval = time.Minute
+
+// maxMetadataSize caps the size of discovery and JWKS responses.
+const maxMetadataSize = 1 << 20
+
+var (
+	// ErrInvalidToken is returned when the token is invalid or malformed.
+	ErrInvalidToken = errors.New("invalid token")
+
+	// ErrTokenExpired is returned when the token has expired.
+	ErrTokenExpired = errors.New("token expired")
+
+	// ErrMissingIssuerURL is returned when the issuer URL is not provided.
+	ErrMissingIssuerURL = errors.New("oidc issuer URL is required")
+
+	// ErrMissingClientID is returned when the client ID is not provided.
+	ErrMissingClientID = errors.New("oidc client ID is required")
+)
+
+// asymmetricAlgorithms are the signature algorithms accepted for keys from the JWKS.
+var asymmetricAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// symmetricAlgorithms are the signature algorithms accepted for tokens signed
+// with the client secret.
+var symmetricAlgorithms = []jose.SignatureAlgorithm{jose.HS256, jose.HS384, jose.HS512}
+
+// ClaimMapping names the token claims mapped to user fields. Nested claims
+// can be addressed with dots, e.g. "profile.email".
+type ClaimMapping struct {
+	// UserID is the claim holding the stable user identifier (default "sub").
+	UserID string
+
+	// Email is the claim holding the email address (default "email").
+	Email string
+
+	// EmailVerified is the claim holding the email verification flag
+	// (default "email_verified").
+	EmailVerified string
+
+	// Name is the claim holding the display name (default "name").
+	Name string
+
+	// Picture is the claim holding the profile picture URL (default "picture").
+	Picture string
+
+	// Provider is the claim naming the upstream identity provider, e.g. a
+	// Keycloak "identity_provider" claim. When empty or absent the provider
+	// is reported as "oidc".
+	Provider string
+}
+
+// Config holds the configuration for the OIDC auth adapter.
+type Config struct {
+	// IssuerURL is the provider's issuer identifier (required). It must match
+	// the "iss" claim of the tokens.
+	IssuerURL string
+
+	// ClientID is the client registered with the provider (required). Tokens
+	// must list it in their "aud" claim.
+	ClientID string
+
+	// ClientSecret is the client secret. When set, tokens signed with it
+	// (HS256/HS384/HS512) are accepted in addition to JWKS-signed tokens.
+	ClientSecret string
+
+	// JWKSURL overrides the jwks_uri advertised by the discovery document.
+	JWKSURL string
+
+	// JWKSRefreshInterval is how often the key set is refreshed in the background.
+	JWKSRefreshInterval time.Duration
+
+	// ClockSkew is the leeway allowed when checking token timestamps.
+	ClockSkew time.Duration
+
+	// Claims maps token claims to user fields.
+	Claims ClaimMapping
+
+	// Timeout is the HTTP request timeout for discovery and JWKS requests.
+	Timeout time.Duration
+
+	// HTTPClient, when set, is used for provider requests instead of a client
+	// built from Timeout, e.g. one sharing a pkg/httpclient transport.
+	HTTPClient *http.Client
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		JWKSRefreshInterval: time.Hour,
+		ClockSkew:           time.Minute,
+		Claims: ClaimMapping{
+			UserID:        "sub",
+			Email:         "email",
+			EmailVerified: "email_verified",
+			Name:          "name",
+			Picture:       "picture",
+		},
+		Timeout: 10 * time.Second,
+	}
+}
+
+// Adapter implements the ports.AuthProvider interface for OIDC providers.
+type Adapter struct {
+	config     Config
+	httpClient *http.Client
+	jwksURL    string
+
+	mu          sync.RWMutex
+	keys        jose.JSONWebKeySet
+	refreshedAt time.Time
+
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// New creates a new OIDC authentication adapter. It fetches the provider's
+// discovery document and key set, then refreshes the keys in the background.
+func New(ctx context.Context, cfg Config) (*Adapter, error) {
+	if cfg.IssuerURL == "" {
+		return nil, ErrMissingIssuerURL
+	}
+	if cfg.ClientID == "" {
+		return nil, ErrMissingClientID
+	}
+
+	defaults := DefaultConfig()
+	cfg.IssuerURL = strings.TrimSuffix(cfg.IssuerURL, "/")
+	if cfg.JWKSRefreshInterval == 0 {
+		cfg.JWKSRefreshInterval = defaults.JWKSRefreshInterval
+	}
+	if cfg.ClockSkew == 0 {
+		cfg.ClockSkew = defaults.ClockSkew
+	}
+	if cfg.Claims.UserID == "" {
+		cfg.Claims.UserID = defaults.Claims.UserID
+	}
+	if cfg.Claims.Email == "" {
+		cfg.Claims.Email = defaults.Claims.Email
+	}
+	if cfg.Claims.EmailVerified == "" {
+		cfg.Claims.EmailVerified = defaults.Claims.EmailVerified
+	}
+	if cfg.Claims.Name == "" {
+		cfg.Claims.Name = defaults.Claims.Name
+	}
+	if cfg.Claims.Picture == "" {
+		cfg.Claims.Picture = defaults.Claims.Picture
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	a := &Adapter{
+		config:     cfg,
+		httpClient: httpClient,
+		jwksURL:    cfg.JWKSURL,
+		stop:       make(chan struct{}),
+	}
+
+	if a.jwksURL == "" {
+		jwksURL, err := a.discover(ctx)
+		if err != nil {
+			return nil, err
+		}
+		a.jwksURL = jwksURL
+	}
+
+	if err := a.refreshKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	go a.refreshLoop()
+
+	log.Info().
+		Str("issuer", cfg.IssuerURL).
+		Str("jwks_url", a.jwksURL).
+		Msg("oidc: adapter initialized successfully")
+
+	return a, nil
+}
+
+// VerifyToken validates an OIDC ID token and returns the mapped claims.
+func (a *Adapter) VerifyToken(ctx context.Context, idToken string) (*ports.AuthClaims, error) {
+	if idToken == "" {
+		return nil, ErrInvalidToken
+	}
+
+	algorithms := asymmetricAlgorithms
+	if a.config.ClientSecret != "" {
+		algorithms = append(append([]jose.SignatureAlgorithm{}, asymmetricAlgorithms...), symmetricAlgorithms...)
+	}
+
+	token, err := jwt.ParseSigned(idToken, algorithms)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	if len(token.Headers) != 1 {
+		return nil, fmt.Errorf("%w: expected exactly one signature", ErrInvalidToken)
+	}
+
+	var (
+		std jwt.Claims
+		raw map[string]any
+	)
+	if err := a.verifySignature(ctx, token, &std, &raw); err != nil {
+		return nil, err
+	}
+
+	if std.Expiry == nil {
+		return nil, fmt.Errorf("%w: missing exp claim", ErrInvalidToken)
+	}
+	err = std.ValidateWithLeeway(jwt.Expected{
+		Issuer:      a.config.IssuerURL,
+		AnyAudience: jwt.Audience{a.config.ClientID},
+		Time:        time.Now(),
+	}, a.config.ClockSkew)
+	if err != nil {
+		if errors.Is(err, jwt.ErrExpired) {
+			return nil, ErrTokenExpired
+		}
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	return a.mapClaims(std, raw)
+}
+
+// verifySignature checks the token signature and decodes its claims.
+func (a *Adapter) verifySignature(ctx context.Context, token *jwt.JSONWebToken, dest ...any) error {
+	header := token.Headers[0]
+
+	if strings.HasPrefix(header.Algorithm, "HS") {
+		if err := token.Claims([]byte(a.config.ClientSecret), dest...); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidToken, err)
+		}
+		return nil
+	}
+
+	keys := a.lookupKeys(header.KeyID)
+	if len(keys) == 0 && header.KeyID != "" && a.canRefreshForUnknownKey() {
+		// The provider may have rotated its keys since the last refresh.
+		if err := a.refreshKeys(ctx); err != nil {
+			log.Warn().Err(err).Msg("oidc: failed to refresh JWKS for unknown key")
+		}
+		keys = a.lookupKeys(header.KeyID)
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("%w: no signing key for kid %q", ErrInvalidToken, header.KeyID)
+	}
+
+	var lastErr error
+	for _, key := range keys {
+		if lastErr = token.Claims(key.Key, dest...); lastErr == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %w", ErrInvalidToken, lastErr)
+}
+
+// mapClaims converts verified token claims into AuthClaims.
+func (a *Adapter) mapClaims(std jwt.Claims, raw map[string]any) (*ports.AuthClaims, error) {
+	mapping := a.config.Claims
+
+	userID, _ := lookupClaim(raw, mapping.UserID).(string)
+	if userID == "" {
+		return nil, fmt.Errorf("%w: missing %s claim", ErrInvalidToken, mapping.UserID)
+	}
+
+	claims := &ports.AuthClaims{
+		UserID:    userID,
+		ExpiresAt: std.Expiry.Time().Unix(),
+		Provider:  "oidc",
+	}
+	if std.IssuedAt != nil {
+		claims.IssuedAt = std.IssuedAt.Time().Unix()
+	}
+
+	if email, ok := lookupClaim(raw, mapping.Email).(string); ok {
+		claims.Email = email
+	}
+
+	// Some providers send email_verified as the string "true".
+	switch verified := lookupClaim(raw, mapping.EmailVerified).(type) {
+	case bool:
+		claims.EmailVerified = verified
+	case string:
+		claims.EmailVerified = verified == "true"
+	}
+
+	if name, ok := lookupClaim(raw, mapping.Name).(string); ok {
+		claims.Name = name
+	}
+
+	if picture, ok := lookupClaim(raw, mapping.Picture).(string); ok {
+		claims.Picture = picture
+	}
+
+	if mapping.Provider != "" {
+		if provider, ok := lookupClaim(raw, mapping.Provider).(string); ok && provider != "" {
+			claims.Provider = provider
+		}
+	}
+
+	return claims, nil
+}
+
+// lookupClaim returns the claim at a dot-separated path, or nil.
+func lookupClaim(claims map[string]any, path string) any {
+	if path == "" {
+		return nil
+	}
+
+	var current any = claims
+	for _, part := range strings.Split(path, ".") {
+		obj, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = obj[part]
+	}
+	return current
+}
+
+// lookupKeys returns the signing keys matching kid, or every signing key
+// when the token has no key ID.
+func (a *Adapter) lookupKeys(kid string) []jose.JSONWebKey {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	var keys []jose.JSONWebKey
+	for _, key := range a.keys.Keys {
+		if key.Use == "enc" {
+			continue
+		}
+		if kid == "" || key.KeyID == kid {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// canRefreshForUnknownKey reports whether enough time has passed since the
+// last refresh to fetch the key set again.
+func (a *Adapter) canRefreshForUnknownKey() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return time.Since(a.refreshedAt) >= unknownKeyRefreshInterval
+}
+
+// discover fetches the discovery document and returns the JWKS URL.
+func (a *Adapter) discover(ctx context.Context) (string, error) {
+	var doc struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := a.getJSON(ctx, a.config.IssuerURL+discoveryPath, &doc); err != nil {
+		return "", fmt.Errorf("oidc: failed to fetch discovery document: %w", err)
+	}
+
+	if strings.TrimSuffix(doc.Issuer, "/") != a.config.IssuerURL {
+		return "", fmt.Errorf("oidc: discovery issuer %q does not match configured issuer %q", doc.Issuer, a.config.IssuerURL)
+	}
+	if doc.JWKSURI == "" {
+		return "", fmt.Errorf("oidc: discovery document has no jwks_uri")
+	}
+
+	return doc.JWKSURI, nil
+}
+
+// refreshKeys fetches the key set and replaces the cached keys.
+func (a *Adapter) refreshKeys(ctx context.Context) error {
+	var keys jose.JSONWebKeySet
+	if err := a.getJSON(ctx, a.jwksURL, &keys); err != nil {
+		return fmt.Errorf("oidc: failed to fetch JWKS: %w", err)
+	}
+	if len(keys.Keys) == 0 && a.config.ClientSecret == "" {
+		return fmt.Errorf("oidc: JWKS has no keys")
+	}
+
+	a.mu.Lock()
+	a.keys = keys
+	a.refreshedAt = time.Now()
+	a.mu.Unlock()
+
+	return nil
+}
+
+// refreshLoop refreshes the key set periodically until the adapter is closed.
+// Failures keep the previous keys.
+func (a *Adapter) refreshLoop() {
+	ticker := time.NewTicker(a.config.JWKSRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), a.config.Timeout)
+			if err := a.refreshKeys(ctx); err != nil {
+				log.Warn().Err(err).Msg("oidc: background JWKS refresh failed")
+			}
+			cancel()
+		}
+	}
+}
+
+// getJSON performs a GET request and decodes the JSON response into dest.
+func (a *Adapter) getJSON(ctx context.Context, url string, dest any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxMetadataSize)).Decode(dest); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// Close stops the background key refresh.
+func (a *Adapter) Close() error {
+	a.closeOnce.Do(func() {
+		close(a.stop)
+	})
+	return nil
+}
+
+// Compile-time check that Adapter implements ports.AuthProvider.
+var _ ports.AuthProvider = (*Adapter)(nil)
@@ -0,0 +1,282 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/oidc"
+)
+
+const testClientID = "chameleon-vitae"
+
+// testProvider is a fake identity provider serving discovery and JWKS documents.
+type testProvider struct {
+	server *httptest.Server
+
+	mu         sync.Mutex
+	keys       []jose.JSONWebKey
+	jwksServed atomic.Int32
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	t.Helper()
+
+	p := &testProvider{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   p.server.URL,
+			"jwks_uri": p.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		p.jwksServed.Add(1)
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: p.keys})
+	})
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+
+	return p
+}
+
+// addKey generates an RSA key, publishes its public half and returns the private key.
+func (p *testProvider) addKey(t *testing.T, kid string) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys = append(p.keys, jose.JSONWebKey{Key: &key.PublicKey, KeyID: kid, Algorithm: string(jose.RS256), Use: "sig"})
+
+	return key
+}
+
+// sign builds a signed token with the given claims.
+func sign(t *testing.T, alg jose.SignatureAlgorithm, key any, kid string, claims ...any) string {
+	t.Helper()
+
+	opts := (&jose.SignerOptions{}).WithType("JWT")
+	if kid != "" {
+		opts = opts.WithHeader(jose.HeaderKey("kid"), kid)
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, opts)
+	require.NoError(t, err)
+
+	builder := jwt.Signed(signer)
+	for _, c := range claims {
+		builder = builder.Claims(c)
+	}
+	token, err := builder.Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+// standardClaims returns valid registered claims for the provider.
+func (p *testProvider) standardClaims() jwt.Claims {
+	now := time.Now()
+	return jwt.Claims{
+		Issuer:   p.server.URL,
+		Subject:  "user-42",
+		Audience: jwt.Audience{testClientID},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+}
+
+func newAdapter(t *testing.T, cfg oidc.Config) *oidc.Adapter {
+	t.Helper()
+
+	adapter, err := oidc.New(context.Background(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = adapter.Close() })
+	return adapter
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requires issuer URL", func(t *testing.T) {
+		_, err := oidc.New(ctx, oidc.Config{ClientID: testClientID})
+		assert.ErrorIs(t, err, oidc.ErrMissingIssuerURL)
+	})
+
+	t.Run("requires client ID", func(t *testing.T) {
+		_, err := oidc.New(ctx, oidc.Config{IssuerURL: "https://idp.example.com"})
+		assert.ErrorIs(t, err, oidc.ErrMissingClientID)
+	})
+
+	t.Run("rejects discovery issuer mismatch", func(t *testing.T) {
+		p := newTestProvider(t)
+		p.addKey(t, "k1")
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"issuer":   "https://someone-else.example.com",
+				"jwks_uri": p.server.URL + "/jwks",
+			})
+		}))
+		defer server.Close()
+
+		_, err := oidc.New(ctx, oidc.Config{IssuerURL: server.URL, ClientID: testClientID})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not match")
+	})
+}
+
+func TestVerifyToken(t *testing.T) {
+	ctx := context.Background()
+	p := newTestProvider(t)
+	key := p.addKey(t, "k1")
+	adapter := newAdapter(t, oidc.Config{IssuerURL: p.server.URL, ClientID: testClientID})
+
+	t.Run("valid token", func(t *testing.T) {
+		token := sign(t, jose.RS256, key, "k1", p.standardClaims(), map[string]any{
+			"email":          "ada@example.com",
+			"email_verified": true,
+			"name":           "Ada Lovelace",
+			"picture":        "https://example.com/ada.png",
+		})
+
+		claims, err := adapter.VerifyToken(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, "user-42", claims.UserID)
+		assert.Equal(t, "ada@example.com", claims.Email)
+		assert.True(t, claims.EmailVerified)
+		assert.Equal(t, "Ada Lovelace", claims.Name)
+		assert.Equal(t, "https://example.com/ada.png", claims.Picture)
+		assert.Equal(t, "oidc", claims.Provider)
+		assert.NotZero(t, claims.ExpiresAt)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		std := p.standardClaims()
+		std.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+		_, err := adapter.VerifyToken(ctx, sign(t, jose.RS256, key, "k1", std))
+		assert.ErrorIs(t, err, oidc.ErrTokenExpired)
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		std := p.standardClaims()
+		std.Audience = jwt.Audience{"another-client"}
+
+		_, err := adapter.VerifyToken(ctx, sign(t, jose.RS256, key, "k1", std))
+		assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+	})
+
+	t.Run("wrong issuer", func(t *testing.T) {
+		std := p.standardClaims()
+		std.Issuer = "https://evil.example.com"
+
+		_, err := adapter.VerifyToken(ctx, sign(t, jose.RS256, key, "k1", std))
+		assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+	})
+
+	t.Run("signed by unknown key", func(t *testing.T) {
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		_, err = adapter.VerifyToken(ctx, sign(t, jose.RS256, other, "k1", p.standardClaims()))
+		assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+	})
+
+	t.Run("HMAC token without client secret", func(t *testing.T) {
+		_, err := adapter.VerifyToken(ctx, sign(t, jose.HS256, []byte("some-secret-some-secret-some-secret"), "", p.standardClaims()))
+		assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+	})
+
+	t.Run("empty token", func(t *testing.T) {
+		_, err := adapter.VerifyToken(ctx, "")
+		assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+	})
+}
+
+func TestVerifyTokenRefreshesKeysOnRotation(t *testing.T) {
+	p := newTestProvider(t)
+	p.addKey(t, "old")
+	adapter := newAdapter(t, oidc.Config{IssuerURL: p.server.URL, ClientID: testClientID})
+	require.Equal(t, int32(1), p.jwksServed.Load())
+
+	// Keys are only refetched for unknown key IDs once the rate limit allows it,
+	// so a freshly initialised adapter rejects the rotated key.
+	rotated := p.addKey(t, "new")
+	_, err := adapter.VerifyToken(context.Background(), sign(t, jose.RS256, rotated, "new", p.standardClaims()))
+	assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+	assert.Equal(t, int32(1), p.jwksServed.Load())
+
+	// A background refresh picks the rotated key up.
+	refreshing := newAdapter(t, oidc.Config{
+		IssuerURL:           p.server.URL,
+		ClientID:            testClientID,
+		JWKSRefreshInterval: 10 * time.Millisecond,
+	})
+	require.Eventually(t, func() bool {
+		_, err := refreshing.VerifyToken(context.Background(), sign(t, jose.RS256, rotated, "new", p.standardClaims()))
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestVerifyTokenWithClientSecret(t *testing.T) {
+	p := newTestProvider(t)
+	p.addKey(t, "k1")
+	secret := "0123456789abcdef0123456789abcdef" // pragma: allowlist secret
+	adapter := newAdapter(t, oidc.Config{IssuerURL: p.server.URL, ClientID: testClientID, ClientSecret: secret})
+
+	claims, err := adapter.VerifyToken(context.Background(), sign(t, jose.HS256, []byte(secret), "", p.standardClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "user-42", claims.UserID)
+
+	_, err = adapter.VerifyToken(context.Background(), sign(t, jose.HS256, []byte("not-the-client-secret-at-all!!!!"), "", p.standardClaims()))
+	assert.ErrorIs(t, err, oidc.ErrInvalidToken)
+}
+
+func TestVerifyTokenClaimMapping(t *testing.T) {
+	p := newTestProvider(t)
+	key := p.addKey(t, "k1")
+	adapter := newAdapter(t, oidc.Config{
+		IssuerURL: p.server.URL,
+		ClientID:  testClientID,
+		Claims: oidc.ClaimMapping{
+			UserID:        "oid",
+			Email:         "profile.mail",
+			EmailVerified: "profile.mail_verified",
+			Name:          "display_name",
+			Provider:      "identity_provider",
+		},
+	})
+
+	token := sign(t, jose.RS256, key, "k1", p.standardClaims(), map[string]any{
+		"oid":               "object-7",
+		"profile":           map[string]any{"mail": "grace@example.com", "mail_verified": "true"},
+		"display_name":      "Grace Hopper",
+		"identity_provider": "github",
+	})
+
+	claims, err := adapter.VerifyToken(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, "object-7", claims.UserID)
+	assert.Equal(t, "grace@example.com", claims.Email)
+	assert.True(t, claims.EmailVerified)
+	assert.Equal(t, "Grace Hopper", claims.Name)
+	assert.Equal(t, "github", claims.Provider)
+
+	_, err = adapter.VerifyToken(context.Background(), sign(t, jose.RS256, key, "k1", p.standardClaims()))
+	assert.ErrorIs(t, err, oidc.ErrInvalidToken, "token without the mapped user ID claim")
+}
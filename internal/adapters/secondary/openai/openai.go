@@ -0,0 +1,257 @@
+// Package openai provides an embeddings adapter for OpenAI-compatible APIs.
+//
+// Any server exposing the OpenAI /embeddings endpoint (OpenAI, Ollama,
+// LM Studio, vLLM, ...) can be used by pointing BaseURL at it.
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/circuitbreaker"
+)
+
+const (
+	baseURL      = "https://api.openai.com/v1"
+	defaultModel = "text-embedding-3-small"
+)
+
+// Config holds embeddings API configuration.
+type Config struct {
+	// APIKey is the API key. It may be empty for local servers.
+	APIKey string
+
+	// BaseURL is the API base URL (without the /embeddings suffix).
+	BaseURL string
+
+	// Model is the embeddings model.
+	Model string
+
+	// Dimensions optionally truncates the returned vectors. Zero keeps the
+	// model's native size.
+	Dimensions int
+
+	// BatchSize is the maximum number of inputs sent per request.
+	BatchSize int
+
+	// Timeout is the HTTP request timeout.
+	Timeout time.Duration
+
+	// MaxRetries is the maximum number of retries on rate limits and server errors.
+	MaxRetries int
+
+	// CircuitBreaker configures the breaker that fails fast while the API is down.
+	CircuitBreaker circuitbreaker.Config
+
+	// HTTPClient, when set, is used for API requests instead of a client
+	// built from Timeout, e.g. one sharing a pkg/httpclient transport.
+	HTTPClient *http.Client
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		BaseURL:        baseURL,
+		Model:          defaultModel,
+		BatchSize:      100,
+		Timeout:        30 * time.Second,
+		MaxRetries:     3,
+		CircuitBreaker: circuitbreaker.DefaultConfig(),
+	}
+}
+
+// Client implements ports.EmbeddingsProvider using an OpenAI-compatible API.
+type Client struct {
+	config     Config
+	httpClient *http.Client
+	breaker    *circuitbreaker.Breaker
+}
+
+var _ ports.EmbeddingsProvider = (*Client)(nil)
+
+// New creates a new embeddings client.
+func New(cfg Config) (*Client, error) {
+	defaults := DefaultConfig()
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = defaults.BaseURL
+	}
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	if cfg.Model == "" {
+		cfg.Model = defaults.Model
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaults.BatchSize
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = defaults.MaxRetries
+	}
+	if cfg.Dimensions < 0 {
+		return nil, fmt.Errorf("openai: dimensions must not be negative")
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	return &Client{
+		config:     cfg,
+		httpClient: httpClient,
+		breaker:    circuitbreaker.New("openai", cfg.CircuitBreaker),
+	}, nil
+}
+
+// Embed returns one embedding per input text, in input order.
+func (c *Client) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+
+	for start := 0; start < len(texts); start += c.config.BatchSize {
+		end := min(start+c.config.BatchSize, len(texts))
+
+		batch, err := c.embedBatch(ctx, texts[start:end])
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, batch...)
+	}
+
+	return vectors, nil
+}
+
+// Model returns the embeddings model identifier.
+func (c *Client) Model() string {
+	if c.config.Dimensions > 0 {
+		return fmt.Sprintf("%s@%d", c.config.Model, c.config.Dimensions)
+	}
+	return c.config.Model
+}
+
+// Close releases any resources held by the client.
+func (c *Client) Close() error {
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// embeddingsRequest is the request body of the /embeddings endpoint.
+type embeddingsRequest struct {
+	Model      string   `json:"model"`
+	Input      []string `json:"input"`
+	Dimensions int      `json:"dimensions,omitempty"`
+}
+
+// embeddingsResponse is the response body of the /embeddings endpoint.
+type embeddingsResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+	Error *struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+	} `json:"error,omitempty"`
+}
+
+// embedBatch embeds a single batch, retrying on rate limits and server errors.
+func (c *Client) embedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(embeddingsRequest{
+		Model:      c.config.Model,
+		Input:      texts,
+		Dimensions: c.config.Dimensions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("openai: failed to marshal request: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			// Exponential backoff.
+			backoff := time.Duration(1<<uint(attempt-1)) * time.Second
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		done, err := c.breaker.Allow()
+		if err != nil {
+			return nil, fmt.Errorf("openai: %w: %w", domain.ErrUpstreamUnavailable, err)
+		}
+
+		vectors, retry, err := c.doRequest(ctx, body, len(texts))
+		done(retry && ctx.Err() == nil)
+		if err == nil {
+			return vectors, nil
+		}
+		lastErr = err
+		if !retry {
+			return nil, fmt.Errorf("openai: %w", err)
+		}
+	}
+
+	return nil, fmt.Errorf("openai: max retries exceeded: %w", lastErr)
+}
+
+// doRequest performs one /embeddings request. The boolean result reports
+// whether the error is transient and the request may be retried.
+func (c *Client) doRequest(ctx context.Context, body []byte, count int) ([][]float32, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.BaseURL+"/embeddings", bytes.NewReader(body))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.APIKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, true, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return nil, retry, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var parsed embeddingsResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return nil, false, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if parsed.Error != nil {
+		return nil, false, fmt.Errorf("API error: %s", parsed.Error.Message)
+	}
+	if len(parsed.Data) != count {
+		return nil, false, fmt.Errorf("expected %d embeddings, got %d", count, len(parsed.Data))
+	}
+
+	sort.Slice(parsed.Data, func(i, j int) bool {
+		return parsed.Data[i].Index < parsed.Data[j].Index
+	})
+
+	vectors := make([][]float32, 0, count)
+	for _, d := range parsed.Data {
+		vectors = append(vectors, d.Embedding)
+	}
+	return vectors, false, nil
+}
@@ -0,0 +1,127 @@
+// Package openai_test contains unit tests for the OpenAI-compatible embeddings adapter.
+package openai_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/openai"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := openai.DefaultConfig()
+
+	assert.Equal(t, "text-embedding-3-small", cfg.Model)
+	assert.Equal(t, 100, cfg.BatchSize)
+	assert.Equal(t, 3, cfg.MaxRetries)
+	assert.NotZero(t, cfg.Timeout)
+}
+
+func TestNew(t *testing.T) {
+	t.Run("works without API key", func(t *testing.T) {
+		client, err := openai.New(openai.Config{})
+		require.NoError(t, err)
+		assert.Equal(t, "text-embedding-3-small", client.Model())
+	})
+
+	t.Run("includes dimensions in model identifier", func(t *testing.T) {
+		client, err := openai.New(openai.Config{Model: "nomic-embed-text", Dimensions: 256})
+		require.NoError(t, err)
+		assert.Equal(t, "nomic-embed-text@256", client.Model())
+	})
+
+	t.Run("rejects negative dimensions", func(t *testing.T) {
+		_, err := openai.New(openai.Config{Dimensions: -1})
+		assert.Error(t, err)
+	})
+}
+
+// embeddingsServer returns a server that embeds each input as [len(input), index]
+// and replies with the data in reverse order.
+func embeddingsServer(t *testing.T, requests *int) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		assert.Equal(t, "/embeddings", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+
+		var req struct {
+			Model string   `json:"model"`
+			Input []string `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		data := make([]map[string]any, 0, len(req.Input))
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			data = append(data, map[string]any{
+				"index":     i,
+				"embedding": []float32{float32(len(req.Input[i])), float32(i)},
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestEmbed(t *testing.T) {
+	var requests int
+	server := embeddingsServer(t, &requests)
+
+	client, err := openai.New(openai.Config{
+		APIKey:    "test-key", // pragma: allowlist secret
+		BaseURL:   server.URL + "/",
+		BatchSize: 2,
+	})
+	require.NoError(t, err)
+
+	vectors, err := client.Embed(context.Background(), []string{"a", "bb", "ccc"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, [][]float32{{1, 0}, {2, 1}, {3, 0}}, vectors)
+}
+
+func TestEmbedErrors(t *testing.T) {
+	t.Run("does not retry client errors", func(t *testing.T) {
+		var requests int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			http.Error(w, `{"error":{"message":"bad model"}}`, http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		client, err := openai.New(openai.Config{BaseURL: server.URL})
+		require.NoError(t, err)
+
+		_, err = client.Embed(context.Background(), []string{"a"})
+		require.Error(t, err)
+		assert.Equal(t, 1, requests)
+		assert.Contains(t, err.Error(), "status 400")
+	})
+
+	t.Run("rejects mismatched embedding count", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"data":[{"index":0,"embedding":[1]}]}`)
+		}))
+		defer server.Close()
+
+		client, err := openai.New(openai.Config{BaseURL: server.URL})
+		require.NoError(t, err)
+
+		_, err = client.Embed(context.Background(), []string{"a", "b"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expected 2 embeddings")
+	})
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// AcademicEntryRepository implements ports.AcademicEntryRepository using PostgreSQL.
+type AcademicEntryRepository struct {
+	pool querier
+
+	// replica serves list queries; it is pool when there is no replica.
+	replica querier
+}
+
+// NewAcademicEntryRepository creates a new AcademicEntryRepository.
+func NewAcademicEntryRepository(pool *pgxpool.Pool) *AcademicEntryRepository {
+	return &AcademicEntryRepository{pool: pool, replica: pool}
+}
+
+// academicEntryColumns are the columns scanned by scanAcademicEntry.
+const academicEntryColumns = `
+	id, user_id, section, title, organization, authors, amount, url,
+	description, start_date, start_date_precision, end_date,
+	end_date_precision, display_order, created_at, updated_at
+`
+
+// Create creates a new academic entry.
+func (r *AcademicEntryRepository) Create(ctx context.Context, entry *domain.AcademicEntry) error {
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+
+	entry.CreatedAt = time.Now().UTC()
+	entry.UpdatedAt = entry.CreatedAt
+
+	query := `
+		INSERT INTO academic_entries (` + academicEntryColumns + `) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+		)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		entry.ID,
+		entry.UserID,
+		string(entry.Section),
+		entry.Title,
+		entry.Organization,
+		entry.Authors,
+		entry.Amount,
+		entry.URL,
+		entry.Description,
+		dateParam(entry.StartDate),
+		precisionParam(entry.StartDate),
+		dateParam(entry.EndDate),
+		precisionParam(entry.EndDate),
+		entry.DisplayOrder,
+		entry.CreatedAt,
+		entry.UpdatedAt,
+	)
+	if err != nil {
+		return domain.NewDatabaseError("create academic entry", err)
+	}
+
+	return nil
+}
+
+// GetByIDForUser retrieves a user's academic entry by ID.
+func (r *AcademicEntryRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.AcademicEntry, error) {
+	query := `SELECT ` + academicEntryColumns + ` FROM academic_entries WHERE id = $1 AND user_id = $2`
+
+	entry, err := scanAcademicEntry(r.pool.QueryRow(ctx, query, id, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAcademicEntryNotFound
+		}
+		return nil, domain.NewDatabaseError("scan academic entry", err)
+	}
+	return entry, nil
+}
+
+// ListByUserID lists all academic entries for a user, ordered by
+// display_order and then most recent first.
+func (r *AcademicEntryRepository) ListByUserID(ctx context.Context, userID string) ([]domain.AcademicEntry, error) {
+	query := `
+		SELECT ` + academicEntryColumns + `
+		FROM academic_entries
+		WHERE user_id = $1
+		ORDER BY display_order ASC, COALESCE(end_date, start_date) DESC NULLS LAST, created_at DESC
+	`
+
+	rows, err := r.replica.Query(ctx, query, userID)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list academic entries", err)
+	}
+	defer rows.Close()
+
+	entries := make([]domain.AcademicEntry, 0)
+	for rows.Next() {
+		entry, err := scanAcademicEntry(rows)
+		if err != nil {
+			return nil, domain.NewDatabaseError("scan academic entry list", err)
+		}
+		entries = append(entries, *entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("iterate academic entries", err)
+	}
+
+	return entries, nil
+}
+
+// Update updates an existing academic entry.
+func (r *AcademicEntryRepository) Update(ctx context.Context, entry *domain.AcademicEntry) error {
+	entry.UpdatedAt = time.Now().UTC()
+
+	query := `
+		UPDATE academic_entries SET
+			section = $3,
+			title = $4,
+			organization = $5,
+			authors = $6,
+			amount = $7,
+			url = $8,
+			description = $9,
+			start_date = $10,
+			start_date_precision = $11,
+			end_date = $12,
+			end_date_precision = $13,
+			display_order = $14,
+			updated_at = $15
+		WHERE id = $1 AND user_id = $2
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		entry.ID,
+		entry.UserID,
+		string(entry.Section),
+		entry.Title,
+		entry.Organization,
+		entry.Authors,
+		entry.Amount,
+		entry.URL,
+		entry.Description,
+		dateParam(entry.StartDate),
+		precisionParam(entry.StartDate),
+		dateParam(entry.EndDate),
+		precisionParam(entry.EndDate),
+		entry.DisplayOrder,
+		entry.UpdatedAt,
+	)
+	if err != nil {
+		return domain.NewDatabaseError("update academic entry", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrAcademicEntryNotFound
+	}
+
+	return nil
+}
+
+// Delete removes a user's academic entry.
+func (r *AcademicEntryRepository) Delete(ctx context.Context, id, userID string) error {
+	query := `DELETE FROM academic_entries WHERE id = $1 AND user_id = $2`
+
+	result, err := r.pool.Exec(ctx, query, id, userID)
+	if err != nil {
+		return domain.NewDatabaseError("delete academic entry", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrAcademicEntryNotFound
+	}
+
+	return nil
+}
+
+// scanAcademicEntry scans an academic entry row selected with
+// academicEntryColumns.
+func scanAcademicEntry(row pgx.Row) (*domain.AcademicEntry, error) {
+	var entry domain.AcademicEntry
+	var section string
+	var startDate, endDate pgtype.Date
+	var startPrecision, endPrecision string
+
+	err := row.Scan(
+		&entry.ID,
+		&entry.UserID,
+		&section,
+		&entry.Title,
+		&entry.Organization,
+		&entry.Authors,
+		&entry.Amount,
+		&entry.URL,
+		&entry.Description,
+		&startDate,
+		&startPrecision,
+		&endDate,
+		&endPrecision,
+		&entry.DisplayOrder,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Section = domain.AcademicSection(section)
+	entry.StartDate = scannedDate(startDate, startPrecision)
+	entry.EndDate = scannedDate(endDate, endPrecision)
+
+	if entry.Authors == nil {
+		entry.Authors = make([]string, 0)
+	}
+
+	return &entry, nil
+}
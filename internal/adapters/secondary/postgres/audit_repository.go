@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// AuditRepository implements ports.AuditRepository using PostgreSQL.
+type AuditRepository struct {
+	pool querier
+}
+
+// Record appends an event to the audit log.
+func (r *AuditRepository) Record(ctx context.Context, event *domain.AuditEvent) error {
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now().UTC()
+	}
+
+	query := `
+		INSERT INTO audit_events (id, action, actor_id, subject_id, session_id, detail, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		event.ID,
+		string(event.Action),
+		event.ActorID,
+		nullIfEmpty(event.SubjectID),
+		nullIfEmpty(event.SessionID),
+		event.Detail,
+		event.CreatedAt,
+	)
+	if err != nil {
+		return domain.NewDatabaseError("record audit event", err)
+	}
+
+	return nil
+}
+
+// nullIfEmpty returns nil for an empty ID, stored as NULL.
+func nullIfEmpty(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
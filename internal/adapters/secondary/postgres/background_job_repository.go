@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// BackgroundJobRepository implements ports.BackgroundJobRepository using PostgreSQL.
+type BackgroundJobRepository struct {
+	pool querier
+}
+
+// Save stores an interrupted job.
+func (r *BackgroundJobRepository) Save(ctx context.Context, job *domain.BackgroundJob) error {
+	if job.ID == "" {
+		job.ID = uuid.New().String()
+	}
+	if job.CreatedAt.IsZero() {
+		job.CreatedAt = time.Now().UTC()
+	}
+
+	paramsJSON, err := json.Marshal(job.Params)
+	if err != nil {
+		return domain.NewDatabaseError("marshal background job params", err)
+	}
+
+	query := `
+		INSERT INTO background_jobs (id, kind, user_id, resume_id, params, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO NOTHING
+	`
+
+	_, err = r.pool.Exec(ctx, query,
+		job.ID, string(job.Kind), job.UserID, job.ResumeID, paramsJSON, job.CreatedAt)
+	if err != nil {
+		return domain.NewDatabaseError("save background job", err)
+	}
+
+	return nil
+}
+
+// ListPending lists the saved jobs, oldest first.
+func (r *BackgroundJobRepository) ListPending(ctx context.Context) ([]domain.BackgroundJob, error) {
+	query := `
+		SELECT id, kind, user_id, resume_id, params, created_at
+		FROM background_jobs
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list background jobs", err)
+	}
+	defer rows.Close()
+
+	jobs := make([]domain.BackgroundJob, 0)
+	for rows.Next() {
+		var job domain.BackgroundJob
+		var kind string
+		var paramsJSON []byte
+
+		if err := rows.Scan(&job.ID, &kind, &job.UserID, &job.ResumeID, &paramsJSON, &job.CreatedAt); err != nil {
+			return nil, domain.NewDatabaseError("scan background job", err)
+		}
+		job.Kind = domain.BackgroundJobKind(kind)
+		if err := json.Unmarshal(paramsJSON, &job.Params); err != nil {
+			return nil, domain.NewDatabaseError("unmarshal background job params", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("iterate background jobs", err)
+	}
+
+	return jobs, nil
+}
+
+// Delete removes a saved job.
+func (r *BackgroundJobRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM background_jobs WHERE id = $1`, id)
+	if err != nil {
+		return domain.NewDatabaseError("delete background job", err)
+	}
+	return nil
+}
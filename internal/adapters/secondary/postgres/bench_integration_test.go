@@ -0,0 +1,96 @@
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// bulletsPerParent is how many bullets each seeded experience and project has.
+const bulletsPerParent = 5
+
+// benchmarkUser creates a user that is deleted, with everything it owns,
+// when the benchmark ends.
+func benchmarkUser(b *testing.B, prefix string) *domain.User {
+	b.Helper()
+	ctx := context.Background()
+	userRepo := testDB.UserRepository()
+
+	user, err := domain.NewUser(prefix + "-" + time.Now().Format("20060102150405.000000"))
+	require.NoError(b, err)
+	require.NoError(b, userRepo.Create(ctx, user))
+	b.Cleanup(func() {
+		_ = userRepo.Delete(ctx, user.ID)
+	})
+
+	return user
+}
+
+// BenchmarkExperienceListWithBullets lists experiences with their bullets.
+// The bullets of all experiences are loaded in one query, so the time per
+// list should grow with the rows returned rather than with round trips.
+func BenchmarkExperienceListWithBullets(b *testing.B) {
+	ctx := context.Background()
+	expRepo := testDB.ExperienceRepository()
+	bulletRepo := testDB.BulletRepository()
+
+	for _, experiences := range []int{1, 10, 50} {
+		b.Run(fmt.Sprintf("experiences=%d", experiences), func(b *testing.B) {
+			user := benchmarkUser(b, "bench-exp-user")
+			for i := range experiences {
+				exp, err := domain.NewExperience(user.ID, domain.ExperienceTypeWork, fmt.Sprintf("Engineer %d", i), "Bench Company", domain.NewDate(2020, 1, 1))
+				require.NoError(b, err)
+				require.NoError(b, expRepo.Create(ctx, exp))
+				for j := range bulletsPerParent {
+					bullet, err := domain.NewBullet(exp.ID, fmt.Sprintf("Delivered result %d", j))
+					require.NoError(b, err)
+					require.NoError(b, bulletRepo.Create(ctx, bullet))
+				}
+			}
+
+			for b.Loop() {
+				list, _, err := expRepo.ListByUserIDWithBullets(ctx, user.ID, ports.ListOptions{Limit: experiences})
+				require.NoError(b, err)
+				require.Len(b, list, experiences)
+			}
+		})
+	}
+}
+
+// BenchmarkProjectListWithBullets lists projects with their bullets, loaded
+// in one query for all projects.
+func BenchmarkProjectListWithBullets(b *testing.B) {
+	ctx := context.Background()
+	projectRepo := testDB.ProjectRepository()
+	projectBulletRepo := testDB.ProjectBulletRepository()
+
+	for _, projects := range []int{1, 10, 50} {
+		b.Run(fmt.Sprintf("projects=%d", projects), func(b *testing.B) {
+			user := benchmarkUser(b, "bench-project-user")
+			for i := range projects {
+				project, err := domain.NewProject(user.ID, fmt.Sprintf("Project %d", i), []string{"Go"})
+				require.NoError(b, err)
+				require.NoError(b, projectRepo.Create(ctx, project))
+				for j := range bulletsPerParent {
+					bullet, err := domain.NewProjectBullet(project.ID, fmt.Sprintf("Shipped feature %d", j))
+					require.NoError(b, err)
+					require.NoError(b, projectBulletRepo.Create(ctx, bullet))
+				}
+			}
+
+			for b.Loop() {
+				list, err := projectRepo.ListByUserIDWithBullets(ctx, user.ID)
+				require.NoError(b, err)
+				require.Len(b, list, projects)
+			}
+		})
+	}
+}
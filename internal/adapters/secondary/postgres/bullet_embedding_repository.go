@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// BulletEmbeddingRepository implements ports.BulletEmbeddingRepository using PostgreSQL.
+type BulletEmbeddingRepository struct {
+	pool querier
+}
+
+// Upsert creates or replaces embeddings, keyed by bullet ID.
+func (r *BulletEmbeddingRepository) Upsert(ctx context.Context, embeddings []domain.BulletEmbedding) error {
+	if len(embeddings) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO bullet_embeddings (bullet_id, model, content_hash, embedding, updated_at)
+		VALUES ($1, $2, $3, $4::vector, $5)
+		ON CONFLICT (bullet_id) DO UPDATE SET
+			model = EXCLUDED.model,
+			content_hash = EXCLUDED.content_hash,
+			embedding = EXCLUDED.embedding,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	batch := &pgx.Batch{}
+	for _, e := range embeddings {
+		if e.UpdatedAt.IsZero() {
+			e.UpdatedAt = time.Now().UTC()
+		}
+		batch.Queue(query, e.BulletID, e.Model, e.ContentHash, formatVector(e.Vector), e.UpdatedAt)
+	}
+
+	if err := r.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return domain.NewDatabaseError("upsert bullet embeddings", err)
+	}
+
+	return nil
+}
+
+// ListByUserID lists a user's bullet embeddings computed with the given model.
+func (r *BulletEmbeddingRepository) ListByUserID(ctx context.Context, userID, model string) ([]domain.BulletEmbedding, error) {
+	query := `
+		SELECT be.bullet_id, be.model, be.content_hash, be.embedding::text, be.updated_at
+		FROM bullet_embeddings be
+		JOIN bullets b ON b.id = be.bullet_id
+		JOIN experiences e ON e.id = b.experience_id
+		WHERE e.user_id = $1 AND be.model = $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, model)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list bullet embeddings", err)
+	}
+	defer rows.Close()
+
+	embeddings := make([]domain.BulletEmbedding, 0)
+	for rows.Next() {
+		var (
+			e      domain.BulletEmbedding
+			vector string
+		)
+		if err := rows.Scan(&e.BulletID, &e.Model, &e.ContentHash, &vector, &e.UpdatedAt); err != nil {
+			return nil, domain.NewDatabaseError("scan bullet embedding", err)
+		}
+		if e.Vector, err = parseVector(vector); err != nil {
+			return nil, domain.NewDatabaseError("parse bullet embedding", err)
+		}
+		embeddings = append(embeddings, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("iterate bullet embeddings", err)
+	}
+
+	return embeddings, nil
+}
+
+// formatVector encodes a vector in pgvector's text format, e.g. "[1,2.5,3]".
+func formatVector(v []float32) string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, x := range v {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(float64(x), 'g', -1, 32))
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// parseVector decodes a vector from pgvector's text format.
+func parseVector(s string) ([]float32, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+		return nil, fmt.Errorf("invalid vector %q", s)
+	}
+
+	s = strings.TrimSpace(s[1 : len(s)-1])
+	if s == "" {
+		return []float32{}, nil
+	}
+
+	parts := strings.Split(s, ",")
+	v := make([]float32, 0, len(parts))
+	for _, part := range parts {
+		x, err := strconv.ParseFloat(strings.TrimSpace(part), 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vector component %q: %w", part, err)
+		}
+		v = append(v, float32(x))
+	}
+	return v, nil
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// BulletFeedbackRepository implements ports.BulletFeedbackRepository using PostgreSQL.
+type BulletFeedbackRepository struct {
+	pool querier
+}
+
+// Upsert stores feedback, replacing the resume's earlier feedback on the same bullet.
+func (r *BulletFeedbackRepository) Upsert(ctx context.Context, feedback *domain.BulletFeedback) error {
+	if feedback.ID == "" {
+		feedback.ID = uuid.New().String()
+	}
+	now := time.Now().UTC()
+
+	query := `
+		INSERT INTO bullet_feedback (
+			id, user_id, resume_id, bullet_id, verdict, tailored_content,
+			edited_content, comment, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (resume_id, bullet_id) DO UPDATE SET
+			verdict = EXCLUDED.verdict,
+			tailored_content = EXCLUDED.tailored_content,
+			edited_content = EXCLUDED.edited_content,
+			comment = EXCLUDED.comment,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.pool.QueryRow(ctx, query,
+		feedback.ID,
+		feedback.UserID,
+		feedback.ResumeID,
+		feedback.BulletID,
+		string(feedback.Verdict),
+		feedback.TailoredContent,
+		feedback.EditedContent,
+		feedback.Comment,
+		now,
+		now,
+	).Scan(&feedback.ID, &feedback.CreatedAt, &feedback.UpdatedAt)
+	if err != nil {
+		return domain.NewDatabaseError("upsert bullet feedback", err)
+	}
+
+	return nil
+}
+
+// ListByResumeID lists a resume's feedback, most recently updated first.
+func (r *BulletFeedbackRepository) ListByResumeID(ctx context.Context, resumeID string) ([]domain.BulletFeedback, error) {
+	query := `
+		SELECT id, user_id, resume_id, bullet_id, verdict, tailored_content,
+			edited_content, comment, created_at, updated_at
+		FROM bullet_feedback
+		WHERE resume_id = $1
+		ORDER BY updated_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, resumeID)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list bullet feedback", err)
+	}
+	return scanBulletFeedback(rows)
+}
+
+// ListRecentByUserID lists up to limit of a user's feedback across all
+// resumes, most recently updated first.
+func (r *BulletFeedbackRepository) ListRecentByUserID(ctx context.Context, userID string, limit int) ([]domain.BulletFeedback, error) {
+	query := `
+		SELECT id, user_id, resume_id, bullet_id, verdict, tailored_content,
+			edited_content, comment, created_at, updated_at
+		FROM bullet_feedback
+		WHERE user_id = $1
+		ORDER BY updated_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list recent bullet feedback", err)
+	}
+	return scanBulletFeedback(rows)
+}
+
+// scanBulletFeedback reads and closes rows of bullet feedback.
+func scanBulletFeedback(rows pgx.Rows) ([]domain.BulletFeedback, error) {
+	defer rows.Close()
+
+	feedback := make([]domain.BulletFeedback, 0)
+	for rows.Next() {
+		var f domain.BulletFeedback
+		var verdict string
+
+		if err := rows.Scan(
+			&f.ID,
+			&f.UserID,
+			&f.ResumeID,
+			&f.BulletID,
+			&verdict,
+			&f.TailoredContent,
+			&f.EditedContent,
+			&f.Comment,
+			&f.CreatedAt,
+			&f.UpdatedAt,
+		); err != nil {
+			return nil, domain.NewDatabaseError("scan bullet feedback", err)
+		}
+
+		f.Verdict = domain.FeedbackVerdict(verdict)
+		feedback = append(feedback, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("iterate bullet feedback", err)
+	}
+
+	return feedback, nil
+}
@@ -10,14 +10,16 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 )
 
 // BulletRepository implements ports.BulletRepository using PostgreSQL.
 type BulletRepository struct {
-	pool *pgxpool.Pool
+	pool querier
+
+	// replica serves list queries; it is pool when there is no replica.
+	replica querier
 }
 
 // Create creates a new bullet.
@@ -37,10 +39,10 @@ func (r *BulletRepository) Create(ctx context.Context, bullet *domain.Bullet) er
 
 	query := `
 		INSERT INTO bullets (
-			id, experience_id, content, impact_score, keywords,
-			metadata, display_order, created_at, updated_at
+			id, experience_id, content, impact_score, impact_scored_hash,
+			keywords, metadata, display_order, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9
+			$1, $2, $3, $4, CASE WHEN $10 THEN md5($3) END, $5, $6, $7, $8, $9
 		)
 	`
 
@@ -54,6 +56,7 @@ func (r *BulletRepository) Create(ctx context.Context, bullet *domain.Bullet) er
 		bullet.DisplayOrder,
 		bullet.CreatedAt,
 		bullet.UpdatedAt,
+		bullet.ImpactScored,
 	)
 	if err != nil {
 		return domain.NewDatabaseError("create bullet", err)
@@ -62,16 +65,17 @@ func (r *BulletRepository) Create(ctx context.Context, bullet *domain.Bullet) er
 	return nil
 }
 
-// GetByID retrieves a bullet by ID.
-func (r *BulletRepository) GetByID(ctx context.Context, id string) (*domain.Bullet, error) {
+// GetByIDForUser retrieves a bullet by ID if its experience belongs to the user.
+func (r *BulletRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.Bullet, error) {
 	query := `
-		SELECT id, experience_id, content, impact_score, keywords,
-			   metadata, display_order, created_at, updated_at
-		FROM bullets
-		WHERE id = $1
+		SELECT b.id, b.experience_id, b.content, b.impact_score, b.keywords,
+			   b.metadata, b.display_order, b.created_at, b.updated_at
+		FROM bullets b
+		JOIN experiences e ON e.id = b.experience_id
+		WHERE b.id = $1 AND e.user_id = $2
 	`
 
-	return r.scanBullet(r.pool.QueryRow(ctx, query, id))
+	return r.scanBullet(r.pool.QueryRow(ctx, query, id, userID))
 }
 
 // ListByExperienceID lists all bullets for an experience.
@@ -84,7 +88,7 @@ func (r *BulletRepository) ListByExperienceID(ctx context.Context, experienceID
 		ORDER BY display_order ASC, created_at ASC
 	`
 
-	rows, err := r.pool.Query(ctx, query, experienceID)
+	rows, err := r.replica.Query(ctx, query, experienceID)
 	if err != nil {
 		return nil, domain.NewDatabaseError("list bullets by experience", err)
 	}
@@ -135,7 +139,7 @@ func (r *BulletRepository) ListByUserID(ctx context.Context, userID string) ([]d
 		ORDER BY e.display_order ASC, b.display_order ASC
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID)
+	rows, err := r.replica.Query(ctx, query, userID)
 	if err != nil {
 		return nil, domain.NewDatabaseError("list bullets by user", err)
 	}
@@ -157,6 +161,7 @@ func (r *BulletRepository) Update(ctx context.Context, bullet *domain.Bullet) er
 		UPDATE bullets SET
 			content = $2,
 			impact_score = $3,
+			impact_scored_hash = CASE WHEN $8 THEN md5($2) ELSE impact_scored_hash END,
 			keywords = $4,
 			metadata = $5,
 			display_order = $6,
@@ -172,6 +177,7 @@ func (r *BulletRepository) Update(ctx context.Context, bullet *domain.Bullet) er
 		metadataJSON,
 		bullet.DisplayOrder,
 		bullet.UpdatedAt,
+		bullet.ImpactScored,
 	)
 	if err != nil {
 		return domain.NewDatabaseError("update bullet", err)
@@ -184,11 +190,15 @@ func (r *BulletRepository) Update(ctx context.Context, bullet *domain.Bullet) er
 	return nil
 }
 
-// Delete removes a bullet.
-func (r *BulletRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM bullets WHERE id = $1`
+// Delete removes a bullet if its experience belongs to the user.
+func (r *BulletRepository) Delete(ctx context.Context, id, userID string) error {
+	query := `
+		DELETE FROM bullets b
+		USING experiences e
+		WHERE b.experience_id = e.id AND b.id = $1 AND e.user_id = $2
+	`
 
-	result, err := r.pool.Exec(ctx, query, id)
+	result, err := r.pool.Exec(ctx, query, id, userID)
 	if err != nil {
 		return domain.NewDatabaseError("delete bullet", err)
 	}
@@ -232,6 +242,33 @@ func (r *BulletRepository) SearchByKeywords(ctx context.Context, userID string,
 	return r.scanBullets(rows)
 }
 
+// SearchSemantic returns the k bullets whose stored embeddings are closest
+// (by cosine distance) to queryEmbedding. Bullets without an embedding are skipped.
+func (r *BulletRepository) SearchSemantic(ctx context.Context, userID string, queryEmbedding []float32, k int) ([]domain.Bullet, error) {
+	if len(queryEmbedding) == 0 || k <= 0 {
+		return []domain.Bullet{}, nil
+	}
+
+	query := `
+		SELECT b.id, b.experience_id, b.content, b.impact_score, b.keywords,
+			   b.metadata, b.display_order, b.created_at, b.updated_at
+		FROM bullets b
+		INNER JOIN experiences e ON b.experience_id = e.id
+		INNER JOIN bullet_embeddings be ON be.bullet_id = b.id
+		WHERE e.user_id = $1
+		ORDER BY be.embedding <=> $2::vector
+		LIMIT $3
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID, formatVector(queryEmbedding), k)
+	if err != nil {
+		return nil, domain.NewDatabaseError("search bullets semantically", err)
+	}
+	defer rows.Close()
+
+	return r.scanBullets(rows)
+}
+
 // GetHighImpactBullets retrieves bullets with impact score >= threshold.
 func (r *BulletRepository) GetHighImpactBullets(ctx context.Context, userID string, minScore int, limit int) ([]domain.Bullet, error) {
 	query := `
@@ -253,6 +290,65 @@ func (r *BulletRepository) GetHighImpactBullets(ctx context.Context, userID stri
 	return r.scanBullets(rows)
 }
 
+// ListForRescoring lists bullets of any user whose impact score was not
+// computed or given for their current content, least recently updated first.
+func (r *BulletRepository) ListForRescoring(ctx context.Context, limit int) ([]domain.Bullet, error) {
+	query := `
+		SELECT id, experience_id, content, impact_score, keywords,
+			   metadata, display_order, created_at, updated_at
+		FROM bullets
+		WHERE impact_scored_hash IS DISTINCT FROM md5(content)
+		ORDER BY updated_at ASC
+		LIMIT $1
+	`
+
+	rows, err := r.pool.Query(ctx, query, limit)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list bullets for rescoring", err)
+	}
+	defer rows.Close()
+
+	return r.scanBullets(rows)
+}
+
+// UpdateImpactScores saves the impact scores of bullets, computed for their
+// content, and returns the number saved. Bullets whose content changed since
+// are skipped, to be rescored on the next run. The update time is kept, as
+// rescoring is no edit.
+func (r *BulletRepository) UpdateImpactScores(ctx context.Context, bullets []domain.Bullet) (int, error) {
+	if len(bullets) == 0 {
+		return 0, nil
+	}
+
+	query := `
+		UPDATE bullets SET
+			impact_score = $2,
+			impact_scored_hash = md5(content)
+		WHERE id = $1 AND content = $3
+	`
+
+	batch := &pgx.Batch{}
+	for _, bullet := range bullets {
+		batch.Queue(query, bullet.ID, bullet.ImpactScore.Int(), bullet.Content)
+	}
+
+	results := r.pool.SendBatch(ctx, batch)
+	updated := 0
+	for range bullets {
+		tag, err := results.Exec()
+		if err != nil {
+			_ = results.Close()
+			return 0, domain.NewDatabaseError("update impact scores", err)
+		}
+		updated += int(tag.RowsAffected())
+	}
+	if err := results.Close(); err != nil {
+		return 0, domain.NewDatabaseError("update impact scores", err)
+	}
+
+	return updated, nil
+}
+
 // scanBullet scans a single bullet row.
 func (r *BulletRepository) scanBullet(row pgx.Row) (*domain.Bullet, error) {
 	bullet := &domain.Bullet{}
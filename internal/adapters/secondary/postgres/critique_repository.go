@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// ResumeCritiqueRepository implements ports.ResumeCritiqueRepository using PostgreSQL.
+type ResumeCritiqueRepository struct {
+	pool querier
+
+	// replica serves list queries; it is pool when there is no replica.
+	replica querier
+}
+
+// Create stores a new critique.
+func (r *ResumeCritiqueRepository) Create(ctx context.Context, critique *domain.ResumeCritique) error {
+	if critique.ID == "" {
+		critique.ID = uuid.New().String()
+	}
+	critique.CreatedAt = time.Now().UTC()
+
+	strengths, err := json.Marshal(critique.Strengths)
+	if err != nil {
+		return domain.NewDatabaseError("marshal critique strengths", err)
+	}
+	weaknesses, err := json.Marshal(critique.Weaknesses)
+	if err != nil {
+		return domain.NewDatabaseError("marshal critique weaknesses", err)
+	}
+	redFlags, err := json.Marshal(critique.RedFlags)
+	if err != nil {
+		return domain.NewDatabaseError("marshal critique red flags", err)
+	}
+	edits, err := json.Marshal(critique.SuggestedEdits)
+	if err != nil {
+		return domain.NewDatabaseError("marshal critique suggested edits", err)
+	}
+
+	query := `
+		INSERT INTO resume_critiques (
+			id, resume_id, user_id, content_hash, score, verdict, summary,
+			strengths, weaknesses, red_flags, suggested_edits, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err = r.pool.Exec(ctx, query,
+		critique.ID,
+		critique.ResumeID,
+		critique.UserID,
+		critique.ContentHash,
+		critique.Score.Int(),
+		string(critique.Verdict),
+		critique.Summary,
+		strengths,
+		weaknesses,
+		redFlags,
+		edits,
+		critique.CreatedAt,
+	)
+	if err != nil {
+		return domain.NewDatabaseError("create resume critique", err)
+	}
+
+	return nil
+}
+
+// ListByResumeID lists a resume's critiques, newest first.
+func (r *ResumeCritiqueRepository) ListByResumeID(ctx context.Context, resumeID string) ([]domain.ResumeCritique, error) {
+	query := `
+		SELECT id, resume_id, user_id, content_hash, score, verdict, summary,
+			strengths, weaknesses, red_flags, suggested_edits, created_at
+		FROM resume_critiques
+		WHERE resume_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.replica.Query(ctx, query, resumeID)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list resume critiques", err)
+	}
+	defer rows.Close()
+
+	critiques := make([]domain.ResumeCritique, 0)
+	for rows.Next() {
+		var critique domain.ResumeCritique
+		var score int
+		var verdict string
+		var strengths, weaknesses, redFlags, edits []byte
+
+		if err := rows.Scan(
+			&critique.ID,
+			&critique.ResumeID,
+			&critique.UserID,
+			&critique.ContentHash,
+			&score,
+			&verdict,
+			&critique.Summary,
+			&strengths,
+			&weaknesses,
+			&redFlags,
+			&edits,
+			&critique.CreatedAt,
+		); err != nil {
+			return nil, domain.NewDatabaseError("scan resume critique", err)
+		}
+
+		critique.Score = domain.MatchScore(score)
+		critique.Verdict = domain.CritiqueVerdict(verdict)
+		if err := unmarshalCritiqueFields(&critique, strengths, weaknesses, redFlags, edits); err != nil {
+			return nil, err
+		}
+
+		critiques = append(critiques, critique)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("iterate resume critiques", err)
+	}
+
+	return critiques, nil
+}
+
+// unmarshalCritiqueFields decodes the JSONB list columns of a critique.
+func unmarshalCritiqueFields(critique *domain.ResumeCritique, strengths, weaknesses, redFlags, edits []byte) error {
+	if err := json.Unmarshal(strengths, &critique.Strengths); err != nil {
+		return domain.NewDatabaseError("unmarshal critique strengths", err)
+	}
+	if err := json.Unmarshal(weaknesses, &critique.Weaknesses); err != nil {
+		return domain.NewDatabaseError("unmarshal critique weaknesses", err)
+	}
+	if err := json.Unmarshal(redFlags, &critique.RedFlags); err != nil {
+		return domain.NewDatabaseError("unmarshal critique red flags", err)
+	}
+	if err := json.Unmarshal(edits, &critique.SuggestedEdits); err != nil {
+		return domain.NewDatabaseError("unmarshal critique suggested edits", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// DATE columns are read and written through pgtype.Date rather than
+// time.Time so that no time zone conversion can move a date to the previous
+// or next day. Each DATE column is paired with a *_precision column holding
+// the domain.DatePrecision it was entered with.
+
+// dateParam converts a domain date to a DATE parameter; nil and zero dates
+// become NULL.
+func dateParam(d *domain.Date) pgtype.Date {
+	if d == nil || d.IsZero() {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{
+		Time:  time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC),
+		Valid: true,
+	}
+}
+
+// precisionParam returns the precision to store for a date, defaulting to day.
+func precisionParam(d *domain.Date) string {
+	if d == nil || !d.Precision.IsValid() {
+		return string(domain.DatePrecisionDay)
+	}
+	return string(d.Precision)
+}
+
+// scannedDate converts a scanned DATE and its precision to a domain date,
+// returning nil for NULL.
+func scannedDate(d pgtype.Date, precision string) *domain.Date {
+	if !d.Valid {
+		return nil
+	}
+	if domain.DatePrecision(precision) == domain.DatePrecisionMonth {
+		date := domain.NewMonthDate(d.Time.Year(), d.Time.Month())
+		return &date
+	}
+	date := domain.NewDate(d.Time.Year(), d.Time.Month(), d.Time.Day())
+	return &date
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// ownedByUser is the updateDisplayOrder ownership condition of tables with a
+// user_id column.
+const ownedByUser = "t.user_id = $4"
+
+// updateDisplayOrder sets the display order of a user's rows of table in a
+// single statement, so a reorder costs one round trip however many rows
+// move. owned is the SQL condition on the row t that holds when it belongs
+// to the user $4; IDs of rows that fail it are ignored. table and owned must
+// be constants, never user input.
+func updateDisplayOrder(ctx context.Context, pool querier, table, owned, op, userID string, orders []ports.DisplayOrderUpdate) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(orders))
+	positions := make([]int32, len(orders))
+	for i, order := range orders {
+		ids[i] = order.ID
+		positions[i] = int32(order.DisplayOrder)
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE %s AS t
+		SET display_order = o.display_order, updated_at = $3
+		FROM UNNEST($1::UUID[], $2::INT[]) AS o(id, display_order)
+		WHERE t.id = o.id AND %s
+	`, table, owned)
+
+	if _, err := pool.Exec(ctx, query, ids, positions, time.Now().UTC(), userID); err != nil {
+		return domain.NewDatabaseError(op, err)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
@@ -15,12 +16,15 @@ import (
 
 // EducationRepository implements ports.EducationRepository using PostgreSQL.
 type EducationRepository struct {
-	pool *pgxpool.Pool
+	pool querier
+
+	// replica serves list queries; it is pool when there is no replica.
+	replica querier
 }
 
 // NewEducationRepository creates a new EducationRepository.
 func NewEducationRepository(pool *pgxpool.Pool) *EducationRepository {
-	return &EducationRepository{pool: pool}
+	return &EducationRepository{pool: pool, replica: pool}
 }
 
 // Create creates a new education entry.
@@ -35,21 +39,14 @@ func (r *EducationRepository) Create(ctx context.Context, education *domain.Educ
 	query := `
 		INSERT INTO education (
 			id, user_id, institution, degree, field_of_study,
-			location, start_date, end_date, gpa, honors,
+			location, start_date, start_date_precision, end_date,
+			end_date_precision, is_expected, gpa, honors,
 			display_order, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
 		)
 	`
 
-	var startDate, endDate interface{}
-	if education.StartDate != nil {
-		startDate = education.StartDate.Time
-	}
-	if education.EndDate != nil {
-		endDate = education.EndDate.Time
-	}
-
 	_, err := r.pool.Exec(ctx, query,
 		education.ID,
 		education.UserID,
@@ -57,8 +54,11 @@ func (r *EducationRepository) Create(ctx context.Context, education *domain.Educ
 		education.Degree,
 		education.FieldOfStudy,
 		education.Location,
-		startDate,
-		endDate,
+		dateParam(education.StartDate),
+		precisionParam(education.StartDate),
+		dateParam(education.EndDate),
+		precisionParam(education.EndDate),
+		education.IsExpected,
 		education.GPA,
 		education.Honors,
 		education.DisplayOrder,
@@ -72,31 +72,33 @@ func (r *EducationRepository) Create(ctx context.Context, education *domain.Educ
 	return nil
 }
 
-// GetByID retrieves an education entry by ID.
-func (r *EducationRepository) GetByID(ctx context.Context, id string) (*domain.Education, error) {
+// GetByIDForUser retrieves a user's education entry by ID.
+func (r *EducationRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.Education, error) {
 	query := `
 		SELECT id, user_id, institution, degree, field_of_study,
-			   location, start_date, end_date, gpa, honors,
+			   location, start_date, start_date_precision, end_date,
+			   end_date_precision, is_expected, gpa, honors,
 			   display_order, created_at, updated_at
 		FROM education
-		WHERE id = $1
+		WHERE id = $1 AND user_id = $2
 	`
 
-	return r.scanEducation(r.pool.QueryRow(ctx, query, id))
+	return r.scanEducation(r.pool.QueryRow(ctx, query, id, userID))
 }
 
 // ListByUserID lists all education entries for a user, ordered by display_order.
 func (r *EducationRepository) ListByUserID(ctx context.Context, userID string) ([]domain.Education, error) {
 	query := `
 		SELECT id, user_id, institution, degree, field_of_study,
-			   location, start_date, end_date, gpa, honors,
+			   location, start_date, start_date_precision, end_date,
+			   end_date_precision, is_expected, gpa, honors,
 			   display_order, created_at, updated_at
 		FROM education
 		WHERE user_id = $1
 		ORDER BY display_order ASC, end_date DESC NULLS FIRST, start_date DESC
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID)
+	rows, err := r.replica.Query(ctx, query, userID)
 	if err != nil {
 		return nil, domain.NewDatabaseError("list education", err)
 	}
@@ -116,30 +118,28 @@ func (r *EducationRepository) Update(ctx context.Context, education *domain.Educ
 			field_of_study = $4,
 			location = $5,
 			start_date = $6,
-			end_date = $7,
-			gpa = $8,
-			honors = $9,
-			display_order = $10,
-			updated_at = $11
+			start_date_precision = $7,
+			end_date = $8,
+			end_date_precision = $9,
+			is_expected = $10,
+			gpa = $11,
+			honors = $12,
+			display_order = $13,
+			updated_at = $14
 		WHERE id = $1
 	`
 
-	var startDate, endDate interface{}
-	if education.StartDate != nil {
-		startDate = education.StartDate.Time
-	}
-	if education.EndDate != nil {
-		endDate = education.EndDate.Time
-	}
-
 	result, err := r.pool.Exec(ctx, query,
 		education.ID,
 		education.Institution,
 		education.Degree,
 		education.FieldOfStudy,
 		education.Location,
-		startDate,
-		endDate,
+		dateParam(education.StartDate),
+		precisionParam(education.StartDate),
+		dateParam(education.EndDate),
+		precisionParam(education.EndDate),
+		education.IsExpected,
 		education.GPA,
 		education.Honors,
 		education.DisplayOrder,
@@ -156,11 +156,11 @@ func (r *EducationRepository) Update(ctx context.Context, education *domain.Educ
 	return nil
 }
 
-// Delete removes an education entry.
-func (r *EducationRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM education WHERE id = $1`
+// Delete removes a user's education entry.
+func (r *EducationRepository) Delete(ctx context.Context, id, userID string) error {
+	query := `DELETE FROM education WHERE id = $1 AND user_id = $2`
 
-	result, err := r.pool.Exec(ctx, query, id)
+	result, err := r.pool.Exec(ctx, query, id, userID)
 	if err != nil {
 		return domain.NewDatabaseError("delete education", err)
 	}
@@ -172,34 +172,16 @@ func (r *EducationRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// UpdateDisplayOrder updates the display order of education entries.
-func (r *EducationRepository) UpdateDisplayOrder(ctx context.Context, orders []ports.DisplayOrderUpdate) error {
-	if len(orders) == 0 {
-		return nil
-	}
-
-	tx, err := r.pool.Begin(ctx)
-	if err != nil {
-		return domain.NewDatabaseError("begin transaction", err)
-	}
-	defer tx.Rollback(ctx)
-
-	query := `UPDATE education SET display_order = $2, updated_at = $3 WHERE id = $1`
-
-	for _, order := range orders {
-		_, err := tx.Exec(ctx, query, order.ID, order.DisplayOrder, time.Now().UTC())
-		if err != nil {
-			return domain.NewDatabaseError("update education order", err)
-		}
-	}
-
-	return tx.Commit(ctx)
+// UpdateDisplayOrder updates the display order of a user's education entries.
+func (r *EducationRepository) UpdateDisplayOrder(ctx context.Context, userID string, orders []ports.DisplayOrderUpdate) error {
+	return updateDisplayOrder(ctx, r.pool, "education", ownedByUser, "update education order", userID, orders)
 }
 
 // scanEducation scans a single education row.
 func (r *EducationRepository) scanEducation(row pgx.Row) (*domain.Education, error) {
 	var education domain.Education
-	var startDate, endDate *time.Time
+	var startDate, endDate pgtype.Date
+	var startPrecision, endPrecision string
 
 	err := row.Scan(
 		&education.ID,
@@ -209,7 +191,10 @@ func (r *EducationRepository) scanEducation(row pgx.Row) (*domain.Education, err
 		&education.FieldOfStudy,
 		&education.Location,
 		&startDate,
+		&startPrecision,
 		&endDate,
+		&endPrecision,
+		&education.IsExpected,
 		&education.GPA,
 		&education.Honors,
 		&education.DisplayOrder,
@@ -223,14 +208,8 @@ func (r *EducationRepository) scanEducation(row pgx.Row) (*domain.Education, err
 		return nil, domain.NewDatabaseError("scan education", err)
 	}
 
-	if startDate != nil {
-		d := domain.Date{Time: *startDate}
-		education.StartDate = &d
-	}
-	if endDate != nil {
-		d := domain.Date{Time: *endDate}
-		education.EndDate = &d
-	}
+	education.StartDate = scannedDate(startDate, startPrecision)
+	education.EndDate = scannedDate(endDate, endPrecision)
 
 	if education.Honors == nil {
 		education.Honors = make([]string, 0)
@@ -245,7 +224,8 @@ func (r *EducationRepository) scanEducationList(rows pgx.Rows) ([]domain.Educati
 
 	for rows.Next() {
 		var education domain.Education
-		var startDate, endDate *time.Time
+		var startDate, endDate pgtype.Date
+		var startPrecision, endPrecision string
 
 		err := rows.Scan(
 			&education.ID,
@@ -255,7 +235,10 @@ func (r *EducationRepository) scanEducationList(rows pgx.Rows) ([]domain.Educati
 			&education.FieldOfStudy,
 			&education.Location,
 			&startDate,
+			&startPrecision,
 			&endDate,
+			&endPrecision,
+			&education.IsExpected,
 			&education.GPA,
 			&education.Honors,
 			&education.DisplayOrder,
@@ -266,14 +249,8 @@ func (r *EducationRepository) scanEducationList(rows pgx.Rows) ([]domain.Educati
 			return nil, domain.NewDatabaseError("scan education list", err)
 		}
 
-		if startDate != nil {
-			d := domain.Date{Time: *startDate}
-			education.StartDate = &d
-		}
-		if endDate != nil {
-			d := domain.Date{Time: *endDate}
-			education.EndDate = &d
-		}
+		education.StartDate = scannedDate(startDate, startPrecision)
+		education.EndDate = scannedDate(endDate, endPrecision)
 
 		if education.Honors == nil {
 			education.Honors = make([]string, 0)
@@ -8,7 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
@@ -16,7 +16,10 @@ import (
 
 // ExperienceRepository implements ports.ExperienceRepository using PostgreSQL.
 type ExperienceRepository struct {
-	pool *pgxpool.Pool
+	pool querier
+
+	// replica serves list queries; it is pool when there is no replica.
+	replica querier
 }
 
 // Create creates a new experience.
@@ -37,19 +40,14 @@ func (r *ExperienceRepository) Create(ctx context.Context, experience *domain.Ex
 	query := `
 		INSERT INTO experiences (
 			id, user_id, type, title, organization, location,
-			start_date, end_date, is_current, description, url,
+			start_date, start_date_precision, end_date, end_date_precision,
+			is_current, exclude_from_tailoring, description, url,
 			metadata, display_order, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
 		)
 	`
 
-	var endDate *time.Time
-	if experience.EndDate != nil {
-		t := experience.EndDate.Time
-		endDate = &t
-	}
-
 	_, err = r.pool.Exec(ctx, query,
 		experience.ID,
 		experience.UserID,
@@ -57,9 +55,12 @@ func (r *ExperienceRepository) Create(ctx context.Context, experience *domain.Ex
 		experience.Title,
 		experience.Organization,
 		experience.Location,
-		experience.StartDate.Time,
-		endDate,
+		dateParam(&experience.StartDate),
+		precisionParam(&experience.StartDate),
+		dateParam(experience.EndDate),
+		precisionParam(experience.EndDate),
 		experience.IsCurrent,
+		experience.ExcludeFromTailoring,
 		experience.Description,
 		experience.URL,
 		metadataJSON,
@@ -74,22 +75,23 @@ func (r *ExperienceRepository) Create(ctx context.Context, experience *domain.Ex
 	return nil
 }
 
-// GetByID retrieves an experience by ID.
-func (r *ExperienceRepository) GetByID(ctx context.Context, id string) (*domain.Experience, error) {
+// GetByIDForUser retrieves a user's experience by ID.
+func (r *ExperienceRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.Experience, error) {
 	query := `
 		SELECT id, user_id, type, title, organization, location,
-			   start_date, end_date, is_current, description, url,
+			   start_date, start_date_precision, end_date, end_date_precision,
+			   is_current, exclude_from_tailoring, description, url,
 			   metadata, display_order, created_at, updated_at
 		FROM experiences
-		WHERE id = $1
+		WHERE id = $1 AND user_id = $2
 	`
 
-	return r.scanExperience(ctx, r.pool.QueryRow(ctx, query, id))
+	return r.scanExperience(ctx, r.pool.QueryRow(ctx, query, id, userID))
 }
 
-// GetByIDWithBullets retrieves an experience with all its bullets.
-func (r *ExperienceRepository) GetByIDWithBullets(ctx context.Context, id string) (*domain.Experience, error) {
-	exp, err := r.GetByID(ctx, id)
+// GetByIDWithBulletsForUser retrieves a user's experience with all its bullets.
+func (r *ExperienceRepository) GetByIDWithBulletsForUser(ctx context.Context, id, userID string) (*domain.Experience, error) {
+	exp, err := r.GetByIDForUser(ctx, id, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -129,13 +131,14 @@ func (r *ExperienceRepository) GetByIDWithBullets(ctx context.Context, id string
 func (r *ExperienceRepository) ListByUserIDWithBullets(ctx context.Context, userID string, opts ports.ListOptions) ([]domain.Experience, int, error) {
 	countQuery := `SELECT COUNT(*) FROM experiences WHERE user_id = $1`
 	var total int
-	if err := r.pool.QueryRow(ctx, countQuery, userID).Scan(&total); err != nil {
+	if err := r.replica.QueryRow(ctx, countQuery, userID).Scan(&total); err != nil {
 		return nil, 0, domain.NewDatabaseError("count experiences", err)
 	}
 
 	query := `
 		SELECT id, user_id, type, title, organization, location,
-			   start_date, end_date, is_current, description, url,
+			   start_date, start_date_precision, end_date, end_date_precision,
+			   is_current, exclude_from_tailoring, description, url,
 			   metadata, display_order, created_at, updated_at
 		FROM experiences
 		WHERE user_id = $1
@@ -143,7 +146,7 @@ func (r *ExperienceRepository) ListByUserIDWithBullets(ctx context.Context, user
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID, opts.Limit, opts.Offset)
+	rows, err := r.replica.Query(ctx, query, userID, opts.Limit, opts.Offset)
 	if err != nil {
 		return nil, 0, domain.NewDatabaseError("list experiences", err)
 	}
@@ -154,36 +157,8 @@ func (r *ExperienceRepository) ListByUserIDWithBullets(ctx context.Context, user
 		return nil, 0, err
 	}
 
-	for i := range experiences {
-		bulletQuery := `
-			SELECT id, experience_id, content, impact_score, keywords,
-				   metadata, display_order, created_at, updated_at
-			FROM bullets
-			WHERE experience_id = $1
-			ORDER BY display_order ASC, created_at ASC
-		`
-
-		bulletRows, err := r.pool.Query(ctx, bulletQuery, experiences[i].ID)
-		if err != nil {
-			return nil, 0, domain.NewDatabaseError("get bullets for experience", err)
-		}
-
-		bullets := make([]domain.Bullet, 0)
-		for bulletRows.Next() {
-			bullet, err := scanBulletRow(bulletRows)
-			if err != nil {
-				bulletRows.Close()
-				return nil, 0, err
-			}
-			bullets = append(bullets, *bullet)
-		}
-
-		if err := bulletRows.Err(); err != nil {
-			bulletRows.Close()
-			return nil, 0, domain.NewDatabaseError("iterate bullets", err)
-		}
-		bulletRows.Close()
-		experiences[i].Bullets = bullets
+	if err := r.loadBullets(ctx, experiences); err != nil {
+		return nil, 0, err
 	}
 
 	return experiences, total, nil
@@ -193,13 +168,14 @@ func (r *ExperienceRepository) ListByUserIDWithBullets(ctx context.Context, user
 func (r *ExperienceRepository) ListByUserIDAndTypeWithBullets(ctx context.Context, userID string, expType domain.ExperienceType, opts ports.ListOptions) ([]domain.Experience, int, error) {
 	countQuery := `SELECT COUNT(*) FROM experiences WHERE user_id = $1 AND type = $2`
 	var total int
-	if err := r.pool.QueryRow(ctx, countQuery, userID, string(expType)).Scan(&total); err != nil {
+	if err := r.replica.QueryRow(ctx, countQuery, userID, string(expType)).Scan(&total); err != nil {
 		return nil, 0, domain.NewDatabaseError("count experiences by type", err)
 	}
 
 	query := `
 		SELECT id, user_id, type, title, organization, location,
-			   start_date, end_date, is_current, description, url,
+			   start_date, start_date_precision, end_date, end_date_precision,
+			   is_current, exclude_from_tailoring, description, url,
 			   metadata, display_order, created_at, updated_at
 		FROM experiences
 		WHERE user_id = $1 AND type = $2
@@ -207,7 +183,7 @@ func (r *ExperienceRepository) ListByUserIDAndTypeWithBullets(ctx context.Contex
 		LIMIT $3 OFFSET $4
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID, string(expType), opts.Limit, opts.Offset)
+	rows, err := r.replica.Query(ctx, query, userID, string(expType), opts.Limit, opts.Offset)
 	if err != nil {
 		return nil, 0, domain.NewDatabaseError("list experiences by type", err)
 	}
@@ -218,36 +194,8 @@ func (r *ExperienceRepository) ListByUserIDAndTypeWithBullets(ctx context.Contex
 		return nil, 0, err
 	}
 
-	for i := range experiences {
-		bulletQuery := `
-			SELECT id, experience_id, content, impact_score, keywords,
-				   metadata, display_order, created_at, updated_at
-			FROM bullets
-			WHERE experience_id = $1
-			ORDER BY display_order ASC, created_at ASC
-		`
-
-		bulletRows, err := r.pool.Query(ctx, bulletQuery, experiences[i].ID)
-		if err != nil {
-			return nil, 0, domain.NewDatabaseError("get bullets for experience", err)
-		}
-
-		bullets := make([]domain.Bullet, 0)
-		for bulletRows.Next() {
-			bullet, err := scanBulletRow(bulletRows)
-			if err != nil {
-				bulletRows.Close()
-				return nil, 0, err
-			}
-			bullets = append(bullets, *bullet)
-		}
-
-		if err := bulletRows.Err(); err != nil {
-			bulletRows.Close()
-			return nil, 0, domain.NewDatabaseError("iterate bullets", err)
-		}
-		bulletRows.Close()
-		experiences[i].Bullets = bullets
+	if err := r.loadBullets(ctx, experiences); err != nil {
+		return nil, 0, err
 	}
 
 	return experiences, total, nil
@@ -269,31 +217,31 @@ func (r *ExperienceRepository) Update(ctx context.Context, experience *domain.Ex
 			organization = $4,
 			location = $5,
 			start_date = $6,
-			end_date = $7,
-			is_current = $8,
-			description = $9,
-			url = $10,
-			metadata = $11,
-			display_order = $12,
-			updated_at = $13
+			start_date_precision = $7,
+			end_date = $8,
+			end_date_precision = $9,
+			is_current = $10,
+			exclude_from_tailoring = $11,
+			description = $12,
+			url = $13,
+			metadata = $14,
+			display_order = $15,
+			updated_at = $16
 		WHERE id = $1
 	`
 
-	var endDate *time.Time
-	if experience.EndDate != nil {
-		t := experience.EndDate.Time
-		endDate = &t
-	}
-
 	result, err := r.pool.Exec(ctx, query,
 		experience.ID,
 		string(experience.Type),
 		experience.Title,
 		experience.Organization,
 		experience.Location,
-		experience.StartDate.Time,
-		endDate,
+		dateParam(&experience.StartDate),
+		precisionParam(&experience.StartDate),
+		dateParam(experience.EndDate),
+		precisionParam(experience.EndDate),
 		experience.IsCurrent,
+		experience.ExcludeFromTailoring,
 		experience.Description,
 		experience.URL,
 		metadataJSON,
@@ -311,8 +259,8 @@ func (r *ExperienceRepository) Update(ctx context.Context, experience *domain.Ex
 	return nil
 }
 
-// Delete removes an experience and all its bullets.
-func (r *ExperienceRepository) Delete(ctx context.Context, id string) error {
+// Delete removes a user's experience and all its bullets.
+func (r *ExperienceRepository) Delete(ctx context.Context, id, userID string) error {
 	// Start transaction to delete bullets and experience atomically.
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
@@ -321,13 +269,17 @@ func (r *ExperienceRepository) Delete(ctx context.Context, id string) error {
 	defer tx.Rollback(ctx)
 
 	// Delete bullets first (foreign key constraint).
-	_, err = tx.Exec(ctx, `DELETE FROM bullets WHERE experience_id = $1`, id)
+	_, err = tx.Exec(ctx, `
+		DELETE FROM bullets b
+		USING experiences e
+		WHERE b.experience_id = e.id AND e.id = $1 AND e.user_id = $2
+	`, id, userID)
 	if err != nil {
 		return domain.NewDatabaseError("delete bullets", err)
 	}
 
 	// Delete experience.
-	result, err := tx.Exec(ctx, `DELETE FROM experiences WHERE id = $1`, id)
+	result, err := tx.Exec(ctx, `DELETE FROM experiences WHERE id = $1 AND user_id = $2`, id, userID)
 	if err != nil {
 		return domain.NewDatabaseError("delete experience", err)
 	}
@@ -343,21 +295,105 @@ func (r *ExperienceRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// UpdateDisplayOrder updates the display order of experiences.
-func (r *ExperienceRepository) UpdateDisplayOrder(ctx context.Context, orders []ports.DisplayOrderUpdate) error {
+// UpdateDisplayOrder updates the display order of a user's experiences.
+func (r *ExperienceRepository) UpdateDisplayOrder(ctx context.Context, userID string, orders []ports.DisplayOrderUpdate) error {
+	return updateDisplayOrder(ctx, r.pool, "experiences", ownedByUser, "update display order", userID, orders)
+}
+
+// CreateBatch creates experiences and their bullets in a single transaction.
+func (r *ExperienceRepository) CreateBatch(ctx context.Context, experiences []domain.Experience) error {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return domain.NewDatabaseError("begin transaction", err)
 	}
 	defer tx.Rollback(ctx)
 
-	query := `UPDATE experiences SET display_order = $2, updated_at = $3 WHERE id = $1`
+	experienceQuery := `
+		INSERT INTO experiences (
+			id, user_id, type, title, organization, location,
+			start_date, start_date_precision, end_date, end_date_precision,
+			is_current, exclude_from_tailoring, description, url,
+			metadata, display_order, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+		)
+	`
+	bulletQuery := `
+		INSERT INTO bullets (
+			id, experience_id, content, impact_score, impact_scored_hash,
+			keywords, metadata, display_order, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, CASE WHEN $10 THEN md5($3) END, $5, $6, $7, $8, $9
+		)
+	`
+
 	now := time.Now().UTC()
+	for i := range experiences {
+		experience := &experiences[i]
+		if experience.ID == "" {
+			experience.ID = uuid.New().String()
+		}
+		experience.CreatedAt = now
+		experience.UpdatedAt = now
 
-	for _, order := range orders {
-		_, err := tx.Exec(ctx, query, order.ID, order.DisplayOrder, now)
+		metadataJSON, err := json.Marshal(experience.Metadata)
 		if err != nil {
-			return domain.NewDatabaseError("update display order", err)
+			return domain.NewDatabaseError("marshal experience metadata", err)
+		}
+
+		_, err = tx.Exec(ctx, experienceQuery,
+			experience.ID,
+			experience.UserID,
+			string(experience.Type),
+			experience.Title,
+			experience.Organization,
+			experience.Location,
+			dateParam(&experience.StartDate),
+			precisionParam(&experience.StartDate),
+			dateParam(experience.EndDate),
+			precisionParam(experience.EndDate),
+			experience.IsCurrent,
+			experience.ExcludeFromTailoring,
+			experience.Description,
+			experience.URL,
+			metadataJSON,
+			experience.DisplayOrder,
+			experience.CreatedAt,
+			experience.UpdatedAt,
+		)
+		if err != nil {
+			return domain.NewDatabaseError("create experience", err)
+		}
+
+		for j := range experience.Bullets {
+			bullet := &experience.Bullets[j]
+			if bullet.ID == "" {
+				bullet.ID = uuid.New().String()
+			}
+			bullet.ExperienceID = experience.ID
+			bullet.CreatedAt = now
+			bullet.UpdatedAt = now
+
+			bulletMetadataJSON, err := json.Marshal(bullet.Metadata)
+			if err != nil {
+				return domain.NewDatabaseError("marshal bullet metadata", err)
+			}
+
+			_, err = tx.Exec(ctx, bulletQuery,
+				bullet.ID,
+				bullet.ExperienceID,
+				bullet.Content,
+				bullet.ImpactScore.Int(),
+				bullet.Keywords,
+				bulletMetadataJSON,
+				bullet.DisplayOrder,
+				bullet.CreatedAt,
+				bullet.UpdatedAt,
+				bullet.ImpactScored,
+			)
+			if err != nil {
+				return domain.NewDatabaseError("create bullet", err)
+			}
 		}
 	}
 
@@ -368,12 +404,57 @@ func (r *ExperienceRepository) UpdateDisplayOrder(ctx context.Context, orders []
 	return nil
 }
 
+// loadBullets sets the bullets of all experiences with a single query on the
+// replica, instead of one query per experience.
+func (r *ExperienceRepository) loadBullets(ctx context.Context, experiences []domain.Experience) error {
+	if len(experiences) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(experiences))
+	byID := make(map[string]*domain.Experience, len(experiences))
+	for i := range experiences {
+		ids[i] = experiences[i].ID
+		byID[experiences[i].ID] = &experiences[i]
+	}
+
+	query := `
+		SELECT id, experience_id, content, impact_score, keywords,
+			   metadata, display_order, created_at, updated_at
+		FROM bullets
+		WHERE experience_id = ANY($1::UUID[])
+		ORDER BY display_order ASC, created_at ASC
+	`
+
+	rows, err := r.replica.Query(ctx, query, ids)
+	if err != nil {
+		return domain.NewDatabaseError("get bullets for experiences", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		bullet, err := scanBulletRow(rows)
+		if err != nil {
+			return err
+		}
+		if exp, ok := byID[bullet.ExperienceID]; ok {
+			exp.Bullets = append(exp.Bullets, *bullet)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return domain.NewDatabaseError("iterate bullets", err)
+	}
+
+	return nil
+}
+
 // scanExperience scans a single experience row.
 func (r *ExperienceRepository) scanExperience(ctx context.Context, row pgx.Row) (*domain.Experience, error) {
 	exp := &domain.Experience{}
 	var expType string
-	var startDate time.Time
-	var endDate *time.Time
+	var startDate, endDate pgtype.Date
+	var startPrecision, endPrecision string
 	var metadataJSON []byte
 
 	err := row.Scan(
@@ -384,8 +465,11 @@ func (r *ExperienceRepository) scanExperience(ctx context.Context, row pgx.Row)
 		&exp.Organization,
 		&exp.Location,
 		&startDate,
+		&startPrecision,
 		&endDate,
+		&endPrecision,
 		&exp.IsCurrent,
+		&exp.ExcludeFromTailoring,
 		&exp.Description,
 		&exp.URL,
 		&metadataJSON,
@@ -401,10 +485,10 @@ func (r *ExperienceRepository) scanExperience(ctx context.Context, row pgx.Row)
 	}
 
 	exp.Type = domain.ExperienceType(expType)
-	exp.StartDate = domain.Date{Time: startDate}
-	if endDate != nil {
-		exp.EndDate = &domain.Date{Time: *endDate}
+	if d := scannedDate(startDate, startPrecision); d != nil {
+		exp.StartDate = *d
 	}
+	exp.EndDate = scannedDate(endDate, endPrecision)
 
 	if len(metadataJSON) > 0 {
 		if err := json.Unmarshal(metadataJSON, &exp.Metadata); err != nil {
@@ -426,8 +510,8 @@ func (r *ExperienceRepository) scanExperiences(ctx context.Context, rows pgx.Row
 	for rows.Next() {
 		exp := domain.Experience{}
 		var expType string
-		var startDate time.Time
-		var endDate *time.Time
+		var startDate, endDate pgtype.Date
+		var startPrecision, endPrecision string
 		var metadataJSON []byte
 
 		err := rows.Scan(
@@ -438,8 +522,11 @@ func (r *ExperienceRepository) scanExperiences(ctx context.Context, rows pgx.Row
 			&exp.Organization,
 			&exp.Location,
 			&startDate,
+			&startPrecision,
 			&endDate,
+			&endPrecision,
 			&exp.IsCurrent,
+			&exp.ExcludeFromTailoring,
 			&exp.Description,
 			&exp.URL,
 			&metadataJSON,
@@ -452,10 +539,10 @@ func (r *ExperienceRepository) scanExperiences(ctx context.Context, rows pgx.Row
 		}
 
 		exp.Type = domain.ExperienceType(expType)
-		exp.StartDate = domain.Date{Time: startDate}
-		if endDate != nil {
-			exp.EndDate = &domain.Date{Time: *endDate}
+		if d := scannedDate(startDate, startPrecision); d != nil {
+			exp.StartDate = *d
 		}
+		exp.EndDate = scannedDate(endDate, endPrecision)
 
 		if len(metadataJSON) > 0 {
 			if err := json.Unmarshal(metadataJSON, &exp.Metadata); err != nil {
@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// execer runs statements on a pool or inside a transaction.
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+// rowQuerier runs single-row queries on a pool or inside a transaction.
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// insertOutboxEvents adds events to the outbox through db, usually the
+// transaction of the change they describe.
+func insertOutboxEvents(ctx context.Context, db execer, events []domain.OutboxEvent) error {
+	query := `
+		INSERT INTO outbox_events (id, type, user_id, resume_id, data, created_at, next_attempt_at)
+		VALUES ($1, $2, $3, NULLIF($4, '')::UUID, $5, $6, $6)
+	`
+
+	for i := range events {
+		event := &events[i]
+		if event.ID == "" {
+			event.ID = uuid.New().String()
+		}
+		event.CreatedAt = time.Now().UTC()
+
+		dataJSON, err := json.Marshal(event.Data)
+		if err != nil {
+			return domain.NewDatabaseError("marshal outbox event data", err)
+		}
+
+		_, err = db.Exec(ctx, query,
+			event.ID, string(event.Type), event.UserID, event.ResumeID, dataJSON, event.CreatedAt)
+		if err != nil {
+			return domain.NewDatabaseError("insert outbox event", err)
+		}
+	}
+
+	return nil
+}
+
+// OutboxRepository implements ports.OutboxRepository using PostgreSQL.
+type OutboxRepository struct {
+	pool querier
+}
+
+// ClaimDue returns up to limit undelivered events due for delivery, oldest
+// first, and postpones their next delivery by lease. Rows locked by another
+// relay are skipped.
+func (r *OutboxRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxEvent, error) {
+	query := `
+		UPDATE outbox_events SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+		WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE sent_at IS NULL AND next_attempt_at <= NOW()
+			ORDER BY created_at, id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, type, user_id, COALESCE(resume_id::TEXT, ''), data, created_at, attempts
+	`
+
+	rows, err := r.pool.Query(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, domain.NewDatabaseError("claim outbox events", err)
+	}
+	defer rows.Close()
+
+	events := make([]domain.OutboxEvent, 0)
+	for rows.Next() {
+		var event domain.OutboxEvent
+		var eventType string
+		var dataJSON []byte
+
+		if err := rows.Scan(
+			&event.ID,
+			&eventType,
+			&event.UserID,
+			&event.ResumeID,
+			&dataJSON,
+			&event.CreatedAt,
+			&event.Attempts,
+		); err != nil {
+			return nil, domain.NewDatabaseError("scan outbox event", err)
+		}
+		event.Type = domain.OutboxEventType(eventType)
+		if err := json.Unmarshal(dataJSON, &event.Data); err != nil {
+			return nil, domain.NewDatabaseError("unmarshal outbox event data", err)
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("iterate outbox events", err)
+	}
+
+	// UPDATE ... RETURNING does not keep the order of the subquery.
+	sortOutboxEvents(events)
+
+	return events, nil
+}
+
+// MarkSent records the delivery of an event.
+func (r *OutboxRepository) MarkSent(ctx context.Context, id string) error {
+	_, err := r.pool.Exec(ctx, `UPDATE outbox_events SET sent_at = NOW(), last_error = '' WHERE id = $1`, id)
+	if err != nil {
+		return domain.NewDatabaseError("mark outbox event sent", err)
+	}
+	return nil
+}
+
+// MarkFailed records a failed delivery and when to try again.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id, reason string, retryAt time.Time) error {
+	query := `
+		UPDATE outbox_events
+		SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+		WHERE id = $1
+	`
+
+	_, err := r.pool.Exec(ctx, query, id, reason, retryAt)
+	if err != nil {
+		return domain.NewDatabaseError("mark outbox event failed", err)
+	}
+	return nil
+}
+
+// DeleteSentBefore removes the events delivered before a time.
+func (r *OutboxRepository) DeleteSentBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.pool.Exec(ctx, `DELETE FROM outbox_events WHERE sent_at < $1`, before)
+	if err != nil {
+		return 0, domain.NewDatabaseError("delete sent outbox events", err)
+	}
+	return int(result.RowsAffected()), nil
+}
+
+// sortOutboxEvents sorts events oldest first.
+func sortOutboxEvents(events []domain.OutboxEvent) {
+	slices.SortFunc(events, func(a, b domain.OutboxEvent) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+}
@@ -46,6 +46,20 @@ type Config struct {
 
 	// ConnectionURL is an optional full connection URL (overrides other fields).
 	ConnectionURL string
+
+	// ReplicaDSN is an optional connection string of a read-only replica.
+	// When set, list queries are sent to it; everything else, including
+	// lookups by ID, stays on the primary so reads right after a write see
+	// it. The replica uses the same pool settings as the primary.
+	ReplicaDSN string
+
+	// RowLevelSecurity scopes the statements of user requests to their user
+	// with row-level security, as a second line of defense against missing
+	// ownership checks. Each such statement runs in a transaction setting
+	// app.current_user_id, which the policies of
+	// deploy/postgres/init/001_init.sql compare row owners with. The database user must not be a superuser or
+	// bypass row-level security, and the policies must be installed.
+	RowLevelSecurity bool
 }
 
 // DefaultConfig returns a Config with sensible defaults.
@@ -78,11 +92,45 @@ func (c Config) DSN() string {
 // DB wraps a pgxpool.Pool and provides repository factories.
 type DB struct {
 	pool *pgxpool.Pool
+
+	// replica is the pool of the read-only replica, or nil without one.
+	replica *pgxpool.Pool
+
+	// rls scopes the statements of user-scoped repositories to the current
+	// user. See Config.RowLevelSecurity.
+	rls bool
 }
 
-// New creates a new DB connection pool.
+// New creates a new DB connection pool, and one for the replica when
+// cfg.ReplicaDSN is set.
 func New(ctx context.Context, cfg Config) (*DB, error) {
-	poolCfg, err := pgxpool.ParseConfig(cfg.DSN())
+	pool, err := newPool(ctx, cfg, cfg.DSN())
+	if err != nil {
+		return nil, err
+	}
+
+	db := &DB{pool: pool, rls: cfg.RowLevelSecurity}
+	if cfg.RowLevelSecurity {
+		if err := checkRowLevelSecurity(ctx, pool); err != nil {
+			pool.Close()
+			return nil, err
+		}
+	}
+	if cfg.ReplicaDSN != "" {
+		replica, err := newPool(ctx, cfg, cfg.ReplicaDSN)
+		if err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("replica: %w", err)
+		}
+		db.replica = replica
+	}
+
+	return db, nil
+}
+
+// newPool creates a connection pool to dsn with the pool settings of cfg.
+func newPool(ctx context.Context, cfg Config, dsn string) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse database config: %w", err)
 	}
@@ -105,14 +153,17 @@ func New(ctx context.Context, cfg Config) (*DB, error) {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	return &DB{pool: pool}, nil
+	return pool, nil
 }
 
-// Close closes the database connection pool.
+// Close closes the database connection pools.
 func (db *DB) Close() {
 	if db.pool != nil {
 		db.pool.Close()
 	}
+	if db.replica != nil {
+		db.replica.Close()
+	}
 }
 
 // Pool returns the underlying connection pool for advanced usage.
@@ -120,9 +171,43 @@ func (db *DB) Pool() *pgxpool.Pool {
 	return db.pool
 }
 
-// HealthCheck verifies the database connection is alive.
+// HasReplica reports whether list queries are sent to a read-only replica.
+func (db *DB) HasReplica() bool {
+	return db.replica != nil
+}
+
+// HealthCheck verifies the database connections are alive.
 func (db *DB) HealthCheck(ctx context.Context) error {
-	return db.pool.Ping(ctx)
+	if err := db.pool.Ping(ctx); err != nil {
+		return err
+	}
+	if db.replica != nil {
+		if err := db.replica.Ping(ctx); err != nil {
+			return fmt.Errorf("replica: %w", err)
+		}
+	}
+	return nil
+}
+
+// reader returns the pool list queries are sent to: the replica if there is
+// one, the primary otherwise.
+func (db *DB) reader() querier {
+	if db.replica == nil {
+		return db.conn()
+	}
+	if db.rls {
+		return &rlsPool{pool: db.replica}
+	}
+	return db.replica
+}
+
+// conn returns the primary pool of user-scoped repositories, which scopes
+// their statements to the current user when row-level security is enabled.
+func (db *DB) conn() querier {
+	if db.rls {
+		return &rlsPool{pool: db.pool}
+	}
+	return db.pool
 }
 
 // UserRepository returns a new UserRepository instance.
@@ -132,40 +217,105 @@ func (db *DB) UserRepository() *UserRepository {
 
 // ExperienceRepository returns a new ExperienceRepository instance.
 func (db *DB) ExperienceRepository() *ExperienceRepository {
-	return &ExperienceRepository{pool: db.pool}
+	return &ExperienceRepository{pool: db.conn(), replica: db.reader()}
 }
 
 // BulletRepository returns a new BulletRepository instance.
 func (db *DB) BulletRepository() *BulletRepository {
-	return &BulletRepository{pool: db.pool}
+	return &BulletRepository{pool: db.conn(), replica: db.reader()}
+}
+
+// BulletEmbeddingRepository returns a new BulletEmbeddingRepository instance.
+func (db *DB) BulletEmbeddingRepository() *BulletEmbeddingRepository {
+	return &BulletEmbeddingRepository{pool: db.conn()}
 }
 
 // SkillRepository returns a new SkillRepository instance.
 func (db *DB) SkillRepository() *SkillRepository {
-	return &SkillRepository{pool: db.pool}
+	return &SkillRepository{pool: db.conn(), replica: db.reader()}
 }
 
 // SpokenLanguageRepository returns a new SpokenLanguageRepository instance.
 func (db *DB) SpokenLanguageRepository() *SpokenLanguageRepository {
-	return &SpokenLanguageRepository{pool: db.pool}
+	return &SpokenLanguageRepository{pool: db.conn(), replica: db.reader()}
 }
 
 // ResumeRepository returns a new ResumeRepository instance.
 func (db *DB) ResumeRepository() *ResumeRepository {
-	return &ResumeRepository{pool: db.pool}
+	return &ResumeRepository{pool: db.conn(), replica: db.reader()}
 }
 
 // EducationRepository returns a new EducationRepository instance.
 func (db *DB) EducationRepository() *EducationRepository {
-	return &EducationRepository{pool: db.pool}
+	return &EducationRepository{pool: db.conn(), replica: db.reader()}
+}
+
+// AcademicEntryRepository returns a new AcademicEntryRepository instance.
+func (db *DB) AcademicEntryRepository() *AcademicEntryRepository {
+	return &AcademicEntryRepository{pool: db.conn(), replica: db.reader()}
 }
 
 // ProjectRepository returns a new ProjectRepository instance.
 func (db *DB) ProjectRepository() *ProjectRepository {
-	return &ProjectRepository{pool: db.pool}
+	return &ProjectRepository{pool: db.conn(), replica: db.reader()}
 }
 
 // ProjectBulletRepository returns a new ProjectBulletRepository instance.
 func (db *DB) ProjectBulletRepository() *ProjectBulletRepository {
-	return &ProjectBulletRepository{pool: db.pool}
+	return &ProjectBulletRepository{pool: db.conn()}
+}
+
+// UsageRepository returns a new UsageRepository instance.
+func (db *DB) UsageRepository() *UsageRepository {
+	return &UsageRepository{pool: db.conn()}
+}
+
+// PreferencesRepository returns a new PreferencesRepository instance.
+func (db *DB) PreferencesRepository() *PreferencesRepository {
+	return &PreferencesRepository{pool: db.conn()}
+}
+
+// ResumeCritiqueRepository returns a new ResumeCritiqueRepository instance.
+func (db *DB) ResumeCritiqueRepository() *ResumeCritiqueRepository {
+	return &ResumeCritiqueRepository{pool: db.conn(), replica: db.reader()}
+}
+
+// BulletFeedbackRepository returns a new BulletFeedbackRepository instance.
+func (db *DB) BulletFeedbackRepository() *BulletFeedbackRepository {
+	return &BulletFeedbackRepository{pool: db.conn()}
+}
+
+// AuditRepository returns a new AuditRepository instance.
+func (db *DB) AuditRepository() *AuditRepository {
+	return &AuditRepository{pool: db.pool}
+}
+
+// ResumeActivityRepository returns a new ResumeActivityRepository instance.
+func (db *DB) ResumeActivityRepository() *ResumeActivityRepository {
+	return &ResumeActivityRepository{pool: db.conn(), replica: db.reader()}
+}
+
+// ResumeTagRepository returns a new ResumeTagRepository instance.
+func (db *DB) ResumeTagRepository() *ResumeTagRepository {
+	return &ResumeTagRepository{pool: db.conn()}
+}
+
+// SavedResumeFilterRepository returns a new SavedResumeFilterRepository instance.
+func (db *DB) SavedResumeFilterRepository() *SavedResumeFilterRepository {
+	return &SavedResumeFilterRepository{pool: db.conn()}
+}
+
+// SuggestionRepository returns a new SuggestionRepository instance.
+func (db *DB) SuggestionRepository() *SuggestionRepository {
+	return &SuggestionRepository{pool: db.conn(), replica: db.reader()}
+}
+
+// BackgroundJobRepository returns a new BackgroundJobRepository instance.
+func (db *DB) BackgroundJobRepository() *BackgroundJobRepository {
+	return &BackgroundJobRepository{pool: db.pool}
+}
+
+// OutboxRepository returns a new OutboxRepository instance.
+func (db *DB) OutboxRepository() *OutboxRepository {
+	return &OutboxRepository{pool: db.pool}
 }
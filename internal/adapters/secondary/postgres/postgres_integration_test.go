@@ -8,11 +8,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/postgres"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
 )
 
 var testDB *postgres.DB
@@ -166,39 +169,250 @@ func TestExperienceRepositoryCRUD(t *testing.T) {
 		experienceID = exp.ID
 	})
 
-	t.Run("GetByID", func(t *testing.T) {
-		exp, err := expRepo.GetByID(ctx, experienceID)
+	t.Run("GetByIDForUser", func(t *testing.T) {
+		exp, err := expRepo.GetByIDForUser(ctx, experienceID, user.ID)
 		require.NoError(t, err)
 		assert.Equal(t, "Software Engineer", exp.Title)
 		assert.Equal(t, "Test Company", exp.Organization)
 	})
 
-	t.Run("ListByUserID", func(t *testing.T) {
-		experiences, total, err := expRepo.ListByUserID(ctx, user.ID, nil)
+	t.Run("GetByIDForUser other user", func(t *testing.T) {
+		_, err := expRepo.GetByIDForUser(ctx, experienceID, uuid.New().String())
+		assert.ErrorIs(t, err, domain.ErrExperienceNotFound)
+	})
+
+	t.Run("ListByUserIDWithBullets", func(t *testing.T) {
+		experiences, total, err := expRepo.ListByUserIDWithBullets(ctx, user.ID, ports.DefaultListOptions())
 		require.NoError(t, err)
 		assert.Equal(t, 1, total)
 		assert.Len(t, experiences, 1)
 	})
 
 	t.Run("Update", func(t *testing.T) {
-		exp, err := expRepo.GetByID(ctx, experienceID)
+		exp, err := expRepo.GetByIDForUser(ctx, experienceID, user.ID)
 		require.NoError(t, err)
 		exp.Title = "Senior Software Engineer"
 		err = expRepo.Update(ctx, exp)
 		require.NoError(t, err)
-		updated, err := expRepo.GetByID(ctx, experienceID)
+		updated, err := expRepo.GetByIDForUser(ctx, experienceID, user.ID)
 		require.NoError(t, err)
 		assert.Equal(t, "Senior Software Engineer", updated.Title)
 	})
 
+	t.Run("Delete other user", func(t *testing.T) {
+		err := expRepo.Delete(ctx, experienceID, uuid.New().String())
+		assert.ErrorIs(t, err, domain.ErrExperienceNotFound)
+	})
+
 	t.Run("Delete", func(t *testing.T) {
-		err := expRepo.Delete(ctx, experienceID)
+		err := expRepo.Delete(ctx, experienceID, user.ID)
 		require.NoError(t, err)
-		_, err = expRepo.GetByID(ctx, experienceID)
+		_, err = expRepo.GetByIDForUser(ctx, experienceID, user.ID)
 		assert.ErrorIs(t, err, domain.ErrExperienceNotFound)
 	})
 }
 
+func TestExperienceUpdateDisplayOrder(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testDB.UserRepository()
+	expRepo := testDB.ExperienceRepository()
+
+	user, err := domain.NewUser("test-order-user-" + time.Now().Format("20060102150405"))
+	require.NoError(t, err)
+	require.NoError(t, userRepo.Create(ctx, user))
+	defer func() {
+		_ = userRepo.Delete(ctx, user.ID)
+	}()
+
+	var orders []ports.DisplayOrderUpdate
+	for i, title := range []string{"First", "Second", "Third"} {
+		exp, err := domain.NewExperience(user.ID, domain.ExperienceTypeWork, title, "Test Company", domain.NewDate(2020, 1, 1))
+		require.NoError(t, err)
+		require.NoError(t, expRepo.Create(ctx, exp))
+		orders = append(orders, ports.DisplayOrderUpdate{ID: exp.ID, DisplayOrder: 2 - i})
+	}
+
+	require.NoError(t, expRepo.UpdateDisplayOrder(ctx, user.ID, orders))
+
+	experiences, _, err := expRepo.ListByUserIDWithBullets(ctx, user.ID, ports.DefaultListOptions())
+	require.NoError(t, err)
+	require.Len(t, experiences, 3)
+	assert.Equal(t, "Third", experiences[0].Title)
+	assert.Equal(t, "Second", experiences[1].Title)
+	assert.Equal(t, "First", experiences[2].Title)
+}
+
+func TestBulletSemanticSearch(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testDB.UserRepository()
+	expRepo := testDB.ExperienceRepository()
+	bulletRepo := testDB.BulletRepository()
+	embeddingRepo := testDB.BulletEmbeddingRepository()
+
+	user, err := domain.NewUser("test-semantic-user-" + time.Now().Format("20060102150405"))
+	require.NoError(t, err)
+	require.NoError(t, userRepo.Create(ctx, user))
+	defer func() {
+		_ = userRepo.Delete(ctx, user.ID)
+	}()
+
+	exp, err := domain.NewExperience(user.ID, domain.ExperienceTypeWork, "Engineer", "Test Company", domain.NewDate(2020, 1, 1))
+	require.NoError(t, err)
+	require.NoError(t, expRepo.Create(ctx, exp))
+
+	// unitVector returns a 1536-dimension vector pointing along axis i.
+	unitVector := func(i int) []float32 {
+		v := make([]float32, 1536)
+		v[i] = 1
+		return v
+	}
+
+	var embeddings []domain.BulletEmbedding
+	var bulletIDs []string
+	for i, content := range []string{"Built Go APIs", "Managed Kubernetes clusters"} {
+		bullet, err := domain.NewBullet(exp.ID, content)
+		require.NoError(t, err)
+		require.NoError(t, bulletRepo.Create(ctx, bullet))
+		bulletIDs = append(bulletIDs, bullet.ID)
+		embeddings = append(embeddings, domain.BulletEmbedding{
+			BulletID:    bullet.ID,
+			Model:       "test-model",
+			ContentHash: domain.HashEmbeddingText(bullet.EmbeddingText()),
+			Vector:      unitVector(i),
+		})
+	}
+	require.NoError(t, embeddingRepo.Upsert(ctx, embeddings))
+
+	t.Run("ListByUserID", func(t *testing.T) {
+		stored, err := embeddingRepo.ListByUserID(ctx, user.ID, "test-model")
+		require.NoError(t, err)
+		require.Len(t, stored, 2)
+		assert.Len(t, stored[0].Vector, 1536)
+	})
+
+	t.Run("SearchSemantic", func(t *testing.T) {
+		results, err := bulletRepo.SearchSemantic(ctx, user.ID, unitVector(1), 1)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, bulletIDs[1], results[0].ID)
+	})
+}
+
+func TestBulletRescoring(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testDB.UserRepository()
+	expRepo := testDB.ExperienceRepository()
+	bulletRepo := testDB.BulletRepository()
+
+	user, err := domain.NewUser("test-rescoring-user-" + time.Now().Format("20060102150405"))
+	require.NoError(t, err)
+	require.NoError(t, userRepo.Create(ctx, user))
+	defer func() {
+		_ = userRepo.Delete(ctx, user.ID)
+	}()
+
+	exp, err := domain.NewExperience(user.ID, domain.ExperienceTypeWork, "Engineer", "Test Company", domain.NewDate(2020, 1, 1))
+	require.NoError(t, err)
+	require.NoError(t, expRepo.Create(ctx, exp))
+
+	unscored, err := domain.NewBullet(exp.ID, "Built Go APIs")
+	require.NoError(t, err)
+	require.NoError(t, bulletRepo.Create(ctx, unscored))
+
+	given, err := domain.NewBullet(exp.ID, "Managed Kubernetes clusters")
+	require.NoError(t, err)
+	require.NoError(t, given.SetImpactScore(80))
+	require.NoError(t, bulletRepo.Create(ctx, given))
+
+	// stale lists the IDs of the test bullets due for rescoring.
+	stale := func() []string {
+		bullets, err := bulletRepo.ListForRescoring(ctx, 1000)
+		require.NoError(t, err)
+		var ids []string
+		for _, bullet := range bullets {
+			if bullet.ExperienceID == exp.ID {
+				ids = append(ids, bullet.ID)
+			}
+		}
+		return ids
+	}
+	assert.Equal(t, []string{unscored.ID}, stale())
+
+	// Editing the content makes the given score stale.
+	stored, err := bulletRepo.GetByIDForUser(ctx, given.ID, user.ID)
+	require.NoError(t, err)
+	require.NoError(t, stored.UpdateContent("Managed 12 Kubernetes clusters"))
+	require.NoError(t, bulletRepo.Update(ctx, stored))
+	assert.ElementsMatch(t, []string{unscored.ID, given.ID}, stale())
+
+	// Scores computed for outdated content are not saved.
+	require.NoError(t, unscored.SetImpactScore(65))
+	require.NoError(t, given.SetImpactScore(90))
+	updated, err := bulletRepo.UpdateImpactScores(ctx, []domain.Bullet{*unscored, *given})
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+	assert.Equal(t, []string{given.ID}, stale())
+
+	rescored, err := bulletRepo.GetByIDForUser(ctx, unscored.ID, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 65, rescored.ImpactScore.Int())
+}
+
+func TestProfileBundleReplaceIsAtomic(t *testing.T) {
+	ctx := context.Background()
+	userRepo := testDB.UserRepository()
+	expRepo := testDB.ExperienceRepository()
+	skillRepo := testDB.SkillRepository()
+
+	user, err := domain.NewUser("test-bundle-user-" + time.Now().Format("20060102150405"))
+	require.NoError(t, err)
+	user.SetName("Ada")
+	require.NoError(t, userRepo.Create(ctx, user))
+	defer func() {
+		_ = userRepo.Delete(ctx, user.ID)
+	}()
+
+	exp, err := domain.NewExperience(user.ID, domain.ExperienceTypeWork, "Engineer", "Test Company", domain.NewDate(2020, 1, 1))
+	require.NoError(t, err)
+	require.NoError(t, expRepo.Create(ctx, exp))
+	skill, err := domain.NewSkill(user.ID, "Go")
+	require.NoError(t, err)
+	require.NoError(t, skillRepo.Create(ctx, skill))
+
+	bundles := services.NewProfileBundleService(
+		services.NewUserService(userRepo, nil),
+		userRepo,
+		expRepo,
+		testDB.EducationRepository(),
+		testDB.ProjectRepository(),
+		skillRepo,
+		testDB.SpokenLanguageRepository(),
+		testDB,
+	)
+
+	// The bundle renames the user and drops the experience, then fails on
+	// the second skill, whose name the first one already took.
+	name := "Grace"
+	_, err = bundles.ReplaceBundle(ctx, user.ID, &services.ProfileBundle{
+		Version: services.ProfileBundleVersion,
+		Profile: services.ProfileBundleProfile{Name: &name},
+		Skills:  []domain.Skill{{Name: "SQL"}, {Name: "sql"}},
+	})
+	require.Error(t, err)
+
+	stored, err := userRepo.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", *stored.Name)
+
+	_, err = expRepo.GetByIDForUser(ctx, exp.ID, user.ID)
+	assert.NoError(t, err)
+
+	skills, err := skillRepo.ListByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, skills, 1)
+	assert.Equal(t, "Go", skills[0].Name)
+}
+
 func TestDBHealthCheck(t *testing.T) {
 	ctx := context.Background()
 	err := testDB.HealthCheck(ctx)
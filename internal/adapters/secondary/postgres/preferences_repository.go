@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// PreferencesRepository implements ports.PreferencesRepository using PostgreSQL.
+type PreferencesRepository struct {
+	pool querier
+}
+
+// GetByUserID retrieves a user's saved preferences.
+func (r *PreferencesRepository) GetByUserID(ctx context.Context, userID string) (*domain.UserPreferences, error) {
+	query := `
+		SELECT user_id, default_template, default_target_language, default_max_bullets,
+			default_font_size, date_format, timezone, filename_pattern,
+			email_product_updates, email_usage_alerts, created_at, updated_at
+		FROM user_preferences
+		WHERE user_id = $1
+	`
+
+	var prefs domain.UserPreferences
+	var dateFormat, filenamePattern string
+	err := r.pool.QueryRow(ctx, query, userID).Scan(
+		&prefs.UserID,
+		&prefs.DefaultTemplate,
+		&prefs.DefaultTargetLanguage,
+		&prefs.DefaultMaxBullets,
+		&prefs.DefaultFontSize,
+		&dateFormat,
+		&prefs.Timezone,
+		&filenamePattern,
+		&prefs.EmailProductUpdates,
+		&prefs.EmailUsageAlerts,
+		&prefs.CreatedAt,
+		&prefs.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrPreferencesNotFound
+		}
+		return nil, domain.NewDatabaseError("get preferences", err)
+	}
+	prefs.DateFormat = domain.DateFormat(dateFormat)
+	prefs.FilenamePattern = domain.FilenamePattern(filenamePattern)
+
+	return &prefs, nil
+}
+
+// Upsert creates or replaces a user's preferences.
+func (r *PreferencesRepository) Upsert(ctx context.Context, prefs *domain.UserPreferences) error {
+	query := `
+		INSERT INTO user_preferences (
+			user_id, default_template, default_target_language, default_max_bullets,
+			default_font_size, date_format, timezone, filename_pattern,
+			email_product_updates, email_usage_alerts, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (user_id) DO UPDATE SET
+			default_template = EXCLUDED.default_template,
+			default_target_language = EXCLUDED.default_target_language,
+			default_max_bullets = EXCLUDED.default_max_bullets,
+			default_font_size = EXCLUDED.default_font_size,
+			date_format = EXCLUDED.date_format,
+			timezone = EXCLUDED.timezone,
+			filename_pattern = EXCLUDED.filename_pattern,
+			email_product_updates = EXCLUDED.email_product_updates,
+			email_usage_alerts = EXCLUDED.email_usage_alerts,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		prefs.UserID,
+		prefs.DefaultTemplate,
+		prefs.DefaultTargetLanguage,
+		prefs.DefaultMaxBullets,
+		prefs.DefaultFontSize,
+		string(prefs.DateFormat),
+		prefs.Timezone,
+		string(prefs.FilenamePattern),
+		prefs.EmailProductUpdates,
+		prefs.EmailUsageAlerts,
+		prefs.CreatedAt,
+		prefs.UpdatedAt,
+	)
+	if err != nil {
+		return domain.NewDatabaseError("upsert preferences", err)
+	}
+
+	return nil
+}
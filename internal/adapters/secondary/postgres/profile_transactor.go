@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+var _ ports.ProfileTransactor = (*DB)(nil)
+
+// InTransaction calls fn with profile repositories that run their statements
+// in one transaction of the primary, scoped to the current user of ctx when
+// row-level security is enabled. The transaction is committed when fn
+// returns nil and rolled back otherwise.
+func (db *DB) InTransaction(ctx context.Context, fn func(repos ports.ProfileRepositories) error) error {
+	tx, err := db.conn().Begin(ctx)
+	if err != nil {
+		return domain.NewDatabaseError("begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = fn(ports.ProfileRepositories{
+		Users:           &UserRepository{pool: tx},
+		Experiences:     &ExperienceRepository{pool: tx, replica: tx},
+		Bullets:         &BulletRepository{pool: tx, replica: tx},
+		Education:       &EducationRepository{pool: tx, replica: tx},
+		Projects:        &ProjectRepository{pool: tx, replica: tx},
+		ProjectBullets:  &ProjectBulletRepository{pool: tx},
+		Skills:          &SkillRepository{pool: tx, replica: tx},
+		SpokenLanguages: &SpokenLanguageRepository{pool: tx, replica: tx},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.NewDatabaseError("commit transaction", err)
+	}
+	return nil
+}
@@ -15,7 +15,7 @@ import (
 
 // ProjectBulletRepository implements ports.ProjectBulletRepository using PostgreSQL.
 type ProjectBulletRepository struct {
-	pool *pgxpool.Pool
+	pool querier
 }
 
 // NewProjectBulletRepository creates a new ProjectBulletRepository.
@@ -55,16 +55,18 @@ func (r *ProjectBulletRepository) Create(ctx context.Context, bullet *domain.Pro
 	return nil
 }
 
-// GetByID retrieves a project bullet by ID.
-func (r *ProjectBulletRepository) GetByID(ctx context.Context, id string) (*domain.ProjectBullet, error) {
+// GetByIDForUser retrieves a project bullet by ID if its project belongs to
+// the user.
+func (r *ProjectBulletRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.ProjectBullet, error) {
 	query := `
-		SELECT id, project_id, content, display_order, created_at, updated_at
-		FROM project_bullets
-		WHERE id = $1
+		SELECT b.id, b.project_id, b.content, b.display_order, b.created_at, b.updated_at
+		FROM project_bullets b
+		JOIN projects p ON p.id = b.project_id
+		WHERE b.id = $1 AND p.user_id = $2
 	`
 
 	var bullet domain.ProjectBullet
-	err := r.pool.QueryRow(ctx, query, id).Scan(
+	err := r.pool.QueryRow(ctx, query, id, userID).Scan(
 		&bullet.ID,
 		&bullet.ProjectID,
 		&bullet.Content,
@@ -150,11 +152,15 @@ func (r *ProjectBulletRepository) Update(ctx context.Context, bullet *domain.Pro
 	return nil
 }
 
-// Delete removes a project bullet.
-func (r *ProjectBulletRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM project_bullets WHERE id = $1`
+// Delete removes a project bullet if its project belongs to the user.
+func (r *ProjectBulletRepository) Delete(ctx context.Context, id, userID string) error {
+	query := `
+		DELETE FROM project_bullets b
+		USING projects p
+		WHERE b.project_id = p.id AND b.id = $1 AND p.user_id = $2
+	`
 
-	result, err := r.pool.Exec(ctx, query, id)
+	result, err := r.pool.Exec(ctx, query, id, userID)
 	if err != nil {
 		return domain.NewDatabaseError("delete project bullet", err)
 	}
@@ -166,26 +172,10 @@ func (r *ProjectBulletRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// UpdateDisplayOrder updates the display order of project bullets.
-func (r *ProjectBulletRepository) UpdateDisplayOrder(ctx context.Context, orders []ports.DisplayOrderUpdate) error {
-	if len(orders) == 0 {
-		return nil
-	}
-
-	tx, err := r.pool.Begin(ctx)
-	if err != nil {
-		return domain.NewDatabaseError("begin transaction", err)
-	}
-	defer tx.Rollback(ctx)
-
-	query := `UPDATE project_bullets SET display_order = $2, updated_at = $3 WHERE id = $1`
-
-	for _, order := range orders {
-		_, err := tx.Exec(ctx, query, order.ID, order.DisplayOrder, time.Now().UTC())
-		if err != nil {
-			return domain.NewDatabaseError("update project bullet order", err)
-		}
-	}
-
-	return tx.Commit(ctx)
+// UpdateDisplayOrder updates the display order of the bullets of a user's
+// projects.
+func (r *ProjectBulletRepository) UpdateDisplayOrder(ctx context.Context, userID string, orders []ports.DisplayOrderUpdate) error {
+	return updateDisplayOrder(ctx, r.pool, "project_bullets",
+		"t.project_id IN (SELECT id FROM projects WHERE user_id = $4)",
+		"update project bullet order", userID, orders)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
@@ -15,12 +16,15 @@ import (
 
 // ProjectRepository implements ports.ProjectRepository using PostgreSQL.
 type ProjectRepository struct {
-	pool *pgxpool.Pool
+	pool querier
+
+	// replica serves list queries; it is pool when there is no replica.
+	replica querier
 }
 
 // NewProjectRepository creates a new ProjectRepository.
 func NewProjectRepository(pool *pgxpool.Pool) *ProjectRepository {
-	return &ProjectRepository{pool: pool}
+	return &ProjectRepository{pool: pool, replica: pool}
 }
 
 // Create creates a new project.
@@ -35,21 +39,14 @@ func (r *ProjectRepository) Create(ctx context.Context, project *domain.Project)
 	query := `
 		INSERT INTO projects (
 			id, user_id, name, description, tech_stack,
-			url, repository_url, start_date, end_date,
+			url, repository_url, start_date, start_date_precision,
+			end_date, end_date_precision,
 			display_order, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
 		)
 	`
 
-	var startDate, endDate interface{}
-	if project.StartDate != nil {
-		startDate = project.StartDate.Time
-	}
-	if project.EndDate != nil {
-		endDate = project.EndDate.Time
-	}
-
 	_, err := r.pool.Exec(ctx, query,
 		project.ID,
 		project.UserID,
@@ -58,8 +55,10 @@ func (r *ProjectRepository) Create(ctx context.Context, project *domain.Project)
 		project.TechStack,
 		project.URL,
 		project.RepositoryURL,
-		startDate,
-		endDate,
+		dateParam(project.StartDate),
+		precisionParam(project.StartDate),
+		dateParam(project.EndDate),
+		precisionParam(project.EndDate),
 		project.DisplayOrder,
 		project.CreatedAt,
 		project.UpdatedAt,
@@ -71,22 +70,23 @@ func (r *ProjectRepository) Create(ctx context.Context, project *domain.Project)
 	return nil
 }
 
-// GetByID retrieves a project by ID (without bullets).
-func (r *ProjectRepository) GetByID(ctx context.Context, id string) (*domain.Project, error) {
+// GetByIDForUser retrieves a user's project by ID (without bullets).
+func (r *ProjectRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.Project, error) {
 	query := `
 		SELECT id, user_id, name, description, tech_stack,
-			   url, repository_url, start_date, end_date,
+			   url, repository_url, start_date, start_date_precision,
+			   end_date, end_date_precision,
 			   display_order, created_at, updated_at
 		FROM projects
-		WHERE id = $1
+		WHERE id = $1 AND user_id = $2
 	`
 
-	return r.scanProject(r.pool.QueryRow(ctx, query, id))
+	return r.scanProject(r.pool.QueryRow(ctx, query, id, userID))
 }
 
-// GetByIDWithBullets retrieves a project with all its bullets.
-func (r *ProjectRepository) GetByIDWithBullets(ctx context.Context, id string) (*domain.Project, error) {
-	project, err := r.GetByID(ctx, id)
+// GetByIDWithBulletsForUser retrieves a user's project with all its bullets.
+func (r *ProjectRepository) GetByIDWithBulletsForUser(ctx context.Context, id, userID string) (*domain.Project, error) {
+	project, err := r.GetByIDForUser(ctx, id, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -104,14 +104,15 @@ func (r *ProjectRepository) GetByIDWithBullets(ctx context.Context, id string) (
 func (r *ProjectRepository) ListByUserID(ctx context.Context, userID string) ([]domain.Project, error) {
 	query := `
 		SELECT id, user_id, name, description, tech_stack,
-			   url, repository_url, start_date, end_date,
+			   url, repository_url, start_date, start_date_precision,
+			   end_date, end_date_precision,
 			   display_order, created_at, updated_at
 		FROM projects
 		WHERE user_id = $1
 		ORDER BY display_order ASC, end_date DESC NULLS FIRST, start_date DESC
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID)
+	rows, err := r.replica.Query(ctx, query, userID)
 	if err != nil {
 		return nil, domain.NewDatabaseError("list projects", err)
 	}
@@ -127,13 +128,8 @@ func (r *ProjectRepository) ListByUserIDWithBullets(ctx context.Context, userID
 		return nil, err
 	}
 
-	// Load bullets for each project.
-	for i := range projects {
-		bullets, err := r.getBulletsByProjectID(ctx, projects[i].ID)
-		if err != nil {
-			return nil, err
-		}
-		projects[i].Bullets = bullets
+	if err := r.loadBullets(ctx, projects); err != nil {
+		return nil, err
 	}
 
 	return projects, nil
@@ -151,20 +147,14 @@ func (r *ProjectRepository) Update(ctx context.Context, project *domain.Project)
 			url = $5,
 			repository_url = $6,
 			start_date = $7,
-			end_date = $8,
-			display_order = $9,
-			updated_at = $10
+			start_date_precision = $8,
+			end_date = $9,
+			end_date_precision = $10,
+			display_order = $11,
+			updated_at = $12
 		WHERE id = $1
 	`
 
-	var startDate, endDate interface{}
-	if project.StartDate != nil {
-		startDate = project.StartDate.Time
-	}
-	if project.EndDate != nil {
-		endDate = project.EndDate.Time
-	}
-
 	result, err := r.pool.Exec(ctx, query,
 		project.ID,
 		project.Name,
@@ -172,8 +162,10 @@ func (r *ProjectRepository) Update(ctx context.Context, project *domain.Project)
 		project.TechStack,
 		project.URL,
 		project.RepositoryURL,
-		startDate,
-		endDate,
+		dateParam(project.StartDate),
+		precisionParam(project.StartDate),
+		dateParam(project.EndDate),
+		precisionParam(project.EndDate),
 		project.DisplayOrder,
 		project.UpdatedAt,
 	)
@@ -188,11 +180,11 @@ func (r *ProjectRepository) Update(ctx context.Context, project *domain.Project)
 	return nil
 }
 
-// Delete removes a project and all its bullets (CASCADE).
-func (r *ProjectRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM projects WHERE id = $1`
+// Delete removes a user's project and all its bullets (CASCADE).
+func (r *ProjectRepository) Delete(ctx context.Context, id, userID string) error {
+	query := `DELETE FROM projects WHERE id = $1 AND user_id = $2`
 
-	result, err := r.pool.Exec(ctx, query, id)
+	result, err := r.pool.Exec(ctx, query, id, userID)
 	if err != nil {
 		return domain.NewDatabaseError("delete project", err)
 	}
@@ -204,28 +196,9 @@ func (r *ProjectRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// UpdateDisplayOrder updates the display order of projects.
-func (r *ProjectRepository) UpdateDisplayOrder(ctx context.Context, orders []ports.DisplayOrderUpdate) error {
-	if len(orders) == 0 {
-		return nil
-	}
-
-	tx, err := r.pool.Begin(ctx)
-	if err != nil {
-		return domain.NewDatabaseError("begin transaction", err)
-	}
-	defer tx.Rollback(ctx)
-
-	query := `UPDATE projects SET display_order = $2, updated_at = $3 WHERE id = $1`
-
-	for _, order := range orders {
-		_, err := tx.Exec(ctx, query, order.ID, order.DisplayOrder, time.Now().UTC())
-		if err != nil {
-			return domain.NewDatabaseError("update project order", err)
-		}
-	}
-
-	return tx.Commit(ctx)
+// UpdateDisplayOrder updates the display order of a user's projects.
+func (r *ProjectRepository) UpdateDisplayOrder(ctx context.Context, userID string, orders []ports.DisplayOrderUpdate) error {
+	return updateDisplayOrder(ctx, r.pool, "projects", ownedByUser, "update project order", userID, orders)
 }
 
 // SearchByTechStack searches projects containing any of the given technologies.
@@ -236,7 +209,8 @@ func (r *ProjectRepository) SearchByTechStack(ctx context.Context, userID string
 
 	query := `
 		SELECT id, user_id, name, description, tech_stack,
-			   url, repository_url, start_date, end_date,
+			   url, repository_url, start_date, start_date_precision,
+			   end_date, end_date_precision,
 			   display_order, created_at, updated_at
 		FROM projects
 		WHERE user_id = $1 AND tech_stack && $2
@@ -291,10 +265,63 @@ func (r *ProjectRepository) getBulletsByProjectID(ctx context.Context, projectID
 	return bullets, nil
 }
 
+// loadBullets sets the bullets of all projects with a single query on the
+// replica, instead of one query per project.
+func (r *ProjectRepository) loadBullets(ctx context.Context, projects []domain.Project) error {
+	if len(projects) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(projects))
+	byID := make(map[string]*domain.Project, len(projects))
+	for i := range projects {
+		ids[i] = projects[i].ID
+		byID[projects[i].ID] = &projects[i]
+	}
+
+	query := `
+		SELECT id, project_id, content, display_order, created_at, updated_at
+		FROM project_bullets
+		WHERE project_id = ANY($1::UUID[])
+		ORDER BY display_order ASC
+	`
+
+	rows, err := r.replica.Query(ctx, query, ids)
+	if err != nil {
+		return domain.NewDatabaseError("get project bullets", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var bullet domain.ProjectBullet
+		err := rows.Scan(
+			&bullet.ID,
+			&bullet.ProjectID,
+			&bullet.Content,
+			&bullet.DisplayOrder,
+			&bullet.CreatedAt,
+			&bullet.UpdatedAt,
+		)
+		if err != nil {
+			return domain.NewDatabaseError("scan project bullet", err)
+		}
+		if project, ok := byID[bullet.ProjectID]; ok {
+			project.Bullets = append(project.Bullets, bullet)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return domain.NewDatabaseError("iterate project bullets", err)
+	}
+
+	return nil
+}
+
 // scanProject scans a single project row.
 func (r *ProjectRepository) scanProject(row pgx.Row) (*domain.Project, error) {
 	var project domain.Project
-	var startDate, endDate *time.Time
+	var startDate, endDate pgtype.Date
+	var startPrecision, endPrecision string
 
 	err := row.Scan(
 		&project.ID,
@@ -305,7 +332,9 @@ func (r *ProjectRepository) scanProject(row pgx.Row) (*domain.Project, error) {
 		&project.URL,
 		&project.RepositoryURL,
 		&startDate,
+		&startPrecision,
 		&endDate,
+		&endPrecision,
 		&project.DisplayOrder,
 		&project.CreatedAt,
 		&project.UpdatedAt,
@@ -317,14 +346,8 @@ func (r *ProjectRepository) scanProject(row pgx.Row) (*domain.Project, error) {
 		return nil, domain.NewDatabaseError("scan project", err)
 	}
 
-	if startDate != nil {
-		d := domain.Date{Time: *startDate}
-		project.StartDate = &d
-	}
-	if endDate != nil {
-		d := domain.Date{Time: *endDate}
-		project.EndDate = &d
-	}
+	project.StartDate = scannedDate(startDate, startPrecision)
+	project.EndDate = scannedDate(endDate, endPrecision)
 
 	if project.TechStack == nil {
 		project.TechStack = make([]string, 0)
@@ -342,7 +365,8 @@ func (r *ProjectRepository) scanProjectList(rows pgx.Rows) ([]domain.Project, er
 
 	for rows.Next() {
 		var project domain.Project
-		var startDate, endDate *time.Time
+		var startDate, endDate pgtype.Date
+		var startPrecision, endPrecision string
 
 		err := rows.Scan(
 			&project.ID,
@@ -353,7 +377,9 @@ func (r *ProjectRepository) scanProjectList(rows pgx.Rows) ([]domain.Project, er
 			&project.URL,
 			&project.RepositoryURL,
 			&startDate,
+			&startPrecision,
 			&endDate,
+			&endPrecision,
 			&project.DisplayOrder,
 			&project.CreatedAt,
 			&project.UpdatedAt,
@@ -362,14 +388,8 @@ func (r *ProjectRepository) scanProjectList(rows pgx.Rows) ([]domain.Project, er
 			return nil, domain.NewDatabaseError("scan project list", err)
 		}
 
-		if startDate != nil {
-			d := domain.Date{Time: *startDate}
-			project.StartDate = &d
-		}
-		if endDate != nil {
-			d := domain.Date{Time: *endDate}
-			project.EndDate = &d
-		}
+		project.StartDate = scannedDate(startDate, startPrecision)
+		project.EndDate = scannedDate(endDate, endPrecision)
 
 		if project.TechStack == nil {
 			project.TechStack = make([]string, 0)
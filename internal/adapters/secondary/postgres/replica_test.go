@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// errRecorded fails the statements of recordingQuerier.
+var errRecorded = errors.New("recorded")
+
+// recordingQuerier records the kind of statements it is sent and fails them.
+type recordingQuerier struct {
+	calls []string
+}
+
+func (q *recordingQuerier) Begin(context.Context) (pgx.Tx, error) {
+	q.calls = append(q.calls, "begin")
+	return nil, errRecorded
+}
+
+func (q *recordingQuerier) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	q.calls = append(q.calls, "exec")
+	return pgconn.CommandTag{}, errRecorded
+}
+
+func (q *recordingQuerier) Query(context.Context, string, ...any) (pgx.Rows, error) {
+	q.calls = append(q.calls, "query")
+	return nil, errRecorded
+}
+
+func (q *recordingQuerier) QueryRow(context.Context, string, ...any) pgx.Row {
+	q.calls = append(q.calls, "query_row")
+	return &txRow{err: errRecorded}
+}
+
+func (q *recordingQuerier) SendBatch(context.Context, *pgx.Batch) pgx.BatchResults {
+	q.calls = append(q.calls, "send_batch")
+	return &txBatchResults{err: errRecorded}
+}
+
+// newLazyPool creates a pool that connects on first use, so tests can
+// tell pools apart without a database.
+func newLazyPool(t *testing.T, host string) *pgxpool.Pool {
+	t.Helper()
+	pool, err := pgxpool.New(context.Background(), "postgres://"+host+"/chameleon")
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestDBReplicaRouting(t *testing.T) {
+	primary := newLazyPool(t, "primary.invalid")
+	replica := newLazyPool(t, "replica.invalid")
+
+	t.Run("lists go to the replica", func(t *testing.T) {
+		db := &DB{pool: primary, replica: replica}
+		assert.Same(t, replica, db.reader())
+		assert.Same(t, primary, db.conn())
+
+		skills := db.SkillRepository()
+		assert.Same(t, replica, skills.replica)
+		assert.Same(t, primary, skills.pool)
+	})
+
+	t.Run("without a replica everything goes to the primary", func(t *testing.T) {
+		db := &DB{pool: primary}
+		assert.Same(t, primary, db.reader())
+		assert.False(t, db.HasReplica())
+	})
+
+	t.Run("row-level security scopes both pools", func(t *testing.T) {
+		db := &DB{pool: primary, replica: replica, rls: true}
+
+		reader, ok := db.reader().(*rlsPool)
+		require.True(t, ok)
+		assert.Same(t, replica, reader.pool)
+
+		writer, ok := db.conn().(*rlsPool)
+		require.True(t, ok)
+		assert.Same(t, primary, writer.pool)
+	})
+}
+
+func TestSkillRepositoryReplicaRouting(t *testing.T) {
+	ctx := context.Background()
+	primary := &recordingQuerier{}
+	replica := &recordingQuerier{}
+	repo := &SkillRepository{pool: primary, replica: replica}
+
+	skill, err := domain.NewSkill("user-1", "Go")
+	require.NoError(t, err)
+
+	// Lists may lag behind the primary.
+	_, err = repo.ListByUserID(ctx, "user-1")
+	assert.ErrorIs(t, err, errRecorded)
+	_, err = repo.SearchByName(ctx, "user-1", "go")
+	assert.ErrorIs(t, err, errRecorded)
+
+	// ID lookups read what was just written, so they stay on the primary
+	// with the writes.
+	_, err = repo.GetByIDForUser(ctx, "skill-1", "user-1")
+	assert.ErrorIs(t, err, errRecorded)
+	assert.ErrorIs(t, repo.Create(ctx, skill), errRecorded)
+	assert.ErrorIs(t, repo.Upsert(ctx, skill), errRecorded)
+	assert.ErrorIs(t, repo.Delete(ctx, "skill-1", "user-1"), errRecorded)
+
+	assert.Equal(t, []string{"query", "query"}, replica.calls)
+	assert.Equal(t, []string{"query_row", "exec", "query_row", "exec"}, primary.calls)
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// ResumeActivityRepository implements ports.ResumeActivityRepository using PostgreSQL.
+type ResumeActivityRepository struct {
+	pool querier
+
+	// replica serves list queries; it is pool when there is no replica.
+	replica querier
+}
+
+// Create appends an entry to a resume's activity log.
+func (r *ResumeActivityRepository) Create(ctx context.Context, activity *domain.ResumeActivity) error {
+	if activity.ID == "" {
+		activity.ID = uuid.New().String()
+	}
+	activity.CreatedAt = time.Now().UTC()
+
+	var aiSettings []byte
+	if activity.AISettings != nil {
+		data, err := json.Marshal(activity.AISettings)
+		if err != nil {
+			return domain.NewDatabaseError("marshal resume activity AI settings", err)
+		}
+		aiSettings = data
+	}
+
+	query := `
+		INSERT INTO resume_activity (
+			id, resume_id, user_id, type, note, from_status, to_status, detail, ai_settings, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		activity.ID,
+		activity.ResumeID,
+		activity.UserID,
+		string(activity.Type),
+		activity.Note,
+		string(activity.FromStatus),
+		string(activity.ToStatus),
+		activity.Detail,
+		aiSettings,
+		activity.CreatedAt,
+	)
+	if err != nil {
+		return domain.NewDatabaseError("create resume activity", err)
+	}
+
+	return nil
+}
+
+// ListByResumeID lists a resume's activity log, oldest first.
+func (r *ResumeActivityRepository) ListByResumeID(ctx context.Context, resumeID string) ([]domain.ResumeActivity, error) {
+	query := `
+		SELECT id, resume_id, user_id, type, note, from_status, to_status, detail, ai_settings, created_at
+		FROM resume_activity
+		WHERE resume_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.replica.Query(ctx, query, resumeID)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list resume activity", err)
+	}
+	defer rows.Close()
+
+	activities := make([]domain.ResumeActivity, 0)
+	for rows.Next() {
+		var activity domain.ResumeActivity
+		var activityType, fromStatus, toStatus string
+		var aiSettings []byte
+
+		if err := rows.Scan(
+			&activity.ID,
+			&activity.ResumeID,
+			&activity.UserID,
+			&activityType,
+			&activity.Note,
+			&fromStatus,
+			&toStatus,
+			&activity.Detail,
+			&aiSettings,
+			&activity.CreatedAt,
+		); err != nil {
+			return nil, domain.NewDatabaseError("scan resume activity", err)
+		}
+
+		activity.Type = domain.ResumeActivityType(activityType)
+		activity.FromStatus = domain.ResumeStatus(fromStatus)
+		activity.ToStatus = domain.ResumeStatus(toStatus)
+		if len(aiSettings) > 0 {
+			activity.AISettings = &domain.AIRunSettings{}
+			if err := json.Unmarshal(aiSettings, activity.AISettings); err != nil {
+				return nil, domain.NewDatabaseError("unmarshal resume activity AI settings", err)
+			}
+		}
+		activities = append(activities, activity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("iterate resume activity", err)
+	}
+
+	return activities, nil
+}
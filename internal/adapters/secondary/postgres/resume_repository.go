@@ -4,11 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
@@ -16,7 +17,10 @@ import (
 
 // ResumeRepository implements ports.ResumeRepository using PostgreSQL.
 type ResumeRepository struct {
-	pool *pgxpool.Pool
+	pool querier
+
+	// replica serves list queries; it is pool when there is no replica.
+	replica querier
 }
 
 // Create creates a new resume.
@@ -32,19 +36,41 @@ func (r *ResumeRepository) Create(ctx context.Context, resume *domain.Resume) er
 	var contentJSON []byte
 	var err error
 	if resume.GeneratedContent != nil {
+		// Never persist malformed content, whatever path produced it.
+		if err := resume.GeneratedContent.Validate(); err != nil {
+			return err
+		}
 		contentJSON, err = json.Marshal(resume.GeneratedContent)
 		if err != nil {
 			return domain.NewDatabaseError("marshal resume content", err)
 		}
 	}
 
+	insights := newInsightColumns(resume.JobInsights)
+
+	sectionsJSON, err := json.Marshal(resume.Sections)
+	if err != nil {
+		return domain.NewDatabaseError("marshal resume sections", err)
+	}
+
+	selectionJSON, err := marshalSelection(resume.Selection)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		INSERT INTO resumes (
 			id, user_id, job_description, job_title, company_name, job_url,
 			target_language, selected_bullets, generated_content, pdf_url,
-			score, notes, status, created_at, updated_at
+			score, notes, status, remote_policy, salary_min, salary_max,
+			salary_currency, salary_period, benefits, visa_sponsorship, qr_code,
+			contact_priority, section_config, max_pages, included_experience_ids,
+			excluded_experience_ids, selection_explanation, archived_at, pinned,
+			created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15,
+			$16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30,
+			$31
 		)
 	`
 
@@ -62,6 +88,22 @@ func (r *ResumeRepository) Create(ctx context.Context, resume *domain.Resume) er
 		resume.Score.Int(),
 		resume.Notes,
 		string(resume.Status),
+		insights.remotePolicy,
+		insights.salaryMin,
+		insights.salaryMax,
+		insights.salaryCurrency,
+		insights.salaryPeriod,
+		insights.benefits,
+		insights.visaSponsorship,
+		string(resume.QRCode),
+		contactPriorityColumn(resume.ContactPriority),
+		sectionsJSON,
+		resume.PageLimit(),
+		resume.IncludedExperienceIDs,
+		resume.ExcludedExperienceIDs,
+		selectionJSON,
+		resume.ArchivedAt,
+		resume.Pinned,
 		resume.CreatedAt,
 		resume.UpdatedAt,
 	)
@@ -72,38 +114,50 @@ func (r *ResumeRepository) Create(ctx context.Context, resume *domain.Resume) er
 	return nil
 }
 
-// GetByID retrieves a resume by ID.
-func (r *ResumeRepository) GetByID(ctx context.Context, id string) (*domain.Resume, error) {
+// GetByIDForUser retrieves a user's resume by ID.
+func (r *ResumeRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.Resume, error) {
 	query := `
 		SELECT id, user_id, job_description, job_title, company_name, job_url,
 			   target_language, selected_bullets, generated_content, pdf_url,
-			   score, notes, status, created_at, updated_at
+			   score, notes, status, remote_policy, salary_min, salary_max,
+			   salary_currency, salary_period, benefits, visa_sponsorship, qr_code,
+			   contact_priority, section_config, max_pages, included_experience_ids,
+			   excluded_experience_ids, selection_explanation, archived_at, pinned,
+			   last_accessed_at, created_at, updated_at,
+			   ARRAY(SELECT tag_id::TEXT FROM resume_tag_assignments
+			         WHERE resume_id = resumes.id ORDER BY tag_id) AS tag_ids
 		FROM resumes
-		WHERE id = $1
+		WHERE id = $1 AND user_id = $2
 	`
 
-	return r.scanResume(r.pool.QueryRow(ctx, query, id))
+	return r.scanResume(r.pool.QueryRow(ctx, query, id, userID))
 }
 
 // ListByUserID lists all resumes for a user.
 func (r *ResumeRepository) ListByUserID(ctx context.Context, userID string, opts ports.ListOptions) ([]domain.Resume, int, error) {
 	countQuery := `SELECT COUNT(*) FROM resumes WHERE user_id = $1`
 	var total int
-	if err := r.pool.QueryRow(ctx, countQuery, userID).Scan(&total); err != nil {
+	if err := r.replica.QueryRow(ctx, countQuery, userID).Scan(&total); err != nil {
 		return nil, 0, domain.NewDatabaseError("count resumes", err)
 	}
 
 	query := `
 		SELECT id, user_id, job_description, job_title, company_name, job_url,
 			   target_language, selected_bullets, generated_content, pdf_url,
-			   score, notes, status, created_at, updated_at
+			   score, notes, status, remote_policy, salary_min, salary_max,
+			   salary_currency, salary_period, benefits, visa_sponsorship, qr_code,
+			   contact_priority, section_config, max_pages, included_experience_ids,
+			   excluded_experience_ids, selection_explanation, archived_at, pinned,
+			   last_accessed_at, created_at, updated_at,
+			   ARRAY(SELECT tag_id::TEXT FROM resume_tag_assignments
+			         WHERE resume_id = resumes.id ORDER BY tag_id) AS tag_ids
 		FROM resumes
 		WHERE user_id = $1
 		ORDER BY created_at DESC
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID, opts.Limit, opts.Offset)
+	rows, err := r.replica.Query(ctx, query, userID, opts.Limit, opts.Offset)
 	if err != nil {
 		return nil, 0, domain.NewDatabaseError("list resumes", err)
 	}
@@ -117,27 +171,41 @@ func (r *ResumeRepository) ListByUserID(ctx context.Context, userID string, opts
 	return resumes, total, nil
 }
 
-// ListByUserIDAndStatus lists resumes filtered by status.
-func (r *ResumeRepository) ListByUserIDAndStatus(ctx context.Context, userID string, status domain.ResumeStatus, opts ports.ListOptions) ([]domain.Resume, int, error) {
-	countQuery := `SELECT COUNT(*) FROM resumes WHERE user_id = $1 AND status = $2`
+// ListByUserIDFiltered lists resumes matching all the filter criteria.
+func (r *ResumeRepository) ListByUserIDFiltered(ctx context.Context, userID string, filter ports.ResumeFilter, opts ports.ListOptions) ([]domain.Resume, int, error) {
+	where, args := resumeFilterClause(userID, filter)
+
+	countQuery := `SELECT COUNT(*) FROM resumes WHERE ` + where
 	var total int
-	if err := r.pool.QueryRow(ctx, countQuery, userID, string(status)).Scan(&total); err != nil {
-		return nil, 0, domain.NewDatabaseError("count resumes by status", err)
+	if err := r.replica.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, domain.NewDatabaseError("count filtered resumes", err)
 	}
 
-	query := `
-		SELECT id, user_id, job_description, job_title, company_name, job_url,
-			   target_language, selected_bullets, generated_content, pdf_url,
-			   score, notes, status, created_at, updated_at
+	// Summaries read the large columns as empty values instead.
+	description, content, selection := "job_description", "generated_content", "selection_explanation"
+	if filter.Summary {
+		description, content, selection = "''", "NULL::JSONB", "NULL::JSONB"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, user_id, %[1]s, job_title, company_name, job_url,
+			   target_language, selected_bullets, %[2]s, pdf_url,
+			   score, notes, status, remote_policy, salary_min, salary_max,
+			   salary_currency, salary_period, benefits, visa_sponsorship, qr_code,
+			   contact_priority, section_config, max_pages, included_experience_ids,
+			   excluded_experience_ids, %[3]s, archived_at, pinned,
+			   last_accessed_at, created_at, updated_at,
+			   ARRAY(SELECT tag_id::TEXT FROM resume_tag_assignments
+			         WHERE resume_id = resumes.id ORDER BY tag_id) AS tag_ids
 		FROM resumes
-		WHERE user_id = $1 AND status = $2
-		ORDER BY created_at DESC
-		LIMIT $3 OFFSET $4
-	`
+		WHERE %[4]s
+		ORDER BY %[5]s
+		LIMIT $%[6]d OFFSET $%[7]d
+	`, description, content, selection, where, resumeOrderBy(filter.Sort), len(args)+1, len(args)+2)
 
-	rows, err := r.pool.Query(ctx, query, userID, string(status), opts.Limit, opts.Offset)
+	rows, err := r.replica.Query(ctx, query, append(args, opts.Limit, opts.Offset)...)
 	if err != nil {
-		return nil, 0, domain.NewDatabaseError("list resumes by status", err)
+		return nil, 0, domain.NewDatabaseError("list filtered resumes", err)
 	}
 	defer rows.Close()
 
@@ -149,19 +217,171 @@ func (r *ResumeRepository) ListByUserIDAndStatus(ctx context.Context, userID str
 	return resumes, total, nil
 }
 
+// resumeFilterClause builds the WHERE conditions and arguments for a filter.
+func resumeFilterClause(userID string, filter ports.ResumeFilter) (string, []any) {
+	conditions := []string{"user_id = $1"}
+	args := []any{userID}
+
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.Status != nil {
+		add("status = $%d", string(*filter.Status))
+	}
+	if len(filter.RemotePolicies) > 0 {
+		policies := make([]string, len(filter.RemotePolicies))
+		for i, policy := range filter.RemotePolicies {
+			policies[i] = string(policy)
+		}
+		add("remote_policy = ANY($%d)", policies)
+	}
+	if filter.MinAnnualSalary != nil {
+		// Compare the top of the range, converted to an annual amount.
+		add(fmt.Sprintf(`COALESCE(salary_max, salary_min)::BIGINT * CASE salary_period
+			WHEN 'month' THEN 12 WHEN 'hour' THEN %d ELSE 1 END >= $%%d`, domain.HoursPerYear), *filter.MinAnnualSalary)
+	}
+	if filter.SalaryCurrency != "" {
+		add("salary_currency = $%d", filter.SalaryCurrency)
+	}
+	if filter.VisaSponsorship != nil {
+		add("visa_sponsorship = $%d", *filter.VisaSponsorship)
+	}
+	for _, tagID := range filter.TagIDs {
+		add("EXISTS (SELECT 1 FROM resume_tag_assignments a WHERE a.resume_id = resumes.id AND a.tag_id::TEXT = $%d)", tagID)
+	}
+	if filter.MinScore != nil {
+		add("score >= $%d", *filter.MinScore)
+	}
+	if filter.Company != "" {
+		add("company_name ILIKE '%%' || $%d || '%%'", escapeLike(filter.Company))
+	}
+	if filter.CreatedAfter != nil {
+		add("created_at >= $%d", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		add("created_at < $%d", *filter.CreatedBefore)
+	}
+	if filter.Language != "" {
+		// Match the language and its regional variants, "pt" matching "pt-br".
+		args = append(args, strings.ToLower(filter.Language))
+		conditions = append(conditions, fmt.Sprintf(
+			"(LOWER(target_language) = $%[1]d OR LOWER(target_language) LIKE $%[1]d || '-%%')", len(args)))
+	}
+	if filter.Archived != nil {
+		if *filter.Archived {
+			conditions = append(conditions, "archived_at IS NOT NULL")
+		} else {
+			conditions = append(conditions, "archived_at IS NULL")
+		}
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// resumeOrderBy returns the ORDER BY expressions of a resume sort.
+func resumeOrderBy(sort domain.ResumeSort) string {
+	switch sort {
+	case domain.ResumeSortRecent:
+		return "last_accessed_at DESC NULLS LAST, created_at DESC"
+	case domain.ResumeSortPinnedFirst:
+		return "pinned DESC, created_at DESC"
+	default:
+		return "created_at DESC"
+	}
+}
+
+// escapeLike escapes the LIKE wildcards in s so that it matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 // Update updates an existing resume.
 func (r *ResumeRepository) Update(ctx context.Context, resume *domain.Resume) error {
+	return r.update(ctx, r.pool, resume)
+}
+
+// UpdateWithEvents updates an existing resume and adds events to the outbox
+// in the same transaction.
+func (r *ResumeRepository) UpdateWithEvents(ctx context.Context, resume *domain.Resume, events []domain.OutboxEvent) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return domain.NewDatabaseError("begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := r.update(ctx, tx, resume); err != nil {
+		return err
+	}
+	if err := insertOutboxEvents(ctx, tx, events); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.NewDatabaseError("commit transaction", err)
+	}
+
+	return nil
+}
+
+// UpdateMany updates existing resumes and adds events to the outbox in a
+// single transaction, rolled back when any resume no longer exists.
+func (r *ResumeRepository) UpdateMany(ctx context.Context, resumes []*domain.Resume, events []domain.OutboxEvent) error {
+	if len(resumes) == 0 {
+		return nil
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return domain.NewDatabaseError("begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, resume := range resumes {
+		if err := r.update(ctx, tx, resume); err != nil {
+			return err
+		}
+	}
+	if err := insertOutboxEvents(ctx, tx, events); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.NewDatabaseError("commit transaction", err)
+	}
+
+	return nil
+}
+
+// update updates an existing resume through db, a pool or a transaction.
+func (r *ResumeRepository) update(ctx context.Context, db execer, resume *domain.Resume) error {
 	resume.UpdatedAt = time.Now().UTC()
 
 	var contentJSON []byte
 	var err error
 	if resume.GeneratedContent != nil {
+		if err := resume.GeneratedContent.Validate(); err != nil {
+			return err
+		}
 		contentJSON, err = json.Marshal(resume.GeneratedContent)
 		if err != nil {
 			return domain.NewDatabaseError("marshal resume content", err)
 		}
 	}
 
+	insights := newInsightColumns(resume.JobInsights)
+
+	sectionsJSON, err := json.Marshal(resume.Sections)
+	if err != nil {
+		return domain.NewDatabaseError("marshal resume sections", err)
+	}
+
+	selectionJSON, err := marshalSelection(resume.Selection)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE resumes SET
 			job_description = $2,
@@ -175,11 +395,27 @@ func (r *ResumeRepository) Update(ctx context.Context, resume *domain.Resume) er
 			score = $10,
 			notes = $11,
 			status = $12,
-			updated_at = $13
+			remote_policy = $13,
+			salary_min = $14,
+			salary_max = $15,
+			salary_currency = $16,
+			salary_period = $17,
+			benefits = $18,
+			visa_sponsorship = $19,
+			qr_code = $20,
+			contact_priority = $21,
+			section_config = $22,
+			max_pages = $23,
+			included_experience_ids = $24,
+			excluded_experience_ids = $25,
+			selection_explanation = $26,
+			archived_at = $27,
+			pinned = $28,
+			updated_at = $29
 		WHERE id = $1
 	`
 
-	result, err := r.pool.Exec(ctx, query,
+	result, err := db.Exec(ctx, query,
 		resume.ID,
 		resume.JobDescription,
 		resume.JobTitle,
@@ -192,6 +428,22 @@ func (r *ResumeRepository) Update(ctx context.Context, resume *domain.Resume) er
 		resume.Score.Int(),
 		resume.Notes,
 		string(resume.Status),
+		insights.remotePolicy,
+		insights.salaryMin,
+		insights.salaryMax,
+		insights.salaryCurrency,
+		insights.salaryPeriod,
+		insights.benefits,
+		insights.visaSponsorship,
+		string(resume.QRCode),
+		contactPriorityColumn(resume.ContactPriority),
+		sectionsJSON,
+		resume.PageLimit(),
+		resume.IncludedExperienceIDs,
+		resume.ExcludedExperienceIDs,
+		selectionJSON,
+		resume.ArchivedAt,
+		resume.Pinned,
 		resume.UpdatedAt,
 	)
 	if err != nil {
@@ -205,11 +457,72 @@ func (r *ResumeRepository) Update(ctx context.Context, resume *domain.Resume) er
 	return nil
 }
 
-// Delete removes a resume.
-func (r *ResumeRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM resumes WHERE id = $1`
+// OwnersByID returns the user IDs of the resumes with the given IDs, keyed by
+// resume ID. IDs are compared as text so that malformed IDs match nothing
+// instead of failing the query.
+func (r *ResumeRepository) OwnersByID(ctx context.Context, ids []string) (map[string]string, error) {
+	owners := make(map[string]string, len(ids))
+	if len(ids) == 0 {
+		return owners, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `SELECT id::TEXT, user_id::TEXT FROM resumes WHERE id::TEXT = ANY($1)`, ids)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list resume owners", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, userID string
+		if err := rows.Scan(&id, &userID); err != nil {
+			return nil, domain.NewDatabaseError("scan resume owner", err)
+		}
+		owners[id] = userID
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("iterate resume owners", err)
+	}
+
+	return owners, nil
+}
+
+// UpdatePDFURLs replaces the PDF URLs of resumes that have one, in a single
+// transaction. Resumes without a PDF URL, or that no longer exist, are skipped.
+func (r *ResumeRepository) UpdatePDFURLs(ctx context.Context, updates []ports.PDFURLUpdate) (int, error) {
+	if len(updates) == 0 {
+		return 0, nil
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, domain.NewDatabaseError("begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE resumes SET pdf_url = $2, updated_at = $3 WHERE id = $1 AND pdf_url IS NOT NULL`
+
+	updated := 0
+	for _, update := range updates {
+		result, err := tx.Exec(ctx, query, update.ResumeID, update.URL, time.Now().UTC())
+		if err != nil {
+			return 0, domain.NewDatabaseError("update resume pdf url", err)
+		}
+		updated += int(result.RowsAffected())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, domain.NewDatabaseError("commit transaction", err)
+	}
+
+	return updated, nil
+}
+
+// Delete removes a user's resume.
+func (r *ResumeRepository) Delete(ctx context.Context, id, userID string) error {
+	query := `DELETE FROM resumes WHERE id = $1 AND user_id = $2`
 
-	result, err := r.pool.Exec(ctx, query, id)
+	result, err := r.pool.Exec(ctx, query, id, userID)
 	if err != nil {
 		return domain.NewDatabaseError("delete resume", err)
 	}
@@ -221,12 +534,60 @@ func (r *ResumeRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// MarkAccessed records that a resume was opened at the given time, without
+// changing its update time.
+func (r *ResumeRepository) MarkAccessed(ctx context.Context, id string, at time.Time) error {
+	query := `
+		UPDATE resumes SET last_accessed_at = $2
+		WHERE id = $1 AND (last_accessed_at IS NULL OR last_accessed_at < $2)
+	`
+
+	if _, err := r.pool.Exec(ctx, query, id, at); err != nil {
+		return domain.NewDatabaseError("mark resume accessed", err)
+	}
+
+	return nil
+}
+
+// DeleteMany removes a user's resumes in a single transaction, rolled back
+// when any of them is not found.
+func (r *ResumeRepository) DeleteMany(ctx context.Context, ids []string, userID string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return domain.NewDatabaseError("begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `DELETE FROM resumes WHERE id::TEXT = ANY($1) AND user_id = $2`, ids, userID)
+	if err != nil {
+		return domain.NewDatabaseError("delete resumes", err)
+	}
+	if int(result.RowsAffected()) != len(ids) {
+		return domain.ErrResumeNotFound
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.NewDatabaseError("commit transaction", err)
+	}
+
+	return nil
+}
+
 // scanResume scans a single resume row.
 func (r *ResumeRepository) scanResume(row pgx.Row) (*domain.Resume, error) {
 	resume := &domain.Resume{}
 	var score int
 	var status string
 	var contentJSON []byte
+	var insights insightColumns
+	var qrCode string
+	var contactPriority []string
+	var sectionsJSON []byte
+	var selectionJSON []byte
 
 	err := row.Scan(
 		&resume.ID,
@@ -242,8 +603,26 @@ func (r *ResumeRepository) scanResume(row pgx.Row) (*domain.Resume, error) {
 		&score,
 		&resume.Notes,
 		&status,
+		&insights.remotePolicy,
+		&insights.salaryMin,
+		&insights.salaryMax,
+		&insights.salaryCurrency,
+		&insights.salaryPeriod,
+		&insights.benefits,
+		&insights.visaSponsorship,
+		&qrCode,
+		&contactPriority,
+		&sectionsJSON,
+		&resume.MaxPages,
+		&resume.IncludedExperienceIDs,
+		&resume.ExcludedExperienceIDs,
+		&selectionJSON,
+		&resume.ArchivedAt,
+		&resume.Pinned,
+		&resume.LastAccessedAt,
 		&resume.CreatedAt,
 		&resume.UpdatedAt,
+		&resume.TagIDs,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -254,6 +633,17 @@ func (r *ResumeRepository) scanResume(row pgx.Row) (*domain.Resume, error) {
 
 	resume.Score = domain.MatchScore(score)
 	resume.Status = domain.ResumeStatus(status)
+	resume.JobInsights = insights.toDomain()
+	resume.QRCode = domain.QRCodeTarget(qrCode)
+	resume.ContactPriority = contactPriorityFields(contactPriority)
+
+	if err := json.Unmarshal(sectionsJSON, &resume.Sections); err != nil {
+		return nil, domain.NewDatabaseError("unmarshal resume sections", err)
+	}
+
+	if resume.Selection, err = unmarshalSelection(selectionJSON); err != nil {
+		return nil, err
+	}
 
 	if len(contentJSON) > 0 {
 		resume.GeneratedContent = &domain.ResumeContent{}
@@ -278,6 +668,11 @@ func (r *ResumeRepository) scanResumes(rows pgx.Rows) ([]domain.Resume, error) {
 		var score int
 		var status string
 		var contentJSON []byte
+		var insights insightColumns
+		var qrCode string
+		var contactPriority []string
+		var sectionsJSON []byte
+		var selectionJSON []byte
 
 		err := rows.Scan(
 			&resume.ID,
@@ -293,8 +688,26 @@ func (r *ResumeRepository) scanResumes(rows pgx.Rows) ([]domain.Resume, error) {
 			&score,
 			&resume.Notes,
 			&status,
+			&insights.remotePolicy,
+			&insights.salaryMin,
+			&insights.salaryMax,
+			&insights.salaryCurrency,
+			&insights.salaryPeriod,
+			&insights.benefits,
+			&insights.visaSponsorship,
+			&qrCode,
+			&contactPriority,
+			&sectionsJSON,
+			&resume.MaxPages,
+			&resume.IncludedExperienceIDs,
+			&resume.ExcludedExperienceIDs,
+			&selectionJSON,
+			&resume.ArchivedAt,
+			&resume.Pinned,
+			&resume.LastAccessedAt,
 			&resume.CreatedAt,
 			&resume.UpdatedAt,
+			&resume.TagIDs,
 		)
 		if err != nil {
 			return nil, domain.NewDatabaseError("scan resume row", err)
@@ -302,6 +715,17 @@ func (r *ResumeRepository) scanResumes(rows pgx.Rows) ([]domain.Resume, error) {
 
 		resume.Score = domain.MatchScore(score)
 		resume.Status = domain.ResumeStatus(status)
+		resume.JobInsights = insights.toDomain()
+		resume.QRCode = domain.QRCodeTarget(qrCode)
+		resume.ContactPriority = contactPriorityFields(contactPriority)
+
+		if err := json.Unmarshal(sectionsJSON, &resume.Sections); err != nil {
+			return nil, domain.NewDatabaseError("unmarshal resume sections", err)
+		}
+
+		if resume.Selection, err = unmarshalSelection(selectionJSON); err != nil {
+			return nil, err
+		}
 
 		if len(contentJSON) > 0 {
 			resume.GeneratedContent = &domain.ResumeContent{}
@@ -323,3 +747,121 @@ func (r *ResumeRepository) scanResumes(rows pgx.Rows) ([]domain.Resume, error) {
 
 	return resumes, nil
 }
+
+// insightColumns holds the job insight columns of a resume row.
+type insightColumns struct {
+	remotePolicy    *string
+	salaryMin       *int
+	salaryMax       *int
+	salaryCurrency  *string
+	salaryPeriod    *string
+	benefits        []string
+	visaSponsorship *bool
+}
+
+// newInsightColumns maps job insights to column values; nil insights map to NULLs.
+func newInsightColumns(insights *domain.JobInsights) insightColumns {
+	c := insightColumns{benefits: []string{}}
+	if insights == nil {
+		return c
+	}
+
+	if insights.RemotePolicy != "" {
+		policy := string(insights.RemotePolicy)
+		c.remotePolicy = &policy
+	}
+	if insights.Salary != nil {
+		c.salaryMin = insights.Salary.Min
+		c.salaryMax = insights.Salary.Max
+		if insights.Salary.Currency != "" {
+			c.salaryCurrency = &insights.Salary.Currency
+		}
+		period := string(insights.Salary.Period)
+		c.salaryPeriod = &period
+	}
+	if insights.Benefits != nil {
+		c.benefits = insights.Benefits
+	}
+	c.visaSponsorship = insights.VisaSponsorship
+
+	return c
+}
+
+// toDomain maps scanned column values to job insights, or nil when empty.
+func (c insightColumns) toDomain() *domain.JobInsights {
+	insights := &domain.JobInsights{
+		Benefits:        c.benefits,
+		VisaSponsorship: c.visaSponsorship,
+	}
+	if insights.Benefits == nil {
+		insights.Benefits = make([]string, 0)
+	}
+	if c.remotePolicy != nil {
+		insights.RemotePolicy = domain.RemotePolicy(*c.remotePolicy)
+	}
+	if c.salaryMin != nil || c.salaryMax != nil {
+		insights.Salary = &domain.SalaryRange{
+			Min:    c.salaryMin,
+			Max:    c.salaryMax,
+			Period: domain.SalaryPeriodYear,
+		}
+		if c.salaryCurrency != nil {
+			insights.Salary.Currency = *c.salaryCurrency
+		}
+		if c.salaryPeriod != nil {
+			insights.Salary.Period = domain.SalaryPeriod(*c.salaryPeriod)
+		}
+	}
+
+	if insights.IsEmpty() {
+		return nil
+	}
+	return insights
+}
+
+// contactPriorityColumn converts a contact priority to its TEXT[] column.
+func contactPriorityColumn(fields []domain.ContactField) []string {
+	column := make([]string, len(fields))
+	for i, f := range fields {
+		column[i] = string(f)
+	}
+	return column
+}
+
+// contactPriorityFields converts a contact_priority column to its fields.
+func contactPriorityFields(column []string) []domain.ContactField {
+	if len(column) == 0 {
+		return nil
+	}
+	fields := make([]domain.ContactField, len(column))
+	for i, f := range column {
+		fields[i] = domain.ContactField(f)
+	}
+	return fields
+}
+
+// marshalSelection converts a selection explanation to its JSONB column,
+// which is NULL before tailoring.
+func marshalSelection(selection *domain.SelectionExplanation) ([]byte, error) {
+	if selection == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(selection)
+	if err != nil {
+		return nil, domain.NewDatabaseError("marshal resume selection", err)
+	}
+	return data, nil
+}
+
+// unmarshalSelection converts a selection_explanation column to its
+// explanation.
+func unmarshalSelection(column []byte) (*domain.SelectionExplanation, error) {
+	if len(column) == 0 {
+		return nil, nil
+	}
+	selection := &domain.SelectionExplanation{}
+	if err := json.Unmarshal(column, selection); err != nil {
+		return nil, domain.NewDatabaseError("unmarshal resume selection", err)
+	}
+	return selection, nil
+}
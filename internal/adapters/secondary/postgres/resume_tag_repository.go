@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// ResumeTagRepository implements ports.ResumeTagRepository using PostgreSQL.
+type ResumeTagRepository struct {
+	pool querier
+}
+
+// Create creates a new tag.
+func (r *ResumeTagRepository) Create(ctx context.Context, tag *domain.ResumeTag) error {
+	if tag.ID == "" {
+		tag.ID = uuid.New().String()
+	}
+
+	query := `
+		INSERT INTO resume_tags (id, user_id, name, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.pool.Exec(ctx, query, tag.ID, tag.UserID, tag.Name, tag.CreatedAt, tag.UpdatedAt)
+	if err != nil {
+		return domain.NewDatabaseError("create resume tag", err)
+	}
+
+	return nil
+}
+
+// GetByIDForUser retrieves a user's tag by ID.
+func (r *ResumeTagRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.ResumeTag, error) {
+	query := `
+		SELECT t.id, t.user_id, t.name,
+			(SELECT COUNT(*) FROM resume_tag_assignments a WHERE a.tag_id = t.id),
+			t.created_at, t.updated_at
+		FROM resume_tags t
+		WHERE t.id = $1 AND t.user_id = $2
+	`
+
+	var tag domain.ResumeTag
+	err := r.pool.QueryRow(ctx, query, id, userID).Scan(
+		&tag.ID,
+		&tag.UserID,
+		&tag.Name,
+		&tag.ResumeCount,
+		&tag.CreatedAt,
+		&tag.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrResumeTagNotFound
+		}
+		return nil, domain.NewDatabaseError("get resume tag", err)
+	}
+
+	return &tag, nil
+}
+
+// ListByUserID lists a user's tags by name, with their resume counts.
+func (r *ResumeTagRepository) ListByUserID(ctx context.Context, userID string) ([]domain.ResumeTag, error) {
+	query := `
+		SELECT t.id, t.user_id, t.name, COUNT(a.resume_id), t.created_at, t.updated_at
+		FROM resume_tags t
+		LEFT JOIN resume_tag_assignments a ON a.tag_id = t.id
+		WHERE t.user_id = $1
+		GROUP BY t.id
+		ORDER BY LOWER(t.name)
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list resume tags", err)
+	}
+	defer rows.Close()
+
+	tags := make([]domain.ResumeTag, 0)
+	for rows.Next() {
+		var tag domain.ResumeTag
+		if err := rows.Scan(
+			&tag.ID,
+			&tag.UserID,
+			&tag.Name,
+			&tag.ResumeCount,
+			&tag.CreatedAt,
+			&tag.UpdatedAt,
+		); err != nil {
+			return nil, domain.NewDatabaseError("scan resume tag", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("iterate resume tags", err)
+	}
+
+	return tags, nil
+}
+
+// Update updates an existing tag.
+func (r *ResumeTagRepository) Update(ctx context.Context, tag *domain.ResumeTag) error {
+	tag.UpdatedAt = time.Now().UTC()
+
+	query := `UPDATE resume_tags SET name = $2, updated_at = $3 WHERE id = $1`
+
+	result, err := r.pool.Exec(ctx, query, tag.ID, tag.Name, tag.UpdatedAt)
+	if err != nil {
+		return domain.NewDatabaseError("update resume tag", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrResumeTagNotFound
+	}
+
+	return nil
+}
+
+// Delete removes a user's tag from all resumes and deletes it.
+func (r *ResumeTagRepository) Delete(ctx context.Context, id, userID string) error {
+	// Assignments are removed by ON DELETE CASCADE.
+	result, err := r.pool.Exec(ctx, `DELETE FROM resume_tags WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return domain.NewDatabaseError("delete resume tag", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrResumeTagNotFound
+	}
+
+	return nil
+}
+
+// Assign adds the tag to the resumes in add and removes it from those in
+// remove, in a single transaction. Adding a tag a resume already has is a
+// no-op.
+func (r *ResumeTagRepository) Assign(ctx context.Context, tagID string, add, remove []string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return domain.NewDatabaseError("begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if len(add) > 0 {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO resume_tag_assignments (resume_id, tag_id)
+			SELECT UNNEST($2::UUID[]), $1
+			ON CONFLICT DO NOTHING
+		`, tagID, add)
+		if err != nil {
+			return domain.NewDatabaseError("add resume tag", err)
+		}
+	}
+
+	if len(remove) > 0 {
+		_, err := tx.Exec(ctx, `
+			DELETE FROM resume_tag_assignments
+			WHERE tag_id = $1 AND resume_id = ANY($2::UUID[])
+		`, tagID, remove)
+		if err != nil {
+			return domain.NewDatabaseError("remove resume tag", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.NewDatabaseError("commit transaction", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,279 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// currentUserSetting is the setting the row-level security policies of
+// deploy/postgres/init/001_init.sql compare the owners of rows with.
+const currentUserSetting = "app.current_user_id"
+
+// querier is the part of a connection pool that repositories query through.
+type querier interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+}
+
+var (
+	_ querier = (*pgxpool.Pool)(nil)
+	_ querier = (*rlsPool)(nil)
+)
+
+// rlsPool runs the statements of contexts with a current user (see
+// ports.WithCurrentUser) in transactions that set currentUserSetting to that
+// user, so that row-level security policies hide other users' rows.
+// Statements without a current user run as they are.
+type rlsPool struct {
+	pool *pgxpool.Pool
+}
+
+// begin starts a transaction scoped to userID.
+func (p *rlsPool) begin(ctx context.Context, userID string) (pgx.Tx, error) {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Local to the transaction, so that the setting never leaks to the next
+	// user of the connection.
+	if _, err := tx.Exec(ctx, "SELECT set_config($1, $2, true)", currentUserSetting, userID); err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, fmt.Errorf("failed to set current user: %w", err)
+	}
+	return tx, nil
+}
+
+// Begin starts a transaction, scoped to the current user of ctx.
+func (p *rlsPool) Begin(ctx context.Context) (pgx.Tx, error) {
+	userID := ports.CurrentUserFromContext(ctx)
+	if userID == "" {
+		return p.pool.Begin(ctx)
+	}
+	return p.begin(ctx, userID)
+}
+
+// Exec runs a statement, scoped to the current user of ctx.
+func (p *rlsPool) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	userID := ports.CurrentUserFromContext(ctx)
+	if userID == "" {
+		return p.pool.Exec(ctx, sql, args...)
+	}
+
+	tx, err := p.begin(ctx, userID)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	tag, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return pgconn.CommandTag{}, err
+	}
+	return tag, tx.Commit(ctx)
+}
+
+// Query runs a query, scoped to the current user of ctx. The transaction
+// ends when the rows are read or closed.
+func (p *rlsPool) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	userID := ports.CurrentUserFromContext(ctx)
+	if userID == "" {
+		return p.pool.Query(ctx, sql, args...)
+	}
+
+	tx, err := p.begin(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, err
+	}
+	return &txRows{Rows: rows, ctx: ctx, tx: tx}, nil
+}
+
+// QueryRow runs a query returning at most one row, scoped to the current
+// user of ctx.
+func (p *rlsPool) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	if ports.CurrentUserFromContext(ctx) == "" {
+		return p.pool.QueryRow(ctx, sql, args...)
+	}
+
+	rows, err := p.Query(ctx, sql, args...)
+	return &txRow{rows: rows, err: err}
+}
+
+// SendBatch sends a batch, scoped to the current user of ctx. The
+// transaction ends when the results are closed.
+func (p *rlsPool) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	userID := ports.CurrentUserFromContext(ctx)
+	if userID == "" {
+		return p.pool.SendBatch(ctx, b)
+	}
+
+	tx, err := p.begin(ctx, userID)
+	if err != nil {
+		return &txBatchResults{err: err}
+	}
+	return &txBatchResults{BatchResults: tx.SendBatch(ctx, b), ctx: ctx, tx: tx}
+}
+
+// txRows are the rows of a query run in its own transaction, which ends
+// once the rows are read or closed.
+type txRows struct {
+	pgx.Rows
+	ctx context.Context
+	tx  pgx.Tx
+
+	done      bool
+	commitErr error
+}
+
+// Next advances to the next row, ending the transaction after the last.
+func (r *txRows) Next() bool {
+	if r.Rows.Next() {
+		return true
+	}
+	r.finish()
+	return false
+}
+
+// Close closes the rows and ends the transaction.
+func (r *txRows) Close() {
+	r.finish()
+}
+
+// Err returns the error of reading the rows or of ending the transaction.
+func (r *txRows) Err() error {
+	if err := r.Rows.Err(); err != nil {
+		return err
+	}
+	return r.commitErr
+}
+
+// finish ends the transaction once. Statements returning rows, such as
+// INSERT ... RETURNING, may write, so it is committed unless reading failed.
+func (r *txRows) finish() {
+	if r.done {
+		return
+	}
+	r.done = true
+	r.Rows.Close()
+	if r.Rows.Err() != nil {
+		_ = r.tx.Rollback(r.ctx)
+		return
+	}
+	r.commitErr = r.tx.Commit(r.ctx)
+}
+
+// txRow is the single row of a query run in its own transaction.
+type txRow struct {
+	rows pgx.Rows
+	err  error
+}
+
+// Scan reads the row into dest like pgx.Row, returning pgx.ErrNoRows when
+// there is none.
+func (r *txRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	defer r.rows.Close()
+
+	if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return err
+		}
+		return pgx.ErrNoRows
+	}
+	if err := r.rows.Scan(dest...); err != nil {
+		return err
+	}
+	r.rows.Close()
+	return r.rows.Err()
+}
+
+// txBatchResults are the results of a batch sent in its own transaction,
+// which ends when they are closed.
+type txBatchResults struct {
+	pgx.BatchResults
+	ctx context.Context
+	tx  pgx.Tx
+
+	// err is the error of starting the transaction.
+	err error
+}
+
+// Exec reads the result of the next statement of the batch.
+func (b *txBatchResults) Exec() (pgconn.CommandTag, error) {
+	if b.err != nil {
+		return pgconn.CommandTag{}, b.err
+	}
+	return b.BatchResults.Exec()
+}
+
+// Query reads the rows of the next statement of the batch.
+func (b *txBatchResults) Query() (pgx.Rows, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.BatchResults.Query()
+}
+
+// QueryRow reads the row of the next statement of the batch.
+func (b *txBatchResults) QueryRow() pgx.Row {
+	if b.err != nil {
+		return &txRow{err: b.err}
+	}
+	return b.BatchResults.QueryRow()
+}
+
+// Close closes the results and ends the transaction, committing it unless
+// a statement failed.
+func (b *txBatchResults) Close() error {
+	if b.err != nil {
+		return b.err
+	}
+	if err := b.BatchResults.Close(); err != nil {
+		_ = b.tx.Rollback(b.ctx)
+		return err
+	}
+	return b.tx.Commit(b.ctx)
+}
+
+// checkRowLevelSecurity verifies that the row-level security policies apply
+// to the role of pool: they are installed and the role neither is a
+// superuser nor bypasses them.
+func checkRowLevelSecurity(ctx context.Context, pool *pgxpool.Pool) error {
+	var bypass bool
+	err := pool.QueryRow(ctx, `SELECT rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = current_user`).Scan(&bypass)
+	if err != nil {
+		return fmt.Errorf("failed to check database role: %w", err)
+	}
+	if bypass {
+		return errors.New("row-level security: the database role is a superuser or bypasses row-level security")
+	}
+
+	var enforced bool
+	err = pool.QueryRow(ctx, `
+		SELECT relrowsecurity AND relforcerowsecurity
+		FROM pg_class
+		WHERE oid = 'resumes'::regclass
+	`).Scan(&enforced)
+	if err != nil {
+		return fmt.Errorf("failed to check row-level security: %w", err)
+	}
+	if !enforced {
+		return errors.New("row-level security: policies are not installed, see deploy/postgres/init/001_init.sql")
+	}
+	return nil
+}
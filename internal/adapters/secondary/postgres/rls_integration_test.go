@@ -0,0 +1,171 @@
+//go:build integration
+
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// rlsTestRole is the ordinary role the row-level security tests connect
+// with, as the policies do not apply to superusers.
+const rlsTestRole = "chameleon_rls_test"
+
+func rlsTestEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// openRLSTestDBs returns a connection as the test superuser, which sees
+// every row, and one as rlsTestRole with row-level security enabled.
+func openRLSTestDBs(t *testing.T) (admin, scoped *DB) {
+	t.Helper()
+	ctx := context.Background()
+
+	cfg := Config{
+		Host:     rlsTestEnv("TEST_DB_HOST", "localhost"),
+		Port:     5432,
+		User:     rlsTestEnv("TEST_DB_USER", "chameleon"),
+		Password: rlsTestEnv("TEST_DB_PASSWORD", "chameleon_secret"), // pragma: allowlist secret
+		Database: rlsTestEnv("TEST_DB_NAME", "chameleon_test"),
+		SSLMode:  "disable",
+		MaxConns: 5,
+		MinConns: 1,
+	}
+	admin, err := New(ctx, cfg)
+	if err != nil {
+		t.Skipf("database unavailable: %v", err)
+	}
+	t.Cleanup(admin.Close)
+
+	_, err = admin.pool.Exec(ctx, `
+		DO $$
+		BEGIN
+			IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = '`+rlsTestRole+`') THEN
+				CREATE ROLE `+rlsTestRole+` LOGIN PASSWORD '`+rlsTestRole+`' NOSUPERUSER NOBYPASSRLS;
+			END IF;
+		END $$;
+		GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA public TO `+rlsTestRole+`;
+		GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public TO `+rlsTestRole+`;
+	`)
+	if err != nil {
+		t.Skipf("cannot create the role of the row-level security tests: %v", err)
+	}
+
+	cfg.User = rlsTestRole
+	cfg.Password = rlsTestRole
+	cfg.RowLevelSecurity = true
+	scoped, err = New(ctx, cfg)
+	require.NoError(t, err)
+	t.Cleanup(scoped.Close)
+
+	return admin, scoped
+}
+
+// rlsTestUser creates a user with one skill, deleted when the test ends.
+func rlsTestUser(t *testing.T, db *DB, name string) *domain.User {
+	t.Helper()
+	ctx := context.Background()
+	userRepo := db.UserRepository()
+
+	user, err := domain.NewUser(name + "-" + time.Now().Format("20060102150405.000000"))
+	require.NoError(t, err)
+	require.NoError(t, userRepo.Create(ctx, user))
+	t.Cleanup(func() {
+		_ = userRepo.Delete(ctx, user.ID)
+	})
+
+	skill, err := domain.NewSkill(user.ID, name+" skill")
+	require.NoError(t, err)
+	require.NoError(t, db.SkillRepository().Create(ctx, skill))
+
+	return user
+}
+
+// countSkills counts the skills of userID, across users.
+func countSkills(t *testing.T, db *DB, userID string) int {
+	t.Helper()
+	var count int
+	err := db.pool.QueryRow(context.Background(), `SELECT COUNT(*) FROM skills WHERE user_id = $1`, userID).Scan(&count)
+	require.NoError(t, err)
+	return count
+}
+
+func TestRowLevelSecurityHidesOtherUsersRows(t *testing.T) {
+	admin, scoped := openRLSTestDBs(t)
+	alice := rlsTestUser(t, admin, "rls-alice")
+	bob := rlsTestUser(t, admin, "rls-bob")
+	repo := scoped.SkillRepository()
+
+	ctx := ports.WithCurrentUser(context.Background(), alice.ID)
+
+	skills, err := repo.ListByUserID(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Len(t, skills, 1)
+
+	// A query missing the ownership check still only sees alice's rows.
+	skills, err = repo.ListByUserID(ctx, bob.ID)
+	require.NoError(t, err)
+	assert.Empty(t, skills)
+
+	var visible int
+	err = scoped.conn().QueryRow(ctx, `SELECT COUNT(*) FROM skills WHERE user_id IN ($1, $2)`, alice.ID, bob.ID).Scan(&visible)
+	require.NoError(t, err)
+	assert.Equal(t, 1, visible)
+}
+
+func TestRowLevelSecurityWithoutCurrentUserSeesAllRows(t *testing.T) {
+	admin, scoped := openRLSTestDBs(t)
+	alice := rlsTestUser(t, admin, "rls-alice")
+	bob := rlsTestUser(t, admin, "rls-bob")
+
+	// As the AdminOnly middleware does for admin endpoints.
+	ctx := ports.WithCurrentUser(ports.WithCurrentUser(context.Background(), alice.ID), "")
+
+	skills, err := scoped.SkillRepository().ListByUserID(ctx, bob.ID)
+	require.NoError(t, err)
+	assert.Len(t, skills, 1)
+
+	var visible int
+	err = scoped.conn().QueryRow(ctx, `SELECT COUNT(*) FROM skills WHERE user_id IN ($1, $2)`, alice.ID, bob.ID).Scan(&visible)
+	require.NoError(t, err)
+	assert.Equal(t, 2, visible)
+}
+
+func TestRowLevelSecurityQueryRowReturningCommits(t *testing.T) {
+	admin, scoped := openRLSTestDBs(t)
+	alice := rlsTestUser(t, admin, "rls-alice")
+	ctx := ports.WithCurrentUser(context.Background(), alice.ID)
+
+	// Upsert runs INSERT ... RETURNING through QueryRow.
+	skill, err := domain.NewSkill(alice.ID, "Go")
+	require.NoError(t, err)
+	require.NoError(t, scoped.SkillRepository().Upsert(ctx, skill))
+
+	assert.Equal(t, 2, countSkills(t, admin, alice.ID))
+}
+
+func TestRowLevelSecurityReadErrorRollsBack(t *testing.T) {
+	admin, scoped := openRLSTestDBs(t)
+	alice := rlsTestUser(t, admin, "rls-alice")
+	ctx := ports.WithCurrentUser(context.Background(), alice.ID)
+
+	// The insert succeeds, but its returned name cannot be read as a number.
+	var name int
+	err := scoped.conn().QueryRow(ctx, `
+		INSERT INTO skills (user_id, name) VALUES ($1, 'Rust') RETURNING name
+	`, alice.ID).Scan(&name)
+	require.Error(t, err)
+
+	assert.Equal(t, 1, countSkills(t, admin, alice.ID))
+}
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// SavedResumeFilterRepository implements ports.SavedResumeFilterRepository
+// using PostgreSQL.
+type SavedResumeFilterRepository struct {
+	pool querier
+}
+
+// Create creates a new saved filter.
+func (r *SavedResumeFilterRepository) Create(ctx context.Context, filter *domain.SavedResumeFilter) error {
+	if filter.ID == "" {
+		filter.ID = uuid.New().String()
+	}
+
+	searchJSON, err := json.Marshal(filter.Search)
+	if err != nil {
+		return domain.NewDatabaseError("marshal saved filter search", err)
+	}
+
+	query := `
+		INSERT INTO saved_resume_filters (id, user_id, name, search, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err = r.pool.Exec(ctx, query,
+		filter.ID, filter.UserID, filter.Name, searchJSON, filter.CreatedAt, filter.UpdatedAt)
+	if err != nil {
+		return domain.NewDatabaseError("create saved filter", err)
+	}
+
+	return nil
+}
+
+// GetByIDForUser retrieves a user's saved filter by ID.
+func (r *SavedResumeFilterRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.SavedResumeFilter, error) {
+	query := `
+		SELECT id, user_id, name, search, created_at, updated_at
+		FROM saved_resume_filters
+		WHERE id = $1 AND user_id = $2
+	`
+
+	filter, err := scanSavedFilter(r.pool.QueryRow(ctx, query, id, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrSavedFilterNotFound
+		}
+		return nil, domain.NewDatabaseError("get saved filter", err)
+	}
+
+	return filter, nil
+}
+
+// ListByUserID lists a user's saved filters by name.
+func (r *SavedResumeFilterRepository) ListByUserID(ctx context.Context, userID string) ([]domain.SavedResumeFilter, error) {
+	query := `
+		SELECT id, user_id, name, search, created_at, updated_at
+		FROM saved_resume_filters
+		WHERE user_id = $1
+		ORDER BY LOWER(name)
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list saved filters", err)
+	}
+	defer rows.Close()
+
+	filters := make([]domain.SavedResumeFilter, 0)
+	for rows.Next() {
+		filter, err := scanSavedFilter(rows)
+		if err != nil {
+			return nil, domain.NewDatabaseError("scan saved filter", err)
+		}
+		filters = append(filters, *filter)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("iterate saved filters", err)
+	}
+
+	return filters, nil
+}
+
+// Update updates an existing saved filter.
+func (r *SavedResumeFilterRepository) Update(ctx context.Context, filter *domain.SavedResumeFilter) error {
+	filter.UpdatedAt = time.Now().UTC()
+
+	searchJSON, err := json.Marshal(filter.Search)
+	if err != nil {
+		return domain.NewDatabaseError("marshal saved filter search", err)
+	}
+
+	query := `UPDATE saved_resume_filters SET name = $2, search = $3, updated_at = $4 WHERE id = $1`
+
+	result, err := r.pool.Exec(ctx, query, filter.ID, filter.Name, searchJSON, filter.UpdatedAt)
+	if err != nil {
+		return domain.NewDatabaseError("update saved filter", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrSavedFilterNotFound
+	}
+
+	return nil
+}
+
+// Delete removes a user's saved filter.
+func (r *SavedResumeFilterRepository) Delete(ctx context.Context, id, userID string) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM saved_resume_filters WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return domain.NewDatabaseError("delete saved filter", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrSavedFilterNotFound
+	}
+
+	return nil
+}
+
+// scanSavedFilter scans a saved filter row.
+func scanSavedFilter(row pgx.Row) (*domain.SavedResumeFilter, error) {
+	var filter domain.SavedResumeFilter
+	var searchJSON []byte
+
+	if err := row.Scan(
+		&filter.ID,
+		&filter.UserID,
+		&filter.Name,
+		&searchJSON,
+		&filter.CreatedAt,
+		&filter.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(searchJSON, &filter.Search); err != nil {
+		return nil, err
+	}
+
+	return &filter, nil
+}
@@ -7,14 +7,16 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 )
 
 // SkillRepository implements ports.SkillRepository using PostgreSQL.
 type SkillRepository struct {
-	pool *pgxpool.Pool
+	pool querier
+
+	// replica serves list queries; it is pool when there is no replica.
+	replica querier
 }
 
 // Create creates a new skill.
@@ -52,16 +54,16 @@ func (r *SkillRepository) Create(ctx context.Context, skill *domain.Skill) error
 	return nil
 }
 
-// GetByID retrieves a skill by ID.
-func (r *SkillRepository) GetByID(ctx context.Context, id string) (*domain.Skill, error) {
+// GetByIDForUser retrieves a user's skill by ID.
+func (r *SkillRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.Skill, error) {
 	query := `
 		SELECT id, user_id, name, category, proficiency_level,
 			   years_of_experience, is_highlighted, display_order, created_at
 		FROM skills
-		WHERE id = $1
+		WHERE id = $1 AND user_id = $2
 	`
 
-	return r.scanSkill(r.pool.QueryRow(ctx, query, id))
+	return r.scanSkill(r.pool.QueryRow(ctx, query, id, userID))
 }
 
 // GetByUserIDAndName retrieves a skill by user ID and name.
@@ -86,7 +88,7 @@ func (r *SkillRepository) ListByUserID(ctx context.Context, userID string) ([]do
 		ORDER BY is_highlighted DESC, display_order ASC, name ASC
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID)
+	rows, err := r.replica.Query(ctx, query, userID)
 	if err != nil {
 		return nil, domain.NewDatabaseError("list skills by user", err)
 	}
@@ -105,7 +107,7 @@ func (r *SkillRepository) ListByUserIDAndCategory(ctx context.Context, userID, c
 		ORDER BY is_highlighted DESC, display_order ASC, name ASC
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID, category)
+	rows, err := r.replica.Query(ctx, query, userID, category)
 	if err != nil {
 		return nil, domain.NewDatabaseError("list skills by category", err)
 	}
@@ -124,7 +126,7 @@ func (r *SkillRepository) ListHighlighted(ctx context.Context, userID string) ([
 		ORDER BY display_order ASC, name ASC
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID)
+	rows, err := r.replica.Query(ctx, query, userID)
 	if err != nil {
 		return nil, domain.NewDatabaseError("list highlighted skills", err)
 	}
@@ -275,11 +277,11 @@ func (r *SkillRepository) BatchUpsert(ctx context.Context, skills []domain.Skill
 	return created, updated, nil
 }
 
-// Delete removes a skill.
-func (r *SkillRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM skills WHERE id = $1`
+// Delete removes a user's skill.
+func (r *SkillRepository) Delete(ctx context.Context, id, userID string) error {
+	query := `DELETE FROM skills WHERE id = $1 AND user_id = $2`
 
-	result, err := r.pool.Exec(ctx, query, id)
+	result, err := r.pool.Exec(ctx, query, id, userID)
 	if err != nil {
 		return domain.NewDatabaseError("delete skill", err)
 	}
@@ -301,7 +303,7 @@ func (r *SkillRepository) SearchByName(ctx context.Context, userID, query string
 		ORDER BY is_highlighted DESC, display_order ASC, name ASC
 	`
 
-	rows, err := r.pool.Query(ctx, sqlQuery, userID, "%"+query+"%")
+	rows, err := r.replica.Query(ctx, sqlQuery, userID, "%"+query+"%")
 	if err != nil {
 		return nil, domain.NewDatabaseError("search skills by name", err)
 	}
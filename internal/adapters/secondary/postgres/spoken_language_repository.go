@@ -7,14 +7,16 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 )
 
 // SpokenLanguageRepository implements ports.SpokenLanguageRepository using PostgreSQL.
 type SpokenLanguageRepository struct {
-	pool *pgxpool.Pool
+	pool querier
+
+	// replica serves list queries; it is pool when there is no replica.
+	replica querier
 }
 
 // Create creates a new spoken language.
@@ -48,15 +50,15 @@ func (r *SpokenLanguageRepository) Create(ctx context.Context, language *domain.
 	return nil
 }
 
-// GetByID retrieves a spoken language by ID.
-func (r *SpokenLanguageRepository) GetByID(ctx context.Context, id string) (*domain.SpokenLanguage, error) {
+// GetByIDForUser retrieves a user's spoken language by ID.
+func (r *SpokenLanguageRepository) GetByIDForUser(ctx context.Context, id, userID string) (*domain.SpokenLanguage, error) {
 	query := `
 		SELECT id, user_id, language, proficiency, display_order, created_at
 		FROM spoken_languages
-		WHERE id = $1
+		WHERE id = $1 AND user_id = $2
 	`
 
-	return r.scanLanguage(r.pool.QueryRow(ctx, query, id))
+	return r.scanLanguage(r.pool.QueryRow(ctx, query, id, userID))
 }
 
 // ListByUserID lists all spoken languages for a user.
@@ -68,7 +70,7 @@ func (r *SpokenLanguageRepository) ListByUserID(ctx context.Context, userID stri
 		ORDER BY display_order ASC, language ASC
 	`
 
-	rows, err := r.pool.Query(ctx, query, userID)
+	rows, err := r.replica.Query(ctx, query, userID)
 	if err != nil {
 		return nil, domain.NewDatabaseError("list spoken languages", err)
 	}
@@ -104,11 +106,11 @@ func (r *SpokenLanguageRepository) Update(ctx context.Context, language *domain.
 	return nil
 }
 
-// Delete removes a spoken language.
-func (r *SpokenLanguageRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM spoken_languages WHERE id = $1`
+// Delete removes a user's spoken language.
+func (r *SpokenLanguageRepository) Delete(ctx context.Context, id, userID string) error {
+	query := `DELETE FROM spoken_languages WHERE id = $1 AND user_id = $2`
 
-	result, err := r.pool.Exec(ctx, query, id)
+	result, err := r.pool.Exec(ctx, query, id, userID)
 	if err != nil {
 		return domain.NewDatabaseError("delete spoken language", err)
 	}
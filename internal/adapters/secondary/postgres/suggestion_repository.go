@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// SuggestionRepository implements ports.SuggestionRepository using PostgreSQL.
+type SuggestionRepository struct {
+	pool querier
+
+	// replica serves list queries; it is pool when there is no replica.
+	replica querier
+}
+
+// Suggest returns up to limit distinct values of each suggestion type that
+// contain the query, ignoring case. Values starting with the query come
+// first, then the most similar ones by trigram similarity.
+func (r *SuggestionRepository) Suggest(ctx context.Context, userID, query string, limit int) ([]domain.Suggestion, error) {
+	// The ILIKE conditions use the trigram indexes of the searched columns.
+	sqlQuery := `
+		WITH matches AS (
+			SELECT 'company' AS type, company_name AS value, NULL::TEXT AS id
+			FROM resumes WHERE user_id = $1 AND company_name ILIKE $2
+			UNION ALL
+			SELECT 'company', organization, NULL
+			FROM experiences WHERE user_id = $1 AND organization ILIKE $2
+			UNION ALL
+			SELECT 'job_title', job_title, NULL
+			FROM resumes WHERE user_id = $1 AND job_title ILIKE $2
+			UNION ALL
+			SELECT 'job_title', title, NULL
+			FROM experiences WHERE user_id = $1 AND title ILIKE $2
+			UNION ALL
+			SELECT 'skill', name, id::TEXT
+			FROM skills WHERE user_id = $1 AND name ILIKE $2
+			UNION ALL
+			SELECT 'tag', name, id::TEXT
+			FROM resume_tags WHERE user_id = $1 AND name ILIKE $2
+		), distinct_matches AS (
+			SELECT DISTINCT ON (type, LOWER(value)) type, value, id
+			FROM matches
+			ORDER BY type, LOWER(value), value
+		), ranked AS (
+			SELECT type, value, id, ROW_NUMBER() OVER (
+				PARTITION BY type
+				ORDER BY STARTS_WITH(LOWER(value), LOWER($3)) DESC,
+					similarity(value, $3) DESC, value
+			) AS rank
+			FROM distinct_matches
+		)
+		SELECT type, value, COALESCE(id, '')
+		FROM ranked
+		WHERE rank <= $4
+		ORDER BY type, rank
+	`
+
+	rows, err := r.replica.Query(ctx, sqlQuery, userID, "%"+escapeLike(query)+"%", query, limit)
+	if err != nil {
+		return nil, domain.NewDatabaseError("suggest", err)
+	}
+	defer rows.Close()
+
+	suggestions := make([]domain.Suggestion, 0)
+	for rows.Next() {
+		var suggestion domain.Suggestion
+		var suggestionType string
+
+		if err := rows.Scan(&suggestionType, &suggestion.Value, &suggestion.ID); err != nil {
+			return nil, domain.NewDatabaseError("scan suggestion", err)
+		}
+
+		suggestion.Type = domain.SuggestionType(suggestionType)
+		suggestions = append(suggestions, suggestion)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("iterate suggestions", err)
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// UsageRepository implements ports.UsageRepository using PostgreSQL.
+type UsageRepository struct {
+	pool querier
+}
+
+// Record stores one occurrence of an action by a user.
+func (r *UsageRepository) Record(ctx context.Context, userID string, action domain.UsageAction) error {
+	query := `INSERT INTO usage_events (user_id, action) VALUES ($1, $2)`
+
+	if _, err := r.pool.Exec(ctx, query, userID, string(action)); err != nil {
+		return domain.NewDatabaseError("record usage", err)
+	}
+
+	return nil
+}
+
+// CountSince counts a user's occurrences of an action since the given time.
+func (r *UsageRepository) CountSince(ctx context.Context, userID string, action domain.UsageAction, since time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM usage_events
+		WHERE user_id = $1 AND action = $2 AND created_at >= $3
+	`
+
+	var count int
+	if err := r.pool.QueryRow(ctx, query, userID, string(action), since).Scan(&count); err != nil {
+		return 0, domain.NewDatabaseError("count usage", err)
+	}
+
+	return count, nil
+}
@@ -7,18 +7,48 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 )
 
 // UserRepository implements ports.UserRepository using PostgreSQL.
 type UserRepository struct {
-	pool *pgxpool.Pool
+	pool querier
 }
 
 // Create creates a new user in the database.
 func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
+	return r.create(ctx, r.pool, user)
+}
+
+// CreateWithEvents creates a new user and adds events about it to the outbox
+// in the same transaction. The events get the ID of the new user.
+func (r *UserRepository) CreateWithEvents(ctx context.Context, user *domain.User, events []domain.OutboxEvent) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return domain.NewDatabaseError("begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := r.create(ctx, tx, user); err != nil {
+		return err
+	}
+	for i := range events {
+		events[i].UserID = user.ID
+	}
+	if err := insertOutboxEvents(ctx, tx, events); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domain.NewDatabaseError("commit transaction", err)
+	}
+
+	return nil
+}
+
+// create creates a new user through db, a pool or a transaction.
+func (r *UserRepository) create(ctx context.Context, db rowQuerier, user *domain.User) error {
 	if user.ID == "" {
 		user.ID = uuid.New().String()
 	}
@@ -29,18 +59,21 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 
 	query := `
 		INSERT INTO users (
-			id, firebase_uid, picture_url, email, name, headline, summary,
+			id, firebase_uid, picture_url, picture_source_url, email, name, headline, summary,
 			location, phone, website, linkedin_url, github_url, portfolio_url,
 			preferred_language, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
 		)
+		RETURNING plan
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	var plan string
+	err := db.QueryRow(ctx, query,
 		user.ID,
 		user.FirebaseUID,
 		user.PictureURL,
+		user.PictureSourceURL,
 		user.Email,
 		user.Name,
 		user.Headline,
@@ -54,68 +87,52 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 		user.PreferredLanguage,
 		user.CreatedAt,
 		user.UpdatedAt,
-	)
+	).Scan(&plan)
 	if err != nil {
 		return domain.NewDatabaseError("create user", err)
 	}
+	user.Plan = domain.Plan(plan)
 
 	return nil
 }
 
 // GetByID retrieves a user by their internal ID.
 func (r *UserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
-	query := `
-		SELECT id, firebase_uid, picture_url, email, name, headline, summary,
-			   location, phone, website, linkedin_url, github_url, portfolio_url,
-			   preferred_language, created_at, updated_at
-		FROM users
-		WHERE id = $1
-	`
+	return r.getUser(ctx, "get user by id", "id = $1", id)
+}
 
-	user := &domain.User{}
-	err := r.pool.QueryRow(ctx, query, id).Scan(
-		&user.ID,
-		&user.FirebaseUID,
-		&user.PictureURL,
-		&user.Email,
-		&user.Name,
-		&user.Headline,
-		&user.Summary,
-		&user.Location,
-		&user.Phone,
-		&user.Website,
-		&user.LinkedInURL,
-		&user.GitHubURL,
-		&user.PortfolioURL,
-		&user.PreferredLanguage,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domain.ErrUserNotFound
-		}
-		return nil, domain.NewDatabaseError("get user by id", err)
-	}
+// GetByFirebaseUID retrieves a user by the provider user ID of their sign-up
+// identity, their Firebase UID, or of a linked identity.
+func (r *UserRepository) GetByFirebaseUID(ctx context.Context, firebaseUID string) (*domain.User, error) {
+	return r.getUser(ctx, "get user by firebase uid", `id = COALESCE(
+			(SELECT id FROM users WHERE firebase_uid = $1),
+			(SELECT user_id FROM user_identities WHERE provider_user_id = $1)
+		)`, firebaseUID)
+}
 
-	return user, nil
+// GetByEmail retrieves the oldest user with an email, compared
+// case-insensitively.
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	return r.getUser(ctx, "get user by email", "LOWER(email) = LOWER($1) ORDER BY created_at LIMIT 1", email)
 }
 
-// GetByFirebaseUID retrieves a user by their Firebase UID.
-func (r *UserRepository) GetByFirebaseUID(ctx context.Context, firebaseUID string) (*domain.User, error) {
+// getUser retrieves the user matching a condition on $1, reporting errors
+// as op.
+func (r *UserRepository) getUser(ctx context.Context, op, condition string, arg any) (*domain.User, error) {
 	query := `
-		SELECT id, firebase_uid, picture_url, email, name, headline, summary,
+		SELECT id, firebase_uid, picture_url, picture_source_url, email, name, headline, summary,
 			   location, phone, website, linkedin_url, github_url, portfolio_url,
-			   preferred_language, created_at, updated_at
+			   preferred_language, plan, sessions_revoked_at, created_at, updated_at
 		FROM users
-		WHERE firebase_uid = $1
-	`
+		WHERE ` + condition
 
 	user := &domain.User{}
-	err := r.pool.QueryRow(ctx, query, firebaseUID).Scan(
+	var plan string
+	err := r.pool.QueryRow(ctx, query, arg).Scan(
 		&user.ID,
 		&user.FirebaseUID,
 		&user.PictureURL,
+		&user.PictureSourceURL,
 		&user.Email,
 		&user.Name,
 		&user.Headline,
@@ -127,6 +144,8 @@ func (r *UserRepository) GetByFirebaseUID(ctx context.Context, firebaseUID strin
 		&user.GitHubURL,
 		&user.PortfolioURL,
 		&user.PreferredLanguage,
+		&plan,
+		&user.SessionsRevokedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -134,8 +153,9 @@ func (r *UserRepository) GetByFirebaseUID(ctx context.Context, firebaseUID strin
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, domain.ErrUserNotFound
 		}
-		return nil, domain.NewDatabaseError("get user by firebase uid", err)
+		return nil, domain.NewDatabaseError(op, err)
 	}
+	user.Plan = domain.Plan(plan)
 
 	return user, nil
 }
@@ -147,24 +167,27 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 	query := `
 		UPDATE users SET
 			picture_url = $2,
-			email = $3,
-			name = $4,
-			headline = $5,
-			summary = $6,
-			location = $7,
-			phone = $8,
-			website = $9,
-			linkedin_url = $10,
-			github_url = $11,
-			portfolio_url = $12,
-			preferred_language = $13,
-			updated_at = $14
+			picture_source_url = $3,
+			email = $4,
+			name = $5,
+			headline = $6,
+			summary = $7,
+			location = $8,
+			phone = $9,
+			website = $10,
+			linkedin_url = $11,
+			github_url = $12,
+			portfolio_url = $13,
+			preferred_language = $14,
+			sessions_revoked_at = $15,
+			updated_at = $16
 		WHERE id = $1
 	`
 
 	result, err := r.pool.Exec(ctx, query,
 		user.ID,
 		user.PictureURL,
+		user.PictureSourceURL,
 		user.Email,
 		user.Name,
 		user.Headline,
@@ -176,6 +199,7 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 		user.GitHubURL,
 		user.PortfolioURL,
 		user.PreferredLanguage,
+		user.SessionsRevokedAt,
 		user.UpdatedAt,
 	)
 	if err != nil {
@@ -216,24 +240,27 @@ func (r *UserRepository) Upsert(ctx context.Context, user *domain.User) error {
 
 	query := `
 		INSERT INTO users (
-			id, firebase_uid, picture_url, email, name, headline, summary,
+			id, firebase_uid, picture_url, picture_source_url, email, name, headline, summary,
 			location, phone, website, linkedin_url, github_url, portfolio_url,
 			preferred_language, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
 		)
 		ON CONFLICT (firebase_uid) DO UPDATE SET
 			picture_url = EXCLUDED.picture_url,
+			picture_source_url = EXCLUDED.picture_source_url,
 			email = EXCLUDED.email,
 			name = EXCLUDED.name,
 			updated_at = EXCLUDED.updated_at
-		RETURNING id, created_at
+		RETURNING id, plan, created_at
 	`
 
+	var plan string
 	err := r.pool.QueryRow(ctx, query,
 		user.ID,
 		user.FirebaseUID,
 		user.PictureURL,
+		user.PictureSourceURL,
 		user.Email,
 		user.Name,
 		user.Headline,
@@ -247,10 +274,90 @@ func (r *UserRepository) Upsert(ctx context.Context, user *domain.User) error {
 		user.PreferredLanguage,
 		now, // created_at for new records
 		user.UpdatedAt,
-	).Scan(&user.ID, &user.CreatedAt)
+	).Scan(&user.ID, &plan, &user.CreatedAt)
 	if err != nil {
 		return domain.NewDatabaseError("upsert user", err)
 	}
+	user.Plan = domain.Plan(plan)
+
+	return nil
+}
+
+// LinkIdentity links an identity to its user.
+func (r *UserRepository) LinkIdentity(ctx context.Context, identity *domain.UserIdentity) error {
+	if identity.ID == "" {
+		identity.ID = uuid.New().String()
+	}
+
+	query := `
+		INSERT INTO user_identities (id, user_id, provider_user_id, provider, email, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		identity.ID,
+		identity.UserID,
+		identity.ProviderUserID,
+		identity.Provider,
+		identity.Email,
+		identity.CreatedAt,
+	)
+	if err != nil {
+		return domain.NewDatabaseError("link identity", err)
+	}
+
+	return nil
+}
+
+// ListIdentities lists the identities linked to a user, oldest first.
+func (r *UserRepository) ListIdentities(ctx context.Context, userID string) ([]domain.UserIdentity, error) {
+	query := `
+		SELECT id, user_id, provider_user_id, provider, email, created_at
+		FROM user_identities
+		WHERE user_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, domain.NewDatabaseError("list identities", err)
+	}
+	defer rows.Close()
+
+	identities := []domain.UserIdentity{}
+	for rows.Next() {
+		var identity domain.UserIdentity
+		if err := rows.Scan(
+			&identity.ID,
+			&identity.UserID,
+			&identity.ProviderUserID,
+			&identity.Provider,
+			&identity.Email,
+			&identity.CreatedAt,
+		); err != nil {
+			return nil, domain.NewDatabaseError("scan identity", err)
+		}
+		identities = append(identities, identity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.NewDatabaseError("list identities", err)
+	}
+
+	return identities, nil
+}
+
+// UnlinkIdentity removes an identity linked to a user.
+func (r *UserRepository) UnlinkIdentity(ctx context.Context, id, userID string) error {
+	query := `DELETE FROM user_identities WHERE id = $1 AND user_id = $2`
+
+	result, err := r.pool.Exec(ctx, query, id, userID)
+	if err != nil {
+		return domain.NewDatabaseError("unlink identity", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrIdentityNotFound
+	}
 
 	return nil
 }
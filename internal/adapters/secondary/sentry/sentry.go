@@ -0,0 +1,279 @@
+// Package sentry provides an error reporting adapter for Sentry.
+//
+// Events are sent to the envelope endpoint of the project named by the DSN,
+// so any Sentry-compatible server (sentry.io, self-hosted Sentry, GlitchTip)
+// can be used. Unless SendPII is set, e-mail addresses, international phone
+// numbers, tokens and URL query strings are scrubbed from the events.
+package sentry
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// clientName identifies this adapter to Sentry.
+const clientName = "chameleon-vitae/1.0"
+
+// Config holds Sentry configuration.
+type Config struct {
+	// DSN is the project's client key URL,
+	// e.g. https://public@o0.ingest.sentry.io/0.
+	DSN string
+
+	// Environment and Release tag every event.
+	Environment string
+	Release     string
+
+	// Timeout bounds sending one event.
+	Timeout time.Duration
+
+	// SendPII sends events as they are instead of scrubbing personal data.
+	SendPII bool
+
+	// HTTPClient, when set, is used for API requests instead of a client
+	// built from Timeout, e.g. one sharing a pkg/httpclient transport.
+	HTTPClient *http.Client
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		Environment: "production",
+		Timeout:     5 * time.Second,
+	}
+}
+
+// Client implements ports.ErrorReporter using the Sentry envelope API.
+type Client struct {
+	config     Config
+	httpClient *http.Client
+
+	// endpoint is the envelope URL and auth the X-Sentry-Auth header,
+	// both derived from the DSN.
+	endpoint string
+	auth     string
+
+	// pending tracks the events being sent, see Close.
+	pending sync.WaitGroup
+}
+
+var _ ports.ErrorReporter = (*Client)(nil)
+
+// New creates a new Sentry client.
+func New(cfg Config) (*Client, error) {
+	defaults := DefaultConfig()
+	if cfg.Environment == "" {
+		cfg.Environment = defaults.Environment
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+
+	dsn, err := url.Parse(cfg.DSN)
+	if err != nil || dsn.Host == "" || dsn.User == nil || dsn.User.Username() == "" {
+		return nil, fmt.Errorf("sentry: invalid DSN")
+	}
+	path := strings.Trim(dsn.Path, "/")
+	slash := strings.LastIndex(path, "/")
+	projectID := path[slash+1:]
+	if projectID == "" {
+		return nil, fmt.Errorf("sentry: DSN has no project ID")
+	}
+	prefix := ""
+	if slash >= 0 {
+		prefix = "/" + path[:slash]
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	return &Client{
+		config:     cfg,
+		httpClient: httpClient,
+		endpoint:   fmt.Sprintf("%s://%s%s/api/%s/envelope/", dsn.Scheme, dsn.Host, prefix, projectID),
+		auth: fmt.Sprintf("Sentry sentry_version=7, sentry_client=%s, sentry_key=%s",
+			clientName, dsn.User.Username()),
+	}, nil
+}
+
+// Report sends the event in the background. Failures to send are logged.
+func (c *Client) Report(ctx context.Context, event ports.ErrorEvent) {
+	c.pending.Add(1)
+	go func() {
+		defer c.pending.Done()
+
+		ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+		defer cancel()
+
+		if err := c.send(ctx, event); err != nil {
+			log.Warn().Err(err).Str("request_id", event.RequestID).Msg("Failed to report error to Sentry")
+		}
+	}()
+}
+
+// Close waits for the events being sent.
+func (c *Client) Close() error {
+	c.pending.Wait()
+	return nil
+}
+
+// sentryEvent is the subset of the Sentry event payload the adapter sends.
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Level       string            `json:"level"`
+	Platform    string            `json:"platform"`
+	Environment string            `json:"environment,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	Message     sentryMessage     `json:"message"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	User        *sentryUser       `json:"user,omitempty"`
+	Request     *sentryRequest    `json:"request,omitempty"`
+	Extra       map[string]string `json:"extra,omitempty"`
+}
+
+type sentryMessage struct {
+	Formatted string `json:"formatted"`
+}
+
+type sentryUser struct {
+	ID string `json:"id"`
+}
+
+type sentryRequest struct {
+	Method string `json:"method,omitempty"`
+	URL    string `json:"url,omitempty"`
+}
+
+// send posts one event as an envelope.
+func (c *Client) send(ctx context.Context, event ports.ErrorEvent) error {
+	if !c.config.SendPII {
+		event = scrub(event)
+	}
+
+	payload := sentryEvent{
+		EventID:     newEventID(),
+		Timestamp:   event.OccurredAt.UTC().Format(time.RFC3339Nano),
+		Level:       "error",
+		Platform:    "go",
+		Environment: c.config.Environment,
+		Release:     c.config.Release,
+		Message:     sentryMessage{Formatted: event.Message},
+	}
+
+	payload.Tags = make(map[string]string, len(event.Tags)+2)
+	for key, value := range event.Tags {
+		payload.Tags[key] = value
+	}
+	if event.RequestID != "" {
+		payload.Tags["request_id"] = event.RequestID
+	}
+	if event.UserID != "" {
+		payload.Tags["user_id"] = event.UserID
+		payload.User = &sentryUser{ID: event.UserID}
+	}
+	if event.Method != "" || event.URL != "" {
+		payload.Request = &sentryRequest{Method: event.Method, URL: event.URL}
+	}
+	if event.Stack != "" {
+		payload.Extra = map[string]string{"stack": event.Stack}
+	}
+
+	body, err := encodeEnvelope(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("sentry: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", c.auth)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sentry: send event: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("sentry: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Personal data patterns replaced when scrubbing events.
+var (
+	emailPattern  = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	phonePattern  = regexp.MustCompile(`\+\d[\d ().-]{6,}\d`)
+	bearerPattern = regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9._~+/=-]+`)
+	jwtPattern    = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+)
+
+// scrub removes e-mail addresses, phone numbers and tokens from the texts of
+// an event, and query strings, which may carry signatures, from its URL.
+func scrub(event ports.ErrorEvent) ports.ErrorEvent {
+	event.Message = scrubText(event.Message)
+	event.Stack = scrubText(event.Stack)
+	if i := strings.IndexByte(event.URL, '?'); i >= 0 {
+		event.URL = event.URL[:i]
+	}
+
+	tags := make(map[string]string, len(event.Tags))
+	for key, value := range event.Tags {
+		tags[key] = scrubText(value)
+	}
+	event.Tags = tags
+	return event
+}
+
+// scrubText replaces the personal data in text with placeholders.
+func scrubText(text string) string {
+	text = bearerPattern.ReplaceAllString(text, "Bearer [token]")
+	text = jwtPattern.ReplaceAllString(text, "[token]")
+	text = emailPattern.ReplaceAllString(text, "[email]")
+	return phonePattern.ReplaceAllString(text, "[phone]")
+}
+
+// encodeEnvelope writes the envelope header, item header and event, one
+// JSON document per line.
+func encodeEnvelope(event sentryEvent) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, part := range []any{
+		map[string]string{"event_id": event.EventID, "sent_at": time.Now().UTC().Format(time.RFC3339Nano)},
+		map[string]string{"type": "event"},
+		event,
+	} {
+		if err := enc.Encode(part); err != nil {
+			return nil, fmt.Errorf("sentry: encode envelope: %w", err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// newEventID returns a random 32 hex digit event ID.
+func newEventID() string {
+	var id [16]byte
+	_, _ = rand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
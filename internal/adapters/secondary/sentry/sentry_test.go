@@ -0,0 +1,137 @@
+// Package sentry_test contains unit tests for the Sentry error reporting adapter.
+package sentry_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/sentry"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("accepts a DSN", func(t *testing.T) {
+		_, err := sentry.New(sentry.Config{DSN: "https://public@o0.ingest.sentry.io/42"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects a DSN without key", func(t *testing.T) {
+		_, err := sentry.New(sentry.Config{DSN: "https://o0.ingest.sentry.io/42"})
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects a DSN without project", func(t *testing.T) {
+		_, err := sentry.New(sentry.Config{DSN: "https://public@o0.ingest.sentry.io/"})
+		assert.Error(t, err)
+	})
+}
+
+// reportedEvent is the part of a Sentry event the tests check.
+type reportedEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Environment string            `json:"environment"`
+	Release     string            `json:"release"`
+	Message     map[string]string `json:"message"`
+	Tags        map[string]string `json:"tags"`
+	User        map[string]string `json:"user"`
+	Request     map[string]string `json:"request"`
+	Extra       map[string]string `json:"extra"`
+}
+
+// report sends event through a client configured with cfg, pointed at a
+// test server, and returns the event the server received.
+func report(t *testing.T, cfg sentry.Config, event ports.ErrorEvent) reportedEvent {
+	t.Helper()
+
+	var lines []string
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/sentry/api/42/envelope/", r.URL.Path)
+		auth = r.Header.Get("X-Sentry-Auth")
+
+		scanner := bufio.NewScanner(r.Body)
+		scanner.Buffer(nil, 1<<20)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg.DSN = strings.Replace(server.URL, "://", "://public@", 1) + "/sentry/42"
+	client, err := sentry.New(cfg)
+	require.NoError(t, err)
+
+	client.Report(context.Background(), event)
+	require.NoError(t, client.Close())
+
+	assert.Contains(t, auth, "sentry_key=public")
+	require.Len(t, lines, 3)
+	assert.JSONEq(t, `{"type":"event"}`, lines[1])
+
+	var reported reportedEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &reported))
+	return reported
+}
+
+func TestReport(t *testing.T) {
+	event := report(t, sentry.Config{Environment: "staging", Release: "1.2.3"}, ports.ErrorEvent{
+		Message:    "panic: boom",
+		Stack:      "goroutine 1 [running]:",
+		RequestID:  "host/abc-000001",
+		Method:     http.MethodGet,
+		URL:        "/v1/resumes/resume-1",
+		UserID:     "user-123",
+		Tags:       map[string]string{"operation": "tailor resume", "resume_id": "resume-1"},
+		OccurredAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+
+	assert.Len(t, event.EventID, 32)
+	assert.Equal(t, "2025-01-02T03:04:05Z", event.Timestamp)
+	assert.Equal(t, "staging", event.Environment)
+	assert.Equal(t, "1.2.3", event.Release)
+	assert.Equal(t, "panic: boom", event.Message["formatted"])
+	assert.Equal(t, map[string]string{
+		"request_id": "host/abc-000001",
+		"user_id":    "user-123",
+		"operation":  "tailor resume",
+		"resume_id":  "resume-1",
+	}, event.Tags)
+	assert.Equal(t, "user-123", event.User["id"])
+	assert.Equal(t, "/v1/resumes/resume-1", event.Request["url"])
+	assert.Equal(t, "goroutine 1 [running]:", event.Extra["stack"])
+}
+
+func TestReportScrubsPII(t *testing.T) {
+	original := ports.ErrorEvent{
+		Message:    "failed to notify jane.doe@example.com at +55 (11) 91234-5678 with Bearer abc.def",
+		Stack:      "token eyJhbGciOi.eyJzdWIiOi.c2lnbmF0dXJl",
+		URL:        "/files/resumes/user-1/resume-1.pdf?expires=1&signature=secret",
+		OccurredAt: time.Now(),
+	}
+
+	t.Run("scrubs by default", func(t *testing.T) {
+		event := report(t, sentry.Config{}, original)
+
+		assert.Equal(t, "failed to notify [email] at [phone] with Bearer [token]", event.Message["formatted"])
+		assert.Equal(t, "token [token]", event.Extra["stack"])
+		assert.Equal(t, "/files/resumes/user-1/resume-1.pdf", event.Request["url"])
+	})
+
+	t.Run("sends PII when enabled", func(t *testing.T) {
+		event := report(t, sentry.Config{SendPII: true}, original)
+
+		assert.Equal(t, original.Message, event.Message["formatted"])
+		assert.Equal(t, original.URL, event.Request["url"])
+	})
+}
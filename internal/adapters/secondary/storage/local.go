@@ -5,12 +5,19 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/fs"
+	"net/http"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/signedurl"
 )
 
 // LocalConfig contains configuration for local file storage.
@@ -20,6 +27,13 @@ type LocalConfig struct {
 
 	// BaseURL is the base URL for serving files.
 	BaseURL string
+
+	// Signer, when set, makes file URLs signed and expiring. They must then be
+	// served by a handler that verifies the signature.
+	Signer *signedurl.Signer
+
+	// URLTTL is how long signed URLs stay valid.
+	URLTTL time.Duration
 }
 
 // DefaultLocalConfig returns default local storage configuration.
@@ -27,6 +41,7 @@ func DefaultLocalConfig() LocalConfig {
 	return LocalConfig{
 		BasePath: "./storage",
 		BaseURL:  "http://localhost:8080/files",
+		URLTTL:   15 * time.Minute,
 	}
 }
 
@@ -34,12 +49,19 @@ func DefaultLocalConfig() LocalConfig {
 type LocalStorage struct {
 	basePath string
 	baseURL  string
+	signer   *signedurl.Signer
+	urlTTL   time.Duration
 }
 
 // NewLocalStorage creates a new local file storage adapter.
 func NewLocalStorage(cfg LocalConfig) (*LocalStorage, error) {
+	defaults := DefaultLocalConfig()
 	if cfg.BasePath == "" {
-		cfg = DefaultLocalConfig()
+		cfg.BasePath = defaults.BasePath
+		cfg.BaseURL = defaults.BaseURL
+	}
+	if cfg.URLTTL == 0 {
+		cfg.URLTTL = defaults.URLTTL
 	}
 
 	// Ensure the base path exists
@@ -50,6 +72,8 @@ func NewLocalStorage(cfg LocalConfig) (*LocalStorage, error) {
 	return &LocalStorage{
 		basePath: cfg.BasePath,
 		baseURL:  cfg.BaseURL,
+		signer:   cfg.Signer,
+		urlTTL:   cfg.URLTTL,
 	}, nil
 }
 
@@ -60,6 +84,9 @@ func (s *LocalStorage) Upload(ctx context.Context, req ports.UploadRequest) (*po
 	if key == "" {
 		key = uuid.New().String()
 	}
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
 
 	// Create full path
 	fullPath := filepath.Join(s.basePath, key)
@@ -85,19 +112,23 @@ func (s *LocalStorage) Upload(ctx context.Context, req ports.UploadRequest) (*po
 
 	return &ports.UploadResult{
 		Key:  key,
-		URL:  fmt.Sprintf("%s/%s", s.baseURL, key),
+		URL:  s.fileURL(key),
 		Size: size,
 	}, nil
 }
 
 // Download retrieves a file from the local filesystem.
 func (s *LocalStorage) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+
 	fullPath := filepath.Join(s.basePath, key)
 
 	file, err := os.Open(fullPath)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("file not found: %s", key)
+			return nil, fmt.Errorf("%w: %s", domain.ErrFileNotFound, key)
 		}
 		return nil, fmt.Errorf("failed to open file: %w", err)
 	}
@@ -107,6 +138,10 @@ func (s *LocalStorage) Download(ctx context.Context, key string) (io.ReadCloser,
 
 // Delete removes a file from the local filesystem.
 func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+
 	fullPath := filepath.Join(s.basePath, key)
 
 	if err := os.Remove(fullPath); err != nil {
@@ -120,15 +155,182 @@ func (s *LocalStorage) Delete(ctx context.Context, key string) error {
 }
 
 // GetURL returns the URL for accessing a file.
+// Signed URLs are only issued for files in a user's directory.
 func (s *LocalStorage) GetURL(ctx context.Context, key string) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	if s.signer != nil {
+		if _, ok := KeyOwner(key); !ok {
+			return "", fmt.Errorf("%w: %s is not in a user directory", domain.ErrInvalidFileKey, key)
+		}
+	}
+
 	fullPath := filepath.Join(s.basePath, key)
 
 	// Check if file exists
 	if _, err := os.Stat(fullPath); os.IsNotExist(err) {
-		return "", fmt.Errorf("file not found: %s", key)
+		return "", fmt.Errorf("%w: %s", domain.ErrFileNotFound, key)
+	}
+
+	return s.fileURL(key), nil
+}
+
+// SignURL returns the URL for accessing a file without checking that it exists.
+// Signed URLs are only issued for files in a user's directory.
+func (s *LocalStorage) SignURL(key string) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	if s.signer != nil {
+		if _, ok := KeyOwner(key); !ok {
+			return "", fmt.Errorf("%w: %s is not in a user directory", domain.ErrInvalidFileKey, key)
+		}
+	}
+
+	return s.fileURL(key), nil
+}
+
+// List returns the files whose key starts with prefix.
+func (s *LocalStorage) List(ctx context.Context, prefix string) ([]ports.FileInfo, error) {
+	var files []ports.FileInfo
+
+	err := filepath.WalkDir(s.basePath, func(fullPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.basePath, fullPath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, ports.FileInfo{
+			Key:       key,
+			Size:      info.Size(),
+			UpdatedAt: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+
+	return files, nil
+}
+
+// fileURL returns the URL of a key, signed when a signer is configured.
+func (s *LocalStorage) fileURL(key string) string {
+	fileURL := fmt.Sprintf("%s/%s", s.baseURL, key)
+	if s.signer == nil {
+		return fileURL
+	}
+	return fileURL + "?" + s.signer.Sign(key, s.urlTTL).Encode()
+}
+
+// ValidateKey rejects keys that could escape the storage directory: absolute
+// paths, parent directory references and unclean paths.
+func ValidateKey(key string) error {
+	if key == "" || strings.Contains(key, "\\") || path.IsAbs(key) || path.Clean(key) != key || key == ".." || strings.HasPrefix(key, "../") {
+		return fmt.Errorf("%w: %q", domain.ErrInvalidFileKey, key)
+	}
+	return nil
+}
+
+// KeyOwner returns the user a key belongs to. User files are stored as
+// "<namespace>/<user ID>/<name>", e.g. "resumes/{userID}/{resumeID}.pdf".
+func KeyOwner(key string) (string, bool) {
+	parts := strings.SplitN(key, "/", 3)
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// servedContentTypes maps the extensions of files served over HTTP to their
+// content type. Files with other extensions, like import chunks, are never
+// served.
+var servedContentTypes = map[string]string{
+	".pdf": "application/pdf",
+}
+
+// Handler returns a handler serving stored files, with the request path
+// (stripped of any mount prefix) as the key. It does not check signatures:
+// mount it behind a middleware that does. Only files inside a user directory
+// are served, and only when their content matches their extension.
+func (s *LocalStorage) Handler() http.Handler {
+	return http.HandlerFunc(s.serveFile)
+}
+
+// serveFile serves one stored file.
+func (s *LocalStorage) serveFile(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	if ValidateKey(key) != nil {
+		http.NotFound(w, r)
+		return
+	}
+	owner, ok := KeyOwner(key)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	contentType, ok := servedContentTypes[strings.ToLower(path.Ext(key))]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	// Open the file through the owner's directory so that nothing, including
+	// symlinks, resolves outside of it.
+	parts := strings.SplitN(key, "/", 3)
+	root, err := os.OpenRoot(filepath.Join(s.basePath, parts[0], owner))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer root.Close()
+
+	file, err := root.Open(filepath.FromSlash(parts[2]))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+
+	// Refuse files whose content does not match their extension, e.g. HTML
+	// saved as .pdf, so browsers never render them as something else.
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(file, head)
+	if sniffed, _, _ := strings.Cut(http.DetectContentType(head[:n]), ";"); sniffed != contentType {
+		http.Error(w, "unsupported media type", http.StatusUnsupportedMediaType)
+		return
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
 	}
 
-	return fmt.Sprintf("%s/%s", s.baseURL, key), nil
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", path.Base(key)))
+	w.Header().Set("Cache-Control", "private, no-store")
+	http.ServeContent(w, r, path.Base(key), info.ModTime(), file)
 }
 
 // Close releases any resources held by the storage.
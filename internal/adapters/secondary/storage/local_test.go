@@ -5,15 +5,22 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/storage"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/signedurl"
 )
 
 func TestNewLocalStorage(t *testing.T) {
@@ -157,6 +164,7 @@ func TestLocalStorageDownload(t *testing.T) {
 	t.Run("returns error for non-existent file", func(t *testing.T) {
 		_, err := s.Download(ctx, "non-existent-file.txt")
 		require.Error(t, err)
+		assert.ErrorIs(t, err, domain.ErrFileNotFound)
 		assert.Contains(t, err.Error(), "file not found")
 	})
 }
@@ -251,3 +259,171 @@ func TestDefaultLocalConfig(t *testing.T) {
 	assert.Equal(t, "./storage", cfg.BasePath)
 	assert.Equal(t, "http://localhost:8080/files", cfg.BaseURL)
 }
+
+func TestValidateKey(t *testing.T) {
+	for _, key := range []string{"resumes/user-1/resume-1.pdf", "file.txt"} {
+		assert.NoError(t, storage.ValidateKey(key), key)
+	}
+	for _, key := range []string{"", "/etc/passwd", "../secret", "resumes/../../secret", "resumes//x.pdf", "resumes\\x.pdf", ".."} {
+		assert.ErrorIs(t, storage.ValidateKey(key), domain.ErrInvalidFileKey, key)
+	}
+}
+
+func TestKeyOwner(t *testing.T) {
+	owner, ok := storage.KeyOwner("resumes/user-1/resume-1.pdf")
+	assert.True(t, ok)
+	assert.Equal(t, "user-1", owner)
+
+	_, ok = storage.KeyOwner("resume-1.pdf")
+	assert.False(t, ok)
+}
+
+// pdfContent is the start of a minimal PDF document.
+var pdfContent = []byte("%PDF-1.7\n1 0 obj\n<<>>\nendobj\n")
+
+func newSignedStorage(t *testing.T) (*storage.LocalStorage, *signedurl.Signer, string) {
+	t.Helper()
+
+	signer, err := signedurl.New([]byte(strings.Repeat("k", signedurl.MinKeySize)))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	s, err := storage.NewLocalStorage(storage.LocalConfig{
+		BasePath: dir,
+		BaseURL:  "http://example.com/files",
+		Signer:   signer,
+		URLTTL:   time.Minute,
+	})
+	require.NoError(t, err)
+
+	return s, signer, dir
+}
+
+func TestLocalStorageSignedURLs(t *testing.T) {
+	s, signer, _ := newSignedStorage(t)
+	ctx := context.Background()
+	key := "resumes/user-1/resume-1.pdf"
+
+	result, err := s.Upload(ctx, ports.UploadRequest{Key: key, Content: bytes.NewReader(pdfContent)})
+	require.NoError(t, err)
+
+	for _, rawURL := range []string{result.URL, mustGetURL(t, s, key)} {
+		u, err := url.Parse(rawURL)
+		require.NoError(t, err)
+		assert.Equal(t, "/files/"+key, u.Path)
+		assert.NoError(t, signer.Verify(key, u.Query()))
+	}
+
+	t.Run("refuses keys outside a user directory", func(t *testing.T) {
+		_, err := s.Upload(ctx, ports.UploadRequest{Key: "shared.pdf", Content: bytes.NewReader(pdfContent)})
+		require.NoError(t, err)
+
+		_, err = s.GetURL(ctx, "shared.pdf")
+		assert.ErrorIs(t, err, domain.ErrInvalidFileKey)
+	})
+
+	t.Run("refuses path traversal", func(t *testing.T) {
+		_, err := s.Upload(ctx, ports.UploadRequest{Key: "../escape.pdf", Content: bytes.NewReader(pdfContent)})
+		assert.ErrorIs(t, err, domain.ErrInvalidFileKey)
+	})
+}
+
+func TestLocalStorageSignURL(t *testing.T) {
+	s, signer, _ := newSignedStorage(t)
+
+	t.Run("signs keys without checking the file exists", func(t *testing.T) {
+		key := "resumes/user-1/missing.pdf"
+		rawURL, err := s.SignURL(key)
+		require.NoError(t, err)
+
+		u, err := url.Parse(rawURL)
+		require.NoError(t, err)
+		assert.Equal(t, "/files/"+key, u.Path)
+		assert.NoError(t, signer.Verify(key, u.Query()))
+	})
+
+	t.Run("refuses keys outside a user directory", func(t *testing.T) {
+		_, err := s.SignURL("shared.pdf")
+		assert.ErrorIs(t, err, domain.ErrInvalidFileKey)
+	})
+
+	t.Run("refuses path traversal", func(t *testing.T) {
+		_, err := s.SignURL("resumes/user-1/../../escape.pdf")
+		assert.ErrorIs(t, err, domain.ErrInvalidFileKey)
+	})
+}
+
+func mustGetURL(t *testing.T, s *storage.LocalStorage, key string) string {
+	t.Helper()
+	u, err := s.GetURL(context.Background(), key)
+	require.NoError(t, err)
+	return u
+}
+
+func TestLocalStorageHandler(t *testing.T) {
+	s, _, dir := newSignedStorage(t)
+	ctx := context.Background()
+
+	upload := func(key string, content []byte) {
+		_, err := s.Upload(ctx, ports.UploadRequest{Key: key, Content: bytes.NewReader(content)})
+		require.NoError(t, err)
+	}
+	upload("resumes/user-1/resume-1.pdf", pdfContent)
+	upload("resumes/user-1/fake.pdf", []byte("<html><script>alert(1)</script></html>"))
+	upload("imports/user-1/UPLOAD/000", []byte("title,organization\n"))
+	upload("loose.pdf", pdfContent)
+
+	// A symlink in user-2's directory pointing into user-1's.
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "resumes", "user-2"), 0o755))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "resumes", "user-1", "resume-1.pdf"), filepath.Join(dir, "resumes", "user-2", "stolen.pdf")))
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+	}{
+		{"serves a PDF", "/resumes/user-1/resume-1.pdf", http.StatusOK},
+		{"refuses content that is not a PDF", "/resumes/user-1/fake.pdf", http.StatusUnsupportedMediaType},
+		{"hides files without a served extension", "/imports/user-1/UPLOAD/000", http.StatusNotFound},
+		{"hides files outside user directories", "/loose.pdf", http.StatusNotFound},
+		{"hides files reached through symlinks", "/resumes/user-2/stolen.pdf", http.StatusNotFound},
+		{"hides missing files", "/resumes/user-1/missing.pdf", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			s.Handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "application/pdf", rr.Header().Get("Content-Type"))
+				assert.Equal(t, "nosniff", rr.Header().Get("X-Content-Type-Options"))
+				assert.Equal(t, pdfContent, rr.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestLocalStorageList(t *testing.T) {
+	s, err := storage.NewLocalStorage(storage.LocalConfig{BasePath: t.TempDir()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, key := range []string{"resumes/user-1/a.pdf", "resumes/user-2/b.pdf", "imports/user-1/UPLOAD/000"} {
+		_, err := s.Upload(ctx, ports.UploadRequest{Key: key, Content: bytes.NewReader(pdfContent)})
+		require.NoError(t, err)
+	}
+
+	files, err := s.List(ctx, "resumes/")
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	assert.Equal(t, "resumes/user-1/a.pdf", files[0].Key)
+	assert.Equal(t, "resumes/user-2/b.pdf", files[1].Key)
+	assert.Equal(t, int64(len(pdfContent)), files[0].Size)
+	assert.False(t, files[0].UpdatedAt.IsZero())
+
+	files, err = s.List(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+}
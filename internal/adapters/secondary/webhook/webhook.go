@@ -0,0 +1,117 @@
+// Package webhook provides an event publisher that delivers outbox events to
+// an HTTP endpoint.
+//
+// Each event is POSTed as JSON. The X-Chameleon-Event header names the event
+// type and X-Chameleon-Delivery its ID, which stays the same across retries.
+// When a secret is configured, X-Chameleon-Signature holds
+// "sha256=" followed by the hex HMAC-SHA256 of the body keyed by the secret.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// Config holds webhook configuration.
+type Config struct {
+	// URL is the endpoint receiving the events.
+	URL string
+
+	// Secret, when set, signs the request bodies.
+	Secret string
+
+	// Timeout bounds delivering one event.
+	Timeout time.Duration
+
+	// HTTPClient, when set, is used for requests instead of a client built
+	// from Timeout, e.g. one sharing a pkg/httpclient transport.
+	HTTPClient *http.Client
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		Timeout: 10 * time.Second,
+	}
+}
+
+// Client implements ports.EventPublisher by POSTing events to a webhook.
+type Client struct {
+	config     Config
+	httpClient *http.Client
+}
+
+var _ ports.EventPublisher = (*Client)(nil)
+
+// New creates a new webhook client.
+func New(cfg Config) (*Client, error) {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultConfig().Timeout
+	}
+
+	endpoint, err := url.Parse(cfg.URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, fmt.Errorf("webhook: URL must be an absolute http or https URL")
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	return &Client{config: cfg, httpClient: httpClient}, nil
+}
+
+// Publish delivers an event. Responses other than 2xx are errors.
+func (c *Client) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("webhook: encode event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Chameleon-Event", string(event.Type))
+	req.Header.Set("X-Chameleon-Delivery", event.ID)
+	if c.config.Secret != "" {
+		req.Header.Set("X-Chameleon-Signature", "sha256="+Sign(c.config.Secret, body))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: send event: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the hex HMAC-SHA256 of body keyed by secret, as sent in the
+// X-Chameleon-Signature header.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
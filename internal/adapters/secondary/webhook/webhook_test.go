@@ -0,0 +1,95 @@
+// Package webhook_test contains unit tests for the webhook event publisher.
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/adapters/secondary/webhook"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("accepts an https URL", func(t *testing.T) {
+		_, err := webhook.New(webhook.Config{URL: "https://hooks.example.com/chameleon"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects a relative URL", func(t *testing.T) {
+		_, err := webhook.New(webhook.Config{URL: "/chameleon"})
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects other schemes", func(t *testing.T) {
+		_, err := webhook.New(webhook.Config{URL: "ftp://hooks.example.com/chameleon"})
+		assert.Error(t, err)
+	})
+}
+
+func TestPublish(t *testing.T) {
+	event := domain.OutboxEvent{
+		ID:        "event-1",
+		Type:      domain.EventResumeStatusChanged,
+		UserID:    "user-1",
+		ResumeID:  "resume-1",
+		Data:      map[string]string{"from": "draft", "to": "applied"},
+		CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	t.Run("posts the signed event", func(t *testing.T) {
+		var body []byte
+		var header http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ = io.ReadAll(r.Body)
+			header = r.Header
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client, err := webhook.New(webhook.Config{URL: server.URL, Secret: "s3cret"})
+		require.NoError(t, err)
+		require.NoError(t, client.Publish(context.Background(), event))
+
+		assert.Equal(t, "resume.status_changed", header.Get("X-Chameleon-Event"))
+		assert.Equal(t, "event-1", header.Get("X-Chameleon-Delivery"))
+		assert.Equal(t, "sha256="+webhook.Sign("s3cret", body), header.Get("X-Chameleon-Signature"))
+
+		var received domain.OutboxEvent
+		require.NoError(t, json.Unmarshal(body, &received))
+		assert.Equal(t, event.ID, received.ID)
+		assert.Equal(t, event.ResumeID, received.ResumeID)
+		assert.Equal(t, "applied", received.Data["to"])
+	})
+
+	t.Run("omits the signature without a secret", func(t *testing.T) {
+		var header http.Header
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header
+		}))
+		defer server.Close()
+
+		client, err := webhook.New(webhook.Config{URL: server.URL})
+		require.NoError(t, err)
+		require.NoError(t, client.Publish(context.Background(), event))
+		assert.Empty(t, header.Get("X-Chameleon-Signature"))
+	})
+
+	t.Run("fails on error statuses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client, err := webhook.New(webhook.Config{URL: server.URL})
+		require.NoError(t, err)
+		assert.Error(t, client.Publish(context.Background(), event))
+	})
+}
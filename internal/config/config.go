@@ -3,22 +3,39 @@ package config
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/viper"
+
+	"github.com/SeltikHD/chameleon-vitae/pkg/signedurl"
 )
 
 // Config holds all application configuration.
 type Config struct {
-	App      AppConfig
-	Server   ServerConfig
-	Database DatabaseConfig
-	Firebase FirebaseConfig
-	Groq     GroqConfig
-	Jina     JinaConfig
-	PDF      PDFConfig
-	Storage  StorageConfig
+	App            AppConfig
+	Server         ServerConfig
+	Database       DatabaseConfig
+	Auth           AuthConfig
+	Firebase       FirebaseConfig
+	OIDC           OIDCConfig
+	Groq           GroqConfig
+	Jina           JinaConfig
+	Embeddings     EmbeddingsConfig
+	Tailoring      TailoringConfig
+	Rescoring      RescoringConfig
+	PDF            PDFConfig
+	Storage        StorageConfig
+	Avatars        AvatarsConfig
+	VirusScan      VirusScanConfig
+	ErrorReporting ErrorReportingConfig
+	Outbox         OutboxConfig
+	Webhooks       WebhooksConfig
+	EventBus       EventBusConfig
+	CircuitBreaker CircuitBreakerConfig
+	HTTPClient     HTTPClientConfig
+	Plans          PlansConfig
 }
 
 // AppConfig contains general application settings.
@@ -36,10 +53,36 @@ type ServerConfig struct {
 	ReadTimeout     time.Duration
 	WriteTimeout    time.Duration
 	IdleTimeout     time.Duration
-	MaxRequestSize  int64
 	AllowedOrigins  []string
 	EnableSwagger   bool
 	EnableProfiling bool
+
+	// MaxRequestSize is the body size limit, in bytes, of routes without
+	// one in RequestSizeLimits, which holds limits per path prefix.
+	MaxRequestSize    int64
+	RequestSizeLimits map[string]int64
+
+	// AccessLog configures request logging: excluded paths, per path-prefix
+	// sample rates (0-1) and the rate for all other paths.
+	AccessLogExcludePaths      []string
+	AccessLogSampleRates       map[string]float64
+	AccessLogDefaultSampleRate float64
+
+	// AdminUserIDs are the IDs of the users allowed to call the /v1/admin
+	// endpoints.
+	AdminUserIDs []string
+
+	// ImpersonationTTL bounds how long the impersonation tokens of admins
+	// are valid. ImpersonationSigningKey signs them; when empty a random key
+	// is generated at startup, so tokens stop working on restart and are
+	// only accepted by the instance that issued them.
+	ImpersonationTTL        time.Duration
+	ImpersonationSigningKey string
+
+	// V1DeprecatedAt, when set, deprecates the v1 API in favor of v2, and
+	// V1SunsetAt announces when v1 will stop responding.
+	V1DeprecatedAt time.Time
+	V1SunsetAt     time.Time
 }
 
 // DatabaseConfig contains PostgreSQL connection settings.
@@ -55,6 +98,25 @@ type DatabaseConfig struct {
 	ConnMaxLifetime   time.Duration
 	ConnMaxIdleTime   time.Duration
 	HealthCheckPeriod time.Duration
+
+	// ReplicaDSN is the optional connection string of a read-only replica
+	// that list queries are sent to.
+	ReplicaDSN string
+
+	// RowLevelSecurity scopes the queries of user requests to their user
+	// with the row-level security policies of the schema.
+	RowLevelSecurity bool
+}
+
+// AuthConfig selects the authentication provider: "firebase" (default) or
+// "oidc" (any OpenID Connect identity provider).
+type AuthConfig struct {
+	Provider string
+
+	// TrustedEmailProviders lists the sign-in providers (the provider claim
+	// of tokens) whose verified emails link new sign-ins to existing
+	// accounts.
+	TrustedEmailProviders []string
 }
 
 // FirebaseConfig contains Firebase authentication settings.
@@ -64,6 +126,25 @@ type FirebaseConfig struct {
 	CredentialsJSON string
 }
 
+// OIDCConfig contains OpenID Connect authentication settings.
+type OIDCConfig struct {
+	IssuerURL           string
+	ClientID            string
+	ClientSecret        string
+	JWKSURL             string
+	JWKSRefreshInterval time.Duration
+	ClockSkew           time.Duration
+	Timeout             time.Duration
+
+	// Claims map token claims to user fields; dots address nested claims.
+	ClaimUserID        string
+	ClaimEmail         string
+	ClaimEmailVerified string
+	ClaimName          string
+	ClaimPicture       string
+	ClaimProvider      string
+}
+
 // GroqConfig contains Groq AI provider settings.
 type GroqConfig struct {
 	APIKey         string
@@ -72,27 +153,244 @@ type GroqConfig struct {
 	AnalysisModel  string
 	MaxRetries     int
 	RequestTimeout time.Duration
+
+	// MaxRepairAttempts is how many times malformed JSON replies are sent back for repair.
+	MaxRepairAttempts int
+
+	// CacheTTL enables caching of identical completions (0 disables).
+	CacheTTL        time.Duration
+	CacheMaxEntries int
+
+	// MaxConcurrentRequests caps in-flight Groq requests; excess requests queue (negative disables).
+	MaxConcurrentRequests int
+
+	// MaxPromptTokens caps the estimated size of bullet selection prompts;
+	// larger bullet libraries are selected in chunks (negative disables).
+	MaxPromptTokens int
+
+	// DefaultModelPrice and AnalysisModelPrice are the prices of the models,
+	// for tailoring cost estimates (zero leaves the cost out).
+	DefaultModelPrice  ModelPriceConfig
+	AnalysisModelPrice ModelPriceConfig
+}
+
+// ModelPriceConfig is the price of an AI model, in USD per million tokens.
+type ModelPriceConfig struct {
+	Input  float64
+	Output float64
 }
 
 // JinaConfig contains Jina Reader settings.
 type JinaConfig struct {
-	APIKey  string
-	BaseURL string
-	Timeout time.Duration
+	APIKey               string
+	BaseURL              string
+	Timeout              time.Duration
+	AllowedDomains       []string
+	BlockedDomains       []string
+	AllowPrivateNetworks bool
+	IgnoreRobots         bool
+	UserAgent            string
 }
 
-// PDFConfig contains Gotenberg PDF engine settings.
+// EmbeddingsConfig contains embeddings provider settings used to pre-rank
+// bullets before LLM selection. Provider is "" (disabled) or "openai" (any
+// OpenAI-compatible /embeddings endpoint, including Ollama and LM Studio).
+type EmbeddingsConfig struct {
+	Provider     string
+	BaseURL      string
+	APIKey       string
+	Model        string
+	Dimensions   int
+	BatchSize    int
+	Timeout      time.Duration
+	PreRankLimit int
+}
+
+// TailoringConfig contains the ranking of bullets before LLM selection, which
+// puts bullets from recent experiences and with high impact scores first, and
+// the time budgets of the AI stages of tailoring.
+type TailoringConfig struct {
+	// RecencyHalfLifeYears is how many years after an experience ended its
+	// bullets weigh half as much as current ones; 0 disables the decay.
+	RecencyHalfLifeYears float64
+
+	// ImpactWeight, from 0 to 1, is how much bullet impact scores count.
+	ImpactWeight float64
+
+	// Stage budgets; 0 bounds a stage only by the request.
+	AnalysisTimeout  time.Duration
+	SelectionTimeout time.Duration
+	TailorTimeout    time.Duration
+	SummaryTimeout   time.Duration
+	ScoringTimeout   time.Duration
+	ThemesTimeout    time.Duration
+}
+
+// RescoringConfig contains settings for recomputing the impact scores of the
+// bullets added or edited since they were last scored.
+type RescoringConfig struct {
+	// Interval is how often bullets are rescored; 0 disables rescoring.
+	Interval time.Duration
+
+	// BatchSize is the number of bullets scored per AI call.
+	BatchSize int
+
+	// MaxBullets is the most bullets scored per run.
+	MaxBullets int
+
+	// Pause is the wait between AI calls.
+	Pause time.Duration
+}
+
+// PDFConfig contains PDF engine settings.
+// Engine selects "gotenberg" (default) or "chromium" (local headless browser).
 type PDFConfig struct {
-	BaseURL string
-	Timeout time.Duration
+	Engine              string
+	BaseURL             string
+	Timeout             time.Duration
+	MaxRetries          int
+	HealthCheckInterval time.Duration
+	ChromiumPath        string
+	ChromiumNoSandbox   bool
+
+	// TemplateDir is a directory of custom HTML resume templates (Go
+	// templates named <name>.html) loaded at startup; empty for none.
+	TemplateDir string
 }
 
 // StorageConfig contains file storage settings.
 type StorageConfig struct {
-	Type      string // "local" or "s3" or "gcs"
+	Type      string // "local" or "gcs"
 	LocalPath string
 	S3Bucket  string
 	S3Region  string
+
+	// GCSBucket and GCSCredentialsFile configure the "gcs" type. Without a
+	// credentials file, Application Default Credentials are used.
+	GCSBucket          string
+	GCSCredentialsFile string
+
+	// SigningKey signs the expiring URLs of local files. When empty a random
+	// key is generated at startup, so URLs stop working on restart.
+	SigningKey string
+
+	// URLTTL is how long signed file URLs stay valid.
+	URLTTL time.Duration
+
+	// CleanupInterval is how often PDFs left behind by deleted resumes are
+	// deleted; 0 disables the cleanup.
+	CleanupInterval time.Duration
+}
+
+// AvatarsConfig contains settings for caching the profile pictures of
+// identity providers in file storage, served on /v1/users/{userID}/avatar.
+// Pictures are only downloaded from AllowedDomains and their subdomains.
+type AvatarsConfig struct {
+	Cache          bool
+	AllowedDomains []string
+	Size           int
+	MaxBytes       int64
+	Timeout        time.Duration
+}
+
+// VirusScanConfig contains settings for scanning imported files. Provider is
+// "" (disabled) or "clamav" (a clamd daemon reached over Network and Address).
+type VirusScanConfig struct {
+	Provider string
+	Network  string
+	Address  string
+	Timeout  time.Duration
+}
+
+// ErrorReportingConfig contains settings for reporting the panics recovered
+// while serving requests and the unexpected errors of tailoring, exporting
+// and critiquing resumes. Provider is "" (disabled) or "sentry" (the project
+// of DSN, tagged with the app environment and version).
+type ErrorReportingConfig struct {
+	Provider string
+	DSN      string
+	Timeout  time.Duration
+
+	// SendPII disables scrubbing e-mail addresses, phone numbers, tokens and
+	// URL query strings from the reports.
+	SendPII bool
+}
+
+// OutboxConfig contains settings for delivering the events of the outbox,
+// which is used when webhooks or an event bus are configured.
+type OutboxConfig struct {
+	// PollInterval is how often the outbox is checked for due events.
+	PollInterval time.Duration
+
+	// BatchSize is the number of events delivered per poll.
+	BatchSize int
+
+	// Retention is how long delivered events are kept.
+	Retention time.Duration
+}
+
+// WebhooksConfig contains settings for delivering events to a webhook. An
+// empty URL disables the webhook.
+type WebhooksConfig struct {
+	URL     string
+	Secret  string
+	Timeout time.Duration
+}
+
+// EventBusConfig contains settings for publishing events to a message bus.
+// Provider is "" (disabled), "nats" (JetStream, one subject per event type
+// under SubjectPrefix) or "kafka" (Topic, through a Kafka REST Proxy).
+type EventBusConfig struct {
+	Provider string
+	Timeout  time.Duration
+
+	NATSURL       string
+	NATSToken     string
+	NATSCredsFile string
+	SubjectPrefix string
+
+	KafkaRESTURL string
+	Topic        string
+}
+
+// CircuitBreakerConfig contains the circuit breaker settings shared by the
+// external service adapters (Groq, Jina, Gotenberg, embeddings and ClamAV).
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens a breaker.
+	FailureThreshold int
+
+	// OpenTimeout is how long an open breaker rejects calls before probing again.
+	OpenTimeout time.Duration
+}
+
+// HTTPClientConfig contains the outbound HTTP transport settings shared by
+// the external service adapters. Each adapter keeps its own request timeout.
+type HTTPClientConfig struct {
+	// ProxyURL overrides the HTTP_PROXY/HTTPS_PROXY/NO_PROXY environment variables.
+	ProxyURL string
+
+	// CAFiles are extra PEM root CAs trusted for outbound TLS.
+	CAFiles []string
+
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	MaxConnsPerHost     int
+	IdleConnTimeout     time.Duration
+	DialTimeout         time.Duration
+	TLSHandshakeTimeout time.Duration
+}
+
+// PlansConfig contains the usage limits of each subscription plan.
+type PlansConfig struct {
+	Free PlanLimitsConfig
+	Pro  PlanLimitsConfig
+}
+
+// PlanLimitsConfig contains the usage limits of a plan. A zero limit means unlimited.
+type PlanLimitsConfig struct {
+	ResumesPerMonth          int
+	TailorsPerDay            int
+	PDFRegenerationsPerMonth int
 }
 
 // Load loads configuration from environment variables and config files.
@@ -149,10 +447,23 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("server.readTimeout", "15s")
 	v.SetDefault("server.writeTimeout", "30s")
 	v.SetDefault("server.idleTimeout", "60s")
-	v.SetDefault("server.maxRequestSize", 10*1024*1024) // 10MB
+	v.SetDefault("server.maxRequestSize", 1024*1024) // 1MB
+	v.SetDefault("server.requestSizeLimits", map[string]int64{
+		"/v1/import":             10 * 1024 * 1024,
+		"/v2/import":             10 * 1024 * 1024,
+		"/v1/admin/users/import": 50 * 1024 * 1024,
+	})
 	v.SetDefault("server.allowedOrigins", []string{"*"})
 	v.SetDefault("server.enableSwagger", true)
 	v.SetDefault("server.enableProfiling", false)
+	v.SetDefault("server.accessLog.excludePaths", []string{"/health", "/ping"})
+	v.SetDefault("server.accessLog.sampleRates", map[string]float64{})
+	v.SetDefault("server.accessLog.defaultSampleRate", 1.0)
+	v.SetDefault("server.adminUserIds", []string{})
+	v.SetDefault("server.impersonation.ttl", "15m")
+	v.SetDefault("server.impersonation.signingKey", "")
+	v.SetDefault("server.v1DeprecatedAt", "")
+	v.SetDefault("server.v1SunsetAt", "")
 
 	// Database defaults
 	v.SetDefault("database.host", "localhost")
@@ -166,12 +477,33 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("database.connMaxLifetime", "30m")
 	v.SetDefault("database.connMaxIdleTime", "5m")
 	v.SetDefault("database.healthCheckPeriod", "1m")
+	v.SetDefault("database.replicaDsn", "")
+	v.SetDefault("database.rowLevelSecurity", false)
+
+	// Auth defaults
+	v.SetDefault("auth.provider", "firebase")
+	v.SetDefault("auth.trustedEmailProviders", []string{"google.com"})
 
 	// Firebase defaults
 	v.SetDefault("firebase.projectId", "")
 	v.SetDefault("firebase.credentialsFile", "")
 	v.SetDefault("firebase.credentialsJson", "")
 
+	// OIDC defaults
+	v.SetDefault("oidc.issuerUrl", "")
+	v.SetDefault("oidc.clientId", "")
+	v.SetDefault("oidc.clientSecret", "")
+	v.SetDefault("oidc.jwksUrl", "")
+	v.SetDefault("oidc.jwksRefreshInterval", "1h")
+	v.SetDefault("oidc.clockSkew", "1m")
+	v.SetDefault("oidc.timeout", "10s")
+	v.SetDefault("oidc.claims.userId", "sub")
+	v.SetDefault("oidc.claims.email", "email")
+	v.SetDefault("oidc.claims.emailVerified", "email_verified")
+	v.SetDefault("oidc.claims.name", "name")
+	v.SetDefault("oidc.claims.picture", "picture")
+	v.SetDefault("oidc.claims.provider", "")
+
 	// Groq defaults
 	v.SetDefault("groq.apiKey", "")
 	v.SetDefault("groq.baseUrl", "https://api.groq.com/openai/v1")
@@ -179,21 +511,133 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("groq.analysisModel", "llama-4-scout-17b-16e-instruct")
 	v.SetDefault("groq.maxRetries", 3)
 	v.SetDefault("groq.requestTimeout", "60s")
+	v.SetDefault("groq.maxRepairAttempts", 2)
+	v.SetDefault("groq.cacheTtl", "0s")
+	v.SetDefault("groq.cacheMaxEntries", 1000)
+	v.SetDefault("groq.maxConcurrentRequests", 4)
+	v.SetDefault("groq.maxPromptTokens", 8000)
+	v.SetDefault("groq.defaultModelPrice.input", 0.59)
+	v.SetDefault("groq.defaultModelPrice.output", 0.79)
+	v.SetDefault("groq.analysisModelPrice.input", 0.11)
+	v.SetDefault("groq.analysisModelPrice.output", 0.34)
 
 	// Jina defaults
 	v.SetDefault("jina.apiKey", "")
 	v.SetDefault("jina.baseUrl", "https://r.jina.ai")
 	v.SetDefault("jina.timeout", "30s")
+	v.SetDefault("jina.allowedDomains", []string{})
+	v.SetDefault("jina.blockedDomains", []string{})
+	v.SetDefault("jina.allowPrivateNetworks", false)
+	v.SetDefault("jina.ignoreRobots", false)
+	v.SetDefault("jina.userAgent", "ChameleonVitaeBot/1.0")
+
+	// Embeddings defaults
+	v.SetDefault("embeddings.provider", "")
+	v.SetDefault("embeddings.baseUrl", "https://api.openai.com/v1")
+	v.SetDefault("embeddings.apiKey", "")
+	v.SetDefault("embeddings.model", "text-embedding-3-small")
+	v.SetDefault("embeddings.dimensions", 0)
+	v.SetDefault("embeddings.batchSize", 100)
+	v.SetDefault("embeddings.timeout", "30s")
+	v.SetDefault("embeddings.preRankLimit", 60)
+
+	// Tailoring defaults
+	v.SetDefault("tailoring.recencyHalfLifeYears", 5)
+	v.SetDefault("tailoring.impactWeight", 0.5)
+	v.SetDefault("tailoring.analysisTimeout", "20s")
+	v.SetDefault("tailoring.selectionTimeout", "20s")
+	v.SetDefault("tailoring.tailorTimeout", "45s")
+	v.SetDefault("tailoring.summaryTimeout", "20s")
+	v.SetDefault("tailoring.scoringTimeout", "15s")
+	v.SetDefault("tailoring.themesTimeout", "15s")
+
+	// Rescoring defaults
+	v.SetDefault("rescoring.interval", "24h")
+	v.SetDefault("rescoring.batchSize", 20)
+	v.SetDefault("rescoring.maxBullets", 1000)
+	v.SetDefault("rescoring.pause", "2s")
 
 	// PDF defaults
+	v.SetDefault("pdf.engine", "gotenberg")
 	v.SetDefault("pdf.baseUrl", "http://localhost:3000")
 	v.SetDefault("pdf.timeout", "60s")
+	v.SetDefault("pdf.maxRetries", 3)
+	v.SetDefault("pdf.healthCheckInterval", "30s")
+	v.SetDefault("pdf.chromiumPath", "")
+	v.SetDefault("pdf.chromiumNoSandbox", false)
+	v.SetDefault("pdf.templateDir", "")
 
 	// Storage defaults
 	v.SetDefault("storage.type", "local")
 	v.SetDefault("storage.localPath", "./storage")
 	v.SetDefault("storage.s3Bucket", "")
 	v.SetDefault("storage.s3Region", "")
+	v.SetDefault("storage.gcsBucket", "")
+	v.SetDefault("storage.gcsCredentialsFile", "")
+	v.SetDefault("storage.signingKey", "")
+	v.SetDefault("storage.urlTtl", "15m")
+	v.SetDefault("storage.cleanupInterval", "24h")
+
+	// Avatar defaults
+	v.SetDefault("avatars.cache", true)
+	v.SetDefault("avatars.allowedDomains", []string{"googleusercontent.com", "githubusercontent.com", "gravatar.com"})
+	v.SetDefault("avatars.size", 256)
+	v.SetDefault("avatars.maxBytes", 5*1024*1024) // 5MB
+	v.SetDefault("avatars.timeout", "10s")
+
+	// Virus scan defaults
+	v.SetDefault("virusScan.provider", "")
+	v.SetDefault("virusScan.network", "tcp")
+	v.SetDefault("virusScan.address", "localhost:3310")
+	v.SetDefault("virusScan.timeout", "30s")
+
+	// Error reporting defaults
+	v.SetDefault("errorReporting.provider", "")
+	v.SetDefault("errorReporting.dsn", "")
+	v.SetDefault("errorReporting.timeout", "5s")
+	v.SetDefault("errorReporting.sendPii", false)
+
+	// Outbox defaults
+	v.SetDefault("outbox.pollInterval", "5s")
+	v.SetDefault("outbox.batchSize", 50)
+	v.SetDefault("outbox.retention", "168h")
+
+	// Webhooks defaults
+	v.SetDefault("webhooks.url", "")
+	v.SetDefault("webhooks.secret", "")
+	v.SetDefault("webhooks.timeout", "10s")
+
+	// Event bus defaults
+	v.SetDefault("eventBus.provider", "")
+	v.SetDefault("eventBus.timeout", "10s")
+	v.SetDefault("eventBus.natsUrl", "nats://localhost:4222")
+	v.SetDefault("eventBus.natsToken", "")
+	v.SetDefault("eventBus.natsCredsFile", "")
+	v.SetDefault("eventBus.subjectPrefix", "chameleon")
+	v.SetDefault("eventBus.kafkaRestUrl", "http://localhost:8082")
+	v.SetDefault("eventBus.topic", "chameleon-events")
+
+	// Circuit breaker defaults
+	v.SetDefault("circuitBreaker.failureThreshold", 5)
+	v.SetDefault("circuitBreaker.openTimeout", "30s")
+
+	// Plan limits defaults
+	v.SetDefault("plans.free.resumesPerMonth", 10)
+	v.SetDefault("plans.free.tailorsPerDay", 20)
+	v.SetDefault("plans.free.pdfRegenerationsPerMonth", 50)
+	v.SetDefault("plans.pro.resumesPerMonth", 200)
+	v.SetDefault("plans.pro.tailorsPerDay", 200)
+	v.SetDefault("plans.pro.pdfRegenerationsPerMonth", 1000)
+
+	// Outbound HTTP client defaults
+	v.SetDefault("httpClient.proxyUrl", "")
+	v.SetDefault("httpClient.caFiles", []string{})
+	v.SetDefault("httpClient.maxIdleConns", 100)
+	v.SetDefault("httpClient.maxIdleConnsPerHost", 10)
+	v.SetDefault("httpClient.maxConnsPerHost", 0)
+	v.SetDefault("httpClient.idleConnTimeout", "90s")
+	v.SetDefault("httpClient.dialTimeout", "10s")
+	v.SetDefault("httpClient.tlsHandshakeTimeout", "10s")
 }
 
 // unmarshalConfig unmarshals viper config into the Config struct.
@@ -211,9 +655,42 @@ func unmarshalConfig(v *viper.Viper, cfg *Config) error {
 	cfg.Server.WriteTimeout = v.GetDuration("server.writeTimeout")
 	cfg.Server.IdleTimeout = v.GetDuration("server.idleTimeout")
 	cfg.Server.MaxRequestSize = v.GetInt64("server.maxRequestSize")
+	cfg.Server.RequestSizeLimits = make(map[string]int64)
+	for prefix, raw := range v.GetStringMapString("server.requestSizeLimits") {
+		limit, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return fmt.Errorf("server.requestSizeLimits[%s] must be a number of bytes", prefix)
+		}
+		cfg.Server.RequestSizeLimits[prefix] = limit
+	}
 	cfg.Server.AllowedOrigins = v.GetStringSlice("server.allowedOrigins")
 	cfg.Server.EnableSwagger = v.GetBool("server.enableSwagger")
 	cfg.Server.EnableProfiling = v.GetBool("server.enableProfiling")
+	cfg.Server.AccessLogExcludePaths = v.GetStringSlice("server.accessLog.excludePaths")
+	cfg.Server.AccessLogDefaultSampleRate = v.GetFloat64("server.accessLog.defaultSampleRate")
+	cfg.Server.AdminUserIDs = v.GetStringSlice("server.adminUserIds")
+	cfg.Server.ImpersonationTTL = v.GetDuration("server.impersonation.ttl")
+	cfg.Server.ImpersonationSigningKey = v.GetString("server.impersonation.signingKey") // pragma: allowlist secret
+	for key, dst := range map[string]*time.Time{
+		"server.v1DeprecatedAt": &cfg.Server.V1DeprecatedAt,
+		"server.v1SunsetAt":     &cfg.Server.V1SunsetAt,
+	} {
+		if raw := v.GetString(key); raw != "" {
+			t, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				return fmt.Errorf("%s must be an RFC 3339 time", key)
+			}
+			*dst = t
+		}
+	}
+	cfg.Server.AccessLogSampleRates = make(map[string]float64)
+	for prefix, raw := range v.GetStringMapString("server.accessLog.sampleRates") {
+		rate, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("server.accessLog.sampleRates[%s] must be a number", prefix)
+		}
+		cfg.Server.AccessLogSampleRates[prefix] = rate
+	}
 
 	// Database
 	cfg.Database.Host = v.GetString("database.host")
@@ -227,12 +704,33 @@ func unmarshalConfig(v *viper.Viper, cfg *Config) error {
 	cfg.Database.ConnMaxLifetime = v.GetDuration("database.connMaxLifetime")
 	cfg.Database.ConnMaxIdleTime = v.GetDuration("database.connMaxIdleTime")
 	cfg.Database.HealthCheckPeriod = v.GetDuration("database.healthCheckPeriod")
+	cfg.Database.ReplicaDSN = v.GetString("database.replicaDsn")
+	cfg.Database.RowLevelSecurity = v.GetBool("database.rowLevelSecurity")
+
+	// Auth
+	cfg.Auth.Provider = v.GetString("auth.provider")
+	cfg.Auth.TrustedEmailProviders = v.GetStringSlice("auth.trustedEmailProviders")
 
 	// Firebase
 	cfg.Firebase.ProjectID = v.GetString("firebase.projectId")
 	cfg.Firebase.CredentialsFile = v.GetString("firebase.credentialsFile")
 	cfg.Firebase.CredentialsJSON = v.GetString("firebase.credentialsJson")
 
+	// OIDC
+	cfg.OIDC.IssuerURL = v.GetString("oidc.issuerUrl")
+	cfg.OIDC.ClientID = v.GetString("oidc.clientId")
+	cfg.OIDC.ClientSecret = v.GetString("oidc.clientSecret") // pragma: allowlist secret
+	cfg.OIDC.JWKSURL = v.GetString("oidc.jwksUrl")
+	cfg.OIDC.JWKSRefreshInterval = v.GetDuration("oidc.jwksRefreshInterval")
+	cfg.OIDC.ClockSkew = v.GetDuration("oidc.clockSkew")
+	cfg.OIDC.Timeout = v.GetDuration("oidc.timeout")
+	cfg.OIDC.ClaimUserID = v.GetString("oidc.claims.userId")
+	cfg.OIDC.ClaimEmail = v.GetString("oidc.claims.email")
+	cfg.OIDC.ClaimEmailVerified = v.GetString("oidc.claims.emailVerified")
+	cfg.OIDC.ClaimName = v.GetString("oidc.claims.name")
+	cfg.OIDC.ClaimPicture = v.GetString("oidc.claims.picture")
+	cfg.OIDC.ClaimProvider = v.GetString("oidc.claims.provider")
+
 	// Groq
 	cfg.Groq.APIKey = v.GetString("groq.apiKey") // pragma: allowlist secret
 	cfg.Groq.BaseURL = v.GetString("groq.baseUrl")
@@ -240,36 +738,331 @@ func unmarshalConfig(v *viper.Viper, cfg *Config) error {
 	cfg.Groq.AnalysisModel = v.GetString("groq.analysisModel")
 	cfg.Groq.MaxRetries = v.GetInt("groq.maxRetries")
 	cfg.Groq.RequestTimeout = v.GetDuration("groq.requestTimeout")
+	cfg.Groq.MaxRepairAttempts = v.GetInt("groq.maxRepairAttempts")
+	cfg.Groq.CacheTTL = v.GetDuration("groq.cacheTtl")
+	cfg.Groq.CacheMaxEntries = v.GetInt("groq.cacheMaxEntries")
+	cfg.Groq.MaxConcurrentRequests = v.GetInt("groq.maxConcurrentRequests")
+	cfg.Groq.MaxPromptTokens = v.GetInt("groq.maxPromptTokens")
+	cfg.Groq.DefaultModelPrice = ModelPriceConfig{
+		Input:  v.GetFloat64("groq.defaultModelPrice.input"),
+		Output: v.GetFloat64("groq.defaultModelPrice.output"),
+	}
+	cfg.Groq.AnalysisModelPrice = ModelPriceConfig{
+		Input:  v.GetFloat64("groq.analysisModelPrice.input"),
+		Output: v.GetFloat64("groq.analysisModelPrice.output"),
+	}
 
 	// Jina
 	cfg.Jina.APIKey = v.GetString("jina.apiKey") // pragma: allowlist secret
 	cfg.Jina.BaseURL = v.GetString("jina.baseUrl")
 	cfg.Jina.Timeout = v.GetDuration("jina.timeout")
+	cfg.Jina.AllowedDomains = v.GetStringSlice("jina.allowedDomains")
+	cfg.Jina.BlockedDomains = v.GetStringSlice("jina.blockedDomains")
+	cfg.Jina.AllowPrivateNetworks = v.GetBool("jina.allowPrivateNetworks")
+	cfg.Jina.IgnoreRobots = v.GetBool("jina.ignoreRobots")
+	cfg.Jina.UserAgent = v.GetString("jina.userAgent")
+
+	// Embeddings
+	cfg.Embeddings.Provider = v.GetString("embeddings.provider")
+	cfg.Embeddings.BaseURL = v.GetString("embeddings.baseUrl")
+	cfg.Embeddings.APIKey = v.GetString("embeddings.apiKey") // pragma: allowlist secret
+	cfg.Embeddings.Model = v.GetString("embeddings.model")
+	cfg.Embeddings.Dimensions = v.GetInt("embeddings.dimensions")
+	cfg.Embeddings.BatchSize = v.GetInt("embeddings.batchSize")
+	cfg.Embeddings.Timeout = v.GetDuration("embeddings.timeout")
+	cfg.Embeddings.PreRankLimit = v.GetInt("embeddings.preRankLimit")
+
+	// Tailoring
+	cfg.Tailoring.RecencyHalfLifeYears = v.GetFloat64("tailoring.recencyHalfLifeYears")
+	cfg.Tailoring.ImpactWeight = v.GetFloat64("tailoring.impactWeight")
+	cfg.Tailoring.AnalysisTimeout = v.GetDuration("tailoring.analysisTimeout")
+	cfg.Tailoring.SelectionTimeout = v.GetDuration("tailoring.selectionTimeout")
+	cfg.Tailoring.TailorTimeout = v.GetDuration("tailoring.tailorTimeout")
+	cfg.Tailoring.SummaryTimeout = v.GetDuration("tailoring.summaryTimeout")
+	cfg.Tailoring.ScoringTimeout = v.GetDuration("tailoring.scoringTimeout")
+	cfg.Tailoring.ThemesTimeout = v.GetDuration("tailoring.themesTimeout")
+
+	// Rescoring
+	cfg.Rescoring.Interval = v.GetDuration("rescoring.interval")
+	cfg.Rescoring.BatchSize = v.GetInt("rescoring.batchSize")
+	cfg.Rescoring.MaxBullets = v.GetInt("rescoring.maxBullets")
+	cfg.Rescoring.Pause = v.GetDuration("rescoring.pause")
 
 	// PDF
+	cfg.PDF.Engine = v.GetString("pdf.engine")
 	cfg.PDF.BaseURL = v.GetString("pdf.baseUrl")
 	cfg.PDF.Timeout = v.GetDuration("pdf.timeout")
+	cfg.PDF.MaxRetries = v.GetInt("pdf.maxRetries")
+	cfg.PDF.HealthCheckInterval = v.GetDuration("pdf.healthCheckInterval")
+	cfg.PDF.ChromiumPath = v.GetString("pdf.chromiumPath")
+	cfg.PDF.ChromiumNoSandbox = v.GetBool("pdf.chromiumNoSandbox")
+	cfg.PDF.TemplateDir = v.GetString("pdf.templateDir")
 
 	// Storage
 	cfg.Storage.Type = v.GetString("storage.type")
 	cfg.Storage.LocalPath = v.GetString("storage.localPath")
 	cfg.Storage.S3Bucket = v.GetString("storage.s3Bucket")
 	cfg.Storage.S3Region = v.GetString("storage.s3Region")
+	cfg.Storage.GCSBucket = v.GetString("storage.gcsBucket")
+	cfg.Storage.GCSCredentialsFile = v.GetString("storage.gcsCredentialsFile")
+	cfg.Storage.SigningKey = v.GetString("storage.signingKey") // pragma: allowlist secret
+	cfg.Storage.URLTTL = v.GetDuration("storage.urlTtl")
+	cfg.Storage.CleanupInterval = v.GetDuration("storage.cleanupInterval")
+
+	// Avatars
+	cfg.Avatars.Cache = v.GetBool("avatars.cache")
+	cfg.Avatars.AllowedDomains = v.GetStringSlice("avatars.allowedDomains")
+	cfg.Avatars.Size = v.GetInt("avatars.size")
+	cfg.Avatars.MaxBytes = v.GetInt64("avatars.maxBytes")
+	cfg.Avatars.Timeout = v.GetDuration("avatars.timeout")
+
+	// Virus scan
+	cfg.VirusScan.Provider = v.GetString("virusScan.provider")
+	cfg.VirusScan.Network = v.GetString("virusScan.network")
+	cfg.VirusScan.Address = v.GetString("virusScan.address")
+	cfg.VirusScan.Timeout = v.GetDuration("virusScan.timeout")
+
+	// Error reporting
+	cfg.ErrorReporting.Provider = v.GetString("errorReporting.provider")
+	cfg.ErrorReporting.DSN = v.GetString("errorReporting.dsn")
+	cfg.ErrorReporting.Timeout = v.GetDuration("errorReporting.timeout")
+	cfg.ErrorReporting.SendPII = v.GetBool("errorReporting.sendPii")
+
+	// Outbox
+	cfg.Outbox.PollInterval = v.GetDuration("outbox.pollInterval")
+	cfg.Outbox.BatchSize = v.GetInt("outbox.batchSize")
+	cfg.Outbox.Retention = v.GetDuration("outbox.retention")
+
+	// Webhooks
+	cfg.Webhooks.URL = v.GetString("webhooks.url")
+	cfg.Webhooks.Secret = v.GetString("webhooks.secret") // pragma: allowlist secret
+	cfg.Webhooks.Timeout = v.GetDuration("webhooks.timeout")
+
+	// Event bus
+	cfg.EventBus.Provider = v.GetString("eventBus.provider")
+	cfg.EventBus.Timeout = v.GetDuration("eventBus.timeout")
+	cfg.EventBus.NATSURL = v.GetString("eventBus.natsUrl")
+	cfg.EventBus.NATSToken = v.GetString("eventBus.natsToken") // pragma: allowlist secret
+	cfg.EventBus.NATSCredsFile = v.GetString("eventBus.natsCredsFile")
+	cfg.EventBus.SubjectPrefix = v.GetString("eventBus.subjectPrefix")
+	cfg.EventBus.KafkaRESTURL = v.GetString("eventBus.kafkaRestUrl")
+	cfg.EventBus.Topic = v.GetString("eventBus.topic")
+
+	// Circuit breaker
+	cfg.CircuitBreaker.FailureThreshold = v.GetInt("circuitBreaker.failureThreshold")
+	cfg.CircuitBreaker.OpenTimeout = v.GetDuration("circuitBreaker.openTimeout")
+
+	// Outbound HTTP client
+	cfg.HTTPClient.ProxyURL = v.GetString("httpClient.proxyUrl")
+	cfg.HTTPClient.CAFiles = v.GetStringSlice("httpClient.caFiles")
+	cfg.HTTPClient.MaxIdleConns = v.GetInt("httpClient.maxIdleConns")
+	cfg.HTTPClient.MaxIdleConnsPerHost = v.GetInt("httpClient.maxIdleConnsPerHost")
+	cfg.HTTPClient.MaxConnsPerHost = v.GetInt("httpClient.maxConnsPerHost")
+	cfg.HTTPClient.IdleConnTimeout = v.GetDuration("httpClient.idleConnTimeout")
+	cfg.HTTPClient.DialTimeout = v.GetDuration("httpClient.dialTimeout")
+	cfg.HTTPClient.TLSHandshakeTimeout = v.GetDuration("httpClient.tlsHandshakeTimeout")
+
+	// Plan limits
+	cfg.Plans.Free = loadPlanLimits(v, "plans.free")
+	cfg.Plans.Pro = loadPlanLimits(v, "plans.pro")
 
 	return nil
 }
 
+// loadPlanLimits reads the limits of the plan under prefix.
+func loadPlanLimits(v *viper.Viper, prefix string) PlanLimitsConfig {
+	return PlanLimitsConfig{
+		ResumesPerMonth:          v.GetInt(prefix + ".resumesPerMonth"),
+		TailorsPerDay:            v.GetInt(prefix + ".tailorsPerDay"),
+		PDFRegenerationsPerMonth: v.GetInt(prefix + ".pdfRegenerationsPerMonth"),
+	}
+}
+
 // validateConfig validates required configuration fields.
 func validateConfig(cfg *Config) error {
-	// Firebase is required for authentication
-	if cfg.Firebase.ProjectID == "" {
-		return fmt.Errorf("firebase.projectId is required")
+	// The selected auth provider must be configured
+	switch cfg.Auth.Provider {
+	case "firebase":
+		if cfg.Firebase.ProjectID == "" {
+			return fmt.Errorf("firebase.projectId is required")
+		}
+	case "oidc":
+		if cfg.OIDC.IssuerURL == "" || cfg.OIDC.ClientID == "" {
+			return fmt.Errorf("oidc.issuerUrl and oidc.clientId are required when auth.provider is \"oidc\"")
+		}
+	default:
+		return fmt.Errorf("auth.provider must be \"firebase\" or \"oidc\"")
 	}
 
 	// Groq API key is required for AI features
 	if cfg.Groq.APIKey == "" {
 		return fmt.Errorf("groq.apiKey is required")
 	}
+	for key, price := range map[string]ModelPriceConfig{
+		"groq.defaultModelPrice":  cfg.Groq.DefaultModelPrice,
+		"groq.analysisModelPrice": cfg.Groq.AnalysisModelPrice,
+	} {
+		if price.Input < 0 || price.Output < 0 {
+			return fmt.Errorf("%s must not be negative", key)
+		}
+	}
+
+	// PDF engine must be a supported adapter
+	if cfg.PDF.Engine != "gotenberg" && cfg.PDF.Engine != "chromium" {
+		return fmt.Errorf("pdf.engine must be \"gotenberg\" or \"chromium\"")
+	}
+
+	// Request body limits must allow a body
+	if cfg.Server.MaxRequestSize <= 0 {
+		return fmt.Errorf("server.maxRequestSize must be positive")
+	}
+	for prefix, limit := range cfg.Server.RequestSizeLimits {
+		if limit <= 0 {
+			return fmt.Errorf("server.requestSizeLimits[%s] must be positive", prefix)
+		}
+	}
+
+	// v1 can only be sunset once deprecated
+	if !cfg.Server.V1SunsetAt.IsZero() {
+		if cfg.Server.V1DeprecatedAt.IsZero() {
+			return fmt.Errorf("server.v1SunsetAt requires server.v1DeprecatedAt")
+		}
+		if !cfg.Server.V1SunsetAt.After(cfg.Server.V1DeprecatedAt) {
+			return fmt.Errorf("server.v1SunsetAt must be after server.v1DeprecatedAt")
+		}
+	}
+
+	// Impersonation tokens are short-lived and signed with a strong key
+	if cfg.Server.ImpersonationTTL <= 0 || cfg.Server.ImpersonationTTL > time.Hour {
+		return fmt.Errorf("server.impersonation.ttl must be positive and at most 1h")
+	}
+	if cfg.Server.ImpersonationSigningKey != "" && len(cfg.Server.ImpersonationSigningKey) < signedurl.MinKeySize {
+		return fmt.Errorf("server.impersonation.signingKey must be at least %d characters", signedurl.MinKeySize)
+	}
+
+	// Access log sample rates are fractions of requests
+	if cfg.Server.AccessLogDefaultSampleRate < 0 || cfg.Server.AccessLogDefaultSampleRate > 1 {
+		return fmt.Errorf("server.accessLog.defaultSampleRate must be between 0 and 1")
+	}
+	for prefix, rate := range cfg.Server.AccessLogSampleRates {
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("server.accessLog.sampleRates[%s] must be between 0 and 1", prefix)
+		}
+	}
+
+	// Embeddings provider must be a supported adapter when enabled
+	if cfg.Embeddings.Provider != "" && cfg.Embeddings.Provider != "openai" {
+		return fmt.Errorf("embeddings.provider must be empty or \"openai\"")
+	}
+
+	// Bullet ranking weights must be in range
+	if cfg.Tailoring.RecencyHalfLifeYears < 0 {
+		return fmt.Errorf("tailoring.recencyHalfLifeYears cannot be negative")
+	}
+	if cfg.Tailoring.ImpactWeight < 0 || cfg.Tailoring.ImpactWeight > 1 {
+		return fmt.Errorf("tailoring.impactWeight must be between 0 and 1")
+	}
+	if cfg.Tailoring.AnalysisTimeout < 0 || cfg.Tailoring.SelectionTimeout < 0 || cfg.Tailoring.TailorTimeout < 0 ||
+		cfg.Tailoring.SummaryTimeout < 0 || cfg.Tailoring.ScoringTimeout < 0 || cfg.Tailoring.ThemesTimeout < 0 {
+		return fmt.Errorf("tailoring stage timeouts cannot be negative")
+	}
+
+	// Rescoring needs batches when enabled
+	if cfg.Rescoring.Interval < 0 {
+		return fmt.Errorf("rescoring.interval cannot be negative")
+	}
+	if cfg.Rescoring.Interval > 0 {
+		if cfg.Rescoring.BatchSize <= 0 {
+			return fmt.Errorf("rescoring.batchSize must be positive")
+		}
+		if cfg.Rescoring.MaxBullets <= 0 {
+			return fmt.Errorf("rescoring.maxBullets must be positive")
+		}
+		if cfg.Rescoring.Pause < 0 {
+			return fmt.Errorf("rescoring.pause cannot be negative")
+		}
+	}
+
+	// Storage type must be a supported adapter
+	switch cfg.Storage.Type {
+	case "local":
+	case "gcs":
+		if cfg.Storage.GCSBucket == "" {
+			return fmt.Errorf("storage.gcsBucket is required when storage.type is \"gcs\"")
+		}
+	default:
+		return fmt.Errorf("storage.type must be \"local\" or \"gcs\"")
+	}
+
+	// Signed file URLs need a strong key that survives restarts in production
+	if cfg.Storage.SigningKey != "" && len(cfg.Storage.SigningKey) < signedurl.MinKeySize {
+		return fmt.Errorf("storage.signingKey must be at least %d characters", signedurl.MinKeySize)
+	}
+	if cfg.Storage.SigningKey == "" && cfg.Storage.Type == "local" && cfg.IsProduction() {
+		return fmt.Errorf("storage.signingKey is required in production")
+	}
+	if cfg.Storage.URLTTL <= 0 {
+		return fmt.Errorf("storage.urlTtl must be positive")
+	}
+	if cfg.Storage.CleanupInterval < 0 {
+		return fmt.Errorf("storage.cleanupInterval cannot be negative")
+	}
+
+	// Cached pictures need hosts to come from and a positive size
+	if cfg.Avatars.Cache {
+		if len(cfg.Avatars.AllowedDomains) == 0 {
+			return fmt.Errorf("avatars.allowedDomains cannot be empty when avatars.cache is enabled")
+		}
+		if cfg.Avatars.Size <= 0 {
+			return fmt.Errorf("avatars.size must be positive")
+		}
+		if cfg.Avatars.MaxBytes <= 0 {
+			return fmt.Errorf("avatars.maxBytes must be positive")
+		}
+	}
+
+	// Virus scan provider must be a supported adapter when enabled
+	if cfg.VirusScan.Provider != "" && cfg.VirusScan.Provider != "clamav" {
+		return fmt.Errorf("virusScan.provider must be empty or \"clamav\"")
+	}
+
+	// Error reporting provider must be a supported adapter when enabled
+	if cfg.ErrorReporting.Provider != "" && cfg.ErrorReporting.Provider != "sentry" {
+		return fmt.Errorf("errorReporting.provider must be empty or \"sentry\"")
+	}
+	if cfg.ErrorReporting.Provider == "sentry" && cfg.ErrorReporting.DSN == "" {
+		return fmt.Errorf("errorReporting.dsn is required when errorReporting.provider is \"sentry\"")
+	}
+
+	// Event bus provider must be a supported adapter when enabled
+	switch cfg.EventBus.Provider {
+	case "", "nats", "kafka":
+	default:
+		return fmt.Errorf("eventBus.provider must be empty, \"nats\" or \"kafka\"")
+	}
+	if cfg.EventBus.Provider == "kafka" && cfg.EventBus.Topic == "" {
+		return fmt.Errorf("eventBus.topic is required when eventBus.provider is \"kafka\"")
+	}
+
+	// Event delivery needs a schedule and batches
+	if cfg.EventsEnabled() {
+		if cfg.Outbox.PollInterval <= 0 {
+			return fmt.Errorf("outbox.pollInterval must be positive")
+		}
+		if cfg.Outbox.BatchSize <= 0 {
+			return fmt.Errorf("outbox.batchSize must be positive")
+		}
+		if cfg.Outbox.Retention <= 0 {
+			return fmt.Errorf("outbox.retention must be positive")
+		}
+	}
+
+	// Plan limits cannot be negative
+	for name, limits := range map[string]PlanLimitsConfig{"free": cfg.Plans.Free, "pro": cfg.Plans.Pro} {
+		if limits.ResumesPerMonth < 0 || limits.TailorsPerDay < 0 || limits.PDFRegenerationsPerMonth < 0 {
+			return fmt.Errorf("plans.%s limits cannot be negative", name)
+		}
+	}
 
 	// Database password should be set in production
 	if cfg.App.Environment == "production" && cfg.Database.Password == "" {
@@ -296,3 +1089,9 @@ func (c *Config) IsProduction() bool {
 func (c *Config) IsDevelopment() bool {
 	return c.App.Environment == "development"
 }
+
+// EventsEnabled returns true if events are delivered to a webhook or an
+// event bus.
+func (c *Config) EventsEnabled() bool {
+	return c.Webhooks.URL != "" || c.EventBus.Provider != ""
+}
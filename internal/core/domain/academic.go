@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// AcademicSection is the section of an academic CV an entry belongs to.
+type AcademicSection string
+
+// Academic section constants, in the order the academic CV shows them.
+const (
+	// AcademicPublications lists papers, books and talks; Organization is
+	// the venue, e.g. the journal or conference.
+	AcademicPublications AcademicSection = "publications"
+
+	// AcademicGrants lists funding received; Organization is the funder.
+	AcademicGrants AcademicSection = "grants"
+
+	// AcademicTeaching lists courses taught; Organization is the
+	// institution.
+	AcademicTeaching AcademicSection = "teaching"
+
+	// AcademicService lists reviewing, committees and other service to the
+	// field; Organization is the body served.
+	AcademicService AcademicSection = "service"
+)
+
+// AcademicSections lists the academic sections in display order.
+var AcademicSections = []AcademicSection{
+	AcademicPublications,
+	AcademicGrants,
+	AcademicTeaching,
+	AcademicService,
+}
+
+// IsValid checks if the academic section is known.
+func (s AcademicSection) IsValid() bool {
+	switch s {
+	case AcademicPublications, AcademicGrants, AcademicTeaching, AcademicService:
+		return true
+	default:
+		return false
+	}
+}
+
+// AcademicEntry is an entry of the academic sections of a CV: a publication,
+// a grant, a course taught or a service role. They are rendered by the
+// academic template only.
+type AcademicEntry struct {
+	ID           string          `json:"id"`
+	UserID       string          `json:"user_id"`
+	Section      AcademicSection `json:"section"`
+	Title        string          `json:"title"`
+	Organization *string         `json:"organization,omitempty"`
+	Authors      []string        `json:"authors,omitempty"` // Publications, in byline order
+	Amount       *string         `json:"amount,omitempty"`  // Grants, e.g. "USD 250,000"
+	URL          *string         `json:"url,omitempty"`     // e.g. a DOI link
+	Description  *string         `json:"description,omitempty"`
+	StartDate    *Date           `json:"start_date,omitempty"`
+	EndDate      *Date           `json:"end_date,omitempty"` // Publications use it as the publication date
+	DisplayOrder int             `json:"display_order"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// NewAcademicEntry creates a new academic entry with required fields.
+func NewAcademicEntry(userID string, section AcademicSection, title string) (*AcademicEntry, error) {
+	if userID == "" || title == "" || !section.IsValid() {
+		return nil, ErrValidation
+	}
+
+	now := time.Now().UTC()
+	return &AcademicEntry{
+		UserID:    userID,
+		Section:   section,
+		Title:     title,
+		Authors:   make([]string, 0),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Validate validates the academic entry.
+func (e *AcademicEntry) Validate() error {
+	v := &ValidationErrors{}
+
+	if e.UserID == "" {
+		v.AddFieldError("user_id", "user ID is required")
+	}
+
+	if !e.Section.IsValid() {
+		v.AddFieldError("section", "must be 'publications', 'grants', 'teaching' or 'service'")
+	}
+
+	if e.Title == "" {
+		v.AddFieldError("title", "title is required")
+	}
+
+	if e.StartDate != nil && e.EndDate != nil && !e.StartDate.IsZero() && !e.EndDate.IsZero() &&
+		e.EndDate.Before(*e.StartDate) {
+		v.AddFieldError("end_date", "end date must be after start date")
+	}
+
+	return v.ToError()
+}
+
+// Error definitions for AcademicEntry.
+var (
+	ErrAcademicEntryNotFound = errors.New("academic entry not found")
+)
@@ -0,0 +1,41 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestNewAcademicEntry(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		entry, err := domain.NewAcademicEntry("user-1", domain.AcademicPublications, "Attention Is All You Need")
+		require.NoError(t, err)
+		assert.NoError(t, entry.Validate())
+	})
+
+	t.Run("unknown section", func(t *testing.T) {
+		_, err := domain.NewAcademicEntry("user-1", "awards", "Best Paper")
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+
+	t.Run("missing title", func(t *testing.T) {
+		_, err := domain.NewAcademicEntry("user-1", domain.AcademicGrants, "")
+		assert.ErrorIs(t, err, domain.ErrValidation)
+	})
+}
+
+func TestAcademicEntryValidate(t *testing.T) {
+	entry, err := domain.NewAcademicEntry("user-1", domain.AcademicTeaching, "Distributed Systems")
+	require.NoError(t, err)
+
+	start := domain.NewMonthDate(2024, time.February)
+	end := domain.NewMonthDate(2023, time.June)
+	entry.StartDate, entry.EndDate = &start, &end
+	entry.Section = "lectures"
+
+	assert.Equal(t, []string{"section", "end_date"}, fieldErrors(t, entry.Validate()))
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// englishActionVerbs are the base forms of the English verbs strong resume
+// bullets open with. Their past tenses are derived by actionVerbForms.
+var englishActionVerbs = []string{
+	"achieve", "analyze", "architect", "automate", "build", "collaborate",
+	"conduct", "configure", "consolidate", "contribute", "coordinate",
+	"create", "cut", "debug", "decrease", "deliver", "deploy", "design",
+	"develop", "document", "drive", "eliminate", "enable", "engineer",
+	"establish", "expand", "grow", "identify", "implement", "improve",
+	"increase", "integrate", "introduce", "launch", "lead", "maintain",
+	"manage", "mentor", "migrate", "modernize", "monitor", "negotiate",
+	"optimize", "orchestrate", "organize", "oversee", "own", "pioneer",
+	"plan", "present", "produce", "publish", "redesign", "reduce",
+	"refactor", "research", "resolve", "restructure", "review", "run",
+	"scale", "secure", "ship", "simplify", "spearhead", "streamline",
+	"supervise", "support", "test", "train", "transform", "troubleshoot",
+	"upgrade", "write",
+}
+
+// englishIrregularPasts are the past tenses not formed by adding -ed.
+var englishIrregularPasts = map[string]string{
+	"build": "built", "cut": "cut", "debug": "debugged", "drive": "drove",
+	"grow": "grew", "lead": "led", "oversee": "oversaw", "plan": "planned",
+	"run": "ran", "ship": "shipped", "write": "wrote",
+}
+
+// portugueseActionVerbs are the infinitives of the Portuguese verbs strong
+// resume bullets open with. Their past tenses are derived by
+// actionVerbForms.
+var portugueseActionVerbs = []string{
+	"analisar", "apoiar", "arquitetar", "atuar", "aumentar", "automatizar",
+	"colaborar", "conduzir", "configurar", "construir", "coordenar", "criar",
+	"definir", "desenvolver", "documentar", "elaborar", "entregar",
+	"escalar", "estabelecer", "estruturar", "expandir", "garantir",
+	"gerenciar", "identificar", "implantar", "implementar", "integrar",
+	"lançar", "liderar", "manter", "melhorar", "mentorar", "migrar",
+	"modernizar", "monitorar", "negociar", "organizar", "orientar",
+	"otimizar", "participar", "pesquisar", "planejar", "projetar",
+	"publicar", "reduzir", "refatorar", "resolver", "revisar",
+	"simplificar", "supervisionar", "testar", "transformar", "treinar",
+}
+
+// portugueseIrregularPasts are the first- and third-person past tenses not
+// formed regularly.
+var portugueseIrregularPasts = map[string][2]string{
+	"manter": {"mantive", "manteve"},
+}
+
+// actionVerbs holds every form of the action verbs, in lowercase.
+var actionVerbs = actionVerbForms()
+
+// actionVerbForms returns the base forms of the action verbs and their past
+// tenses: -ed in English, and the first and third persons in Portuguese,
+// e.g. "liderei" and "liderou", since bullets are written in either.
+func actionVerbForms() map[string]bool {
+	forms := make(map[string]bool)
+	for _, verb := range englishActionVerbs {
+		forms[verb] = true
+		forms[englishPast(verb)] = true
+	}
+	for _, verb := range portugueseActionVerbs {
+		forms[verb] = true
+		for _, past := range portuguesePasts(verb) {
+			forms[past] = true
+		}
+	}
+	return forms
+}
+
+// englishPast returns the past tense of an English action verb.
+func englishPast(verb string) string {
+	switch {
+	case englishIrregularPasts[verb] != "":
+		return englishIrregularPasts[verb]
+	case strings.HasSuffix(verb, "e"):
+		return verb + "d"
+	case strings.HasSuffix(verb, "y") && !strings.ContainsAny(verb[len(verb)-2:len(verb)-1], "aeiou"):
+		return strings.TrimSuffix(verb, "y") + "ied"
+	default:
+		return verb + "ed"
+	}
+}
+
+// portuguesePasts returns the first- and third-person past tenses of a
+// Portuguese action verb.
+func portuguesePasts(verb string) [2]string {
+	if pasts, ok := portugueseIrregularPasts[verb]; ok {
+		return pasts
+	}
+	stem, ending := verb[:len(verb)-2], verb[len(verb)-2:]
+	switch ending {
+	case "ar":
+		// The stem is respelled before e to keep its sound.
+		first := stem
+		switch {
+		case strings.HasSuffix(stem, "ç"):
+			first = strings.TrimSuffix(stem, "ç") + "c"
+		case strings.HasSuffix(stem, "c"):
+			first = strings.TrimSuffix(stem, "c") + "qu"
+		case strings.HasSuffix(stem, "g"):
+			first = stem + "u"
+		}
+		return [2]string{first + "ei", stem + "ou"}
+	case "er":
+		return [2]string{stem + "i", stem + "eu"}
+	default:
+		if strings.HasSuffix(stem, "u") {
+			return [2]string{stem + "í", stem + "iu"}
+		}
+		return [2]string{stem + "i", stem + "iu"}
+	}
+}
+
+// LeadingActionVerb returns the first word of text when it is an action
+// verb, in English or Portuguese, as written; otherwise it returns "".
+// Words in capitals, such as acronyms, are not verbs.
+func LeadingActionVerb(text string) string {
+	for _, field := range strings.Fields(text) {
+		word := strings.TrimFunc(field, func(r rune) bool { return !unicode.IsLetter(r) })
+		if word == "" {
+			continue
+		}
+		if utf8.RuneCountInString(word) > 1 && strings.ToUpper(word) == word {
+			return ""
+		}
+		if actionVerbs[strings.ToLower(word)] {
+			return word
+		}
+		return ""
+	}
+	return ""
+}
@@ -0,0 +1,38 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestLeadingActionVerb(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "Led a team of five engineers", want: "Led"},
+		{text: "Reduced latency by 40%", want: "Reduced"},
+		{text: "Simplified the deploy pipeline", want: "Simplified"},
+		{text: "Deployed services to Kubernetes", want: "Deployed"},
+		{text: "  • Built the billing API", want: "Built"},
+		{text: "Develop internal tools", want: "Develop"},
+		{text: "Liderei a migração para Go", want: "Liderei"},
+		{text: "Publiquei a documentação da API", want: "Publiquei"},
+		{text: "Construí o pipeline de dados", want: "Construí"},
+		{text: "Desenvolveu serviços em Go", want: "Desenvolveu"},
+		{text: "Mantive a plataforma de pagamentos", want: "Mantive"},
+		{text: "Responsible for the billing API", want: ""},
+		{text: "I built the billing API", want: ""},
+		{text: "AWS migration of the billing API", want: ""},
+		{text: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, domain.LeadingActionVerb(tt.text))
+		})
+	}
+}
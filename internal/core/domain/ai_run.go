@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+// AICallSettings are the settings of the calls an AI run made for one
+// operation, such as rewriting bullets.
+type AICallSettings struct {
+	// Operation names the prompt template, e.g. "tailor_bullet".
+	Operation string `json:"operation"`
+
+	// PromptVersion is the version of the template, bumped whenever its
+	// wording changes.
+	PromptVersion string  `json:"prompt_version"`
+	Model         string  `json:"model"`
+	Temperature   float64 `json:"temperature"`
+
+	// Count is the number of calls made with these settings.
+	Count int `json:"count"`
+}
+
+// AIRunSettings are what an AI run on a resume was made with, to reproduce
+// it or tell why two runs differ.
+type AIRunSettings struct {
+	// Seed is the seed given to the model. Providers apply it on a best
+	// effort basis, so a seeded run may still differ. Nil when not seeded.
+	Seed *int64 `json:"seed,omitempty"`
+
+	// Calls are the settings of the AI calls, in the order first made.
+	Calls []AICallSettings `json:"calls"`
+
+	// MaxBullets and IncludeHighlightedSkills are the options of a
+	// tailoring run; the writing voice is kept with the content.
+	MaxBullets               int  `json:"max_bullets,omitempty"`
+	IncludeHighlightedSkills bool `json:"include_highlighted_skills,omitempty"`
+}
+
+// Differences describes how the settings of run other differ from s, such
+// as a prompt version or model that changed since, to contrast two runs.
+// Calls made by only one of the runs are not differences, as they depend
+// on the content.
+func (s *AIRunSettings) Differences(other *AIRunSettings) []string {
+	var diffs []string
+	if (s.Seed == nil) != (other.Seed == nil) || (s.Seed != nil && *s.Seed != *other.Seed) {
+		diffs = append(diffs, fmt.Sprintf("seed: %s -> %s", formatSeed(s.Seed), formatSeed(other.Seed)))
+	}
+	if s.MaxBullets != other.MaxBullets {
+		diffs = append(diffs, fmt.Sprintf("max_bullets: %d -> %d", s.MaxBullets, other.MaxBullets))
+	}
+	if s.IncludeHighlightedSkills != other.IncludeHighlightedSkills {
+		diffs = append(diffs, fmt.Sprintf("include_highlighted_skills: %t -> %t", s.IncludeHighlightedSkills, other.IncludeHighlightedSkills))
+	}
+
+	for _, call := range s.Calls {
+		i := slices.IndexFunc(other.Calls, func(c AICallSettings) bool { return c.Operation == call.Operation })
+		if i < 0 {
+			continue
+		}
+		next := other.Calls[i]
+		if call.PromptVersion != next.PromptVersion {
+			diffs = append(diffs, fmt.Sprintf("%s prompt: %s -> %s", call.Operation, call.PromptVersion, next.PromptVersion))
+		}
+		if call.Model != next.Model {
+			diffs = append(diffs, fmt.Sprintf("%s model: %s -> %s", call.Operation, call.Model, next.Model))
+		}
+		if call.Temperature != next.Temperature {
+			diffs = append(diffs, fmt.Sprintf("%s temperature: %g -> %g", call.Operation, call.Temperature, next.Temperature))
+		}
+	}
+	return diffs
+}
+
+// formatSeed formats a seed for Differences.
+func formatSeed(seed *int64) string {
+	if seed == nil {
+		return "none"
+	}
+	return fmt.Sprint(*seed)
+}
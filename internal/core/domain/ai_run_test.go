@@ -0,0 +1,46 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestAIRunSettingsDifferences(t *testing.T) {
+	seed := int64(42)
+	previous := &domain.AIRunSettings{
+		Seed:       &seed,
+		MaxBullets: 10,
+		Calls: []domain.AICallSettings{
+			{Operation: "analyze_job", PromptVersion: "v1", Model: "scout", Temperature: 0.3, Count: 1},
+			{Operation: "tailor_bullet", PromptVersion: "v1", Model: "llama", Temperature: 0.7, Count: 8},
+			{Operation: "shorten_texts", PromptVersion: "v1", Model: "llama", Temperature: 0.3, Count: 1},
+		},
+	}
+
+	same := *previous
+	same.Calls = []domain.AICallSettings{
+		{Operation: "tailor_bullet", PromptVersion: "v1", Model: "llama", Temperature: 0.7, Count: 6},
+		{Operation: "analyze_job", PromptVersion: "v1", Model: "scout", Temperature: 0.3, Count: 1},
+	}
+	assert.Empty(t, previous.Differences(&same))
+
+	next := &domain.AIRunSettings{
+		MaxBullets:               8,
+		IncludeHighlightedSkills: true,
+		Calls: []domain.AICallSettings{
+			{Operation: "analyze_job", PromptVersion: "v2", Model: "scout", Temperature: 0.3, Count: 1},
+			{Operation: "tailor_bullet", PromptVersion: "v1", Model: "qwen", Temperature: 0.5, Count: 8},
+		},
+	}
+	assert.Equal(t, []string{
+		"seed: 42 -> none",
+		"max_bullets: 10 -> 8",
+		"include_highlighted_skills: false -> true",
+		"analyze_job prompt: v1 -> v2",
+		"tailor_bullet model: llama -> qwen",
+		"tailor_bullet temperature: 0.7 -> 0.5",
+	}, previous.Differences(next))
+}
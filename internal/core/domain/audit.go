@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// AuditAction identifies a security-relevant action recorded in the audit
+// log.
+type AuditAction string
+
+// Audit actions.
+const (
+	// AuditImpersonationStarted is an admin starting to impersonate a user.
+	AuditImpersonationStarted AuditAction = "impersonation_started"
+
+	// AuditImpersonatedRequest is a request an admin made as a user.
+	AuditImpersonatedRequest AuditAction = "impersonated_request"
+
+	// AuditUserExported is an admin exporting the data of a user.
+	AuditUserExported AuditAction = "user_exported"
+
+	// AuditUserImported is an admin importing the data of a user.
+	AuditUserImported AuditAction = "user_imported"
+)
+
+// AuditEvent is an entry of the audit log. Entries outlive the users they
+// name, so that they can be reviewed after an account is deleted.
+type AuditEvent struct {
+	ID     string      `json:"id"`
+	Action AuditAction `json:"action"`
+
+	// ActorID is the user who acted; SubjectID the user acted on, if any.
+	ActorID   string `json:"actor_id"`
+	SubjectID string `json:"subject_id,omitempty"`
+
+	// SessionID groups the events of one impersonation.
+	SessionID string `json:"session_id,omitempty"`
+
+	// Detail describes the action, such as the method, path and status of a
+	// request.
+	Detail string `json:"detail,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// BackgroundJobKind identifies what a background job does.
+type BackgroundJobKind string
+
+// Background job kinds.
+const (
+	// BackgroundJobCachePDF stores a generated resume PDF in the PDF cache.
+	// Its params are the template and the density of the PDF.
+	BackgroundJobCachePDF BackgroundJobKind = "cache_pdf"
+)
+
+// BackgroundJob is work started by a request that goes on after the response,
+// such as caching a generated PDF. Jobs still running when the server shuts
+// down are saved and run again on the next start.
+type BackgroundJob struct {
+	ID       string            `json:"id"`
+	Kind     BackgroundJobKind `json:"kind"`
+	UserID   string            `json:"user_id"`
+	ResumeID string            `json:"resume_id"`
+
+	// Params holds the kind-specific arguments of the job.
+	Params map[string]string `json:"params,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewBackgroundJob returns a job of the given kind for a resume.
+func NewBackgroundJob(kind BackgroundJobKind, resume *Resume, params map[string]string) *BackgroundJob {
+	return &BackgroundJob{Kind: kind, UserID: resume.UserID, ResumeID: resume.ID, Params: params}
+}
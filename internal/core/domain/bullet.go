@@ -1,7 +1,12 @@
 // Package domain contains the core business entities and value objects.
 package domain
 
-import "time"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
 
 // Bullet represents an atomic unit of experience that can be selected for resume tailoring.
 // Each bullet is a single achievement or responsibility that can be independently
@@ -16,6 +21,11 @@ type Bullet struct {
 	DisplayOrder int            `json:"display_order"`
 	CreatedAt    time.Time      `json:"created_at"`
 	UpdatedAt    time.Time      `json:"updated_at"`
+
+	// ImpactScored reports that ImpactScore was computed or given for the
+	// current content, so that it is not rescored until the content changes.
+	// It is set by SetImpactScore and not loaded from storage.
+	ImpactScored bool `json:"-"`
 }
 
 // NewBullet creates a new bullet with required fields.
@@ -62,6 +72,7 @@ func (b *Bullet) SetImpactScore(score int) error {
 		return err
 	}
 	b.ImpactScore = impactScore
+	b.ImpactScored = true
 	b.UpdatedAt = time.Now().UTC()
 	return nil
 }
@@ -112,3 +123,28 @@ func (b *Bullet) IsHighImpact() bool {
 func (b *Bullet) IsLowImpact() bool {
 	return b.ImpactScore.Int() < 40
 }
+
+// EmbeddingText returns the text used to compute the bullet's embedding.
+func (b *Bullet) EmbeddingText() string {
+	if len(b.Keywords) == 0 {
+		return b.Content
+	}
+	return b.Content + "\n" + strings.Join(b.Keywords, ", ")
+}
+
+// BulletEmbedding is a precomputed embedding vector for a bullet.
+// ContentHash identifies the text it was computed from, so stale
+// embeddings can be detected after the bullet is edited.
+type BulletEmbedding struct {
+	BulletID    string    `json:"bullet_id"`
+	Model       string    `json:"model"`
+	ContentHash string    `json:"content_hash"`
+	Vector      []float32 `json:"vector"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// HashEmbeddingText returns the content hash stored alongside an embedding.
+func HashEmbeddingText(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
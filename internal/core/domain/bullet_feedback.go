@@ -0,0 +1,206 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// FeedbackVerdict is a user's judgement of a tailored bullet.
+type FeedbackVerdict string
+
+// Feedback verdict constants.
+const (
+	FeedbackVerdictAccepted FeedbackVerdict = "accepted"
+	FeedbackVerdictEdited   FeedbackVerdict = "edited"
+	FeedbackVerdictRejected FeedbackVerdict = "rejected"
+)
+
+// IsValid checks if the feedback verdict is valid.
+func (v FeedbackVerdict) IsValid() bool {
+	switch v {
+	case FeedbackVerdictAccepted, FeedbackVerdictEdited, FeedbackVerdictRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// BulletFeedback is a user's verdict on one tailored bullet of a resume. A
+// resume holds at most one feedback per bullet; the latest replaces earlier
+// ones.
+type BulletFeedback struct {
+	ID       string          `json:"id"`
+	UserID   string          `json:"user_id"`
+	ResumeID string          `json:"resume_id"`
+	BulletID string          `json:"bullet_id"`
+	Verdict  FeedbackVerdict `json:"verdict"`
+
+	// TailoredContent is the tailored text the verdict is about.
+	TailoredContent string `json:"tailored_content"`
+
+	// EditedContent is the user's rewrite; set only for edited bullets.
+	EditedContent *string `json:"edited_content,omitempty"`
+
+	// Comment is the user's optional explanation, e.g. "too many buzzwords".
+	Comment *string `json:"comment,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Validate checks the verdict and that edited bullets carry the edit.
+func (f *BulletFeedback) Validate() error {
+	v := &ValidationErrors{}
+	if !f.Verdict.IsValid() {
+		v.AddFieldError("verdict", "must be 'accepted', 'edited' or 'rejected'")
+	}
+	if f.Verdict == FeedbackVerdictEdited && (f.EditedContent == nil || strings.TrimSpace(*f.EditedContent) == "") {
+		v.AddFieldError("edited_content", "is required for edited bullets")
+	}
+	if f.Verdict != FeedbackVerdictEdited && f.EditedContent != nil {
+		v.AddFieldError("edited_content", "is only allowed for edited bullets")
+	}
+	return v.ToError()
+}
+
+// Limits on the examples WritingPreferences quotes.
+const (
+	maxPreferenceExamples = 3
+	maxPreferenceComments = 5
+)
+
+// buzzwords are filler words users commonly strip from generated bullets.
+var buzzwords = []string{
+	"best-in-class", "cutting-edge", "dynamic", "game-changing", "innovative",
+	"leverage", "leveraged", "leveraging", "passionate", "proactive",
+	"results-driven", "robust", "seamless", "seamlessly", "spearheaded",
+	"synergy", "world-class",
+}
+
+// WritingPreferences sums up a user's feedback, newest first, as short
+// statements of how they like their bullets written, for tailoring prompts.
+// Besides preferences inferred from their edits and rejections, it quotes a
+// few of their comments, rejected bullets and edits. Accepted bullets only
+// count towards the totals.
+func WritingPreferences(feedback []BulletFeedback) []string {
+	var edited, rejected []BulletFeedback
+	for _, f := range feedback {
+		switch f.Verdict {
+		case FeedbackVerdictEdited:
+			if f.EditedContent != nil {
+				edited = append(edited, f)
+			}
+		case FeedbackVerdictRejected:
+			rejected = append(rejected, f)
+		}
+	}
+	if len(edited) == 0 && len(rejected) == 0 {
+		return nil
+	}
+
+	var prefs []string
+	var addedMetrics, shortened, lengthened int
+	removed := make(map[string]bool)
+	var removedOrder []string
+	addRemoved := func(words []string) {
+		for _, w := range words {
+			if !removed[w] {
+				removed[w] = true
+				removedOrder = append(removedOrder, w)
+			}
+		}
+	}
+
+	for _, f := range edited {
+		before, after := f.TailoredContent, *f.EditedContent
+		if !hasDigit(before) && hasDigit(after) {
+			addedMetrics++
+		}
+		switch b, a := len([]rune(before)), len([]rune(after)); {
+		case a*5 < b*4:
+			shortened++
+		case a*5 > b*6:
+			lengthened++
+		}
+		var dropped []string
+		for _, w := range containedBuzzwords(before) {
+			if !containsFold(after, w) {
+				dropped = append(dropped, w)
+			}
+		}
+		addRemoved(dropped)
+	}
+	for _, f := range rejected {
+		addRemoved(containedBuzzwords(f.TailoredContent))
+	}
+
+	prefs = append(prefs, fmt.Sprintf("Accepted %d, edited %d and rejected %d of the tailored bullets they reviewed.",
+		len(feedback)-len(edited)-len(rejected), len(edited), len(rejected)))
+	if len(removedOrder) > 0 {
+		prefs = append(prefs, fmt.Sprintf("Dislikes buzzwords such as %s.", strings.Join(removedOrder, ", ")))
+	}
+	if addedMetrics > 0 && addedMetrics*2 >= len(edited) {
+		prefs = append(prefs, "Prefers concrete metrics over adjectives.")
+	}
+	if shortened > lengthened && shortened*2 >= len(edited) {
+		prefs = append(prefs, "Prefers shorter, more concise bullets.")
+	} else if lengthened > shortened && lengthened*2 >= len(edited) {
+		prefs = append(prefs, "Prefers more detailed bullets.")
+	}
+
+	comments := 0
+	for _, f := range feedback {
+		if f.Comment == nil || strings.TrimSpace(*f.Comment) == "" || f.Verdict == FeedbackVerdictAccepted {
+			continue
+		}
+		if comments++; comments > maxPreferenceComments {
+			break
+		}
+		prefs = append(prefs, fmt.Sprintf("Commented: %q", strings.TrimSpace(*f.Comment)))
+	}
+	for i, f := range rejected {
+		if i == maxPreferenceExamples {
+			break
+		}
+		prefs = append(prefs, fmt.Sprintf("Rejected: %q", f.TailoredContent))
+	}
+	for i, f := range edited {
+		if i == maxPreferenceExamples {
+			break
+		}
+		prefs = append(prefs, fmt.Sprintf("Rewrote %q as %q", f.TailoredContent, *f.EditedContent))
+	}
+
+	return prefs
+}
+
+// containedBuzzwords returns the buzzwords s contains.
+func containedBuzzwords(s string) []string {
+	var found []string
+	for _, w := range buzzwords {
+		if containsFold(s, w) {
+			found = append(found, w)
+		}
+	}
+	return found
+}
+
+// containsFold reports whether s contains word as a whole word, ignoring case.
+func containsFold(s, word string) bool {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-'
+	})
+	for _, f := range fields {
+		if f == word {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDigit reports whether s contains a digit.
+func hasDigit(s string) bool {
+	return strings.IndexFunc(s, unicode.IsDigit) >= 0
+}
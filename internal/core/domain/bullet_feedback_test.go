@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestBulletFeedbackValidate(t *testing.T) {
+	edit := "Cut latency by 40%"
+	blank := "  "
+
+	tests := []struct {
+		name     string
+		feedback domain.BulletFeedback
+		// wantField is the field reported invalid; empty when valid.
+		wantField string
+	}{
+		{"accepted", domain.BulletFeedback{Verdict: domain.FeedbackVerdictAccepted}, ""},
+		{"rejected", domain.BulletFeedback{Verdict: domain.FeedbackVerdictRejected}, ""},
+		{"edited", domain.BulletFeedback{Verdict: domain.FeedbackVerdictEdited, EditedContent: &edit}, ""},
+		{"edited without edit", domain.BulletFeedback{Verdict: domain.FeedbackVerdictEdited}, "edited_content"},
+		{"edited with blank edit", domain.BulletFeedback{Verdict: domain.FeedbackVerdictEdited, EditedContent: &blank}, "edited_content"},
+		{"edit on rejected", domain.BulletFeedback{Verdict: domain.FeedbackVerdictRejected, EditedContent: &edit}, "edited_content"},
+		{"unknown verdict", domain.BulletFeedback{Verdict: "liked"}, "verdict"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.feedback.Validate()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *domain.ValidationErrors
+			require.True(t, errors.As(err, &validationErr))
+			require.Len(t, validationErr.Errors, 1)
+			assert.Equal(t, tt.wantField, validationErr.Errors[0].Field)
+		})
+	}
+}
+
+func TestWritingPreferences(t *testing.T) {
+	edited := func(before, after string) domain.BulletFeedback {
+		return domain.BulletFeedback{Verdict: domain.FeedbackVerdictEdited, TailoredContent: before, EditedContent: &after}
+	}
+	comment := "Too much fluff"
+
+	prefs := domain.WritingPreferences([]domain.BulletFeedback{
+		edited("Leveraged cutting-edge tooling to dramatically improve the deployment experience", "Cut deploy time by 40%"),
+		edited("Spearheaded a seamless migration to the new platform", "Migrated 12 services to Kubernetes"),
+		{Verdict: domain.FeedbackVerdictRejected, TailoredContent: "Passionate, results-driven engineer", Comment: &comment},
+		{Verdict: domain.FeedbackVerdictAccepted, TailoredContent: "Built the billing API in Go"},
+	})
+
+	assert.Equal(t, []string{
+		"Accepted 1, edited 2 and rejected 1 of the tailored bullets they reviewed.",
+		"Dislikes buzzwords such as cutting-edge, leveraged, seamless, spearheaded, passionate, results-driven.",
+		"Prefers concrete metrics over adjectives.",
+		"Prefers shorter, more concise bullets.",
+		`Commented: "Too much fluff"`,
+		`Rejected: "Passionate, results-driven engineer"`,
+		`Rewrote "Leveraged cutting-edge tooling to dramatically improve the deployment experience" as "Cut deploy time by 40%"`,
+		`Rewrote "Spearheaded a seamless migration to the new platform" as "Migrated 12 services to Kubernetes"`,
+	}, prefs)
+}
+
+func TestWritingPreferencesOnlyAccepted(t *testing.T) {
+	assert.Nil(t, domain.WritingPreferences(nil))
+	assert.Nil(t, domain.WritingPreferences([]domain.BulletFeedback{
+		{Verdict: domain.FeedbackVerdictAccepted, TailoredContent: "Built the billing API in Go"},
+	}))
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ProfileVocabulary holds the words and numbers of a user's profile, to tell
+// the terms of a tailored bullet taken from it from those the AI made up.
+type ProfileVocabulary struct {
+	words   map[string]bool
+	numbers map[string]bool
+}
+
+// NewProfileVocabulary returns the vocabulary of the given profile texts,
+// e.g. bullets, experience titles and organizations, and skill names.
+func NewProfileVocabulary(texts ...string) *ProfileVocabulary {
+	v := &ProfileVocabulary{words: make(map[string]bool), numbers: make(map[string]bool)}
+	for _, text := range texts {
+		for _, word := range strings.Fields(text) {
+			if term := trimTerm(word); term != "" {
+				v.words[strings.ToLower(term)] = true
+			}
+		}
+		for number := range numbersIn(text) {
+			v.numbers[number] = true
+		}
+	}
+	return v
+}
+
+// UnverifiedTerms returns the terms of rewrites, e.g. a tailored bullet and
+// its translation, that do not appear in the profile: numbers, names such as
+// companies (capitalized words that do not start a sentence) and
+// technologies (words with capitals, digits or symbols inside, such as
+// GraphQL, S3 or C++). Terms are returned once, in order of appearance.
+// The check is deterministic and errs on the side of flagging.
+func (v *ProfileVocabulary) UnverifiedTerms(rewrites ...string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	add := func(term string) {
+		if key := strings.ToLower(term); !seen[key] {
+			seen[key] = true
+			terms = append(terms, term)
+		}
+	}
+
+	for _, rewrite := range rewrites {
+		sentenceStart := true
+		for _, word := range strings.Fields(rewrite) {
+			term := trimTerm(word)
+			switch {
+			case term == "":
+			case isNumber(term):
+				for number := range numbersIn(term) {
+					if !v.numbers[number] {
+						add(term)
+					}
+				}
+			case looksLikeName(term, sentenceStart) && !v.words[strings.ToLower(term)]:
+				add(term)
+			}
+			sentenceStart = strings.ContainsAny(word[len(word)-1:], ".!?:")
+		}
+	}
+	return terms
+}
+
+// looksLikeName reports whether a term may be a name or a technology rather
+// than an ordinary word.
+func looksLikeName(term string, sentenceStart bool) bool {
+	for i, r := range term {
+		switch {
+		case i == 0 && unicode.IsUpper(r):
+			if !sentenceStart {
+				return true
+			}
+		case unicode.IsUpper(r), unicode.IsDigit(r), r == '+', r == '#':
+			return true
+		}
+	}
+	return false
+}
+
+// isNumber reports whether a term is a number, possibly with a unit such as
+// % or M.
+func isNumber(term string) bool {
+	r, _ := utf8.DecodeRuneInString(term)
+	return unicode.IsDigit(r)
+}
+
+// trimTerm strips the punctuation around a word and its possessive ending,
+// keeping the symbols ending technology names such as C++ and C#, and
+// percentages.
+func trimTerm(word string) string {
+	term := strings.TrimLeftFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	term = strings.TrimRightFunc(term, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#' && r != '%'
+	})
+	for _, suffix := range []string{"'s", "’s"} {
+		term = strings.TrimSuffix(term, suffix)
+	}
+	return term
+}
@@ -0,0 +1,58 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestProfileVocabularyUnverifiedTerms(t *testing.T) {
+	vocabulary := domain.NewProfileVocabulary(
+		"Reduced API latency by 40% across 1,200 services",
+		"Software Engineer at Acme Corp",
+		"Go", "PostgreSQL", "C++",
+	)
+
+	tests := []struct {
+		name     string
+		rewrites []string
+		want     []string
+	}{
+		{
+			name:     "terms from the profile",
+			rewrites: []string{"Cut API latency by 40% across 1.200 Go and PostgreSQL services at Acme's platform team"},
+		},
+		{
+			name:     "sentence starts are ordinary words",
+			rewrites: []string{"Optimized services. Reduced latency by 40%: Tuned queries."},
+		},
+		{
+			name:     "symbols of technology names",
+			rewrites: []string{"Rewrote C++ services, then C# tooling"},
+			want:     []string{"C#"},
+		},
+		{
+			name:     "invented numbers",
+			rewrites: []string{"Reduced latency by 45% for $2M in savings"},
+			want:     []string{"45%", "2M"},
+		},
+		{
+			name:     "invented companies and technologies",
+			rewrites: []string{"Migrated services at Google to Kubernetes on AWS S3"},
+			want:     []string{"Google", "Kubernetes", "AWS", "S3"},
+		},
+		{
+			name:     "terms in a translation, once each",
+			rewrites: []string{"Migrated services to Kubernetes", "Migrou serviços para Kubernetes na Google"},
+			want:     []string{"Kubernetes", "Google"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, vocabulary.UnverifiedTerms(tt.rewrites...))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package domain
+
+// BulletOutcome is what tailoring did with one of the user's bullets.
+type BulletOutcome string
+
+// Bullet outcome constants.
+const (
+	// BulletOutcomeSelected means the AI chose the bullet for the resume.
+	BulletOutcomeSelected BulletOutcome = "selected"
+
+	// BulletOutcomeDropped means the AI considered the bullet but did not
+	// choose it.
+	BulletOutcomeDropped BulletOutcome = "dropped"
+
+	// BulletOutcomeNotRanked means the bullet ranked too low to be sent to
+	// the AI.
+	BulletOutcomeNotRanked BulletOutcome = "not_ranked"
+
+	// BulletOutcomeExcluded means the bullet's experience is excluded from
+	// tailoring.
+	BulletOutcomeExcluded BulletOutcome = "excluded"
+)
+
+// BulletDecision records what tailoring did with a bullet and why.
+type BulletDecision struct {
+	BulletID string        `json:"bullet_id"`
+	Outcome  BulletOutcome `json:"outcome"`
+
+	// Reason is the AI's short explanation; empty when it gave none or
+	// never saw the bullet.
+	Reason string `json:"reason,omitempty"`
+
+	// Rejected holds the issues of the AI rewrite of a selected bullet that
+	// failed validation, so the bullet kept its original content.
+	Rejected []BulletIssue `json:"rejected,omitempty"`
+}
+
+// TailorValidation counts how the AI rewrites of the selected bullets fared
+// in validation; see ValidateTailoredBullet.
+type TailorValidation struct {
+	// Checked is the number of bullets whose rewrites were validated.
+	Checked int `json:"checked"`
+
+	// Retried is the number of bullets rewritten again after their first
+	// rewrite failed validation.
+	Retried int `json:"retried"`
+
+	// Rejected is the number of bullets that kept their original content
+	// because every rewrite failed validation.
+	Rejected int `json:"rejected"`
+
+	// TenseFixed is the number of bullets whose opening verb was put in the
+	// tense of their role: past for ended roles, present for current ones.
+	TenseFixed int `json:"tense_fixed"`
+}
+
+// SelectionExplanation explains the bullets chosen when a resume was last
+// tailored, so users can check or contest the choices.
+type SelectionExplanation struct {
+	// Reasoning is the AI's explanation of its overall selection strategy.
+	Reasoning string `json:"reasoning"`
+
+	// Bullets holds a decision for each of the user's bullets, selected
+	// ones first.
+	Bullets []BulletDecision `json:"bullets"`
+
+	// Validation summarizes the validation of the rewritten bullets; nil
+	// for resumes tailored before bullets were validated.
+	Validation *TailorValidation `json:"validation,omitempty"`
+}
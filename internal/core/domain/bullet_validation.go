@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// BulletIssue is a problem found in an AI rewrite of a bullet, or in other
+// generated text such as a summary.
+type BulletIssue string
+
+// Bullet issue constants, in the order ValidateTailoredBullet reports them.
+const (
+	// BulletIssueEmpty means the rewrite has no content.
+	BulletIssueEmpty BulletIssue = "empty"
+
+	// BulletIssueTooLong means the rewrite exceeds MaxTailoredBulletLength
+	// characters, or the length of the original when it already did.
+	BulletIssueTooLong BulletIssue = "too_long"
+
+	// BulletIssueFirstPerson means the rewrite uses a first-person pronoun.
+	BulletIssueFirstPerson BulletIssue = "first_person"
+
+	// BulletIssueProfanity means the text has offensive language.
+	BulletIssueProfanity BulletIssue = "profanity"
+
+	// BulletIssueImplausibleClaim means the text boasts or claims an
+	// improbable improvement; see ScreenGeneratedText.
+	BulletIssueImplausibleClaim BulletIssue = "implausible_claim"
+
+	// BulletIssueMissingKeyword means a keyword the AI claims to have used
+	// does not appear in the rewrite.
+	BulletIssueMissingKeyword BulletIssue = "missing_keyword"
+
+	// BulletIssueInventedNumber means the rewrite has a number the original
+	// does not.
+	BulletIssueInventedNumber BulletIssue = "invented_number"
+)
+
+// bulletIssueOrder lists the issues in the order they are reported.
+var bulletIssueOrder = []BulletIssue{
+	BulletIssueEmpty,
+	BulletIssueTooLong,
+	BulletIssueFirstPerson,
+	BulletIssueProfanity,
+	BulletIssueImplausibleClaim,
+	BulletIssueMissingKeyword,
+	BulletIssueInventedNumber,
+}
+
+// MaxTailoredBulletLength is the length in characters a rewritten bullet may
+// have, unless its original was already longer.
+const MaxTailoredBulletLength = 300
+
+// firstPersonWords are the first-person pronouns and possessives, in
+// English, Portuguese and Spanish, that resume bullets leave out.
+var firstPersonWords = map[string]bool{
+	// English; "I" is matched separately.
+	"me": true, "my": true, "mine": true, "myself": true,
+	"we": true, "us": true, "our": true, "ours": true, "ourselves": true,
+	// Portuguese.
+	"eu": true, "meu": true, "minha": true, "meus": true, "minhas": true,
+	"comigo": true, "nós": true, "nosso": true, "nossa": true, "nossos": true, "nossas": true,
+	// Spanish.
+	"yo": true, "mi": true, "mis": true, "mí": true, "mío": true, "mía": true,
+	"conmigo": true, "nosotros": true, "nosotras": true,
+	"nuestro": true, "nuestra": true, "nuestros": true, "nuestras": true,
+}
+
+// numberPattern matches numbers, with their thousands and decimal separators.
+var numberPattern = regexp.MustCompile(`\d+(?:[.,]\d+)*`)
+
+// ValidateTailoredBullet checks the AI rewrites of an original bullet, e.g.
+// its tailored content and translation, and returns the issues found, or nil
+// when they can replace the original. keywords are the job keywords the AI
+// claims to have used; each must appear in at least one rewrite. Numbers
+// are compared ignoring separators, so 1,000 matches 1.000 but not 1k.
+// Profanity and implausible claims are only issues when the original does
+// not have them already.
+func ValidateTailoredBullet(original string, keywords []string, rewrites ...string) []BulletIssue {
+	found := make(map[BulletIssue]bool)
+
+	limit := max(MaxTailoredBulletLength, utf8.RuneCountInString(original))
+	originalNumbers := numbersIn(original)
+	originalScreen := make(map[BulletIssue]bool)
+	for _, issue := range ScreenGeneratedText(original) {
+		originalScreen[issue] = true
+	}
+	for _, rewrite := range rewrites {
+		if strings.TrimSpace(rewrite) == "" {
+			found[BulletIssueEmpty] = true
+			continue
+		}
+		if utf8.RuneCountInString(rewrite) > limit {
+			found[BulletIssueTooLong] = true
+		}
+		if hasFirstPerson(rewrite) {
+			found[BulletIssueFirstPerson] = true
+		}
+		for _, issue := range ScreenGeneratedText(rewrite) {
+			if !originalScreen[issue] {
+				found[issue] = true
+			}
+		}
+		for number := range numbersIn(rewrite) {
+			if !originalNumbers[number] {
+				found[BulletIssueInventedNumber] = true
+			}
+		}
+	}
+
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword == "" {
+			continue
+		}
+		present := false
+		for _, rewrite := range rewrites {
+			if strings.Contains(strings.ToLower(rewrite), keyword) {
+				present = true
+				break
+			}
+		}
+		if !present {
+			found[BulletIssueMissingKeyword] = true
+		}
+	}
+
+	var issues []BulletIssue
+	for _, issue := range bulletIssueOrder {
+		if found[issue] {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// hasFirstPerson reports whether s has a first-person word. Words in
+// capitals other than "I", such as US, are taken for acronyms.
+func hasFirstPerson(s string) bool {
+	words := strings.FieldsFunc(s, func(r rune) bool { return !unicode.IsLetter(r) })
+	for _, word := range words {
+		if word == "I" {
+			return true
+		}
+		if utf8.RuneCountInString(word) > 1 && strings.ToUpper(word) == word {
+			continue
+		}
+		if firstPersonWords[strings.ToLower(word)] {
+			return true
+		}
+	}
+	return false
+}
+
+// numbersIn returns the numbers in s without their separators.
+func numbersIn(s string) map[string]bool {
+	numbers := make(map[string]bool)
+	for _, match := range numberPattern.FindAllString(s, -1) {
+		numbers[strings.NewReplacer(".", "", ",", "").Replace(match)] = true
+	}
+	return numbers
+}
@@ -0,0 +1,103 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestValidateTailoredBullet(t *testing.T) {
+	original := "Reduced API latency by 40% across 1,200 services written in Go"
+
+	tests := []struct {
+		name     string
+		keywords []string
+		rewrites []string
+		want     []domain.BulletIssue
+	}{
+		{
+			name:     "valid rewrite",
+			keywords: []string{"Go", "latency"},
+			rewrites: []string{"Cut API latency by 40% across 1,200 Go microservices"},
+		},
+		{
+			name:     "numbers with other separators",
+			rewrites: []string{"Reduziu a latência da API em 40% em 1.200 serviços"},
+		},
+		{
+			name:     "acronyms are not pronouns",
+			rewrites: []string{"Cut latency by 40% for US and MY regions"},
+		},
+		{
+			name:     "empty",
+			rewrites: []string{"  "},
+			want:     []domain.BulletIssue{domain.BulletIssueEmpty},
+		},
+		{
+			name:     "too long",
+			rewrites: []string{strings.Repeat("Scaled services ", 20)},
+			want:     []domain.BulletIssue{domain.BulletIssueTooLong},
+		},
+		{
+			name:     "first person",
+			rewrites: []string{"I cut latency by 40%"},
+			want:     []domain.BulletIssue{domain.BulletIssueFirstPerson},
+		},
+		{
+			name:     "first person in a translation",
+			rewrites: []string{"Cut latency by 40%", "Reduzi a latência da minha API em 40%"},
+			want:     []domain.BulletIssue{domain.BulletIssueFirstPerson},
+		},
+		{
+			name:     "claimed keyword missing",
+			keywords: []string{"Kubernetes"},
+			rewrites: []string{"Cut API latency by 40%"},
+			want:     []domain.BulletIssue{domain.BulletIssueMissingKeyword},
+		},
+		{
+			name:     "keyword in the translation only",
+			keywords: []string{"latência"},
+			rewrites: []string{"Cut API latency by 40%", "Reduziu a latência da API em 40%"},
+		},
+		{
+			name:     "invented number",
+			rewrites: []string{"Cut API latency by 45% for 2M users"},
+			want:     []domain.BulletIssue{domain.BulletIssueInventedNumber},
+		},
+		{
+			name:     "implausible claim",
+			rewrites: []string{"Single-handedly cut API latency by 40%"},
+			want:     []domain.BulletIssue{domain.BulletIssueImplausibleClaim},
+		},
+		{
+			name:     "several issues",
+			keywords: []string{"Terraform"},
+			rewrites: []string{"We cut latency by 60%"},
+			want: []domain.BulletIssue{
+				domain.BulletIssueFirstPerson,
+				domain.BulletIssueMissingKeyword,
+				domain.BulletIssueInventedNumber,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := domain.ValidateTailoredBullet(original, tt.keywords, tt.rewrites...)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("claims the original already makes", func(t *testing.T) {
+		boast := "Single-handedly migrated 40 services"
+		assert.Empty(t, domain.ValidateTailoredBullet(boast, nil, "Single-handedly migrated 40 Go services"))
+	})
+
+	t.Run("long originals may stay as long", func(t *testing.T) {
+		long := strings.Repeat("Scaled services ", 25)
+		assert.Empty(t, domain.ValidateTailoredBullet(long, nil, long))
+	})
+}
@@ -0,0 +1,67 @@
+package domain
+
+// ContactField is a contact detail shown in resume headers.
+type ContactField string
+
+// Contact field constants.
+const (
+	ContactPhone     ContactField = "phone"
+	ContactEmail     ContactField = "email"
+	ContactLinkedIn  ContactField = "linkedin"
+	ContactGitHub    ContactField = "github"
+	ContactPortfolio ContactField = "portfolio"
+)
+
+// DefaultContactOrder is the order of contact details in resume headers,
+// from the highest priority to the lowest.
+var DefaultContactOrder = []ContactField{
+	ContactPhone, ContactEmail, ContactLinkedIn, ContactGitHub, ContactPortfolio,
+}
+
+// IsValid checks if the contact field is valid.
+func (f ContactField) IsValid() bool {
+	switch f {
+	case ContactPhone, ContactEmail, ContactLinkedIn, ContactGitHub, ContactPortfolio:
+		return true
+	default:
+		return false
+	}
+}
+
+// Value returns the user's value for the field, or "" when it is not set.
+func (f ContactField) Value(user *User) string {
+	var value *string
+	switch f {
+	case ContactPhone:
+		value = user.Phone
+	case ContactEmail:
+		value = user.Email
+	case ContactLinkedIn:
+		value = user.LinkedInURL
+	case ContactGitHub:
+		value = user.GitHubURL
+	case ContactPortfolio:
+		value = user.PortfolioURL
+	}
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// ContactOrder returns the resume's contact order, from the highest priority
+// to the lowest: the fields it lists, then the others in the default order.
+// Headers that run out of room drop the lowest priority fields first.
+func (r *Resume) ContactOrder() []ContactField {
+	order := make([]ContactField, 0, len(DefaultContactOrder))
+	seen := make(map[ContactField]bool, len(DefaultContactOrder))
+	for _, fields := range [][]ContactField{r.ContactPriority, DefaultContactOrder} {
+		for _, f := range fields {
+			if f.IsValid() && !seen[f] {
+				seen[f] = true
+				order = append(order, f)
+			}
+		}
+	}
+	return order
+}
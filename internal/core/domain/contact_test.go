@@ -0,0 +1,44 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestResumeContactOrder(t *testing.T) {
+	t.Run("default order", func(t *testing.T) {
+		resume := &domain.Resume{}
+		assert.Equal(t, domain.DefaultContactOrder, resume.ContactOrder())
+	})
+
+	t.Run("listed fields first, then the rest in default order", func(t *testing.T) {
+		resume := &domain.Resume{
+			ContactPriority: []domain.ContactField{domain.ContactGitHub, domain.ContactEmail},
+		}
+		assert.Equal(t, []domain.ContactField{
+			domain.ContactGitHub, domain.ContactEmail, domain.ContactPhone,
+			domain.ContactLinkedIn, domain.ContactPortfolio,
+		}, resume.ContactOrder())
+	})
+
+	t.Run("skips unknown and repeated fields", func(t *testing.T) {
+		resume := &domain.Resume{
+			ContactPriority: []domain.ContactField{"fax", domain.ContactPortfolio, domain.ContactPortfolio},
+		}
+		assert.Equal(t, []domain.ContactField{
+			domain.ContactPortfolio, domain.ContactPhone, domain.ContactEmail,
+			domain.ContactLinkedIn, domain.ContactGitHub,
+		}, resume.ContactOrder())
+	})
+}
+
+func TestContactFieldValue(t *testing.T) {
+	email := "jane@example.com"
+	user := &domain.User{Email: &email}
+
+	assert.Equal(t, email, domain.ContactEmail.Value(user))
+	assert.Empty(t, domain.ContactPhone.Value(user))
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// profanities are offensive words, in English, Portuguese and Spanish, that
+// generated resume text must not contain. Words match whole.
+var profanities = map[string]bool{
+	// English.
+	"asshole": true, "bastard": true, "bitch": true, "bullshit": true, "crap": true,
+	"cunt": true, "damn": true, "dick": true, "fuck": true, "fucked": true,
+	"fucking": true, "motherfucker": true, "piss": true, "pissed": true,
+	"shit": true, "shitty": true, "wtf": true,
+	// Portuguese.
+	"babaca": true, "cacete": true, "caralho": true, "foda": true, "fodido": true,
+	"merda": true, "porra": true, "puta": true,
+	// Spanish.
+	"cabrón": true, "coño": true, "gilipollas": true, "jodido": true, "joder": true,
+	"mierda": true, "pendejo": true,
+}
+
+// absurdClaims are boasts no resume can back up.
+var absurdClaims = []string{
+	"single-handedly", "singlehandedly", "world's best", "best in the world",
+	"greatest of all time", "100% uptime", "zero bugs", "never made a mistake",
+	"melhor do mundo", "mejor del mundo",
+}
+
+// reductionWords introduce decreases, which cannot exceed 100%.
+var reductionWords = map[string]bool{
+	"cut": true, "cutting": true, "decreased": true, "decreasing": true,
+	"lowered": true, "reduced": true, "reducing": true, "shrank": true,
+	"cortou": true, "diminuiu": true, "diminuindo": true, "reduziu": true, "reduzindo": true,
+	"disminuyó": true, "disminuyendo": true, "recortó": true, "redujo": true, "reduciendo": true,
+}
+
+// Limits on claimed improvements.
+const (
+	maxPlausiblePercent    = 1000
+	maxPlausibleMultiplier = 100
+)
+
+var (
+	percentPattern    = regexp.MustCompile(`(\d+(?:[.,]\d+)*)\s*%`)
+	multiplierPattern = regexp.MustCompile(`(?i)(\d+(?:[.,]\d+)*)\s*[x×](?:\s|$|[.,;:!?)])`)
+	sentencePattern   = regexp.MustCompile(`[.!?](?:\s|$)`)
+	thousandsPattern  = regexp.MustCompile(`^\d{1,3}(?:[.,]\d{3})+$`)
+)
+
+// ScreenGeneratedText checks text written by the AI, e.g. a bullet rewrite or
+// a professional summary, for profanity and implausible claims: boasts such
+// as "single-handedly", improvements above 1000% or 100x, and reductions
+// above 100%. It returns the issues found, in the order of BulletIssue, or
+// nil.
+func ScreenGeneratedText(text string) []BulletIssue {
+	var issues []BulletIssue
+	if hasProfanity(text) {
+		issues = append(issues, BulletIssueProfanity)
+	}
+	if hasImplausibleClaim(text) {
+		issues = append(issues, BulletIssueImplausibleClaim)
+	}
+	return issues
+}
+
+// hasProfanity reports whether text has a word of the profanity list.
+func hasProfanity(text string) bool {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !unicode.IsLetter(r) })
+	for _, word := range words {
+		if profanities[word] {
+			return true
+		}
+	}
+	return false
+}
+
+// hasImplausibleClaim reports whether text boasts or claims improbable
+// improvements.
+func hasImplausibleClaim(text string) bool {
+	lower := strings.ToLower(strings.ReplaceAll(text, "’", "'"))
+	for _, claim := range absurdClaims {
+		if containsPhrase(lower, claim) {
+			return true
+		}
+	}
+
+	for _, match := range multiplierPattern.FindAllStringSubmatch(text, -1) {
+		if parseAmount(match[1]) >= maxPlausibleMultiplier {
+			return true
+		}
+	}
+
+	for _, sentence := range sentencePattern.Split(lower, -1) {
+		reduction := false
+		for _, word := range strings.FieldsFunc(sentence, func(r rune) bool { return !unicode.IsLetter(r) }) {
+			if reductionWords[word] {
+				reduction = true
+				break
+			}
+		}
+		for _, match := range percentPattern.FindAllStringSubmatch(sentence, -1) {
+			percent := parseAmount(match[1])
+			if percent > maxPlausiblePercent || (reduction && percent > 100) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// containsPhrase reports whether phrase appears in text as whole words.
+func containsPhrase(text, phrase string) bool {
+	for offset := 0; ; {
+		i := strings.Index(text[offset:], phrase)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(phrase)
+		if !isWordByteAt(text, start-1) && !isWordByteAt(text, end) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+// isWordByteAt reports whether text has a letter or digit byte at i.
+func isWordByteAt(text string, i int) bool {
+	if i < 0 || i >= len(text) {
+		return false
+	}
+	c := text[i]
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c >= 0x80
+}
+
+// parseAmount parses a number with thousands separators (1,000 or 1.000) or
+// a decimal separator (1.5 or 1,5).
+func parseAmount(s string) float64 {
+	if thousandsPattern.MatchString(s) {
+		s = strings.NewReplacer(",", "", ".", "").Replace(s)
+	} else {
+		s = strings.ReplaceAll(s, ",", ".")
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
@@ -0,0 +1,39 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestScreenGeneratedText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []domain.BulletIssue
+	}{
+		{"clean bullet", "Reduced deploy time by 40% and grew revenue 3x", nil},
+		{"clean summary", "Backend engineer. Improved throughput by 250%. Cut costs by 1,5%.", nil},
+		{"words containing profanities", "Documented the Scunthorpe assessment of class hierarchies", nil},
+		{"profanity", "Fixed a shitty legacy pipeline", []domain.BulletIssue{domain.BulletIssueProfanity}},
+		{"portuguese profanity", "Corrigiu uma merda de pipeline", []domain.BulletIssue{domain.BulletIssueProfanity}},
+		{"boast", "Single-handedly rebuilt the platform", []domain.BulletIssue{domain.BulletIssueImplausibleClaim}},
+		{"huge percentage", "Grew revenue by 5,000%", []domain.BulletIssue{domain.BulletIssueImplausibleClaim}},
+		{"huge multiplier", "Made queries 200x faster", []domain.BulletIssue{domain.BulletIssueImplausibleClaim}},
+		{"reduction above 100%", "Reduced latency by 150%", []domain.BulletIssue{domain.BulletIssueImplausibleClaim}},
+		{"growth above 100% in another sentence", "Reduced latency. Grew users by 150%.", nil},
+		{
+			"both",
+			"Best in the world at fucking up outages",
+			[]domain.BulletIssue{domain.BulletIssueProfanity, domain.BulletIssueImplausibleClaim},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, domain.ScreenGeneratedText(tt.text))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// CritiqueVerdict is the hiring manager's decision on a resume.
+type CritiqueVerdict string
+
+// Critique verdict constants.
+const (
+	CritiqueVerdictInterview CritiqueVerdict = "interview"
+	CritiqueVerdictMaybe     CritiqueVerdict = "maybe"
+	CritiqueVerdictReject    CritiqueVerdict = "reject"
+)
+
+// IsValid checks if the critique verdict is valid.
+func (v CritiqueVerdict) IsValid() bool {
+	switch v {
+	case CritiqueVerdictInterview, CritiqueVerdictMaybe, CritiqueVerdictReject:
+		return true
+	default:
+		return false
+	}
+}
+
+// SuggestedEdit is a concrete change proposed by a critique.
+type SuggestedEdit struct {
+	// Section is the part of the resume to change, e.g. "summary" or
+	// "experience: Backend Engineer at Acme".
+	Section string `json:"section"`
+
+	// Original is the current text; empty when the edit adds new content.
+	Original string `json:"original,omitempty"`
+
+	Suggested string `json:"suggested"`
+	Reason    string `json:"reason"`
+}
+
+// ResumeCritique is a review of a tailored resume written from the point of
+// view of a hiring manager for the target job. Critiques are kept so users can
+// track how successive versions of a resume improve.
+type ResumeCritique struct {
+	ID       string `json:"id"`
+	ResumeID string `json:"resume_id"`
+	UserID   string `json:"user_id"`
+
+	// ContentHash identifies the version of the generated content that was
+	// reviewed; see ResumeContentHash.
+	ContentHash string `json:"content_hash"`
+
+	// Score is the reviewer's overall rating of the resume for the job (0-100).
+	Score   MatchScore      `json:"score"`
+	Verdict CritiqueVerdict `json:"verdict"`
+	Summary string          `json:"summary"`
+
+	Strengths      []string        `json:"strengths"`
+	Weaknesses     []string        `json:"weaknesses"`
+	RedFlags       []string        `json:"red_flags"`
+	SuggestedEdits []SuggestedEdit `json:"suggested_edits"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Normalize trims the AI output, drops empty items and edits without a
+// suggestion, and falls back to a "maybe" verdict when the verdict is unknown.
+func (c *ResumeCritique) Normalize() {
+	c.Summary = strings.TrimSpace(c.Summary)
+	c.Verdict = CritiqueVerdict(strings.ToLower(strings.TrimSpace(string(c.Verdict))))
+	if !c.Verdict.IsValid() {
+		c.Verdict = CritiqueVerdictMaybe
+	}
+
+	c.Strengths = compactStrings(c.Strengths)
+	c.Weaknesses = compactStrings(c.Weaknesses)
+	c.RedFlags = compactStrings(c.RedFlags)
+
+	edits := make([]SuggestedEdit, 0, len(c.SuggestedEdits))
+	for _, edit := range c.SuggestedEdits {
+		edit.Section = strings.TrimSpace(edit.Section)
+		edit.Original = strings.TrimSpace(edit.Original)
+		edit.Suggested = strings.TrimSpace(edit.Suggested)
+		edit.Reason = strings.TrimSpace(edit.Reason)
+		if edit.Suggested == "" || edit.Suggested == edit.Original {
+			continue
+		}
+		edits = append(edits, edit)
+	}
+	c.SuggestedEdits = edits
+}
+
+// compactStrings trims each string and drops the empty ones.
+func compactStrings(values []string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// ResumeContentHash returns the SHA-256 of the generated content, identifying
+// one version of a resume. It returns an empty string for nil content.
+func ResumeContentHash(content *ResumeContent) string {
+	if content == nil {
+		return ""
+	}
+	data, err := json.Marshal(content)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
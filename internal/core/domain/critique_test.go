@@ -0,0 +1,55 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestResumeCritiqueNormalize(t *testing.T) {
+	critique := &domain.ResumeCritique{
+		Verdict:    " Interview ",
+		Summary:    "  Strong backend profile.  ",
+		Strengths:  []string{" Go experience ", "", "  "},
+		Weaknesses: nil,
+		RedFlags:   []string{"Short tenure at last role"},
+		SuggestedEdits: []domain.SuggestedEdit{
+			{Section: " summary ", Original: "Engineer", Suggested: " Backend engineer ", Reason: "Match the title"},
+			{Section: "skills", Original: "Go", Suggested: "Go"},
+			{Section: "skills", Suggested: "  "},
+		},
+	}
+
+	critique.Normalize()
+
+	assert.Equal(t, domain.CritiqueVerdictInterview, critique.Verdict)
+	assert.Equal(t, "Strong backend profile.", critique.Summary)
+	assert.Equal(t, []string{"Go experience"}, critique.Strengths)
+	assert.NotNil(t, critique.Weaknesses)
+	assert.Empty(t, critique.Weaknesses)
+	assert.Equal(t, []string{"Short tenure at last role"}, critique.RedFlags)
+	assert.Equal(t, []domain.SuggestedEdit{
+		{Section: "summary", Original: "Engineer", Suggested: "Backend engineer", Reason: "Match the title"},
+	}, critique.SuggestedEdits)
+}
+
+func TestResumeCritiqueNormalizeUnknownVerdict(t *testing.T) {
+	critique := &domain.ResumeCritique{Verdict: "strong hire"}
+	critique.Normalize()
+	assert.Equal(t, domain.CritiqueVerdictMaybe, critique.Verdict)
+}
+
+func TestResumeContentHash(t *testing.T) {
+	content := &domain.ResumeContent{Summary: "Backend engineer", Skills: []string{"Go"}}
+
+	hash := domain.ResumeContentHash(content)
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, domain.ResumeContentHash(&domain.ResumeContent{Summary: "Backend engineer", Skills: []string{"Go"}}))
+
+	content.Skills = append(content.Skills, "PostgreSQL")
+	assert.NotEqual(t, hash, domain.ResumeContentHash(content))
+
+	assert.Empty(t, domain.ResumeContentHash(nil))
+}
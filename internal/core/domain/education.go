@@ -16,6 +16,7 @@ type Education struct {
 	Location     *string   `json:"location,omitempty"`
 	StartDate    *Date     `json:"start_date,omitempty"`
 	EndDate      *Date     `json:"end_date,omitempty"`
+	IsExpected   bool      `json:"is_expected"` // EndDate is an expected graduation date
 	GPA          *string   `json:"gpa,omitempty"`
 	Honors       []string  `json:"honors,omitempty"`
 	DisplayOrder int       `json:"display_order"`
@@ -63,30 +64,36 @@ func (e *Education) Validate() error {
 		v.AddFieldError("degree", "degree is required")
 	}
 
+	hasStart := e.StartDate != nil && !e.StartDate.IsZero()
+	hasEnd := e.EndDate != nil && !e.EndDate.IsZero()
+
+	if hasStart && e.StartDate.IsFuture() {
+		v.AddFieldError("start_date", "start date cannot be in the future")
+	}
+
 	// Validate date range if both dates are provided.
-	if e.StartDate != nil && e.EndDate != nil {
-		if !e.StartDate.IsZero() && !e.EndDate.IsZero() {
-			if e.EndDate.Before(*e.StartDate) {
-				v.AddFieldError("end_date", "end date must be after start date")
-			}
-		}
+	if hasStart && hasEnd && e.EndDate.Before(*e.StartDate) {
+		v.AddFieldError("end_date", "end date must be after start date")
+	}
+
+	// An end date still to come is an expected graduation, and an expected
+	// graduation needs its date.
+	switch {
+	case e.IsExpected && !hasEnd:
+		v.AddFieldError("end_date", "expected graduation requires an end date")
+	case !e.IsExpected && hasEnd && e.EndDate.IsFuture():
+		v.AddFieldError("is_expected", "a future end date must be marked as an expected graduation")
 	}
 
 	return v.ToError()
 }
 
-// SetDates sets the start and end dates for the education entry.
-func (e *Education) SetDates(startDate, endDate *Date) error {
-	if startDate != nil && endDate != nil {
-		if !startDate.IsZero() && !endDate.IsZero() && endDate.Before(*startDate) {
-			return ErrInvalidDateRange
-		}
-	}
-
+// SetDates sets the start and end dates for the education entry. The date
+// range is checked by Validate.
+func (e *Education) SetDates(startDate, endDate *Date) {
 	e.StartDate = startDate
 	e.EndDate = endDate
 	e.UpdatedAt = time.Now().UTC()
-	return nil
 }
 
 // SetFieldOfStudy sets the field of study.
@@ -140,6 +147,10 @@ func (e *Education) DateRange() string {
 		return start + " -- Present"
 	}
 
+	if e.IsExpected {
+		return start + " -- Expected " + e.EndDate.Format("Jan. 2006")
+	}
+
 	return start + " -- " + e.EndDate.Format("Jan. 2006")
 }
 
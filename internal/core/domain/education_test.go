@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestEducationExpectedGraduation(t *testing.T) {
+	edu, err := domain.NewEducation("user-1", "MIT", "BSc Computer Science")
+	require.NoError(t, err)
+
+	start := domain.NewMonthDate(2021, time.September)
+	end := domain.NewMonthDate(2025, time.June)
+	edu.SetDates(&start, &end)
+
+	t.Run("expected end date", func(t *testing.T) {
+		edu.IsExpected = true
+		assert.NoError(t, edu.Validate())
+		assert.Equal(t, "Sep. 2021 -- Expected Jun. 2025", edu.DateRange())
+	})
+
+	t.Run("expected requires an end date", func(t *testing.T) {
+		noEnd := *edu
+		noEnd.EndDate = nil
+		assert.Error(t, noEnd.Validate())
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		edu.IsExpected = false
+		assert.Equal(t, "Sep. 2021 -- Jun. 2025", edu.DateRange())
+	})
+}
+
+func TestEducationValidateDates(t *testing.T) {
+	nextYear := time.Now().UTC().Year() + 1
+	date := func(year int, month time.Month) *domain.Date {
+		d := domain.NewMonthDate(year, month)
+		return &d
+	}
+
+	tests := []struct {
+		name     string
+		start    *domain.Date
+		end      *domain.Date
+		expected bool
+		field    string
+	}{
+		{"end before start", date(2021, time.September), date(2020, time.June), false, "end_date"},
+		{"future start", date(nextYear, time.September), nil, false, "start_date"},
+		{"future end not expected", date(2021, time.September), date(nextYear, time.June), false, "is_expected"},
+		{"future end expected", date(2021, time.September), date(nextYear, time.June), true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edu, err := domain.NewEducation("user-1", "MIT", "BSc Computer Science")
+			require.NoError(t, err)
+			edu.SetDates(tt.start, tt.end)
+			edu.IsExpected = tt.expected
+
+			err = edu.Validate()
+			if tt.field == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr *domain.ValidationErrors
+			require.ErrorAs(t, err, &validationErr)
+			require.Len(t, validationErr.Errors, 1)
+			assert.Equal(t, tt.field, validationErr.Errors[0].Field)
+		})
+	}
+}
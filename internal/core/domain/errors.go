@@ -10,11 +10,34 @@ var (
 	ErrUserAlreadyExists  = errors.New("user already exists")
 	ErrInvalidFirebaseUID = errors.New("invalid firebase UID")
 
+	// ErrAccountExists is returned when signing up with an email that
+	// another account uses, unverified or from an untrusted provider; the user must link the new sign-in method
+	// from that account instead.
+	ErrAccountExists = errors.New("an account with this email already exists")
+
+	// Linked identity errors.
+	ErrIdentityNotFound      = errors.New("identity not found")
+	ErrIdentityAlreadyLinked = errors.New("identity is already linked to this account")
+	ErrIdentityInUse         = errors.New("identity belongs to another account")
+	ErrInvalidIdentityToken  = errors.New("identity token is invalid or expired")
+
+	// Impersonation errors.
+	ErrInvalidImpersonationScope = errors.New("impersonation scope must be read or full")
+	ErrCannotImpersonateSelf     = errors.New("admins cannot impersonate themselves")
+	ErrInvalidImpersonationToken = errors.New("impersonation token is invalid")
+	ErrImpersonationExpired      = errors.New("impersonation token has expired")
+
+	// User archive errors.
+	ErrUnsupportedArchiveVersion = errors.New("unsupported user archive version")
+
+	// Preferences errors.
+	ErrPreferencesNotFound = errors.New("preferences not found")
+
 	// Experience errors.
 	ErrExperienceNotFound    = errors.New("experience not found")
 	ErrInvalidExperienceType = errors.New("invalid experience type")
 	ErrInvalidDateRange      = errors.New("end date must be after start date")
-	ErrInvalidDateFormat     = errors.New("invalid date format, expected YYYY-MM-DD")
+	ErrInvalidDateFormat     = errors.New("invalid date format, expected YYYY-MM-DD or YYYY-MM")
 	ErrCurrentWithEndDate    = errors.New("current experience cannot have an end date")
 
 	// Bullet errors.
@@ -43,22 +66,70 @@ var (
 	ErrInvalidStatusTransition = errors.New("invalid status transition")
 	ErrNoBulletsAvailable      = errors.New("no bullets available for resume generation")
 	ErrResumeNotReady          = errors.New("resume is not ready for PDF generation")
+	ErrUnsupportedExportFormat = errors.New("unsupported export format")
+
+	// ErrBulletsNeedReview is returned when a resume is marked reviewed
+	// while some of its tailored bullets await the user's confirmation.
+	ErrBulletsNeedReview = errors.New("tailored bullets need review")
+
+	// ErrTailorTimeout is returned when a stage of tailoring a resume runs
+	// out of its time budget.
+	ErrTailorTimeout = errors.New("resume tailoring ran out of time")
+
+	// ErrBulkActionAborted is reported for the resumes of a transactional
+	// bulk action left unchanged because the action failed for another one.
+	ErrBulkActionAborted = errors.New("bulk action aborted")
+
+	// ErrContentRejected is returned when the AI keeps generating text that
+	// fails the content filter and there is nothing to fall back to.
+	ErrContentRejected = errors.New("generated content was rejected by the content filter")
+
+	// Resume tag errors.
+	ErrResumeTagNotFound      = errors.New("resume tag not found")
+	ErrResumeTagAlreadyExists = errors.New("resume tag already exists for this user")
+
+	// Saved filter errors.
+	ErrSavedFilterNotFound      = errors.New("saved filter not found")
+	ErrSavedFilterAlreadyExists = errors.New("saved filter already exists for this user")
+
+	// File upload errors.
+	ErrFileNotFound     = errors.New("file not found")
+	ErrInvalidFileKey   = errors.New("invalid file key")
+	ErrFileTooLarge     = errors.New("file is too large")
+	ErrFileInfected     = errors.New("file is infected")
+	ErrUploadNotFound   = errors.New("upload not found")
+	ErrInvalidChunk     = errors.New("invalid upload chunk")
+	ErrIncompleteUpload = errors.New("upload is missing chunks")
 
 	// Validation errors.
 	ErrValidation          = errors.New("validation error")
 	ErrRequiredField       = errors.New("required field is missing")
 	ErrInvalidEmail        = errors.New("invalid email format")
 	ErrInvalidURL          = errors.New("invalid URL format")
+	ErrURLNotAllowed       = errors.New("URL is not allowed to be fetched")
 	ErrInvalidLanguageCode = errors.New("invalid language code")
 
+	// Plan and quota errors.
+	ErrInvalidPlan   = errors.New("invalid plan")
+	ErrQuotaExceeded = errors.New("plan quota exceeded")
+
 	// Authorization errors.
 	ErrUnauthorized = errors.New("unauthorized access")
 	ErrForbidden    = errors.New("access forbidden")
 
+	// Session errors.
+	ErrSessionRevoked       = errors.New("session was revoked")
+	ErrSessionsNotSupported = errors.New("the authentication provider does not support session management")
+
 	// External service errors.
 	ErrAIServiceUnavailable  = errors.New("AI service is unavailable")
 	ErrPDFServiceUnavailable = errors.New("PDF service is unavailable")
 	ErrJobParserUnavailable  = errors.New("job parser service is unavailable")
+	ErrVirusScanUnavailable  = errors.New("virus scan service is unavailable")
+
+	// ErrUpstreamUnavailable is returned without calling an external service
+	// while its circuit breaker is open.
+	ErrUpstreamUnavailable = errors.New("upstream service is unavailable")
 )
 
 // DomainError wraps a domain error with additional context.
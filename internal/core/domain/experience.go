@@ -14,6 +14,11 @@ type Experience struct {
 	StartDate    Date           `json:"start_date"`
 	EndDate      *Date          `json:"end_date,omitempty"`
 	IsCurrent    bool           `json:"is_current"`
+
+	// ExcludeFromTailoring leaves the experience's bullets out of tailoring,
+	// e.g. for unrelated early-career jobs; see Resume.TailorsExperience.
+	ExcludeFromTailoring bool `json:"exclude_from_tailoring"`
+
 	Description  *string        `json:"description,omitempty"`
 	URL          *string        `json:"url,omitempty"`
 	Metadata     map[string]any `json:"metadata,omitempty"`
@@ -66,6 +71,8 @@ func (e *Experience) Validate() error {
 
 	if e.StartDate.IsZero() {
 		v.AddFieldError("start_date", "start date is required")
+	} else if e.StartDate.IsFuture() {
+		v.AddFieldError("start_date", "start date cannot be in the future")
 	}
 
 	// Validate date range.
@@ -83,18 +90,14 @@ func (e *Experience) Validate() error {
 	return v.ToError()
 }
 
-// SetEndDate sets the end date and updates is_current accordingly.
-func (e *Experience) SetEndDate(endDate *Date) error {
-	if endDate != nil && !endDate.IsZero() && endDate.Before(e.StartDate) {
-		return ErrInvalidDateRange
-	}
-
+// SetEndDate sets the end date and updates is_current accordingly. The date
+// range is checked by Validate.
+func (e *Experience) SetEndDate(endDate *Date) {
 	e.EndDate = endDate
 	if endDate != nil && !endDate.IsZero() {
 		e.IsCurrent = false
 	}
 	e.UpdatedAt = time.Now().UTC()
-	return nil
 }
 
 // MarkAsCurrent marks the experience as current (ongoing).
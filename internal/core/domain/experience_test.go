@@ -2,6 +2,7 @@ package domain_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -82,17 +83,48 @@ func TestExperienceSetEndDate(t *testing.T) {
 	exp, _ := domain.NewExperience("user-123", domain.ExperienceTypeWork, "Title", "Org", startDate)
 
 	t.Run("sets valid end date", func(t *testing.T) {
+		exp.MarkAsCurrent()
 		endDate := domain.NewDate(2022, 12, 31)
-		err := exp.SetEndDate(&endDate)
-		require.NoError(t, err)
+		exp.SetEndDate(&endDate)
 		assert.False(t, exp.IsCurrent)
+		assert.NoError(t, exp.Validate())
 	})
 
-	t.Run("rejects end date before start date", func(t *testing.T) {
+	t.Run("reports end date before start date", func(t *testing.T) {
 		endDate := domain.NewDate(2019, 1, 1)
-		err := exp.SetEndDate(&endDate)
-		require.Error(t, err)
-		assert.ErrorIs(t, err, domain.ErrInvalidDateRange)
+		exp.SetEndDate(&endDate)
+
+		var validationErr *domain.ValidationErrors
+		require.ErrorAs(t, exp.Validate(), &validationErr)
+		assert.Equal(t, "end_date", validationErr.Errors[0].Field)
+	})
+}
+
+func TestExperienceValidateDates(t *testing.T) {
+	t.Run("future start date", func(t *testing.T) {
+		next := time.Now().UTC().AddDate(0, 1, 0)
+		exp, _ := domain.NewExperience("user-123", domain.ExperienceTypeWork, "Title", "Org", domain.NewMonthDate(next.Year(), next.Month()))
+
+		var validationErr *domain.ValidationErrors
+		require.ErrorAs(t, exp.Validate(), &validationErr)
+		assert.Equal(t, "start_date", validationErr.Errors[0].Field)
+	})
+
+	t.Run("start date this month", func(t *testing.T) {
+		now := time.Now().UTC()
+		exp, _ := domain.NewExperience("user-123", domain.ExperienceTypeWork, "Title", "Org", domain.NewMonthDate(now.Year(), now.Month()))
+		assert.NoError(t, exp.Validate())
+	})
+
+	t.Run("current with an end date", func(t *testing.T) {
+		exp, _ := domain.NewExperience("user-123", domain.ExperienceTypeWork, "Title", "Org", domain.NewDate(2020, 1, 15))
+		endDate := domain.NewDate(2022, 12, 31)
+		exp.SetEndDate(&endDate)
+		exp.IsCurrent = true
+
+		var validationErr *domain.ValidationErrors
+		require.ErrorAs(t, exp.Validate(), &validationErr)
+		assert.Equal(t, "is_current", validationErr.Errors[0].Field)
 	})
 }
 
@@ -0,0 +1,143 @@
+package domain
+
+import "strings"
+
+// FilenamePattern names downloaded resume files. Tokens in braces, such as
+// {name}, are replaced with details of the user and resume; the extension of
+// the downloaded format is appended.
+type FilenamePattern string
+
+// DefaultFilenamePattern gives names like "Jane_Doe_Resume_Acme".
+const DefaultFilenamePattern FilenamePattern = "{name}_Resume_{target}"
+
+// MaxFilenamePatternLength is the maximum length of a filename pattern.
+const MaxFilenamePatternLength = 100
+
+// Filename pattern tokens.
+const (
+	// FilenameTokenName is the user's display name.
+	FilenameTokenName = "name"
+
+	// FilenameTokenCompany is the company of the job applied to.
+	FilenameTokenCompany = "company"
+
+	// FilenameTokenJobTitle is the title of the job applied to.
+	FilenameTokenJobTitle = "job_title"
+
+	// FilenameTokenTarget is the company, or the job title without one.
+	FilenameTokenTarget = "target"
+
+	// FilenameTokenLanguage is the resume's target language.
+	FilenameTokenLanguage = "language"
+
+	// FilenameTokenDate is the download date as YYYY-MM-DD, in the user's
+	// time zone.
+	FilenameTokenDate = "date"
+)
+
+// filenameTokens holds the tokens a pattern can use.
+var filenameTokens = map[string]bool{
+	FilenameTokenName:     true,
+	FilenameTokenCompany:  true,
+	FilenameTokenJobTitle: true,
+	FilenameTokenTarget:   true,
+	FilenameTokenLanguage: true,
+	FilenameTokenDate:     true,
+}
+
+// IsValid checks if the pattern is valid.
+func (p FilenamePattern) IsValid() bool {
+	return p.problem() == ""
+}
+
+// problem describes why the pattern is invalid, or returns "" if it is valid.
+// Outside of tokens, patterns are limited to ASCII letters, digits, spaces
+// and "_", "-" and ".", which are safe in filenames on every platform.
+func (p FilenamePattern) problem() string {
+	s := string(p)
+	if strings.TrimSpace(s) == "" {
+		return "must not be empty"
+	}
+	if len(s) > MaxFilenamePatternLength {
+		return "must be at most 100 characters"
+	}
+
+	hasToken := false
+	for s != "" {
+		if s[0] == '{' {
+			end := strings.IndexByte(s, '}')
+			if end < 0 {
+				return "has an unclosed '{'"
+			}
+			if !filenameTokens[s[1:end]] {
+				return "has unknown token {" + s[1:end] + "}; use {name}, {company}, {job_title}, {target}, {language} or {date}"
+			}
+			hasToken = true
+			s = s[end+1:]
+			continue
+		}
+
+		c := s[0]
+		if !isFilenameChar(c) {
+			return "may only contain letters, digits, spaces, '_', '-', '.' and tokens"
+		}
+		s = s[1:]
+	}
+
+	if !hasToken {
+		return "must contain at least one token"
+	}
+	return ""
+}
+
+// isFilenameChar reports whether c may appear outside of tokens.
+func isFilenameChar(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	case c == ' ', c == '_', c == '-', c == '.':
+		return true
+	default:
+		return false
+	}
+}
+
+// Expand replaces the tokens of a valid pattern with their values. Tokens
+// without a value are dropped, along with the separators they leave doubled
+// or dangling at either end, so "{name}_{company}" gives "Jane" without a
+// company rather than "Jane_".
+func (p FilenamePattern) Expand(values map[string]string) string {
+	var sb strings.Builder
+	s := string(p)
+	for s != "" {
+		if s[0] == '{' {
+			if end := strings.IndexByte(s, '}'); end > 0 {
+				sb.WriteString(values[s[1:end]])
+				s = s[end+1:]
+				continue
+			}
+		}
+		sb.WriteByte(s[0])
+		s = s[1:]
+	}
+
+	return collapseSeparators(sb.String())
+}
+
+// collapseSeparators trims separators from both ends of name and collapses
+// runs of them to their first character.
+func collapseSeparators(name string) string {
+	var sb strings.Builder
+	lastSeparator := true
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		separator := c == ' ' || c == '_' || c == '-' || c == '.'
+		if separator && lastSeparator {
+			continue
+		}
+		sb.WriteByte(c)
+		lastSeparator = separator
+	}
+
+	return strings.TrimRight(sb.String(), " _-.")
+}
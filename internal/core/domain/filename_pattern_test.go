@@ -0,0 +1,57 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestFilenamePatternIsValid(t *testing.T) {
+	tests := []struct {
+		pattern domain.FilenamePattern
+		valid   bool
+	}{
+		{domain.DefaultFilenamePattern, true},
+		{"{name}_{company}_{date}", true},
+		{"CV - {job_title} ({language})", false},
+		{"CV - {job_title}.{language}", true},
+		{"", false},
+		{"   ", false},
+		{"Resume", false},
+		{"{name}_{salary}", false},
+		{"{name}_{company", false},
+		{"{name}/{company}", false},
+		{"{name}_Currículo", false},
+		{domain.FilenamePattern("{name}" + strings.Repeat("_", domain.MaxFilenamePatternLength)), false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.valid, tt.pattern.IsValid(), "pattern %q", tt.pattern)
+	}
+}
+
+func TestFilenamePatternExpand(t *testing.T) {
+	values := map[string]string{
+		domain.FilenameTokenName:    "Jane_Doe",
+		domain.FilenameTokenCompany: "Acme",
+		domain.FilenameTokenDate:    "2026-01-09",
+	}
+
+	tests := []struct {
+		pattern  domain.FilenamePattern
+		expected string
+	}{
+		{"{name}_{company}_{date}", "Jane_Doe_Acme_2026-01-09"},
+		{"{name}_Resume_{job_title}", "Jane_Doe_Resume"},
+		{"{name}_{job_title}_{date}", "Jane_Doe_2026-01-09"},
+		{"{job_title} - {name}", "Jane_Doe"},
+		{"{job_title}", ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, tt.pattern.Expand(values), "pattern %q", tt.pattern)
+	}
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"net/http"
+	"time"
+)
+
+// ImpersonationScope limits what an admin may do while impersonating a user.
+type ImpersonationScope string
+
+// Impersonation scopes.
+const (
+	// ImpersonationScopeRead only allows reading, for debugging what a user
+	// sees.
+	ImpersonationScopeRead ImpersonationScope = "read"
+
+	// ImpersonationScopeFull allows everything the user may do.
+	ImpersonationScopeFull ImpersonationScope = "full"
+)
+
+// IsValid checks if the scope is a known impersonation scope.
+func (s ImpersonationScope) IsValid() bool {
+	return s == ImpersonationScopeRead || s == ImpersonationScopeFull
+}
+
+// Impersonation is an admin acting as a user for support debugging, for a
+// short time.
+type Impersonation struct {
+	// ID identifies the impersonation in the audit log.
+	ID        string             `json:"id"`
+	AdminID   string             `json:"admin_id"`
+	UserID    string             `json:"user_id"`
+	Scope     ImpersonationScope `json:"scope"`
+	ExpiresAt time.Time          `json:"expires_at"`
+}
+
+// Allows reports whether the scope of the impersonation allows a request
+// with the given HTTP method.
+func (i *Impersonation) Allows(method string) bool {
+	if i.Scope == ImpersonationScopeFull {
+		return true
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package domain
+
+import "strings"
+
+// RemotePolicy is where a job is performed.
+type RemotePolicy string
+
+// Remote policy constants.
+const (
+	RemotePolicyRemote RemotePolicy = "remote"
+	RemotePolicyHybrid RemotePolicy = "hybrid"
+	RemotePolicyOnsite RemotePolicy = "onsite"
+)
+
+// IsValid checks if the remote policy is valid.
+func (p RemotePolicy) IsValid() bool {
+	switch p {
+	case RemotePolicyRemote, RemotePolicyHybrid, RemotePolicyOnsite:
+		return true
+	default:
+		return false
+	}
+}
+
+// SalaryPeriod is the period a salary amount is paid for.
+type SalaryPeriod string
+
+// Salary period constants.
+const (
+	SalaryPeriodYear  SalaryPeriod = "year"
+	SalaryPeriodMonth SalaryPeriod = "month"
+	SalaryPeriodHour  SalaryPeriod = "hour"
+)
+
+// HoursPerYear converts hourly rates to annual amounts (40 hours x 52 weeks).
+const HoursPerYear = 2080
+
+// IsValid checks if the salary period is valid.
+func (p SalaryPeriod) IsValid() bool {
+	switch p {
+	case SalaryPeriodYear, SalaryPeriodMonth, SalaryPeriodHour:
+		return true
+	default:
+		return false
+	}
+}
+
+// AnnualFactor returns the multiplier that converts an amount paid per period
+// to an annual amount.
+func (p SalaryPeriod) AnnualFactor() int {
+	switch p {
+	case SalaryPeriodMonth:
+		return 12
+	case SalaryPeriodHour:
+		return HoursPerYear
+	default:
+		return 1
+	}
+}
+
+// SalaryRange is the pay advertised for a job. Either bound may be missing.
+type SalaryRange struct {
+	Min *int `json:"min,omitempty"`
+	Max *int `json:"max,omitempty"`
+
+	// Currency is an ISO 4217 code, e.g. "USD"; empty when not stated.
+	Currency string       `json:"currency,omitempty"`
+	Period   SalaryPeriod `json:"period"`
+}
+
+// JobInsights are details about a job beyond its requirements, extracted
+// from the job description when present.
+type JobInsights struct {
+	// Salary is nil when the description states no pay.
+	Salary *SalaryRange `json:"salary,omitempty"`
+
+	Benefits []string `json:"benefits"`
+
+	// RemotePolicy is empty when the description does not say.
+	RemotePolicy RemotePolicy `json:"remote_policy,omitempty"`
+
+	// VisaSponsorship is nil when sponsorship is not mentioned.
+	VisaSponsorship *bool `json:"visa_sponsorship,omitempty"`
+}
+
+// Normalize cleans up AI-extracted insights: unknown remote policies are
+// dropped, non-positive salary bounds are removed, swapped bounds are fixed,
+// currencies are upper-cased ISO codes, the period defaults to a year, and
+// empty benefits are removed.
+func (i *JobInsights) Normalize() {
+	policy := RemotePolicy(strings.ToLower(strings.TrimSpace(string(i.RemotePolicy))))
+	if policy == "on-site" || policy == "on_site" {
+		policy = RemotePolicyOnsite
+	}
+	if !policy.IsValid() {
+		policy = ""
+	}
+	i.RemotePolicy = policy
+
+	i.Benefits = compactStrings(i.Benefits)
+
+	if s := i.Salary; s != nil {
+		if s.Min != nil && *s.Min <= 0 {
+			s.Min = nil
+		}
+		if s.Max != nil && *s.Max <= 0 {
+			s.Max = nil
+		}
+		if s.Min != nil && s.Max != nil && *s.Min > *s.Max {
+			s.Min, s.Max = s.Max, s.Min
+		}
+
+		s.Currency = strings.ToUpper(strings.TrimSpace(s.Currency))
+		if len(s.Currency) != 3 {
+			s.Currency = ""
+		}
+
+		s.Period = SalaryPeriod(strings.ToLower(strings.TrimSpace(string(s.Period))))
+		if !s.Period.IsValid() {
+			s.Period = SalaryPeriodYear
+		}
+
+		if s.Min == nil && s.Max == nil {
+			i.Salary = nil
+		}
+	}
+}
+
+// IsEmpty reports whether no insight was found.
+func (i *JobInsights) IsEmpty() bool {
+	return i.Salary == nil && len(i.Benefits) == 0 && i.RemotePolicy == "" && i.VisaSponsorship == nil
+}
@@ -0,0 +1,57 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestJobInsightsNormalize(t *testing.T) {
+	t.Run("cleans up extracted values", func(t *testing.T) {
+		insights := &domain.JobInsights{
+			RemotePolicy: " On-Site ",
+			Benefits:     []string{" Health insurance ", ""},
+			Salary: &domain.SalaryRange{
+				Min:      intPtr(120000),
+				Max:      intPtr(90000),
+				Currency: "usd",
+				Period:   "annually",
+			},
+		}
+
+		insights.Normalize()
+
+		assert.Equal(t, domain.RemotePolicyOnsite, insights.RemotePolicy)
+		assert.Equal(t, []string{"Health insurance"}, insights.Benefits)
+		require.NotNil(t, insights.Salary)
+		assert.Equal(t, 90000, *insights.Salary.Min)
+		assert.Equal(t, 120000, *insights.Salary.Max)
+		assert.Equal(t, "USD", insights.Salary.Currency)
+		assert.Equal(t, domain.SalaryPeriodYear, insights.Salary.Period)
+		assert.False(t, insights.IsEmpty())
+	})
+
+	t.Run("drops unknown values", func(t *testing.T) {
+		insights := &domain.JobInsights{
+			RemotePolicy: "flexible",
+			Salary:       &domain.SalaryRange{Min: intPtr(0), Currency: "dollars", Period: "hour"},
+		}
+
+		insights.Normalize()
+
+		assert.Empty(t, insights.RemotePolicy)
+		assert.Nil(t, insights.Salary)
+		assert.True(t, insights.IsEmpty())
+	})
+}
+
+func TestSalaryPeriodAnnualFactor(t *testing.T) {
+	assert.Equal(t, 1, domain.SalaryPeriodYear.AnnualFactor())
+	assert.Equal(t, 12, domain.SalaryPeriodMonth.AnnualFactor())
+	assert.Equal(t, domain.HoursPerYear, domain.SalaryPeriodHour.AnnualFactor())
+}
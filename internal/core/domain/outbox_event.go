@@ -0,0 +1,51 @@
+package domain
+
+import "time"
+
+// OutboxEventType identifies what happened in an outbox event.
+type OutboxEventType string
+
+// Outbox event types.
+const (
+	// EventUserCreated is sent when a user signs in for the first time.
+	EventUserCreated OutboxEventType = "user.created"
+
+	// EventResumeTailored is sent when a resume has been tailored.
+	EventResumeTailored OutboxEventType = "resume.tailored"
+
+	// EventResumePDFGenerated is sent when a resume PDF has been generated
+	// and stored.
+	EventResumePDFGenerated OutboxEventType = "resume.pdf_generated"
+
+	// EventResumeStatusChanged is sent when a resume's status has changed.
+	// Its data holds the from and to statuses.
+	EventResumeStatusChanged OutboxEventType = "resume.status_changed"
+)
+
+// OutboxEvent is an event recorded in the same transaction as the change it
+// describes, then delivered to webhooks and event buses at least once by a
+// relay. Receivers should ignore events whose ID they have already seen.
+type OutboxEvent struct {
+	ID       string          `json:"id"`
+	Type     OutboxEventType `json:"type"`
+	UserID   string          `json:"user_id"`
+	ResumeID string          `json:"resume_id,omitempty"`
+
+	// Data holds the type-specific details of the event.
+	Data map[string]string `json:"data,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+
+	// Attempts counts the failed deliveries so far.
+	Attempts int `json:"-"`
+}
+
+// NewUserOutboxEvent returns an event of the given type about a user.
+func NewUserOutboxEvent(eventType OutboxEventType, user *User) OutboxEvent {
+	return OutboxEvent{Type: eventType, UserID: user.ID}
+}
+
+// NewResumeOutboxEvent returns an event of the given type about a resume.
+func NewResumeOutboxEvent(eventType OutboxEventType, resume *Resume, data map[string]string) OutboxEvent {
+	return OutboxEvent{Type: eventType, UserID: resume.UserID, ResumeID: resume.ID, Data: data}
+}
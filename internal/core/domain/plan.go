@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Plan is the subscription plan of a user, which determines usage limits.
+type Plan string
+
+// Plan constants.
+const (
+	PlanFree Plan = "free"
+	PlanPro  Plan = "pro"
+)
+
+// IsValid checks if the plan is valid.
+func (p Plan) IsValid() bool {
+	return p == PlanFree || p == PlanPro
+}
+
+// ParsePlan parses a string into a Plan.
+func ParsePlan(s string) (Plan, error) {
+	plan := Plan(s)
+	if !plan.IsValid() {
+		return "", ErrInvalidPlan
+	}
+	return plan, nil
+}
+
+// UsageAction is a metered action counted against a plan's limits.
+type UsageAction string
+
+// Usage action constants.
+const (
+	UsageResumeCreated   UsageAction = "resume_created"
+	UsageTailor          UsageAction = "tailor"
+	UsagePDFRegeneration UsageAction = "pdf_regeneration"
+)
+
+// QuotaPeriod is the window over which usage is counted.
+type QuotaPeriod string
+
+// Quota period constants. Periods are calendar days and months in UTC.
+const (
+	QuotaPeriodDay   QuotaPeriod = "day"
+	QuotaPeriodMonth QuotaPeriod = "month"
+)
+
+// Start returns the start of the period containing t.
+func (p QuotaPeriod) Start(t time.Time) time.Time {
+	t = t.UTC()
+	if p == QuotaPeriodDay {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// End returns the start of the period following the one containing t.
+func (p QuotaPeriod) End(t time.Time) time.Time {
+	start := p.Start(t)
+	if p == QuotaPeriodDay {
+		return start.AddDate(0, 0, 1)
+	}
+	return start.AddDate(0, 1, 0)
+}
+
+// PlanLimits holds the usage limits of a plan. A zero limit means unlimited.
+type PlanLimits struct {
+	ResumesPerMonth          int `json:"resumes_per_month"`
+	TailorsPerDay            int `json:"tailors_per_day"`
+	PDFRegenerationsPerMonth int `json:"pdf_regenerations_per_month"`
+}
+
+// DefaultPlanLimits returns the default limits for every plan.
+func DefaultPlanLimits() map[Plan]PlanLimits {
+	return map[Plan]PlanLimits{
+		PlanFree: {ResumesPerMonth: 10, TailorsPerDay: 20, PDFRegenerationsPerMonth: 50},
+		PlanPro:  {ResumesPerMonth: 200, TailorsPerDay: 200, PDFRegenerationsPerMonth: 1000},
+	}
+}
+
+// Limit returns the limit and counting period for an action.
+func (l PlanLimits) Limit(action UsageAction) (int, QuotaPeriod) {
+	switch action {
+	case UsageResumeCreated:
+		return l.ResumesPerMonth, QuotaPeriodMonth
+	case UsageTailor:
+		return l.TailorsPerDay, QuotaPeriodDay
+	case UsagePDFRegeneration:
+		return l.PDFRegenerationsPerMonth, QuotaPeriodMonth
+	default:
+		return 0, QuotaPeriodMonth
+	}
+}
+
+// QuotaExceededError reports which plan limit was reached. It matches ErrQuotaExceeded.
+type QuotaExceededError struct {
+	Action   UsageAction
+	Limit    int
+	Period   QuotaPeriod
+	ResetsAt time.Time
+}
+
+// Error returns the error message.
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s: %s limit of %d per %s reached", ErrQuotaExceeded, e.Action, e.Limit, e.Period)
+}
+
+// Unwrap returns ErrQuotaExceeded.
+func (e *QuotaExceededError) Unwrap() error {
+	return ErrQuotaExceeded
+}
@@ -0,0 +1,39 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestQuotaPeriodBounds(t *testing.T) {
+	now := time.Date(2026, time.December, 31, 23, 30, 0, 0, time.FixedZone("BRT", -3*60*60))
+
+	assert.Equal(t, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), domain.QuotaPeriodDay.Start(now))
+	assert.Equal(t, time.Date(2027, time.January, 2, 0, 0, 0, 0, time.UTC), domain.QuotaPeriodDay.End(now))
+	assert.Equal(t, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), domain.QuotaPeriodMonth.Start(now))
+	assert.Equal(t, time.Date(2027, time.February, 1, 0, 0, 0, 0, time.UTC), domain.QuotaPeriodMonth.End(now))
+}
+
+func TestPlanLimitsLimit(t *testing.T) {
+	limits := domain.PlanLimits{ResumesPerMonth: 10, TailorsPerDay: 20, PDFRegenerationsPerMonth: 50}
+
+	limit, period := limits.Limit(domain.UsageTailor)
+	assert.Equal(t, 20, limit)
+	assert.Equal(t, domain.QuotaPeriodDay, period)
+
+	limit, period = limits.Limit(domain.UsagePDFRegeneration)
+	assert.Equal(t, 50, limit)
+	assert.Equal(t, domain.QuotaPeriodMonth, period)
+}
+
+func TestQuotaExceededErrorMatchesSentinel(t *testing.T) {
+	err := &domain.QuotaExceededError{Action: domain.UsageTailor, Limit: 20, Period: domain.QuotaPeriodDay}
+
+	assert.True(t, errors.Is(err, domain.ErrQuotaExceeded))
+	assert.Contains(t, err.Error(), "tailor limit of 20 per day reached")
+}
@@ -0,0 +1,137 @@
+package domain
+
+import "time"
+
+// DateFormat controls how month/year dates are printed on resumes.
+type DateFormat string
+
+// Date format constants.
+const (
+	// DateFormatLocale follows the resume's target language (default).
+	DateFormatLocale DateFormat = "locale"
+
+	// DateFormatMonthYear prints abbreviated month names, e.g. "Jan 2024".
+	DateFormatMonthYear DateFormat = "mon_yyyy"
+
+	// DateFormatNumeric prints "01/2024".
+	DateFormatNumeric DateFormat = "mm/yyyy"
+
+	// DateFormatISO prints "2024-01".
+	DateFormatISO DateFormat = "yyyy-mm"
+)
+
+// IsValid checks if the date format is valid.
+func (f DateFormat) IsValid() bool {
+	switch f {
+	case DateFormatLocale, DateFormatMonthYear, DateFormatNumeric, DateFormatISO:
+		return true
+	default:
+		return false
+	}
+}
+
+// Resume templates that can be chosen as a default.
+const (
+	TemplateJake       = "jake"
+	TemplateEuropass   = "europass"
+	TemplateAcademic   = "academic"
+	TemplateFunctional = "functional"
+)
+
+// Preference limits.
+const (
+	MinMaxBullets = 1
+	MaxMaxBullets = 50
+	MinFontSize   = 9
+	MaxFontSize   = 12
+)
+
+// UserPreferences holds a user's defaults for resume generation and
+// notifications. Zero values mean "use the system default".
+type UserPreferences struct {
+	UserID string `json:"user_id"`
+
+	// DefaultTemplate is used when a PDF is requested without a template.
+	DefaultTemplate string `json:"default_template"`
+
+	// DefaultTargetLanguage is used when a resume is created without a language.
+	DefaultTargetLanguage string `json:"default_target_language"`
+
+	// DefaultMaxBullets is used when a resume is tailored without a bullet limit.
+	DefaultMaxBullets int `json:"default_max_bullets"`
+
+	// DefaultFontSize is the base font size in pt for rendered resumes; 0
+	// keeps each template's own default.
+	DefaultFontSize int `json:"default_font_size"`
+
+	DateFormat DateFormat `json:"date_format"`
+
+	// Timezone is an IANA time zone name, e.g. "America/Sao_Paulo".
+	Timezone string `json:"timezone"`
+
+	// FilenamePattern names downloaded resume files.
+	FilenamePattern FilenamePattern `json:"filename_pattern"`
+
+	EmailProductUpdates bool `json:"email_product_updates"`
+	EmailUsageAlerts    bool `json:"email_usage_alerts"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DefaultUserPreferences returns the preferences of a user who never saved any.
+func DefaultUserPreferences(userID string) *UserPreferences {
+	now := time.Now().UTC()
+	return &UserPreferences{
+		UserID:                userID,
+		DefaultTemplate:       TemplateJake,
+		DefaultTargetLanguage: "en",
+		DefaultMaxBullets:     15,
+		DateFormat:            DateFormatLocale,
+		Timezone:              "UTC",
+		FilenamePattern:       DefaultFilenamePattern,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+}
+
+// Validate validates the preferences.
+func (p *UserPreferences) Validate() error {
+	v := &ValidationErrors{}
+
+	if p.UserID == "" {
+		v.AddFieldError("user_id", "user ID is required")
+	}
+
+	switch p.DefaultTemplate {
+	case TemplateJake, TemplateEuropass, TemplateAcademic, TemplateFunctional:
+	default:
+		v.AddFieldError("default_template", "must be 'jake', 'europass', 'academic' or 'functional'")
+	}
+
+	if p.DefaultTargetLanguage != "en" && p.DefaultTargetLanguage != "pt-br" {
+		v.AddFieldError("default_target_language", "must be 'en' or 'pt-br'")
+	}
+
+	if p.DefaultMaxBullets < MinMaxBullets || p.DefaultMaxBullets > MaxMaxBullets {
+		v.AddFieldError("default_max_bullets", "must be between 1 and 50")
+	}
+
+	if p.DefaultFontSize != 0 && (p.DefaultFontSize < MinFontSize || p.DefaultFontSize > MaxFontSize) {
+		v.AddFieldError("default_font_size", "must be between 9 and 12, or 0 for the template default")
+	}
+
+	if !p.DateFormat.IsValid() {
+		v.AddFieldError("date_format", "must be 'locale', 'mon_yyyy', 'mm/yyyy' or 'yyyy-mm'")
+	}
+
+	if _, err := time.LoadLocation(p.Timezone); err != nil || p.Timezone == "" {
+		v.AddFieldError("timezone", "must be an IANA time zone name")
+	}
+
+	if problem := p.FilenamePattern.problem(); problem != "" {
+		v.AddFieldError("filename_pattern", problem)
+	}
+
+	return v.ToError()
+}
@@ -0,0 +1,48 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestUserPreferencesValidate(t *testing.T) {
+	t.Run("defaults are valid", func(t *testing.T) {
+		assert.NoError(t, domain.DefaultUserPreferences("user-1").Validate())
+	})
+
+	t.Run("font size 0 keeps the template default", func(t *testing.T) {
+		prefs := domain.DefaultUserPreferences("user-1")
+		prefs.DefaultFontSize = 0
+		assert.NoError(t, prefs.Validate())
+
+		prefs.DefaultFontSize = 11
+		assert.NoError(t, prefs.Validate())
+	})
+
+	t.Run("rejects out of range values", func(t *testing.T) {
+		prefs := domain.DefaultUserPreferences("user-1")
+		prefs.DefaultTemplate = "modern"
+		prefs.DefaultTargetLanguage = "fr"
+		prefs.DefaultMaxBullets = 51
+		prefs.DefaultFontSize = 14
+		prefs.DateFormat = "dd/mm/yyyy"
+		prefs.Timezone = ""
+
+		var validationErr *domain.ValidationErrors
+		require.True(t, errors.As(prefs.Validate(), &validationErr))
+
+		fields := make([]string, 0, len(validationErr.Errors))
+		for _, e := range validationErr.Errors {
+			fields = append(fields, e.Field)
+		}
+		assert.ElementsMatch(t, []string{
+			"default_template", "default_target_language", "default_max_bullets",
+			"default_font_size", "date_format", "timezone",
+		}, fields)
+	})
+}
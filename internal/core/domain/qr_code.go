@@ -0,0 +1,43 @@
+package domain
+
+// QRCodeTarget is the profile link a resume's header QR code points to.
+type QRCodeTarget string
+
+// QR code target constants. QRCodeNone leaves the QR code out.
+const (
+	QRCodeNone      QRCodeTarget = ""
+	QRCodePortfolio QRCodeTarget = "portfolio"
+	QRCodeWebsite   QRCodeTarget = "website"
+	QRCodeLinkedIn  QRCodeTarget = "linkedin"
+	QRCodeGitHub    QRCodeTarget = "github"
+)
+
+// IsValid checks if the QR code target is valid.
+func (t QRCodeTarget) IsValid() bool {
+	switch t {
+	case QRCodeNone, QRCodePortfolio, QRCodeWebsite, QRCodeLinkedIn, QRCodeGitHub:
+		return true
+	default:
+		return false
+	}
+}
+
+// URL returns the user's link for the target, or "" when the user has not
+// set it or the target is QRCodeNone.
+func (t QRCodeTarget) URL(user *User) string {
+	var link *string
+	switch t {
+	case QRCodePortfolio:
+		link = user.PortfolioURL
+	case QRCodeWebsite:
+		link = user.Website
+	case QRCodeLinkedIn:
+		link = user.LinkedInURL
+	case QRCodeGitHub:
+		link = user.GitHubURL
+	}
+	if link == nil {
+		return ""
+	}
+	return *link
+}
@@ -0,0 +1,25 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestQRCodeTargetIsValid(t *testing.T) {
+	for _, target := range []domain.QRCodeTarget{domain.QRCodeNone, domain.QRCodePortfolio, domain.QRCodeWebsite, domain.QRCodeLinkedIn, domain.QRCodeGitHub} {
+		assert.True(t, target.IsValid(), target)
+	}
+	assert.False(t, domain.QRCodeTarget("twitter").IsValid())
+}
+
+func TestQRCodeTargetURL(t *testing.T) {
+	portfolio := "https://jane.dev"
+	user := &domain.User{PortfolioURL: &portfolio}
+
+	assert.Equal(t, portfolio, domain.QRCodePortfolio.URL(user))
+	assert.Empty(t, domain.QRCodeGitHub.URL(user), "unset links have no URL")
+	assert.Empty(t, domain.QRCodeNone.URL(user))
+}
@@ -1,7 +1,12 @@
 // Package domain contains the core business entities and value objects.
 package domain
 
-import "time"
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
 
 // Resume represents a generated resume tailored to a specific job application.
 type Resume struct {
@@ -11,6 +16,7 @@ type Resume struct {
 	JobTitle         *string        `json:"job_title,omitempty"`
 	CompanyName      *string        `json:"company_name,omitempty"`
 	JobURL           *string        `json:"job_url,omitempty"`
+	JobInsights      *JobInsights   `json:"job_insights,omitempty"`
 	TargetLanguage   string         `json:"target_language"`
 	SelectedBullets  []string       `json:"selected_bullets"`
 	GeneratedContent *ResumeContent `json:"generated_content,omitempty"`
@@ -18,8 +24,45 @@ type Resume struct {
 	Score            MatchScore     `json:"score"`
 	Notes            *string        `json:"notes,omitempty"`
 	Status           ResumeStatus   `json:"status"`
-	CreatedAt        time.Time      `json:"created_at"`
-	UpdatedAt        time.Time      `json:"updated_at"`
+	QRCode           QRCodeTarget   `json:"qr_code,omitempty"`
+
+	// ContactPriority orders the contact details in the header, from the
+	// highest priority to the lowest; see ContactOrder.
+	ContactPriority []ContactField `json:"contact_priority,omitempty"`
+
+	// Sections holds display options for the resume's sections.
+	Sections SectionConfig `json:"sections"`
+
+	// MaxPages is the number of pages the resume may span, from 1 to
+	// MaxResumePages; see PageLimit.
+	MaxPages int `json:"max_pages"`
+
+	// IncludedExperienceIDs and ExcludedExperienceIDs override, for this
+	// resume, whether experiences are tailored; see TailorsExperience.
+	IncludedExperienceIDs []string `json:"included_experience_ids,omitempty"`
+	ExcludedExperienceIDs []string `json:"excluded_experience_ids,omitempty"`
+
+	// Selection explains the bullets chosen when the resume was last
+	// tailored; nil before tailoring.
+	Selection *SelectionExplanation `json:"selection,omitempty"`
+
+	// TagIDs are the IDs of the user's tags on the resume. They are
+	// changed through tag assignments, not by updating the resume.
+	TagIDs []string `json:"tag_ids,omitempty"`
+
+	// ArchivedAt is when the user archived the resume, nil unless archived.
+	// Archived resumes are kept, and lists can leave them out.
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// Pinned resumes can be listed first; see ResumeSortPinnedFirst.
+	Pinned bool `json:"pinned"`
+
+	// LastAccessedAt is when the user last opened the resume, nil if never.
+	// It is recorded on reads, not by updating the resume.
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 // ResumeContent represents the AI-generated content for a resume.
@@ -28,6 +71,70 @@ type ResumeContent struct {
 	Experiences []TailoredExperience `json:"experiences"`
 	Skills      []string             `json:"skills"`
 	Analysis    *ResumeAnalysis      `json:"analysis,omitempty"`
+
+	// SourceLanguage is the language of the bullets' tailored content when
+	// they were translated to the resume's target language.
+	SourceLanguage string `json:"source_language,omitempty"`
+
+	// Themes group the tailored bullets by the skill they demonstrate, for
+	// the functional template. Empty when the AI did not group them.
+	Themes []SkillTheme `json:"themes,omitempty"`
+
+	// Job is the AI analysis of the job description the content was
+	// tailored to, kept to regenerate parts of the content without
+	// analyzing the job again. Nil for content tailored before it was kept.
+	Job *JobRequirements `json:"job,omitempty"`
+
+	// SummaryVariants are the summaries the AI wrote from different
+	// angles; Summary is the text of the one at SummaryAngle. Empty for
+	// content tailored before variants were kept, or when the user's own
+	// summary was used.
+	SummaryVariants []SummaryVariant `json:"summary_variants,omitempty"`
+	SummaryAngle    string           `json:"summary_angle,omitempty"`
+
+	// Voice is the tone and target reader the content was written with,
+	// kept to write regenerated parts the same way. Nil when tailored
+	// with the defaults.
+	Voice *WritingVoice `json:"voice,omitempty"`
+
+	// AISettings are the settings the content was tailored with, to tailor
+	// it again the same way. Nil for content tailored before they were
+	// kept.
+	AISettings *AIRunSettings `json:"ai_settings,omitempty"`
+}
+
+// Angles of the summary variants.
+const (
+	SummaryAngleTechnical  = "technical"
+	SummaryAngleLeadership = "leadership"
+	SummaryAngleImpact     = "impact"
+)
+
+// SummaryAngles are the angles summaries are written from, the first being
+// the one used by default.
+var SummaryAngles = []string{SummaryAngleTechnical, SummaryAngleLeadership, SummaryAngleImpact}
+
+// SummaryVariant is a professional summary written from one angle.
+type SummaryVariant struct {
+	Angle   string `json:"angle"`
+	Summary string `json:"summary"`
+}
+
+// JobRequirements are what the AI found a job description asks for.
+type JobRequirements struct {
+	RequiredSkills  []string `json:"required_skills"`
+	PreferredSkills []string `json:"preferred_skills"`
+	Keywords        []string `json:"keywords"`
+	SeniorityLevel  string   `json:"seniority_level,omitempty"`
+	YearsExperience *int     `json:"years_experience,omitempty"`
+	Summary         string   `json:"summary,omitempty"`
+}
+
+// SkillTheme is a skill theme of a functional resume and the tailored
+// bullets, by ID, that demonstrate it.
+type SkillTheme struct {
+	Name      string   `json:"name"`
+	BulletIDs []string `json:"bullet_ids"`
 }
 
 // TailoredExperience represents an experience entry tailored for a specific job.
@@ -46,6 +153,94 @@ type TailoredBullet struct {
 	BulletID        string `json:"bullet_id"`
 	OriginalContent string `json:"original_content"`
 	TailoredContent string `json:"tailored_content"`
+
+	// TranslatedContent is the tailored content in the resume's target
+	// language, set when it differs from the profile language. Tailored
+	// content then stays in the profile language for verification.
+	TranslatedContent string `json:"translated_content,omitempty"`
+
+	// NeedsReview is set when the tailored or translated content has terms
+	// not found in the user's profile, listed in UnverifiedTerms. The user
+	// must confirm such bullets before the resume is marked reviewed.
+	NeedsReview     bool     `json:"needs_review,omitempty"`
+	UnverifiedTerms []string `json:"unverified_terms,omitempty"`
+}
+
+// DisplayContent returns the text to render on the resume: the translation
+// if there is one, else the tailored content, else the original content.
+func (b TailoredBullet) DisplayContent() string {
+	if b.TranslatedContent != "" {
+		return b.TranslatedContent
+	}
+	if b.TailoredContent != "" {
+		return b.TailoredContent
+	}
+	return b.OriginalContent
+}
+
+// BulletsNeedingReview returns the IDs of the bullets awaiting the user's
+// confirmation.
+func (c *ResumeContent) BulletsNeedingReview() []string {
+	var ids []string
+	for _, exp := range c.Experiences {
+		for _, bullet := range exp.Bullets {
+			if bullet.NeedsReview {
+				ids = append(ids, bullet.BulletID)
+			}
+		}
+	}
+	return ids
+}
+
+// ConfirmBullets records that the user checked the given bullets, clearing
+// their review flags. Every ID must be a bullet of the content.
+func (c *ResumeContent) ConfirmBullets(bulletIDs []string) error {
+	index := make(map[string]*TailoredBullet)
+	for i := range c.Experiences {
+		for j := range c.Experiences[i].Bullets {
+			bullet := &c.Experiences[i].Bullets[j]
+			index[bullet.BulletID] = bullet
+		}
+	}
+
+	v := &ValidationErrors{}
+	if len(bulletIDs) == 0 {
+		v.AddFieldError("bullet_ids", "at least one bullet is required")
+	}
+	for i, id := range bulletIDs {
+		if _, ok := index[id]; !ok {
+			v.AddFieldError(fmt.Sprintf("bullet_ids[%d]", i), fmt.Sprintf("bullet %q is not on the resume", id))
+		}
+	}
+	if err := v.ToError(); err != nil {
+		return err
+	}
+
+	for _, id := range bulletIDs {
+		index[id].NeedsReview = false
+		index[id].UnverifiedTerms = nil
+	}
+	return nil
+}
+
+// SelectSummary makes the variant written from angle the summary of the
+// content.
+func (c *ResumeContent) SelectSummary(angle string) error {
+	for _, variant := range c.SummaryVariants {
+		if variant.Angle == angle {
+			c.Summary = variant.Summary
+			c.SummaryAngle = angle
+			return nil
+		}
+	}
+
+	v := &ValidationErrors{}
+	if len(c.SummaryVariants) == 0 {
+		v.AddFieldError("angle", "the resume has no summary variants; tailor it again to generate them")
+	} else {
+		v.AddFieldError("angle", fmt.Sprintf("the resume has no %q summary", angle))
+	}
+	return v.ToError()
 }
 
 // ResumeAnalysis contains the AI analysis of how well the resume matches the job.
@@ -57,6 +252,144 @@ type ResumeAnalysis struct {
 	ImprovementAreas []string `json:"improvement_areas"`
 }
 
+// Validate checks the generated content structure: required fields,
+// non-empty bullet lists and unique bullet IDs.
+func (c *ResumeContent) Validate() error {
+	v := &ValidationErrors{}
+
+	if strings.TrimSpace(c.Summary) == "" {
+		v.AddFieldError("summary", "summary is required")
+	}
+
+	if len(c.Experiences) == 0 {
+		v.AddFieldError("experiences", "at least one experience is required")
+	}
+
+	seenBullets := make(map[string]bool)
+	for i, exp := range c.Experiences {
+		field := fmt.Sprintf("experiences[%d]", i)
+
+		if exp.ExperienceID == "" {
+			v.AddFieldError(field+".experience_id", "experience ID is required")
+		}
+		if strings.TrimSpace(exp.Title) == "" {
+			v.AddFieldError(field+".title", "title is required")
+		}
+		if strings.TrimSpace(exp.Organization) == "" {
+			v.AddFieldError(field+".organization", "organization is required")
+		}
+		if _, err := ParseDate(exp.StartDate); err != nil {
+			v.AddFieldError(field+".start_date", "must be a date in YYYY-MM-DD or YYYY-MM format")
+		}
+		if exp.EndDate != nil {
+			if exp.IsCurrent {
+				v.AddFieldError(field+".end_date", "current experience cannot have an end date")
+			} else if _, err := ParseDate(*exp.EndDate); err != nil {
+				v.AddFieldError(field+".end_date", "must be a date in YYYY-MM-DD or YYYY-MM format")
+			}
+		}
+
+		if len(exp.Bullets) == 0 {
+			v.AddFieldError(field+".bullets", "at least one bullet is required")
+		}
+		for j, bullet := range exp.Bullets {
+			bulletField := fmt.Sprintf("%s.bullets[%d]", field, j)
+
+			if bullet.BulletID == "" {
+				v.AddFieldError(bulletField+".bullet_id", "bullet ID is required")
+			} else if seenBullets[bullet.BulletID] {
+				v.AddFieldError(bulletField+".bullet_id", "duplicate bullet ID")
+			}
+			seenBullets[bullet.BulletID] = true
+
+			if strings.TrimSpace(bullet.TailoredContent) == "" {
+				v.AddFieldError(bulletField+".tailored_content", "tailored content is required")
+			}
+		}
+	}
+
+	for i, skill := range c.Skills {
+		if strings.TrimSpace(skill) == "" {
+			v.AddFieldError(fmt.Sprintf("skills[%d]", i), "skill name cannot be empty")
+		}
+	}
+
+	return v.ToError()
+}
+
+// Repair fixes common defects in AI-generated content before validation:
+// it trims whitespace, falls back to the original text for empty tailored
+// bullets, drops empty or duplicate bullets and skills, and removes
+// experiences left without bullets. Themes keep only bullets of the content,
+// each under its first theme, and themes left without bullets are dropped.
+func (c *ResumeContent) Repair() {
+	c.Summary = strings.TrimSpace(c.Summary)
+
+	seenBullets := make(map[string]bool)
+	experiences := c.Experiences[:0]
+	for _, exp := range c.Experiences {
+		exp.Title = strings.TrimSpace(exp.Title)
+		exp.Organization = strings.TrimSpace(exp.Organization)
+		exp.StartDate = strings.TrimSpace(exp.StartDate)
+		if exp.EndDate != nil && (exp.IsCurrent || strings.TrimSpace(*exp.EndDate) == "") {
+			exp.EndDate = nil
+		}
+
+		bullets := make([]TailoredBullet, 0, len(exp.Bullets))
+		for _, bullet := range exp.Bullets {
+			bullet.TailoredContent = strings.TrimSpace(bullet.TailoredContent)
+			bullet.OriginalContent = strings.TrimSpace(bullet.OriginalContent)
+			bullet.TranslatedContent = strings.TrimSpace(bullet.TranslatedContent)
+			if bullet.TailoredContent == "" {
+				bullet.TailoredContent = bullet.OriginalContent
+			}
+			if bullet.BulletID == "" || bullet.TailoredContent == "" || seenBullets[bullet.BulletID] {
+				continue
+			}
+			seenBullets[bullet.BulletID] = true
+			bullets = append(bullets, bullet)
+		}
+		if len(bullets) == 0 {
+			continue
+		}
+		exp.Bullets = bullets
+		experiences = append(experiences, exp)
+	}
+	c.Experiences = experiences
+
+	seenSkills := make(map[string]bool)
+	skills := make([]string, 0, len(c.Skills))
+	for _, skill := range c.Skills {
+		skill = strings.TrimSpace(skill)
+		key := strings.ToLower(skill)
+		if skill == "" || seenSkills[key] {
+			continue
+		}
+		seenSkills[key] = true
+		skills = append(skills, skill)
+	}
+	c.Skills = skills
+
+	themed := make(map[string]bool)
+	themes := c.Themes[:0]
+	for _, theme := range c.Themes {
+		theme.Name = strings.TrimSpace(theme.Name)
+		ids := make([]string, 0, len(theme.BulletIDs))
+		for _, id := range theme.BulletIDs {
+			if seenBullets[id] && !themed[id] {
+				themed[id] = true
+				ids = append(ids, id)
+			}
+		}
+		if theme.Name == "" || len(ids) == 0 {
+			continue
+		}
+		theme.BulletIDs = ids
+		themes = append(themes, theme)
+	}
+	c.Themes = themes
+}
+
 // NewResume creates a new resume draft with required fields.
 func NewResume(userID, jobDescription string) (*Resume, error) {
 	if jobDescription == "" {
@@ -73,11 +406,35 @@ func NewResume(userID, jobDescription string) (*Resume, error) {
 		SelectedBullets: make([]string, 0),
 		Score:           score,
 		Status:          ResumeStatusDraft,
+		MaxPages:        1,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}, nil
 }
 
+// MaxResumePages is the most pages a resume may span.
+const MaxResumePages = 2
+
+// PageLimit returns the number of pages the resume may span; resumes without
+// a MaxPages span one.
+func (r *Resume) PageLimit() int {
+	if r.MaxPages < 1 {
+		return 1
+	}
+	return r.MaxPages
+}
+
+// SetMaxPages sets the number of pages the resume may span.
+func (r *Resume) SetMaxPages(pages int) error {
+	if pages < 1 || pages > MaxResumePages {
+		v := &ValidationErrors{}
+		v.AddFieldError("max_pages", fmt.Sprintf("must be between 1 and %d", MaxResumePages))
+		return v
+	}
+	r.MaxPages = pages
+	return nil
+}
+
 // Validate validates the resume entity.
 func (r *Resume) Validate() error {
 	v := &ValidationErrors{}
@@ -115,11 +472,44 @@ func (r *Resume) SetJobDetails(title, company, url string) {
 	r.UpdatedAt = time.Now().UTC()
 }
 
-// SetGeneratedContent sets the AI-generated content.
-func (r *Resume) SetGeneratedContent(content *ResumeContent) {
+// SetGeneratedContent repairs, validates and sets the AI-generated content.
+// When bullets were selected, every tailored bullet must reference one of them.
+func (r *Resume) SetGeneratedContent(content *ResumeContent) error {
+	if content == nil {
+		v := &ValidationErrors{}
+		v.AddFieldError("generated_content", "generated content is required")
+		return v
+	}
+
+	content.Repair()
+	if err := content.Validate(); err != nil {
+		return err
+	}
+
+	if len(r.SelectedBullets) > 0 {
+		selected := make(map[string]bool, len(r.SelectedBullets))
+		for _, id := range r.SelectedBullets {
+			selected[id] = true
+		}
+
+		v := &ValidationErrors{}
+		for i, exp := range content.Experiences {
+			for j, bullet := range exp.Bullets {
+				if !selected[bullet.BulletID] {
+					v.AddFieldError(fmt.Sprintf("experiences[%d].bullets[%d].bullet_id", i, j),
+						"does not reference a selected bullet")
+				}
+			}
+		}
+		if err := v.ToError(); err != nil {
+			return err
+		}
+	}
+
 	r.GeneratedContent = content
 	r.Status = ResumeStatusGenerated
 	r.UpdatedAt = time.Now().UTC()
+	return nil
 }
 
 // SetScore sets the match score.
@@ -161,12 +551,60 @@ func (r *Resume) RemoveSelectedBullet(bulletID string) {
 	}
 }
 
+// TailorsExperience reports whether tailoring the resume draws on the
+// experience's bullets: experiences the resume excludes are left out, those
+// it includes are used, and others are used unless they are excluded from
+// tailoring.
+func (r *Resume) TailorsExperience(exp *Experience) bool {
+	if slices.Contains(r.ExcludedExperienceIDs, exp.ID) {
+		return false
+	}
+	if slices.Contains(r.IncludedExperienceIDs, exp.ID) {
+		return true
+	}
+	return !exp.ExcludeFromTailoring
+}
+
 // SetPDFURL sets the URL of the generated PDF.
 func (r *Resume) SetPDFURL(url string) {
 	r.PDFURL = &url
 	r.UpdatedAt = time.Now().UTC()
 }
 
+// Archive archives the resume. Archiving an archived resume keeps the time
+// it was first archived.
+func (r *Resume) Archive() {
+	if r.ArchivedAt != nil {
+		return
+	}
+	now := time.Now().UTC()
+	r.ArchivedAt = &now
+	r.UpdatedAt = now
+}
+
+// Unarchive restores an archived resume.
+func (r *Resume) Unarchive() {
+	if r.ArchivedAt == nil {
+		return
+	}
+	r.ArchivedAt = nil
+	r.UpdatedAt = time.Now().UTC()
+}
+
+// IsArchived returns true if the resume is archived.
+func (r *Resume) IsArchived() bool {
+	return r.ArchivedAt != nil
+}
+
+// Pin pins or unpins the resume.
+func (r *Resume) Pin(pinned bool) {
+	if r.Pinned == pinned {
+		return
+	}
+	r.Pinned = pinned
+	r.UpdatedAt = time.Now().UTC()
+}
+
 // TransitionStatus transitions the resume to a new status.
 func (r *Resume) TransitionStatus(newStatus ResumeStatus) error {
 	if !newStatus.IsValid() {
@@ -191,6 +629,11 @@ func (r *Resume) TransitionStatus(newStatus ResumeStatus) error {
 
 	for _, status := range allowed {
 		if status == newStatus {
+			// Bullets with terms not found in the profile must be confirmed
+			// first.
+			if newStatus == ResumeStatusReviewed && r.GeneratedContent != nil && len(r.GeneratedContent.BulletsNeedingReview()) > 0 {
+				return ErrBulletsNeedReview
+			}
 			r.Status = newStatus
 			r.UpdatedAt = time.Now().UTC()
 			return nil
@@ -210,6 +653,11 @@ func (r *Resume) IsGenerated() bool {
 	return r.Status == ResumeStatusGenerated || r.Status == ResumeStatusReviewed
 }
 
+// IsUnreviewed returns true if the resume content has not been reviewed yet.
+func (r *Resume) IsUnreviewed() bool {
+	return r.Status == ResumeStatusDraft || r.Status == ResumeStatusGenerated
+}
+
 // IsSubmitted returns true if the resume has been submitted.
 func (r *Resume) IsSubmitted() bool {
 	return r.Status == ResumeStatusSubmitted ||
@@ -218,6 +666,35 @@ func (r *Resume) IsSubmitted() bool {
 		r.Status == ResumeStatusAccepted
 }
 
+// ApplicationOutcome summarizes where the job application of a resume
+// stands.
+type ApplicationOutcome string
+
+// Application outcome constants.
+const (
+	OutcomeNotApplied   ApplicationOutcome = "not_applied"
+	OutcomePending      ApplicationOutcome = "pending"
+	OutcomeInterviewing ApplicationOutcome = "interviewing"
+	OutcomeRejected     ApplicationOutcome = "rejected"
+	OutcomeOffer        ApplicationOutcome = "offer"
+)
+
+// Outcome returns the outcome of the resume's application so far.
+func (r *Resume) Outcome() ApplicationOutcome {
+	switch r.Status {
+	case ResumeStatusSubmitted:
+		return OutcomePending
+	case ResumeStatusInterview:
+		return OutcomeInterviewing
+	case ResumeStatusRejected:
+		return OutcomeRejected
+	case ResumeStatusAccepted:
+		return OutcomeOffer
+	default:
+		return OutcomeNotApplied
+	}
+}
+
 // CanGeneratePDF returns true if the resume can be exported to PDF.
 func (r *Resume) CanGeneratePDF() bool {
 	return r.GeneratedContent != nil &&
@@ -0,0 +1,88 @@
+package domain
+
+import "time"
+
+// ResumeActivityType identifies what happened to a resume.
+type ResumeActivityType string
+
+// Resume activity types.
+const (
+	// ResumeActivityNote is a note the user left on the resume.
+	ResumeActivityNote ResumeActivityType = "note"
+
+	// ResumeActivityStatusChange is a change of the resume's status.
+	ResumeActivityStatusChange ResumeActivityType = "status_change"
+
+	// ResumeActivityPDFGenerated is the generation of a PDF of the resume.
+	ResumeActivityPDFGenerated ResumeActivityType = "pdf_generated"
+
+	// ResumeActivityAIRun is an AI operation on the resume, such as
+	// tailoring or a critique.
+	ResumeActivityAIRun ResumeActivityType = "ai_run"
+)
+
+// AI operations recorded as ResumeActivityAIRun details.
+const (
+	AIRunTailor     = "tailor"
+	AIRunCritique   = "critique"
+	AIRunFit        = "fit"
+	AIRunRegenerate = "regenerate"
+)
+
+// ResumeActivity is an entry of a resume's activity log, which tracks the
+// lifecycle of the job application from notes and automatic events.
+type ResumeActivity struct {
+	ID       string             `json:"id"`
+	ResumeID string             `json:"resume_id"`
+	UserID   string             `json:"user_id"`
+	Type     ResumeActivityType `json:"type"`
+
+	// Note is the text of a note.
+	Note string `json:"note,omitempty"`
+
+	// FromStatus and ToStatus are the statuses before and after a status
+	// change.
+	FromStatus ResumeStatus `json:"from_status,omitempty"`
+	ToStatus   ResumeStatus `json:"to_status,omitempty"`
+
+	// Detail describes other events: the template of a PDF generation, or
+	// the operation of an AI run (AIRunTailor, AIRunCritique, AIRunFit,
+	// AIRunRegenerate).
+	Detail string `json:"detail,omitempty"`
+
+	// AISettings are the settings of an AI run; nil for other events and
+	// runs recorded before settings were kept.
+	AISettings *AIRunSettings `json:"ai_settings,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewResumeNote returns a note entry for a resume.
+func NewResumeNote(resume *Resume, note string) *ResumeActivity {
+	return &ResumeActivity{ResumeID: resume.ID, UserID: resume.UserID, Type: ResumeActivityNote, Note: note}
+}
+
+// NewResumeStatusChange returns a status change entry for a resume that
+// moved from status from to its current status.
+func NewResumeStatusChange(resume *Resume, from ResumeStatus) *ResumeActivity {
+	return &ResumeActivity{
+		ResumeID:   resume.ID,
+		UserID:     resume.UserID,
+		Type:       ResumeActivityStatusChange,
+		FromStatus: from,
+		ToStatus:   resume.Status,
+	}
+}
+
+// NewResumeEvent returns a PDF generation or AI run entry for a resume.
+func NewResumeEvent(resume *Resume, activityType ResumeActivityType, detail string) *ResumeActivity {
+	return &ResumeActivity{ResumeID: resume.ID, UserID: resume.UserID, Type: activityType, Detail: detail}
+}
+
+// NewAIRunEvent returns an AI run entry for a resume, with the settings the
+// run was made with.
+func NewAIRunEvent(resume *Resume, operation string, settings *AIRunSettings) *ResumeActivity {
+	activity := NewResumeEvent(resume, ResumeActivityAIRun, operation)
+	activity.AISettings = settings
+	return activity
+}
@@ -0,0 +1,34 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestNewResumeStatusChange(t *testing.T) {
+	resume := &domain.Resume{ID: "r1", UserID: "u1", Status: domain.ResumeStatusSubmitted}
+
+	activity := domain.NewResumeStatusChange(resume, domain.ResumeStatusReviewed)
+
+	assert.Equal(t, &domain.ResumeActivity{
+		ResumeID:   "r1",
+		UserID:     "u1",
+		Type:       domain.ResumeActivityStatusChange,
+		FromStatus: domain.ResumeStatusReviewed,
+		ToStatus:   domain.ResumeStatusSubmitted,
+	}, activity)
+}
+
+func TestNewResumeEvent(t *testing.T) {
+	resume := &domain.Resume{ID: "r1", UserID: "u1"}
+
+	assert.Equal(t, &domain.ResumeActivity{
+		ResumeID: "r1",
+		UserID:   "u1",
+		Type:     domain.ResumeActivityAIRun,
+		Detail:   domain.AIRunTailor,
+	}, domain.NewResumeEvent(resume, domain.ResumeActivityAIRun, domain.AIRunTailor))
+}
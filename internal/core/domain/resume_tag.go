@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxResumeTagNameLength is the maximum length of a tag name in characters.
+const MaxResumeTagNameLength = 50
+
+// ResumeTag is a user-defined label for organizing resumes, such as
+// "Backend", "2025 Q1" or "Dream companies". A resume may have any number
+// of tags; a tag used on its own works as a folder.
+type ResumeTag struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+
+	// Name is unique per user, ignoring case.
+	Name string `json:"name"`
+
+	// ResumeCount is the number of resumes with the tag; only set when
+	// listing tags.
+	ResumeCount int `json:"resume_count"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewResumeTag creates a new tag with a validated name.
+func NewResumeTag(userID, name string) (*ResumeTag, error) {
+	now := time.Now().UTC()
+	tag := &ResumeTag{
+		UserID:    userID,
+		Name:      strings.TrimSpace(name),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := tag.Validate(); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+// Rename changes the tag's name.
+func (t *ResumeTag) Rename(name string) error {
+	t.Name = strings.TrimSpace(name)
+	t.UpdatedAt = time.Now().UTC()
+	return t.Validate()
+}
+
+// Validate validates the resume tag entity.
+func (t *ResumeTag) Validate() error {
+	v := &ValidationErrors{}
+
+	if t.UserID == "" {
+		v.AddFieldError("user_id", "user ID is required")
+	}
+
+	switch {
+	case t.Name == "":
+		v.AddFieldError("name", "name is required")
+	case utf8.RuneCountInString(t.Name) > MaxResumeTagNameLength:
+		v.AddFieldError("name", fmt.Sprintf("must be at most %d characters", MaxResumeTagNameLength))
+	}
+
+	return v.ToError()
+}
@@ -0,0 +1,36 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestNewResumeTag(t *testing.T) {
+	tag, err := domain.NewResumeTag("user-1", "  Dream companies ")
+	require.NoError(t, err)
+	assert.Equal(t, "Dream companies", tag.Name)
+	assert.Equal(t, "user-1", tag.UserID)
+
+	for _, name := range []string{"", "   ", strings.Repeat("x", domain.MaxResumeTagNameLength+1)} {
+		_, err := domain.NewResumeTag("user-1", name)
+		var validationErr *domain.ValidationErrors
+		require.True(t, errors.As(err, &validationErr), "name %q", name)
+		assert.Equal(t, "name", validationErr.Errors[0].Field)
+	}
+}
+
+func TestResumeTagRename(t *testing.T) {
+	tag, err := domain.NewResumeTag("user-1", "Backend")
+	require.NoError(t, err)
+
+	require.NoError(t, tag.Rename(" 2025 Q1 "))
+	assert.Equal(t, "2025 Q1", tag.Name)
+
+	assert.Error(t, tag.Rename(""))
+}
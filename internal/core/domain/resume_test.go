@@ -0,0 +1,312 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func validResumeContent() *domain.ResumeContent {
+	return &domain.ResumeContent{
+		Summary: "Backend engineer with 5 years of Go experience.",
+		Experiences: []domain.TailoredExperience{
+			{
+				ExperienceID: "exp-1",
+				Title:        "Software Engineer",
+				Organization: "Tech Corp",
+				StartDate:    "2020-01-15",
+				IsCurrent:    true,
+				Bullets: []domain.TailoredBullet{
+					{BulletID: "b-1", OriginalContent: "Built APIs", TailoredContent: "Built **Go** APIs"},
+					{BulletID: "b-2", OriginalContent: "Led team", TailoredContent: "Led a team of 4"},
+				},
+			},
+		},
+		Skills: []string{"Go", "PostgreSQL"},
+	}
+}
+
+// fieldErrors returns the field names of a validation error.
+func fieldErrors(t *testing.T, err error) []string {
+	t.Helper()
+
+	var validationErr *domain.ValidationErrors
+	require.ErrorAs(t, err, &validationErr)
+
+	fields := make([]string, 0, len(validationErr.Errors))
+	for _, e := range validationErr.Errors {
+		fields = append(fields, e.Field)
+	}
+	return fields
+}
+
+func TestResumeContentValidate(t *testing.T) {
+	t.Run("valid content", func(t *testing.T) {
+		assert.NoError(t, validResumeContent().Validate())
+	})
+
+	t.Run("missing required fields", func(t *testing.T) {
+		content := validResumeContent()
+		content.Summary = ""
+		content.Experiences[0].ExperienceID = ""
+		content.Experiences[0].Title = " "
+		content.Experiences[0].StartDate = "Jan 2020"
+
+		fields := fieldErrors(t, content.Validate())
+		assert.ElementsMatch(t, []string{
+			"summary",
+			"experiences[0].experience_id",
+			"experiences[0].title",
+			"experiences[0].start_date",
+		}, fields)
+	})
+
+	t.Run("requires at least one experience", func(t *testing.T) {
+		content := validResumeContent()
+		content.Experiences = nil
+
+		assert.Equal(t, []string{"experiences"}, fieldErrors(t, content.Validate()))
+	})
+
+	t.Run("rejects empty bullet lists", func(t *testing.T) {
+		content := validResumeContent()
+		content.Experiences[0].Bullets = nil
+
+		assert.Equal(t, []string{"experiences[0].bullets"}, fieldErrors(t, content.Validate()))
+	})
+
+	t.Run("rejects duplicate and missing bullet IDs", func(t *testing.T) {
+		content := validResumeContent()
+		content.Experiences[0].Bullets[1].BulletID = "b-1"
+		content.Experiences[0].Bullets = append(content.Experiences[0].Bullets,
+			domain.TailoredBullet{TailoredContent: "Orphan bullet"})
+
+		assert.Equal(t, []string{
+			"experiences[0].bullets[1].bullet_id",
+			"experiences[0].bullets[2].bullet_id",
+		}, fieldErrors(t, content.Validate()))
+	})
+
+	t.Run("rejects end date on current experience", func(t *testing.T) {
+		content := validResumeContent()
+		end := "2023-01-01"
+		content.Experiences[0].EndDate = &end
+
+		assert.Equal(t, []string{"experiences[0].end_date"}, fieldErrors(t, content.Validate()))
+	})
+}
+
+func TestResumeContentRepair(t *testing.T) {
+	content := validResumeContent()
+	content.Summary = "  Summary  "
+	content.Experiences[0].Bullets = []domain.TailoredBullet{
+		{BulletID: "b-1", OriginalContent: "Built APIs", TailoredContent: "  "},
+		{BulletID: "b-1", OriginalContent: "Duplicate", TailoredContent: "Duplicate"},
+		{BulletID: "", OriginalContent: "No ID", TailoredContent: "No ID"},
+		{BulletID: "b-3", OriginalContent: "", TailoredContent: ""},
+	}
+	content.Experiences = append(content.Experiences, domain.TailoredExperience{
+		ExperienceID: "exp-2",
+		Title:        "Intern",
+		Organization: "Startup",
+		StartDate:    "2019-01-01",
+		Bullets:      []domain.TailoredBullet{{BulletID: "b-4"}},
+	})
+	content.Skills = []string{"Go", " go ", "", "Docker"}
+	content.Themes = []domain.SkillTheme{
+		{Name: " Backend ", BulletIDs: []string{"b-1", "b-3", "b-1"}},
+		{Name: "Operations", BulletIDs: []string{"b-4", "b-1"}},
+	}
+
+	content.Repair()
+
+	assert.Equal(t, "Summary", content.Summary)
+	require.Len(t, content.Experiences, 1)
+	require.Len(t, content.Experiences[0].Bullets, 1)
+	assert.Equal(t, "b-1", content.Experiences[0].Bullets[0].BulletID)
+	assert.Equal(t, "Built APIs", content.Experiences[0].Bullets[0].TailoredContent)
+	assert.Equal(t, []string{"Go", "Docker"}, content.Skills)
+	assert.Equal(t, []domain.SkillTheme{{Name: "Backend", BulletIDs: []string{"b-1"}}}, content.Themes)
+	assert.NoError(t, content.Validate())
+}
+
+func TestTailoredBulletDisplayContent(t *testing.T) {
+	bullet := domain.TailoredBullet{OriginalContent: "Criei APIs"}
+	assert.Equal(t, "Criei APIs", bullet.DisplayContent())
+
+	bullet.TailoredContent = "Criei APIs em **Go**"
+	assert.Equal(t, "Criei APIs em **Go**", bullet.DisplayContent())
+
+	bullet.TranslatedContent = "Built APIs in **Go**"
+	assert.Equal(t, "Built APIs in **Go**", bullet.DisplayContent())
+}
+
+func TestResumeSetGeneratedContent(t *testing.T) {
+	t.Run("sets valid content and marks as generated", func(t *testing.T) {
+		resume, err := domain.NewResume("user-123", "Go developer")
+		require.NoError(t, err)
+		resume.SelectBullets([]string{"b-1", "b-2"})
+
+		require.NoError(t, resume.SetGeneratedContent(validResumeContent()))
+		assert.NotNil(t, resume.GeneratedContent)
+		assert.Equal(t, domain.ResumeStatusGenerated, resume.Status)
+	})
+
+	t.Run("rejects bullets outside the selection", func(t *testing.T) {
+		resume, _ := domain.NewResume("user-123", "Go developer")
+		resume.SelectBullets([]string{"b-1"})
+
+		err := resume.SetGeneratedContent(validResumeContent())
+		assert.Equal(t, []string{"experiences[0].bullets[1].bullet_id"}, fieldErrors(t, err))
+		assert.Nil(t, resume.GeneratedContent)
+		assert.Equal(t, domain.ResumeStatusDraft, resume.Status)
+	})
+
+	t.Run("rejects nil content", func(t *testing.T) {
+		resume, _ := domain.NewResume("user-123", "Go developer")
+
+		err := resume.SetGeneratedContent(nil)
+		assert.Equal(t, []string{"generated_content"}, fieldErrors(t, err))
+	})
+}
+
+func TestResumeBulletReview(t *testing.T) {
+	flaggedResume := func(t *testing.T) *domain.Resume {
+		t.Helper()
+		resume, err := domain.NewResume("user-123", "Go developer")
+		require.NoError(t, err)
+		resume.SelectBullets([]string{"b-1", "b-2"})
+
+		content := validResumeContent()
+		content.Experiences[0].Bullets[1].NeedsReview = true
+		content.Experiences[0].Bullets[1].UnverifiedTerms = []string{"4"}
+		require.NoError(t, resume.SetGeneratedContent(content))
+		return resume
+	}
+
+	t.Run("blocks review until flagged bullets are confirmed", func(t *testing.T) {
+		resume := flaggedResume(t)
+		assert.Equal(t, []string{"b-2"}, resume.GeneratedContent.BulletsNeedingReview())
+		assert.ErrorIs(t, resume.TransitionStatus(domain.ResumeStatusReviewed), domain.ErrBulletsNeedReview)
+		assert.Equal(t, domain.ResumeStatusGenerated, resume.Status)
+
+		require.NoError(t, resume.GeneratedContent.ConfirmBullets([]string{"b-2"}))
+		assert.Empty(t, resume.GeneratedContent.BulletsNeedingReview())
+		assert.Nil(t, resume.GeneratedContent.Experiences[0].Bullets[1].UnverifiedTerms)
+		assert.NoError(t, resume.TransitionStatus(domain.ResumeStatusReviewed))
+	})
+
+	t.Run("other transitions are not blocked", func(t *testing.T) {
+		resume := flaggedResume(t)
+		assert.NoError(t, resume.TransitionStatus(domain.ResumeStatusDraft))
+	})
+
+	t.Run("rejects bullets not on the resume", func(t *testing.T) {
+		resume := flaggedResume(t)
+		err := resume.GeneratedContent.ConfirmBullets([]string{"b-2", "b-9"})
+		assert.Equal(t, []string{"bullet_ids[1]"}, fieldErrors(t, err))
+		assert.Equal(t, []string{"b-2"}, resume.GeneratedContent.BulletsNeedingReview())
+	})
+}
+
+func TestResumeContentSelectSummary(t *testing.T) {
+	content := validResumeContent()
+	content.SummaryVariants = []domain.SummaryVariant{
+		{Angle: domain.SummaryAngleTechnical, Summary: content.Summary},
+		{Angle: domain.SummaryAngleImpact, Summary: "Engineer who cut payment failures by 40%."},
+	}
+	content.SummaryAngle = domain.SummaryAngleTechnical
+
+	require.NoError(t, content.SelectSummary(domain.SummaryAngleImpact))
+	assert.Equal(t, "Engineer who cut payment failures by 40%.", content.Summary)
+	assert.Equal(t, domain.SummaryAngleImpact, content.SummaryAngle)
+	assert.Len(t, content.SummaryVariants, 2)
+
+	err := content.SelectSummary(domain.SummaryAngleLeadership)
+	assert.Equal(t, []string{"angle"}, fieldErrors(t, err))
+	assert.Equal(t, domain.SummaryAngleImpact, content.SummaryAngle)
+
+	err = validResumeContent().SelectSummary(domain.SummaryAngleTechnical)
+	assert.Equal(t, []string{"angle"}, fieldErrors(t, err))
+}
+
+func TestResumeTailorsExperience(t *testing.T) {
+	included := &domain.Experience{ID: "exp-1"}
+	excluded := &domain.Experience{ID: "exp-2", ExcludeFromTailoring: true}
+
+	t.Run("follows the experience flag by default", func(t *testing.T) {
+		resume := &domain.Resume{}
+		assert.True(t, resume.TailorsExperience(included))
+		assert.False(t, resume.TailorsExperience(excluded))
+	})
+
+	t.Run("resume overrides the flag", func(t *testing.T) {
+		resume := &domain.Resume{
+			IncludedExperienceIDs: []string{"exp-2"},
+			ExcludedExperienceIDs: []string{"exp-1"},
+		}
+		assert.False(t, resume.TailorsExperience(included))
+		assert.True(t, resume.TailorsExperience(excluded))
+	})
+}
+
+func TestResumeMaxPages(t *testing.T) {
+	t.Run("defaults to one page", func(t *testing.T) {
+		resume, err := domain.NewResume("user-1", "Go developer")
+		require.NoError(t, err)
+		assert.Equal(t, 1, resume.PageLimit())
+		assert.Equal(t, 1, (&domain.Resume{}).PageLimit())
+	})
+
+	t.Run("allows two pages", func(t *testing.T) {
+		resume := &domain.Resume{}
+		require.NoError(t, resume.SetMaxPages(2))
+		assert.Equal(t, 2, resume.PageLimit())
+	})
+
+	t.Run("rejects other page counts", func(t *testing.T) {
+		resume := &domain.Resume{MaxPages: 2}
+		for _, pages := range []int{0, 3} {
+			assert.Equal(t, []string{"max_pages"}, fieldErrors(t, resume.SetMaxPages(pages)))
+		}
+		assert.Equal(t, 2, resume.MaxPages)
+	})
+}
+
+func TestResumeArchive(t *testing.T) {
+	resume := &domain.Resume{}
+	assert.False(t, resume.IsArchived())
+
+	resume.Archive()
+	require.True(t, resume.IsArchived())
+	archivedAt := *resume.ArchivedAt
+
+	resume.Archive()
+	assert.Equal(t, archivedAt, *resume.ArchivedAt, "archiving again keeps the archive time")
+
+	resume.Unarchive()
+	assert.False(t, resume.IsArchived())
+}
+
+func TestResumeOutcome(t *testing.T) {
+	tests := []struct {
+		status   domain.ResumeStatus
+		expected domain.ApplicationOutcome
+	}{
+		{domain.ResumeStatusDraft, domain.OutcomeNotApplied},
+		{domain.ResumeStatusGenerated, domain.OutcomeNotApplied},
+		{domain.ResumeStatusReviewed, domain.OutcomeNotApplied},
+		{domain.ResumeStatusSubmitted, domain.OutcomePending},
+		{domain.ResumeStatusInterview, domain.OutcomeInterviewing},
+		{domain.ResumeStatusRejected, domain.OutcomeRejected},
+		{domain.ResumeStatusAccepted, domain.OutcomeOffer},
+	}
+
+	for _, tt := range tests {
+		resume := &domain.Resume{Status: tt.status}
+		assert.Equal(t, tt.expected, resume.Outcome(), "status %s", tt.status)
+	}
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxSavedFilterNameLength is the maximum length of a saved filter name in
+// characters.
+const MaxSavedFilterNameLength = 100
+
+// ResumeSearch holds the criteria of a resume list search. The JSON names
+// match the query parameters of the resume list. Zero fields do not filter.
+type ResumeSearch struct {
+	Status string `json:"status,omitempty"`
+
+	// Job insight filters.
+	RemotePolicies  []string `json:"remote,omitempty"`
+	MinSalary       *int     `json:"min_salary,omitempty"`
+	SalaryCurrency  string   `json:"currency,omitempty"`
+	VisaSponsorship *bool    `json:"visa_sponsorship,omitempty"`
+
+	// TagIDs keeps resumes that have all of these tags.
+	TagIDs []string `json:"tag,omitempty"`
+
+	// MinScore keeps resumes with at least this match score.
+	MinScore *int `json:"score_min,omitempty"`
+
+	// Company keeps resumes whose company name contains this text,
+	// ignoring case.
+	Company string `json:"company,omitempty"`
+
+	// CreatedAfter and CreatedBefore bound the creation time, as dates
+	// (YYYY-MM-DD) or RFC 3339 timestamps. A date covers the whole day.
+	CreatedAfter  string `json:"created_after,omitempty"`
+	CreatedBefore string `json:"created_before,omitempty"`
+
+	// Language keeps resumes in this target language; "pt" also matches
+	// regional variants such as "pt-br".
+	Language string `json:"language,omitempty"`
+
+	// Archived keeps archived (true) or unarchived (false) resumes. Without
+	// it, archived resumes are left out unless IncludeArchived is set.
+	Archived        *bool `json:"archived,omitempty"`
+	IncludeArchived bool  `json:"include_archived,omitempty"`
+
+	// Sort orders the resumes: "newest" (the default), "recent" or
+	// "pinned_first"; see ResumeSort.
+	Sort string `json:"sort,omitempty"`
+}
+
+// ResumeSort is the order of a resume list.
+type ResumeSort string
+
+// Resume list orders.
+const (
+	// ResumeSortNewest lists the most recently created resumes first.
+	ResumeSortNewest ResumeSort = "newest"
+
+	// ResumeSortRecent lists the most recently opened resumes first, then
+	// those never opened, newest first.
+	ResumeSortRecent ResumeSort = "recent"
+
+	// ResumeSortPinnedFirst lists pinned resumes first, newest first within
+	// each group.
+	ResumeSortPinnedFirst ResumeSort = "pinned_first"
+)
+
+// IsValid checks if the resume sort is valid.
+func (s ResumeSort) IsValid() bool {
+	switch s {
+	case ResumeSortNewest, ResumeSortRecent, ResumeSortPinnedFirst:
+		return true
+	}
+	return false
+}
+
+// SavedResumeFilter is a resume search the user named to run again later.
+type SavedResumeFilter struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+
+	// Name is unique per user, ignoring case.
+	Name   string       `json:"name"`
+	Search ResumeSearch `json:"search"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewSavedResumeFilter creates a new saved filter with a validated name.
+func NewSavedResumeFilter(userID, name string, search ResumeSearch) (*SavedResumeFilter, error) {
+	now := time.Now().UTC()
+	filter := &SavedResumeFilter{
+		UserID:    userID,
+		Name:      strings.TrimSpace(name),
+		Search:    search,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// Replace changes the filter's name and search.
+func (f *SavedResumeFilter) Replace(name string, search ResumeSearch) error {
+	f.Name = strings.TrimSpace(name)
+	f.Search = search
+	f.UpdatedAt = time.Now().UTC()
+	return f.Validate()
+}
+
+// Validate validates the saved filter entity. The search criteria are
+// checked when the filter is run.
+func (f *SavedResumeFilter) Validate() error {
+	v := &ValidationErrors{}
+
+	if f.UserID == "" {
+		v.AddFieldError("user_id", "user ID is required")
+	}
+
+	switch {
+	case f.Name == "":
+		v.AddFieldError("name", "name is required")
+	case utf8.RuneCountInString(f.Name) > MaxSavedFilterNameLength:
+		v.AddFieldError("name", fmt.Sprintf("must be at most %d characters", MaxSavedFilterNameLength))
+	}
+
+	return v.ToError()
+}
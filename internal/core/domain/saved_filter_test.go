@@ -0,0 +1,41 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestNewSavedResumeFilter(t *testing.T) {
+	minScore := 80
+	search := domain.ResumeSearch{MinScore: &minScore, Language: "pt"}
+
+	filter, err := domain.NewSavedResumeFilter("user-1", " Strong matches ", search)
+	require.NoError(t, err)
+	assert.Equal(t, "Strong matches", filter.Name)
+	assert.Equal(t, search, filter.Search)
+
+	for _, name := range []string{"", "  ", strings.Repeat("x", domain.MaxSavedFilterNameLength+1)} {
+		_, err := domain.NewSavedResumeFilter("user-1", name, search)
+		var validationErr *domain.ValidationErrors
+		require.True(t, errors.As(err, &validationErr), "name %q", name)
+		assert.Equal(t, "name", validationErr.Errors[0].Field)
+	}
+}
+
+func TestSavedResumeFilterReplace(t *testing.T) {
+	filter, err := domain.NewSavedResumeFilter("user-1", "Backend", domain.ResumeSearch{Company: "acme"})
+	require.NoError(t, err)
+
+	search := domain.ResumeSearch{Status: "submitted"}
+	require.NoError(t, filter.Replace("Submitted", search))
+	assert.Equal(t, "Submitted", filter.Name)
+	assert.Equal(t, search, filter.Search)
+
+	assert.Error(t, filter.Replace("", search))
+}
@@ -0,0 +1,45 @@
+package domain
+
+// SkillsDisplay controls how the skills section shows each skill.
+type SkillsDisplay string
+
+// Skills display constants.
+const (
+	// SkillsDisplayList lists skill names by category (default).
+	SkillsDisplayList SkillsDisplay = "list"
+
+	// SkillsDisplayBars shows each skill with a bar for its proficiency
+	// level, grouped by category. Text formats fall back to the list.
+	SkillsDisplayBars SkillsDisplay = "bars"
+
+	// SkillsDisplayYears annotates skills with their years of experience,
+	// e.g. "Go (3 yrs)".
+	SkillsDisplayYears SkillsDisplay = "years"
+)
+
+// IsValid checks if the skills display is valid. Empty is SkillsDisplayList.
+func (d SkillsDisplay) IsValid() bool {
+	switch d {
+	case "", SkillsDisplayList, SkillsDisplayBars, SkillsDisplayYears:
+		return true
+	default:
+		return false
+	}
+}
+
+// SectionConfig holds a resume's display options for its sections. Zero
+// values keep each section's default display.
+type SectionConfig struct {
+	SkillsDisplay SkillsDisplay `json:"skills_display,omitempty"`
+}
+
+// Validate validates the section options.
+func (c SectionConfig) Validate() error {
+	v := &ValidationErrors{}
+
+	if !c.SkillsDisplay.IsValid() {
+		v.AddFieldError("sections.skills_display", "must be 'list', 'bars' or 'years'")
+	}
+
+	return v.ToError()
+}
@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestSkillsDisplayIsValid(t *testing.T) {
+	tests := []struct {
+		display domain.SkillsDisplay
+		want    bool
+	}{
+		{"", true},
+		{domain.SkillsDisplayList, true},
+		{domain.SkillsDisplayBars, true},
+		{domain.SkillsDisplayYears, true},
+		{"stars", false},
+		{"Bars", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.display), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.display.IsValid())
+		})
+	}
+}
+
+func TestSectionConfigValidate(t *testing.T) {
+	t.Run("zero value", func(t *testing.T) {
+		assert.NoError(t, domain.SectionConfig{}.Validate())
+	})
+
+	t.Run("invalid skills display", func(t *testing.T) {
+		err := domain.SectionConfig{SkillsDisplay: "stars"}.Validate()
+		require.Error(t, err)
+
+		var v *domain.ValidationErrors
+		require.ErrorAs(t, err, &v)
+		assert.Contains(t, v.Error(), "sections.skills_display")
+	})
+}
@@ -0,0 +1,24 @@
+package domain
+
+// SuggestionType is the kind of value a type-ahead suggestion completes.
+type SuggestionType string
+
+// Suggestion type constants, in the order suggestions are listed.
+const (
+	SuggestionCompany  SuggestionType = "company"
+	SuggestionJobTitle SuggestionType = "job_title"
+	SuggestionSkill    SuggestionType = "skill"
+	SuggestionTag      SuggestionType = "tag"
+)
+
+// Suggestion is a value from the user's own data that matches what they
+// are typing: a company or job title from their resumes and experiences,
+// one of their skills or one of their resume tags.
+type Suggestion struct {
+	Type  SuggestionType `json:"type"`
+	Value string         `json:"value"`
+
+	// ID identifies the skill or tag; empty for companies and job titles,
+	// which are free text.
+	ID string `json:"id,omitempty"`
+}
@@ -0,0 +1,268 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Timeline defaults.
+const (
+	// DefaultGapThresholdMonths is the longest gap between activities that
+	// is not flagged.
+	DefaultGapThresholdMonths = 3
+
+	// overlapToleranceMonths is how long two full-time roles may overlap
+	// without being flagged; job changes often share a month.
+	overlapToleranceMonths = 1
+)
+
+// Experience metadata keys recognised by the timeline.
+const (
+	// MetadataEmploymentType marks a work experience as part-time when set to
+	// EmploymentTypePartTime. Part-time roles never count as overlapping.
+	MetadataEmploymentType = "employment_type"
+	EmploymentTypePartTime = "part_time"
+)
+
+// TimelineSuggestionKind identifies the kind of timeline suggestion.
+type TimelineSuggestionKind string
+
+// Timeline suggestion kinds.
+const (
+	// SuggestionExplainGap suggests adding an entry (sabbatical, studies,
+	// freelance work, ...) that covers a gap.
+	SuggestionExplainGap TimelineSuggestionKind = "explain_gap"
+
+	// SuggestionReviewOverlap suggests fixing the dates of overlapping
+	// full-time roles or marking one of them as part-time.
+	SuggestionReviewOverlap TimelineSuggestionKind = "review_overlap"
+)
+
+// TimelineEntry is an experience normalized to whole months.
+type TimelineEntry struct {
+	ExperienceID string
+	Type         ExperienceType
+	Title        string
+	Organization string
+	Start        Date // first month
+	End          Date // last month; the current month for ongoing entries
+	IsCurrent    bool
+	FullTime     bool
+}
+
+// Months returns the number of months the entry spans.
+func (e TimelineEntry) Months() int {
+	return e.End.monthIndex() - e.Start.monthIndex() + 1
+}
+
+// TimelineGap is a period not covered by any activity.
+type TimelineGap struct {
+	Start   Date // first uncovered month
+	End     Date // last uncovered month
+	Months  int
+	Ongoing bool // the gap runs until the current month
+}
+
+// TimelineOverlap is a period where two full-time roles overlap.
+type TimelineOverlap struct {
+	ExperienceIDs [2]string
+	Start         Date
+	End           Date
+	Months        int
+}
+
+// TimelineSuggestion is an actionable hint about the timeline.
+type TimelineSuggestion struct {
+	Kind          TimelineSuggestionKind
+	Message       string
+	ExperienceIDs []string
+}
+
+// Timeline is a chronological view of a user's experiences.
+type Timeline struct {
+	Entries     []TimelineEntry
+	Gaps        []TimelineGap
+	Overlaps    []TimelineOverlap
+	Suggestions []TimelineSuggestion
+}
+
+// TimelineOptions configures BuildTimeline.
+type TimelineOptions struct {
+	// GapThresholdMonths flags gaps longer than this many months. Zero uses
+	// DefaultGapThresholdMonths.
+	GapThresholdMonths int
+
+	// Now is the reference time for ongoing entries. Zero uses the current time.
+	Now time.Time
+}
+
+// BuildTimeline normalizes experiences to months, sorted by start date, and
+// detects gaps between activities and overlapping full-time roles.
+//
+// Point-in-time experiences (awards, publications, certifications,
+// hackathons and events) appear in the timeline but neither fill gaps nor
+// overlap. Ongoing entries end in the current month; past entries without an
+// end date are treated as lasting a single month.
+func BuildTimeline(experiences []Experience, opts TimelineOptions) *Timeline {
+	if opts.GapThresholdMonths <= 0 {
+		opts.GapThresholdMonths = DefaultGapThresholdMonths
+	}
+	if opts.Now.IsZero() {
+		opts.Now = time.Now().UTC()
+	}
+	now := NewMonthDate(opts.Now.Year(), opts.Now.Month())
+
+	timeline := &Timeline{
+		Entries:     make([]TimelineEntry, 0, len(experiences)),
+		Gaps:        make([]TimelineGap, 0),
+		Overlaps:    make([]TimelineOverlap, 0),
+		Suggestions: make([]TimelineSuggestion, 0),
+	}
+
+	for _, exp := range experiences {
+		if exp.StartDate.IsZero() {
+			continue
+		}
+		timeline.Entries = append(timeline.Entries, newTimelineEntry(exp, now))
+	}
+	sort.SliceStable(timeline.Entries, func(i, j int) bool {
+		a, b := timeline.Entries[i], timeline.Entries[j]
+		if a.Start.monthIndex() != b.Start.monthIndex() {
+			return a.Start.monthIndex() < b.Start.monthIndex()
+		}
+		return a.End.monthIndex() < b.End.monthIndex()
+	})
+
+	timeline.detectGaps(now, opts.GapThresholdMonths)
+	timeline.detectOverlaps()
+
+	return timeline
+}
+
+// newTimelineEntry normalizes an experience to whole months.
+func newTimelineEntry(exp Experience, now Date) TimelineEntry {
+	entry := TimelineEntry{
+		ExperienceID: exp.ID,
+		Type:         exp.Type,
+		Title:        exp.Title,
+		Organization: exp.Organization,
+		Start:        NewMonthDate(exp.StartDate.Year(), exp.StartDate.Month()),
+		IsCurrent:    exp.IsCurrent,
+		FullTime:     exp.Type == ExperienceTypeWork && exp.Metadata[MetadataEmploymentType] != EmploymentTypePartTime,
+	}
+
+	switch {
+	case exp.IsCurrent:
+		entry.End = now
+	case exp.EndDate != nil && !exp.EndDate.IsZero():
+		entry.End = NewMonthDate(exp.EndDate.Year(), exp.EndDate.Month())
+	default:
+		entry.End = entry.Start
+	}
+	if entry.End.Before(entry.Start) {
+		entry.End = entry.Start
+	}
+
+	return entry
+}
+
+// fillsTime reports whether the entry accounts for the time it spans.
+func (e TimelineEntry) fillsTime() bool {
+	switch e.Type {
+	case ExperienceTypeCertification, ExperienceTypeHackathon, ExperienceTypeEventOrganization,
+		ExperienceTypePublication, ExperienceTypeAward:
+		return false
+	default:
+		return true
+	}
+}
+
+// detectGaps flags uncovered periods longer than threshold months, from the
+// first activity up to the current month. Entries must be sorted by start.
+func (t *Timeline) detectGaps(now Date, threshold int) {
+	coveredUntil := -1
+	for _, entry := range t.Entries {
+		if !entry.fillsTime() {
+			continue
+		}
+		start, end := entry.Start.monthIndex(), entry.End.monthIndex()
+		if coveredUntil >= 0 && start-coveredUntil-1 > threshold {
+			t.addGap(coveredUntil+1, start-1, false)
+		}
+		if end > coveredUntil {
+			coveredUntil = end
+		}
+	}
+
+	if coveredUntil >= 0 && now.monthIndex()-coveredUntil > threshold {
+		t.addGap(coveredUntil+1, now.monthIndex(), true)
+	}
+}
+
+// addGap records a gap between two month indexes (inclusive) and suggests
+// explaining it.
+func (t *Timeline) addGap(from, to int, ongoing bool) {
+	gap := TimelineGap{
+		Start:   monthDateFromIndex(from),
+		End:     monthDateFromIndex(to),
+		Months:  to - from + 1,
+		Ongoing: ongoing,
+	}
+	t.Gaps = append(t.Gaps, gap)
+
+	message := fmt.Sprintf("Add an entry for the %d-month gap between %s and %s, such as a sabbatical, studies or freelance work.",
+		gap.Months, gap.Start.Format("Jan 2006"), gap.End.Format("Jan 2006"))
+	if ongoing {
+		message = fmt.Sprintf("Nothing is recorded since %s (%d months). Add your current activity or mark your latest role as current.",
+			gap.Start.Format("Jan 2006"), gap.Months)
+	}
+	t.Suggestions = append(t.Suggestions, TimelineSuggestion{
+		Kind:    SuggestionExplainGap,
+		Message: message,
+	})
+}
+
+// detectOverlaps flags full-time roles that overlap by more than the
+// tolerance. Entries must be sorted by start.
+func (t *Timeline) detectOverlaps() {
+	for i, a := range t.Entries {
+		if !a.FullTime {
+			continue
+		}
+		for _, b := range t.Entries[i+1:] {
+			if b.Start.monthIndex() > a.End.monthIndex() {
+				break
+			}
+			if !b.FullTime {
+				continue
+			}
+
+			from := b.Start.monthIndex()
+			to := min(a.End.monthIndex(), b.End.monthIndex())
+			months := to - from + 1
+			if months <= overlapToleranceMonths {
+				continue
+			}
+
+			ids := [2]string{a.ExperienceID, b.ExperienceID}
+			t.Overlaps = append(t.Overlaps, TimelineOverlap{
+				ExperienceIDs: ids,
+				Start:         monthDateFromIndex(from),
+				End:           monthDateFromIndex(to),
+				Months:        months,
+			})
+			t.Suggestions = append(t.Suggestions, TimelineSuggestion{
+				Kind: SuggestionReviewOverlap,
+				Message: fmt.Sprintf("%s at %s and %s at %s overlap for %d months. Check the dates or mark one as part-time.",
+					a.Title, a.Organization, b.Title, b.Organization, months),
+				ExperienceIDs: ids[:],
+			})
+		}
+	}
+}
+
+// monthDateFromIndex is the inverse of Date.monthIndex.
+func monthDateFromIndex(index int) Date {
+	return NewMonthDate(index/12, time.Month(index%12+1))
+}
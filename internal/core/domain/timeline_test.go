@@ -0,0 +1,97 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func timelineExperience(id string, expType domain.ExperienceType, start, end string) domain.Experience {
+	exp := domain.Experience{ID: id, Type: expType, Title: id, Organization: "Org " + id}
+	exp.StartDate, _ = domain.ParseDate(start)
+	if end == "" {
+		exp.IsCurrent = true
+	} else {
+		d, _ := domain.ParseDate(end)
+		exp.EndDate = &d
+	}
+	return exp
+}
+
+func TestBuildTimeline(t *testing.T) {
+	now := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("sorts and normalizes entries", func(t *testing.T) {
+		timeline := domain.BuildTimeline([]domain.Experience{
+			timelineExperience("b", domain.ExperienceTypeWork, "2022-03-15", ""),
+			timelineExperience("a", domain.ExperienceTypeWork, "2020-01", "2022-02-28"),
+		}, domain.TimelineOptions{Now: now})
+
+		require.Len(t, timeline.Entries, 2)
+		assert.Equal(t, "a", timeline.Entries[0].ExperienceID)
+		assert.Equal(t, "2020-01", timeline.Entries[0].Start.String())
+		assert.Equal(t, "2022-02", timeline.Entries[0].End.String())
+		assert.Equal(t, 26, timeline.Entries[0].Months())
+		assert.Equal(t, "2025-06", timeline.Entries[1].End.String())
+		assert.Empty(t, timeline.Gaps)
+		assert.Empty(t, timeline.Overlaps)
+		assert.Empty(t, timeline.Suggestions)
+	})
+
+	t.Run("flags gaps over the threshold", func(t *testing.T) {
+		timeline := domain.BuildTimeline([]domain.Experience{
+			timelineExperience("a", domain.ExperienceTypeWork, "2018-01", "2019-12"),
+			timelineExperience("award", domain.ExperienceTypeAward, "2020-03", "2020-03"),
+			timelineExperience("b", domain.ExperienceTypeWork, "2020-07", "2023-01"),
+			timelineExperience("c", domain.ExperienceTypeWork, "2023-04", "2024-06"),
+		}, domain.TimelineOptions{Now: now})
+
+		require.Len(t, timeline.Gaps, 2)
+		assert.Equal(t, domain.TimelineGap{
+			Start:  domain.NewMonthDate(2020, time.January),
+			End:    domain.NewMonthDate(2020, time.June),
+			Months: 6,
+		}, timeline.Gaps[0], "an award does not explain a gap")
+		assert.Equal(t, 12, timeline.Gaps[1].Months)
+		assert.True(t, timeline.Gaps[1].Ongoing)
+
+		require.Len(t, timeline.Suggestions, 2)
+		assert.Equal(t, domain.SuggestionExplainGap, timeline.Suggestions[0].Kind)
+		assert.Contains(t, timeline.Suggestions[0].Message, "Jan 2020 and Jun 2020")
+	})
+
+	t.Run("custom threshold", func(t *testing.T) {
+		timeline := domain.BuildTimeline([]domain.Experience{
+			timelineExperience("a", domain.ExperienceTypeWork, "2023-01", "2023-12"),
+			timelineExperience("b", domain.ExperienceTypeWork, "2024-03", ""),
+		}, domain.TimelineOptions{Now: now, GapThresholdMonths: 1})
+
+		require.Len(t, timeline.Gaps, 1)
+		assert.Equal(t, 2, timeline.Gaps[0].Months)
+	})
+
+	t.Run("flags overlapping full-time roles", func(t *testing.T) {
+		partTime := timelineExperience("teaching", domain.ExperienceTypeWork, "2021-01", "2021-12")
+		partTime.Metadata = map[string]any{domain.MetadataEmploymentType: domain.EmploymentTypePartTime}
+
+		timeline := domain.BuildTimeline([]domain.Experience{
+			timelineExperience("a", domain.ExperienceTypeWork, "2020-01", "2021-06"),
+			timelineExperience("b", domain.ExperienceTypeWork, "2021-06", "2022-12"),
+			timelineExperience("c", domain.ExperienceTypeWork, "2022-09", ""),
+			timelineExperience("oss", domain.ExperienceTypeOpenSource, "2020-01", ""),
+			partTime,
+		}, domain.TimelineOptions{Now: now})
+
+		require.Len(t, timeline.Overlaps, 1, "a one-month handover is tolerated")
+		assert.Equal(t, [2]string{"b", "c"}, timeline.Overlaps[0].ExperienceIDs)
+		assert.Equal(t, 4, timeline.Overlaps[0].Months)
+
+		require.Len(t, timeline.Suggestions, 1)
+		assert.Equal(t, domain.SuggestionReviewOverlap, timeline.Suggestions[0].Kind)
+		assert.Equal(t, []string{"b", "c"}, timeline.Suggestions[0].ExperienceIDs)
+	})
+}
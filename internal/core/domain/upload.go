@@ -0,0 +1,35 @@
+package domain
+
+import "fmt"
+
+// FileTooLargeError reports the size limit a file exceeded. It matches ErrFileTooLarge.
+type FileTooLargeError struct {
+	// Limit is the maximum size in bytes.
+	Limit int64
+}
+
+// Error returns the error message.
+func (e *FileTooLargeError) Error() string {
+	return fmt.Sprintf("%s: limit is %d bytes", ErrFileTooLarge, e.Limit)
+}
+
+// Unwrap returns ErrFileTooLarge.
+func (e *FileTooLargeError) Unwrap() error {
+	return ErrFileTooLarge
+}
+
+// InfectedFileError reports the malware found in a file. It matches ErrFileInfected.
+type InfectedFileError struct {
+	// Signature is the scanner's name for the malware, e.g. "Eicar-Signature".
+	Signature string
+}
+
+// Error returns the error message.
+func (e *InfectedFileError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrFileInfected, e.Signature)
+}
+
+// Unwrap returns ErrFileInfected.
+func (e *InfectedFileError) Unwrap() error {
+	return ErrFileInfected
+}
@@ -0,0 +1,20 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestUploadErrorsMatchSentinels(t *testing.T) {
+	tooLarge := &domain.FileTooLargeError{Limit: 1024}
+	assert.True(t, errors.Is(tooLarge, domain.ErrFileTooLarge))
+	assert.Contains(t, tooLarge.Error(), "limit is 1024 bytes")
+
+	infected := &domain.InfectedFileError{Signature: "Eicar-Signature"}
+	assert.True(t, errors.Is(infected, domain.ErrFileInfected))
+	assert.Contains(t, infected.Error(), "Eicar-Signature")
+}
@@ -5,22 +5,25 @@ import "time"
 
 // User represents a system user linked to Firebase authentication.
 type User struct {
-	ID                string    `json:"id"`
-	FirebaseUID       string    `json:"firebase_uid"`
-	PictureURL        *string   `json:"picture_url,omitempty"`
-	Email             *string   `json:"email,omitempty"`
-	Name              *string   `json:"name,omitempty"`
-	Headline          *string   `json:"headline,omitempty"`
-	Summary           *string   `json:"summary,omitempty"`
-	Location          *string   `json:"location,omitempty"`
-	Phone             *string   `json:"phone,omitempty"`
-	Website           *string   `json:"website,omitempty"`
-	LinkedInURL       *string   `json:"linkedin_url,omitempty"`
-	GitHubURL         *string   `json:"github_url,omitempty"`
-	PortfolioURL      *string   `json:"portfolio_url,omitempty"`
-	PreferredLanguage string    `json:"preferred_language"`
-	CreatedAt         time.Time `json:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	ID                string     `json:"id"`
+	FirebaseUID       string     `json:"firebase_uid"`
+	PictureURL        *string    `json:"picture_url,omitempty"`
+	PictureSourceURL  *string    `json:"-"`
+	Email             *string    `json:"email,omitempty"`
+	Name              *string    `json:"name,omitempty"`
+	Headline          *string    `json:"headline,omitempty"`
+	Summary           *string    `json:"summary,omitempty"`
+	Location          *string    `json:"location,omitempty"`
+	Phone             *string    `json:"phone,omitempty"`
+	Website           *string    `json:"website,omitempty"`
+	LinkedInURL       *string    `json:"linkedin_url,omitempty"`
+	GitHubURL         *string    `json:"github_url,omitempty"`
+	PortfolioURL      *string    `json:"portfolio_url,omitempty"`
+	PreferredLanguage string     `json:"preferred_language"`
+	Plan              Plan       `json:"plan"`
+	SessionsRevokedAt *time.Time `json:"-"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
 }
 
 // NewUser creates a new user with required fields.
@@ -33,6 +36,7 @@ func NewUser(firebaseUID string) (*User, error) {
 	return &User{
 		FirebaseUID:       firebaseUID,
 		PreferredLanguage: "en",
+		Plan:              PlanFree,
 		CreatedAt:         now,
 		UpdatedAt:         now,
 	}, nil
@@ -73,6 +77,13 @@ func (u *User) SetName(name string) {
 	u.UpdatedAt = time.Now().UTC()
 }
 
+// SessionRevoked reports whether a token issued at issuedAt, a Unix time,
+// belongs to a session revoked since. Revocation has a precision of one
+// second, like the tokens.
+func (u *User) SessionRevoked(issuedAt int64) bool {
+	return u.SessionsRevokedAt != nil && issuedAt < u.SessionsRevokedAt.Unix()
+}
+
 // GetDisplayName returns the user's display name (name or email fallback).
 func (u *User) GetDisplayName() string {
 	if u.Name != nil && *u.Name != "" {
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// UserIdentity is a sign-in method linked to a user besides the one they
+// signed up with, such as GitHub for a user who signed up with Google. Each
+// identity has its own user ID at the authentication provider.
+type UserIdentity struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+
+	// ProviderUserID is the user ID of the identity at the authentication
+	// provider, like User.FirebaseUID for the sign-up identity.
+	ProviderUserID string `json:"provider_user_id"`
+
+	// Provider is the sign-in method (e.g., "github.com"); empty if unknown.
+	Provider string `json:"provider"`
+
+	Email     *string   `json:"email,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewUserIdentity creates an identity to link to a user.
+func NewUserIdentity(userID, providerUserID, provider, email string) (*UserIdentity, error) {
+	if providerUserID == "" {
+		return nil, ErrInvalidFirebaseUID
+	}
+
+	identity := &UserIdentity{
+		UserID:         userID,
+		ProviderUserID: providerUserID,
+		Provider:       provider,
+		CreatedAt:      time.Now().UTC(),
+	}
+	if email != "" {
+		identity.Email = &email
+	}
+	return identity, nil
+}
@@ -206,41 +206,131 @@ func (s MatchScore) Int() int {
 	return int(s)
 }
 
-// Date represents a date without time information.
+// DatePrecision is the granularity a date was entered with.
+type DatePrecision string
+
+// Date precision constants.
+const (
+	// DatePrecisionDay is a full calendar date (YYYY-MM-DD).
+	DatePrecisionDay DatePrecision = "day"
+
+	// DatePrecisionMonth is a month and year only (YYYY-MM). The day is
+	// stored as the 1st and ignored in comparisons.
+	DatePrecisionMonth DatePrecision = "month"
+)
+
+// IsValid checks if the date precision is valid.
+func (p DatePrecision) IsValid() bool {
+	return p == DatePrecisionDay || p == DatePrecisionMonth
+}
+
+// Date represents a calendar date without time of day or time zone. The
+// embedded time is always midnight UTC so that the date never shifts when
+// converted between zones; build dates with NewDate, NewMonthDate, DateOf or
+// ParseDate rather than from arbitrary timestamps.
+//
+// Dates serialize as YYYY-MM-DD, or YYYY-MM for month precision.
 type Date struct {
 	time.Time
+	Precision DatePrecision
 }
 
 // NewDate creates a new Date from year, month, and day.
 func NewDate(year int, month time.Month, day int) Date {
-	return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+	return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC), Precision: DatePrecisionDay}
+}
+
+// NewMonthDate creates a new month-precision Date.
+func NewMonthDate(year int, month time.Month) Date {
+	return Date{Time: time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), Precision: DatePrecisionMonth}
+}
+
+// DateOf returns the calendar date of t in t's own location, so a
+// timestamp late in the evening west of UTC keeps its local day.
+func DateOf(t time.Time) Date {
+	return NewDate(t.Year(), t.Month(), t.Day())
 }
 
-// ParseDate parses a date string in YYYY-MM-DD format.
+// ParseDate parses a date string in YYYY-MM-DD or YYYY-MM format.
 func ParseDate(s string) (Date, error) {
-	t, err := time.Parse("2006-01-02", s)
-	if err != nil {
-		return Date{}, ErrInvalidDateFormat
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return Date{Time: t, Precision: DatePrecisionDay}, nil
 	}
-	return Date{Time: t}, nil
+	if t, err := time.Parse("2006-01", s); err == nil {
+		return Date{Time: t, Precision: DatePrecisionMonth}, nil
+	}
+	return Date{}, ErrInvalidDateFormat
 }
 
-// String returns the date in YYYY-MM-DD format.
+// String returns the date in YYYY-MM-DD format, or YYYY-MM for month precision.
 func (d Date) String() string {
+	if d.IsMonthOnly() {
+		return d.Time.Format("2006-01")
+	}
 	return d.Time.Format("2006-01-02")
 }
 
+// IsMonthOnly reports whether the date has month precision.
+func (d Date) IsMonthOnly() bool {
+	return d.Precision == DatePrecisionMonth
+}
+
 // IsZero reports whether the date is the zero value.
 func (d Date) IsZero() bool {
 	return d.Time.IsZero()
 }
 
-// Before reports whether d is before other.
+// Before reports whether d is before other. When either date has month
+// precision only the month and year are compared.
 func (d Date) Before(other Date) bool {
+	if d.IsMonthOnly() || other.IsMonthOnly() {
+		return d.monthIndex() < other.monthIndex()
+	}
 	return d.Time.Before(other.Time)
 }
 
-// After reports whether d is after other.
+// After reports whether d is after other. When either date has month
+// precision only the month and year are compared.
 func (d Date) After(other Date) bool {
+	if d.IsMonthOnly() || other.IsMonthOnly() {
+		return d.monthIndex() > other.monthIndex()
+	}
 	return d.Time.After(other.Time)
 }
+
+// IsFuture reports whether the date is later than the current UTC day. A
+// month-precision date is in the future only from the next month on.
+func (d Date) IsFuture() bool {
+	return d.After(DateOf(time.Now().UTC()))
+}
+
+// monthIndex returns the number of months since year 0.
+func (d Date) monthIndex() int {
+	return d.Year()*12 + int(d.Month()) - 1
+}
+
+// MarshalJSON encodes the date as a string, or null when zero.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+// UnmarshalJSON decodes a YYYY-MM-DD or YYYY-MM string.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" || s == `""` {
+		*d = Date{}
+		return nil
+	}
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return ErrInvalidDateFormat
+	}
+	parsed, err := ParseDate(s[1 : len(s)-1])
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,74 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestParseDate(t *testing.T) {
+	t.Run("full date", func(t *testing.T) {
+		d, err := domain.ParseDate("2024-02-29")
+		require.NoError(t, err)
+		assert.Equal(t, domain.NewDate(2024, time.February, 29), d)
+		assert.Equal(t, "2024-02-29", d.String())
+	})
+
+	t.Run("month only", func(t *testing.T) {
+		d, err := domain.ParseDate("2024-02")
+		require.NoError(t, err)
+		assert.True(t, d.IsMonthOnly())
+		assert.Equal(t, domain.NewMonthDate(2024, time.February), d)
+		assert.Equal(t, "2024-02", d.String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, s := range []string{"", "2024", "02/2024", "2024-13", "2024-02-30T00:00:00Z"} {
+			_, err := domain.ParseDate(s)
+			assert.ErrorIs(t, err, domain.ErrInvalidDateFormat, s)
+		}
+	})
+}
+
+func TestDateOfKeepsLocalDay(t *testing.T) {
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+	evening := time.Date(2024, time.March, 31, 22, 0, 0, 0, saoPaulo)
+
+	d := domain.DateOf(evening)
+	assert.Equal(t, "2024-03-31", d.String())
+	assert.Equal(t, time.UTC, d.Location())
+}
+
+func TestDateCompareMonthPrecision(t *testing.T) {
+	month := domain.NewMonthDate(2024, time.March)
+	midMonth := domain.NewDate(2024, time.March, 15)
+
+	assert.False(t, midMonth.Before(month))
+	assert.False(t, month.After(midMonth))
+	assert.True(t, month.Before(domain.NewDate(2024, time.April, 1)))
+	assert.True(t, domain.NewDate(2024, time.March, 1).Before(midMonth))
+}
+
+func TestDateJSON(t *testing.T) {
+	type payload struct {
+		Start domain.Date  `json:"start"`
+		End   *domain.Date `json:"end"`
+	}
+
+	end := domain.NewDate(2024, time.June, 30)
+	data, err := json.Marshal(payload{Start: domain.NewMonthDate(2023, time.January), End: &end})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"start":"2023-01","end":"2024-06-30"}`, string(data))
+
+	var decoded payload
+	require.NoError(t, json.Unmarshal([]byte(`{"start":"2023-01","end":null}`), &decoded))
+	assert.Equal(t, domain.NewMonthDate(2023, time.January), decoded.Start)
+	assert.Nil(t, decoded.End)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"start":"2023-01-01T00:00:00Z"}`), &decoded))
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// VerbTense is the tense of the action verb a bullet opens with.
+type VerbTense string
+
+// Verb tenses of bullets: past for ended roles, present for current ones.
+const (
+	VerbTensePast    VerbTense = "past"
+	VerbTensePresent VerbTense = "present"
+)
+
+// verbConjugation holds the past and present forms matching one form of an
+// action verb, in the same person.
+type verbConjugation struct {
+	past    string
+	present string
+}
+
+// englishThirdPersons are the English third-person presents not formed by
+// adding -s.
+var englishThirdPersons = map[string]string{
+	"oversee": "oversees",
+}
+
+// portugueseIrregularPresents are the first- and third-person presents not
+// formed regularly.
+var portugueseIrregularPresents = map[string][2]string{
+	"construir":   {"construo", "constrói"},
+	"conduzir":    {"conduzo", "conduz"},
+	"estabelecer": {"estabeleço", "estabelece"},
+	"manter":      {"mantenho", "mantém"},
+	"reduzir":     {"reduzo", "reduz"},
+}
+
+// nounLikePresents are present forms that more often open a noun phrase
+// than a sentence, such as "Design system" or "Projeto de migração", and
+// are therefore not turned into the past.
+var nounLikePresents = map[string]bool{
+	"design": true, "document": true, "lead": true, "monitor": true,
+	"plan": true, "present": true, "research": true, "review": true,
+	"support": true, "test": true, "train": true, "upgrade": true,
+	"apoio": true, "arquiteto": true, "aumento": true, "documento": true,
+	"escala": true, "implemento": true, "integro": true, "lanço": true,
+	"negocio": true, "pesquisa": true, "projeto": true, "treino": true,
+}
+
+// verbConjugations maps the lowercase forms of the action verbs to their
+// past and present forms.
+var verbConjugations = verbConjugationTable()
+
+// verbConjugationTable returns the conjugations of the action verbs: the
+// base form, third person and past in English, and the first and third
+// persons of the past and present in Portuguese. Infinitives are left out,
+// as they have no tense.
+func verbConjugationTable() map[string]verbConjugation {
+	table := make(map[string]verbConjugation)
+	add := func(c verbConjugation) {
+		table[c.past] = c
+		table[c.present] = c
+	}
+
+	for _, verb := range englishActionVerbs {
+		past := englishPast(verb)
+		add(verbConjugation{past: past, present: verb})
+
+		third, ok := englishThirdPersons[verb]
+		switch {
+		case ok:
+		case strings.HasSuffix(verb, "y") && !strings.ContainsAny(verb[len(verb)-2:len(verb)-1], "aeiou"):
+			third = strings.TrimSuffix(verb, "y") + "ies"
+		case strings.HasSuffix(verb, "s") || strings.HasSuffix(verb, "sh") || strings.HasSuffix(verb, "ch"):
+			third = verb + "es"
+		default:
+			third = verb + "s"
+		}
+		table[third] = verbConjugation{past: past, present: third}
+	}
+
+	for _, verb := range portugueseActionVerbs {
+		pasts := portuguesePasts(verb)
+		presents, ok := portugueseIrregularPresents[verb]
+		if !ok {
+			stem := verb[:len(verb)-2]
+			third := stem + "a"
+			if !strings.HasSuffix(verb, "ar") {
+				third = stem + "e"
+			}
+			presents = [2]string{stem + "o", third}
+		}
+		add(verbConjugation{past: pasts[0], present: presents[0]})
+		add(verbConjugation{past: pasts[1], present: presents[1]})
+	}
+	return table
+}
+
+// ConformVerbTense rewrites the action verb text opens with in tense,
+// keeping its person and capitalization, and reports whether text changed.
+// Text that does not open with an action verb, opens with an infinitive or
+// with a present form that is more often a noun, such as "Design", is
+// returned unchanged.
+func ConformVerbTense(text string, tense VerbTense) (string, bool) {
+	for _, field := range strings.Fields(text) {
+		word := strings.TrimFunc(field, func(r rune) bool { return !unicode.IsLetter(r) })
+		if word == "" {
+			continue
+		}
+		if utf8.RuneCountInString(word) > 1 && strings.ToUpper(word) == word {
+			return text, false
+		}
+
+		lower := strings.ToLower(word)
+		conjugation, ok := verbConjugations[lower]
+		if !ok {
+			return text, false
+		}
+		target := conjugation.present
+		if tense == VerbTensePast {
+			if nounLikePresents[lower] {
+				return text, false
+			}
+			target = conjugation.past
+		}
+		if target == lower {
+			return text, false
+		}
+
+		if first, _ := utf8.DecodeRuneInString(word); unicode.IsUpper(first) {
+			r, size := utf8.DecodeRuneInString(target)
+			target = string(unicode.ToUpper(r)) + target[size:]
+		}
+		// Only non-letters come before the verb, so its first occurrence
+		// is the one to replace.
+		return strings.Replace(text, word, target, 1), true
+	}
+	return text, false
+}
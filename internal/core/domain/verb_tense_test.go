@@ -0,0 +1,47 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestConformVerbTense(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		tense   domain.VerbTense
+		want    string
+		changed bool
+	}{
+		{name: "present to past", text: "Build the billing API", tense: domain.VerbTensePast, want: "Built the billing API", changed: true},
+		{name: "third person to past", text: "Manages a team of five", tense: domain.VerbTensePast, want: "Managed a team of five", changed: true},
+		{name: "y to ied", text: "simplify deploys", tense: domain.VerbTensePast, want: "simplified deploys", changed: true},
+		{name: "past to present", text: "Led a team of five", tense: domain.VerbTensePresent, want: "Lead a team of five", changed: true},
+		{name: "markdown kept", text: "**Reduced** latency by 40%", tense: domain.VerbTensePresent, want: "**Reduce** latency by 40%", changed: true},
+		{name: "third person kept", text: "Oversees hiring", tense: domain.VerbTensePresent, want: "Oversees hiring"},
+		{name: "already past", text: "Deployed services", tense: domain.VerbTensePast, want: "Deployed services"},
+		{name: "same forms", text: "Cut costs by 30%", tense: domain.VerbTensePresent, want: "Cut costs by 30%"},
+		{name: "noun-like present", text: "Design system for the web app", tense: domain.VerbTensePast, want: "Design system for the web app"},
+		{name: "not a verb", text: "Responsible for the billing API", tense: domain.VerbTensePast, want: "Responsible for the billing API"},
+		{name: "acronym", text: "AWS migration", tense: domain.VerbTensePast, want: "AWS migration"},
+		{name: "portuguese first person", text: "Liderei a migração para Go", tense: domain.VerbTensePresent, want: "Lidero a migração para Go", changed: true},
+		{name: "portuguese third person", text: "Desenvolve serviços em Go", tense: domain.VerbTensePast, want: "Desenvolveu serviços em Go", changed: true},
+		{name: "portuguese respelled stem", text: "Publico a documentação", tense: domain.VerbTensePast, want: "Publiquei a documentação", changed: true},
+		{name: "portuguese irregular", text: "Mantive a plataforma", tense: domain.VerbTensePresent, want: "Mantenho a plataforma", changed: true},
+		{name: "portuguese accented", text: "Construí o pipeline", tense: domain.VerbTensePresent, want: "Construo o pipeline", changed: true},
+		{name: "portuguese infinitive", text: "Liderar a migração", tense: domain.VerbTensePast, want: "Liderar a migração"},
+		{name: "portuguese noun", text: "Projeto de migração", tense: domain.VerbTensePast, want: "Projeto de migração"},
+		{name: "empty", text: "", tense: domain.VerbTensePast, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := domain.ConformVerbTense(tt.text, tt.tense)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.changed, changed)
+		})
+	}
+}
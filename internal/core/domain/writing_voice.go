@@ -0,0 +1,73 @@
+package domain
+
+// Tone is the tone tailored content is written in.
+type Tone string
+
+// Tone constants.
+const (
+	// ToneConfident states achievements assertively, without hedging.
+	ToneConfident Tone = "confident"
+
+	// ToneHumble credits teams and lets results speak, without
+	// superlatives.
+	ToneHumble Tone = "humble"
+
+	// ToneEnergetic uses lively verbs and shows enthusiasm for the work.
+	ToneEnergetic Tone = "energetic"
+)
+
+// IsValid checks if the tone is valid. Empty is the AI's default tone.
+func (t Tone) IsValid() bool {
+	switch t {
+	case "", ToneConfident, ToneHumble, ToneEnergetic:
+		return true
+	default:
+		return false
+	}
+}
+
+// Persona is the reader tailored content is written for.
+type Persona string
+
+// Persona constants.
+const (
+	// PersonaTechnicalHiringManager is an engineer who judges technical
+	// depth, tools and the scale of the work.
+	PersonaTechnicalHiringManager Persona = "technical_hiring_manager"
+
+	// PersonaHRScreener is a recruiter who skims for the job's keywords,
+	// titles and plain-language outcomes.
+	PersonaHRScreener Persona = "hr_screener"
+)
+
+// IsValid checks if the persona is valid. Empty writes for no reader in
+// particular.
+func (p Persona) IsValid() bool {
+	switch p {
+	case "", PersonaTechnicalHiringManager, PersonaHRScreener:
+		return true
+	default:
+		return false
+	}
+}
+
+// WritingVoice is the tone and target reader tailored content is written
+// with. Zero values keep the AI's defaults.
+type WritingVoice struct {
+	Tone    Tone    `json:"tone,omitempty"`
+	Persona Persona `json:"persona,omitempty"`
+}
+
+// Validate validates the tone and persona.
+func (v WritingVoice) Validate() error {
+	errs := &ValidationErrors{}
+
+	if !v.Tone.IsValid() {
+		errs.AddFieldError("tone", "must be confident, humble or energetic")
+	}
+	if !v.Persona.IsValid() {
+		errs.AddFieldError("persona", "must be technical_hiring_manager or hr_screener")
+	}
+
+	return errs.ToError()
+}
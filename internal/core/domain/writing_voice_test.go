@@ -0,0 +1,20 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+func TestWritingVoiceValidate(t *testing.T) {
+	assert.NoError(t, domain.WritingVoice{}.Validate())
+	assert.NoError(t, domain.WritingVoice{Tone: domain.ToneHumble, Persona: domain.PersonaHRScreener}.Validate())
+
+	err := domain.WritingVoice{Tone: "sarcastic", Persona: "ceo"}.Validate()
+	assert.Equal(t, []string{"tone", "persona"}, fieldErrors(t, err))
+
+	err = domain.WritingVoice{Tone: "Confident"}.Validate()
+	assert.Equal(t, []string{"tone"}, fieldErrors(t, err))
+}
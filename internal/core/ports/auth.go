@@ -1,7 +1,10 @@
 // Package ports defines the interfaces (ports) that adapters must implement.
 package ports
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // AuthClaims represents the claims extracted from an authentication token.
 type AuthClaims struct {
@@ -40,3 +43,36 @@ type AuthProvider interface {
 	// Close releases any resources held by the auth provider.
 	Close() error
 }
+
+// AuthAccount describes a user's account at the authentication provider.
+type AuthAccount struct {
+	// Providers lists the sign-in methods linked to the account (e.g.,
+	// "google.com", "password").
+	Providers []string
+
+	CreatedAt time.Time
+
+	// LastSignInAt is when the user last signed in, on any device.
+	LastSignInAt time.Time
+
+	// LastRefreshAt is when a session last refreshed its token; zero if
+	// unknown.
+	LastRefreshAt time.Time
+
+	// ValidSince is when the sessions were last revoked: tokens issued
+	// before are rejected. Zero if never.
+	ValidSince time.Time
+}
+
+// SessionManager defines the interface for managing the sign-in sessions of
+// users at the authentication provider. It is optional: providers that
+// cannot revoke sessions don't implement it.
+type SessionManager interface {
+	// GetAccount returns the sign-in activity of a user, identified by the
+	// provider's user ID.
+	GetAccount(ctx context.Context, providerUserID string) (*AuthAccount, error)
+
+	// RevokeSessions revokes the refresh tokens of a user, signing them out
+	// on every device once their ID tokens expire.
+	RevokeSessions(ctx context.Context, providerUserID string) error
+}
@@ -3,6 +3,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 )
@@ -12,12 +13,21 @@ type UserRepository interface {
 	// Create creates a new user in the database.
 	Create(ctx context.Context, user *domain.User) error
 
+	// CreateWithEvents creates a new user and adds events about it to the
+	// outbox in the same transaction. The events get the ID of the new user.
+	CreateWithEvents(ctx context.Context, user *domain.User, events []domain.OutboxEvent) error
+
 	// GetByID retrieves a user by their internal ID.
 	GetByID(ctx context.Context, id string) (*domain.User, error)
 
-	// GetByFirebaseUID retrieves a user by their Firebase UID.
+	// GetByFirebaseUID retrieves a user by the provider user ID of their
+	// sign-up identity, their Firebase UID, or of a linked identity.
 	GetByFirebaseUID(ctx context.Context, firebaseUID string) (*domain.User, error)
 
+	// GetByEmail retrieves the oldest user with an email, compared
+	// case-insensitively.
+	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+
 	// Update updates an existing user.
 	Update(ctx context.Context, user *domain.User) error
 
@@ -26,6 +36,15 @@ type UserRepository interface {
 
 	// Upsert creates or updates a user based on Firebase UID.
 	Upsert(ctx context.Context, user *domain.User) error
+
+	// LinkIdentity links an identity to its user.
+	LinkIdentity(ctx context.Context, identity *domain.UserIdentity) error
+
+	// ListIdentities lists the identities linked to a user, oldest first.
+	ListIdentities(ctx context.Context, userID string) ([]domain.UserIdentity, error)
+
+	// UnlinkIdentity removes an identity linked to a user.
+	UnlinkIdentity(ctx context.Context, id, userID string) error
 }
 
 // ExperienceRepository defines the interface for experience persistence operations.
@@ -33,11 +52,13 @@ type ExperienceRepository interface {
 	// Create creates a new experience.
 	Create(ctx context.Context, experience *domain.Experience) error
 
-	// GetByID retrieves an experience by ID.
-	GetByID(ctx context.Context, id string) (*domain.Experience, error)
+	// GetByIDForUser retrieves a user's experience by ID. Experiences of
+	// other users are reported as domain.ErrExperienceNotFound.
+	GetByIDForUser(ctx context.Context, id, userID string) (*domain.Experience, error)
 
-	// GetByIDWithBullets retrieves an experience with all its bullets.
-	GetByIDWithBullets(ctx context.Context, id string) (*domain.Experience, error)
+	// GetByIDWithBulletsForUser retrieves a user's experience with all its
+	// bullets.
+	GetByIDWithBulletsForUser(ctx context.Context, id, userID string) (*domain.Experience, error)
 
 	// ListByUserID lists all experiences for a user.
 	ListByUserIDWithBullets(ctx context.Context, userID string, opts ListOptions) ([]domain.Experience, int, error)
@@ -48,11 +69,15 @@ type ExperienceRepository interface {
 	// Update updates an existing experience.
 	Update(ctx context.Context, experience *domain.Experience) error
 
-	// Delete removes an experience and all its bullets.
-	Delete(ctx context.Context, id string) error
+	// Delete removes a user's experience and all its bullets.
+	Delete(ctx context.Context, id, userID string) error
+
+	// UpdateDisplayOrder updates the display order of a user's experiences.
+	// IDs of other users' experiences are ignored.
+	UpdateDisplayOrder(ctx context.Context, userID string, orders []DisplayOrderUpdate) error
 
-	// UpdateDisplayOrder updates the display order of experiences.
-	UpdateDisplayOrder(ctx context.Context, orders []DisplayOrderUpdate) error
+	// CreateBatch creates experiences and their bullets in a single transaction.
+	CreateBatch(ctx context.Context, experiences []domain.Experience) error
 }
 
 // BulletRepository defines the interface for bullet persistence operations.
@@ -60,8 +85,9 @@ type BulletRepository interface {
 	// Create creates a new bullet.
 	Create(ctx context.Context, bullet *domain.Bullet) error
 
-	// GetByID retrieves a bullet by ID.
-	GetByID(ctx context.Context, id string) (*domain.Bullet, error)
+	// GetByIDForUser retrieves a bullet by ID if its experience belongs to
+	// the user.
+	GetByIDForUser(ctx context.Context, id, userID string) (*domain.Bullet, error)
 
 	// ListByExperienceID lists all bullets for an experience.
 	ListByExperienceID(ctx context.Context, experienceID string) ([]domain.Bullet, error)
@@ -75,14 +101,37 @@ type BulletRepository interface {
 	// Update updates an existing bullet.
 	Update(ctx context.Context, bullet *domain.Bullet) error
 
-	// Delete removes a bullet.
-	Delete(ctx context.Context, id string) error
+	// Delete removes a bullet if its experience belongs to the user.
+	Delete(ctx context.Context, id, userID string) error
 
 	// SearchByKeywords searches bullets by keywords.
 	SearchByKeywords(ctx context.Context, userID string, keywords []string) ([]domain.Bullet, error)
 
+	// SearchSemantic returns the k bullets whose stored embeddings are closest
+	// (by cosine distance) to queryEmbedding.
+	SearchSemantic(ctx context.Context, userID string, queryEmbedding []float32, k int) ([]domain.Bullet, error)
+
 	// GetHighImpactBullets retrieves bullets with impact score >= threshold.
 	GetHighImpactBullets(ctx context.Context, userID string, minScore int, limit int) ([]domain.Bullet, error)
+
+	// ListForRescoring lists bullets of any user whose impact score was not
+	// computed or given for their current content, least recently updated
+	// first.
+	ListForRescoring(ctx context.Context, limit int) ([]domain.Bullet, error)
+
+	// UpdateImpactScores saves the impact scores of bullets, computed for
+	// their content, and returns the number saved. Bullets whose content
+	// changed since are skipped.
+	UpdateImpactScores(ctx context.Context, bullets []domain.Bullet) (int, error)
+}
+
+// BulletEmbeddingRepository defines the interface for persisting precomputed bullet embeddings.
+type BulletEmbeddingRepository interface {
+	// Upsert creates or replaces embeddings, keyed by bullet ID.
+	Upsert(ctx context.Context, embeddings []domain.BulletEmbedding) error
+
+	// ListByUserID lists a user's bullet embeddings computed with the given model.
+	ListByUserID(ctx context.Context, userID, model string) ([]domain.BulletEmbedding, error)
 }
 
 // SkillRepository defines the interface for skill persistence operations.
@@ -90,8 +139,8 @@ type SkillRepository interface {
 	// Create creates a new skill.
 	Create(ctx context.Context, skill *domain.Skill) error
 
-	// GetByID retrieves a skill by ID.
-	GetByID(ctx context.Context, id string) (*domain.Skill, error)
+	// GetByIDForUser retrieves a user's skill by ID.
+	GetByIDForUser(ctx context.Context, id, userID string) (*domain.Skill, error)
 
 	// GetByUserIDAndName retrieves a skill by user ID and name.
 	GetByUserIDAndName(ctx context.Context, userID, name string) (*domain.Skill, error)
@@ -114,8 +163,8 @@ type SkillRepository interface {
 	// BatchUpsert creates or updates multiple skills.
 	BatchUpsert(ctx context.Context, skills []domain.Skill) (created int, updated int, err error)
 
-	// Delete removes a skill.
-	Delete(ctx context.Context, id string) error
+	// Delete removes a user's skill.
+	Delete(ctx context.Context, id, userID string) error
 
 	// SearchByName searches skills by name (fuzzy match).
 	SearchByName(ctx context.Context, userID, query string) ([]domain.Skill, error)
@@ -126,8 +175,8 @@ type SpokenLanguageRepository interface {
 	// Create creates a new spoken language.
 	Create(ctx context.Context, language *domain.SpokenLanguage) error
 
-	// GetByID retrieves a spoken language by ID.
-	GetByID(ctx context.Context, id string) (*domain.SpokenLanguage, error)
+	// GetByIDForUser retrieves a user's spoken language by ID.
+	GetByIDForUser(ctx context.Context, id, userID string) (*domain.SpokenLanguage, error)
 
 	// ListByUserID lists all spoken languages for a user.
 	ListByUserID(ctx context.Context, userID string) ([]domain.SpokenLanguage, error)
@@ -135,8 +184,8 @@ type SpokenLanguageRepository interface {
 	// Update updates an existing spoken language.
 	Update(ctx context.Context, language *domain.SpokenLanguage) error
 
-	// Delete removes a spoken language.
-	Delete(ctx context.Context, id string) error
+	// Delete removes a user's spoken language.
+	Delete(ctx context.Context, id, userID string) error
 }
 
 // ResumeRepository defines the interface for resume persistence operations.
@@ -144,22 +193,250 @@ type ResumeRepository interface {
 	// Create creates a new resume.
 	Create(ctx context.Context, resume *domain.Resume) error
 
-	// GetByID retrieves a resume by ID.
-	GetByID(ctx context.Context, id string) (*domain.Resume, error)
+	// GetByIDForUser retrieves a user's resume by ID.
+	GetByIDForUser(ctx context.Context, id, userID string) (*domain.Resume, error)
 
 	// ListByUserID lists all resumes for a user.
 	ListByUserID(ctx context.Context, userID string, opts ListOptions) ([]domain.Resume, int, error)
 
-	// ListByUserIDAndStatus lists resumes filtered by status.
-	ListByUserIDAndStatus(ctx context.Context, userID string, status domain.ResumeStatus, opts ListOptions) ([]domain.Resume, int, error)
+	// ListByUserIDFiltered lists resumes matching all the filter criteria.
+	ListByUserIDFiltered(ctx context.Context, userID string, filter ResumeFilter, opts ListOptions) ([]domain.Resume, int, error)
 
 	// Update updates an existing resume.
 	Update(ctx context.Context, resume *domain.Resume) error
 
-	// Delete removes a resume.
+	// UpdateWithEvents updates an existing resume and adds events to the
+	// outbox in the same transaction.
+	UpdateWithEvents(ctx context.Context, resume *domain.Resume, events []domain.OutboxEvent) error
+
+	// UpdateMany updates existing resumes and adds events to the outbox in
+	// a single transaction: either every resume is updated or none is.
+	UpdateMany(ctx context.Context, resumes []*domain.Resume, events []domain.OutboxEvent) error
+
+	// Delete removes a user's resume.
+	Delete(ctx context.Context, id, userID string) error
+
+	// MarkAccessed records that a resume was opened at the given time,
+	// unless it was opened later already. The resume is not otherwise
+	// updated.
+	MarkAccessed(ctx context.Context, id string, at time.Time) error
+
+	// DeleteMany removes a user's resumes in a single transaction: either
+	// every resume is removed or none is.
+	DeleteMany(ctx context.Context, ids []string, userID string) error
+
+	// OwnersByID returns the user IDs of the resumes with the given IDs,
+	// keyed by resume ID. IDs of missing resumes, including IDs that are not
+	// valid resume IDs, are left out.
+	OwnersByID(ctx context.Context, ids []string) (map[string]string, error)
+
+	// UpdatePDFURLs replaces the PDF URLs of resumes that have one, in a
+	// single transaction, and returns how many resumes were updated.
+	UpdatePDFURLs(ctx context.Context, updates []PDFURLUpdate) (int, error)
+}
+
+// PDFURLUpdate represents a PDF URL update for a resume.
+type PDFURLUpdate struct {
+	ResumeID string
+	URL      string
+}
+
+// ResumeFilter narrows resume lists. Zero fields do not filter.
+type ResumeFilter struct {
+	Status *domain.ResumeStatus
+
+	// RemotePolicies keeps resumes whose job has one of these policies.
+	RemotePolicies []domain.RemotePolicy
+
+	// MinAnnualSalary keeps resumes whose job pays at least this much per
+	// year at the top of its range. Jobs without a salary are excluded.
+	MinAnnualSalary *int
+
+	// SalaryCurrency keeps resumes whose salary is in this ISO 4217 currency.
+	SalaryCurrency string
+
+	// VisaSponsorship keeps resumes whose job explicitly offers (true) or
+	// rules out (false) visa sponsorship.
+	VisaSponsorship *bool
+
+	// TagIDs keeps resumes that have all of these tags.
+	TagIDs []string
+
+	// MinScore keeps resumes with at least this match score.
+	MinScore *int
+
+	// Company keeps resumes whose company name contains this text,
+	// ignoring case.
+	Company string
+
+	// CreatedAfter and CreatedBefore keep resumes created at or after, and
+	// before, these times.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+
+	// Language keeps resumes whose target language is this language or one
+	// of its regional variants.
+	Language string
+
+	// Archived keeps archived (true) or unarchived (false) resumes.
+	Archived *bool
+
+	// Sort orders the resumes, newest first when empty. It does not filter.
+	Sort domain.ResumeSort
+
+	// Summary leaves the job description, generated content and selection
+	// explanation out of the listed resumes. It does not filter.
+	Summary bool
+}
+
+// IsEmpty reports whether the filter matches every resume.
+func (f ResumeFilter) IsEmpty() bool {
+	return f.Status == nil && len(f.RemotePolicies) == 0 && f.MinAnnualSalary == nil &&
+		f.SalaryCurrency == "" && f.VisaSponsorship == nil && len(f.TagIDs) == 0 &&
+		f.MinScore == nil && f.Company == "" && f.CreatedAfter == nil &&
+		f.CreatedBefore == nil && f.Language == "" && f.Archived == nil
+}
+
+// ResumeTagRepository defines the interface for resume tag persistence.
+type ResumeTagRepository interface {
+	// Create creates a new tag.
+	Create(ctx context.Context, tag *domain.ResumeTag) error
+
+	// GetByIDForUser retrieves a user's tag by ID.
+	GetByIDForUser(ctx context.Context, id, userID string) (*domain.ResumeTag, error)
+
+	// ListByUserID lists a user's tags by name, with their resume counts.
+	ListByUserID(ctx context.Context, userID string) ([]domain.ResumeTag, error)
+
+	// Update updates an existing tag.
+	Update(ctx context.Context, tag *domain.ResumeTag) error
+
+	// Delete removes a user's tag from all resumes and deletes it.
+	Delete(ctx context.Context, id, userID string) error
+
+	// Assign adds the tag to the resumes in add and removes it from those
+	// in remove, in a single transaction.
+	Assign(ctx context.Context, tagID string, add, remove []string) error
+}
+
+// SavedResumeFilterRepository defines the interface for saved resume filter
+// persistence.
+type SavedResumeFilterRepository interface {
+	// Create creates a new saved filter.
+	Create(ctx context.Context, filter *domain.SavedResumeFilter) error
+
+	// GetByIDForUser retrieves a user's saved filter by ID.
+	GetByIDForUser(ctx context.Context, id, userID string) (*domain.SavedResumeFilter, error)
+
+	// ListByUserID lists a user's saved filters by name.
+	ListByUserID(ctx context.Context, userID string) ([]domain.SavedResumeFilter, error)
+
+	// Update updates an existing saved filter.
+	Update(ctx context.Context, filter *domain.SavedResumeFilter) error
+
+	// Delete removes a user's saved filter.
+	Delete(ctx context.Context, id, userID string) error
+}
+
+// SuggestionRepository defines the interface for type-ahead lookups across a
+// user's data.
+type SuggestionRepository interface {
+	// Suggest returns up to limit distinct values of each suggestion type
+	// that contain the query, ignoring case, best matches first. Values
+	// starting with the query rank before the others.
+	Suggest(ctx context.Context, userID, query string, limit int) ([]domain.Suggestion, error)
+}
+
+// ResumeCritiqueRepository defines the interface for resume critique persistence.
+type ResumeCritiqueRepository interface {
+	// Create stores a new critique.
+	Create(ctx context.Context, critique *domain.ResumeCritique) error
+
+	// ListByResumeID lists a resume's critiques, newest first.
+	ListByResumeID(ctx context.Context, resumeID string) ([]domain.ResumeCritique, error)
+}
+
+// BulletFeedbackRepository defines the interface for tailored bullet feedback persistence.
+type BulletFeedbackRepository interface {
+	// Upsert stores feedback, replacing the resume's earlier feedback on the
+	// same bullet.
+	Upsert(ctx context.Context, feedback *domain.BulletFeedback) error
+
+	// ListByResumeID lists a resume's feedback, most recently updated first.
+	ListByResumeID(ctx context.Context, resumeID string) ([]domain.BulletFeedback, error)
+
+	// ListRecentByUserID lists up to limit of a user's feedback across all
+	// resumes, most recently updated first.
+	ListRecentByUserID(ctx context.Context, userID string, limit int) ([]domain.BulletFeedback, error)
+}
+
+// ResumeActivityRepository defines the interface for resume activity log persistence.
+type ResumeActivityRepository interface {
+	// Create appends an entry to a resume's activity log.
+	Create(ctx context.Context, activity *domain.ResumeActivity) error
+
+	// ListByResumeID lists a resume's activity log, oldest first.
+	ListByResumeID(ctx context.Context, resumeID string) ([]domain.ResumeActivity, error)
+}
+
+// AuditRepository defines the interface for the append-only audit log.
+type AuditRepository interface {
+	// Record appends an event to the audit log.
+	Record(ctx context.Context, event *domain.AuditEvent) error
+}
+
+// BackgroundJobRepository defines the interface for saving the background
+// jobs interrupted by a shutdown until the next start.
+type BackgroundJobRepository interface {
+	// Save stores an interrupted job.
+	Save(ctx context.Context, job *domain.BackgroundJob) error
+
+	// ListPending lists the saved jobs, oldest first.
+	ListPending(ctx context.Context) ([]domain.BackgroundJob, error)
+
+	// Delete removes a saved job.
 	Delete(ctx context.Context, id string) error
 }
 
+// OutboxRepository defines the interface for delivering the events of the
+// outbox. Events are added with the changes they describe, see
+// ResumeRepository.UpdateWithEvents.
+type OutboxRepository interface {
+	// ClaimDue returns up to limit undelivered events due for delivery,
+	// oldest first, and postpones their next delivery by lease so that
+	// concurrent relays skip them.
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxEvent, error)
+
+	// MarkSent records the delivery of an event.
+	MarkSent(ctx context.Context, id string) error
+
+	// MarkFailed records a failed delivery and when to try again.
+	MarkFailed(ctx context.Context, id, reason string, retryAt time.Time) error
+
+	// DeleteSentBefore removes the events delivered before a time and
+	// returns how many were removed.
+	DeleteSentBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// UsageRepository defines the interface for recording metered usage.
+type UsageRepository interface {
+	// Record stores one occurrence of an action by a user.
+	Record(ctx context.Context, userID string, action domain.UsageAction) error
+
+	// CountSince counts a user's occurrences of an action since the given time.
+	CountSince(ctx context.Context, userID string, action domain.UsageAction, since time.Time) (int, error)
+}
+
+// PreferencesRepository defines the interface for user preferences persistence.
+type PreferencesRepository interface {
+	// GetByUserID retrieves a user's preferences.
+	// Returns domain.ErrPreferencesNotFound if the user never saved any.
+	GetByUserID(ctx context.Context, userID string) (*domain.UserPreferences, error)
+
+	// Upsert creates or replaces a user's preferences.
+	Upsert(ctx context.Context, prefs *domain.UserPreferences) error
+}
+
 // ListOptions contains pagination and filtering options.
 type ListOptions struct {
 	Limit  int
@@ -185,8 +462,8 @@ type EducationRepository interface {
 	// Create creates a new education entry.
 	Create(ctx context.Context, education *domain.Education) error
 
-	// GetByID retrieves an education entry by ID.
-	GetByID(ctx context.Context, id string) (*domain.Education, error)
+	// GetByIDForUser retrieves a user's education entry by ID.
+	GetByIDForUser(ctx context.Context, id, userID string) (*domain.Education, error)
 
 	// ListByUserID lists all education entries for a user, ordered by display_order.
 	ListByUserID(ctx context.Context, userID string) ([]domain.Education, error)
@@ -194,11 +471,32 @@ type EducationRepository interface {
 	// Update updates an existing education entry.
 	Update(ctx context.Context, education *domain.Education) error
 
-	// Delete removes an education entry.
-	Delete(ctx context.Context, id string) error
+	// Delete removes a user's education entry.
+	Delete(ctx context.Context, id, userID string) error
 
-	// UpdateDisplayOrder updates the display order of education entries.
-	UpdateDisplayOrder(ctx context.Context, orders []DisplayOrderUpdate) error
+	// UpdateDisplayOrder updates the display order of a user's education
+	// entries. IDs of other users' entries are ignored.
+	UpdateDisplayOrder(ctx context.Context, userID string, orders []DisplayOrderUpdate) error
+}
+
+// AcademicEntryRepository defines the interface for academic entry
+// persistence operations.
+type AcademicEntryRepository interface {
+	// Create creates a new academic entry.
+	Create(ctx context.Context, entry *domain.AcademicEntry) error
+
+	// GetByIDForUser retrieves a user's academic entry by ID.
+	GetByIDForUser(ctx context.Context, id, userID string) (*domain.AcademicEntry, error)
+
+	// ListByUserID lists all academic entries for a user, ordered by
+	// display_order and then most recent first.
+	ListByUserID(ctx context.Context, userID string) ([]domain.AcademicEntry, error)
+
+	// Update updates an existing academic entry.
+	Update(ctx context.Context, entry *domain.AcademicEntry) error
+
+	// Delete removes a user's academic entry.
+	Delete(ctx context.Context, id, userID string) error
 }
 
 // ProjectRepository defines the interface for project persistence operations.
@@ -206,11 +504,12 @@ type ProjectRepository interface {
 	// Create creates a new project.
 	Create(ctx context.Context, project *domain.Project) error
 
-	// GetByID retrieves a project by ID (without bullets).
-	GetByID(ctx context.Context, id string) (*domain.Project, error)
+	// GetByIDForUser retrieves a user's project by ID (without bullets).
+	GetByIDForUser(ctx context.Context, id, userID string) (*domain.Project, error)
 
-	// GetByIDWithBullets retrieves a project with all its bullets.
-	GetByIDWithBullets(ctx context.Context, id string) (*domain.Project, error)
+	// GetByIDWithBulletsForUser retrieves a user's project with all its
+	// bullets.
+	GetByIDWithBulletsForUser(ctx context.Context, id, userID string) (*domain.Project, error)
 
 	// ListByUserID lists all projects for a user, ordered by display_order.
 	ListByUserID(ctx context.Context, userID string) ([]domain.Project, error)
@@ -221,11 +520,12 @@ type ProjectRepository interface {
 	// Update updates an existing project.
 	Update(ctx context.Context, project *domain.Project) error
 
-	// Delete removes a project and all its bullets.
-	Delete(ctx context.Context, id string) error
+	// Delete removes a user's project and all its bullets.
+	Delete(ctx context.Context, id, userID string) error
 
-	// UpdateDisplayOrder updates the display order of projects.
-	UpdateDisplayOrder(ctx context.Context, orders []DisplayOrderUpdate) error
+	// UpdateDisplayOrder updates the display order of a user's projects.
+	// IDs of other users' projects are ignored.
+	UpdateDisplayOrder(ctx context.Context, userID string, orders []DisplayOrderUpdate) error
 
 	// SearchByTechStack searches projects containing any of the given technologies.
 	SearchByTechStack(ctx context.Context, userID string, technologies []string) ([]domain.Project, error)
@@ -236,8 +536,9 @@ type ProjectBulletRepository interface {
 	// Create creates a new project bullet.
 	Create(ctx context.Context, bullet *domain.ProjectBullet) error
 
-	// GetByID retrieves a project bullet by ID.
-	GetByID(ctx context.Context, id string) (*domain.ProjectBullet, error)
+	// GetByIDForUser retrieves a project bullet by ID if its project
+	// belongs to the user.
+	GetByIDForUser(ctx context.Context, id, userID string) (*domain.ProjectBullet, error)
 
 	// ListByProjectID lists all bullets for a project, ordered by display_order.
 	ListByProjectID(ctx context.Context, projectID string) ([]domain.ProjectBullet, error)
@@ -245,9 +546,49 @@ type ProjectBulletRepository interface {
 	// Update updates an existing project bullet.
 	Update(ctx context.Context, bullet *domain.ProjectBullet) error
 
-	// Delete removes a project bullet.
-	Delete(ctx context.Context, id string) error
+	// Delete removes a project bullet if its project belongs to the user.
+	Delete(ctx context.Context, id, userID string) error
+
+	// UpdateDisplayOrder updates the display order of the bullets of a
+	// user's projects. IDs of other users' bullets are ignored.
+	UpdateDisplayOrder(ctx context.Context, userID string, orders []DisplayOrderUpdate) error
+}
+
+// ProfileRepositories are the repositories of the master profile of users.
+type ProfileRepositories struct {
+	Users           UserRepository
+	Experiences     ExperienceRepository
+	Bullets         BulletRepository
+	Education       EducationRepository
+	Projects        ProjectRepository
+	ProjectBullets  ProjectBulletRepository
+	Skills          SkillRepository
+	SpokenLanguages SpokenLanguageRepository
+}
+
+// ProfileTransactor runs changes to the master profile of users in single
+// transactions.
+type ProfileTransactor interface {
+	// InTransaction calls fn with repositories whose changes are committed
+	// together when fn returns nil and rolled back otherwise: either every
+	// change is saved or none is.
+	InTransaction(ctx context.Context, fn func(repos ProfileRepositories) error) error
+}
+
+// currentUserKey is the context key for the user whose data is accessed.
+type currentUserKey struct{}
+
+// WithCurrentUser returns a context carrying the ID of the user whose data
+// is accessed, so that repositories may restrict queries to that user's
+// rows, behind the ownership checks of services. An empty ID lifts the
+// restriction, for work across users such as administration.
+func WithCurrentUser(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, currentUserKey{}, userID)
+}
 
-	// UpdateDisplayOrder updates the display order of project bullets.
-	UpdateDisplayOrder(ctx context.Context, orders []DisplayOrderUpdate) error
+// CurrentUserFromContext returns the user whose data a context accesses, or
+// "" for work across users.
+func CurrentUserFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(currentUserKey{}).(string)
+	return id
 }
@@ -4,6 +4,11 @@ package ports
 import (
 	"context"
 	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 )
@@ -28,10 +33,132 @@ type AIProvider interface {
 	// ScoreMatch calculates a match score between resume and job.
 	ScoreMatch(ctx context.Context, req ScoreMatchRequest) (*domain.MatchScore, error)
 
+	// CritiqueResume reviews a tailored resume as a hiring manager for the job.
+	CritiqueResume(ctx context.Context, req CritiqueResumeRequest) (*domain.ResumeCritique, error)
+
+	// GroupBulletsByTheme groups tailored bullets under the skill themes
+	// they demonstrate, for skills-based resumes.
+	GroupBulletsByTheme(ctx context.Context, req GroupBulletsByThemeRequest) ([]domain.SkillTheme, error)
+
+	// ShortenTexts rewrites resume texts within length budgets, returning
+	// the shortened texts by ID.
+	ShortenTexts(ctx context.Context, req ShortenTextsRequest) (map[string]string, error)
+
+	// ScoreBulletImpact rates how impressive each bullet is on its own, from
+	// 0 to 100, returning the scores by bullet ID.
+	ScoreBulletImpact(ctx context.Context, req ScoreBulletImpactRequest) (map[string]int, error)
+
+	// EstimateTailoring estimates the tokens and cost of tailoring a resume
+	// without calling the AI.
+	EstimateTailoring(req EstimateTailoringRequest) *TailoringEstimate
+
 	// Close releases any resources held by the AI provider.
 	Close() error
 }
 
+// AIPriority orders AI requests when the provider is at its concurrency limit.
+type AIPriority int
+
+// AI request priorities. Interactive requests are served before background ones.
+const (
+	AIPriorityInteractive AIPriority = iota
+	AIPriorityBackground
+)
+
+// aiPriorityKey is the context key for the AI request priority.
+type aiPriorityKey struct{}
+
+// WithAIPriority returns a context whose AI requests use the given priority.
+func WithAIPriority(ctx context.Context, priority AIPriority) context.Context {
+	return context.WithValue(ctx, aiPriorityKey{}, priority)
+}
+
+// AIPriorityFromContext returns the AI request priority of a context,
+// defaulting to AIPriorityInteractive.
+func AIPriorityFromContext(ctx context.Context) AIPriority {
+	if priority, ok := ctx.Value(aiPriorityKey{}).(AIPriority); ok {
+		return priority
+	}
+	return AIPriorityInteractive
+}
+
+// freshAIReplyKey is the context key for skipping cached AI replies.
+type freshAIReplyKey struct{}
+
+// WithFreshAIReply returns a context whose AI requests skip replies cached
+// for identical requests, so that content generated again differs.
+func WithFreshAIReply(ctx context.Context) context.Context {
+	return context.WithValue(ctx, freshAIReplyKey{}, true)
+}
+
+// FreshAIReply reports whether the AI requests of a context skip cached
+// replies.
+func FreshAIReply(ctx context.Context) bool {
+	fresh, _ := ctx.Value(freshAIReplyKey{}).(bool)
+	return fresh
+}
+
+// aiSeedKey is the context key for the seed of AI requests.
+type aiSeedKey struct{}
+
+// WithAISeed returns a context whose AI requests ask the model for
+// reproducible output with seed, where the provider supports it.
+func WithAISeed(ctx context.Context, seed int64) context.Context {
+	return context.WithValue(ctx, aiSeedKey{}, seed)
+}
+
+// AISeed returns the seed of the AI requests of a context, if any.
+func AISeed(ctx context.Context) (int64, bool) {
+	seed, ok := ctx.Value(aiSeedKey{}).(int64)
+	return seed, ok
+}
+
+// aiRunRecorderKey is the context key for the recorder of AI calls.
+type aiRunRecorderKey struct{}
+
+// AIRunRecorder collects the settings of the AI calls made with a context,
+// merging calls made with the same settings. It is safe for concurrent use.
+type AIRunRecorder struct {
+	mu    sync.Mutex
+	calls []domain.AICallSettings
+}
+
+// WithAIRunRecorder returns a context whose AI calls are recorded by the
+// returned recorder.
+func WithAIRunRecorder(ctx context.Context) (context.Context, *AIRunRecorder) {
+	recorder := &AIRunRecorder{}
+	return context.WithValue(ctx, aiRunRecorderKey{}, recorder), recorder
+}
+
+// RecordAICall records an AI call made with ctx, when it has a recorder.
+// Providers call it once per call, leaving Count zero.
+func RecordAICall(ctx context.Context, call domain.AICallSettings) {
+	recorder, ok := ctx.Value(aiRunRecorderKey{}).(*AIRunRecorder)
+	if !ok {
+		return
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	for i := range recorder.calls {
+		recorded := &recorder.calls[i]
+		if recorded.Operation == call.Operation && recorded.PromptVersion == call.PromptVersion &&
+			recorded.Model == call.Model && recorded.Temperature == call.Temperature {
+			recorded.Count++
+			return
+		}
+	}
+	call.Count = 1
+	recorder.calls = append(recorder.calls, call)
+}
+
+// Calls returns the settings of the calls recorded so far.
+func (r *AIRunRecorder) Calls() []domain.AICallSettings {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.calls)
+}
+
 // AnalyzeJobRequest contains parameters for job analysis.
 type AnalyzeJobRequest struct {
 	// JobDescription is the parsed job description text.
@@ -66,6 +193,10 @@ type JobAnalysis struct {
 
 	// Summary is a brief summary of the job requirements.
 	Summary string
+
+	// Insights are the salary, benefits, remote policy and visa sponsorship
+	// details mentioned in the description; nil when none are.
+	Insights *domain.JobInsights
 }
 
 // SelectBulletsRequest contains parameters for bullet selection.
@@ -79,6 +210,10 @@ type SelectBulletsRequest struct {
 	// MaxBullets is the maximum number of bullets to select.
 	MaxBullets int
 
+	// HighlightedSkills are the skills the candidate wants to feature;
+	// bullets that show them are preferred.
+	HighlightedSkills []string
+
 	// TargetLanguage is the language for selection.
 	TargetLanguage string
 }
@@ -90,6 +225,10 @@ type BulletSelection struct {
 
 	// Reasoning explains why these bullets were selected.
 	Reasoning string
+
+	// BulletReasons holds a short reason per bullet ID for choosing or
+	// dropping it; bullets may be missing.
+	BulletReasons map[string]string
 }
 
 // TailorBulletRequest contains parameters for bullet tailoring.
@@ -103,8 +242,30 @@ type TailorBulletRequest struct {
 	// TargetLanguage is the output language.
 	TargetLanguage string
 
+	// SourceLanguage is the language of the user's profile. When set and
+	// different from TargetLanguage, the bullet is tailored in SourceLanguage
+	// and also translated to TargetLanguage.
+	SourceLanguage string
+
 	// Style is the writing style (e.g., "professional", "technical").
 	Style string
+
+	// WritingPreferences are what the user's feedback on earlier tailored
+	// bullets says about how they like bullets written; see
+	// domain.WritingPreferences.
+	WritingPreferences []string
+
+	// Instructions are the user's own instructions for this rewrite, such
+	// as "mention the on-call rotation"; empty for none.
+	Instructions string
+
+	// Voice is the tone and target reader to write for.
+	Voice domain.WritingVoice
+}
+
+// Translates reports whether the tailored bullet must also be translated.
+func (r TailorBulletRequest) Translates() bool {
+	return r.SourceLanguage != "" && r.SourceLanguage != r.TargetLanguage
 }
 
 // TailoredBulletResult contains the result of bullet tailoring.
@@ -112,9 +273,14 @@ type TailoredBulletResult struct {
 	// OriginalID is the ID of the original bullet.
 	OriginalID string
 
-	// TailoredContent is the rewritten bullet content.
+	// TailoredContent is the rewritten bullet content, in the source
+	// language when the request translates, else in the target language.
 	TailoredContent string
 
+	// TranslatedContent is TailoredContent in the target language; empty
+	// unless the request translates.
+	TranslatedContent string
+
 	// Keywords are the matched keywords from the job.
 	Keywords []string
 }
@@ -130,14 +296,28 @@ type GenerateSummaryRequest struct {
 	// SelectedBullets are the bullets selected for this resume.
 	SelectedBullets []domain.Bullet
 
+	// HighlightedSkills are the skills the candidate wants to feature.
+	HighlightedSkills []string
+
 	// TargetLanguage is the output language.
 	TargetLanguage string
+
+	// Instructions are the user's own instructions for the summary, such
+	// as "open with my management experience"; empty for none.
+	Instructions string
+
+	// Voice is the tone and target reader to write for.
+	Voice domain.WritingVoice
 }
 
 // SummaryResult contains the generated professional summary.
 type SummaryResult struct {
-	// Summary is the generated professional summary.
+	// Summary is the generated professional summary, the first variant.
 	Summary string
+
+	// Variants are the summaries written from each of domain.SummaryAngles,
+	// in that order. Nil when a single summary was generated.
+	Variants []domain.SummaryVariant
 }
 
 // ScoreMatchRequest contains parameters for match scoring.
@@ -152,6 +332,107 @@ type ScoreMatchRequest struct {
 	UserSkills []domain.Skill
 }
 
+// CritiqueResumeRequest contains parameters for a resume critique.
+type CritiqueResumeRequest struct {
+	// JobDescription is the job description the resume was tailored to.
+	JobDescription string
+
+	// JobTitle and CompanyName describe the position, when known.
+	JobTitle    string
+	CompanyName string
+
+	// Resume is the generated resume content to review.
+	Resume *domain.ResumeContent
+
+	// TargetLanguage is the language for the critique output.
+	TargetLanguage string
+}
+
+// GroupBulletsByThemeRequest contains parameters for grouping bullets by
+// skill theme.
+type GroupBulletsByThemeRequest struct {
+	// JobAnalysis is the analyzed job; themes favor its skills.
+	JobAnalysis *JobAnalysis
+
+	// Bullets are the tailored bullets to group.
+	Bullets []domain.TailoredBullet
+
+	// MaxThemes is the maximum number of themes.
+	MaxThemes int
+
+	// TargetLanguage is the language of the theme names.
+	TargetLanguage string
+}
+
+// ShortenTextsRequest contains resume texts to shorten, such as bullets and
+// the summary.
+type ShortenTextsRequest struct {
+	// Texts are the texts to shorten, each with its budget.
+	Texts []TextBudget
+
+	// Keywords are the job keywords to keep in the texts that have them.
+	Keywords []string
+
+	// Language is the language of the texts, which the rewrites keep.
+	Language string
+}
+
+// TextBudget is a text to shorten to at most MaxLength characters.
+type TextBudget struct {
+	ID        string
+	Text      string
+	MaxLength int
+}
+
+// ScoreBulletImpactRequest contains the bullets to score for impact.
+type ScoreBulletImpactRequest struct {
+	// Bullets are the bullets to score, each judged on its own.
+	Bullets []domain.Bullet
+}
+
+// EstimateTailoringRequest describes a tailoring run to estimate.
+type EstimateTailoringRequest struct {
+	// JobDescription is the job description text.
+	JobDescription string
+
+	// Bullets are the bullets the AI would select from.
+	Bullets []domain.Bullet
+
+	// MaxBullets is the number of bullets to select.
+	MaxBullets int
+
+	// Translate is true when tailored bullets are translated to the
+	// target language.
+	Translate bool
+}
+
+// TailoringEstimate is the approximate size and cost of a tailoring run,
+// assuming no cached replies or retries.
+type TailoringEstimate struct {
+	// Calls are the estimated AI calls, by operation, in tailoring order.
+	Calls []AICallEstimate
+
+	InputTokens  int
+	OutputTokens int
+
+	// CostUSD is the estimated cost in USD; nil when the price of a model
+	// is not known.
+	CostUSD *float64
+}
+
+// AICallEstimate is the estimated size and cost of the calls made for one
+// operation of a tailoring run.
+type AICallEstimate struct {
+	Operation    string
+	Model        string
+	Count        int
+	InputTokens  int
+	OutputTokens int
+
+	// CostUSD is nil when the price of the model is not known.
+	CostUSD *float64
+}
+
 // PDFEngine defines the interface for PDF generation.
 // Implementations should handle communication with Gotenberg.
 type PDFEngine interface {
@@ -179,6 +460,10 @@ type GeneratePDFRequest struct {
 	// CSS is optional custom CSS to apply.
 	CSS string
 
+	// Watermark is optional text stamped faintly across every page, e.g.
+	// "DRAFT". See WatermarkCSS.
+	Watermark string
+
 	// Options are PDF generation options.
 	Options PDFOptions
 }
@@ -205,6 +490,17 @@ type PDFOptions struct {
 
 	// Scale is the scale factor (0.1 to 2.0).
 	Scale float64
+
+	// MaxPages, when positive, keeps only the first pages of the PDF.
+	MaxPages int
+}
+
+// PageRanges returns the pages to print, e.g. "1-2", or "" for all of them.
+func (o PDFOptions) PageRanges() string {
+	if o.MaxPages < 1 {
+		return ""
+	}
+	return "1-" + strconv.Itoa(o.MaxPages)
 }
 
 // DefaultPDFOptions returns sensible defaults for PDF generation.
@@ -220,6 +516,33 @@ func DefaultPDFOptions() PDFOptions {
 	}
 }
 
+// WatermarkCSS returns CSS that stamps text diagonally across the middle of
+// every printed page, faint enough to keep the content readable.
+func WatermarkCSS(text string) string {
+	quoted := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\A `,
+		"<", `\3C `,
+	).Replace(text)
+
+	return `body::after {
+	content: "` + quoted + `";
+	position: fixed;
+	top: 50%;
+	left: 50%;
+	transform: translate(-50%, -50%) rotate(-45deg);
+	font-family: sans-serif;
+	font-size: 96pt;
+	font-weight: bold;
+	letter-spacing: 0.1em;
+	white-space: nowrap;
+	color: rgba(0, 0, 0, 0.08);
+	pointer-events: none;
+	z-index: 1000;
+}`
+}
+
 // PDFResult contains the generated PDF.
 type PDFResult struct {
 	// Content is the PDF file content.
@@ -247,6 +570,75 @@ type PDFTemplate struct {
 	PreviewURL string
 }
 
+// DefaultPDFTemplates returns the built-in resume templates shared by all PDF engines.
+func DefaultPDFTemplates() []PDFTemplate {
+	return []PDFTemplate{
+		{
+			Name:        "jake",
+			DisplayName: "Jake's Resume",
+			Description: "Industry gold standard for developer resumes. Single-page, dense, ATS-friendly format.",
+			PreviewURL:  "/templates/jake/preview.png",
+		},
+		{
+			Name:        "professional",
+			DisplayName: "Professional",
+			Description: "Clean, modern design suitable for most industries. Emphasizes readability and structure.",
+			PreviewURL:  "/templates/professional/preview.png",
+		},
+		{
+			Name:        "minimal",
+			DisplayName: "Minimal",
+			Description: "Simple, elegant layout with plenty of white space. Perfect for creative roles.",
+			PreviewURL:  "/templates/minimal/preview.png",
+		},
+		{
+			Name:        "technical",
+			DisplayName: "Technical",
+			Description: "Optimized for engineering and tech roles. Highlights skills and projects prominently.",
+			PreviewURL:  "/templates/technical/preview.png",
+		},
+		{
+			Name:        "executive",
+			DisplayName: "Executive",
+			Description: "Sophisticated design for senior leadership positions. Emphasizes achievements and impact.",
+			PreviewURL:  "/templates/executive/preview.png",
+		},
+		{
+			Name:        "academic",
+			DisplayName: "Academic",
+			Description: "Multi-page CV for research and academic positions: publications first, then grants, teaching and service.",
+			PreviewURL:  "/templates/academic/preview.png",
+		},
+		{
+			Name:        "functional",
+			DisplayName: "Functional",
+			Description: "Skills-first layout for career changers. Groups achievements under skill themes ahead of a brief employment history.",
+			PreviewURL:  "/templates/functional/preview.png",
+		},
+		{
+			Name:        "europass",
+			DisplayName: "Europass",
+			Description: "Official EU CV layout with localized section labels and CEFR language grid.",
+			PreviewURL:  "/templates/europass/preview.png",
+		},
+	}
+}
+
+// EmbeddingsProvider defines the interface for computing text embeddings.
+// Implementations may call a hosted API (OpenAI, Groq-compatible endpoints)
+// or a local model server.
+type EmbeddingsProvider interface {
+	// Embed returns one embedding vector per input text, in the same order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+
+	// Model returns the identifier of the embedding model in use.
+	// Embeddings from different models are not comparable.
+	Model() string
+
+	// Close releases any resources held by the provider.
+	Close() error
+}
+
 // JobParser defines the interface for parsing job descriptions from URLs.
 // Implementations should handle communication with Jina Reader API.
 type JobParser interface {
@@ -287,7 +679,8 @@ type FileStorage interface {
 	// Upload uploads a file and returns its URL.
 	Upload(ctx context.Context, req UploadRequest) (*UploadResult, error)
 
-	// Download downloads a file by its key.
+	// Download downloads a file by its key. Missing files return an error
+	// matching domain.ErrFileNotFound.
 	Download(ctx context.Context, key string) (io.ReadCloser, error)
 
 	// Delete removes a file.
@@ -296,6 +689,13 @@ type FileStorage interface {
 	// GetURL returns a URL for accessing a file.
 	GetURL(ctx context.Context, key string) (string, error)
 
+	// SignURL returns a URL for accessing a file without checking that it
+	// exists, so URLs can be issued for many files without a lookup each.
+	SignURL(key string) (string, error)
+
+	// List returns the files whose key starts with prefix.
+	List(ctx context.Context, prefix string) ([]FileInfo, error)
+
 	// Close releases any resources held by the storage.
 	Close() error
 }
@@ -326,3 +726,103 @@ type UploadResult struct {
 	// Size is the size in bytes.
 	Size int64
 }
+
+// FileInfo describes a stored file.
+type FileInfo struct {
+	// Key is the storage key.
+	Key string
+
+	// Size is the size in bytes.
+	Size int64
+
+	// UpdatedAt is when the file was last written.
+	UpdatedAt time.Time
+}
+
+// QRCodeEncoder renders short texts, such as URLs, as QR code images.
+type QRCodeEncoder interface {
+	// DataURI returns the QR code of text as an image data URI.
+	DataURI(text string) (string, error)
+}
+
+// AvatarFetcher defines the interface for downloading the profile pictures
+// of identity providers, so they can be served from file storage.
+type AvatarFetcher interface {
+	// FetchAvatar downloads a picture and returns it as a square JPEG
+	// thumbnail. URLs outside the allowed picture hosts return an error
+	// matching domain.ErrURLNotAllowed.
+	FetchAvatar(ctx context.Context, url string) ([]byte, error)
+}
+
+// VirusScanner defines the interface for scanning uploaded files for malware.
+type VirusScanner interface {
+	// Scan reads the content and reports whether it is infected.
+	Scan(ctx context.Context, content io.Reader) (*ScanResult, error)
+
+	// Close releases any resources held by the scanner.
+	Close() error
+}
+
+// ScanResult contains the outcome of a virus scan.
+type ScanResult struct {
+	Infected bool
+
+	// Signature names the malware found; empty when the file is clean.
+	Signature string
+}
+
+// EventPublisher defines the interface for delivering outbox events to an
+// integration, such as a webhook or a queue.
+type EventPublisher interface {
+	// Publish delivers an event. Delivery may be repeated after an error, so
+	// receivers must tolerate duplicates.
+	Publish(ctx context.Context, event domain.OutboxEvent) error
+}
+
+// ErrorReporter defines the interface for reporting unexpected failures,
+// such as recovered panics, to an error tracking service (e.g., Sentry).
+type ErrorReporter interface {
+	// Report sends an error event. It must return quickly, sending the event
+	// in the background if needed.
+	Report(ctx context.Context, event ErrorEvent)
+
+	// Close waits for pending events to be sent and releases any resources
+	// held by the reporter.
+	Close() error
+}
+
+// ErrorEvent describes an unexpected failure while serving a request.
+type ErrorEvent struct {
+	// Message describes the failure, e.g. the panic value or the error.
+	Message string
+
+	// Stack is the stack trace of the failing goroutine, if known.
+	Stack string
+
+	RequestID string
+	Method    string
+	URL       string
+
+	// UserID is the authenticated user, if any.
+	UserID string
+
+	// Tags identify what failed, e.g. "operation" and "resume_id".
+	Tags map[string]string
+
+	OccurredAt time.Time
+}
+
+// requestIDKey is the context key for the ID of the request being served.
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying the ID of the request being
+// served, so errors reported while serving it can name it.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID of a context, or "".
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
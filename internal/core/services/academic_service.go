@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// AcademicService handles the use cases of academic CV entries.
+type AcademicService struct {
+	academicRepo ports.AcademicEntryRepository
+}
+
+// NewAcademicService creates a new AcademicService with required dependencies.
+func NewAcademicService(academicRepo ports.AcademicEntryRepository) *AcademicService {
+	return &AcademicService{
+		academicRepo: academicRepo,
+	}
+}
+
+// CreateAcademicEntryRequest contains the parameters for creating an
+// academic entry.
+type CreateAcademicEntryRequest struct {
+	UserID       string
+	Section      string
+	Title        string
+	Organization *string
+	Authors      []string
+	Amount       *string
+	URL          *string
+	Description  *string
+	StartDate    *domain.Date
+	EndDate      *domain.Date
+	DisplayOrder int
+}
+
+// CreateAcademicEntry creates a new academic entry for a user.
+func (s *AcademicService) CreateAcademicEntry(ctx context.Context, req CreateAcademicEntryRequest) (*domain.AcademicEntry, error) {
+	entry := &domain.AcademicEntry{
+		UserID:       req.UserID,
+		Section:      domain.AcademicSection(req.Section),
+		Title:        req.Title,
+		Organization: optionalString(req.Organization),
+		Authors:      req.Authors,
+		Amount:       optionalString(req.Amount),
+		URL:          optionalString(req.URL),
+		Description:  optionalString(req.Description),
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		DisplayOrder: req.DisplayOrder,
+	}
+	if entry.Authors == nil {
+		entry.Authors = make([]string, 0)
+	}
+
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.academicRepo.Create(ctx, entry); err != nil {
+		return nil, fmt.Errorf("failed to create academic entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// GetAcademicEntry retrieves a user's academic entry by ID.
+func (s *AcademicService) GetAcademicEntry(ctx context.Context, entryID, userID string) (*domain.AcademicEntry, error) {
+	entry, err := s.academicRepo.GetByIDForUser(ctx, entryID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get academic entry: %w", err)
+	}
+	return entry, nil
+}
+
+// ListAcademicEntries lists a user's academic entries, optionally only those
+// of one section.
+func (s *AcademicService) ListAcademicEntries(ctx context.Context, userID, section string) ([]domain.AcademicEntry, error) {
+	if section != "" && !domain.AcademicSection(section).IsValid() {
+		v := &domain.ValidationErrors{}
+		v.AddFieldError("section", "must be 'publications', 'grants', 'teaching' or 'service'")
+		return nil, v
+	}
+
+	entries, err := s.academicRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list academic entries: %w", err)
+	}
+
+	if section == "" {
+		return entries, nil
+	}
+	filtered := make([]domain.AcademicEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Section == domain.AcademicSection(section) {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered, nil
+}
+
+// UpdateAcademicEntryRequest contains parameters for updating an academic
+// entry. Nil fields are left unchanged; empty strings clear optional ones.
+type UpdateAcademicEntryRequest struct {
+	EntryID      string
+	UserID       string
+	Section      *string
+	Title        *string
+	Organization *string
+	Authors      []string
+	Amount       *string
+	URL          *string
+	Description  *string
+	StartDate    *domain.Date
+	EndDate      *domain.Date
+	DisplayOrder *int
+}
+
+// UpdateAcademicEntry updates an existing academic entry.
+func (s *AcademicService) UpdateAcademicEntry(ctx context.Context, req UpdateAcademicEntryRequest) (*domain.AcademicEntry, error) {
+	entry, err := s.academicRepo.GetByIDForUser(ctx, req.EntryID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Section != nil {
+		entry.Section = domain.AcademicSection(*req.Section)
+	}
+	if req.Title != nil {
+		entry.Title = *req.Title
+	}
+	if req.Organization != nil {
+		entry.Organization = optionalString(req.Organization)
+	}
+	if req.Authors != nil {
+		entry.Authors = req.Authors
+	}
+	if req.Amount != nil {
+		entry.Amount = optionalString(req.Amount)
+	}
+	if req.URL != nil {
+		entry.URL = optionalString(req.URL)
+	}
+	if req.Description != nil {
+		entry.Description = optionalString(req.Description)
+	}
+	if req.StartDate != nil {
+		entry.StartDate = req.StartDate
+	}
+	if req.EndDate != nil {
+		entry.EndDate = req.EndDate
+	}
+	if req.DisplayOrder != nil {
+		entry.DisplayOrder = *req.DisplayOrder
+	}
+
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.academicRepo.Update(ctx, entry); err != nil {
+		return nil, fmt.Errorf("failed to update academic entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// DeleteAcademicEntry removes a user's academic entry.
+func (s *AcademicService) DeleteAcademicEntry(ctx context.Context, entryID, userID string) error {
+	if err := s.academicRepo.Delete(ctx, entryID, userID); err != nil {
+		return fmt.Errorf("failed to delete academic entry: %w", err)
+	}
+	return nil
+}
+
+// optionalString returns s, or nil when it is nil or blank.
+func optionalString(s *string) *string {
+	if s == nil || strings.TrimSpace(*s) == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// ActivityService keeps the activity logs of resumes.
+type ActivityService struct {
+	resumeRepo   ports.ResumeRepository
+	activityRepo ports.ResumeActivityRepository
+}
+
+// NewActivityService creates a new ActivityService with required dependencies.
+func NewActivityService(resumeRepo ports.ResumeRepository, activityRepo ports.ResumeActivityRepository) *ActivityService {
+	return &ActivityService{
+		resumeRepo:   resumeRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+// Record appends an entry to a resume's activity log.
+func (s *ActivityService) Record(ctx context.Context, activity *domain.ResumeActivity) error {
+	if err := s.activityRepo.Create(ctx, activity); err != nil {
+		return fmt.Errorf("failed to record resume activity: %w", err)
+	}
+	return nil
+}
+
+// ListActivity returns a resume's activity log, oldest first.
+func (s *ActivityService) ListActivity(ctx context.Context, resumeID, userID string) ([]domain.ResumeActivity, error) {
+	_, err := s.resumeRepo.GetByIDForUser(ctx, resumeID, userID)
+	if errors.Is(err, domain.ErrResumeNotFound) {
+		return nil, domain.ErrResumeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+
+	activities, err := s.activityRepo.ListByResumeID(ctx, resumeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resume activity: %w", err)
+	}
+	return activities, nil
+}
+
+// SetActivityLog enables recording notes, status changes, PDF generations
+// and tailoring runs in the activity logs of resumes.
+func (s *ResumeService) SetActivityLog(activity *ActivityService) {
+	s.activity = activity
+}
+
+// recordActivity appends entries to a resume's activity log. A failure to
+// record is ignored because the logged action itself already succeeded.
+func (s *ResumeService) recordActivity(ctx context.Context, activities ...*domain.ResumeActivity) {
+	recordActivity(ctx, s.activity, activities...)
+}
+
+// recordStatusChange logs a change of the resume's status from the given
+// status, if there was one.
+func (s *ResumeService) recordStatusChange(ctx context.Context, resume *domain.Resume, from domain.ResumeStatus) {
+	if resume.Status != from {
+		s.recordActivity(ctx, domain.NewResumeStatusChange(resume, from))
+	}
+}
+
+// SetActivityLog enables recording critiques in the activity logs of resumes.
+func (s *CritiqueService) SetActivityLog(activity *ActivityService) {
+	s.activity = activity
+}
+
+// recordActivity records activities, ignoring failures, when activity is set.
+func recordActivity(ctx context.Context, activity *ActivityService, activities ...*domain.ResumeActivity) {
+	if activity == nil {
+		return
+	}
+	for _, a := range activities {
+		_ = activity.Record(ctx, a)
+	}
+}
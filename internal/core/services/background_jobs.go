@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// JobHandler runs a background job. It must return soon after ctx is done.
+type JobHandler func(ctx context.Context, job domain.BackgroundJob) error
+
+// JobRunner runs the background jobs started by requests and drains them on
+// shutdown. Jobs that do not finish in time are cancelled and saved, then run
+// again by the handler of their kind when the next process calls Resume.
+type JobRunner struct {
+	// repo saves interrupted jobs; nil drops them.
+	repo     ports.BackgroundJobRepository
+	handlers map[domain.BackgroundJobKind]JobHandler
+
+	// ctx is the parent of the job contexts, cancelled when draining gives
+	// up on the running jobs.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu          sync.Mutex
+	draining    bool
+	interrupted []domain.BackgroundJob
+	running     sync.WaitGroup
+}
+
+// NewJobRunner creates a JobRunner that saves interrupted jobs to repo. A nil
+// repo drops them instead.
+func NewJobRunner(repo ports.BackgroundJobRepository) *JobRunner {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &JobRunner{
+		repo:     repo,
+		handlers: make(map[domain.BackgroundJobKind]JobHandler),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Handle sets the handler that resumes the saved jobs of a kind.
+func (r *JobRunner) Handle(kind domain.BackgroundJobKind, handler JobHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[kind] = handler
+}
+
+// Go runs job with run in the background. Once draining has started, the job
+// is saved for the next start instead.
+func (r *JobRunner) Go(ctx context.Context, job domain.BackgroundJob, run JobHandler) error {
+	r.mu.Lock()
+	if r.draining {
+		r.mu.Unlock()
+		return r.save(context.WithoutCancel(ctx), []domain.BackgroundJob{job})
+	}
+	r.running.Add(1)
+	r.mu.Unlock()
+
+	go func() {
+		defer r.running.Done()
+
+		if err := run(r.ctx, job); err != nil && r.ctx.Err() != nil {
+			r.mu.Lock()
+			r.interrupted = append(r.interrupted, job)
+			r.mu.Unlock()
+		}
+	}()
+	return nil
+}
+
+// Resume runs the jobs saved by an earlier Drain with the handlers of their
+// kinds. Saved jobs without a handler are dropped.
+func (r *JobRunner) Resume(ctx context.Context) (int, error) {
+	if r.repo == nil {
+		return 0, nil
+	}
+
+	jobs, err := r.repo.ListPending(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list saved jobs: %w", err)
+	}
+
+	resumed := 0
+	for _, job := range jobs {
+		if err := r.repo.Delete(ctx, job.ID); err != nil {
+			return resumed, fmt.Errorf("failed to delete saved job: %w", err)
+		}
+
+		r.mu.Lock()
+		handler := r.handlers[job.Kind]
+		r.mu.Unlock()
+		if handler == nil {
+			continue
+		}
+		if err := r.Go(ctx, job, handler); err != nil {
+			return resumed, err
+		}
+		resumed++
+	}
+	return resumed, nil
+}
+
+// Drain stops starting jobs and waits for the running ones until ctx is done.
+// It then cancels the jobs still running and saves the ones that did not
+// finish. It returns the number of saved jobs.
+func (r *JobRunner) Drain(ctx context.Context) (int, error) {
+	r.mu.Lock()
+	r.draining = true
+	r.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.running.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		r.cancel()
+		<-done
+	}
+	r.cancel()
+
+	r.mu.Lock()
+	interrupted := r.interrupted
+	r.interrupted = nil
+	r.mu.Unlock()
+
+	if len(interrupted) == 0 || r.repo == nil {
+		return 0, nil
+	}
+	if err := r.save(context.WithoutCancel(ctx), interrupted); err != nil {
+		return 0, err
+	}
+	return len(interrupted), nil
+}
+
+// save stores jobs for the next start, or drops them without a repository.
+func (r *JobRunner) save(ctx context.Context, jobs []domain.BackgroundJob) error {
+	if r.repo == nil {
+		return nil
+	}
+
+	var errs []error
+	for i := range jobs {
+		if err := r.repo.Save(ctx, &jobs[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to save %d interrupted jobs: %w", len(errs), errors.Join(errs...))
+	}
+	return nil
+}
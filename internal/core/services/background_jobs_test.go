@@ -0,0 +1,166 @@
+package services_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// memoryJobRepo is an in-memory ports.BackgroundJobRepository.
+type memoryJobRepo struct {
+	mu   sync.Mutex
+	jobs []domain.BackgroundJob
+}
+
+func (r *memoryJobRepo) Save(_ context.Context, job *domain.BackgroundJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs = append(r.jobs, *job)
+	return nil
+}
+
+func (r *memoryJobRepo) ListPending(context.Context) ([]domain.BackgroundJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]domain.BackgroundJob(nil), r.jobs...), nil
+}
+
+func (r *memoryJobRepo) Delete(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, job := range r.jobs {
+		if job.ID == id {
+			r.jobs = append(r.jobs[:i], r.jobs[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func cachePDFJob(id string) domain.BackgroundJob {
+	return domain.BackgroundJob{ID: id, Kind: domain.BackgroundJobCachePDF, UserID: "user-1", ResumeID: "resume-" + id}
+}
+
+// blockUntilCancelled is a job that runs until its context is cancelled.
+func blockUntilCancelled(ctx context.Context, _ domain.BackgroundJob) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestJobRunnerDrainWaitsForRunningJobs(t *testing.T) {
+	repo := &memoryJobRepo{}
+	runner := services.NewJobRunner(repo)
+
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	require.NoError(t, runner.Go(context.Background(), cachePDFJob("1"), func(context.Context, domain.BackgroundJob) error {
+		<-release
+		close(finished)
+		return nil
+	}))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+
+	saved, err := runner.Drain(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, saved)
+	assert.Empty(t, repo.jobs)
+
+	select {
+	case <-finished:
+	default:
+		t.Fatal("Drain returned before the job finished")
+	}
+}
+
+func TestJobRunnerDrainSavesInterruptedJobs(t *testing.T) {
+	repo := &memoryJobRepo{}
+	runner := services.NewJobRunner(repo)
+
+	require.NoError(t, runner.Go(context.Background(), cachePDFJob("1"), blockUntilCancelled))
+	require.NoError(t, runner.Go(context.Background(), cachePDFJob("2"), func(context.Context, domain.BackgroundJob) error {
+		return nil
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	saved, err := runner.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, saved)
+	require.Len(t, repo.jobs, 1)
+	assert.Equal(t, "1", repo.jobs[0].ID)
+}
+
+func TestJobRunnerSavesJobsStartedWhileDraining(t *testing.T) {
+	repo := &memoryJobRepo{}
+	runner := services.NewJobRunner(repo)
+
+	_, err := runner.Drain(context.Background())
+	require.NoError(t, err)
+
+	ran := false
+	require.NoError(t, runner.Go(context.Background(), cachePDFJob("1"), func(context.Context, domain.BackgroundJob) error {
+		ran = true
+		return nil
+	}))
+
+	assert.False(t, ran)
+	require.Len(t, repo.jobs, 1)
+	assert.Equal(t, "1", repo.jobs[0].ID)
+}
+
+func TestJobRunnerResume(t *testing.T) {
+	repo := &memoryJobRepo{jobs: []domain.BackgroundJob{
+		cachePDFJob("1"),
+		{ID: "2", Kind: "unknown"},
+		cachePDFJob("3"),
+	}}
+	runner := services.NewJobRunner(repo)
+
+	var mu sync.Mutex
+	var resumed []string
+	runner.Handle(domain.BackgroundJobCachePDF, func(_ context.Context, job domain.BackgroundJob) error {
+		mu.Lock()
+		defer mu.Unlock()
+		resumed = append(resumed, job.ID)
+		return nil
+	})
+
+	count, err := runner.Resume(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	_, err = runner.Drain(context.Background())
+	require.NoError(t, err)
+
+	// Saved jobs are deleted once resumed; those of unknown kinds are dropped.
+	assert.ElementsMatch(t, []string{"1", "3"}, resumed)
+	assert.Empty(t, repo.jobs)
+}
+
+func TestJobRunnerWithoutRepositoryDropsJobs(t *testing.T) {
+	runner := services.NewJobRunner(nil)
+	require.NoError(t, runner.Go(context.Background(), cachePDFJob("1"), blockUntilCancelled))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	saved, err := runner.Drain(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, saved)
+
+	resumed, err := runner.Resume(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, resumed)
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// BulletRanking configures the deterministic ranking of bullets before the
+// LLM selection step, which puts bullets from recent experiences and with
+// high impact scores first.
+type BulletRanking struct {
+	// HalfLifeYears is how many years after an experience ended its bullets
+	// weigh half as much as current ones. Zero or less disables the decay.
+	HalfLifeYears float64
+
+	// ImpactWeight, from 0 to 1, is how much the impact score counts: at 0 it
+	// is ignored, at 1 a bullet with impact 0 weighs nothing. Bullets never
+	// scored carry domain.DefaultImpactScore (50), so they rank between
+	// weak and strong scored bullets rather than last.
+	ImpactWeight float64
+}
+
+// DefaultBulletRanking returns the bullet ranking used unless configured.
+func DefaultBulletRanking() BulletRanking {
+	return BulletRanking{HalfLifeYears: 5, ImpactWeight: 0.5}
+}
+
+// SetBulletRanking replaces the bullet ranking settings.
+func (s *ResumeService) SetBulletRanking(ranking BulletRanking) {
+	s.ranking = ranking
+}
+
+// rank orders bullets by weight, heaviest first, keeping the original order
+// among bullets of equal weight. experiences holds the bullets' experiences;
+// bullets of unknown experiences are treated as current.
+func (r BulletRanking) rank(bullets []domain.Bullet, experiences []domain.Experience, now time.Time) []domain.Bullet {
+	ended := make(map[string]time.Time, len(experiences))
+	for _, exp := range experiences {
+		if !exp.IsCurrent && exp.EndDate != nil && !exp.EndDate.IsZero() {
+			ended[exp.ID] = exp.EndDate.Time
+		}
+	}
+
+	weights := make(map[string]float64, len(bullets))
+	for _, bullet := range bullets {
+		var age float64
+		if end, ok := ended[bullet.ExperienceID]; ok && end.Before(now) {
+			age = now.Sub(end).Hours() / (24 * 365.25)
+		}
+		weights[bullet.ID] = r.weight(age, bullet.ImpactScore.Int())
+	}
+
+	ranked := append([]domain.Bullet(nil), bullets...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return weights[ranked[i].ID] > weights[ranked[j].ID]
+	})
+	return ranked
+}
+
+// weight scores a bullet of an experience that ended age years ago.
+func (r BulletRanking) weight(age float64, impact int) float64 {
+	recency := 1.0
+	if r.HalfLifeYears > 0 {
+		recency = math.Pow(0.5, age/r.HalfLifeYears)
+	}
+
+	w := min(max(r.ImpactWeight, 0), 1)
+	return recency * (1 - w + w*float64(impact)/100)
+}
@@ -0,0 +1,97 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestBulletWeight(t *testing.T) {
+	tests := []struct {
+		name    string
+		ranking services.BulletRanking
+		age     float64
+		impact  int
+		want    float64
+	}{
+		{name: "current bullet of full impact", ranking: services.BulletRanking{HalfLifeYears: 5, ImpactWeight: 0.5}, age: 0, impact: 100, want: 1},
+		{name: "one half-life halves the weight", ranking: services.BulletRanking{HalfLifeYears: 5, ImpactWeight: 0.5}, age: 5, impact: 100, want: 0.5},
+		{name: "two half-lives quarter the weight", ranking: services.BulletRanking{HalfLifeYears: 5, ImpactWeight: 0.5}, age: 10, impact: 100, want: 0.25},
+		{name: "no half-life disables the decay", ranking: services.BulletRanking{HalfLifeYears: 0, ImpactWeight: 0.5}, age: 30, impact: 100, want: 1},
+		{name: "half impact weight", ranking: services.BulletRanking{HalfLifeYears: 5, ImpactWeight: 0.5}, age: 0, impact: 0, want: 0.5},
+		{name: "no impact weight ignores the score", ranking: services.BulletRanking{ImpactWeight: 0}, age: 0, impact: 0, want: 1},
+		{name: "full impact weight", ranking: services.BulletRanking{ImpactWeight: 1}, age: 0, impact: 30, want: 0.3},
+		{name: "impact weight clamped to 1", ranking: services.BulletRanking{ImpactWeight: 3}, age: 0, impact: 30, want: 0.3},
+		{name: "impact weight clamped to 0", ranking: services.BulletRanking{ImpactWeight: -2}, age: 0, impact: 30, want: 1},
+		{name: "unscored bullets are neutral", ranking: services.BulletRanking{ImpactWeight: 1}, age: 0, impact: domain.DefaultImpactScore().Int(), want: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, services.BulletWeight(tt.ranking, tt.age, tt.impact), 1e-9)
+		})
+	}
+}
+
+func TestRankBullets(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	ended := func(year int) *domain.Date {
+		date := domain.NewDate(year, time.June, 1)
+		return &date
+	}
+	experiences := []domain.Experience{
+		{ID: "current", IsCurrent: true},
+		{ID: "old", EndDate: ended(2016)},
+		{ID: "future", EndDate: ended(2030)},
+	}
+	bullet := func(id, experienceID string, impact int) domain.Bullet {
+		return domain.Bullet{ID: id, ExperienceID: experienceID, ImpactScore: domain.ImpactScore(impact)}
+	}
+	ids := func(bullets []domain.Bullet) []string {
+		out := make([]string, len(bullets))
+		for i, b := range bullets {
+			out[i] = b.ID
+		}
+		return out
+	}
+
+	t.Run("recent and impactful bullets first", func(t *testing.T) {
+		bullets := []domain.Bullet{
+			bullet("old-strong", "old", 100),
+			bullet("current-weak", "current", 20),
+			bullet("current-strong", "current", 90),
+		}
+
+		ranked := services.RankBullets(services.DefaultBulletRanking(), bullets, experiences, now)
+		assert.Equal(t, []string{"current-strong", "current-weak", "old-strong"}, ids(ranked))
+		assert.Equal(t, "old-strong", bullets[0].ID, "the input is left as it was")
+	})
+
+	t.Run("equal weights keep their order", func(t *testing.T) {
+		bullets := []domain.Bullet{
+			bullet("a", "current", 50),
+			bullet("b", "unknown", 50),
+			bullet("c", "future", 50),
+			bullet("d", "current", 50),
+		}
+
+		ranked := services.RankBullets(services.DefaultBulletRanking(), bullets, experiences, now)
+		assert.Equal(t, []string{"a", "b", "c", "d"}, ids(ranked))
+	})
+
+	t.Run("unscored bullets rank between weak and strong ones", func(t *testing.T) {
+		unscored := domain.Bullet{ID: "unscored", ExperienceID: "current", ImpactScore: domain.DefaultImpactScore()}
+		bullets := []domain.Bullet{
+			bullet("weak", "current", 10),
+			unscored,
+			bullet("strong", "current", 90),
+		}
+
+		ranked := services.RankBullets(services.DefaultBulletRanking(), bullets, experiences, now)
+		assert.Equal(t, []string{"strong", "unscored", "weak"}, ids(ranked))
+	})
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// profileVocabulary returns the vocabulary of everything the user wrote in
+// their profile, against which tailored bullets are checked for made-up
+// terms.
+func profileVocabulary(user *domain.User, bullets []domain.Bullet, experiences []domain.Experience, skills []domain.Skill, projects []domain.Project) *domain.ProfileVocabulary {
+	var texts []string
+	add := func(values ...*string) {
+		for _, value := range values {
+			if value != nil {
+				texts = append(texts, *value)
+			}
+		}
+	}
+
+	add(user.Headline, user.Summary, user.Location)
+	for _, bullet := range bullets {
+		texts = append(texts, bullet.Content)
+		texts = append(texts, bullet.Keywords...)
+	}
+	for _, exp := range experiences {
+		texts = append(texts, exp.Title, exp.Organization)
+		add(exp.Location, exp.Description)
+	}
+	for _, skill := range skills {
+		texts = append(texts, skill.Name)
+	}
+	for _, project := range projects {
+		texts = append(texts, project.Name)
+		texts = append(texts, project.TechStack...)
+		add(project.Description)
+		for _, bullet := range project.Bullets {
+			texts = append(texts, bullet.Content)
+		}
+	}
+	return domain.NewProfileVocabulary(texts...)
+}
+
+// ConfirmBulletsRequest contains the tailored bullets of a resume the user
+// checked.
+type ConfirmBulletsRequest struct {
+	ResumeID  string
+	UserID    string
+	BulletIDs []string
+}
+
+// ConfirmBullets clears the review flags of tailored bullets the user
+// checked, so the resume can be marked reviewed.
+func (s *ResumeService) ConfirmBullets(ctx context.Context, req ConfirmBulletsRequest) (*domain.Resume, error) {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+	if resume.GeneratedContent == nil {
+		return nil, domain.ErrResumeNotReady
+	}
+
+	if err := resume.GeneratedContent.ConfirmBullets(req.BulletIDs); err != nil {
+		return nil, err
+	}
+	if err := s.resumeRepo.Update(ctx, resume); err != nil {
+		return nil, fmt.Errorf("failed to update resume: %w", err)
+	}
+	return resume, nil
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// explainSelection records what tailoring did with each of the user's
+// bullets: all is every bullet, tailored those of experiences the resume
+// tailors, and candidates those sent to the AI, which chose selection.
+func explainSelection(all, tailored, candidates []domain.Bullet, selection *ports.BulletSelection) *domain.SelectionExplanation {
+	outcomes := make(map[string]domain.BulletOutcome, len(all))
+	for _, bullet := range all {
+		outcomes[bullet.ID] = domain.BulletOutcomeExcluded
+	}
+	for _, bullet := range tailored {
+		outcomes[bullet.ID] = domain.BulletOutcomeNotRanked
+	}
+	for _, bullet := range candidates {
+		outcomes[bullet.ID] = domain.BulletOutcomeDropped
+	}
+
+	explanation := &domain.SelectionExplanation{
+		Reasoning: selection.Reasoning,
+		Bullets:   make([]domain.BulletDecision, 0, len(all)),
+	}
+
+	// Selected bullets first, in the AI's order of relevance.
+	for _, id := range selection.SelectedBulletIDs {
+		if outcomes[id] != domain.BulletOutcomeDropped {
+			continue
+		}
+		outcomes[id] = domain.BulletOutcomeSelected
+		explanation.Bullets = append(explanation.Bullets, domain.BulletDecision{
+			BulletID: id,
+			Outcome:  domain.BulletOutcomeSelected,
+			Reason:   selection.BulletReasons[id],
+		})
+	}
+
+	for _, bullet := range all {
+		outcome := outcomes[bullet.ID]
+		if outcome == domain.BulletOutcomeSelected {
+			continue
+		}
+		decision := domain.BulletDecision{BulletID: bullet.ID, Outcome: outcome}
+		if outcome == domain.BulletOutcomeDropped {
+			decision.Reason = selection.BulletReasons[bullet.ID]
+		}
+		explanation.Bullets = append(explanation.Bullets, decision)
+	}
+
+	return explanation
+}
@@ -4,6 +4,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
@@ -14,6 +15,8 @@ type BulletService struct {
 	bulletRepo     ports.BulletRepository
 	experienceRepo ports.ExperienceRepository
 	aiProvider     ports.AIProvider
+	embeddings     ports.EmbeddingsProvider
+	embeddingRepo  ports.BulletEmbeddingRepository
 }
 
 // NewBulletService creates a new BulletService with required dependencies.
@@ -29,9 +32,16 @@ func NewBulletService(
 	}
 }
 
+// SetEmbeddings enables semantic matching in SearchBullets.
+func (s *BulletService) SetEmbeddings(provider ports.EmbeddingsProvider, repo ports.BulletEmbeddingRepository) {
+	s.embeddings = provider
+	s.embeddingRepo = repo
+}
+
 // CreateBulletRequest contains the parameters for creating a bullet.
 type CreateBulletRequest struct {
 	ExperienceID string
+	UserID       string
 	Content      string
 	ImpactScore  *int
 	Keywords     []string
@@ -40,8 +50,8 @@ type CreateBulletRequest struct {
 
 // CreateBullet creates a new bullet for an experience.
 func (s *BulletService) CreateBullet(ctx context.Context, req CreateBulletRequest) (*domain.Bullet, error) {
-	// Verify the experience exists.
-	_, err := s.experienceRepo.GetByID(ctx, req.ExperienceID)
+	// Verify the experience exists and belongs to the user.
+	_, err := s.experienceRepo.GetByIDForUser(ctx, req.ExperienceID, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("experience not found: %w", err)
 	}
@@ -77,9 +87,9 @@ func (s *BulletService) CreateBullet(ctx context.Context, req CreateBulletReques
 	return bullet, nil
 }
 
-// GetBullet retrieves a bullet by ID.
-func (s *BulletService) GetBullet(ctx context.Context, bulletID string) (*domain.Bullet, error) {
-	bullet, err := s.bulletRepo.GetByID(ctx, bulletID)
+// GetBullet retrieves a user's bullet by ID.
+func (s *BulletService) GetBullet(ctx context.Context, bulletID, userID string) (*domain.Bullet, error) {
+	bullet, err := s.bulletRepo.GetByIDForUser(ctx, bulletID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bullet: %w", err)
 	}
@@ -107,6 +117,7 @@ func (s *BulletService) ListBulletsByUser(ctx context.Context, userID string) ([
 // UpdateBulletRequest contains the parameters for updating a bullet.
 type UpdateBulletRequest struct {
 	BulletID     string
+	UserID       string
 	Content      *string
 	ImpactScore  *int
 	Keywords     []string
@@ -115,7 +126,7 @@ type UpdateBulletRequest struct {
 
 // UpdateBullet updates an existing bullet.
 func (s *BulletService) UpdateBullet(ctx context.Context, req UpdateBulletRequest) (*domain.Bullet, error) {
-	bullet, err := s.bulletRepo.GetByID(ctx, req.BulletID)
+	bullet, err := s.bulletRepo.GetByIDForUser(ctx, req.BulletID, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bullet: %w", err)
 	}
@@ -154,27 +165,82 @@ func (s *BulletService) UpdateBullet(ctx context.Context, req UpdateBulletReques
 	return bullet, nil
 }
 
-// DeleteBullet removes a bullet.
-func (s *BulletService) DeleteBullet(ctx context.Context, bulletID string) error {
-	if err := s.bulletRepo.Delete(ctx, bulletID); err != nil {
+// DeleteBullet removes a user's bullet.
+func (s *BulletService) DeleteBullet(ctx context.Context, bulletID, userID string) error {
+	if err := s.bulletRepo.Delete(ctx, bulletID, userID); err != nil {
 		return fmt.Errorf("failed to delete bullet: %w", err)
 	}
 	return nil
 }
 
+// Search result limits.
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
 // SearchBulletsRequest contains the parameters for searching bullets.
 type SearchBulletsRequest struct {
-	UserID   string
+	UserID string
+	// Query is free text matched semantically and split into keywords.
+	Query    string
 	Keywords []string
+	Limit    int
 }
 
-// SearchBullets searches bullets by keywords.
+// SearchBullets searches bullets by keywords and, when embeddings are
+// enabled, by semantic similarity to the query. Both rankings are fused so
+// bullets matching either way are returned.
 func (s *BulletService) SearchBullets(ctx context.Context, req SearchBulletsRequest) ([]domain.Bullet, error) {
-	bullets, err := s.bulletRepo.SearchByKeywords(ctx, req.UserID, req.Keywords)
+	if req.Limit <= 0 {
+		req.Limit = DefaultSearchLimit
+	}
+	if req.Limit > MaxSearchLimit {
+		req.Limit = MaxSearchLimit
+	}
+
+	keywords := append([]string{}, req.Keywords...)
+	keywords = append(keywords, strings.Fields(req.Query)...)
+
+	keywordResults, err := s.bulletRepo.SearchByKeywords(ctx, req.UserID, keywords)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search bullets: %w", err)
 	}
-	return bullets, nil
+
+	// Semantic matching is best effort: keyword results are still useful
+	// when the embeddings provider is unavailable.
+	semanticResults, err := s.searchSemantic(ctx, req.UserID, req.Query, req.Limit)
+	if err != nil {
+		semanticResults = nil
+	}
+
+	return fuseRankings(req.Limit, semanticResults, keywordResults), nil
+}
+
+// searchSemantic returns the bullets nearest to the query, refreshing stale
+// embeddings first. It returns nothing when embeddings are disabled.
+func (s *BulletService) searchSemantic(ctx context.Context, userID, query string, limit int) ([]domain.Bullet, error) {
+	if s.embeddings == nil || s.embeddingRepo == nil || strings.TrimSpace(query) == "" {
+		return nil, nil
+	}
+
+	bullets, err := s.bulletRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := syncBulletEmbeddings(ctx, s.embeddings, s.embeddingRepo, userID, bullets); err != nil {
+		return nil, err
+	}
+
+	vectors, err := s.embeddings.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+	if len(vectors) != 1 {
+		return nil, fmt.Errorf("embeddings provider returned %d vectors for 1 query", len(vectors))
+	}
+
+	return s.bulletRepo.SearchSemantic(ctx, userID, vectors[0], limit)
 }
 
 // GetHighImpactBulletsRequest contains parameters for getting high-impact bullets.
@@ -203,12 +269,13 @@ func (s *BulletService) GetHighImpactBullets(ctx context.Context, req GetHighImp
 // AnalyzeBulletImpactRequest contains parameters for analyzing bullet impact.
 type AnalyzeBulletImpactRequest struct {
 	BulletID       string
+	UserID         string
 	JobDescription string
 }
 
 // AnalyzeBulletImpact uses AI to analyze and score a bullet's impact.
 func (s *BulletService) AnalyzeBulletImpact(ctx context.Context, req AnalyzeBulletImpactRequest) (*domain.Bullet, error) {
-	bullet, err := s.bulletRepo.GetByID(ctx, req.BulletID)
+	bullet, err := s.bulletRepo.GetByIDForUser(ctx, req.BulletID, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bullet: %w", err)
 	}
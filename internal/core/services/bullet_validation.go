@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// maxTailorAttempts is how many times a bullet is rewritten before its
+// rewrites are given up for failing validation.
+const maxTailorAttempts = 2
+
+// tailorBullet rewrites a bullet and validates the rewrite, asking the AI
+// again when it fails. When every rewrite fails, it returns a nil result and
+// the issues of the last one, and the bullet keeps its original content.
+// validation counts the outcome.
+func (s *ResumeService) tailorBullet(ctx context.Context, req ports.TailorBulletRequest, validation *domain.TailorValidation) (*ports.TailoredBulletResult, []domain.BulletIssue, error) {
+	var issues []domain.BulletIssue
+	for attempt := range maxTailorAttempts {
+		result, err := s.aiProvider.TailorBullet(ctx, req)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rewrites := []string{result.TailoredContent}
+		if req.Translates() {
+			rewrites = append(rewrites, result.TranslatedContent)
+		}
+		issues = domain.ValidateTailoredBullet(req.Bullet.Content, result.Keywords, rewrites...)
+
+		if attempt == 0 {
+			validation.Checked++
+		}
+		if len(issues) == 0 {
+			return result, nil, nil
+		}
+		if attempt+1 < maxTailorAttempts {
+			validation.Retried++
+		}
+	}
+	validation.Rejected++
+	return nil, issues, nil
+}
+
+// conformBulletTenses puts the action verbs the bullets of experiences open
+// with in the past for ended roles and in the present for current ones,
+// since mixed tenses read as careless, and returns the number of bullets
+// changed. Both the tailored content and its translation are conformed.
+func conformBulletTenses(experiences []domain.TailoredExperience) int {
+	fixed := 0
+	for i := range experiences {
+		tense := domain.VerbTensePast
+		if experiences[i].IsCurrent {
+			tense = domain.VerbTensePresent
+		}
+
+		bullets := experiences[i].Bullets
+		for j := range bullets {
+			var changed, translated bool
+			bullets[j].TailoredContent, changed = domain.ConformVerbTense(bullets[j].TailoredContent, tense)
+			if bullets[j].TranslatedContent != "" {
+				bullets[j].TranslatedContent, translated = domain.ConformVerbTense(bullets[j].TranslatedContent, tense)
+			}
+			if changed || translated {
+				fixed++
+			}
+		}
+	}
+	return fixed
+}
+
+// recordRejectedRewrites adds the issues of the rejected rewrites of bullets
+// to their decisions.
+func recordRejectedRewrites(explanation *domain.SelectionExplanation, rejected map[string][]domain.BulletIssue) {
+	for i, decision := range explanation.Bullets {
+		if issues, ok := rejected[decision.BulletID]; ok {
+			explanation.Bullets[i].Rejected = issues
+		}
+	}
+}
+
+// generateSummary generates the professional summary, asking the AI again
+// when it fails domain.ScreenGeneratedText. Variants failing it are dropped,
+// and the AI is asked again only when every variant fails. When every
+// summary fails, the user's own profile summary is used, or the stage fails
+// with domain.ErrContentRejected when they have none.
+func (s *ResumeService) generateSummary(ctx context.Context, req ports.GenerateSummaryRequest) (*ports.SummaryResult, error) {
+	var issues []domain.BulletIssue
+	for range maxTailorAttempts {
+		result, err := s.aiProvider.GenerateSummary(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if len(result.Variants) == 0 {
+			issues = domain.ScreenGeneratedText(result.Summary)
+			if len(issues) == 0 {
+				return result, nil
+			}
+			continue
+		}
+
+		var variants []domain.SummaryVariant
+		for _, variant := range result.Variants {
+			if variantIssues := domain.ScreenGeneratedText(variant.Summary); len(variantIssues) > 0 {
+				issues = variantIssues
+				continue
+			}
+			variants = append(variants, variant)
+		}
+		if len(variants) > 0 {
+			return &ports.SummaryResult{Summary: variants[0].Summary, Variants: variants}, nil
+		}
+	}
+
+	if req.User.Summary != nil && strings.TrimSpace(*req.User.Summary) != "" {
+		return &ports.SummaryResult{Summary: *req.User.Summary}, nil
+	}
+	return nil, domain.NewDomainError(domain.ErrContentRejected, fmt.Sprintf("summary failed the content filter: %v", issues))
+}
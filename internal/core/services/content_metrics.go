@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/pkg/readability"
+)
+
+// metricsLineWidth is the width in points of a line of bullet text on a
+// Letter page with the default template's margins and bullet indent.
+const metricsLineWidth = (8.5 - 2*0.4 - 0.25) * 72
+
+// metricsCharWidth is the average width of a character, relative to the
+// font size, of the sans-serif fonts the templates use.
+const metricsCharWidth = 0.5
+
+// defaultMetricsFontSize is the font size in pt of the default template,
+// used when the user has not picked one.
+const defaultMetricsFontSize = 11
+
+// TextMetrics describe a piece of tailored content, to guide manual edits
+// toward a one-page resume.
+type TextMetrics struct {
+	// Characters is the length of the text in characters.
+	Characters int
+
+	// Lines estimates the lines the text takes on the rendered page.
+	Lines int
+
+	// ReadingEase is the Flesch reading ease of the text; higher is easier.
+	ReadingEase float64
+
+	// ActionVerb is the action verb the text opens with, or "" when it does
+	// not open with one.
+	ActionVerb string
+}
+
+// ContentMetrics are the TextMetrics of a resume's tailored content.
+type ContentMetrics struct {
+	// FontSize is the font size in pt lines were estimated at.
+	FontSize int
+
+	Summary TextMetrics
+
+	// Bullets are keyed by bullet ID.
+	Bullets map[string]TextMetrics
+}
+
+// MeasureContent returns the metrics of the summary and bullets of a
+// resume, as rendered in its target language, or nil when it has not been
+// tailored. Lines are estimated at the user's default font size.
+func (s *ResumeService) MeasureContent(ctx context.Context, resume *domain.Resume) *ContentMetrics {
+	content := resume.GeneratedContent
+	if content == nil {
+		return nil
+	}
+
+	fontSize := s.userPreferences(ctx, resume.UserID).DefaultFontSize
+	if fontSize == 0 {
+		fontSize = defaultMetricsFontSize
+	}
+
+	metrics := &ContentMetrics{
+		FontSize: fontSize,
+		Summary:  measureText(content.Summary, resume.TargetLanguage, fontSize),
+		Bullets:  make(map[string]TextMetrics),
+	}
+	for _, exp := range content.Experiences {
+		for _, bullet := range exp.Bullets {
+			metrics.Bullets[bullet.BulletID] = measureText(bullet.DisplayContent(), resume.TargetLanguage, fontSize)
+		}
+	}
+	return metrics
+}
+
+// measureText returns the metrics of text in lang at fontSize.
+func measureText(text, lang string, fontSize int) TextMetrics {
+	text = strings.TrimSpace(text)
+	return TextMetrics{
+		Characters:  utf8.RuneCountInString(text),
+		Lines:       estimateLines(text, int(metricsLineWidth/(float64(fontSize)*metricsCharWidth))),
+		ReadingEase: readability.ReadingEase(text, lang),
+		ActionVerb:  domain.LeadingActionVerb(text),
+	}
+}
+
+// estimateLines wraps text word by word at width characters per line and
+// returns the lines it takes. Words longer than a line are broken.
+func estimateLines(text string, width int) int {
+	lines, used := 0, 0
+	for _, word := range strings.Fields(text) {
+		length := utf8.RuneCountInString(word)
+		if used > 0 && used+1+length <= width {
+			used += 1 + length
+			continue
+		}
+		lines += (length + width - 1) / width
+		used = (length-1)%width + 1
+	}
+	return lines
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// CritiqueService handles AI resume critiques.
+type CritiqueService struct {
+	resumeRepo   ports.ResumeRepository
+	critiqueRepo ports.ResumeCritiqueRepository
+	aiProvider   ports.AIProvider
+
+	// Optional resume activity logs, see SetActivityLog.
+	activity *ActivityService
+
+	// Optional error reporting, see SetErrorReporter.
+	reporter ports.ErrorReporter
+}
+
+// NewCritiqueService creates a new CritiqueService with required dependencies.
+func NewCritiqueService(
+	resumeRepo ports.ResumeRepository,
+	critiqueRepo ports.ResumeCritiqueRepository,
+	aiProvider ports.AIProvider,
+) *CritiqueService {
+	return &CritiqueService{
+		resumeRepo:   resumeRepo,
+		critiqueRepo: critiqueRepo,
+		aiProvider:   aiProvider,
+	}
+}
+
+// CritiqueResumeRequest identifies the resume to critique.
+type CritiqueResumeRequest struct {
+	ResumeID string
+	UserID   string
+}
+
+// CritiqueResume has the AI review the resume's current generated content as
+// a hiring manager for the job, and stores the critique.
+func (s *CritiqueService) CritiqueResume(ctx context.Context, req CritiqueResumeRequest) (*domain.ResumeCritique, error) {
+	resume, err := s.ownedResume(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resume.GeneratedContent == nil {
+		return nil, domain.ErrResumeNotReady
+	}
+
+	aiReq := ports.CritiqueResumeRequest{
+		JobDescription: resume.JobDescription,
+		Resume:         resume.GeneratedContent,
+		TargetLanguage: resume.TargetLanguage,
+	}
+	if resume.JobTitle != nil {
+		aiReq.JobTitle = *resume.JobTitle
+	}
+	if resume.CompanyName != nil {
+		aiReq.CompanyName = *resume.CompanyName
+	}
+
+	ctx, aiRun := startAIRun(ctx, nil)
+	critique, err := s.aiProvider.CritiqueResume(ctx, aiReq)
+	if err != nil {
+		err = fmt.Errorf("failed to critique resume: %w", err)
+		reportError(ctx, s.reporter, "critique resume", err, resume.UserID, resume.ID)
+		return nil, err
+	}
+
+	critique.ResumeID = resume.ID
+	critique.UserID = resume.UserID
+	critique.ContentHash = domain.ResumeContentHash(resume.GeneratedContent)
+
+	if err := s.critiqueRepo.Create(ctx, critique); err != nil {
+		err = fmt.Errorf("failed to save critique: %w", err)
+		reportError(ctx, s.reporter, "critique resume", err, resume.UserID, resume.ID)
+		return nil, err
+	}
+	recordActivity(ctx, s.activity, domain.NewAIRunEvent(resume, domain.AIRunCritique, aiRun()))
+
+	return critique, nil
+}
+
+// ListCritiques returns a resume's critiques, newest first.
+func (s *CritiqueService) ListCritiques(ctx context.Context, resumeID, userID string) ([]domain.ResumeCritique, error) {
+	if _, err := s.ownedResume(ctx, resumeID, userID); err != nil {
+		return nil, err
+	}
+
+	critiques, err := s.critiqueRepo.ListByResumeID(ctx, resumeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list critiques: %w", err)
+	}
+	return critiques, nil
+}
+
+// ownedResume loads a resume, reporting resumes of other users as not found.
+func (s *CritiqueService) ownedResume(ctx context.Context, resumeID, userID string) (*domain.Resume, error) {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, resumeID, userID)
+	if errors.Is(err, domain.ErrResumeNotFound) {
+		return nil, domain.ErrResumeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+	return resume, nil
+}
@@ -30,6 +30,7 @@ type CreateEducationRequest struct {
 	Location     *string
 	StartDate    *domain.Date
 	EndDate      *domain.Date
+	IsExpected   bool
 	GPA          *string
 	Honors       []string
 	DisplayOrder int
@@ -53,11 +54,11 @@ func (s *EducationService) CreateEducation(ctx context.Context, req CreateEducat
 	}
 
 	if req.StartDate != nil || req.EndDate != nil {
-		if err := education.SetDates(req.StartDate, req.EndDate); err != nil {
-			return nil, err
-		}
+		education.SetDates(req.StartDate, req.EndDate)
 	}
 
+	education.IsExpected = req.IsExpected
+
 	if req.GPA != nil {
 		education.SetGPA(*req.GPA)
 	}
@@ -81,9 +82,9 @@ func (s *EducationService) CreateEducation(ctx context.Context, req CreateEducat
 	return education, nil
 }
 
-// GetEducation retrieves an education entry by ID.
-func (s *EducationService) GetEducation(ctx context.Context, educationID string) (*domain.Education, error) {
-	education, err := s.educationRepo.GetByID(ctx, educationID)
+// GetEducation retrieves a user's education entry by ID.
+func (s *EducationService) GetEducation(ctx context.Context, educationID, userID string) (*domain.Education, error) {
+	education, err := s.educationRepo.GetByIDForUser(ctx, educationID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get education: %w", err)
 	}
@@ -107,12 +108,14 @@ func (s *EducationService) ListEducation(ctx context.Context, req ListEducationR
 // UpdateEducationRequest contains parameters for updating an education entry.
 type UpdateEducationRequest struct {
 	EducationID  string
+	UserID       string
 	Institution  *string
 	Degree       *string
 	FieldOfStudy *string
 	Location     *string
 	StartDate    *domain.Date
 	EndDate      *domain.Date
+	IsExpected   *bool
 	GPA          *string
 	Honors       []string
 	DisplayOrder *int
@@ -121,7 +124,7 @@ type UpdateEducationRequest struct {
 // UpdateEducation updates an existing education entry.
 func (s *EducationService) UpdateEducation(ctx context.Context, req UpdateEducationRequest) (*domain.Education, error) {
 	// Get existing.
-	education, err := s.educationRepo.GetByID(ctx, req.EducationID)
+	education, err := s.educationRepo.GetByIDForUser(ctx, req.EducationID, req.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -154,9 +157,11 @@ func (s *EducationService) UpdateEducation(ctx context.Context, req UpdateEducat
 			endDate = req.EndDate
 		}
 
-		if err := education.SetDates(startDate, endDate); err != nil {
-			return nil, err
-		}
+		education.SetDates(startDate, endDate)
+	}
+
+	if req.IsExpected != nil {
+		education.IsExpected = *req.IsExpected
 	}
 
 	if req.GPA != nil {
@@ -184,9 +189,9 @@ func (s *EducationService) UpdateEducation(ctx context.Context, req UpdateEducat
 	return education, nil
 }
 
-// DeleteEducation removes an education entry.
-func (s *EducationService) DeleteEducation(ctx context.Context, educationID string) error {
-	if err := s.educationRepo.Delete(ctx, educationID); err != nil {
+// DeleteEducation removes a user's education entry.
+func (s *EducationService) DeleteEducation(ctx context.Context, educationID, userID string) error {
+	if err := s.educationRepo.Delete(ctx, educationID, userID); err != nil {
 		return fmt.Errorf("failed to delete education: %w", err)
 	}
 	return nil
@@ -194,12 +199,13 @@ func (s *EducationService) DeleteEducation(ctx context.Context, educationID stri
 
 // UpdateEducationOrderRequest contains parameters for updating education display order.
 type UpdateEducationOrderRequest struct {
+	UserID string
 	Orders []ports.DisplayOrderUpdate
 }
 
-// UpdateEducationOrder updates the display order of education entries.
+// UpdateEducationOrder updates the display order of a user's education entries.
 func (s *EducationService) UpdateEducationOrder(ctx context.Context, req UpdateEducationOrderRequest) error {
-	if err := s.educationRepo.UpdateDisplayOrder(ctx, req.Orders); err != nil {
+	if err := s.educationRepo.UpdateDisplayOrder(ctx, req.UserID, req.Orders); err != nil {
 		return fmt.Errorf("failed to update education order: %w", err)
 	}
 	return nil
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// expectedErrors are the errors caused by the request or by a known outage
+// rather than by a bug, which are not worth reporting.
+var expectedErrors = []error{
+	context.Canceled,
+	domain.ErrValidation,
+	domain.ErrResumeNotFound,
+	domain.ErrUserNotFound,
+	domain.ErrResumeNotReady,
+	domain.ErrNoBulletsAvailable,
+	domain.ErrQuotaExceeded,
+	domain.ErrUpstreamUnavailable,
+	domain.ErrUnsupportedExportFormat,
+	domain.ErrTailorTimeout,
+}
+
+// SetErrorReporter enables reporting the unexpected errors of tailoring
+// resumes and generating their PDFs.
+func (s *ResumeService) SetErrorReporter(reporter ports.ErrorReporter) {
+	s.reporter = reporter
+}
+
+// SetErrorReporter enables reporting the unexpected errors of critiques.
+func (s *CritiqueService) SetErrorReporter(reporter ports.ErrorReporter) {
+	s.reporter = reporter
+}
+
+// reportError reports err, tagged with the operation and the user and resume
+// concerned, unless reporter is nil or the error is expected.
+func reportError(ctx context.Context, reporter ports.ErrorReporter, operation string, err error, userID, resumeID string) {
+	if reporter == nil || err == nil || isExpectedError(err) {
+		return
+	}
+
+	tags := map[string]string{"operation": operation}
+	if resumeID != "" {
+		tags["resume_id"] = resumeID
+	}
+	reporter.Report(context.WithoutCancel(ctx), ports.ErrorEvent{
+		Message:    err.Error(),
+		RequestID:  ports.RequestIDFromContext(ctx),
+		UserID:     userID,
+		Tags:       tags,
+		OccurredAt: time.Now().UTC(),
+	})
+}
+
+// isExpectedError reports whether err is one of the expected errors.
+func isExpectedError(err error) bool {
+	var validation *domain.ValidationErrors
+	if errors.As(err, &validation) {
+		return true
+	}
+	for _, expected := range expectedErrors {
+		if errors.Is(err, expected) {
+			return true
+		}
+	}
+	return false
+}
@@ -28,17 +28,18 @@ func NewExperienceService(
 
 // CreateExperienceRequest contains the parameters for creating an experience.
 type CreateExperienceRequest struct {
-	UserID       string
-	Type         string
-	Title        string
-	Organization string
-	Location     *string
-	StartDate    string
-	EndDate      *string
-	IsCurrent    bool
-	Description  *string
-	URL          *string
-	DisplayOrder int
+	UserID               string
+	Type                 string
+	Title                string
+	Organization         string
+	Location             *string
+	StartDate            string
+	EndDate              *string
+	IsCurrent            bool
+	Description          *string
+	URL                  *string
+	DisplayOrder         int
+	ExcludeFromTailoring bool
 }
 
 // CreateExperience creates a new experience entry.
@@ -52,7 +53,7 @@ func (s *ExperienceService) CreateExperience(ctx context.Context, req CreateExpe
 	// Parse start date.
 	startDate, err := domain.ParseDate(req.StartDate)
 	if err != nil {
-		return nil, fmt.Errorf("invalid start date: %w", err)
+		return nil, invalidDateError("start_date", err)
 	}
 
 	// Create experience.
@@ -66,18 +67,17 @@ func (s *ExperienceService) CreateExperience(ctx context.Context, req CreateExpe
 	experience.Description = req.Description
 	experience.URL = req.URL
 	experience.DisplayOrder = req.DisplayOrder
+	experience.ExcludeFromTailoring = req.ExcludeFromTailoring
 
-	if req.IsCurrent {
-		experience.MarkAsCurrent()
-	} else if req.EndDate != nil && *req.EndDate != "" {
+	if req.EndDate != nil && *req.EndDate != "" {
 		endDate, err := domain.ParseDate(*req.EndDate)
 		if err != nil {
-			return nil, fmt.Errorf("invalid end date: %w", err)
-		}
-		if err := experience.SetEndDate(&endDate); err != nil {
-			return nil, err
+			return nil, invalidDateError("end_date", err)
 		}
+		experience.SetEndDate(&endDate)
 	}
+	// An end date sent along with is_current is reported by Validate.
+	experience.IsCurrent = req.IsCurrent
 
 	// Validate.
 	if err := experience.Validate(); err != nil {
@@ -92,18 +92,18 @@ func (s *ExperienceService) CreateExperience(ctx context.Context, req CreateExpe
 	return experience, nil
 }
 
-// GetExperience retrieves an experience by ID.
-func (s *ExperienceService) GetExperience(ctx context.Context, experienceID string) (*domain.Experience, error) {
-	experience, err := s.experienceRepo.GetByID(ctx, experienceID)
+// GetExperience retrieves a user's experience by ID.
+func (s *ExperienceService) GetExperience(ctx context.Context, experienceID, userID string) (*domain.Experience, error) {
+	experience, err := s.experienceRepo.GetByIDForUser(ctx, experienceID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get experience: %w", err)
 	}
 	return experience, nil
 }
 
-// GetExperienceWithBullets retrieves an experience with all its bullets.
-func (s *ExperienceService) GetExperienceWithBullets(ctx context.Context, experienceID string) (*domain.Experience, error) {
-	experience, err := s.experienceRepo.GetByIDWithBullets(ctx, experienceID)
+// GetExperienceWithBullets retrieves a user's experience with all its bullets.
+func (s *ExperienceService) GetExperienceWithBullets(ctx context.Context, experienceID, userID string) (*domain.Experience, error) {
+	experience, err := s.experienceRepo.GetByIDWithBulletsForUser(ctx, experienceID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get experience with bullets: %w", err)
 	}
@@ -161,22 +161,24 @@ func (s *ExperienceService) ListExperiences(ctx context.Context, req ListExperie
 
 // UpdateExperienceRequest contains the parameters for updating an experience.
 type UpdateExperienceRequest struct {
-	ExperienceID string
-	Type         *string
-	Title        *string
-	Organization *string
-	Location     *string
-	StartDate    *string
-	EndDate      *string
-	IsCurrent    *bool
-	Description  *string
-	URL          *string
-	DisplayOrder *int
+	ExperienceID         string
+	UserID               string
+	Type                 *string
+	Title                *string
+	Organization         *string
+	Location             *string
+	StartDate            *string
+	EndDate              *string
+	IsCurrent            *bool
+	Description          *string
+	URL                  *string
+	DisplayOrder         *int
+	ExcludeFromTailoring *bool
 }
 
 // UpdateExperience updates an existing experience.
 func (s *ExperienceService) UpdateExperience(ctx context.Context, req UpdateExperienceRequest) (*domain.Experience, error) {
-	experience, err := s.experienceRepo.GetByID(ctx, req.ExperienceID)
+	experience, err := s.experienceRepo.GetByIDForUser(ctx, req.ExperienceID, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get experience: %w", err)
 	}
@@ -209,24 +211,29 @@ func (s *ExperienceService) UpdateExperience(ctx context.Context, req UpdateExpe
 	if req.StartDate != nil {
 		startDate, err := domain.ParseDate(*req.StartDate)
 		if err != nil {
-			return nil, fmt.Errorf("invalid start date: %w", err)
+			return nil, invalidDateError("start_date", err)
 		}
 		experience.StartDate = startDate
 	}
 
-	if req.IsCurrent != nil && *req.IsCurrent {
-		experience.MarkAsCurrent()
-	} else if req.EndDate != nil {
+	if req.EndDate != nil {
 		if *req.EndDate == "" {
 			experience.EndDate = nil
 		} else {
 			endDate, err := domain.ParseDate(*req.EndDate)
 			if err != nil {
-				return nil, fmt.Errorf("invalid end date: %w", err)
-			}
-			if err := experience.SetEndDate(&endDate); err != nil {
-				return nil, err
+				return nil, invalidDateError("end_date", err)
 			}
+			experience.SetEndDate(&endDate)
+		}
+	}
+
+	if req.IsCurrent != nil {
+		if *req.IsCurrent && (req.EndDate == nil || *req.EndDate == "") {
+			experience.MarkAsCurrent()
+		} else {
+			// An end date sent along with is_current is reported by Validate.
+			experience.IsCurrent = *req.IsCurrent
 		}
 	}
 
@@ -250,6 +257,10 @@ func (s *ExperienceService) UpdateExperience(ctx context.Context, req UpdateExpe
 		experience.DisplayOrder = *req.DisplayOrder
 	}
 
+	if req.ExcludeFromTailoring != nil {
+		experience.ExcludeFromTailoring = *req.ExcludeFromTailoring
+	}
+
 	// Validate.
 	if err := experience.Validate(); err != nil {
 		return nil, err
@@ -263,9 +274,9 @@ func (s *ExperienceService) UpdateExperience(ctx context.Context, req UpdateExpe
 	return experience, nil
 }
 
-// DeleteExperience removes an experience and all its bullets.
-func (s *ExperienceService) DeleteExperience(ctx context.Context, experienceID string) error {
-	if err := s.experienceRepo.Delete(ctx, experienceID); err != nil {
+// DeleteExperience removes a user's experience and all its bullets.
+func (s *ExperienceService) DeleteExperience(ctx context.Context, experienceID, userID string) error {
+	if err := s.experienceRepo.Delete(ctx, experienceID, userID); err != nil {
 		return fmt.Errorf("failed to delete experience: %w", err)
 	}
 	return nil
@@ -273,13 +284,22 @@ func (s *ExperienceService) DeleteExperience(ctx context.Context, experienceID s
 
 // ReorderExperiencesRequest contains the new order for experiences.
 type ReorderExperiencesRequest struct {
+	UserID string
 	Orders []ports.DisplayOrderUpdate
 }
 
-// ReorderExperiences updates the display order of multiple experiences.
+// ReorderExperiences updates the display order of multiple experiences of a
+// user.
 func (s *ExperienceService) ReorderExperiences(ctx context.Context, req ReorderExperiencesRequest) error {
-	if err := s.experienceRepo.UpdateDisplayOrder(ctx, req.Orders); err != nil {
+	if err := s.experienceRepo.UpdateDisplayOrder(ctx, req.UserID, req.Orders); err != nil {
 		return fmt.Errorf("failed to reorder experiences: %w", err)
 	}
 	return nil
 }
+
+// invalidDateError reports an unparsable date as a validation error on field.
+func invalidDateError(field string, err error) error {
+	v := &domain.ValidationErrors{}
+	v.Add(domain.NewFieldError(err, field, "must be a date in YYYY-MM-DD or YYYY-MM format"))
+	return v
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// ResumePDFOptions exposes resumePDFOptions to the snapshot tests.
+var ResumePDFOptions = resumePDFOptions
+
+// RemapArchiveIDs exposes remapArchiveIDs to the backup tests.
+var RemapArchiveIDs = remapArchiveIDs
+
+// PlanProfileBundle runs planBundle for the profile bundle tests, returning
+// the IDs of the records of bundle that update the user's.
+func PlanProfileBundle(userID string, current, bundle *ProfileBundle) (map[string]bool, error) {
+	plan, err := planBundle(userID, current, bundle)
+	if err != nil {
+		return nil, err
+	}
+	return plan.exists, nil
+}
+
+// EstimateFit exposes estimateFit to the fit check tests.
+var EstimateFit = estimateFit
+
+// PlanTrim exposes planTrim to the fit tests.
+var PlanTrim = planTrim
+
+// ApplyShortenedTexts exposes applyShortenedTexts to the fit tests.
+var ApplyShortenedTexts = applyShortenedTexts
+
+// RenderEuropassXML exposes renderEuropassXML to the export snapshot tests.
+var RenderEuropassXML = renderEuropassXML
+
+// EscapeLaTeX exposes escapeLaTeX to the LaTeX export tests.
+var EscapeLaTeX = escapeLaTeX
+
+// LaTeXHref exposes latexHref to the LaTeX export tests.
+var LaTeXHref = latexHref
+
+// LaTeXMarkdownBold exposes latexMarkdownBold to the LaTeX export tests.
+var LaTeXMarkdownBold = latexMarkdownBold
+
+// PDFCacheKey exposes pdfCacheKey to the density tests.
+var PDFCacheKey = pdfCacheKey
+
+// TailorStage exposes tailorStage to the tailoring budget tests.
+var TailorStage = tailorStage
+
+// TailorStageError exposes tailorStageError to the tailoring budget tests.
+var TailorStageError = tailorStageError
+
+// RankBullets exposes BulletRanking.rank to the ranking tests.
+func RankBullets(r BulletRanking, bullets []domain.Bullet, experiences []domain.Experience, now time.Time) []domain.Bullet {
+	return r.rank(bullets, experiences, now)
+}
+
+// BulletWeight exposes BulletRanking.weight to the ranking tests.
+func BulletWeight(r BulletRanking, age float64, impact int) float64 {
+	return r.weight(age, impact)
+}
+
+// ValidatePreviewRequest exposes validatePreviewRequest to the preview tests.
+var ValidatePreviewRequest = validatePreviewRequest
+
+// ApplyPreviewEdits exposes applyPreviewEdits to the preview tests.
+var ApplyPreviewEdits = applyPreviewEdits
+
+// PDFURLKey exposes pdfURLKey to the PDF URL tests.
+var PDFURLKey = pdfURLKey
+
+// OutboxBackoff exposes outboxBackoff to the outbox relay tests.
+var OutboxBackoff = outboxBackoff
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// feedbackHistorySize is how much of a user's recent bullet feedback is
+// summed up into writing preferences when tailoring.
+const feedbackHistorySize = 50
+
+// FeedbackService handles user feedback on tailored bullets.
+type FeedbackService struct {
+	resumeRepo   ports.ResumeRepository
+	feedbackRepo ports.BulletFeedbackRepository
+}
+
+// NewFeedbackService creates a new FeedbackService with required dependencies.
+func NewFeedbackService(
+	resumeRepo ports.ResumeRepository,
+	feedbackRepo ports.BulletFeedbackRepository,
+) *FeedbackService {
+	return &FeedbackService{
+		resumeRepo:   resumeRepo,
+		feedbackRepo: feedbackRepo,
+	}
+}
+
+// BulletFeedbackInput is a user's verdict on one tailored bullet.
+type BulletFeedbackInput struct {
+	BulletID      string
+	Verdict       domain.FeedbackVerdict
+	EditedContent *string
+	Comment       *string
+}
+
+// SubmitFeedbackRequest contains a user's feedback on a resume's bullets.
+type SubmitFeedbackRequest struct {
+	ResumeID string
+	UserID   string
+	Bullets  []BulletFeedbackInput
+}
+
+// SubmitFeedback stores feedback on tailored bullets of a resume, replacing
+// earlier feedback on the same bullets, and returns all of the resume's
+// feedback. Every bullet must be part of the resume's generated content.
+func (s *FeedbackService) SubmitFeedback(ctx context.Context, req SubmitFeedbackRequest) ([]domain.BulletFeedback, error) {
+	resume, err := s.ownedResume(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if resume.GeneratedContent == nil {
+		return nil, domain.ErrResumeNotReady
+	}
+
+	tailored := make(map[string]domain.TailoredBullet)
+	for _, exp := range resume.GeneratedContent.Experiences {
+		for _, bullet := range exp.Bullets {
+			tailored[bullet.BulletID] = bullet
+		}
+	}
+
+	v := &domain.ValidationErrors{}
+	if len(req.Bullets) == 0 {
+		v.AddFieldError("bullets", "at least one bullet is required")
+	}
+	feedback := make([]domain.BulletFeedback, 0, len(req.Bullets))
+	seen := make(map[string]bool, len(req.Bullets))
+	for i, in := range req.Bullets {
+		field := fmt.Sprintf("bullets[%d]", i)
+		bullet, ok := tailored[in.BulletID]
+		switch {
+		case !ok:
+			v.AddFieldError(field+".bullet_id", fmt.Sprintf("bullet %q is not on the resume", in.BulletID))
+			continue
+		case seen[in.BulletID]:
+			v.AddFieldError(field+".bullet_id", fmt.Sprintf("bullet %q is listed twice", in.BulletID))
+			continue
+		}
+		seen[in.BulletID] = true
+
+		f := domain.BulletFeedback{
+			UserID:          resume.UserID,
+			ResumeID:        resume.ID,
+			BulletID:        in.BulletID,
+			Verdict:         in.Verdict,
+			TailoredContent: bullet.DisplayContent(),
+			EditedContent:   trimmedOrNil(in.EditedContent),
+			Comment:         trimmedOrNil(in.Comment),
+		}
+		if err := f.Validate(); err != nil {
+			var fieldErrs *domain.ValidationErrors
+			if errors.As(err, &fieldErrs) {
+				for _, fe := range fieldErrs.Errors {
+					v.AddFieldError(field+"."+fe.Field, fe.Message)
+				}
+				continue
+			}
+			return nil, err
+		}
+		feedback = append(feedback, f)
+	}
+	if err := v.ToError(); err != nil {
+		return nil, err
+	}
+
+	for i := range feedback {
+		if err := s.feedbackRepo.Upsert(ctx, &feedback[i]); err != nil {
+			return nil, fmt.Errorf("failed to save bullet feedback: %w", err)
+		}
+	}
+
+	all, err := s.feedbackRepo.ListByResumeID(ctx, resume.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bullet feedback: %w", err)
+	}
+	return all, nil
+}
+
+// ownedResume loads a resume, reporting resumes of other users as not found.
+func (s *FeedbackService) ownedResume(ctx context.Context, resumeID, userID string) (*domain.Resume, error) {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, resumeID, userID)
+	if errors.Is(err, domain.ErrResumeNotFound) {
+		return nil, domain.ErrResumeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+	return resume, nil
+}
+
+// SetFeedback enables writing preferences learned from the user's feedback
+// on earlier tailored bullets in tailoring prompts.
+func (s *ResumeService) SetFeedback(feedbackRepo ports.BulletFeedbackRepository) {
+	s.feedbackRepo = feedbackRepo
+}
+
+// writingPreferences sums up the user's recent bullet feedback. Feedback is
+// optional: without a repository, or when it fails, there are none.
+func (s *ResumeService) writingPreferences(ctx context.Context, userID string) []string {
+	if s.feedbackRepo == nil {
+		return nil
+	}
+	feedback, err := s.feedbackRepo.ListRecentByUserID(ctx, userID, feedbackHistorySize)
+	if err != nil {
+		return nil
+	}
+	return domain.WritingPreferences(feedback)
+}
+
+// trimmedOrNil trims s, returning nil when it is nil or blank.
+func trimmedOrNil(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	t := strings.TrimSpace(*s)
+	if t == "" {
+		return nil
+	}
+	return &t
+}
@@ -3,8 +3,12 @@ package services
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 )
 
 // Locale represents a supported language/region combination.
@@ -30,6 +34,7 @@ const (
 	KeyTechnicalSkills     TranslationKey = "technical_skills"
 	KeyLanguages           TranslationKey = "languages"
 	KeyPresent             TranslationKey = "present"
+	KeyExpected            TranslationKey = "expected"
 	KeyGPA                 TranslationKey = "gpa"
 	KeyGrade               TranslationKey = "grade"
 	KeyNative              TranslationKey = "native"
@@ -37,89 +42,206 @@ const (
 	KeyAdvanced            TranslationKey = "advanced"
 	KeyIntermediate        TranslationKey = "intermediate"
 	KeyBasic               TranslationKey = "basic"
+
+	// Europass section labels.
+	KeyPersonalInformation  TranslationKey = "personal_information"
+	KeyWorkExperience       TranslationKey = "work_experience"
+	KeyEducationAndTraining TranslationKey = "education_and_training"
+	KeyPersonalSkills       TranslationKey = "personal_skills"
+	KeyMotherTongue         TranslationKey = "mother_tongue"
+	KeyOtherLanguages       TranslationKey = "other_languages"
+	KeyDigitalSkills        TranslationKey = "digital_skills"
+
+	// Academic CV section labels.
+	KeyPublications TranslationKey = "publications"
+	KeyGrants       TranslationKey = "grants"
+	KeyTeaching     TranslationKey = "teaching"
+	KeyService      TranslationKey = "service"
+
+	// Functional resume section labels.
+	KeySkillHighlights   TranslationKey = "skill_highlights"
+	KeyOtherAchievements TranslationKey = "other_achievements"
+	KeyEmploymentHistory TranslationKey = "employment_history"
+
+	// KeyDraft is the watermark of unreviewed PDFs.
+	KeyDraft TranslationKey = "draft"
+
+	// Abbreviated units of skill experience, e.g. "Go (3 yrs)".
+	KeyYearShort  TranslationKey = "year_short"
+	KeyYearsShort TranslationKey = "years_short"
 )
 
 // translations contains all localized strings.
 var translations = map[Locale]map[TranslationKey]string{
 	LocaleEnUS: {
-		KeyProfessionalSummary: "Professional Summary",
-		KeyEducation:           "Education",
-		KeyExperience:          "Experience",
-		KeyProjects:            "Projects",
-		KeyTechnicalSkills:     "Technical Skills",
-		KeyLanguages:           "Languages",
-		KeyPresent:             "Present",
-		KeyGPA:                 "GPA",
-		KeyGrade:               "Grade",
-		KeyNative:              "Native",
-		KeyFluent:              "Fluent",
-		KeyAdvanced:            "Advanced",
-		KeyIntermediate:        "Intermediate",
-		KeyBasic:               "Basic",
+		KeyProfessionalSummary:  "Professional Summary",
+		KeyEducation:            "Education",
+		KeyExperience:           "Experience",
+		KeyProjects:             "Projects",
+		KeyTechnicalSkills:      "Technical Skills",
+		KeyLanguages:            "Languages",
+		KeyPresent:              "Present",
+		KeyExpected:             "Expected",
+		KeyGPA:                  "GPA",
+		KeyGrade:                "Grade",
+		KeyNative:               "Native",
+		KeyFluent:               "Fluent",
+		KeyAdvanced:             "Advanced",
+		KeyIntermediate:         "Intermediate",
+		KeyBasic:                "Basic",
+		KeyPersonalInformation:  "Personal information",
+		KeyWorkExperience:       "Work experience",
+		KeyEducationAndTraining: "Education and training",
+		KeyPersonalSkills:       "Personal skills",
+		KeyMotherTongue:         "Mother tongue(s)",
+		KeyOtherLanguages:       "Other language(s)",
+		KeyDigitalSkills:        "Digital skills",
+		KeyPublications:         "Publications",
+		KeyGrants:               "Grants and Funding",
+		KeyTeaching:             "Teaching",
+		KeyService:              "Service",
+		KeySkillHighlights:      "Relevant Skills",
+		KeyOtherAchievements:    "Other Achievements",
+		KeyEmploymentHistory:    "Employment History",
+		KeyDraft:                "DRAFT",
+		KeyYearShort:            "yr",
+		KeyYearsShort:           "yrs",
 	},
 	LocalePtBR: {
-		KeyProfessionalSummary: "Resumo Profissional",
-		KeyEducation:           "Formação Acadêmica",
-		KeyExperience:          "Experiência Profissional",
-		KeyProjects:            "Projetos",
-		KeyTechnicalSkills:     "Habilidades Técnicas",
-		KeyLanguages:           "Idiomas",
-		KeyPresent:             "Atual",
-		KeyGPA:                 "CR",
-		KeyGrade:               "Média",
-		KeyNative:              "Nativo",
-		KeyFluent:              "Fluente",
-		KeyAdvanced:            "Avançado",
-		KeyIntermediate:        "Intermediário",
-		KeyBasic:               "Básico",
+		KeyProfessionalSummary:  "Resumo Profissional",
+		KeyEducation:            "Formação Acadêmica",
+		KeyExperience:           "Experiência Profissional",
+		KeyProjects:             "Projetos",
+		KeyTechnicalSkills:      "Habilidades Técnicas",
+		KeyLanguages:            "Idiomas",
+		KeyPresent:              "Atual",
+		KeyExpected:             "Previsão",
+		KeyGPA:                  "CR",
+		KeyGrade:                "Média",
+		KeyNative:               "Nativo",
+		KeyFluent:               "Fluente",
+		KeyAdvanced:             "Avançado",
+		KeyIntermediate:         "Intermediário",
+		KeyBasic:                "Básico",
+		KeyPersonalInformation:  "Informação pessoal",
+		KeyWorkExperience:       "Experiência profissional",
+		KeyEducationAndTraining: "Educação e formação",
+		KeyPersonalSkills:       "Competências pessoais",
+		KeyMotherTongue:         "Língua(s) materna(s)",
+		KeyOtherLanguages:       "Outra(s) língua(s)",
+		KeyDigitalSkills:        "Competências digitais",
+		KeyPublications:         "Publicações",
+		KeyGrants:               "Financiamentos",
+		KeyTeaching:             "Docência",
+		KeyService:              "Serviço Acadêmico",
+		KeySkillHighlights:      "Competências Relevantes",
+		KeyOtherAchievements:    "Outras Realizações",
+		KeyEmploymentHistory:    "Histórico Profissional",
+		KeyDraft:                "RASCUNHO",
+		KeyYearShort:            "ano",
+		KeyYearsShort:           "anos",
 	},
 	LocaleEsES: {
-		KeyProfessionalSummary: "Resumen Profesional",
-		KeyEducation:           "Formación Académica",
-		KeyExperience:          "Experiencia Profesional",
-		KeyProjects:            "Proyectos",
-		KeyTechnicalSkills:     "Habilidades Técnicas",
-		KeyLanguages:           "Idiomas",
-		KeyPresent:             "Actual",
-		KeyGPA:                 "Promedio",
-		KeyGrade:               "Nota",
-		KeyNative:              "Nativo",
-		KeyFluent:              "Fluido",
-		KeyAdvanced:            "Avanzado",
-		KeyIntermediate:        "Intermedio",
-		KeyBasic:               "Básico",
+		KeyProfessionalSummary:  "Resumen Profesional",
+		KeyEducation:            "Formación Académica",
+		KeyExperience:           "Experiencia Profesional",
+		KeyProjects:             "Proyectos",
+		KeyTechnicalSkills:      "Habilidades Técnicas",
+		KeyLanguages:            "Idiomas",
+		KeyPresent:              "Actual",
+		KeyExpected:             "Previsto",
+		KeyGPA:                  "Promedio",
+		KeyGrade:                "Nota",
+		KeyNative:               "Nativo",
+		KeyFluent:               "Fluido",
+		KeyAdvanced:             "Avanzado",
+		KeyIntermediate:         "Intermedio",
+		KeyBasic:                "Básico",
+		KeyPersonalInformation:  "Información personal",
+		KeyWorkExperience:       "Experiencia laboral",
+		KeyEducationAndTraining: "Educación y formación",
+		KeyPersonalSkills:       "Competencias personales",
+		KeyMotherTongue:         "Lengua(s) materna(s)",
+		KeyOtherLanguages:       "Otro(s) idioma(s)",
+		KeyDigitalSkills:        "Competencias digitales",
+		KeyPublications:         "Publicaciones",
+		KeyGrants:               "Financiación",
+		KeyTeaching:             "Docencia",
+		KeyService:              "Servicio Académico",
+		KeySkillHighlights:      "Competencias Relevantes",
+		KeyOtherAchievements:    "Otros Logros",
+		KeyEmploymentHistory:    "Historial Laboral",
+		KeyDraft:                "BORRADOR",
+		KeyYearShort:            "año",
+		KeyYearsShort:           "años",
 	},
 	LocaleFrFR: {
-		KeyProfessionalSummary: "Résumé Professionnel",
-		KeyEducation:           "Formation",
-		KeyExperience:          "Expérience Professionnelle",
-		KeyProjects:            "Projets",
-		KeyTechnicalSkills:     "Compétences Techniques",
-		KeyLanguages:           "Langues",
-		KeyPresent:             "Présent",
-		KeyGPA:                 "Moyenne",
-		KeyGrade:               "Note",
-		KeyNative:              "Natif",
-		KeyFluent:              "Courant",
-		KeyAdvanced:            "Avancé",
-		KeyIntermediate:        "Intermédiaire",
-		KeyBasic:               "Basique",
+		KeyProfessionalSummary:  "Résumé Professionnel",
+		KeyEducation:            "Formation",
+		KeyExperience:           "Expérience Professionnelle",
+		KeyProjects:             "Projets",
+		KeyTechnicalSkills:      "Compétences Techniques",
+		KeyLanguages:            "Langues",
+		KeyPresent:              "Présent",
+		KeyExpected:             "Prévu",
+		KeyGPA:                  "Moyenne",
+		KeyGrade:                "Note",
+		KeyNative:               "Natif",
+		KeyFluent:               "Courant",
+		KeyAdvanced:             "Avancé",
+		KeyIntermediate:         "Intermédiaire",
+		KeyBasic:                "Basique",
+		KeyPersonalInformation:  "Informations personnelles",
+		KeyWorkExperience:       "Expérience professionnelle",
+		KeyEducationAndTraining: "Éducation et formation",
+		KeyPersonalSkills:       "Compétences personnelles",
+		KeyMotherTongue:         "Langue(s) maternelle(s)",
+		KeyOtherLanguages:       "Autre(s) langue(s)",
+		KeyDigitalSkills:        "Compétences numériques",
+		KeyPublications:         "Publications",
+		KeyGrants:               "Financements",
+		KeyTeaching:             "Enseignement",
+		KeyService:              "Services à la communauté",
+		KeySkillHighlights:      "Compétences clés",
+		KeyOtherAchievements:    "Autres réalisations",
+		KeyEmploymentHistory:    "Parcours professionnel",
+		KeyDraft:                "BROUILLON",
+		KeyYearShort:            "an",
+		KeyYearsShort:           "ans",
 	},
 	LocaleDeDE: {
-		KeyProfessionalSummary: "Berufsprofil",
-		KeyEducation:           "Ausbildung",
-		KeyExperience:          "Berufserfahrung",
-		KeyProjects:            "Projekte",
-		KeyTechnicalSkills:     "Technische Fähigkeiten",
-		KeyLanguages:           "Sprachen",
-		KeyPresent:             "Aktuell",
-		KeyGPA:                 "Notendurchschnitt",
-		KeyGrade:               "Note",
-		KeyNative:              "Muttersprache",
-		KeyFluent:              "Fließend",
-		KeyAdvanced:            "Fortgeschritten",
-		KeyIntermediate:        "Mittelstufe",
-		KeyBasic:               "Grundkenntnisse",
+		KeyProfessionalSummary:  "Berufsprofil",
+		KeyEducation:            "Ausbildung",
+		KeyExperience:           "Berufserfahrung",
+		KeyProjects:             "Projekte",
+		KeyTechnicalSkills:      "Technische Fähigkeiten",
+		KeyLanguages:            "Sprachen",
+		KeyPresent:              "Aktuell",
+		KeyExpected:             "Voraussichtlich",
+		KeyGPA:                  "Notendurchschnitt",
+		KeyGrade:                "Note",
+		KeyNative:               "Muttersprache",
+		KeyFluent:               "Fließend",
+		KeyAdvanced:             "Fortgeschritten",
+		KeyIntermediate:         "Mittelstufe",
+		KeyBasic:                "Grundkenntnisse",
+		KeyPersonalInformation:  "Angaben zur Person",
+		KeyWorkExperience:       "Berufserfahrung",
+		KeyEducationAndTraining: "Schul- und Berufsbildung",
+		KeyPersonalSkills:       "Persönliche Fähigkeiten",
+		KeyMotherTongue:         "Muttersprache(n)",
+		KeyOtherLanguages:       "Weitere Sprache(n)",
+		KeyDigitalSkills:        "Digitale Kompetenz",
+		KeyPublications:         "Publikationen",
+		KeyGrants:               "Drittmittel",
+		KeyTeaching:             "Lehre",
+		KeyService:              "Akademische Selbstverwaltung",
+		KeySkillHighlights:      "Relevante Kompetenzen",
+		KeyOtherAchievements:    "Weitere Erfolge",
+		KeyEmploymentHistory:    "Beruflicher Werdegang",
+		KeyDraft:                "ENTWURF",
+		KeyYearShort:            "J.",
+		KeyYearsShort:           "J.",
 	},
 }
 
@@ -134,7 +256,8 @@ var monthNames = map[Locale][]string{
 
 // I18n provides internationalization utilities for resume generation.
 type I18n struct {
-	locale Locale
+	locale     Locale
+	dateFormat domain.DateFormat
 }
 
 // NewI18n creates a new I18n instance for the specified locale.
@@ -165,6 +288,12 @@ func ParseLocale(s string) Locale {
 	}
 }
 
+// Region returns the ISO 3166 code of the locale's region, such as "BR".
+func (l Locale) Region() string {
+	_, region, _ := strings.Cut(string(l), "-")
+	return region
+}
+
 // T returns the translated string for the given key.
 func (i *I18n) T(key TranslationKey) string {
 	if dict, ok := translations[i.locale]; ok {
@@ -187,7 +316,16 @@ func (i *I18n) Locale() Locale {
 	return i.locale
 }
 
-// FormatDate formats a date according to the locale.
+// WithDateFormat returns a copy that formats dates in the given style.
+// An empty format or domain.DateFormatLocale keeps the locale's style.
+func (i *I18n) WithDateFormat(format domain.DateFormat) *I18n {
+	clone := *i
+	clone.dateFormat = format
+	return &clone
+}
+
+// FormatDate formats a date according to the date format, or the locale
+// when none is set.
 // For en-US: "Jan 2024"
 // For pt-BR: "01/2024"
 // For es-ES: "Ene 2024"
@@ -195,18 +333,32 @@ func (i *I18n) FormatDate(t time.Time) string {
 	month := int(t.Month())
 	year := t.Year()
 
+	switch i.dateFormat {
+	case domain.DateFormatNumeric:
+		return fmt.Sprintf("%02d/%d", month, year)
+	case domain.DateFormatISO:
+		return fmt.Sprintf("%d-%02d", year, month)
+	case domain.DateFormatMonthYear:
+		return fmt.Sprintf("%s %d", i.monthName(month), year)
+	}
+
 	switch i.locale {
 	case LocalePtBR:
 		// Portuguese uses numeric format: MM/YYYY
 		return fmt.Sprintf("%02d/%d", month, year)
 	default:
 		// Other locales use abbreviated month name
-		months := monthNames[i.locale]
-		if months == nil {
-			months = monthNames[LocaleEnUS]
-		}
-		return fmt.Sprintf("%s %d", months[month-1], year)
+		return fmt.Sprintf("%s %d", i.monthName(month), year)
+	}
+}
+
+// monthName returns the localized abbreviated name of a month (1-12).
+func (i *I18n) monthName(month int) string {
+	months := monthNames[i.locale]
+	if months == nil {
+		months = monthNames[LocaleEnUS]
 	}
+	return months[month-1]
 }
 
 // FormatDateString parses a date string and formats it according to the locale.
@@ -281,6 +433,22 @@ func (i *I18n) FormatProficiencyLevel(level string) string {
 	}
 }
 
+// FormatSkillYears formats years of experience with a skill, e.g. "3 yrs"
+// or "1,5 anos", rounded to half years.
+func (i *I18n) FormatSkillYears(years float64) string {
+	years = math.Round(years*2) / 2
+	number := strconv.FormatFloat(years, 'f', -1, 64)
+	if i.locale != LocaleEnUS {
+		number = strings.Replace(number, ".", ",", 1)
+	}
+
+	unit := i.T(KeyYearsShort)
+	if years == 1 {
+		unit = i.T(KeyYearShort)
+	}
+	return number + " " + unit
+}
+
 // GetLanguageName returns the display name for a locale.
 func GetLanguageName(locale Locale) string {
 	switch locale {
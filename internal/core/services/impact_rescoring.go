@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// ImpactRescoringConfig tunes an ImpactRescoringService.
+type ImpactRescoringConfig struct {
+	// BatchSize is the number of bullets scored per AI call.
+	BatchSize int
+
+	// MaxBullets is the most bullets scored per run; the rest are scored
+	// on the next runs, least recently updated first.
+	MaxBullets int
+
+	// Pause is the wait between AI calls, so that a run leaves the AI
+	// quota to the users.
+	Pause time.Duration
+}
+
+// DefaultImpactRescoringConfig returns the rescoring settings used unless
+// configured.
+func DefaultImpactRescoringConfig() ImpactRescoringConfig {
+	return ImpactRescoringConfig{
+		BatchSize:  20,
+		MaxBullets: 1000,
+		Pause:      2 * time.Second,
+	}
+}
+
+// ImpactRescoringService recomputes the impact scores of the bullets added or
+// edited since they were last scored, so that GetHighImpactBullets and the
+// bullet ranking stay meaningful without users scoring bullets one by one.
+// Scores given by users are kept until the bullet content changes.
+type ImpactRescoringService struct {
+	bulletRepo ports.BulletRepository
+	aiProvider ports.AIProvider
+	config     ImpactRescoringConfig
+}
+
+// NewImpactRescoringService creates a new ImpactRescoringService. Zero config
+// fields take their default values.
+func NewImpactRescoringService(bulletRepo ports.BulletRepository, aiProvider ports.AIProvider, config ImpactRescoringConfig) *ImpactRescoringService {
+	defaults := DefaultImpactRescoringConfig()
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.MaxBullets <= 0 {
+		config.MaxBullets = defaults.MaxBullets
+	}
+	if config.Pause < 0 {
+		config.Pause = defaults.Pause
+	}
+	return &ImpactRescoringService{bulletRepo: bulletRepo, aiProvider: aiProvider, config: config}
+}
+
+// RescoreResult summarizes a rescoring run.
+type RescoreResult struct {
+	// Scored is the number of bullets whose score was saved.
+	Scored int
+
+	// Failed is the number of bullets left unscored because their AI call
+	// failed or the reply left them out; they are retried on the next run.
+	Failed int
+}
+
+// Rescore scores the bullets whose impact score is stale, in batches of
+// BatchSize with Pause between AI calls. A failed batch is counted and
+// skipped, so that one bad reply does not hold back the others.
+func (s *ImpactRescoringService) Rescore(ctx context.Context) (*RescoreResult, error) {
+	bullets, err := s.bulletRepo.ListForRescoring(ctx, s.config.MaxBullets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bullets for rescoring: %w", err)
+	}
+
+	ctx = ports.WithAIPriority(ctx, ports.AIPriorityBackground)
+	result := &RescoreResult{}
+	for start := 0; start < len(bullets); start += s.config.BatchSize {
+		if start > 0 {
+			select {
+			case <-ctx.Done():
+				return result, ctx.Err()
+			case <-time.After(s.config.Pause):
+			}
+		}
+
+		batch := bullets[start:min(start+s.config.BatchSize, len(bullets))]
+		scores, err := s.aiProvider.ScoreBulletImpact(ctx, ports.ScoreBulletImpactRequest{Bullets: batch})
+		if err != nil {
+			if ctx.Err() != nil {
+				return result, ctx.Err()
+			}
+			result.Failed += len(batch)
+			continue
+		}
+
+		scored := make([]domain.Bullet, 0, len(scores))
+		for _, bullet := range batch {
+			score, ok := scores[bullet.ID]
+			if !ok || bullet.SetImpactScore(score) != nil {
+				result.Failed++
+				continue
+			}
+			scored = append(scored, bullet)
+		}
+
+		updated, err := s.bulletRepo.UpdateImpactScores(ctx, scored)
+		if err != nil {
+			return result, fmt.Errorf("failed to save impact scores: %w", err)
+		}
+		result.Scored += updated
+	}
+
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/signedurl"
+)
+
+// ImpersonationTokenPrefix starts impersonation tokens, which tells them
+// apart from the tokens of the identity provider.
+const ImpersonationTokenPrefix = "imp."
+
+// impersonationSignedPrefix is prepended to the signed payload, so that the
+// signatures of tokens and of other signed values never match.
+const impersonationSignedPrefix = "impersonation/"
+
+// ImpersonationService lets admins act as a user for support debugging,
+// through short-lived tokens. Starting an impersonation and every request
+// made with its token are recorded in the audit log.
+type ImpersonationService struct {
+	userRepo ports.UserRepository
+	audit    ports.AuditRepository
+	signer   *signedurl.Signer
+	ttl      time.Duration
+}
+
+// NewImpersonationService creates a new ImpersonationService with required
+// dependencies. Tokens are valid for ttl.
+func NewImpersonationService(userRepo ports.UserRepository, audit ports.AuditRepository, signer *signedurl.Signer, ttl time.Duration) *ImpersonationService {
+	return &ImpersonationService{
+		userRepo: userRepo,
+		audit:    audit,
+		signer:   signer,
+		ttl:      ttl,
+	}
+}
+
+// ImpersonationGrant is a started impersonation and its token.
+type ImpersonationGrant struct {
+	Impersonation *domain.Impersonation
+	Token         string
+}
+
+// Impersonate starts an admin's impersonation of a user with the given
+// scope, read when empty. No token is issued unless the start is recorded
+// in the audit log.
+func (s *ImpersonationService) Impersonate(ctx context.Context, adminID, userID string, scope domain.ImpersonationScope) (*ImpersonationGrant, error) {
+	if scope == "" {
+		scope = domain.ImpersonationScopeRead
+	}
+	if !scope.IsValid() {
+		return nil, domain.ErrInvalidImpersonationScope
+	}
+	if adminID == userID {
+		return nil, domain.ErrCannotImpersonateSelf
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	impersonation := &domain.Impersonation{
+		ID:      uuid.New().String(),
+		AdminID: adminID,
+		UserID:  userID,
+		Scope:   scope,
+	}
+	payload := strings.Join([]string{impersonation.ID, adminID, userID, string(scope)}, ":")
+	query := s.signer.Sign(impersonationSignedPrefix+payload, s.ttl)
+	expires, _ := strconv.ParseInt(query.Get(signedurl.ExpiresParam), 10, 64)
+	impersonation.ExpiresAt = time.Unix(expires, 0).UTC()
+
+	err := s.audit.Record(ctx, &domain.AuditEvent{
+		Action:    domain.AuditImpersonationStarted,
+		ActorID:   adminID,
+		SubjectID: userID,
+		SessionID: impersonation.ID,
+		Detail:    fmt.Sprintf("scope %s until %s", scope, impersonation.ExpiresAt.Format(time.RFC3339)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record impersonation: %w", err)
+	}
+
+	token := ImpersonationTokenPrefix + strings.Join([]string{
+		base64.RawURLEncoding.EncodeToString([]byte(payload)),
+		query.Get(signedurl.ExpiresParam),
+		query.Get(signedurl.SignatureParam),
+	}, ".")
+	return &ImpersonationGrant{Impersonation: impersonation, Token: token}, nil
+}
+
+// Verify returns the impersonation of a token. Tokens past their expiry
+// return domain.ErrImpersonationExpired; others that were not issued by
+// Impersonate return domain.ErrInvalidImpersonationToken.
+func (s *ImpersonationService) Verify(token string) (*domain.Impersonation, error) {
+	parts := strings.Split(strings.TrimPrefix(token, ImpersonationTokenPrefix), ".")
+	if !strings.HasPrefix(token, ImpersonationTokenPrefix) || len(parts) != 3 {
+		return nil, domain.ErrInvalidImpersonationToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, domain.ErrInvalidImpersonationToken
+	}
+
+	err = s.signer.Verify(impersonationSignedPrefix+string(payload), url.Values{
+		signedurl.ExpiresParam:   {parts[1]},
+		signedurl.SignatureParam: {parts[2]},
+	})
+	if errors.Is(err, signedurl.ErrExpired) {
+		return nil, domain.ErrImpersonationExpired
+	}
+	if err != nil {
+		return nil, domain.ErrInvalidImpersonationToken
+	}
+
+	// The payload and expiry are signed, so they are well-formed.
+	fields := strings.Split(string(payload), ":")
+	expires, _ := strconv.ParseInt(parts[1], 10, 64)
+	return &domain.Impersonation{
+		ID:        fields[0],
+		AdminID:   fields[1],
+		UserID:    fields[2],
+		Scope:     domain.ImpersonationScope(fields[3]),
+		ExpiresAt: time.Unix(expires, 0).UTC(),
+	}, nil
+}
+
+// RecordRequest records a request made during an impersonation in the audit
+// log.
+func (s *ImpersonationService) RecordRequest(ctx context.Context, impersonation *domain.Impersonation, method, path string, status int) error {
+	err := s.audit.Record(ctx, &domain.AuditEvent{
+		Action:    domain.AuditImpersonatedRequest,
+		ActorID:   impersonation.AdminID,
+		SubjectID: impersonation.UserID,
+		SessionID: impersonation.ID,
+		Detail:    fmt.Sprintf("%s %s %d", method, path, status),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record impersonated request: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,415 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// MaxImportRows is the maximum number of data rows accepted in a CSV import.
+const MaxImportRows = 2000
+
+// MaxImportFileSize is the maximum size in bytes of an imported file.
+const MaxImportFileSize = 5 << 20
+
+// CSV import columns. Each row holds one bullet; rows sharing type, title,
+// organization and start date are grouped into a single experience, whose
+// fields are taken from the first row of the group.
+const (
+	csvColumnType         = "type"
+	csvColumnTitle        = "title"
+	csvColumnOrganization = "organization"
+	csvColumnLocation     = "location"
+	csvColumnStartDate    = "start_date"
+	csvColumnEndDate      = "end_date"
+	csvColumnIsCurrent    = "is_current"
+	csvColumnDescription  = "description"
+	csvColumnURL          = "url"
+	csvColumnBullet       = "bullet"
+	csvColumnKeywords     = "keywords"
+	csvColumnImpactScore  = "impact_score"
+)
+
+// csvRequiredColumns must be present in the header row.
+var csvRequiredColumns = []string{csvColumnTitle, csvColumnOrganization, csvColumnStartDate}
+
+// ImportService handles bulk imports of profile data.
+type ImportService struct {
+	experienceRepo ports.ExperienceRepository
+
+	// Optional dependencies.
+	scanner ports.VirusScanner
+	storage ports.FileStorage
+}
+
+// NewImportService creates a new ImportService with required dependencies.
+func NewImportService(experienceRepo ports.ExperienceRepository) *ImportService {
+	return &ImportService{
+		experienceRepo: experienceRepo,
+	}
+}
+
+// SetVirusScanner enables scanning imported files for malware before they
+// are parsed. Without a scanner files are parsed unscanned.
+func (s *ImportService) SetVirusScanner(scanner ports.VirusScanner) {
+	s.scanner = scanner
+}
+
+// SetUploadStorage enables chunked uploads, whose chunks are kept in storage
+// until the upload is completed.
+func (s *ImportService) SetUploadStorage(storage ports.FileStorage) {
+	s.storage = storage
+}
+
+// ImportRowError describes a problem with a single CSV row.
+type ImportRowError struct {
+	// Row is the 1-based line number in the file (the header is row 1).
+	Row     int
+	Column  string
+	Message string
+}
+
+// ImportCSVRequest contains the parameters for a CSV import.
+type ImportCSVRequest struct {
+	UserID string
+	Data   io.Reader
+	DryRun bool
+}
+
+// ImportResult summarises a CSV import. When Errors is not empty nothing
+// was imported.
+type ImportResult struct {
+	DryRun      bool
+	Experiences []domain.Experience
+	Bullets     int
+	Errors      []ImportRowError
+}
+
+// ImportCSV validates a CSV of experiences and bullets and, unless DryRun is
+// set or any row is invalid, creates them in a single transaction.
+// Oversized files fail with a *domain.FileTooLargeError and infected files
+// with a *domain.InfectedFileError, before any parsing.
+func (s *ImportService) ImportCSV(ctx context.Context, req ImportCSVRequest) (*ImportResult, error) {
+	data, err := io.ReadAll(io.LimitReader(req.Data, MaxImportFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if len(data) > MaxImportFileSize {
+		return nil, &domain.FileTooLargeError{Limit: MaxImportFileSize}
+	}
+
+	return s.importCSV(ctx, req.UserID, data, req.DryRun)
+}
+
+// importCSV scans and imports a complete CSV file.
+func (s *ImportService) importCSV(ctx context.Context, userID string, data []byte, dryRun bool) (*ImportResult, error) {
+	if err := s.scanFile(ctx, data); err != nil {
+		return nil, err
+	}
+
+	experiences, rowErrors := parseExperienceCSV(userID, bytes.NewReader(data))
+
+	result := &ImportResult{
+		DryRun: dryRun,
+		Errors: rowErrors,
+	}
+	if len(rowErrors) > 0 {
+		return result, nil
+	}
+
+	for _, exp := range experiences {
+		result.Bullets += len(exp.Bullets)
+	}
+	result.Experiences = experiences
+
+	if dryRun {
+		return result, nil
+	}
+
+	if err := s.experienceRepo.CreateBatch(ctx, experiences); err != nil {
+		return nil, fmt.Errorf("failed to import experiences: %w", err)
+	}
+
+	return result, nil
+}
+
+// scanFile rejects infected files when a virus scanner is configured.
+func (s *ImportService) scanFile(ctx context.Context, data []byte) error {
+	if s.scanner == nil {
+		return nil
+	}
+
+	result, err := s.scanner.Scan(ctx, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to scan file: %w", err)
+	}
+	if result.Infected {
+		return &domain.InfectedFileError{Signature: result.Signature}
+	}
+
+	return nil
+}
+
+// parseExperienceCSV parses and validates a CSV, returning the grouped
+// experiences or every row error found.
+func parseExperienceCSV(userID string, data io.Reader) ([]domain.Experience, []ImportRowError) {
+	reader := csv.NewReader(data)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, []ImportRowError{{Row: 1, Message: "file is empty"}}
+	}
+	if err != nil {
+		return nil, []ImportRowError{csvParseError(err)}
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		columns[name] = i
+	}
+
+	var rowErrors []ImportRowError
+	for _, name := range csvRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			rowErrors = append(rowErrors, ImportRowError{Row: 1, Column: name, Message: "column is required"})
+		}
+	}
+	if len(rowErrors) > 0 {
+		return nil, rowErrors
+	}
+
+	var (
+		experiences []domain.Experience
+		groups      = make(map[string]int)
+		dataRows    int
+	)
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, csvParseError(err))
+			break
+		}
+
+		row, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		if isBlankRecord(record) {
+			continue
+		}
+
+		dataRows++
+		if dataRows > MaxImportRows {
+			rowErrors = append(rowErrors, ImportRowError{Row: row, Message: fmt.Sprintf("too many rows (maximum %d)", MaxImportRows)})
+			break
+		}
+
+		key := strings.ToLower(strings.Join([]string{
+			field(csvColumnType), field(csvColumnTitle), field(csvColumnOrganization), field(csvColumnStartDate),
+		}, "|"))
+
+		idx, exists := groups[key]
+		if !exists {
+			exp, errs := parseExperienceRow(userID, row, field)
+			if len(errs) > 0 {
+				rowErrors = append(rowErrors, errs...)
+				continue
+			}
+			idx = len(experiences)
+			groups[key] = idx
+			exp.DisplayOrder = idx
+			experiences = append(experiences, *exp)
+		}
+
+		bullet, errs := parseBulletRow(row, field)
+		if len(errs) > 0 {
+			rowErrors = append(rowErrors, errs...)
+			continue
+		}
+		if bullet != nil {
+			bullet.DisplayOrder = len(experiences[idx].Bullets)
+			experiences[idx].Bullets = append(experiences[idx].Bullets, *bullet)
+		}
+	}
+
+	if dataRows == 0 && len(rowErrors) == 0 {
+		rowErrors = append(rowErrors, ImportRowError{Row: 1, Message: "file has no data rows"})
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, rowErrors
+	}
+
+	return experiences, nil
+}
+
+// parseExperienceRow builds and validates the experience described by a row.
+func parseExperienceRow(userID string, row int, field func(string) string) (*domain.Experience, []ImportRowError) {
+	var rowErrors []ImportRowError
+	addError := func(column, message string) {
+		rowErrors = append(rowErrors, ImportRowError{Row: row, Column: column, Message: message})
+	}
+
+	expType := domain.ExperienceTypeWork
+	if raw := field(csvColumnType); raw != "" {
+		parsed, err := domain.ParseExperienceType(strings.ToLower(raw))
+		if err != nil {
+			addError(csvColumnType, "invalid experience type")
+		}
+		expType = parsed
+	}
+
+	startDate, err := domain.ParseDate(field(csvColumnStartDate))
+	if err != nil {
+		addError(csvColumnStartDate, "must be a date in YYYY-MM-DD or YYYY-MM format")
+	}
+
+	var endDate *domain.Date
+	if raw := field(csvColumnEndDate); raw != "" {
+		parsed, err := domain.ParseDate(raw)
+		if err != nil {
+			addError(csvColumnEndDate, "must be a date in YYYY-MM-DD or YYYY-MM format")
+		} else {
+			endDate = &parsed
+		}
+	}
+
+	isCurrent := false
+	if raw := field(csvColumnIsCurrent); raw != "" {
+		parsed, err := parseCSVBool(raw)
+		if err != nil {
+			addError(csvColumnIsCurrent, "must be true or false")
+		}
+		isCurrent = parsed
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, rowErrors
+	}
+
+	exp, err := domain.NewExperience(userID, expType, field(csvColumnTitle), field(csvColumnOrganization), startDate)
+	if err != nil {
+		addError(csvColumnType, err.Error())
+		return nil, rowErrors
+	}
+
+	if v := field(csvColumnLocation); v != "" {
+		exp.Location = &v
+	}
+	if v := field(csvColumnDescription); v != "" {
+		exp.Description = &v
+	}
+	if v := field(csvColumnURL); v != "" {
+		exp.URL = &v
+	}
+
+	if isCurrent {
+		if endDate != nil {
+			addError(csvColumnEndDate, "current experience cannot have an end date")
+			return nil, rowErrors
+		}
+		exp.MarkAsCurrent()
+	} else if endDate != nil {
+		exp.SetEndDate(endDate)
+	}
+
+	if err := exp.Validate(); err != nil {
+		var validationErr *domain.ValidationErrors
+		if !errors.As(err, &validationErr) {
+			addError("", err.Error())
+			return nil, rowErrors
+		}
+		for _, fieldErr := range validationErr.Errors {
+			addError(fieldErr.Field, fieldErr.Message)
+		}
+		return nil, rowErrors
+	}
+
+	return exp, nil
+}
+
+// parseBulletRow builds the bullet described by a row, or nil when the row
+// has no bullet text.
+func parseBulletRow(row int, field func(string) string) (*domain.Bullet, []ImportRowError) {
+	content := field(csvColumnBullet)
+	if content == "" {
+		if field(csvColumnKeywords) != "" || field(csvColumnImpactScore) != "" {
+			return nil, []ImportRowError{{Row: row, Column: csvColumnBullet, Message: "bullet is required when keywords or impact_score are set"}}
+		}
+		return nil, nil
+	}
+
+	bullet, err := domain.NewBullet("", content)
+	if err != nil {
+		return nil, []ImportRowError{{Row: row, Column: csvColumnBullet, Message: err.Error()}}
+	}
+
+	if raw := field(csvColumnKeywords); raw != "" {
+		var keywords []string
+		for _, kw := range strings.Split(raw, ";") {
+			if kw = strings.TrimSpace(kw); kw != "" {
+				keywords = append(keywords, kw)
+			}
+		}
+		bullet.SetKeywords(keywords)
+	}
+
+	if raw := field(csvColumnImpactScore); raw != "" {
+		score, err := strconv.Atoi(raw)
+		if err != nil || bullet.SetImpactScore(score) != nil {
+			return nil, []ImportRowError{{Row: row, Column: csvColumnImpactScore, Message: "must be an integer between 0 and 100"}}
+		}
+	}
+
+	return bullet, nil
+}
+
+// parseCSVBool parses common spreadsheet boolean spellings.
+func parseCSVBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "true", "yes", "y", "1", "x":
+		return true, nil
+	case "false", "no", "n", "0":
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid boolean %q", s)
+	}
+}
+
+// isBlankRecord reports whether every field of a record is empty.
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// csvParseError converts a CSV reader error into a row error.
+func csvParseError(err error) ImportRowError {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return ImportRowError{Row: parseErr.Line, Message: parseErr.Err.Error()}
+	}
+	return ImportRowError{Row: 1, Message: err.Error()}
+}
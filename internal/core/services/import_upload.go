@@ -0,0 +1,180 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// Chunked upload limits. The assembled file is still bound by MaxImportFileSize.
+const (
+	MaxImportChunkSize = 1 << 20
+	MaxImportChunks    = 100
+)
+
+// uploadIDLength is the length of the IDs returned by rand.Text.
+const uploadIDLength = 26
+
+// errUploadsNotConfigured is returned when no upload storage was set.
+var errUploadsNotConfigured = errors.New("chunked uploads are not configured")
+
+// ImportUpload describes a started chunked upload and its limits.
+type ImportUpload struct {
+	ID           string
+	MaxChunkSize int64
+	MaxChunks    int
+	MaxFileSize  int64
+}
+
+// StartUpload starts a chunked upload. Chunks are sent with UploadChunk, in
+// any order, and the file is imported by CompleteUpload.
+func (s *ImportService) StartUpload(ctx context.Context) (*ImportUpload, error) {
+	if s.storage == nil {
+		return nil, errUploadsNotConfigured
+	}
+
+	return &ImportUpload{
+		ID:           rand.Text(),
+		MaxChunkSize: MaxImportChunkSize,
+		MaxChunks:    MaxImportChunks,
+		MaxFileSize:  MaxImportFileSize,
+	}, nil
+}
+
+// UploadChunkRequest contains one chunk of a chunked upload.
+type UploadChunkRequest struct {
+	UserID   string
+	UploadID string
+
+	// Index is the 0-based position of the chunk in the file. Sending an
+	// index again replaces the chunk.
+	Index int
+	Data  io.Reader
+}
+
+// UploadChunk stores one chunk of a chunked upload.
+func (s *ImportService) UploadChunk(ctx context.Context, req UploadChunkRequest) error {
+	if s.storage == nil {
+		return errUploadsNotConfigured
+	}
+	if !isValidUploadID(req.UploadID) {
+		return domain.ErrUploadNotFound
+	}
+	if req.Index < 0 || req.Index >= MaxImportChunks {
+		return domain.NewDomainError(domain.ErrInvalidChunk, fmt.Sprintf("chunk index must be between 0 and %d", MaxImportChunks-1))
+	}
+
+	data, err := io.ReadAll(io.LimitReader(req.Data, MaxImportChunkSize+1))
+	if err != nil {
+		return fmt.Errorf("failed to read chunk: %w", err)
+	}
+	if len(data) > MaxImportChunkSize {
+		return &domain.FileTooLargeError{Limit: MaxImportChunkSize}
+	}
+	if len(data) == 0 {
+		return domain.NewDomainError(domain.ErrInvalidChunk, "chunk is empty")
+	}
+
+	_, err = s.storage.Upload(ctx, ports.UploadRequest{
+		Key:         uploadChunkKey(req.UserID, req.UploadID, req.Index),
+		Content:     bytes.NewReader(data),
+		ContentType: "application/octet-stream",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store chunk: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteUploadRequest contains the parameters for completing a chunked upload.
+type CompleteUploadRequest struct {
+	UserID   string
+	UploadID string
+	Chunks   int
+	DryRun   bool
+}
+
+// CompleteUpload assembles the chunks of an upload and imports the file as
+// ImportCSV would. The chunks are discarded whatever the outcome.
+func (s *ImportService) CompleteUpload(ctx context.Context, req CompleteUploadRequest) (*ImportResult, error) {
+	if s.storage == nil {
+		return nil, errUploadsNotConfigured
+	}
+	if !isValidUploadID(req.UploadID) {
+		return nil, domain.ErrUploadNotFound
+	}
+	if req.Chunks < 1 || req.Chunks > MaxImportChunks {
+		return nil, domain.NewDomainError(domain.ErrInvalidChunk, fmt.Sprintf("chunk count must be between 1 and %d", MaxImportChunks))
+	}
+	defer s.discardUpload(ctx, req.UserID, req.UploadID, req.Chunks)
+
+	data, err := s.assembleUpload(ctx, req.UserID, req.UploadID, req.Chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.importCSV(ctx, req.UserID, data, req.DryRun)
+}
+
+// assembleUpload concatenates the chunks of an upload in index order.
+func (s *ImportService) assembleUpload(ctx context.Context, userID, uploadID string, chunks int) ([]byte, error) {
+	var buf bytes.Buffer
+
+	for i := range chunks {
+		chunk, err := s.storage.Download(ctx, uploadChunkKey(userID, uploadID, i))
+		if errors.Is(err, domain.ErrFileNotFound) {
+			if i == 0 {
+				return nil, domain.ErrUploadNotFound
+			}
+			return nil, domain.NewDomainError(domain.ErrIncompleteUpload, fmt.Sprintf("chunk %d is missing", i))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read chunk %d: %w", i, err)
+		}
+
+		_, err = io.Copy(&buf, io.LimitReader(chunk, int64(MaxImportFileSize+1-buf.Len())))
+		chunk.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read chunk %d: %w", i, err)
+		}
+		if buf.Len() > MaxImportFileSize {
+			return nil, &domain.FileTooLargeError{Limit: MaxImportFileSize}
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// discardUpload deletes the chunks of an upload. Failures only leave
+// orphaned chunks behind, so they are ignored.
+func (s *ImportService) discardUpload(ctx context.Context, userID, uploadID string, chunks int) {
+	for i := range chunks {
+		_ = s.storage.Delete(ctx, uploadChunkKey(userID, uploadID, i))
+	}
+}
+
+// uploadChunkKey returns the storage key of a chunk, scoped to its owner.
+func uploadChunkKey(userID, uploadID string, index int) string {
+	return fmt.Sprintf("imports/%s/%s/%03d", userID, uploadID, index)
+}
+
+// isValidUploadID reports whether id has the shape of a StartUpload ID,
+// which also keeps it safe to use in storage keys.
+func isValidUploadID(id string) bool {
+	if len(id) != uploadIDLength {
+		return false
+	}
+	for _, c := range id {
+		if (c < 'A' || c > 'Z') && (c < '2' || c > '7') {
+			return false
+		}
+	}
+	return true
+}
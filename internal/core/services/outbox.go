@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// SetEventOutbox enables recording user.created events in the outbox, in the
+// same transaction as the new user, for delivery by an OutboxRelay.
+func (s *UserService) SetEventOutbox(enabled bool) {
+	s.outbox = enabled
+}
+
+// SetEventOutbox enables recording resume events (tailored, PDF generated,
+// status changed) in the outbox, in the same transaction as the resume
+// update, for delivery by an OutboxRelay.
+func (s *ResumeService) SetEventOutbox(enabled bool) {
+	s.outbox = enabled
+}
+
+// updateResume saves a resume with the events describing the change, when
+// the outbox is enabled.
+func (s *ResumeService) updateResume(ctx context.Context, resume *domain.Resume, events ...domain.OutboxEvent) error {
+	if !s.outbox || len(events) == 0 {
+		return s.resumeRepo.Update(ctx, resume)
+	}
+	return s.resumeRepo.UpdateWithEvents(ctx, resume, events)
+}
+
+// statusChangeEvents returns the status change event of a resume that moved
+// from status from, if its status changed.
+func statusChangeEvents(resume *domain.Resume, from domain.ResumeStatus) []domain.OutboxEvent {
+	if resume.Status == from {
+		return nil
+	}
+	return []domain.OutboxEvent{domain.NewResumeOutboxEvent(domain.EventResumeStatusChanged, resume, map[string]string{
+		"from": string(from),
+		"to":   string(resume.Status),
+	})}
+}
+
+// OutboxRelayConfig tunes an OutboxRelay.
+type OutboxRelayConfig struct {
+	// BatchSize is the number of events claimed per delivery round.
+	BatchSize int
+
+	// Lease is how long claimed events are hidden from other relays while
+	// they are being delivered.
+	Lease time.Duration
+
+	// Retention is how long delivered events are kept.
+	Retention time.Duration
+}
+
+// DefaultOutboxRelayConfig returns the relay settings used unless configured.
+func DefaultOutboxRelayConfig() OutboxRelayConfig {
+	return OutboxRelayConfig{
+		BatchSize: 50,
+		Lease:     time.Minute,
+		Retention: 7 * 24 * time.Hour,
+	}
+}
+
+// OutboxRelay delivers the events of the outbox to a publisher and marks
+// them sent. Failed deliveries are retried with exponential backoff, so every
+// event is delivered at least once.
+type OutboxRelay struct {
+	repo      ports.OutboxRepository
+	publisher ports.EventPublisher
+	config    OutboxRelayConfig
+}
+
+// NewOutboxRelay creates a new OutboxRelay. Zero config fields take their
+// default values.
+func NewOutboxRelay(repo ports.OutboxRepository, publisher ports.EventPublisher, config OutboxRelayConfig) *OutboxRelay {
+	defaults := DefaultOutboxRelayConfig()
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.Lease <= 0 {
+		config.Lease = defaults.Lease
+	}
+	if config.Retention <= 0 {
+		config.Retention = defaults.Retention
+	}
+	return &OutboxRelay{repo: repo, publisher: publisher, config: config}
+}
+
+// OutboxDeliveryResult summarizes a delivery round.
+type OutboxDeliveryResult struct {
+	Sent   int
+	Failed int
+	Purged int
+}
+
+// Deliver publishes one batch of due events and removes the events
+// delivered before the retention period.
+func (r *OutboxRelay) Deliver(ctx context.Context) (*OutboxDeliveryResult, error) {
+	events, err := r.repo.ClaimDue(ctx, r.config.BatchSize, r.config.Lease)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+
+	result := &OutboxDeliveryResult{}
+	for _, event := range events {
+		if err := r.publisher.Publish(ctx, event); err != nil {
+			if ctx.Err() != nil {
+				// The claim expires and the event is delivered later.
+				return result, ctx.Err()
+			}
+			retryAt := time.Now().UTC().Add(outboxBackoff(event.Attempts))
+			if err := r.repo.MarkFailed(ctx, event.ID, err.Error(), retryAt); err != nil {
+				return result, fmt.Errorf("failed to mark outbox event failed: %w", err)
+			}
+			result.Failed++
+			continue
+		}
+
+		if err := r.repo.MarkSent(ctx, event.ID); err != nil {
+			return result, fmt.Errorf("failed to mark outbox event sent: %w", err)
+		}
+		result.Sent++
+	}
+
+	purged, err := r.repo.DeleteSentBefore(ctx, time.Now().UTC().Add(-r.config.Retention))
+	if err != nil {
+		return result, fmt.Errorf("failed to delete sent outbox events: %w", err)
+	}
+	result.Purged = purged
+
+	return result, nil
+}
+
+// EventPublishers publishes each event to all its publishers, e.g. a webhook
+// and an event bus. When one fails, the event is delivered to all of them
+// again on retry.
+type EventPublishers []ports.EventPublisher
+
+var _ ports.EventPublisher = EventPublishers(nil)
+
+// Publish delivers the event to every publisher and joins their errors.
+func (p EventPublishers) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	var errs []error
+	for _, publisher := range p {
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// outboxBackoff returns the delay before retrying an event that failed
+// attempts times before: 30 seconds, doubling up to 6 hours.
+func outboxBackoff(attempts int) time.Duration {
+	const (
+		base     = 30 * time.Second
+		maxDelay = 6 * time.Hour
+	)
+	if attempts >= 10 {
+		return maxDelay
+	}
+	return min(base<<attempts, maxDelay)
+}
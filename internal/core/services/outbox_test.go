@@ -0,0 +1,213 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// failedDelivery is a delivery recorded by memoryOutbox.MarkFailed.
+type failedDelivery struct {
+	reason  string
+	retryAt time.Time
+}
+
+// memoryOutbox is an in-memory ports.OutboxRepository.
+type memoryOutbox struct {
+	due    []domain.OutboxEvent
+	sent   []string
+	failed map[string]failedDelivery
+	purged int
+
+	claimedLimit int
+	claimedLease time.Duration
+	purgedBefore time.Time
+}
+
+func (o *memoryOutbox) ClaimDue(_ context.Context, limit int, lease time.Duration) ([]domain.OutboxEvent, error) {
+	o.claimedLimit, o.claimedLease = limit, lease
+	return o.due[:min(limit, len(o.due))], nil
+}
+
+func (o *memoryOutbox) MarkSent(_ context.Context, id string) error {
+	o.sent = append(o.sent, id)
+	return nil
+}
+
+func (o *memoryOutbox) MarkFailed(_ context.Context, id, reason string, retryAt time.Time) error {
+	if o.failed == nil {
+		o.failed = make(map[string]failedDelivery)
+	}
+	o.failed[id] = failedDelivery{reason: reason, retryAt: retryAt}
+	return nil
+}
+
+func (o *memoryOutbox) DeleteSentBefore(_ context.Context, before time.Time) (int, error) {
+	o.purgedBefore = before
+	return o.purged, nil
+}
+
+// publisherFunc adapts a function to ports.EventPublisher.
+type publisherFunc func(ctx context.Context, event domain.OutboxEvent) error
+
+func (f publisherFunc) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	return f(ctx, event)
+}
+
+// failIDs is a publisher that fails the events with the given IDs.
+func failIDs(published *[]string, ids ...string) publisherFunc {
+	return func(_ context.Context, event domain.OutboxEvent) error {
+		*published = append(*published, event.ID)
+		for _, id := range ids {
+			if event.ID == id {
+				return errors.New("receiver unavailable")
+			}
+		}
+		return nil
+	}
+}
+
+func TestOutboxRelayDeliver(t *testing.T) {
+	t.Run("marks published events sent", func(t *testing.T) {
+		repo := &memoryOutbox{
+			due:    []domain.OutboxEvent{{ID: "event-1"}, {ID: "event-2"}, {ID: "event-3"}},
+			purged: 4,
+		}
+		var published []string
+		relay := services.NewOutboxRelay(repo, failIDs(&published), services.OutboxRelayConfig{
+			BatchSize: 2,
+			Lease:     30 * time.Second,
+			Retention: 24 * time.Hour,
+		})
+
+		before := time.Now().UTC()
+		result, err := relay.Deliver(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, &services.OutboxDeliveryResult{Sent: 2, Purged: 4}, result)
+		assert.Equal(t, []string{"event-1", "event-2"}, published)
+		assert.Equal(t, []string{"event-1", "event-2"}, repo.sent)
+		assert.Equal(t, 2, repo.claimedLimit)
+		assert.Equal(t, 30*time.Second, repo.claimedLease)
+		assert.WithinDuration(t, before.Add(-24*time.Hour), repo.purgedBefore, time.Second)
+	})
+
+	t.Run("uses the default config", func(t *testing.T) {
+		repo := &memoryOutbox{}
+		relay := services.NewOutboxRelay(repo, failIDs(new([]string)), services.OutboxRelayConfig{})
+
+		before := time.Now().UTC()
+		_, err := relay.Deliver(context.Background())
+		require.NoError(t, err)
+
+		defaults := services.DefaultOutboxRelayConfig()
+		assert.Equal(t, defaults.BatchSize, repo.claimedLimit)
+		assert.Equal(t, defaults.Lease, repo.claimedLease)
+		assert.WithinDuration(t, before.Add(-defaults.Retention), repo.purgedBefore, time.Second)
+	})
+
+	t.Run("schedules failed events for retry", func(t *testing.T) {
+		repo := &memoryOutbox{due: []domain.OutboxEvent{
+			{ID: "event-1", Attempts: 2},
+			{ID: "event-2"},
+		}}
+		var published []string
+		relay := services.NewOutboxRelay(repo, failIDs(&published, "event-1"), services.OutboxRelayConfig{})
+
+		before := time.Now().UTC()
+		result, err := relay.Deliver(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, &services.OutboxDeliveryResult{Sent: 1, Failed: 1}, result)
+		assert.Equal(t, []string{"event-1", "event-2"}, published)
+		assert.Equal(t, []string{"event-2"}, repo.sent)
+		require.Contains(t, repo.failed, "event-1")
+		assert.Equal(t, "receiver unavailable", repo.failed["event-1"].reason)
+		// Two failed attempts before: 30s doubled twice.
+		assert.WithinDuration(t, before.Add(2*time.Minute), repo.failed["event-1"].retryAt, time.Second)
+	})
+
+	t.Run("stops when cancelled, leaving the claim to expire", func(t *testing.T) {
+		repo := &memoryOutbox{due: []domain.OutboxEvent{{ID: "event-1"}, {ID: "event-2"}}}
+		ctx, cancel := context.WithCancel(context.Background())
+		var published []string
+		relay := services.NewOutboxRelay(repo, publisherFunc(func(ctx context.Context, event domain.OutboxEvent) error {
+			published = append(published, event.ID)
+			cancel()
+			return ctx.Err()
+		}), services.OutboxRelayConfig{})
+
+		result, err := relay.Deliver(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		assert.Equal(t, &services.OutboxDeliveryResult{}, result)
+		assert.Equal(t, []string{"event-1"}, published)
+		assert.Empty(t, repo.sent)
+		assert.Empty(t, repo.failed)
+		assert.True(t, repo.purgedBefore.IsZero(), "nothing is purged")
+	})
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: 30 * time.Second},
+		{attempts: 1, want: time.Minute},
+		{attempts: 5, want: 16 * time.Minute},
+		{attempts: 9, want: 4*time.Hour + 16*time.Minute},
+		{attempts: 10, want: 6 * time.Hour},
+		{attempts: 100, want: 6 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, services.OutboxBackoff(tt.attempts), "attempts %d", tt.attempts)
+	}
+}
+
+func TestEventPublishers(t *testing.T) {
+	event := domain.OutboxEvent{ID: "event-1", Type: domain.EventResumeTailored}
+	errWebhook := errors.New("webhook failed")
+	errBus := errors.New("bus failed")
+
+	var calls []string
+	publisher := func(name string, err error) publisherFunc {
+		return func(_ context.Context, got domain.OutboxEvent) error {
+			assert.Equal(t, event, got)
+			calls = append(calls, name)
+			return err
+		}
+	}
+
+	t.Run("publishes to every publisher", func(t *testing.T) {
+		calls = nil
+		err := services.EventPublishers{publisher("webhook", nil), publisher("bus", nil)}.Publish(context.Background(), event)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"webhook", "bus"}, calls)
+	})
+
+	t.Run("joins the errors after trying every publisher", func(t *testing.T) {
+		calls = nil
+		err := services.EventPublishers{
+			publisher("webhook", errWebhook),
+			publisher("log", nil),
+			publisher("bus", errBus),
+		}.Publish(context.Background(), event)
+
+		assert.ErrorIs(t, err, errWebhook)
+		assert.ErrorIs(t, err, errBus)
+		assert.Equal(t, []string{"webhook", "log", "bus"}, calls)
+	})
+
+	t.Run("no publishers", func(t *testing.T) {
+		assert.NoError(t, services.EventPublishers(nil).Publish(context.Background(), event))
+	})
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// PreferencesService handles user preferences use cases.
+type PreferencesService struct {
+	prefsRepo ports.PreferencesRepository
+}
+
+// NewPreferencesService creates a new PreferencesService with required dependencies.
+func NewPreferencesService(prefsRepo ports.PreferencesRepository) *PreferencesService {
+	return &PreferencesService{
+		prefsRepo: prefsRepo,
+	}
+}
+
+// GetPreferences returns a user's preferences, or the defaults if the user
+// never saved any.
+func (s *PreferencesService) GetPreferences(ctx context.Context, userID string) (*domain.UserPreferences, error) {
+	prefs, err := s.prefsRepo.GetByUserID(ctx, userID)
+	if errors.Is(err, domain.ErrPreferencesNotFound) {
+		return domain.DefaultUserPreferences(userID), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get preferences: %w", err)
+	}
+	return prefs, nil
+}
+
+// UpdatePreferencesRequest contains the preferences to change. Nil fields
+// are left unchanged.
+type UpdatePreferencesRequest struct {
+	UserID                string
+	DefaultTemplate       *string
+	DefaultTargetLanguage *string
+	DefaultMaxBullets     *int
+	DefaultFontSize       *int
+	DateFormat            *string
+	Timezone              *string
+	FilenamePattern       *string
+	EmailProductUpdates   *bool
+	EmailUsageAlerts      *bool
+}
+
+// UpdatePreferences applies a partial update to a user's preferences.
+func (s *PreferencesService) UpdatePreferences(ctx context.Context, req UpdatePreferencesRequest) (*domain.UserPreferences, error) {
+	prefs, err := s.GetPreferences(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DefaultTemplate != nil {
+		prefs.DefaultTemplate = *req.DefaultTemplate
+	}
+	if req.DefaultTargetLanguage != nil {
+		prefs.DefaultTargetLanguage = *req.DefaultTargetLanguage
+	}
+	if req.DefaultMaxBullets != nil {
+		prefs.DefaultMaxBullets = *req.DefaultMaxBullets
+	}
+	if req.DefaultFontSize != nil {
+		prefs.DefaultFontSize = *req.DefaultFontSize
+	}
+	if req.DateFormat != nil {
+		prefs.DateFormat = domain.DateFormat(*req.DateFormat)
+	}
+	if req.Timezone != nil {
+		prefs.Timezone = *req.Timezone
+	}
+	if req.FilenamePattern != nil {
+		prefs.FilenamePattern = domain.FilenamePattern(*req.FilenamePattern)
+	}
+	if req.EmailProductUpdates != nil {
+		prefs.EmailProductUpdates = *req.EmailProductUpdates
+	}
+	if req.EmailUsageAlerts != nil {
+		prefs.EmailUsageAlerts = *req.EmailUsageAlerts
+	}
+
+	prefs.UpdatedAt = time.Now().UTC()
+
+	if err := prefs.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.prefsRepo.Upsert(ctx, prefs); err != nil {
+		return nil, fmt.Errorf("failed to save preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// SetPreferences makes resume creation, tailoring and rendering use each
+// user's saved defaults. Without it the system defaults apply.
+func (s *ResumeService) SetPreferences(prefs *PreferencesService) {
+	s.prefs = prefs
+}
+
+// userPreferences returns the user's preferences, or the defaults when
+// preferences are disabled or cannot be loaded.
+func (s *ResumeService) userPreferences(ctx context.Context, userID string) *domain.UserPreferences {
+	if s.prefs == nil {
+		return domain.DefaultUserPreferences(userID)
+	}
+	prefs, err := s.prefs.GetPreferences(ctx, userID)
+	if err != nil {
+		return domain.DefaultUserPreferences(userID)
+	}
+	return prefs
+}
@@ -0,0 +1,496 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// ProfileBundleVersion is the format version of profile bundles. Bundles of
+// other versions are rejected.
+const ProfileBundleVersion = 1
+
+// ProfileBundle is a user's whole master profile in one document, for
+// keeping it in version control and editing it in a text editor.
+//
+// Records are listed in display order. When a bundle is saved, records are
+// matched with the user's by ID: records with a known ID are updated, others
+// are created with a new ID and the user's records missing from the bundle
+// are deleted. IDs of owners, display orders and timestamps are ignored.
+type ProfileBundle struct {
+	Version int                  `json:"version"`
+	Profile ProfileBundleProfile `json:"profile"`
+
+	// Experiences and Projects include their bullets.
+	Experiences     []domain.Experience     `json:"experiences"`
+	Education       []domain.Education      `json:"education"`
+	Projects        []domain.Project        `json:"projects"`
+	Skills          []domain.Skill          `json:"skills"`
+	SpokenLanguages []domain.SpokenLanguage `json:"spoken_languages"`
+}
+
+// ProfileBundleProfile holds the editable fields of a user. Fields left out
+// of a saved bundle are cleared, except the preferred language.
+type ProfileBundleProfile struct {
+	Name              *string `json:"name,omitempty"`
+	Headline          *string `json:"headline,omitempty"`
+	Summary           *string `json:"summary,omitempty"`
+	Location          *string `json:"location,omitempty"`
+	Phone             *string `json:"phone,omitempty"`
+	Website           *string `json:"website,omitempty"`
+	LinkedInURL       *string `json:"linkedin_url,omitempty"`
+	GitHubURL         *string `json:"github_url,omitempty"`
+	PortfolioURL      *string `json:"portfolio_url,omitempty"`
+	PreferredLanguage string  `json:"preferred_language,omitempty"`
+}
+
+// ProfileBundleService reads and replaces the master profile of users as a
+// ProfileBundle.
+type ProfileBundleService struct {
+	userService    *UserService
+	userRepo       ports.UserRepository
+	experienceRepo ports.ExperienceRepository
+	educationRepo  ports.EducationRepository
+	projectRepo    ports.ProjectRepository
+	skillRepo      ports.SkillRepository
+	languageRepo   ports.SpokenLanguageRepository
+	transactor     ports.ProfileTransactor
+}
+
+// NewProfileBundleService creates a new ProfileBundleService with required
+// dependencies. The profile section of bundles is checked by userService,
+// which normalizes links and phone numbers, and bundles are saved in a single
+// transaction of transactor.
+func NewProfileBundleService(
+	userService *UserService,
+	userRepo ports.UserRepository,
+	experienceRepo ports.ExperienceRepository,
+	educationRepo ports.EducationRepository,
+	projectRepo ports.ProjectRepository,
+	skillRepo ports.SkillRepository,
+	languageRepo ports.SpokenLanguageRepository,
+	transactor ports.ProfileTransactor,
+) *ProfileBundleService {
+	return &ProfileBundleService{
+		userService:    userService,
+		userRepo:       userRepo,
+		experienceRepo: experienceRepo,
+		educationRepo:  educationRepo,
+		projectRepo:    projectRepo,
+		skillRepo:      skillRepo,
+		languageRepo:   languageRepo,
+		transactor:     transactor,
+	}
+}
+
+// GetBundle returns the master profile of a user.
+func (s *ProfileBundleService) GetBundle(ctx context.Context, userID string) (*ProfileBundle, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	bundle := &ProfileBundle{
+		Version: ProfileBundleVersion,
+		Profile: ProfileBundleProfile{
+			Name:              user.Name,
+			Headline:          user.Headline,
+			Summary:           user.Summary,
+			Location:          user.Location,
+			Phone:             user.Phone,
+			Website:           user.Website,
+			LinkedInURL:       user.LinkedInURL,
+			GitHubURL:         user.GitHubURL,
+			PortfolioURL:      user.PortfolioURL,
+			PreferredLanguage: user.PreferredLanguage,
+		},
+	}
+
+	if bundle.Experiences, err = s.listExperiences(ctx, userID); err != nil {
+		return nil, err
+	}
+	if bundle.Education, err = s.educationRepo.ListByUserID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to list education: %w", err)
+	}
+	if bundle.Projects, err = s.projectRepo.ListByUserIDWithBullets(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	if bundle.Skills, err = s.skillRepo.ListByUserID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to list skills: %w", err)
+	}
+	if bundle.SpokenLanguages, err = s.languageRepo.ListByUserID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to list spoken languages: %w", err)
+	}
+
+	return bundle, nil
+}
+
+// listExperiences returns all the experiences of a user with their bullets.
+func (s *ProfileBundleService) listExperiences(ctx context.Context, userID string) ([]domain.Experience, error) {
+	var experiences []domain.Experience
+	for offset := 0; ; offset += archivePageSize {
+		page, total, err := s.experienceRepo.ListByUserIDWithBullets(ctx, userID, ports.ListOptions{
+			Limit:  archivePageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list experiences: %w", err)
+		}
+		experiences = append(experiences, page...)
+		if len(page) < archivePageSize || len(experiences) >= total {
+			return experiences, nil
+		}
+	}
+}
+
+// ReplaceBundle replaces the master profile of a user with a bundle and
+// returns the saved profile. The whole bundle is validated before anything
+// is saved; invalid bundles return domain.ValidationErrors whose fields are
+// paths into the bundle, such as "experiences[0].bullets[1].content". The
+// bundle is saved in a single transaction, so a failure leaves the profile
+// as it was.
+func (s *ProfileBundleService) ReplaceBundle(ctx context.Context, userID string, bundle *ProfileBundle) (*ProfileBundle, error) {
+	if bundle.Version != ProfileBundleVersion {
+		v := &domain.ValidationErrors{}
+		v.AddFieldError("version", fmt.Sprintf("unsupported version, expected %d", ProfileBundleVersion))
+		return nil, v
+	}
+
+	current, err := s.GetBundle(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := planBundle(userID, current, bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := bundle.Profile
+	user, err := s.userService.updatedProfile(ctx, UpdateProfileRequest{
+		UserID:            userID,
+		Name:              orEmpty(profile.Name),
+		Headline:          orEmpty(profile.Headline),
+		Summary:           orEmpty(profile.Summary),
+		Location:          orEmpty(profile.Location),
+		Phone:             orEmpty(profile.Phone),
+		Website:           orEmpty(profile.Website),
+		LinkedInURL:       orEmpty(profile.LinkedInURL),
+		GitHubURL:         orEmpty(profile.GitHubURL),
+		PortfolioURL:      orEmpty(profile.PortfolioURL),
+		PreferredLanguage: &profile.PreferredLanguage,
+	})
+	if err != nil {
+		v := &domain.ValidationErrors{}
+		if addBundleErrors(v, "profile", err) {
+			return nil, v
+		}
+		return nil, err
+	}
+
+	err = s.transactor.InTransaction(ctx, func(repos ports.ProfileRepositories) error {
+		if err := repos.Users.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+		return apply(ctx, repos, userID, current, plan)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetBundle(ctx, userID)
+}
+
+// orEmpty returns value, or a pointer to an empty string, which clears
+// fields in UpdateProfileRequest, when it is nil.
+func orEmpty(value *string) *string {
+	if value == nil {
+		return new(string)
+	}
+	return value
+}
+
+// bundlePlan holds the records of a bundle ready to be saved, each flagged
+// as new or existing.
+type bundlePlan struct {
+	bundle *ProfileBundle
+
+	// exists holds the IDs of the bundle's records the user already has.
+	exists map[string]bool
+}
+
+// planBundle prepares the records of bundle to replace those of current:
+// it assigns IDs to new records and sets their owners and display orders.
+// It returns the validation errors of all records.
+func planBundle(userID string, current, bundle *ProfileBundle) (*bundlePlan, error) {
+	// known maps the IDs of the user's records to the experience or project
+	// of bullets, and to "" for other records.
+	known := make(map[string]string)
+	for _, experience := range current.Experiences {
+		known[experience.ID] = ""
+		for _, bullet := range experience.Bullets {
+			known[bullet.ID] = experience.ID
+		}
+	}
+	for _, education := range current.Education {
+		known[education.ID] = ""
+	}
+	for _, project := range current.Projects {
+		known[project.ID] = ""
+		for _, bullet := range project.Bullets {
+			known[bullet.ID] = project.ID
+		}
+	}
+	for _, skill := range current.Skills {
+		known[skill.ID] = ""
+	}
+	for _, language := range current.SpokenLanguages {
+		known[language.ID] = ""
+	}
+
+	plan := &bundlePlan{bundle: bundle, exists: make(map[string]bool)}
+	v := &domain.ValidationErrors{}
+
+	// claim gives a record its ID. A record keeps its ID when the user has
+	// it under the same owner and no other record of the bundle claimed it;
+	// others get a new one.
+	claim := func(path string, id *string, owner string) {
+		knownOwner, ok := known[*id]
+		if !ok || knownOwner != owner {
+			*id = uuid.NewString()
+			return
+		}
+		if plan.exists[*id] {
+			v.AddFieldError(path+".id", "duplicate id")
+			return
+		}
+		plan.exists[*id] = true
+	}
+
+	for i := range bundle.Experiences {
+		path := fmt.Sprintf("experiences[%d]", i)
+		experience := &bundle.Experiences[i]
+		experience.UserID = userID
+		experience.DisplayOrder = i
+		claim(path, &experience.ID, "")
+		addBundleErrors(v, path, experience.Validate())
+
+		for j := range experience.Bullets {
+			path := fmt.Sprintf("%s.bullets[%d]", path, j)
+			bullet := &experience.Bullets[j]
+			bullet.ExperienceID = experience.ID
+			bullet.DisplayOrder = j
+			if bullet.Keywords == nil {
+				bullet.Keywords = make([]string, 0)
+			}
+			claim(path, &bullet.ID, experience.ID)
+			addBundleErrors(v, path, bullet.Validate())
+		}
+	}
+
+	for i := range bundle.Education {
+		path := fmt.Sprintf("education[%d]", i)
+		education := &bundle.Education[i]
+		education.UserID = userID
+		education.DisplayOrder = i
+		if education.Honors == nil {
+			education.Honors = make([]string, 0)
+		}
+		claim(path, &education.ID, "")
+		addBundleErrors(v, path, education.Validate())
+	}
+
+	for i := range bundle.Projects {
+		path := fmt.Sprintf("projects[%d]", i)
+		project := &bundle.Projects[i]
+		project.UserID = userID
+		project.DisplayOrder = i
+		if project.TechStack == nil {
+			project.TechStack = make([]string, 0)
+		}
+		claim(path, &project.ID, "")
+		addBundleErrors(v, path, project.Validate())
+
+		for j := range project.Bullets {
+			path := fmt.Sprintf("%s.bullets[%d]", path, j)
+			bullet := &project.Bullets[j]
+			bullet.ProjectID = project.ID
+			bullet.DisplayOrder = j
+			claim(path, &bullet.ID, project.ID)
+			if bullet.Content == "" {
+				v.AddFieldError(path+".content", "content is required")
+			}
+		}
+	}
+
+	for i := range bundle.Skills {
+		path := fmt.Sprintf("skills[%d]", i)
+		skill := &bundle.Skills[i]
+		skill.UserID = userID
+		skill.DisplayOrder = i
+		claim(path, &skill.ID, "")
+		addBundleErrors(v, path, skill.Validate())
+	}
+
+	for i := range bundle.SpokenLanguages {
+		path := fmt.Sprintf("spoken_languages[%d]", i)
+		language := &bundle.SpokenLanguages[i]
+		language.UserID = userID
+		language.DisplayOrder = i
+		claim(path, &language.ID, "")
+		addBundleErrors(v, path, language.Validate())
+	}
+
+	if err := v.ToError(); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// addBundleErrors adds the validation errors of err to v, prefixing their
+// fields with path, and reports whether err held any.
+func addBundleErrors(v *domain.ValidationErrors, path string, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var fields []*domain.DomainError
+	var validation *domain.ValidationErrors
+	var field *domain.DomainError
+	switch {
+	case errors.As(err, &validation):
+		fields = validation.Errors
+	case errors.As(err, &field) && errors.Is(err, domain.ErrValidation):
+		fields = []*domain.DomainError{field}
+	default:
+		return false
+	}
+
+	for _, fieldErr := range fields {
+		name := path
+		if fieldErr.Field != "" {
+			name += "." + fieldErr.Field
+		}
+		v.Add(domain.NewFieldError(fieldErr.Err, name, fieldErr.Message))
+	}
+	return true
+}
+
+// apply saves the records of plan with repos and deletes the records of
+// current it leaves out. Deletions go first, so that a record can take the
+// name of a deleted one.
+func apply(ctx context.Context, repos ports.ProfileRepositories, userID string, current *ProfileBundle, plan *bundlePlan) error {
+	bundle := plan.bundle
+
+	// Deleting an experience or project deletes its bullets.
+	for _, experience := range current.Experiences {
+		if !plan.exists[experience.ID] {
+			if err := repos.Experiences.Delete(ctx, experience.ID, userID); err != nil {
+				return fmt.Errorf("failed to delete experience: %w", err)
+			}
+			continue
+		}
+		for _, bullet := range experience.Bullets {
+			if !plan.exists[bullet.ID] {
+				if err := repos.Bullets.Delete(ctx, bullet.ID, userID); err != nil {
+					return fmt.Errorf("failed to delete bullet: %w", err)
+				}
+			}
+		}
+	}
+	for _, education := range current.Education {
+		if !plan.exists[education.ID] {
+			if err := repos.Education.Delete(ctx, education.ID, userID); err != nil {
+				return fmt.Errorf("failed to delete education: %w", err)
+			}
+		}
+	}
+	for _, project := range current.Projects {
+		if !plan.exists[project.ID] {
+			if err := repos.Projects.Delete(ctx, project.ID, userID); err != nil {
+				return fmt.Errorf("failed to delete project: %w", err)
+			}
+			continue
+		}
+		for _, bullet := range project.Bullets {
+			if !plan.exists[bullet.ID] {
+				if err := repos.ProjectBullets.Delete(ctx, bullet.ID, userID); err != nil {
+					return fmt.Errorf("failed to delete project bullet: %w", err)
+				}
+			}
+		}
+	}
+	for _, skill := range current.Skills {
+		if !plan.exists[skill.ID] {
+			if err := repos.Skills.Delete(ctx, skill.ID, userID); err != nil {
+				return fmt.Errorf("failed to delete skill: %w", err)
+			}
+		}
+	}
+	for _, language := range current.SpokenLanguages {
+		if !plan.exists[language.ID] {
+			if err := repos.SpokenLanguages.Delete(ctx, language.ID, userID); err != nil {
+				return fmt.Errorf("failed to delete spoken language: %w", err)
+			}
+		}
+	}
+
+	for i := range bundle.Experiences {
+		experience := &bundle.Experiences[i]
+		if err := save(ctx, plan, experience.ID, experience, repos.Experiences.Create, repos.Experiences.Update); err != nil {
+			return fmt.Errorf("failed to save experience: %w", err)
+		}
+		for j := range experience.Bullets {
+			bullet := &experience.Bullets[j]
+			if err := save(ctx, plan, bullet.ID, bullet, repos.Bullets.Create, repos.Bullets.Update); err != nil {
+				return fmt.Errorf("failed to save bullet: %w", err)
+			}
+		}
+	}
+	for i := range bundle.Education {
+		education := &bundle.Education[i]
+		if err := save(ctx, plan, education.ID, education, repos.Education.Create, repos.Education.Update); err != nil {
+			return fmt.Errorf("failed to save education: %w", err)
+		}
+	}
+	for i := range bundle.Projects {
+		project := &bundle.Projects[i]
+		if err := save(ctx, plan, project.ID, project, repos.Projects.Create, repos.Projects.Update); err != nil {
+			return fmt.Errorf("failed to save project: %w", err)
+		}
+		for j := range project.Bullets {
+			bullet := &project.Bullets[j]
+			if err := save(ctx, plan, bullet.ID, bullet, repos.ProjectBullets.Create, repos.ProjectBullets.Update); err != nil {
+				return fmt.Errorf("failed to save project bullet: %w", err)
+			}
+		}
+	}
+	for i := range bundle.Skills {
+		skill := &bundle.Skills[i]
+		if err := save(ctx, plan, skill.ID, skill, repos.Skills.Create, repos.Skills.Update); err != nil {
+			return fmt.Errorf("failed to save skill: %w", err)
+		}
+	}
+	for i := range bundle.SpokenLanguages {
+		language := &bundle.SpokenLanguages[i]
+		if err := save(ctx, plan, language.ID, language, repos.SpokenLanguages.Create, repos.SpokenLanguages.Update); err != nil {
+			return fmt.Errorf("failed to save spoken language: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// save updates a record of plan the user already has and creates the
+// others.
+func save[T any](ctx context.Context, plan *bundlePlan, id string, record *T, create, update func(context.Context, *T) error) error {
+	if plan.exists[id] {
+		return update(ctx, record)
+	}
+	return create(ctx, record)
+}
@@ -0,0 +1,94 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestPlanProfileBundle(t *testing.T) {
+	const userID = "user-123"
+	start := domain.NewDate(2020, time.January, 1)
+
+	current := &services.ProfileBundle{
+		Experiences: []domain.Experience{
+			{ID: "exp-1", UserID: userID, Bullets: []domain.Bullet{{ID: "bullet-1", ExperienceID: "exp-1"}}},
+			{ID: "exp-2", UserID: userID},
+		},
+		Skills: []domain.Skill{{ID: "skill-1", UserID: userID, Name: "Go"}},
+	}
+
+	experience := func(id string, bullets ...domain.Bullet) domain.Experience {
+		return domain.Experience{
+			ID:           id,
+			Type:         domain.ExperienceTypeWork,
+			Title:        "Engineer",
+			Organization: "Acme",
+			StartDate:    start,
+			Bullets:      bullets,
+		}
+	}
+
+	t.Run("matches records by ID", func(t *testing.T) {
+		bundle := &services.ProfileBundle{
+			Version: services.ProfileBundleVersion,
+			Experiences: []domain.Experience{
+				experience("exp-2", domain.Bullet{ID: "bullet-1", Content: "Moved from exp-1"}),
+				experience("exp-1", domain.Bullet{ID: "bullet-1", Content: "Kept"}),
+				experience("", domain.Bullet{Content: "New"}),
+			},
+			Skills: []domain.Skill{{ID: "unknown", Name: "SQL"}},
+		}
+
+		exists, err := services.PlanProfileBundle(userID, current, bundle)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]bool{"exp-1": true, "exp-2": true, "bullet-1": true}, exists)
+
+		// Bullets keep their ID only under their experience.
+		moved := bundle.Experiences[0].Bullets[0]
+		assert.NotEqual(t, "bullet-1", moved.ID)
+		assert.Equal(t, "exp-2", moved.ExperienceID)
+		assert.Equal(t, "bullet-1", bundle.Experiences[1].Bullets[0].ID)
+
+		created := bundle.Experiences[2]
+		assert.NotEmpty(t, created.ID)
+		assert.Equal(t, userID, created.UserID)
+		assert.Equal(t, 2, created.DisplayOrder)
+		assert.Equal(t, created.ID, created.Bullets[0].ExperienceID)
+		assert.NotNil(t, created.Bullets[0].Keywords)
+
+		assert.NotEqual(t, "unknown", bundle.Skills[0].ID)
+		assert.Equal(t, userID, bundle.Skills[0].UserID)
+	})
+
+	t.Run("reports fields by path", func(t *testing.T) {
+		invalid := experience("exp-1", domain.Bullet{Content: "Fine"}, domain.Bullet{})
+		invalid.Title = ""
+		bundle := &services.ProfileBundle{
+			Version:     services.ProfileBundleVersion,
+			Experiences: []domain.Experience{invalid, experience("exp-1")},
+			Skills:      []domain.Skill{{Name: ""}},
+		}
+
+		_, err := services.PlanProfileBundle(userID, current, bundle)
+
+		var validation *domain.ValidationErrors
+		require.ErrorAs(t, err, &validation)
+		var fields []string
+		for _, fieldErr := range validation.Errors {
+			fields = append(fields, fieldErr.Field)
+		}
+		assert.Equal(t, []string{
+			"experiences[0].title",
+			"experiences[0].bullets[1].content",
+			"experiences[1].id",
+			"skills[0].name",
+		}, fields)
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/weburl"
 )
 
 // ProjectService handles project-related use cases.
@@ -42,6 +43,13 @@ type CreateProjectRequest struct {
 
 // CreateProject creates a new project for a user.
 func (s *ProjectService) CreateProject(ctx context.Context, req CreateProjectRequest) (*domain.Project, error) {
+	v := &domain.ValidationErrors{}
+	req.URL = normalizeURL(v, "url", req.URL, weburl.Normalize)
+	req.RepositoryURL = normalizeURL(v, "repository_url", req.RepositoryURL, weburl.Normalize)
+	if err := v.ToError(); err != nil {
+		return nil, err
+	}
+
 	// Create project.
 	project, err := domain.NewProject(req.UserID, req.Name, req.TechStack)
 	if err != nil {
@@ -98,9 +106,9 @@ func (s *ProjectService) CreateProject(ctx context.Context, req CreateProjectReq
 	return project, nil
 }
 
-// GetProject retrieves a project by ID.
-func (s *ProjectService) GetProject(ctx context.Context, projectID string) (*domain.Project, error) {
-	project, err := s.projectRepo.GetByIDWithBullets(ctx, projectID)
+// GetProject retrieves a user's project by ID.
+func (s *ProjectService) GetProject(ctx context.Context, projectID, userID string) (*domain.Project, error) {
+	project, err := s.projectRepo.GetByIDWithBulletsForUser(ctx, projectID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
@@ -124,6 +132,7 @@ func (s *ProjectService) ListProjects(ctx context.Context, req ListProjectsReque
 // UpdateProjectRequest contains parameters for updating a project.
 type UpdateProjectRequest struct {
 	ProjectID     string
+	UserID        string
 	Name          *string
 	Description   *string
 	TechStack     []string
@@ -136,8 +145,15 @@ type UpdateProjectRequest struct {
 
 // UpdateProject updates an existing project.
 func (s *ProjectService) UpdateProject(ctx context.Context, req UpdateProjectRequest) (*domain.Project, error) {
+	v := &domain.ValidationErrors{}
+	req.URL = normalizeURL(v, "url", req.URL, weburl.Normalize)
+	req.RepositoryURL = normalizeURL(v, "repository_url", req.RepositoryURL, weburl.Normalize)
+	if err := v.ToError(); err != nil {
+		return nil, err
+	}
+
 	// Get existing.
-	project, err := s.projectRepo.GetByID(ctx, req.ProjectID)
+	project, err := s.projectRepo.GetByIDForUser(ctx, req.ProjectID, req.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -194,12 +210,12 @@ func (s *ProjectService) UpdateProject(ctx context.Context, req UpdateProjectReq
 	}
 
 	// Reload with bullets.
-	return s.projectRepo.GetByIDWithBullets(ctx, project.ID)
+	return s.projectRepo.GetByIDWithBulletsForUser(ctx, project.ID, req.UserID)
 }
 
-// DeleteProject removes a project and all its bullets.
-func (s *ProjectService) DeleteProject(ctx context.Context, projectID string) error {
-	if err := s.projectRepo.Delete(ctx, projectID); err != nil {
+// DeleteProject removes a user's project and all its bullets.
+func (s *ProjectService) DeleteProject(ctx context.Context, projectID, userID string) error {
+	if err := s.projectRepo.Delete(ctx, projectID, userID); err != nil {
 		return fmt.Errorf("failed to delete project: %w", err)
 	}
 	return nil
@@ -207,12 +223,13 @@ func (s *ProjectService) DeleteProject(ctx context.Context, projectID string) er
 
 // UpdateProjectOrderRequest contains parameters for updating project display order.
 type UpdateProjectOrderRequest struct {
+	UserID string
 	Orders []ports.DisplayOrderUpdate
 }
 
-// UpdateProjectOrder updates the display order of projects.
+// UpdateProjectOrder updates the display order of a user's projects.
 func (s *ProjectService) UpdateProjectOrder(ctx context.Context, req UpdateProjectOrderRequest) error {
-	if err := s.projectRepo.UpdateDisplayOrder(ctx, req.Orders); err != nil {
+	if err := s.projectRepo.UpdateDisplayOrder(ctx, req.UserID, req.Orders); err != nil {
 		return fmt.Errorf("failed to update project order: %w", err)
 	}
 	return nil
@@ -221,14 +238,15 @@ func (s *ProjectService) UpdateProjectOrder(ctx context.Context, req UpdateProje
 // AddProjectBulletRequest contains parameters for adding a bullet to a project.
 type AddProjectBulletRequest struct {
 	ProjectID    string
+	UserID       string
 	Content      string
 	DisplayOrder int
 }
 
 // AddProjectBullet adds a bullet to a project.
 func (s *ProjectService) AddProjectBullet(ctx context.Context, req AddProjectBulletRequest) (*domain.ProjectBullet, error) {
-	// Verify project exists.
-	_, err := s.projectRepo.GetByID(ctx, req.ProjectID)
+	// Verify the project exists and belongs to the user.
+	_, err := s.projectRepo.GetByIDForUser(ctx, req.ProjectID, req.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -251,6 +269,7 @@ func (s *ProjectService) AddProjectBullet(ctx context.Context, req AddProjectBul
 // UpdateProjectBulletRequest contains parameters for updating a project bullet.
 type UpdateProjectBulletRequest struct {
 	BulletID     string
+	UserID       string
 	Content      *string
 	DisplayOrder *int
 }
@@ -258,7 +277,7 @@ type UpdateProjectBulletRequest struct {
 // UpdateProjectBullet updates an existing project bullet.
 func (s *ProjectService) UpdateProjectBullet(ctx context.Context, req UpdateProjectBulletRequest) (*domain.ProjectBullet, error) {
 	// Get existing.
-	bullet, err := s.projectBulletRepo.GetByID(ctx, req.BulletID)
+	bullet, err := s.projectBulletRepo.GetByIDForUser(ctx, req.BulletID, req.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -280,9 +299,9 @@ func (s *ProjectService) UpdateProjectBullet(ctx context.Context, req UpdateProj
 	return bullet, nil
 }
 
-// DeleteProjectBullet removes a project bullet.
-func (s *ProjectService) DeleteProjectBullet(ctx context.Context, bulletID string) error {
-	if err := s.projectBulletRepo.Delete(ctx, bulletID); err != nil {
+// DeleteProjectBullet removes a bullet of a user's project.
+func (s *ProjectService) DeleteProjectBullet(ctx context.Context, bulletID, userID string) error {
+	if err := s.projectBulletRepo.Delete(ctx, bulletID, userID); err != nil {
 		return fmt.Errorf("failed to delete project bullet: %w", err)
 	}
 	return nil
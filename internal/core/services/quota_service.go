@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// quotaActions lists the metered actions in the order they are reported.
+var quotaActions = []domain.UsageAction{
+	domain.UsageResumeCreated,
+	domain.UsageTailor,
+	domain.UsagePDFRegeneration,
+}
+
+// QuotaService enforces per-plan usage limits.
+type QuotaService struct {
+	userRepo  ports.UserRepository
+	usageRepo ports.UsageRepository
+	limits    map[domain.Plan]domain.PlanLimits
+	now       func() time.Time
+}
+
+// NewQuotaService creates a new QuotaService. Plans missing from limits
+// fall back to domain.DefaultPlanLimits.
+func NewQuotaService(userRepo ports.UserRepository, usageRepo ports.UsageRepository, limits map[domain.Plan]domain.PlanLimits) *QuotaService {
+	merged := domain.DefaultPlanLimits()
+	for plan, l := range limits {
+		merged[plan] = l
+	}
+
+	return &QuotaService{
+		userRepo:  userRepo,
+		usageRepo: usageRepo,
+		limits:    merged,
+		now:       time.Now,
+	}
+}
+
+// Quota describes a user's usage of one metered action in the current period.
+type Quota struct {
+	Action domain.UsageAction
+	Period domain.QuotaPeriod
+
+	// Limit is zero when the action is unlimited; Remaining is then -1.
+	Limit     int
+	Used      int
+	Remaining int
+	ResetsAt  time.Time
+}
+
+// UserLimits contains a user's plan and the state of each quota.
+type UserLimits struct {
+	Plan   domain.Plan
+	Quotas []Quota
+}
+
+// GetLimits returns the user's plan and remaining quota for every metered action.
+func (s *QuotaService) GetLimits(ctx context.Context, userID string) (*UserLimits, error) {
+	limits, plan, err := s.planLimits(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UserLimits{Plan: plan, Quotas: make([]Quota, 0, len(quotaActions))}
+	for _, action := range quotaActions {
+		quota, err := s.quota(ctx, userID, action, limits)
+		if err != nil {
+			return nil, err
+		}
+		result.Quotas = append(result.Quotas, *quota)
+	}
+
+	return result, nil
+}
+
+// Check returns a *domain.QuotaExceededError if the user has no quota left
+// for the action.
+func (s *QuotaService) Check(ctx context.Context, userID string, action domain.UsageAction) error {
+	limits, _, err := s.planLimits(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	quota, err := s.quota(ctx, userID, action, limits)
+	if err != nil {
+		return err
+	}
+
+	if quota.Limit > 0 && quota.Remaining <= 0 {
+		return &domain.QuotaExceededError{
+			Action:   action,
+			Limit:    quota.Limit,
+			Period:   quota.Period,
+			ResetsAt: quota.ResetsAt,
+		}
+	}
+
+	return nil
+}
+
+// GetQuota returns the user's quota for one action.
+func (s *QuotaService) GetQuota(ctx context.Context, userID string, action domain.UsageAction) (*Quota, error) {
+	limits, _, err := s.planLimits(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.quota(ctx, userID, action, limits)
+}
+
+// Record counts one occurrence of the action against the user's quota.
+func (s *QuotaService) Record(ctx context.Context, userID string, action domain.UsageAction) error {
+	if err := s.usageRepo.Record(ctx, userID, action); err != nil {
+		return fmt.Errorf("failed to record usage: %w", err)
+	}
+	return nil
+}
+
+// planLimits returns the limits of the user's plan.
+func (s *QuotaService) planLimits(ctx context.Context, userID string) (domain.PlanLimits, domain.Plan, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return domain.PlanLimits{}, "", fmt.Errorf("failed to get user: %w", err)
+	}
+
+	plan := user.Plan
+	if !plan.IsValid() {
+		plan = domain.PlanFree
+	}
+
+	return s.limits[plan], plan, nil
+}
+
+// quota computes the user's usage of an action in the current period.
+func (s *QuotaService) quota(ctx context.Context, userID string, action domain.UsageAction, limits domain.PlanLimits) (*Quota, error) {
+	limit, period := limits.Limit(action)
+	now := s.now()
+
+	used, err := s.usageRepo.CountSince(ctx, userID, action, period.Start(now))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count usage: %w", err)
+	}
+
+	remaining := -1
+	if limit > 0 {
+		remaining = max(limit-used, 0)
+	}
+
+	return &Quota{
+		Action:    action,
+		Period:    period,
+		Limit:     limit,
+		Used:      used,
+		Remaining: remaining,
+		ResetsAt:  period.End(now),
+	}, nil
+}
+
+// SetQuotas enables plan limits on resume creation, tailoring and PDF
+// generation. Without it these actions are unlimited.
+func (s *ResumeService) SetQuotas(quotas *QuotaService) {
+	s.quotas = quotas
+}
+
+// checkQuota returns an error if the user has no quota left for the action.
+func (s *ResumeService) checkQuota(ctx context.Context, userID string, action domain.UsageAction) error {
+	if s.quotas == nil {
+		return nil
+	}
+	return s.quotas.Check(ctx, userID, action)
+}
+
+// recordUsage counts a completed action. A failure to record is ignored
+// because the action itself already succeeded.
+func (s *ResumeService) recordUsage(ctx context.Context, userID string, action domain.UsageAction) {
+	if s.quotas == nil {
+		return
+	}
+	_ = s.quotas.Record(ctx, userID, action)
+}
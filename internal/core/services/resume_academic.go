@@ -0,0 +1,327 @@
+package services
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// SetAcademicEntries enables the academic sections of the academic CV
+// template; without it, academic CVs only show the resume's other sections.
+func (s *ResumeService) SetAcademicEntries(repo ports.AcademicEntryRepository) {
+	s.academicRepo = repo
+}
+
+// AcademicCVTemplate renders an academic CV: publications first, then
+// grants, teaching, experience and service, in a serif typeface. Unlike a
+// resume it has no page limit, so entries flow onto as many pages as they
+// need without being split.
+type AcademicCVTemplate struct{}
+
+// academicLayout is the comfortable layout of the academic CV.
+var academicLayout = densityLayout{
+	lineHeight: 1.4,
+	marginY:    0.75,
+	marginX:    0.9,
+	sectionGap: 12,
+	entryGap:   6,
+	bulletGap:  1,
+}
+
+// NewAcademicCVTemplate creates a new academic CV template.
+func NewAcademicCVTemplate() *AcademicCVTemplate {
+	return &AcademicCVTemplate{}
+}
+
+// Render generates the HTML for the CV.
+func (t *AcademicCVTemplate) Render(data ResumeTemplateData) string {
+	if data.FontSize == 0 {
+		data.FontSize = 11
+	}
+	i18n := data.i18n()
+
+	var sb strings.Builder
+	sb.WriteString(t.renderHead(data))
+	sb.WriteString(`<body><div class="cv">`)
+
+	if data.User != nil {
+		sb.WriteString(t.renderHeader(data.User, data.Resume, data.Locale, data.qrCode("cv-qr")))
+	}
+
+	if summary := data.summary(); data.ShowSummary && summary != "" {
+		sb.WriteString(t.section(i18n.T(KeyProfessionalSummary),
+			`<p class="cv-summary">`+renderMarkdownBold(summary)+`</p>`))
+	}
+
+	if len(data.Education) > 0 {
+		var body strings.Builder
+		for _, edu := range data.Education {
+			degree := edu.Degree
+			if edu.FieldOfStudy != nil && *edu.FieldOfStudy != "" {
+				degree += ", " + *edu.FieldOfStudy
+			}
+			org := edu.Institution
+			if edu.Location != nil && *edu.Location != "" {
+				org += ", " + *edu.Location
+			}
+			body.WriteString(t.entry(
+				formatEducationDateRangeLocalized(edu.StartDate, edu.EndDate, edu.IsExpected, i18n),
+				`<span class="cv-title">`+html.EscapeString(degree)+`</span>`,
+				html.EscapeString(org),
+				edu.Honors,
+			))
+		}
+		sb.WriteString(t.section(i18n.T(KeyEducation), body.String()))
+	}
+
+	sections := make(map[domain.AcademicSection][]domain.AcademicEntry)
+	for _, entry := range data.Academic {
+		sections[entry.Section] = append(sections[entry.Section], entry)
+	}
+
+	if pubs := sections[domain.AcademicPublications]; len(pubs) > 0 {
+		sb.WriteString(t.section(i18n.T(KeyPublications), t.renderPublications(pubs, data.User)))
+	}
+	sb.WriteString(t.renderAcademicSection(sections[domain.AcademicGrants], i18n.T(KeyGrants), i18n))
+	sb.WriteString(t.renderAcademicSection(sections[domain.AcademicTeaching], i18n.T(KeyTeaching), i18n))
+
+	if content := data.Resume.GeneratedContent; content != nil && len(content.Experiences) > 0 {
+		var body strings.Builder
+		for _, exp := range content.Experiences {
+			body.WriteString(t.entry(
+				formatExperienceDateRangeLocalized(exp.StartDate, exp.EndDate, exp.IsCurrent, i18n),
+				`<span class="cv-title">`+html.EscapeString(exp.Title)+`</span>`,
+				html.EscapeString(exp.Organization),
+				tailoredBulletTexts(exp.Bullets),
+			))
+		}
+		sb.WriteString(t.section(i18n.T(KeyExperience), body.String()))
+	}
+
+	sb.WriteString(t.renderAcademicSection(sections[domain.AcademicService], i18n.T(KeyService), i18n))
+
+	if content := data.Resume.GeneratedContent; content != nil && len(content.Skills) > 0 {
+		var body strings.Builder
+		for _, group := range groupSkillsByCategory(content.Skills, data.Skills) {
+			fmt.Fprintf(&body, `<p class="cv-skills"><span class="cv-title">%s:</span> %s</p>`,
+				html.EscapeString(group.Category),
+				html.EscapeString(strings.Join(group.labels(data.Resume.Sections.SkillsDisplay, i18n), ", ")))
+		}
+		sb.WriteString(t.section(i18n.T(KeyTechnicalSkills), body.String()))
+	}
+
+	if len(data.Languages) > 0 {
+		items := make([]string, 0, len(data.Languages))
+		for _, lang := range data.Languages {
+			items = append(items, fmt.Sprintf(`%s (%s)`, html.EscapeString(lang.Language),
+				html.EscapeString(i18n.FormatProficiencyLevel(string(lang.Proficiency)))))
+		}
+		sb.WriteString(t.section(i18n.T(KeyLanguages), `<p>`+strings.Join(items, "; ")+`</p>`))
+	}
+
+	sb.WriteString(`</div></body></html>`)
+	return sb.String()
+}
+
+// renderHead generates the HTML head with the academic CV CSS.
+func (t *AcademicCVTemplate) renderHead(data ResumeTemplateData) string {
+	userName := "CV"
+	if data.User != nil {
+		userName = data.User.GetDisplayName()
+	}
+
+	lang := "en"
+	if data.Resume != nil {
+		lang = data.Resume.TargetLanguage
+	}
+
+	return fmt.Sprintf(`<!DOCTYPE html>
+<html lang="%s">
+<head>
+    <meta charset="UTF-8">
+    <title>Curriculum Vitae - %s</title>
+    <style>
+        %s
+        *, *::before, *::after { box-sizing: border-box; margin: 0; padding: 0; }
+        body { font-family: Georgia, "Times New Roman", Times, serif; font-size: %dpt; line-height: var(--line-height); color: #111; background: #fff; }
+        .cv { max-width: 8.5in; margin: 0 auto; padding: var(--page-margin); }
+        .cv-header { text-align: center; margin-bottom: var(--section-gap); }
+        .cv-header.has-qr { position: relative; min-height: 0.8in; }
+        .cv-qr { position: absolute; top: 0; right: 0; }
+        .cv-qr img { display: block; width: 0.7in; height: 0.7in; }
+        .cv-name { font-size: calc(20pt * var(--heading-scale)); font-weight: normal; letter-spacing: 1pt; }
+        .cv-headline { font-style: italic; margin-top: 2pt; }
+        .cv-contact { font-size: 9.5pt; margin-top: 3pt; }
+        .cv-contact a { color: inherit; text-decoration: none; }
+        .cv-section { margin-bottom: var(--section-gap); }
+        .cv-section-title { font-variant: small-caps; font-weight: normal; font-size: calc(13pt * var(--heading-scale)); letter-spacing: 0.5pt; border-bottom: 0.5pt solid #111; margin-bottom: 4pt; break-after: avoid; page-break-after: avoid; }
+        .cv-entry { display: flex; gap: 12pt; margin-bottom: var(--entry-gap); break-inside: avoid; page-break-inside: avoid; }
+        .cv-date { flex: 0 0 1.25in; font-size: 9.5pt; color: #444; }
+        .cv-detail { flex: 1; }
+        .cv-title { font-weight: bold; }
+        .cv-org { font-style: italic; }
+        .cv-detail ul { margin: 2pt 0 0 14pt; }
+        .cv-detail li { margin-bottom: var(--bullet-gap); }
+        .cv-publications { margin-left: 18pt; }
+        .cv-publications li { margin-bottom: var(--entry-gap); break-inside: avoid; page-break-inside: avoid; }
+        .cv-self { font-weight: bold; }
+        .cv-venue { font-style: italic; }
+        .cv-link { font-size: 9.5pt; color: #1a4f8b; word-break: break-all; }
+        .cv-summary, .cv-skills { text-align: justify; margin-bottom: 2pt; }
+        @page { size: letter; margin: 0; }
+        @media print { body { -webkit-print-color-adjust: exact; print-color-adjust: exact; } }
+    </style>
+</head>
+`, lang, html.EscapeString(userName), academicLayout.cssVariables(data.Density), data.FontSize)
+}
+
+// renderHeader renders the name, headline and contacts, with the QR code,
+// if any, in the top right corner.
+func (t *AcademicCVTemplate) renderHeader(user *domain.User, resume *domain.Resume, locale Locale, qrCode string) string {
+	var sb strings.Builder
+	if qrCode != "" {
+		sb.WriteString(`<header class="cv-header has-qr">`)
+		sb.WriteString(qrCode)
+	} else {
+		sb.WriteString(`<header class="cv-header">`)
+	}
+	fmt.Fprintf(&sb, `<h1 class="cv-name">%s</h1>`, html.EscapeString(user.GetDisplayName()))
+	if user.Headline != nil && *user.Headline != "" {
+		fmt.Fprintf(&sb, `<div class="cv-headline">%s</div>`, html.EscapeString(*user.Headline))
+	}
+
+	var contacts []string
+	for _, c := range resumeContacts(user, resume, locale, europassContactText) {
+		switch c.Field {
+		case domain.ContactPhone:
+			contacts = append(contacts, html.EscapeString(c.Text))
+		case domain.ContactEmail:
+			contacts = append(contacts, fmt.Sprintf(`<a href="mailto:%s">%s</a>`,
+				html.EscapeString(c.Value), html.EscapeString(c.Text)))
+		default:
+			contacts = append(contacts, fmt.Sprintf(`<a href="%s">%s</a>`,
+				html.EscapeString(c.Value), html.EscapeString(c.Text)))
+		}
+	}
+	if len(contacts) > 0 {
+		fmt.Fprintf(&sb, `<div class="cv-contact">%s</div>`, strings.Join(contacts, " · "))
+	}
+
+	sb.WriteString(`</header>`)
+	return sb.String()
+}
+
+// section renders a titled section.
+func (t *AcademicCVTemplate) section(title, body string) string {
+	return fmt.Sprintf(`<section class="cv-section"><h2 class="cv-section-title">%s</h2>%s</section>`,
+		html.EscapeString(title), body)
+}
+
+// entry renders a dated entry: the date on the left, and the title, the
+// organization and any bullets on the right. title and org are HTML.
+func (t *AcademicCVTemplate) entry(date, title, org string, bullets []string) string {
+	var sb strings.Builder
+	sb.WriteString(`<div class="cv-entry">`)
+	fmt.Fprintf(&sb, `<div class="cv-date">%s</div>`, html.EscapeString(date))
+	sb.WriteString(`<div class="cv-detail">`)
+	sb.WriteString(title)
+	if org != "" {
+		fmt.Fprintf(&sb, `, <span class="cv-org">%s</span>`, org)
+	}
+	if len(bullets) > 0 {
+		sb.WriteString(`<ul>`)
+		for _, b := range bullets {
+			fmt.Fprintf(&sb, `<li>%s</li>`, renderMarkdownBold(b))
+		}
+		sb.WriteString(`</ul>`)
+	}
+	sb.WriteString(`</div></div>`)
+	return sb.String()
+}
+
+// renderPublications renders publications as a numbered bibliography:
+// authors, year, title, venue and link. The user's name is set in bold among
+// the authors.
+func (t *AcademicCVTemplate) renderPublications(pubs []domain.AcademicEntry, user *domain.User) string {
+	self := ""
+	if user != nil {
+		self = user.GetDisplayName()
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<ol class="cv-publications">`)
+	for _, pub := range pubs {
+		sb.WriteString(`<li>`)
+		if len(pub.Authors) > 0 {
+			authors := make([]string, 0, len(pub.Authors))
+			for _, author := range pub.Authors {
+				if self != "" && strings.EqualFold(strings.TrimSpace(author), self) {
+					authors = append(authors, `<span class="cv-self">`+html.EscapeString(author)+`</span>`)
+				} else {
+					authors = append(authors, html.EscapeString(author))
+				}
+			}
+			sb.WriteString(strings.Join(authors, ", "))
+		}
+		if year := academicYear(pub); year != "" {
+			fmt.Fprintf(&sb, ` (%s)`, year)
+		}
+		if len(pub.Authors) > 0 || academicYear(pub) != "" {
+			sb.WriteString(`. `)
+		}
+		fmt.Fprintf(&sb, `%s.`, html.EscapeString(strings.TrimSuffix(pub.Title, ".")))
+		if pub.Organization != nil {
+			fmt.Fprintf(&sb, ` <span class="cv-venue">%s</span>.`, html.EscapeString(*pub.Organization))
+		}
+		if pub.URL != nil {
+			fmt.Fprintf(&sb, ` <a class="cv-link" href="%s">%s</a>`, html.EscapeString(*pub.URL), html.EscapeString(*pub.URL))
+		}
+		sb.WriteString(`</li>`)
+	}
+	sb.WriteString(`</ol>`)
+	return sb.String()
+}
+
+// renderAcademicSection renders grants, teaching or service entries as dated
+// entries, or nothing when there are none. Grant amounts follow the funder.
+func (t *AcademicCVTemplate) renderAcademicSection(entries []domain.AcademicEntry, title string, i18n *I18n) string {
+	if len(entries) == 0 {
+		return ""
+	}
+
+	var body strings.Builder
+	for _, entry := range entries {
+		var org []string
+		if entry.Organization != nil {
+			org = append(org, html.EscapeString(*entry.Organization))
+		}
+		if entry.Amount != nil {
+			org = append(org, html.EscapeString(*entry.Amount))
+		}
+		var bullets []string
+		if entry.Description != nil {
+			bullets = append(bullets, *entry.Description)
+		}
+		body.WriteString(t.entry(
+			formatEducationDateRangeLocalized(entry.StartDate, entry.EndDate, false, i18n),
+			`<span class="cv-title">`+html.EscapeString(entry.Title)+`</span>`,
+			strings.Join(org, " · "),
+			bullets,
+		))
+	}
+	return t.section(title, body.String())
+}
+
+// academicYear returns the year of a publication: that of its end date, else
+// its start date, or "" when it has neither.
+func academicYear(entry domain.AcademicEntry) string {
+	for _, d := range []*domain.Date{entry.EndDate, entry.StartDate} {
+		if d != nil && !d.IsZero() {
+			return fmt.Sprintf("%d", d.Year())
+		}
+	}
+	return ""
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// MaxBulkResumes is the most resumes a bulk action may cover.
+const MaxBulkResumes = 100
+
+// BulkResumeAction is an action applied to many resumes at once.
+type BulkResumeAction string
+
+// Bulk resume actions.
+const (
+	BulkResumeDelete       BulkResumeAction = "delete"
+	BulkResumeArchive      BulkResumeAction = "archive"
+	BulkResumeUnarchive    BulkResumeAction = "unarchive"
+	BulkResumeChangeStatus BulkResumeAction = "change_status"
+	BulkResumeAddTag       BulkResumeAction = "add_tag"
+)
+
+// IsValid checks if the action is known.
+func (a BulkResumeAction) IsValid() bool {
+	switch a {
+	case BulkResumeDelete, BulkResumeArchive, BulkResumeUnarchive, BulkResumeChangeStatus, BulkResumeAddTag:
+		return true
+	}
+	return false
+}
+
+// SetResumeTags enables tagging resumes with bulk actions.
+func (s *ResumeService) SetResumeTags(tagRepo ports.ResumeTagRepository) {
+	s.tagRepo = tagRepo
+}
+
+// BulkResumesRequest applies an action to many of a user's resumes.
+type BulkResumesRequest struct {
+	UserID    string
+	Action    string
+	ResumeIDs []string
+
+	// Status is the status to move the resumes to, for change_status.
+	Status string
+
+	// TagID is the tag to add to the resumes, for add_tag.
+	TagID string
+
+	// Transactional applies the action to every resume or to none: when it
+	// fails for one resume, the others are left unchanged.
+	Transactional bool
+}
+
+// BulkResumeResult is the outcome of a bulk action for one resume.
+type BulkResumeResult struct {
+	ResumeID string
+
+	// Err is why the action was not applied to the resume, nil when it was;
+	// domain.ErrBulkActionAborted when a transactional action failed for
+	// another resume.
+	Err error
+}
+
+// BulkResumesResponse contains the outcome of a bulk action, in the order of
+// the requested resumes.
+type BulkResumesResponse struct {
+	Results []BulkResumeResult
+
+	// Applied is the number of resumes the action was applied to.
+	Applied int
+}
+
+// BulkResumes applies an action to many resumes with one call, reporting the
+// outcome for each. Resumes that are not the user's, or whose status cannot
+// move to the requested one, fail on their own unless the request is
+// transactional, in which case nothing is changed.
+func (s *ResumeService) BulkResumes(ctx context.Context, req BulkResumesRequest) (*BulkResumesResponse, error) {
+	action, status, err := s.validateBulkResumes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	owners, err := s.resumeRepo.OwnersByID(ctx, req.ResumeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check resume owners: %w", err)
+	}
+
+	results := make([]BulkResumeResult, len(req.ResumeIDs))
+	resumes := make([]*domain.Resume, len(req.ResumeIDs))
+	previous := make([]domain.ResumeStatus, len(req.ResumeIDs))
+	failed := false
+	for i, id := range req.ResumeIDs {
+		results[i].ResumeID = id
+		if owners[id] != req.UserID {
+			results[i].Err = domain.ErrResumeNotFound
+			failed = true
+			continue
+		}
+
+		resume, err := s.resumeRepo.GetByIDForUser(ctx, id, req.UserID)
+		if err != nil {
+			if !errors.Is(err, domain.ErrResumeNotFound) {
+				return nil, fmt.Errorf("failed to get resume: %w", err)
+			}
+			results[i].Err = err
+			failed = true
+			continue
+		}
+		previous[i] = resume.Status
+
+		switch action {
+		case BulkResumeArchive:
+			resume.Archive()
+		case BulkResumeUnarchive:
+			resume.Unarchive()
+		case BulkResumeChangeStatus:
+			if err := resume.TransitionStatus(status); err != nil {
+				results[i].Err = err
+				failed = true
+				continue
+			}
+		}
+		resumes[i] = resume
+	}
+
+	resp := &BulkResumesResponse{Results: results}
+	if failed && req.Transactional {
+		for i := range results {
+			if results[i].Err == nil {
+				results[i].Err = domain.ErrBulkActionAborted
+			}
+		}
+		return resp, nil
+	}
+
+	if req.Transactional {
+		if err := s.applyBulkResumesAtomically(ctx, req, action, resumes, previous); err != nil {
+			return nil, err
+		}
+	} else {
+		s.applyBulkResumes(ctx, req, action, resumes, previous, results)
+	}
+
+	for i, result := range results {
+		if result.Err != nil {
+			continue
+		}
+		resp.Applied++
+		switch action {
+		case BulkResumeDelete:
+			s.deletePDF(ctx, resumes[i])
+		case BulkResumeChangeStatus:
+			s.recordStatusChange(ctx, resumes[i], previous[i])
+		}
+	}
+
+	return resp, nil
+}
+
+// validateBulkResumes checks a bulk request and returns its action and, for
+// change_status, the status to move to.
+func (s *ResumeService) validateBulkResumes(ctx context.Context, req BulkResumesRequest) (BulkResumeAction, domain.ResumeStatus, error) {
+	action := BulkResumeAction(req.Action)
+	var status domain.ResumeStatus
+
+	v := &domain.ValidationErrors{}
+	switch {
+	case !action.IsValid():
+		v.AddFieldError("action", "must be 'delete', 'archive', 'unarchive', 'change_status' or 'add_tag'")
+	case action == BulkResumeChangeStatus:
+		parsed, err := domain.ParseResumeStatus(req.Status)
+		if err != nil {
+			v.AddFieldError("status", "a valid status is required for change_status")
+		}
+		status = parsed
+	case action == BulkResumeAddTag:
+		if s.tagRepo == nil {
+			v.AddFieldError("action", "add_tag is not available")
+		} else if req.TagID == "" {
+			v.AddFieldError("tag_id", "tag ID is required for add_tag")
+		}
+	}
+
+	switch {
+	case len(req.ResumeIDs) == 0:
+		v.AddFieldError("resume_ids", "at least one resume is required")
+	case len(req.ResumeIDs) > MaxBulkResumes:
+		v.AddFieldError("resume_ids", fmt.Sprintf("must have at most %d resumes", MaxBulkResumes))
+	}
+	seen := make(map[string]bool, len(req.ResumeIDs))
+	for i, id := range req.ResumeIDs {
+		if seen[id] {
+			v.AddFieldError(fmt.Sprintf("resume_ids[%d]", i), fmt.Sprintf("resume %q is listed twice", id))
+		}
+		seen[id] = true
+	}
+	if err := v.ToError(); err != nil {
+		return action, status, err
+	}
+
+	if action == BulkResumeAddTag {
+		if _, err := s.tagRepo.GetByIDForUser(ctx, req.TagID, req.UserID); err != nil {
+			if errors.Is(err, domain.ErrResumeTagNotFound) {
+				return action, status, domain.ErrResumeTagNotFound
+			}
+			return action, status, fmt.Errorf("failed to get resume tag: %w", err)
+		}
+	}
+
+	return action, status, nil
+}
+
+// applyBulkResumesAtomically applies a bulk action to all the resumes in a
+// single transaction.
+func (s *ResumeService) applyBulkResumesAtomically(ctx context.Context, req BulkResumesRequest, action BulkResumeAction, resumes []*domain.Resume, previous []domain.ResumeStatus) error {
+	switch action {
+	case BulkResumeDelete:
+		if err := s.resumeRepo.DeleteMany(ctx, req.ResumeIDs, req.UserID); err != nil {
+			return fmt.Errorf("failed to delete resumes: %w", err)
+		}
+	case BulkResumeAddTag:
+		if err := s.tagRepo.Assign(ctx, req.TagID, req.ResumeIDs, nil); err != nil {
+			return fmt.Errorf("failed to assign resume tag: %w", err)
+		}
+	default:
+		var events []domain.OutboxEvent
+		if s.outbox {
+			for i, resume := range resumes {
+				events = append(events, statusChangeEvents(resume, previous[i])...)
+			}
+		}
+		if err := s.resumeRepo.UpdateMany(ctx, resumes, events); err != nil {
+			return fmt.Errorf("failed to update resumes: %w", err)
+		}
+	}
+	return nil
+}
+
+// applyBulkResumes applies a bulk action to each resume that has not failed
+// yet, recording the resumes it fails for in results.
+func (s *ResumeService) applyBulkResumes(ctx context.Context, req BulkResumesRequest, action BulkResumeAction, resumes []*domain.Resume, previous []domain.ResumeStatus, results []BulkResumeResult) {
+	for i, resume := range resumes {
+		if results[i].Err != nil {
+			continue
+		}
+
+		var err error
+		switch action {
+		case BulkResumeDelete:
+			err = s.resumeRepo.Delete(ctx, resume.ID, req.UserID)
+		case BulkResumeAddTag:
+			err = s.tagRepo.Assign(ctx, req.TagID, []string{resume.ID}, nil)
+		default:
+			err = s.updateResume(ctx, resume, statusChangeEvents(resume, previous[i])...)
+		}
+		results[i].Err = err
+	}
+}
+
+// deletePDF removes the stored PDF of a resume, if it has one. Storage
+// errors are ignored.
+func (s *ResumeService) deletePDF(ctx context.Context, resume *domain.Resume) {
+	if resume.PDFURL == nil {
+		return
+	}
+	filename := fmt.Sprintf("resumes/%s/%s.pdf", resume.UserID, resume.ID)
+	_ = s.fileStorage.Delete(ctx, filename)
+}
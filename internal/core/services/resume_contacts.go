@@ -0,0 +1,146 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/pkg/phonenumber"
+)
+
+// Contact line metrics of the Jake template, in points. The line is set in
+// 9pt Arial, whose characters average about 0.5em wide; the estimate rounds
+// up so lines that are measured to fit do fit.
+const (
+	contactCharWidth      = 5.0
+	contactSeparatorWidth = 15.0
+
+	// contactLineWidth is the width of a letter page inside the margins.
+	contactLineWidth = 554.0
+
+	// contactQRCodeWidth is the width the header padding around a QR code
+	// takes from the contact line.
+	contactQRCodeWidth = 115.0
+
+	// maxContactLines is the number of lines contacts may wrap to before
+	// the lowest priority ones are dropped.
+	maxContactLines = 2
+)
+
+// headerContact is a contact detail as shown in a resume header.
+type headerContact struct {
+	Field domain.ContactField
+
+	// Value is the user's value, such as a phone number or URL.
+	Value string
+
+	// Text is the text shown for it.
+	Text string
+}
+
+// resumeContacts returns the user's contact details in the resume's contact
+// order, skipping those the user has not set. display returns the text shown
+// for each one.
+func resumeContacts(user *domain.User, resume *domain.Resume, locale Locale, display func(domain.ContactField, string) string) []headerContact {
+	order := domain.DefaultContactOrder
+	if resume != nil {
+		order = resume.ContactOrder()
+	}
+
+	contacts := make([]headerContact, 0, len(order))
+	for _, field := range order {
+		value := field.Value(user)
+		if value == "" {
+			continue
+		}
+		text := display(field, value)
+		if field == domain.ContactPhone {
+			text = displayPhone(value, locale)
+		}
+		contacts = append(contacts, headerContact{Field: field, Value: value, Text: text})
+	}
+	return contacts
+}
+
+// displayPhone formats a phone number for the readers of a resume in locale:
+// in the national format when the number is from the locale's region, such
+// as (11) 99999-9999 on a Brazilian Portuguese resume, and in the
+// international format otherwise. Numbers that do not parse, such as
+// those saved before numbers were checked, and numbers of regions without
+// numbering rules are shown as entered.
+func displayPhone(value string, locale Locale) string {
+	number, err := phonenumber.Parse(value, "")
+	if err != nil || number.Region == "" {
+		return value
+	}
+	return number.Format(locale.Region())
+}
+
+// parseContactPriority validates a contact priority order. Each field may
+// appear once; fields left out keep their default relative order.
+func parseContactPriority(fields []string) ([]domain.ContactField, error) {
+	v := &domain.ValidationErrors{}
+	priority := make([]domain.ContactField, 0, len(fields))
+	seen := make(map[domain.ContactField]bool, len(fields))
+
+	for _, value := range fields {
+		field := domain.ContactField(value)
+		switch {
+		case !field.IsValid():
+			v.AddFieldError("contact_priority", fmt.Sprintf("unknown field %q; use 'phone', 'email', 'linkedin', 'github' or 'portfolio'", value))
+		case seen[field]:
+			v.AddFieldError("contact_priority", fmt.Sprintf("field %q is listed twice", value))
+		default:
+			seen[field] = true
+			priority = append(priority, field)
+		}
+	}
+
+	if err := v.ToError(); err != nil {
+		return nil, err
+	}
+	return priority, nil
+}
+
+// layoutContacts breaks contacts, in priority order, into lines no wider
+// than width. They stay on one line when they fit, and otherwise wrap to up
+// to maxContactLines lines; contacts that still do not fit are dropped,
+// lowest priority first.
+func layoutContacts(contacts []headerContact, width float64) [][]headerContact {
+	for n := len(contacts); n > 0; n-- {
+		if lines := wrapContacts(contacts[:n], width); len(lines) <= maxContactLines || n == 1 {
+			return lines
+		}
+	}
+	return nil
+}
+
+// wrapContacts fills lines with contacts, starting a new line when the next
+// contact would overflow the current one.
+func wrapContacts(contacts []headerContact, width float64) [][]headerContact {
+	var lines [][]headerContact
+	var line []headerContact
+	var lineWidth float64
+
+	for _, c := range contacts {
+		w := contactWidth(c)
+		if len(line) > 0 && lineWidth+contactSeparatorWidth+w > width {
+			lines = append(lines, line)
+			line, lineWidth = nil, 0
+		}
+		if len(line) > 0 {
+			lineWidth += contactSeparatorWidth
+		}
+		line = append(line, c)
+		lineWidth += w
+	}
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// contactWidth estimates the printed width of a contact in points.
+func contactWidth(c headerContact) float64 {
+	return float64(len([]rune(c.Text))) * contactCharWidth
+}
@@ -0,0 +1,358 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// customTemplateBlocks are the blocks every custom template must define, so
+// that a template cannot leave out the main parts of a resume.
+var customTemplateBlocks = []string{"header", "summary", "experience", "education", "skills"}
+
+// customTemplateName is the form of custom template names, taken from their
+// file names. Names end up in storage keys, so they are kept to safe
+// characters.
+var customTemplateName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
+// builtinTemplates are the names of the templates that custom ones cannot
+// replace.
+var builtinTemplates = []string{
+	domain.TemplateJake,
+	domain.TemplateEuropass,
+	domain.TemplateAcademic,
+	domain.TemplateFunctional,
+}
+
+// CustomTemplates are HTML resume templates written as Go templates
+// (html/template) and loaded at startup, so that deployments can brand
+// resumes without code changes.
+//
+// Each template is a <name>.html file, selected with its name, that renders
+// a whole HTML document from a CustomTemplateData and defines the blocks in
+// customTemplateBlocks.
+type CustomTemplates struct {
+	templates map[string]*template.Template
+}
+
+// SetCustomTemplates makes custom templates available by name, alongside the
+// built-in ones.
+func (s *ResumeService) SetCustomTemplates(templates *CustomTemplates) {
+	s.customTemplates = templates
+}
+
+// LoadCustomTemplates parses the *.html files at the root of fsys. It fails
+// on files that do not parse, miss a required block, fail to render sample
+// data, or whose names are invalid or those of built-in templates.
+func LoadCustomTemplates(fsys fs.FS) (*CustomTemplates, error) {
+	files, err := fs.Glob(fsys, "*.html")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list custom templates: %w", err)
+	}
+
+	custom := &CustomTemplates{templates: make(map[string]*template.Template, len(files))}
+	for _, file := range files {
+		name := strings.TrimSuffix(path.Base(file), ".html")
+		if !customTemplateName.MatchString(name) {
+			return nil, fmt.Errorf("custom template %s: name must be lowercase letters, digits, '-' or '_'", file)
+		}
+		if slices.Contains(builtinTemplates, name) {
+			return nil, fmt.Errorf("custom template %s: %q is a built-in template", file, name)
+		}
+
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read custom template %s: %w", file, err)
+		}
+		tmpl, err := template.New(name).Option("missingkey=error").Parse(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse custom template %s: %w", file, err)
+		}
+		for _, block := range customTemplateBlocks {
+			if tmpl.Lookup(block) == nil {
+				return nil, fmt.Errorf("custom template %s: missing block %q", file, block)
+			}
+		}
+		if err := tmpl.Execute(&bytes.Buffer{}, sampleCustomTemplateData()); err != nil {
+			return nil, fmt.Errorf("custom template %s: %w", file, err)
+		}
+
+		custom.templates[name] = tmpl
+	}
+
+	return custom, nil
+}
+
+// Names returns the names of the custom templates, sorted.
+func (c *CustomTemplates) Names() []string {
+	if c == nil {
+		return nil
+	}
+	names := make([]string, 0, len(c.templates))
+	for name := range c.templates {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Has reports whether there is a custom template with the name.
+func (c *CustomTemplates) Has(name string) bool {
+	if c == nil {
+		return false
+	}
+	_, ok := c.templates[name]
+	return ok
+}
+
+// Render renders the resume with the named custom template.
+func (c *CustomTemplates) Render(name string, data ResumeTemplateData) (string, error) {
+	tmpl, ok := c.templates[name]
+	if !ok {
+		return "", fmt.Errorf("unknown custom template %q", name)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, newCustomTemplateData(data)); err != nil {
+		return "", fmt.Errorf("failed to render custom template %q: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// CustomTemplateData is the data custom templates render. Text fields are
+// plain text, escaped by html/template; HTML fields are already escaped and
+// carry the **bold** highlights of tailored content.
+type CustomTemplateData struct {
+	Lang     string
+	FontSize int
+
+	// Pages is the number of pages the resume may span.
+	Pages int
+
+	Name     string
+	Headline string
+	Contacts []CustomTemplateContact
+
+	// QRCode is the header QR code as a linked image, or empty.
+	QRCode template.HTML
+
+	Summary     template.HTML
+	Experiences []CustomTemplateExperience
+	Education   []CustomTemplateEducation
+	Projects    []CustomTemplateProject
+	Skills      []CustomTemplateSkillGroup
+	Languages   []CustomTemplateLanguage
+
+	// Labels are the section titles in the resume's language, keyed by
+	// section: summary, education, experience, projects, skills and
+	// languages.
+	Labels map[string]string
+}
+
+// CustomTemplateContact is a header contact. URL is empty for phones.
+type CustomTemplateContact struct {
+	Text string
+	URL  string
+}
+
+// CustomTemplateExperience is a tailored experience.
+type CustomTemplateExperience struct {
+	Title        string
+	Organization string
+	Dates        string
+	Bullets      []template.HTML
+}
+
+// CustomTemplateEducation is an education entry.
+type CustomTemplateEducation struct {
+	Institution string
+	Degree      string
+	Location    string
+	Dates       string
+	Honors      []string
+}
+
+// CustomTemplateProject is a project.
+type CustomTemplateProject struct {
+	Name      string
+	TechStack []string
+	URL       string
+	Dates     string
+	Bullets   []template.HTML
+}
+
+// CustomTemplateSkillGroup is a category of the resume's skills.
+type CustomTemplateSkillGroup struct {
+	Category string
+	Skills   []string
+}
+
+// CustomTemplateLanguage is a spoken language.
+type CustomTemplateLanguage struct {
+	Language    string
+	Proficiency string
+}
+
+// newCustomTemplateData prepares the template data of a resume for custom
+// templates.
+func newCustomTemplateData(data ResumeTemplateData) CustomTemplateData {
+	i18n := data.i18n()
+
+	out := CustomTemplateData{
+		Lang:     "en",
+		FontSize: data.FontSize,
+		Pages:    data.pageLimit(),
+		QRCode:   template.HTML(data.qrCode("resume-qr")),
+		Labels: map[string]string{
+			string(SectionSummary):    i18n.T(KeyProfessionalSummary),
+			string(SectionEducation):  i18n.T(KeyEducation),
+			string(SectionExperience): i18n.T(KeyExperience),
+			string(SectionProjects):   i18n.T(KeyProjects),
+			string(SectionSkills):     i18n.T(KeyTechnicalSkills),
+			string(SectionLanguages):  i18n.T(KeyLanguages),
+		},
+	}
+	if out.FontSize == 0 {
+		out.FontSize = 11
+	}
+	if data.Resume != nil {
+		out.Lang = data.Resume.TargetLanguage
+	}
+
+	if data.User != nil {
+		out.Name = data.User.GetDisplayName()
+		if data.User.Headline != nil {
+			out.Headline = *data.User.Headline
+		}
+		for _, c := range resumeContacts(data.User, data.Resume, data.Locale, jakeContactText) {
+			contact := CustomTemplateContact{Text: c.Text}
+			switch c.Field {
+			case domain.ContactPhone:
+			case domain.ContactEmail:
+				contact.URL = "mailto:" + c.Value
+			default:
+				contact.URL = c.Value
+			}
+			out.Contacts = append(out.Contacts, contact)
+		}
+	}
+
+	if data.ShowSummary {
+		out.Summary = template.HTML(renderMarkdownBold(data.summary()))
+	}
+
+	var content *domain.ResumeContent
+	if data.Resume != nil {
+		content = data.Resume.GeneratedContent
+	}
+	if content != nil {
+		for _, exp := range content.Experiences {
+			out.Experiences = append(out.Experiences, CustomTemplateExperience{
+				Title:        exp.Title,
+				Organization: exp.Organization,
+				Dates:        formatExperienceDateRangeLocalized(exp.StartDate, exp.EndDate, exp.IsCurrent, i18n),
+				Bullets:      customTemplateBullets(tailoredBulletTexts(exp.Bullets)),
+			})
+		}
+		for _, group := range groupSkillsByCategory(content.Skills, data.Skills) {
+			out.Skills = append(out.Skills, CustomTemplateSkillGroup{
+				Category: group.Category,
+				Skills:   group.labels(data.Resume.Sections.SkillsDisplay, i18n),
+			})
+		}
+	}
+
+	for _, edu := range data.Education {
+		entry := CustomTemplateEducation{
+			Institution: edu.Institution,
+			Degree:      edu.Degree,
+			Dates:       formatEducationDateRangeLocalized(edu.StartDate, edu.EndDate, edu.IsExpected, i18n),
+			Honors:      edu.Honors,
+		}
+		if edu.FieldOfStudy != nil && *edu.FieldOfStudy != "" {
+			entry.Degree += ", " + *edu.FieldOfStudy
+		}
+		if edu.Location != nil {
+			entry.Location = *edu.Location
+		}
+		out.Education = append(out.Education, entry)
+	}
+
+	for _, proj := range data.Projects {
+		entry := CustomTemplateProject{
+			Name:      proj.Name,
+			TechStack: proj.TechStack,
+			Dates:     formatProjectDateRangeLocalized(proj.StartDate, proj.EndDate, i18n),
+		}
+		if proj.URL != nil {
+			entry.URL = *proj.URL
+		}
+		var bullets []string
+		for _, bullet := range proj.Bullets {
+			bullets = append(bullets, bullet.Content)
+		}
+		entry.Bullets = customTemplateBullets(bullets)
+		out.Projects = append(out.Projects, entry)
+	}
+
+	for _, lang := range data.Languages {
+		out.Languages = append(out.Languages, CustomTemplateLanguage{
+			Language:    lang.Language,
+			Proficiency: i18n.FormatProficiencyLevel(string(lang.Proficiency)),
+		})
+	}
+
+	return out
+}
+
+// customTemplateBullets renders bullets as HTML with their **bold**
+// highlights.
+func customTemplateBullets(bullets []string) []template.HTML {
+	out := make([]template.HTML, 0, len(bullets))
+	for _, bullet := range bullets {
+		out = append(out, template.HTML(renderMarkdownBold(bullet)))
+	}
+	return out
+}
+
+// sampleCustomTemplateData is a resume with every section filled, which
+// custom templates must render when loaded.
+func sampleCustomTemplateData() CustomTemplateData {
+	name := "Alex Doe"
+	location := "Lisbon"
+	end := "2024-06"
+	start := domain.NewMonthDate(2018, 9)
+	finish := domain.NewMonthDate(2022, 7)
+
+	return newCustomTemplateData(ResumeTemplateData{
+		User: &domain.User{Name: &name},
+		Resume: &domain.Resume{
+			TargetLanguage: "en",
+			GeneratedContent: &domain.ResumeContent{
+				Summary: "Engineer with **5 years** of experience",
+				Experiences: []domain.TailoredExperience{{
+					Title:        "Engineer",
+					Organization: "Acme",
+					StartDate:    "2022-01",
+					EndDate:      &end,
+					Bullets:      []domain.TailoredBullet{{BulletID: "b1", TailoredContent: "Built **APIs**"}},
+				}},
+				Skills: []string{"Go"},
+			},
+		},
+		Education: []domain.Education{{
+			Institution: "University", Degree: "BSc", Location: &location,
+			StartDate: &start, EndDate: &finish, Honors: []string{"Cum laude"},
+		}},
+		Projects:    []domain.Project{{Name: "Tool", TechStack: []string{"Go"}, Bullets: []domain.ProjectBullet{{Content: "Shipped"}}}},
+		Languages:   []domain.SpokenLanguage{{Language: "English", Proficiency: domain.ProficiencyNative}},
+		ShowSummary: true,
+	})
+}
@@ -0,0 +1,95 @@
+package services_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// validCustomTemplate defines every required block and renders the resume.
+const validCustomTemplate = `<!DOCTYPE html>
+<html lang="{{.Lang}}"><body>
+{{block "header" .}}<h1>{{.Name}}</h1>{{end}}
+{{block "summary" .}}<p>{{.Summary}}</p>{{end}}
+{{block "experience" .}}{{range .Experiences}}<h2>{{.Title}}, {{.Organization}}</h2><ul>{{range .Bullets}}<li>{{.}}</li>{{end}}</ul>{{end}}{{end}}
+{{block "education" .}}{{range .Education}}<p>{{.Institution}}</p>{{end}}{{end}}
+{{block "skills" .}}{{range .Skills}}<p>{{.Category}}</p>{{end}}{{end}}
+</body></html>`
+
+func TestLoadCustomTemplates(t *testing.T) {
+	t.Run("loads valid templates", func(t *testing.T) {
+		templates, err := services.LoadCustomTemplates(fstest.MapFS{
+			"brand.html":  {Data: []byte(validCustomTemplate)},
+			"acme_2.html": {Data: []byte(validCustomTemplate)},
+			"notes.txt":   {Data: []byte("not a template")},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"acme_2", "brand"}, templates.Names())
+		assert.True(t, templates.Has("brand"))
+		assert.False(t, templates.Has("notes"))
+
+		html, err := templates.Render("brand", goldenResumeData())
+		require.NoError(t, err)
+		assert.Contains(t, html, "<h1>Ana &lt;Souza&gt; &amp; Co</h1>")
+		assert.Contains(t, html, "Cut p99 latency by <strong>40%</strong>")
+	})
+
+	t.Run("loads nothing from an empty directory", func(t *testing.T) {
+		templates, err := services.LoadCustomTemplates(fstest.MapFS{})
+		require.NoError(t, err)
+		assert.Empty(t, templates.Names())
+	})
+
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		wantErr string
+	}{
+		{
+			name:    "missing block",
+			files:   fstest.MapFS{"brand.html": {Data: []byte(`{{define "header"}}{{end}}{{define "summary"}}{{end}}{{define "experience"}}{{end}}{{define "education"}}{{end}}`)}},
+			wantErr: `missing block "skills"`,
+		},
+		{
+			name:    "built-in template name",
+			files:   fstest.MapFS{domain.TemplateJake + ".html": {Data: []byte(validCustomTemplate)}},
+			wantErr: "is a built-in template",
+		},
+		{
+			name:    "uppercase name",
+			files:   fstest.MapFS{"Brand.html": {Data: []byte(validCustomTemplate)}},
+			wantErr: "name must be lowercase letters",
+		},
+		{
+			name:    "name with spaces",
+			files:   fstest.MapFS{"my brand.html": {Data: []byte(validCustomTemplate)}},
+			wantErr: "name must be lowercase letters",
+		},
+		{
+			name:    "template that does not parse",
+			files:   fstest.MapFS{"brand.html": {Data: []byte(`{{block "header" .}}`)}},
+			wantErr: "failed to parse custom template brand.html",
+		},
+		{
+			name: "template that fails to render the sample",
+			files: fstest.MapFS{"brand.html": {Data: []byte(
+				validCustomTemplate + `{{.Missing}}`,
+			)}},
+			wantErr: "can't evaluate field Missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templates, err := services.LoadCustomTemplates(tt.files)
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Nil(t, templates)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// Density controls how tightly a rendered resume is set: its line height,
+// page margins, the gaps between sections, entries and bullets, and the
+// size of section headings.
+type Density string
+
+// Density presets. DensityComfortable is each template's own layout.
+const (
+	DensityComfortable Density = "comfortable"
+	DensityCompact     Density = "compact"
+	DensityUltra       Density = "ultra"
+)
+
+// ParseDensity parses a density preset name; an empty name is
+// DensityComfortable.
+func ParseDensity(s string) (Density, error) {
+	switch d := Density(s); d {
+	case "":
+		return DensityComfortable, nil
+	case DensityComfortable, DensityCompact, DensityUltra:
+		return d, nil
+	default:
+		v := &domain.ValidationErrors{}
+		v.AddFieldError("density", "must be 'comfortable', 'compact' or 'ultra'")
+		return "", v
+	}
+}
+
+// densityScale holds the factors a preset applies to a template's layout.
+type densityScale struct {
+	lineHeight float64
+	margin     float64
+	spacing    float64
+	heading    float64
+}
+
+// scale returns the preset's factors. Unknown presets are comfortable.
+func (d Density) scale() densityScale {
+	switch d {
+	case DensityCompact:
+		return densityScale{lineHeight: 0.88, margin: 0.8, spacing: 0.6, heading: 0.95}
+	case DensityUltra:
+		return densityScale{lineHeight: 0.78, margin: 0.6, spacing: 0.35, heading: 0.9}
+	default:
+		return densityScale{lineHeight: 1, margin: 1, spacing: 1, heading: 1}
+	}
+}
+
+// densityLayout is a template's comfortable layout, which presets scale.
+type densityLayout struct {
+	lineHeight float64
+
+	// Page margins in inches.
+	marginY, marginX float64
+
+	// Gaps after sections, entries and bullets in points.
+	sectionGap, entryGap, bulletGap float64
+}
+
+// pageMargin returns the scaled page margins as a CSS margin value.
+func (l densityLayout) pageMargin(d Density) string {
+	s := d.scale()
+	return fmt.Sprintf("%.2fin %.2fin", l.marginY*s.margin, l.marginX*s.margin)
+}
+
+// cssVariables returns a :root rule defining the scaled layout as CSS
+// variables: --line-height, --page-margin, --section-gap, --entry-gap,
+// --bullet-gap and --heading-scale.
+func (l densityLayout) cssVariables(d Density) string {
+	s := d.scale()
+	return fmt.Sprintf(`:root {
+            --line-height: %.2f;
+            --page-margin: %s;
+            --section-gap: %.1fpt;
+            --entry-gap: %.1fpt;
+            --bullet-gap: %.1fpt;
+            --heading-scale: %.2f;
+        }`,
+		l.lineHeight*s.lineHeight,
+		l.pageMargin(d),
+		l.sectionGap*s.spacing,
+		l.entryGap*s.spacing,
+		l.bulletGap*s.spacing,
+		s.heading,
+	)
+}
@@ -0,0 +1,82 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestParseDensity(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    services.Density
+		wantErr bool
+	}{
+		{in: "", want: services.DensityComfortable},
+		{in: "comfortable", want: services.DensityComfortable},
+		{in: "compact", want: services.DensityCompact},
+		{in: "ultra", want: services.DensityUltra},
+		{in: "tight", wantErr: true},
+		{in: "Compact", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := services.ParseDensity(tt.in)
+			if tt.wantErr {
+				var validationErr *domain.ValidationErrors
+				require.ErrorAs(t, err, &validationErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDensityScalesJakeLayout(t *testing.T) {
+	render := func(density services.Density) string {
+		data := goldenResumeData()
+		data.Density = density
+		return services.NewJakeResumeTemplate().Render(data)
+	}
+
+	tests := []struct {
+		density services.Density
+		want    []string
+	}{
+		{density: services.DensityComfortable, want: []string{
+			"--line-height: 1.60;", "--page-margin: 0.30in 0.40in;", "--section-gap: 8.0pt;", "--heading-scale: 1.00;",
+		}},
+		{density: services.DensityCompact, want: []string{
+			"--line-height: 1.41;", "--page-margin: 0.24in 0.32in;", "--section-gap: 4.8pt;", "--heading-scale: 0.95;",
+		}},
+		{density: services.DensityUltra, want: []string{
+			"--line-height: 1.25;", "--page-margin: 0.18in 0.24in;", "--section-gap: 2.8pt;", "--heading-scale: 0.90;",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.density), func(t *testing.T) {
+			html := render(tt.density)
+			for _, want := range tt.want {
+				assert.Contains(t, html, want)
+			}
+		})
+	}
+
+	// The zero value renders each template's own layout.
+	assert.Equal(t, render(services.DensityComfortable), render(""))
+}
+
+func TestPDFCacheKeyDensity(t *testing.T) {
+	resume := &domain.Resume{ID: "resume-1", UserID: "user-1"}
+
+	assert.Equal(t, "resumes/user-1/resume-1.pdf", services.PDFCacheKey(resume, domain.TemplateJake, services.DensityComfortable))
+	assert.Equal(t, "resumes/user-1/resume-1_compact.pdf", services.PDFCacheKey(resume, domain.TemplateJake, services.DensityCompact))
+	assert.Equal(t, "resumes/user-1/resume-1_europass_ultra.pdf", services.PDFCacheKey(resume, domain.TemplateEuropass, services.DensityUltra))
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/pkg/worddiff"
+)
+
+// ResumeDiff shows what tailoring changed in a resume, word by word.
+type ResumeDiff struct {
+	ResumeID string
+
+	// Summary compares the user's profile summary with the resume's.
+	Summary ContentDiff
+
+	// Bullets compare the master bullets with their tailored content, in
+	// the order of the resume.
+	Bullets []BulletDiff
+}
+
+// ContentDiff compares an original text with its tailored version.
+type ContentDiff struct {
+	Original string
+	Tailored string
+	Changed  bool
+	Segments []worddiff.Segment
+}
+
+// BulletDiff compares a master bullet with its tailored content.
+type BulletDiff struct {
+	BulletID     string
+	ExperienceID string
+	ContentDiff
+}
+
+// newContentDiff compares original with tailored.
+func newContentDiff(original, tailored string) ContentDiff {
+	segments := worddiff.Diff(original, tailored)
+	return ContentDiff{
+		Original: original,
+		Tailored: tailored,
+		Changed:  worddiff.Changed(segments),
+		Segments: segments,
+	}
+}
+
+// DiffResume compares a tailored resume with the master profile. Bullets
+// compare the content they were tailored from with the tailored content,
+// before any translation; the summary compares the user's current profile
+// summary with the resume's. Resumes not tailored yet return
+// domain.ErrResumeNotReady.
+func (s *ResumeService) DiffResume(ctx context.Context, resumeID, userID string) (*ResumeDiff, error) {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, resumeID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+	if resume.GeneratedContent == nil {
+		return nil, domain.ErrResumeNotReady
+	}
+
+	var profileSummary string
+	user, err := s.userRepo.GetByID(ctx, userID)
+	switch {
+	case err == nil:
+		if user.Summary != nil {
+			profileSummary = *user.Summary
+		}
+	case !errors.Is(err, domain.ErrUserNotFound):
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	content := resume.GeneratedContent
+	diff := &ResumeDiff{
+		ResumeID: resume.ID,
+		Summary:  newContentDiff(profileSummary, content.Summary),
+		Bullets:  make([]BulletDiff, 0),
+	}
+	for _, exp := range content.Experiences {
+		for _, bullet := range exp.Bullets {
+			tailored := bullet.TailoredContent
+			if tailored == "" {
+				tailored = bullet.OriginalContent
+			}
+			diff.Bullets = append(diff.Bullets, BulletDiff{
+				BulletID:     bullet.BulletID,
+				ExperienceID: exp.ExperienceID,
+				ContentDiff:  newContentDiff(bullet.OriginalContent, tailored),
+			})
+		}
+	}
+
+	return diff, nil
+}
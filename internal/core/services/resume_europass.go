@@ -0,0 +1,730 @@
+package services
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// Europass (SkillsPassport v3.4) XML document model.
+// Only the elements we can populate from the domain are modelled.
+
+const (
+	europassNamespace  = "http://europass.cedefop.europa.eu/Europass"
+	europassXSDVersion = "V3.4"
+	europassGenerator  = "chameleon-vitae"
+)
+
+// europassList encodes a Europass "XxxList" wrapper element whose children are
+// named after the wrapper without the "List" suffix. Empty lists are omitted.
+type europassList[T any] []T
+
+// MarshalXML implements xml.Marshaler.
+func (l europassList[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	item := xml.StartElement{Name: xml.Name{Local: strings.TrimSuffix(start.Name.Local, "List")}}
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, v := range l {
+		if err := e.EncodeElement(v, item); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+type europassDocument struct {
+	XMLName      xml.Name             `xml:"SkillsPassport"`
+	Xmlns        string               `xml:"xmlns,attr"`
+	Locale       string               `xml:"locale,attr"`
+	DocumentInfo europassDocumentInfo `xml:"DocumentInfo"`
+	LearnerInfo  europassLearnerInfo  `xml:"LearnerInfo"`
+}
+
+type europassDocumentInfo struct {
+	DocumentType string `xml:"DocumentType"`
+	CreationDate string `xml:"CreationDate"`
+	XSDVersion   string `xml:"XSDVersion"`
+	Generator    string `xml:"Generator"`
+}
+
+type europassLearnerInfo struct {
+	Identification     europassIdentification                 `xml:"Identification"`
+	Headline           *europassHeadline                      `xml:"Headline,omitempty"`
+	WorkExperienceList europassList[europassWorkExperience]   `xml:"WorkExperienceList,omitempty"`
+	EducationList      europassList[europassEducation]        `xml:"EducationList,omitempty"`
+	Skills             *europassSkills                        `xml:"Skills,omitempty"`
+	AchievementList    europassList[europassAchievementEntry] `xml:"AchievementList,omitempty"`
+}
+
+type europassIdentification struct {
+	PersonName  europassPersonName   `xml:"PersonName"`
+	ContactInfo *europassContactInfo `xml:"ContactInfo,omitempty"`
+}
+
+type europassPersonName struct {
+	FirstName string `xml:"FirstName"`
+	Surname   string `xml:"Surname"`
+}
+
+type europassContactInfo struct {
+	Address   *europassAddress                  `xml:"Address,omitempty"`
+	Email     *europassContact                  `xml:"Email,omitempty"`
+	Telephone europassList[europassUsedContact] `xml:"TelephoneList,omitempty"`
+	Website   europassList[europassUsedContact] `xml:"WebsiteList,omitempty"`
+}
+
+type europassAddress struct {
+	Contact europassAddressContact `xml:"Contact"`
+}
+
+type europassAddressContact struct {
+	Municipality string `xml:"Municipality"`
+}
+
+type europassContact struct {
+	Contact string `xml:"Contact"`
+}
+
+type europassUsedContact struct {
+	Contact string       `xml:"Contact"`
+	Use     europassCode `xml:"Use"`
+}
+
+type europassCode struct {
+	Code  string `xml:"Code,omitempty"`
+	Label string `xml:"Label,omitempty"`
+}
+
+type europassHeadline struct {
+	Type        europassCode  `xml:"Type"`
+	Description europassLabel `xml:"Description"`
+}
+
+type europassLabel struct {
+	Label string `xml:"Label"`
+}
+
+type europassPeriod struct {
+	From    *europassDate `xml:"From,omitempty"`
+	To      *europassDate `xml:"To,omitempty"`
+	Current bool          `xml:"Current,omitempty"`
+}
+
+type europassDate struct {
+	Year  string `xml:"year,attr"`
+	Month string `xml:"month,attr,omitempty"`
+}
+
+type europassWorkExperience struct {
+	Period     europassPeriod       `xml:"Period"`
+	Position   europassLabel        `xml:"Position"`
+	Activities string               `xml:"Activities,omitempty"`
+	Employer   europassOrganisation `xml:"Employer"`
+}
+
+type europassEducation struct {
+	Period       europassPeriod       `xml:"Period"`
+	Title        string               `xml:"Title"`
+	Activities   string               `xml:"Activities,omitempty"`
+	Organisation europassOrganisation `xml:"Organisation"`
+}
+
+type europassOrganisation struct {
+	Name        string               `xml:"Name"`
+	ContactInfo *europassContactInfo `xml:"ContactInfo,omitempty"`
+}
+
+type europassSkills struct {
+	Linguistic *europassLinguistic   `xml:"Linguistic,omitempty"`
+	Computer   *europassSkillSummary `xml:"Computer,omitempty"`
+}
+
+type europassSkillSummary struct {
+	Description string `xml:"Description"`
+}
+
+type europassLinguistic struct {
+	MotherTongueList    europassList[europassLanguage]        `xml:"MotherTongueList,omitempty"`
+	ForeignLanguageList europassList[europassForeignLanguage] `xml:"ForeignLanguageList,omitempty"`
+}
+
+type europassLanguage struct {
+	Description europassCode `xml:"Description"`
+}
+
+type europassForeignLanguage struct {
+	Description      europassCode       `xml:"Description"`
+	ProficiencyLevel europassCEFRLevels `xml:"ProficiencyLevel"`
+}
+
+type europassCEFRLevels struct {
+	Listening         string `xml:"Listening"`
+	Reading           string `xml:"Reading"`
+	SpokenInteraction string `xml:"SpokenInteraction"`
+	SpokenProduction  string `xml:"SpokenProduction"`
+	Writing           string `xml:"Writing"`
+}
+
+type europassAchievementEntry struct {
+	Title       europassCode `xml:"Title"`
+	Description string       `xml:"Description"`
+}
+
+// renderEuropassXML maps the resume data to a Europass SkillsPassport XML document.
+func renderEuropassXML(data ResumeTemplateData, now time.Time) ([]byte, error) {
+	i18n := data.i18n()
+
+	doc := europassDocument{
+		Xmlns:  europassNamespace,
+		Locale: europassLocale(data.Locale),
+		DocumentInfo: europassDocumentInfo{
+			DocumentType: "ECV",
+			CreationDate: now.UTC().Format(time.RFC3339),
+			XSDVersion:   europassXSDVersion,
+			Generator:    europassGenerator,
+		},
+	}
+
+	learner := &doc.LearnerInfo
+
+	if data.User != nil {
+		learner.Identification = europassIdentificationFor(data.User)
+		if data.User.Headline != nil && *data.User.Headline != "" {
+			learner.Headline = &europassHeadline{
+				Type:        europassCode{Code: "position"},
+				Description: europassLabel{Label: *data.User.Headline},
+			}
+		}
+	}
+
+	if content := data.Resume.GeneratedContent; content != nil {
+		for _, exp := range content.Experiences {
+			learner.WorkExperienceList = append(learner.WorkExperienceList, europassWorkExperience{
+				Period:     europassPeriodFromStrings(exp.StartDate, exp.EndDate, exp.IsCurrent),
+				Position:   europassLabel{Label: exp.Title},
+				Activities: europassBulletList(tailoredBulletTexts(exp.Bullets)),
+				Employer:   europassOrganisation{Name: exp.Organization},
+			})
+		}
+	}
+
+	for _, edu := range data.Education {
+		title := edu.Degree
+		if edu.FieldOfStudy != nil && *edu.FieldOfStudy != "" {
+			title += " in " + *edu.FieldOfStudy
+		}
+
+		var activities []string
+		if edu.GPA != nil && *edu.GPA != "" {
+			activities = append(activities, i18n.T(KeyGPA)+": "+*edu.GPA)
+		}
+		activities = append(activities, edu.Honors...)
+
+		org := europassOrganisation{Name: edu.Institution}
+		if edu.Location != nil && *edu.Location != "" {
+			org.ContactInfo = &europassContactInfo{
+				Address: &europassAddress{Contact: europassAddressContact{Municipality: *edu.Location}},
+			}
+		}
+
+		learner.EducationList = append(learner.EducationList, europassEducation{
+			Period:       europassPeriodFromDates(edu.StartDate, edu.EndDate),
+			Title:        title,
+			Activities:   europassBulletList(activities),
+			Organisation: org,
+		})
+	}
+
+	skills := &europassSkills{}
+	if len(data.Languages) > 0 {
+		skills.Linguistic = europassLinguisticFor(data.Languages)
+	}
+	if content := data.Resume.GeneratedContent; content != nil && len(content.Skills) > 0 {
+		var lines []string
+		for _, group := range groupSkillsByCategory(content.Skills, data.Skills) {
+			lines = append(lines, group.Category+": "+strings.Join(group.Skills, ", "))
+		}
+		skills.Computer = &europassSkillSummary{Description: europassBulletList(lines)}
+	}
+	if skills.Linguistic != nil || skills.Computer != nil {
+		learner.Skills = skills
+	}
+
+	for _, proj := range data.Projects {
+		var lines []string
+		for _, b := range proj.Bullets {
+			lines = append(lines, stripMarkdownBold(b.Content))
+		}
+		learner.AchievementList = append(learner.AchievementList, europassAchievementEntry{
+			Title:       europassCode{Code: "projects", Label: proj.Name},
+			Description: europassBulletList(lines),
+		})
+	}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Europass XML: %w", err)
+	}
+
+	return append([]byte(xml.Header), out...), nil
+}
+
+// europassIdentificationFor maps the user's name and contact details.
+func europassIdentificationFor(user *domain.User) europassIdentification {
+	first, last := splitPersonName(user.GetDisplayName())
+	id := europassIdentification{
+		PersonName: europassPersonName{FirstName: first, Surname: last},
+	}
+
+	contact := &europassContactInfo{}
+	if user.Location != nil && *user.Location != "" {
+		contact.Address = &europassAddress{Contact: europassAddressContact{Municipality: *user.Location}}
+	}
+	if user.Email != nil && *user.Email != "" {
+		contact.Email = &europassContact{Contact: *user.Email}
+	}
+	if user.Phone != nil && *user.Phone != "" {
+		contact.Telephone = append(contact.Telephone, europassUsedContact{
+			Contact: *user.Phone,
+			Use:     europassCode{Code: "mobile"},
+		})
+	}
+	for _, site := range []struct {
+		url  *string
+		code string
+	}{
+		{user.PortfolioURL, "personal"},
+		{user.Website, "personal"},
+		{user.LinkedInURL, "business"},
+		{user.GitHubURL, "business"},
+	} {
+		if site.url != nil && *site.url != "" {
+			contact.Website = append(contact.Website, europassUsedContact{
+				Contact: *site.url,
+				Use:     europassCode{Code: site.code},
+			})
+		}
+	}
+
+	if contact.Address != nil || contact.Email != nil || len(contact.Telephone) > 0 || len(contact.Website) > 0 {
+		id.ContactInfo = contact
+	}
+
+	return id
+}
+
+// europassLinguisticFor splits languages into mother tongues and CEFR-rated foreign languages.
+func europassLinguisticFor(languages []domain.SpokenLanguage) *europassLinguistic {
+	ling := &europassLinguistic{}
+	for _, lang := range languages {
+		desc := europassCode{Code: languageCode(lang.Language), Label: lang.Language}
+		if lang.Proficiency == domain.ProficiencyNative {
+			ling.MotherTongueList = append(ling.MotherTongueList, europassLanguage{Description: desc})
+			continue
+		}
+		level := cefrLevel(lang.Proficiency)
+		ling.ForeignLanguageList = append(ling.ForeignLanguageList, europassForeignLanguage{
+			Description: desc,
+			ProficiencyLevel: europassCEFRLevels{
+				Listening:         level,
+				Reading:           level,
+				SpokenInteraction: level,
+				SpokenProduction:  level,
+				Writing:           level,
+			},
+		})
+	}
+	return ling
+}
+
+// EuropassResumeTemplate renders a Europass-styled HTML resume for PDF output.
+// Layout follows the Europass CV: a narrow label column on the left and the
+// content on the right, with locale-aware section labels.
+type EuropassResumeTemplate struct{}
+
+// europassLayout is the comfortable layout of the Europass CV.
+var europassLayout = densityLayout{
+	lineHeight: 1.35,
+	marginY:    0.3,
+	marginX:    0.4,
+	sectionGap: 10,
+	entryGap:   6,
+	bulletGap:  1,
+}
+
+// NewEuropassResumeTemplate creates a new Europass resume template.
+func NewEuropassResumeTemplate() *EuropassResumeTemplate {
+	return &EuropassResumeTemplate{}
+}
+
+// Render generates the HTML for the resume.
+func (t *EuropassResumeTemplate) Render(data ResumeTemplateData) string {
+	if data.FontSize == 0 {
+		data.FontSize = 10
+	}
+	i18n := data.i18n()
+
+	var sb strings.Builder
+	sb.WriteString(t.renderHead(data))
+	sb.WriteString(`<body><div class="ecv">`)
+
+	sb.WriteString(`<div class="ecv-brand">europass</div>`)
+
+	if data.User != nil {
+		sb.WriteString(t.renderPersonalInformation(data.User, data.Resume, data.qrCode("ecv-qr"), i18n))
+	}
+
+	if data.ShowSummary {
+		if summary := data.summary(); summary != "" {
+			sb.WriteString(t.section(i18n.T(KeyProfessionalSummary),
+				`<p>`+renderMarkdownBold(summary)+`</p>`))
+		}
+	}
+
+	content := data.Resume.GeneratedContent
+
+	if content != nil && len(content.Experiences) > 0 {
+		var body strings.Builder
+		for _, exp := range content.Experiences {
+			body.WriteString(`<div class="ecv-entry">`)
+			fmt.Fprintf(&body, `<div class="ecv-date">%s</div>`,
+				html.EscapeString(formatExperienceDateRangeLocalized(exp.StartDate, exp.EndDate, exp.IsCurrent, i18n)))
+			fmt.Fprintf(&body, `<div class="ecv-title">%s</div>`, html.EscapeString(exp.Title))
+			fmt.Fprintf(&body, `<div class="ecv-org">%s</div>`, html.EscapeString(exp.Organization))
+			body.WriteString(t.renderBullets(tailoredBulletTexts(exp.Bullets)))
+			body.WriteString(`</div>`)
+		}
+		sb.WriteString(t.section(i18n.T(KeyWorkExperience), body.String()))
+	}
+
+	if len(data.Education) > 0 {
+		var body strings.Builder
+		for _, edu := range data.Education {
+			degree := edu.Degree
+			if edu.FieldOfStudy != nil && *edu.FieldOfStudy != "" {
+				degree += " in " + *edu.FieldOfStudy
+			}
+			org := edu.Institution
+			if edu.Location != nil && *edu.Location != "" {
+				org += ", " + *edu.Location
+			}
+			body.WriteString(`<div class="ecv-entry">`)
+			fmt.Fprintf(&body, `<div class="ecv-date">%s</div>`,
+				html.EscapeString(formatEducationDateRangeLocalized(edu.StartDate, edu.EndDate, edu.IsExpected, i18n)))
+			fmt.Fprintf(&body, `<div class="ecv-title">%s</div>`, html.EscapeString(degree))
+			fmt.Fprintf(&body, `<div class="ecv-org">%s</div>`, html.EscapeString(org))
+			var extras []string
+			if edu.GPA != nil && *edu.GPA != "" {
+				extras = append(extras, i18n.T(KeyGPA)+": "+*edu.GPA)
+			}
+			extras = append(extras, edu.Honors...)
+			if len(extras) > 0 {
+				fmt.Fprintf(&body, `<div class="ecv-extra">%s</div>`, html.EscapeString(strings.Join(extras, " | ")))
+			}
+			body.WriteString(`</div>`)
+		}
+		sb.WriteString(t.section(i18n.T(KeyEducationAndTraining), body.String()))
+	}
+
+	if skills := t.renderPersonalSkills(data, i18n); skills != "" {
+		sb.WriteString(t.section(i18n.T(KeyPersonalSkills), skills))
+	}
+
+	if len(data.Projects) > 0 {
+		var body strings.Builder
+		for _, proj := range data.Projects {
+			body.WriteString(`<div class="ecv-entry">`)
+			fmt.Fprintf(&body, `<div class="ecv-title">%s</div>`, html.EscapeString(proj.Name))
+			if len(proj.TechStack) > 0 {
+				fmt.Fprintf(&body, `<div class="ecv-org">%s</div>`, html.EscapeString(strings.Join(proj.TechStack, ", ")))
+			}
+			var bullets []string
+			for _, b := range proj.Bullets {
+				bullets = append(bullets, b.Content)
+			}
+			body.WriteString(t.renderBullets(bullets))
+			body.WriteString(`</div>`)
+		}
+		sb.WriteString(t.section(i18n.T(KeyProjects), body.String()))
+	}
+
+	sb.WriteString(`</div></body></html>`)
+	return sb.String()
+}
+
+// renderHead generates the HTML head with Europass CSS.
+func (t *EuropassResumeTemplate) renderHead(data ResumeTemplateData) string {
+	userName := "Resume"
+	if data.User != nil {
+		userName = data.User.GetDisplayName()
+	}
+
+	lang := "en"
+	if data.Resume != nil {
+		lang = data.Resume.TargetLanguage
+	}
+
+	return fmt.Sprintf(`<!DOCTYPE html>
+<html lang="%s">
+<head>
+    <meta charset="UTF-8">
+    <title>Europass CV - %s</title>
+    <style>
+        %s
+        *, *::before, *::after { box-sizing: border-box; margin: 0; padding: 0; }
+        body { font-family: Arial, Helvetica, sans-serif; font-size: %dpt; line-height: var(--line-height); color: #3f3a38; background: #fff; }
+        .ecv { max-width: 8.27in; margin: 0 auto; padding: var(--page-margin); }
+        .ecv-brand { color: #0e4194; font-size: calc(16pt * var(--heading-scale)); font-weight: bold; letter-spacing: 0.5pt; margin-bottom: 10pt; }
+        .ecv-section { display: flex; margin-bottom: var(--section-gap); page-break-inside: avoid; }
+        .ecv-label { flex: 0 0 28%%; padding-right: 10pt; text-align: right; color: #0e4194; font-size: 9pt; text-transform: uppercase; }
+        .ecv-body { flex: 1; border-left: 1pt solid #0e4194; padding-left: 10pt; }
+        .ecv-name { font-size: calc(15pt * var(--heading-scale)); color: #0e4194; margin-bottom: 3pt; }
+        .ecv-contact { font-size: 9pt; }
+        .ecv-qr { float: right; margin-left: 10pt; }
+        .ecv-qr img { display: block; width: 0.7in; height: 0.7in; }
+        .ecv-contact a { color: #3f3a38; text-decoration: none; }
+        .ecv-entry { margin-bottom: var(--entry-gap); }
+        .ecv-date { font-size: 9pt; color: #0e4194; }
+        .ecv-title { font-weight: bold; font-size: 11pt; }
+        .ecv-org { font-style: italic; }
+        .ecv-extra { font-size: 9pt; }
+        .ecv-body ul { margin: 2pt 0 0 14pt; }
+        .ecv-body li { margin-bottom: var(--bullet-gap); }
+        .ecv-sublabel { font-weight: bold; margin-top: 3pt; }
+        table.ecv-cefr { border-collapse: collapse; margin-top: 3pt; font-size: 9pt; }
+        table.ecv-cefr th, table.ecv-cefr td { border: 0.5pt solid #c8c8c8; padding: 2pt 6pt; text-align: center; }
+        table.ecv-cefr th { color: #0e4194; font-weight: normal; }
+        table.ecv-cefr td:first-child { text-align: left; }
+        @page { size: A4; margin: 0; }
+    </style>
+</head>
+`, lang, html.EscapeString(userName), europassLayout.cssVariables(data.Density), data.FontSize)
+}
+
+// section wraps a body with the left-hand label column.
+func (t *EuropassResumeTemplate) section(label, body string) string {
+	return fmt.Sprintf(`<section class="ecv-section"><div class="ecv-label">%s</div><div class="ecv-body">%s</div></section>`,
+		html.EscapeString(label), body)
+}
+
+// renderPersonalInformation renders the name and contact block, with the QR
+// code, if any, on the right.
+func (t *EuropassResumeTemplate) renderPersonalInformation(user *domain.User, resume *domain.Resume, qrCode string, i18n *I18n) string {
+	var body strings.Builder
+	body.WriteString(qrCode)
+	fmt.Fprintf(&body, `<div class="ecv-name">%s</div>`, html.EscapeString(user.GetDisplayName()))
+
+	var contacts []string
+	if user.Location != nil && *user.Location != "" {
+		contacts = append(contacts, html.EscapeString(*user.Location))
+	}
+	for _, c := range resumeContacts(user, resume, i18n.Locale(), europassContactText) {
+		switch c.Field {
+		case domain.ContactPhone:
+			contacts = append(contacts, html.EscapeString(c.Text))
+		case domain.ContactEmail:
+			contacts = append(contacts, fmt.Sprintf(`<a href="mailto:%s">%s</a>`,
+				html.EscapeString(c.Value), html.EscapeString(c.Text)))
+		default:
+			contacts = append(contacts, fmt.Sprintf(`<a href="%s">%s</a>`,
+				html.EscapeString(c.Value), html.EscapeString(c.Text)))
+		}
+	}
+	for _, c := range contacts {
+		fmt.Fprintf(&body, `<div class="ecv-contact">%s</div>`, c)
+	}
+
+	if user.Headline != nil && *user.Headline != "" {
+		fmt.Fprintf(&body, `<div class="ecv-title">%s</div>`, html.EscapeString(*user.Headline))
+	}
+
+	return t.section(i18n.T(KeyPersonalInformation), body.String())
+}
+
+// europassContactText returns the text shown for a contact: links without
+// their scheme, other values as they are.
+func europassContactText(_ domain.ContactField, value string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(value, "https://"), "http://"), "/")
+}
+
+// renderPersonalSkills renders languages (with the CEFR grid) and digital skills.
+func (t *EuropassResumeTemplate) renderPersonalSkills(data ResumeTemplateData, i18n *I18n) string {
+	var body strings.Builder
+
+	if len(data.Languages) > 0 {
+		ling := europassLinguisticFor(data.Languages)
+
+		if len(ling.MotherTongueList) > 0 {
+			names := make([]string, 0, len(ling.MotherTongueList))
+			for _, m := range ling.MotherTongueList {
+				names = append(names, m.Description.Label)
+			}
+			fmt.Fprintf(&body, `<div class="ecv-sublabel">%s</div><div>%s</div>`,
+				html.EscapeString(i18n.T(KeyMotherTongue)), html.EscapeString(strings.Join(names, ", ")))
+		}
+
+		if len(ling.ForeignLanguageList) > 0 {
+			fmt.Fprintf(&body, `<div class="ecv-sublabel">%s</div>`, html.EscapeString(i18n.T(KeyOtherLanguages)))
+			body.WriteString(`<table class="ecv-cefr"><tr><th></th><th>CEFR</th></tr>`)
+			for _, f := range ling.ForeignLanguageList {
+				fmt.Fprintf(&body, `<tr><td>%s</td><td>%s</td></tr>`,
+					html.EscapeString(f.Description.Label), html.EscapeString(f.ProficiencyLevel.SpokenProduction))
+			}
+			body.WriteString(`</table>`)
+		}
+	}
+
+	if content := data.Resume.GeneratedContent; content != nil && len(content.Skills) > 0 {
+		fmt.Fprintf(&body, `<div class="ecv-sublabel">%s</div>`, html.EscapeString(i18n.T(KeyDigitalSkills)))
+		body.WriteString(`<ul>`)
+		for _, group := range groupSkillsByCategory(content.Skills, data.Skills) {
+			fmt.Fprintf(&body, `<li><strong>%s:</strong> %s</li>`,
+				html.EscapeString(group.Category), html.EscapeString(strings.Join(group.labels(data.Resume.Sections.SkillsDisplay, i18n), ", ")))
+		}
+		body.WriteString(`</ul>`)
+	}
+
+	return body.String()
+}
+
+// renderBullets renders a bullet list, converting **bold** markers.
+func (t *EuropassResumeTemplate) renderBullets(items []string) string {
+	if len(items) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(`<ul>`)
+	for _, item := range items {
+		fmt.Fprintf(&sb, `<li>%s</li>`, renderMarkdownBold(item))
+	}
+	sb.WriteString(`</ul>`)
+	return sb.String()
+}
+
+// tailoredBulletTexts returns the tailored text of each bullet, falling back to the original.
+func tailoredBulletTexts(bullets []domain.TailoredBullet) []string {
+	texts := make([]string, 0, len(bullets))
+	for _, b := range bullets {
+		content := b.DisplayContent()
+		texts = append(texts, content)
+	}
+	return texts
+}
+
+// europassBulletList formats items as the HTML list Europass expects in rich-text fields.
+func europassBulletList(items []string) string {
+	if len(items) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("<ul>")
+	for _, item := range items {
+		sb.WriteString("<li>" + html.EscapeString(stripMarkdownBold(item)) + "</li>")
+	}
+	sb.WriteString("</ul>")
+	return sb.String()
+}
+
+// europassPeriodFromStrings builds a period from YYYY-MM-DD or YYYY-MM strings.
+func europassPeriodFromStrings(start string, end *string, isCurrent bool) europassPeriod {
+	period := europassPeriod{Current: isCurrent}
+	if t, ok := parseEuropassDateString(start); ok {
+		period.From = europassDateFor(t)
+	}
+	if !isCurrent && end != nil {
+		if t, ok := parseEuropassDateString(*end); ok {
+			period.To = europassDateFor(t)
+		}
+	}
+	return period
+}
+
+// parseEuropassDateString parses "YYYY-MM-DD" or "YYYY-MM" dates.
+func parseEuropassDateString(s string) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02", "2006-01"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// europassPeriodFromDates builds a period from optional domain dates.
+func europassPeriodFromDates(start, end *domain.Date) europassPeriod {
+	var period europassPeriod
+	if start != nil && !start.IsZero() {
+		period.From = europassDateFor(start.Time)
+	}
+	if end != nil && !end.IsZero() {
+		period.To = europassDateFor(end.Time)
+	}
+	return period
+}
+
+// europassDateFor formats a date with XML Schema gYear/gMonth attributes.
+func europassDateFor(t time.Time) *europassDate {
+	return &europassDate{
+		Year:  fmt.Sprintf("%04d", t.Year()),
+		Month: fmt.Sprintf("--%02d", int(t.Month())),
+	}
+}
+
+// europassLocale maps our locale to the Europass locale attribute.
+func europassLocale(locale Locale) string {
+	code, _, _ := strings.Cut(string(locale), "-")
+	if code == "" {
+		return "en"
+	}
+	return code
+}
+
+// cefrLevel maps our proficiency scale to a CEFR level.
+func cefrLevel(p domain.LanguageProficiency) string {
+	switch p {
+	case domain.ProficiencyNative, domain.ProficiencyFluent:
+		return "C2"
+	case domain.ProficiencyAdvanced:
+		return "C1"
+	case domain.ProficiencyIntermediate:
+		return "B1"
+	default:
+		return "A2"
+	}
+}
+
+// languageCodes maps common language names (in several languages) to ISO 639-1 codes.
+var languageCodes = map[string]string{
+	"english": "en", "inglês": "en", "ingles": "en", "inglés": "en", "anglais": "en", "englisch": "en",
+	"portuguese": "pt", "português": "pt", "portugues": "pt", "portugués": "pt", "portugais": "pt", "portugiesisch": "pt",
+	"spanish": "es", "espanhol": "es", "español": "es", "espagnol": "es", "spanisch": "es",
+	"french": "fr", "francês": "fr", "francés": "fr", "français": "fr", "französisch": "fr",
+	"german": "de", "alemão": "de", "alemán": "de", "allemand": "de", "deutsch": "de",
+	"italian": "it", "italiano": "it", "italien": "it", "italienisch": "it",
+	"dutch": "nl", "nederlands": "nl",
+	"polish": "pl", "polski": "pl",
+	"russian": "ru", "chinese": "zh", "mandarin": "zh", "japanese": "ja", "korean": "ko", "arabic": "ar",
+}
+
+// languageCode returns the ISO 639-1 code for a language name, or "" if unknown.
+func languageCode(name string) string {
+	return languageCodes[strings.ToLower(strings.TrimSpace(name))]
+}
+
+// splitPersonName splits a display name into first name(s) and surname.
+func splitPersonName(name string) (first, last string) {
+	parts := strings.Fields(name)
+	switch len(parts) {
+	case 0:
+		return "", ""
+	case 1:
+		return parts[0], ""
+	default:
+		return strings.Join(parts[:len(parts)-1], " "), parts[len(parts)-1]
+	}
+}
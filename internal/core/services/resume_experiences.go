@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// tailoringBullets returns the bullets of the experiences the resume
+// tailors; see Resume.TailorsExperience.
+func tailoringBullets(resume *domain.Resume, experiences []domain.Experience, bullets []domain.Bullet) []domain.Bullet {
+	excluded := make(map[string]bool)
+	for i := range experiences {
+		if !resume.TailorsExperience(&experiences[i]) {
+			excluded[experiences[i].ID] = true
+		}
+	}
+	if len(excluded) == 0 {
+		return bullets
+	}
+
+	tailored := make([]domain.Bullet, 0, len(bullets))
+	for _, bullet := range bullets {
+		if !excluded[bullet.ExperienceID] {
+			tailored = append(tailored, bullet)
+		}
+	}
+	return tailored
+}
+
+// validateExperienceOverrides checks that the experiences a resume includes
+// or excludes belong to its user and are not listed as both.
+func (s *ResumeService) validateExperienceOverrides(ctx context.Context, userID string, included, excluded []string) error {
+	experiences, err := s.listExperiences(ctx, userID)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]bool, len(experiences))
+	for _, exp := range experiences {
+		known[exp.ID] = true
+	}
+
+	v := &domain.ValidationErrors{}
+	for _, id := range included {
+		if !known[id] {
+			v.AddFieldError("included_experience_ids", fmt.Sprintf("experience %q not found", id))
+		}
+	}
+	for _, id := range excluded {
+		switch {
+		case !known[id]:
+			v.AddFieldError("excluded_experience_ids", fmt.Sprintf("experience %q not found", id))
+		case slices.Contains(included, id):
+			v.AddFieldError("excluded_experience_ids", fmt.Sprintf("experience %q is also included", id))
+		}
+	}
+
+	return v.ToError()
+}
+
+// listExperiences lists all of a user's experiences.
+func (s *ResumeService) listExperiences(ctx context.Context, userID string) ([]domain.Experience, error) {
+	var experiences []domain.Experience
+	for offset := 0; ; offset += timelinePageSize {
+		page, total, err := s.experienceRepo.ListByUserIDWithBullets(ctx, userID, ports.ListOptions{
+			Limit:  timelinePageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list experiences: %w", err)
+		}
+		experiences = append(experiences, page...)
+		if len(page) < timelinePageSize || len(experiences) >= total {
+			return experiences, nil
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// ExportFormat identifies a resume export format.
+type ExportFormat string
+
+// Supported export formats.
+const (
+	ExportFormatHTML     ExportFormat = "html"
+	ExportFormatMarkdown ExportFormat = "markdown"
+	ExportFormatText     ExportFormat = "text"
+	ExportFormatEuropass ExportFormat = "europass"
+	ExportFormatLaTeX    ExportFormat = "latex"
+)
+
+// ParseExportFormat parses a format name, accepting common aliases.
+func ParseExportFormat(s string) (ExportFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "html", "htm":
+		return ExportFormatHTML, nil
+	case "markdown", "md":
+		return ExportFormatMarkdown, nil
+	case "text", "txt", "plain", "plaintext":
+		return ExportFormatText, nil
+	case "europass", "europass-xml", "europass_xml":
+		return ExportFormatEuropass, nil
+	case "latex", "tex":
+		return ExportFormatLaTeX, nil
+	default:
+		return "", domain.ErrUnsupportedExportFormat
+	}
+}
+
+// ExportResumeRequest contains parameters for exporting a resume.
+type ExportResumeRequest struct {
+	ResumeID string
+	UserID   string
+	Format   ExportFormat
+}
+
+// ExportResult contains an exported resume document.
+type ExportResult struct {
+	Content     []byte
+	Filename    string
+	ContentType string
+}
+
+// ExportResume renders the resume in the requested format.
+func (s *ResumeService) ExportResume(ctx context.Context, req ExportResumeRequest) (*ExportResult, error) {
+	switch req.Format {
+	case ExportFormatHTML:
+		return s.ExportHTML(ctx, req)
+	case ExportFormatMarkdown:
+		return s.ExportMarkdown(ctx, req)
+	case ExportFormatText:
+		return s.ExportPlainText(ctx, req)
+	case ExportFormatEuropass:
+		return s.ExportEuropassXML(ctx, req)
+	case ExportFormatLaTeX:
+		return s.ExportLaTeX(ctx, req)
+	default:
+		return nil, domain.ErrUnsupportedExportFormat
+	}
+}
+
+// ExportHTML renders the resume as a self-contained HTML document (inline CSS).
+func (s *ResumeService) ExportHTML(ctx context.Context, req ExportResumeRequest) (*ExportResult, error) {
+	resume, user, err := s.loadExportableResume(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	htmlContent, err := s.renderResumeHTML(ctx, user, resume)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportResult{
+		Content:     []byte(htmlContent),
+		Filename:    s.resumeFilename(ctx, user, resume, "html"),
+		ContentType: "text/html; charset=utf-8",
+	}, nil
+}
+
+// PreviewHTMLRequest contains unsaved edits to render over a resume.
+// Nil or empty fields keep the saved content.
+type PreviewHTMLRequest struct {
+	ResumeID string
+	UserID   string
+	Summary  *string
+
+	// Bullets maps bullet IDs to edited bullet text.
+	Bullets      map[string]string
+	FontSize     *int
+	SectionOrder []string
+
+	// Density is the spacing preset name; empty is comfortable.
+	Density string
+}
+
+// PreviewHTML renders the resume with draft edits applied, without saving them.
+func (s *ResumeService) PreviewHTML(ctx context.Context, req PreviewHTMLRequest) (*ExportResult, error) {
+	resume, user, err := s.loadExportableResume(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := validatePreviewRequest(req, resume.GeneratedContent)
+	if err != nil {
+		return nil, err
+	}
+	density, err := ParseDensity(req.Density)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := *resume
+	draft.GeneratedContent = applyPreviewEdits(resume.GeneratedContent, req)
+
+	data, err := s.loadResumeTemplateData(ctx, user, &draft)
+	if err != nil {
+		return nil, err
+	}
+	if req.FontSize != nil {
+		data.FontSize = *req.FontSize
+	}
+	data.SectionOrder = order
+	data.Density = density
+
+	return &ExportResult{
+		Content:     []byte(NewJakeResumeTemplate().Render(data)),
+		Filename:    s.resumeFilename(ctx, user, resume, "html"),
+		ContentType: "text/html; charset=utf-8",
+	}, nil
+}
+
+// validatePreviewRequest checks the draft edits against the saved content and
+// returns the parsed section order.
+func validatePreviewRequest(req PreviewHTMLRequest, content *domain.ResumeContent) ([]ResumeSection, error) {
+	var v domain.ValidationErrors
+
+	if req.FontSize != nil && (*req.FontSize < domain.MinFontSize || *req.FontSize > domain.MaxFontSize) {
+		v.AddFieldError("font_size", "must be between 9 and 12")
+	}
+
+	order := make([]ResumeSection, 0, len(req.SectionOrder))
+	for _, name := range req.SectionOrder {
+		section := ResumeSection(strings.ToLower(strings.TrimSpace(name)))
+		switch {
+		case !section.IsValid():
+			v.AddFieldError("section_order", fmt.Sprintf("unknown section %q", name))
+		case slices.Contains(order, section):
+			v.AddFieldError("section_order", fmt.Sprintf("duplicate section %q", name))
+		default:
+			order = append(order, section)
+		}
+	}
+
+	known := make(map[string]bool)
+	for _, exp := range content.Experiences {
+		for _, bullet := range exp.Bullets {
+			known[bullet.BulletID] = true
+		}
+	}
+	for id, text := range req.Bullets {
+		if !known[id] {
+			v.AddFieldError("bullets", fmt.Sprintf("bullet %q is not on this resume", id))
+		} else if strings.TrimSpace(text) == "" {
+			v.AddFieldError("bullets", fmt.Sprintf("bullet %q must not be empty", id))
+		}
+	}
+
+	return order, v.ToError()
+}
+
+// applyPreviewEdits returns a copy of the content with the draft edits applied.
+// Edited bullets replace the rendered text, so any translation is dropped.
+func applyPreviewEdits(content *domain.ResumeContent, req PreviewHTMLRequest) *domain.ResumeContent {
+	draft := *content
+	if req.Summary != nil {
+		draft.Summary = strings.TrimSpace(*req.Summary)
+	}
+
+	draft.Experiences = make([]domain.TailoredExperience, len(content.Experiences))
+	for i, exp := range content.Experiences {
+		exp.Bullets = slices.Clone(exp.Bullets)
+		for j, bullet := range exp.Bullets {
+			if text, ok := req.Bullets[bullet.BulletID]; ok {
+				exp.Bullets[j].TailoredContent = strings.TrimSpace(text)
+				exp.Bullets[j].TranslatedContent = ""
+			}
+		}
+		draft.Experiences[i] = exp
+	}
+
+	return &draft
+}
+
+// ExportMarkdown renders the resume as Markdown.
+func (s *ResumeService) ExportMarkdown(ctx context.Context, req ExportResumeRequest) (*ExportResult, error) {
+	resume, user, err := s.loadExportableResume(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.loadResumeTemplateData(ctx, user, resume)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportResult{
+		Content:     []byte(NewMarkdownResumeTemplate().Render(data)),
+		Filename:    s.resumeFilename(ctx, user, resume, "md"),
+		ContentType: "text/markdown; charset=utf-8",
+	}, nil
+}
+
+// ExportPlainText renders the resume as ATS-safe ASCII plain text.
+func (s *ResumeService) ExportPlainText(ctx context.Context, req ExportResumeRequest) (*ExportResult, error) {
+	resume, user, err := s.loadExportableResume(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.loadResumeTemplateData(ctx, user, resume)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportResult{
+		Content:     []byte(NewPlainTextResumeTemplate().Render(data)),
+		Filename:    s.resumeFilename(ctx, user, resume, "txt"),
+		ContentType: "text/plain; charset=us-ascii",
+	}, nil
+}
+
+// ExportEuropassXML maps the resume to a Europass (SkillsPassport) XML document.
+func (s *ResumeService) ExportEuropassXML(ctx context.Context, req ExportResumeRequest) (*ExportResult, error) {
+	resume, user, err := s.loadExportableResume(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.loadResumeTemplateData(ctx, user, resume)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := renderEuropassXML(data, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportResult{
+		Content:     content,
+		Filename:    s.resumeFilename(ctx, user, resume, "xml"),
+		ContentType: "application/xml; charset=utf-8",
+	}, nil
+}
+
+// ExportLaTeX renders the resume as Jake's Resume LaTeX source.
+func (s *ResumeService) ExportLaTeX(ctx context.Context, req ExportResumeRequest) (*ExportResult, error) {
+	resume, user, err := s.loadExportableResume(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.loadResumeTemplateData(ctx, user, resume)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportResult{
+		Content:     []byte(NewLaTeXResumeTemplate().Render(data)),
+		Filename:    s.resumeFilename(ctx, user, resume, "tex"),
+		ContentType: "application/x-tex; charset=utf-8",
+	}, nil
+}
+
+// loadExportableResume loads a user's resume and its owner, ensuring the content was generated.
+func (s *ResumeService) loadExportableResume(ctx context.Context, resumeID, userID string) (*domain.Resume, *domain.User, error) {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, resumeID, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+
+	if !resume.CanGeneratePDF() {
+		return nil, nil, domain.ErrResumeNotReady
+	}
+
+	user, err := s.userRepo.GetByID(ctx, resume.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return resume, user, nil
+}
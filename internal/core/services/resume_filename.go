@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// resumeFilename names a downloaded resume file from the user's filename
+// pattern, falling back to the default pattern when it is not valid.
+func (s *ResumeService) resumeFilename(ctx context.Context, user *domain.User, resume *domain.Resume, ext string) string {
+	prefs := s.userPreferences(ctx, resume.UserID)
+
+	pattern := prefs.FilenamePattern
+	if !pattern.IsValid() {
+		pattern = domain.DefaultFilenamePattern
+	}
+
+	loc, err := time.LoadLocation(prefs.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	return resumeFilename(pattern, user, resume, time.Now().In(loc), ext)
+}
+
+// resumeFilename expands pattern for a resume downloaded at now.
+func resumeFilename(pattern domain.FilenamePattern, user *domain.User, resume *domain.Resume, now time.Time, ext string) string {
+	var company, jobTitle string
+	if resume.CompanyName != nil {
+		company = sanitizeFilename(*resume.CompanyName)
+	}
+	if resume.JobTitle != nil {
+		jobTitle = sanitizeFilename(*resume.JobTitle)
+	}
+	target := company
+	if target == "" {
+		target = jobTitle
+	}
+
+	name := pattern.Expand(map[string]string{
+		domain.FilenameTokenName:     sanitizeFilename(user.GetDisplayName()),
+		domain.FilenameTokenCompany:  company,
+		domain.FilenameTokenJobTitle: jobTitle,
+		domain.FilenameTokenTarget:   target,
+		domain.FilenameTokenLanguage: sanitizeFilename(resume.TargetLanguage),
+		domain.FilenameTokenDate:     now.Format(time.DateOnly),
+	})
+	if name == "" {
+		name = "Resume"
+	}
+
+	return name + "." + ext
+}
+
+// sanitizeFilename makes name safe in filenames: spaces and characters that
+// are invalid in filenames become "_", and control and other invisible
+// characters are dropped. Letters outside of ASCII are kept; the HTTP
+// adapter sends an ASCII spelling alongside them.
+func sanitizeFilename(name string) string {
+	var sb strings.Builder
+	for _, r := range name {
+		switch {
+		case unicode.IsSpace(r), strings.ContainsRune(`/\:*?"<>|`, r):
+			sb.WriteByte('_')
+		case unicode.IsGraphic(r):
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,362 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// fitSectionAcademic names the academic entries of the academic CV in a
+// FitCheck, as they are not a ResumeSection.
+const fitSectionAcademic = "academic"
+
+// fitBulletIndent is the indent of bullets in points.
+const fitBulletIndent = 18
+
+// FitCheckRequest contains the rendering to estimate. Empty or nil fields
+// keep the user's preferences.
+type FitCheckRequest struct {
+	ResumeID     string
+	UserID       string
+	TemplateName string
+	FontSize     *int
+
+	// Density is the spacing preset name; empty is comfortable.
+	Density string
+}
+
+// FitCheck estimates how much of the page a resume fills when rendered,
+// without rendering it. Heights are counted in lines of body text.
+type FitCheck struct {
+	Template string
+	FontSize int
+	Density  Density
+
+	// MaxPages is the number of pages the PDF keeps; 0 means no limit.
+	MaxPages int
+
+	Lines        int
+	LinesPerPage int
+
+	// Pages is the estimated number of pages filled, e.g. 1.2.
+	Pages float64
+
+	// Fits reports whether the content is estimated to fit MaxPages.
+	Fits bool
+
+	// OverflowLines estimates the lines past the last page kept; 0 when
+	// the content fits.
+	OverflowLines int
+
+	// Sections break the lines down by section, in rendering order, after
+	// the header.
+	Sections []SectionFit
+}
+
+// SectionFit is the estimated height of one section.
+type SectionFit struct {
+	Name  string
+	Lines int
+}
+
+// CheckFit estimates whether a resume fits its page limit with a template,
+// font size and density, so clients can warn before generating a PDF. Text
+// is wrapped at an average character width, so the estimate is off by a few
+// lines for text much wider or narrower than usual.
+func (s *ResumeService) CheckFit(ctx context.Context, req FitCheckRequest) (*FitCheck, error) {
+	_, data, templateName, opts, err := s.loadFitData(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return estimateFit(data, templateName, opts), nil
+}
+
+// loadFitData loads a resume and the data to estimate its fit with, and
+// resolves the template and PDF options of the rendering req describes.
+func (s *ResumeService) loadFitData(ctx context.Context, req FitCheckRequest) (*domain.Resume, ResumeTemplateData, string, ports.PDFOptions, error) {
+	var opts ports.PDFOptions
+	if req.FontSize != nil && (*req.FontSize < domain.MinFontSize || *req.FontSize > domain.MaxFontSize) {
+		v := &domain.ValidationErrors{}
+		v.AddFieldError("font_size", "must be between 9 and 12")
+		return nil, ResumeTemplateData{}, "", opts, v
+	}
+	density, err := ParseDensity(req.Density)
+	if err != nil {
+		return nil, ResumeTemplateData{}, "", opts, err
+	}
+
+	resume, user, err := s.loadExportableResume(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, ResumeTemplateData{}, "", opts, err
+	}
+
+	data, err := s.loadResumeTemplateData(ctx, user, resume)
+	if err != nil {
+		return nil, ResumeTemplateData{}, "", opts, err
+	}
+	data.Density = density
+
+	templateName := req.TemplateName
+	if templateName == "" {
+		templateName = s.userPreferences(ctx, resume.UserID).DefaultTemplate
+	}
+	templateName = s.resolveTemplate(templateName)
+
+	if req.FontSize != nil {
+		data.FontSize = *req.FontSize
+	}
+	if data.FontSize == 0 {
+		data.FontSize = templateFontSize(templateName)
+	}
+	if templateName == domain.TemplateAcademic && s.academicRepo != nil {
+		data.Academic, err = s.academicRepo.ListByUserID(ctx, resume.UserID)
+		if err != nil {
+			return nil, ResumeTemplateData{}, "", opts, fmt.Errorf("failed to get academic entries: %w", err)
+		}
+	}
+
+	return resume, data, templateName, resumePDFOptions(resume, templateName), nil
+}
+
+// templateFontSize returns the default font size in pt of a template.
+func templateFontSize(templateName string) int {
+	if templateName == domain.TemplateEuropass {
+		return 10
+	}
+	return 11
+}
+
+// fitEstimator measures rendered heights in points for one template, font
+// size and density.
+type fitEstimator struct {
+	fontSize   float64
+	lineHeight float64
+	scale      densityScale
+	layout     densityLayout
+
+	// width is the width of the text column in points.
+	width float64
+}
+
+// newFitEstimator returns the estimator of a template at the data's font
+// size and density on paper of the given options. Custom templates are
+// measured as Jake's Resume.
+func newFitEstimator(data ResumeTemplateData, templateName string, opts ports.PDFOptions) fitEstimator {
+	layout, share := jakeLayout, 1.0
+	switch templateName {
+	case domain.TemplateEuropass:
+		// Text flows right of the label column, 28% of the width.
+		layout, share = europassLayout, 0.72
+	case domain.TemplateAcademic:
+		layout = academicLayout
+	}
+
+	scale := data.Density.scale()
+	return fitEstimator{
+		fontSize:   float64(data.FontSize),
+		lineHeight: layout.lineHeight * scale.lineHeight,
+		scale:      scale,
+		layout:     layout,
+		width:      (opts.PaperWidth - 2*layout.marginX*scale.margin) * 72 * share,
+	}
+}
+
+// line returns the height of a line of body text.
+func (e fitEstimator) line() float64 {
+	return e.fontSize * e.lineHeight
+}
+
+// text returns the height of text wrapped in the column, less indent
+// points.
+func (e fitEstimator) text(text string, indent float64) float64 {
+	return float64(e.lines(text, indent)) * e.line()
+}
+
+// lines returns the lines text takes wrapped in the column, less indent
+// points.
+func (e fitEstimator) lines(text string, indent float64) int {
+	return estimateLines(stripMarkdownBold(text), e.chars(indent))
+}
+
+// chars returns the characters that fit on a line of the column, less
+// indent points.
+func (e fitEstimator) chars(indent float64) int {
+	return max(int((e.width-indent)/(e.fontSize*metricsCharWidth)), 1)
+}
+
+// heading returns the height of a section title with the gap after the
+// section.
+func (e fitEstimator) heading() float64 {
+	return 11*e.scale.heading*e.lineHeight + 6 + e.layout.sectionGap*e.scale.spacing
+}
+
+// entry returns the height of an entry with rows of one line and bullets.
+func (e fitEstimator) entry(rows int, bullets []string) float64 {
+	height := float64(rows)*e.line() + e.layout.entryGap*e.scale.spacing
+	if len(bullets) > 0 {
+		height += 2
+	}
+	for _, bullet := range bullets {
+		height += e.text(bullet, 18) + e.layout.bulletGap*e.scale.spacing
+	}
+	return height
+}
+
+// header returns the height of the name and contact lines.
+func (e fitEstimator) header(data ResumeTemplateData) float64 {
+	view := NewJakeResumeTemplate().headerView(data.User, data.Resume, data.Locale, data.qrCode("resume-qr"))
+	if view == nil {
+		return 0
+	}
+	return 18*e.scale.heading*e.lineHeight + 4 + float64(len(view.ContactLines))*9*e.lineHeight + 12
+}
+
+// section returns the height of a section, or 0 when it has no content.
+func (e fitEstimator) section(section ResumeSection, data ResumeTemplateData, templateName string) float64 {
+	content := data.Resume.GeneratedContent
+	var height float64
+
+	switch section {
+	case SectionSummary:
+		summary := data.summary()
+		if !data.ShowSummary || summary == "" {
+			return 0
+		}
+		height = e.text(summary, 0)
+	case SectionExperience:
+		if content == nil || len(content.Experiences) == 0 {
+			return 0
+		}
+		if templateName == domain.TemplateFunctional {
+			// Bullets under theme titles, then one line per employer.
+			var bullets []string
+			for _, exp := range content.Experiences {
+				bullets = append(bullets, tailoredBulletTexts(exp.Bullets)...)
+			}
+			height = e.entry(len(content.Themes), bullets) + e.heading()
+			height += float64(len(content.Experiences)) * e.line()
+			break
+		}
+		for _, exp := range content.Experiences {
+			height += e.entry(2, tailoredBulletTexts(exp.Bullets))
+		}
+	case SectionEducation:
+		for _, edu := range data.Education {
+			rows := 2
+			if (edu.GPA != nil && *edu.GPA != "") || len(edu.Honors) > 0 {
+				rows++
+			}
+			height += e.entry(rows, nil)
+		}
+	case SectionProjects:
+		for _, proj := range data.Projects {
+			var bullets []string
+			for _, bullet := range proj.Bullets {
+				bullets = append(bullets, bullet.Content)
+			}
+			height += e.entry(1, bullets)
+		}
+	case SectionSkills:
+		if content == nil || len(content.Skills) == 0 {
+			return 0
+		}
+		display := data.Resume.Sections.SkillsDisplay
+		for _, group := range groupSkillsByCategory(content.Skills, data.Skills) {
+			if display == domain.SkillsDisplayBars {
+				// A category title over bars two to a row.
+				height += float64(1+(len(group.Skills)+1)/2) * e.line()
+				continue
+			}
+			height += e.text(group.Category+": "+strings.Join(group.labels(display, data.i18n()), ", "), 0)
+		}
+	case SectionLanguages:
+		entries := make([]string, 0, len(data.Languages))
+		for _, lang := range data.Languages {
+			entries = append(entries, lang.Language+" ("+data.i18n().FormatProficiencyLevel(string(lang.Proficiency))+")")
+		}
+		if len(entries) > 0 {
+			height = e.text(strings.Join(entries, "  "), 0)
+		}
+	}
+
+	if height == 0 {
+		return 0
+	}
+	return height + e.heading()
+}
+
+// academic returns the height of the academic CV sections, or 0 without
+// entries.
+func (e fitEstimator) academic(entries []domain.AcademicEntry) float64 {
+	var height float64
+	sections := make(map[domain.AcademicSection]bool)
+	for _, entry := range entries {
+		if !sections[entry.Section] {
+			sections[entry.Section] = true
+			height += e.heading()
+		}
+		text := entry.Title
+		if len(entry.Authors) > 0 {
+			text = strings.Join(entry.Authors, ", ") + ". " + text
+		}
+		if entry.Organization != nil {
+			text += ", " + *entry.Organization
+		}
+		height += e.text(text, 0) + e.layout.entryGap*e.scale.spacing
+		if entry.Description != nil && *entry.Description != "" {
+			height += e.text(*entry.Description, 0)
+		}
+	}
+	return height
+}
+
+// estimateFit estimates the height of the rendered resume and compares it
+// with the pages opts keeps.
+func estimateFit(data ResumeTemplateData, templateName string, opts ports.PDFOptions) *FitCheck {
+	e := newFitEstimator(data, templateName, opts)
+
+	order := data.sectionOrder()
+	if templateName == domain.TemplateFunctional && len(data.SectionOrder) == 0 {
+		order = functionalSectionOrder
+	}
+
+	fit := &FitCheck{
+		Template: templateName,
+		FontSize: data.FontSize,
+		Density:  data.Density,
+		MaxPages: opts.MaxPages,
+		Sections: make([]SectionFit, 0, len(order)+1),
+	}
+	if fit.Density == "" {
+		fit.Density = DensityComfortable
+	}
+
+	total := e.header(data)
+	add := func(name string, height float64) {
+		if height == 0 {
+			return
+		}
+		total += height
+		fit.Sections = append(fit.Sections, SectionFit{Name: name, Lines: max(int(math.Round(height/e.line())), 1)})
+	}
+	for _, section := range order {
+		add(string(section), e.section(section, data, templateName))
+	}
+	if templateName == domain.TemplateAcademic {
+		add(fitSectionAcademic, e.academic(data.Academic))
+	}
+
+	page := (opts.PaperHeight - 2*e.layout.marginY*e.scale.margin) * 72
+	fit.Lines = int(math.Ceil(total / e.line()))
+	fit.LinesPerPage = int(page / e.line())
+	fit.Pages = total / page
+	fit.Fits = opts.MaxPages < 1 || total <= float64(opts.MaxPages)*page
+	if !fit.Fits {
+		fit.OverflowLines = int(math.Ceil((total - float64(opts.MaxPages)*page) / e.line()))
+	}
+	return fit
+}
@@ -0,0 +1,176 @@
+package services_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/internal/testutil/fixtures"
+)
+
+func TestEstimateFit(t *testing.T) {
+	onePage := ports.DefaultPDFOptions()
+	onePage.MaxPages = 1
+
+	t.Run("short resume fits", func(t *testing.T) {
+		data := fixtures.Maximal().TemplateData(services.LocaleEnUS)
+		data.FontSize = 11
+
+		fit := services.EstimateFit(data, domain.TemplateJake, onePage)
+
+		assert.True(t, fit.Fits)
+		assert.Zero(t, fit.OverflowLines)
+		assert.Less(t, fit.Pages, 1.0)
+		assert.LessOrEqual(t, fit.Lines, fit.LinesPerPage)
+		assert.Equal(t, services.DensityComfortable, fit.Density)
+
+		var names []string
+		for _, section := range fit.Sections {
+			names = append(names, section.Name)
+			assert.Positive(t, section.Lines, section.Name)
+		}
+		assert.Equal(t, []string{"summary", "education", "skills", "experience", "projects", "languages"}, names)
+	})
+
+	// Twelve bullets of the long fixture's length.
+	long := fixtures.LongBullets().TemplateData(services.LocaleEnUS)
+	content := *long.Resume.GeneratedContent
+	bullets := make([]domain.TailoredBullet, 12)
+	for i := range bullets {
+		bullets[i] = content.Experiences[0].Bullets[0]
+	}
+	content.Experiences = []domain.TailoredExperience{{Title: "Engineer", Bullets: bullets}}
+	resume := *long.Resume
+	resume.GeneratedContent = &content
+	long.Resume = &resume
+	long.FontSize = 11
+
+	t.Run("long resume overflows", func(t *testing.T) {
+		fit := services.EstimateFit(long, domain.TemplateJake, onePage)
+
+		assert.False(t, fit.Fits)
+		assert.Greater(t, fit.Pages, 1.0)
+		assert.InDelta(t, fit.Lines-fit.LinesPerPage, fit.OverflowLines, 1)
+	})
+
+	t.Run("smaller and denser takes fewer lines", func(t *testing.T) {
+		comfortable := services.EstimateFit(long, domain.TemplateJake, onePage)
+
+		small := long
+		small.FontSize = 9
+		smaller := services.EstimateFit(small, domain.TemplateJake, onePage)
+		assert.Less(t, smaller.Pages, comfortable.Pages)
+
+		ultra := long
+		ultra.Density = services.DensityUltra
+		denser := services.EstimateFit(ultra, domain.TemplateJake, onePage)
+		assert.Less(t, denser.Pages, comfortable.Pages)
+		assert.Greater(t, denser.LinesPerPage, comfortable.LinesPerPage)
+	})
+
+	t.Run("narrow column takes more lines", func(t *testing.T) {
+		jake := services.EstimateFit(long, domain.TemplateJake, onePage)
+		europass := services.EstimateFit(long, domain.TemplateEuropass, onePage)
+		assert.Greater(t, europass.Sections[0].Lines, jake.Sections[0].Lines)
+	})
+
+	t.Run("no page limit", func(t *testing.T) {
+		fit := services.EstimateFit(long, domain.TemplateAcademic, ports.DefaultPDFOptions())
+		assert.True(t, fit.Fits)
+		assert.Zero(t, fit.MaxPages)
+	})
+
+	t.Run("long words are broken", func(t *testing.T) {
+		data := fixtures.Minimal().TemplateData(services.LocaleEnUS)
+		data.FontSize = 11
+		data.Resume = &domain.Resume{GeneratedContent: &domain.ResumeContent{Summary: strings.Repeat("x", 300)}}
+
+		fit := services.EstimateFit(data, domain.TemplateJake, onePage)
+		// Three lines of 100 characters at 11pt, and the section title.
+		assert.Equal(t, []services.SectionFit{{Name: "summary", Lines: 5}}, fit.Sections)
+	})
+}
+
+func TestPlanTrim(t *testing.T) {
+	onePage := ports.DefaultPDFOptions()
+	onePage.MaxPages = 1
+
+	// Three lines of summary; bullets of two, one and five lines.
+	data := fixtures.Minimal().TemplateData(services.LocaleEnUS)
+	data.FontSize = 11
+	data.ShowSummary = true
+	data.Resume = &domain.Resume{GeneratedContent: &domain.ResumeContent{
+		Summary: strings.Repeat("abcd ", 60),
+		Experiences: []domain.TailoredExperience{{Bullets: []domain.TailoredBullet{
+			{BulletID: "b1", TailoredContent: strings.Repeat("abcd ", 30)},
+			{BulletID: "b2", TailoredContent: "Short"},
+			{BulletID: "b3", TailoredContent: "Short", TranslatedContent: strings.Repeat("abcd ", 80)},
+		}}},
+	}}
+
+	ids := func(budgets []ports.TextBudget) []string {
+		var out []string
+		for _, budget := range budgets {
+			out = append(out, budget.ID)
+		}
+		return out
+	}
+
+	budgets := services.PlanTrim(data, domain.TemplateJake, onePage, 2)
+	assert.Equal(t, []string{"b3", services.TrimSummaryID}, ids(budgets))
+	for _, budget := range budgets {
+		assert.Less(t, budget.MaxLength, len(budget.Text), budget.ID)
+		assert.Positive(t, budget.MaxLength, budget.ID)
+	}
+	assert.Equal(t, strings.Repeat("abcd ", 80), budgets[0].Text)
+
+	// One-line texts are never picked.
+	budgets = services.PlanTrim(data, domain.TemplateJake, onePage, 10)
+	assert.Equal(t, []string{"b3", services.TrimSummaryID, "b1"}, ids(budgets))
+
+	assert.Empty(t, services.PlanTrim(data, domain.TemplateJake, onePage, 0))
+}
+
+func TestApplyShortenedTexts(t *testing.T) {
+	content := &domain.ResumeContent{
+		Summary: "Platform engineer running Kubernetes clusters for eight years",
+		Experiences: []domain.TailoredExperience{{Bullets: []domain.TailoredBullet{
+			{BulletID: "b1", TailoredContent: "Migrated 12 services to Kubernetes with zero downtime"},
+			{BulletID: "b2", TailoredContent: "Cut costs", TranslatedContent: "Reduziu os custos de nuvem em 30% com Kubernetes"},
+			{BulletID: "b3", TailoredContent: "Led a team of 5 engineers"},
+			{BulletID: "b4", TailoredContent: "Built CI pipelines cutting release time"},
+		}}},
+	}
+	budgets := []ports.TextBudget{
+		{ID: services.TrimSummaryID, Text: content.Summary},
+		{ID: "b1", Text: content.Experiences[0].Bullets[0].TailoredContent},
+		{ID: "b2", Text: content.Experiences[0].Bullets[1].TranslatedContent},
+		{ID: "b3", Text: content.Experiences[0].Bullets[2].TailoredContent},
+		{ID: "b4", Text: content.Experiences[0].Bullets[3].TailoredContent},
+	}
+	texts := map[string]string{
+		services.TrimSummaryID: " Platform engineer running Kubernetes ",
+		// Drops the keyword.
+		"b1": "Migrated 12 services with zero downtime",
+		"b2": "Reduziu custos em 30% com Kubernetes",
+		// Not shorter.
+		"b3": "Led a team of 5 senior engineers",
+		// Invents a number.
+		"b4": "Built CI cutting releases by 80%",
+	}
+
+	applied := services.ApplyShortenedTexts(content, budgets, texts, []string{"kubernetes"})
+
+	assert.Equal(t, []string{services.TrimSummaryID, "b2"}, applied)
+	assert.Equal(t, "Platform engineer running Kubernetes", content.Summary)
+	bullets := content.Experiences[0].Bullets
+	assert.Equal(t, "Migrated 12 services to Kubernetes with zero downtime", bullets[0].TailoredContent)
+	assert.Equal(t, "Cut costs", bullets[1].TailoredContent)
+	assert.Equal(t, "Reduziu custos em 30% com Kubernetes", bullets[1].TranslatedContent)
+	assert.Equal(t, "Led a team of 5 engineers", bullets[2].TailoredContent)
+	assert.Equal(t, "Built CI pipelines cutting release time", bullets[3].TailoredContent)
+}
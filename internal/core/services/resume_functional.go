@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// maxSkillThemes is the most skill themes of a functional resume.
+const maxSkillThemes = 5
+
+// skillThemes has the AI group the tailored bullets by skill theme for the
+// functional template. Grouping is best effort: it returns nil when the AI
+// fails or runs out of time, and the template then lists the bullets
+// without themes.
+func (s *ResumeService) skillThemes(ctx context.Context, jobAnalysis *ports.JobAnalysis, experiences []domain.TailoredExperience, targetLanguage string) []domain.SkillTheme {
+	var bullets []domain.TailoredBullet
+	for _, exp := range experiences {
+		bullets = append(bullets, exp.Bullets...)
+	}
+	if len(bullets) == 0 {
+		return nil
+	}
+
+	themes, err := s.aiProvider.GroupBulletsByTheme(ctx, ports.GroupBulletsByThemeRequest{
+		JobAnalysis:    jobAnalysis,
+		Bullets:        bullets,
+		MaxThemes:      maxSkillThemes,
+		TargetLanguage: targetLanguage,
+	})
+	if err != nil {
+		return nil
+	}
+	return themes
+}
+
+// FunctionalResumeTemplate renders a skills-based resume for career
+// changers: tailored bullets are grouped under the skill themes they
+// demonstrate, and the employment history that follows only lists titles,
+// organizations and dates. It shares the styling of Jake's Resume.
+type FunctionalResumeTemplate struct {
+	JakeResumeTemplate
+}
+
+// functionalSectionOrder is the default section order of the functional
+// template, skills first.
+var functionalSectionOrder = []ResumeSection{
+	SectionSummary,
+	SectionExperience,
+	SectionSkills,
+	SectionProjects,
+	SectionEducation,
+	SectionLanguages,
+}
+
+// NewFunctionalResumeTemplate creates a new functional resume template.
+func NewFunctionalResumeTemplate() *FunctionalResumeTemplate {
+	return &FunctionalResumeTemplate{}
+}
+
+// Render generates the HTML for the resume.
+func (t *FunctionalResumeTemplate) Render(data ResumeTemplateData) string {
+	if data.FontSize == 0 {
+		data.FontSize = 11
+	}
+	i18n := data.i18n()
+
+	order := functionalSectionOrder
+	if len(data.SectionOrder) > 0 {
+		order = data.sectionOrder()
+	}
+
+	var sb strings.Builder
+	sb.WriteString(t.renderHead(data))
+	sb.WriteString(`<body>`)
+	sb.WriteString(`<div class="resume-container">`)
+
+	header := t.renderHeader(data.User, data.Resume, data.Locale, data.qrCode("resume-qr"))
+	if data.pageLimit() > 1 {
+		sb.WriteString(`<table class="resume-pages"><thead><tr><td>`)
+		sb.WriteString(header)
+		sb.WriteString(`</td></tr></thead><tbody><tr><td>`)
+	} else {
+		sb.WriteString(header)
+	}
+
+	for _, section := range order {
+		if section == SectionExperience {
+			sb.WriteString(t.renderFunctionalExperience(data.Resume.GeneratedContent, i18n))
+			continue
+		}
+		sb.WriteString(t.renderSection(section, data, i18n))
+	}
+
+	if data.pageLimit() > 1 {
+		sb.WriteString(`</td></tr></tbody></table>`)
+	}
+	sb.WriteString(`</div>`)
+	sb.WriteString(`</body></html>`)
+
+	return sb.String()
+}
+
+// renderFunctionalExperience renders the tailored bullets under their skill
+// themes, followed by the employment history. Bullets without a theme are
+// listed last under "Other Achievements", or on their own when the content
+// has no themes.
+func (t *FunctionalResumeTemplate) renderFunctionalExperience(content *domain.ResumeContent, i18n *I18n) string {
+	if content == nil || len(content.Experiences) == 0 {
+		return ""
+	}
+
+	bullets := make(map[string]domain.TailoredBullet)
+	var order []string
+	for _, exp := range content.Experiences {
+		for _, bullet := range exp.Bullets {
+			bullets[bullet.BulletID] = bullet
+			order = append(order, bullet.BulletID)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<section class="resume-section">`)
+	fmt.Fprintf(&sb, `<h2 class="section-title">%s</h2>`, html.EscapeString(i18n.T(KeySkillHighlights)))
+
+	themed := make(map[string]bool)
+	for _, theme := range content.Themes {
+		var items []string
+		for _, id := range theme.BulletIDs {
+			if bullet, ok := bullets[id]; ok && !themed[id] {
+				themed[id] = true
+				items = append(items, bullet.DisplayContent())
+			}
+		}
+		sb.WriteString(t.renderTheme(theme.Name, items))
+	}
+
+	var rest []string
+	for _, id := range order {
+		if !themed[id] {
+			rest = append(rest, bullets[id].DisplayContent())
+		}
+	}
+	if len(content.Themes) == 0 {
+		sb.WriteString(t.renderTheme("", rest))
+	} else {
+		sb.WriteString(t.renderTheme(i18n.T(KeyOtherAchievements), rest))
+	}
+	sb.WriteString(`</section>`)
+
+	sb.WriteString(`<section class="resume-section">`)
+	fmt.Fprintf(&sb, `<h2 class="section-title">%s</h2>`, html.EscapeString(i18n.T(KeyEmploymentHistory)))
+	for _, exp := range content.Experiences {
+		sb.WriteString(`<div class="entry-header">`)
+		fmt.Fprintf(&sb, `<span><span class="entry-title">%s</span>, <span class="entry-subtitle">%s</span></span>`,
+			html.EscapeString(exp.Title), html.EscapeString(exp.Organization))
+		dateStr := formatExperienceDateRangeLocalized(exp.StartDate, exp.EndDate, exp.IsCurrent, i18n)
+		fmt.Fprintf(&sb, `<span class="entry-date">%s</span>`, html.EscapeString(dateStr))
+		sb.WriteString(`</div>`)
+	}
+	sb.WriteString(`</section>`)
+
+	return sb.String()
+}
+
+// renderTheme renders the bullets of a skill theme under its name, if any,
+// or nothing when there are no bullets.
+func (t *FunctionalResumeTemplate) renderTheme(name string, items []string) string {
+	if len(items) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<div class="resume-entry">`)
+	if name != "" {
+		fmt.Fprintf(&sb, `<div class="entry-header"><span class="entry-title">%s</span></div>`, html.EscapeString(name))
+	}
+	sb.WriteString(`<ul class="entry-bullets">`)
+	for _, item := range items {
+		fmt.Fprintf(&sb, `<li>%s</li>`, renderMarkdownBold(item))
+	}
+	sb.WriteString(`</ul>`)
+	sb.WriteString(`</div>`)
+	return sb.String()
+}
@@ -0,0 +1,138 @@
+package services_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/internal/testutil/fixtures"
+)
+
+// snapshotTemplates are the built-in HTML templates under snapshot.
+var snapshotTemplates = map[string]func(services.ResumeTemplateData) string{
+	domain.TemplateJake:       services.NewJakeResumeTemplate().Render,
+	domain.TemplateEuropass:   services.NewEuropassResumeTemplate().Render,
+	domain.TemplateAcademic:   services.NewAcademicCVTemplate().Render,
+	domain.TemplateFunctional: services.NewFunctionalResumeTemplate().Render,
+}
+
+var snapshotLocales = []services.Locale{
+	services.LocaleEnUS,
+	services.LocalePtBR,
+	services.LocaleEsES,
+	services.LocaleFrFR,
+	services.LocaleDeDE,
+}
+
+// pdfMetadata is what the PDF of a rendered resume is made of besides its
+// HTML: the document language and title, and the print options.
+type pdfMetadata struct {
+	Lang       string           `json:"lang"`
+	Title      string           `json:"title"`
+	PageRanges string           `json:"page_ranges"`
+	Options    ports.PDFOptions `json:"options"`
+}
+
+var (
+	htmlLang  = regexp.MustCompile(`<html lang="([^"]*)"`)
+	htmlTitle = regexp.MustCompile(`<title>([^<]*)</title>`)
+)
+
+// TestResumeSnapshots renders every fixture profile with every built-in
+// template in every locale and compares the HTML and the PDF metadata with
+// the golden files under testdata/golden. Run with -update to refresh them
+// after an intended rendering change.
+func TestResumeSnapshots(t *testing.T) {
+	metadata := make(map[string]pdfMetadata)
+
+	for templateName, render := range snapshotTemplates {
+		for _, profile := range fixtures.Profiles() {
+			for _, locale := range snapshotLocales {
+				name := templateName + "/" + profile.Name + "." + string(locale)
+				t.Run(name, func(t *testing.T) {
+					got := render(profile.TemplateData(locale))
+					assertGolden(t, filepath.Join("testdata", "golden", name+".html"), got)
+
+					meta := pdfMetadata{Options: services.ResumePDFOptions(profile.Resume, templateName)}
+					if m := htmlLang.FindStringSubmatch(got); m != nil {
+						meta.Lang = m[1]
+					}
+					if m := htmlTitle.FindStringSubmatch(got); m != nil {
+						meta.Title = m[1]
+					}
+					meta.PageRanges = meta.Options.PageRanges()
+					metadata[name] = meta
+				})
+			}
+		}
+	}
+
+	// encoding/json sorts map keys, so the file is stable.
+	got, err := json.MarshalIndent(metadata, "", "  ")
+	require.NoError(t, err)
+	assertGolden(t, filepath.Join("testdata", "golden", "pdf_metadata.json"), string(got)+"\n")
+}
+
+// snapshotTime is the creation date of the exported documents that carry
+// one.
+var snapshotTime = time.Date(2026, time.January, 15, 9, 30, 0, 0, time.UTC)
+
+// snapshotExports are the text export formats under snapshot, keyed by
+// format, with the extension of their golden files.
+var snapshotExports = map[string]struct {
+	ext    string
+	render func(t *testing.T, data services.ResumeTemplateData) string
+}{
+	"markdown": {ext: "md", render: func(_ *testing.T, data services.ResumeTemplateData) string {
+		return services.NewMarkdownResumeTemplate().Render(data)
+	}},
+	"text": {ext: "txt", render: func(_ *testing.T, data services.ResumeTemplateData) string {
+		return services.NewPlainTextResumeTemplate().Render(data)
+	}},
+	"europass_xml": {ext: "xml", render: func(t *testing.T, data services.ResumeTemplateData) string {
+		out, err := services.RenderEuropassXML(data, snapshotTime)
+		require.NoError(t, err)
+		return string(out)
+	}},
+}
+
+// TestExportSnapshots renders every fixture profile in every export format
+// and locale and compares the documents with the golden files under
+// testdata/golden. Run with -update to refresh them after an intended
+// change.
+func TestExportSnapshots(t *testing.T) {
+	for format, export := range snapshotExports {
+		for _, profile := range fixtures.Profiles() {
+			for _, locale := range snapshotLocales {
+				name := format + "/" + profile.Name + "." + string(locale)
+				t.Run(name, func(t *testing.T) {
+					got := export.render(t, profile.TemplateData(locale))
+					assertGolden(t, filepath.Join("testdata", "golden", name+"."+export.ext), got)
+				})
+			}
+		}
+	}
+}
+
+// assertGolden compares got with the golden file at path, first writing it
+// with -update.
+func assertGolden(t *testing.T, path, got string) {
+	t.Helper()
+
+	if *update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(got), 0o644))
+	}
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), got)
+}
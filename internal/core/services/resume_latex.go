@@ -0,0 +1,394 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// latexPreamble is the document preamble of Jake's Resume
+// (https://github.com/jakegut/resume, MIT licensed).
+//
+// pdflatex only typesets Latin scripts; resumes in other scripts, such as
+// Cyrillic or CJK, compile with xelatex or lualatex, which load fontspec
+// instead and need a \setmainfont covering the script.
+const latexPreamble = `%-------------------------
+% Resume in LaTeX
+% Based on Jake's Resume: https://github.com/jakegut/resume
+% License: MIT
+%------------------------
+
+\documentclass[letterpaper,11pt]{article}
+
+\usepackage{iftex}
+\ifPDFTeX
+  \usepackage[utf8]{inputenc}
+  \usepackage[T1]{fontenc}
+  \input{glyphtounicode}
+\else
+  % xelatex or lualatex: for Cyrillic or CJK text, pick a font covering it,
+  % e.g. \setmainfont{Noto Serif CJK SC}
+  \usepackage{fontspec}
+\fi
+\usepackage{latexsym}
+\usepackage[empty]{fullpage}
+\usepackage{titlesec}
+\usepackage{marvosym}
+\usepackage[usenames,dvipsnames]{color}
+\usepackage{verbatim}
+\usepackage{enumitem}
+\usepackage[hidelinks]{hyperref}
+\usepackage{fancyhdr}
+\usepackage{tabularx}
+
+\pagestyle{fancy}
+\fancyhf{}
+\fancyfoot{}
+\renewcommand{\headrulewidth}{0pt}
+\renewcommand{\footrulewidth}{0pt}
+
+% Adjust margins
+\addtolength{\oddsidemargin}{-0.5in}
+\addtolength{\evensidemargin}{-0.5in}
+\addtolength{\textwidth}{1in}
+\addtolength{\topmargin}{-.5in}
+\addtolength{\textheight}{1.0in}
+
+\urlstyle{same}
+
+\raggedbottom
+\raggedright
+\setlength{\tabcolsep}{0in}
+
+% Sections formatting
+\titleformat{\section}{
+  \vspace{-4pt}\scshape\raggedright\large
+}{}{0em}{}[\color{black}\titlerule \vspace{-5pt}]
+
+% Ensure that generated pdf is machine readable/ATS parsable
+\ifPDFTeX
+  \pdfgentounicode=1
+\fi
+
+%-------------------------
+% Custom commands
+\newcommand{\resumeItem}[1]{
+  \item\small{
+    {#1 \vspace{-2pt}}
+  }
+}
+
+\newcommand{\resumeSubheading}[4]{
+  \vspace{-2pt}\item
+    \begin{tabular*}{0.97\textwidth}[t]{l@{\extracolsep{\fill}}r}
+      \textbf{#1} & #2 \\
+      \textit{\small#3} & \textit{\small #4} \\
+    \end{tabular*}\vspace{-7pt}
+}
+
+\newcommand{\resumeProjectHeading}[2]{
+    \item
+    \begin{tabular*}{0.97\textwidth}{l@{\extracolsep{\fill}}r}
+      \small#1 & #2 \\
+    \end{tabular*}\vspace{-7pt}
+}
+
+\renewcommand\labelitemii{$\vcenter{\hbox{\tiny$\bullet$}}$}
+
+\newcommand{\resumeSubHeadingListStart}{\begin{itemize}[leftmargin=0.15in, label={}]}
+\newcommand{\resumeSubHeadingListEnd}{\end{itemize}}
+\newcommand{\resumeItemListStart}{\begin{itemize}}
+\newcommand{\resumeItemListEnd}{\end{itemize}\vspace{-5pt}}
+
+%-------------------------------------------
+%%%%%%  RESUME STARTS HERE  %%%%%%%%%%%%%%%%%%%%%%%%%%%%
+`
+
+// LaTeXResumeTemplate renders the LaTeX source of Jake's Resume with the
+// tailored content, so power users can compile and fine-tune it themselves.
+// Sections follow the HTML template: Header → Summary → Education →
+// Technical Skills → Experience → Projects → Languages.
+type LaTeXResumeTemplate struct{}
+
+// NewLaTeXResumeTemplate creates a new LaTeX resume template.
+func NewLaTeXResumeTemplate() *LaTeXResumeTemplate {
+	return &LaTeXResumeTemplate{}
+}
+
+// Render generates the .tex source for the resume.
+func (t *LaTeXResumeTemplate) Render(data ResumeTemplateData) string {
+	i18n := data.i18n()
+
+	var sb strings.Builder
+	sb.WriteString(latexPreamble)
+	sb.WriteString("\n\\begin{document}\n")
+
+	sb.WriteString(t.renderHeader(data.User, data.Locale))
+
+	if data.ShowSummary {
+		if summary := data.summary(); summary != "" {
+			sb.WriteString(t.sectionHeader(i18n.T(KeyProfessionalSummary)))
+			fmt.Fprintf(&sb, "\\small{%s}\n", latexMarkdownBold(summary))
+		}
+	}
+
+	if len(data.Education) > 0 {
+		sb.WriteString(t.renderEducation(data.Education, i18n))
+	}
+
+	content := data.Resume.GeneratedContent
+
+	if content != nil && len(content.Skills) > 0 {
+		sb.WriteString(t.renderSkills(content.Skills, data.Skills, data.Resume.Sections.SkillsDisplay, i18n))
+	}
+
+	if content != nil && len(content.Experiences) > 0 {
+		sb.WriteString(t.renderExperience(content.Experiences, i18n))
+	}
+
+	if len(data.Projects) > 0 {
+		sb.WriteString(t.renderProjects(data.Projects, i18n))
+	}
+
+	if len(data.Languages) > 0 {
+		sb.WriteString(t.renderLanguages(data.Languages, i18n))
+	}
+
+	sb.WriteString("\n%-------------------------------------------\n")
+	sb.WriteString("\\end{document}\n")
+
+	return sb.String()
+}
+
+// sectionHeader starts a new resume section.
+func (t *LaTeXResumeTemplate) sectionHeader(title string) string {
+	return fmt.Sprintf("\n%%----------%s----------\n\\section{%s}\n", strings.ToUpper(title), escapeLaTeX(title))
+}
+
+// renderHeader generates the centered name and contact line.
+func (t *LaTeXResumeTemplate) renderHeader(user *domain.User, locale Locale) string {
+	if user == nil {
+		return ""
+	}
+
+	var contacts []string
+	if user.Phone != nil && *user.Phone != "" {
+		contacts = append(contacts, escapeLaTeX(displayPhone(*user.Phone, locale)))
+	}
+	if user.Email != nil && *user.Email != "" {
+		contacts = append(contacts, latexHref("mailto:"+*user.Email, *user.Email))
+	}
+	if user.LinkedInURL != nil && *user.LinkedInURL != "" {
+		contacts = append(contacts, latexHref(*user.LinkedInURL, extractURLDisplay(*user.LinkedInURL, "linkedin.com/in/")))
+	}
+	if user.GitHubURL != nil && *user.GitHubURL != "" {
+		contacts = append(contacts, latexHref(*user.GitHubURL, extractURLDisplay(*user.GitHubURL, "github.com/")))
+	}
+	if user.PortfolioURL != nil && *user.PortfolioURL != "" {
+		contacts = append(contacts, latexHref(*user.PortfolioURL, extractDomain(*user.PortfolioURL)))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n%----------HEADING----------\n")
+	sb.WriteString("\\begin{center}\n")
+	fmt.Fprintf(&sb, "    \\textbf{\\Huge \\scshape %s} \\\\ \\vspace{1pt}\n", escapeLaTeX(user.GetDisplayName()))
+	if len(contacts) > 0 {
+		fmt.Fprintf(&sb, "    \\small %s\n", strings.Join(contacts, " $|$ "))
+	}
+	sb.WriteString("\\end{center}\n")
+
+	return sb.String()
+}
+
+// renderEducation generates the education section.
+func (t *LaTeXResumeTemplate) renderEducation(education []domain.Education, i18n *I18n) string {
+	var sb strings.Builder
+	sb.WriteString(t.sectionHeader(i18n.T(KeyEducation)))
+	sb.WriteString("  \\resumeSubHeadingListStart\n")
+
+	for _, edu := range education {
+		location := ""
+		if edu.Location != nil {
+			location = *edu.Location
+		}
+		degree := edu.Degree
+		if edu.FieldOfStudy != nil && *edu.FieldOfStudy != "" {
+			degree += " in " + *edu.FieldOfStudy
+		}
+
+		fmt.Fprintf(&sb, "    \\resumeSubheading\n      {%s}{%s}\n      {%s}{%s}\n",
+			escapeLaTeX(edu.Institution), escapeLaTeX(location),
+			escapeLaTeX(degree), escapeLaTeX(formatEducationDateRangeLocalized(edu.StartDate, edu.EndDate, edu.IsExpected, i18n)))
+
+		var extras []string
+		if edu.GPA != nil && *edu.GPA != "" {
+			extras = append(extras, i18n.T(KeyGPA)+": "+*edu.GPA)
+		}
+		if len(edu.Honors) > 0 {
+			extras = append(extras, strings.Join(edu.Honors, ", "))
+		}
+		if len(extras) > 0 {
+			sb.WriteString("      \\resumeItemListStart\n")
+			fmt.Fprintf(&sb, "        \\resumeItem{%s}\n", escapeLaTeX(strings.Join(extras, " | ")))
+			sb.WriteString("      \\resumeItemListEnd\n")
+		}
+	}
+
+	sb.WriteString("  \\resumeSubHeadingListEnd\n")
+	return sb.String()
+}
+
+// renderExperience generates the experience section.
+func (t *LaTeXResumeTemplate) renderExperience(experiences []domain.TailoredExperience, i18n *I18n) string {
+	var sb strings.Builder
+	sb.WriteString(t.sectionHeader(i18n.T(KeyExperience)))
+	sb.WriteString("  \\resumeSubHeadingListStart\n")
+
+	for _, exp := range experiences {
+		dateStr := formatExperienceDateRangeLocalized(exp.StartDate, exp.EndDate, exp.IsCurrent, i18n)
+		fmt.Fprintf(&sb, "    \\resumeSubheading\n      {%s}{%s}\n      {%s}{}\n",
+			escapeLaTeX(exp.Title), escapeLaTeX(dateStr), escapeLaTeX(exp.Organization))
+
+		if len(exp.Bullets) > 0 {
+			sb.WriteString("      \\resumeItemListStart\n")
+			for _, bullet := range exp.Bullets {
+				content := bullet.DisplayContent()
+				fmt.Fprintf(&sb, "        \\resumeItem{%s}\n", latexMarkdownBold(content))
+			}
+			sb.WriteString("      \\resumeItemListEnd\n")
+		}
+	}
+
+	sb.WriteString("  \\resumeSubHeadingListEnd\n")
+	return sb.String()
+}
+
+// renderProjects generates the projects section.
+func (t *LaTeXResumeTemplate) renderProjects(projects []domain.Project, i18n *I18n) string {
+	var sb strings.Builder
+	sb.WriteString(t.sectionHeader(i18n.T(KeyProjects)))
+	sb.WriteString("    \\resumeSubHeadingListStart\n")
+
+	for _, proj := range projects {
+		heading := "\\textbf{" + escapeLaTeX(proj.Name) + "}"
+		if len(proj.TechStack) > 0 {
+			heading += " $|$ \\emph{" + escapeLaTeX(strings.Join(proj.TechStack, ", ")) + "}"
+		}
+		if proj.RepositoryURL != nil && *proj.RepositoryURL != "" {
+			heading += " " + latexHref(*proj.RepositoryURL, "[Source]")
+		}
+		if proj.URL != nil && *proj.URL != "" {
+			heading += " " + latexHref(*proj.URL, "[Demo]")
+		}
+
+		fmt.Fprintf(&sb, "      \\resumeProjectHeading\n          {%s}{%s}\n",
+			heading, escapeLaTeX(formatProjectDateRangeLocalized(proj.StartDate, proj.EndDate, i18n)))
+
+		if len(proj.Bullets) > 0 {
+			sb.WriteString("          \\resumeItemListStart\n")
+			for _, bullet := range proj.Bullets {
+				fmt.Fprintf(&sb, "            \\resumeItem{%s}\n", latexMarkdownBold(bullet.Content))
+			}
+			sb.WriteString("          \\resumeItemListEnd\n")
+		}
+	}
+
+	sb.WriteString("    \\resumeSubHeadingListEnd\n")
+	return sb.String()
+}
+
+// renderSkills generates the technical skills section in key-value format.
+func (t *LaTeXResumeTemplate) renderSkills(selectedSkills []string, userSkills []domain.Skill, display domain.SkillsDisplay, i18n *I18n) string {
+	groups := groupSkillsByCategory(selectedSkills, userSkills)
+
+	rows := make([]string, 0, len(groups))
+	for _, group := range groups {
+		rows = append(rows, fmt.Sprintf("     \\textbf{%s}{: %s}", escapeLaTeX(group.Category), escapeLaTeX(strings.Join(group.labels(display, i18n), ", "))))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(t.sectionHeader(i18n.T(KeyTechnicalSkills)))
+	sb.WriteString(" \\begin{itemize}[leftmargin=0.15in, label={}]\n")
+	sb.WriteString("    \\small{\\item{\n")
+	sb.WriteString(strings.Join(rows, " \\\\\n"))
+	sb.WriteString("\n    }}\n")
+	sb.WriteString(" \\end{itemize}\n")
+	return sb.String()
+}
+
+// renderLanguages generates the spoken languages section.
+func (t *LaTeXResumeTemplate) renderLanguages(languages []domain.SpokenLanguage, i18n *I18n) string {
+	items := make([]string, 0, len(languages))
+	for _, lang := range languages {
+		items = append(items, fmt.Sprintf("\\textbf{%s} (%s)",
+			escapeLaTeX(lang.Language), escapeLaTeX(i18n.FormatProficiencyLevel(string(lang.Proficiency)))))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(t.sectionHeader(i18n.T(KeyLanguages)))
+	sb.WriteString(" \\begin{itemize}[leftmargin=0.15in, label={}]\n")
+	fmt.Fprintf(&sb, "    \\small{\\item{%s}}\n", strings.Join(items, ", "))
+	sb.WriteString(" \\end{itemize}\n")
+	return sb.String()
+}
+
+// latexReplacer escapes characters that have a special meaning in LaTeX.
+var latexReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`$`, `\$`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	`<`, `\textless{}`,
+	`>`, `\textgreater{}`,
+)
+
+// escapeLaTeX escapes text for use in LaTeX body content.
+func escapeLaTeX(text string) string {
+	return latexReplacer.Replace(text)
+}
+
+// latexURLReplacer escapes the characters that break \href URLs.
+var latexURLReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`#`, `\#`,
+	`%`, `\%`,
+	`{`, `\{`,
+	`}`, `\}`,
+)
+
+// latexHref renders a hyperlink with an escaped URL and label.
+func latexHref(url, label string) string {
+	return fmt.Sprintf(`\href{%s}{\underline{%s}}`, latexURLReplacer.Replace(url), escapeLaTeX(label))
+}
+
+// latexMarkdownBold escapes text and converts markdown **bold** to \textbf{}.
+func latexMarkdownBold(text string) string {
+	escaped := escapeLaTeX(text)
+
+	var result strings.Builder
+	for {
+		start := strings.Index(escaped, "**")
+		if start == -1 {
+			break
+		}
+		end := strings.Index(escaped[start+2:], "**")
+		if end == -1 {
+			break
+		}
+		result.WriteString(escaped[:start])
+		result.WriteString(`\textbf{`)
+		result.WriteString(escaped[start+2 : start+2+end])
+		result.WriteString(`}`)
+		escaped = escaped[start+2+end+2:]
+	}
+	result.WriteString(escaped)
+
+	return result.String()
+}
@@ -0,0 +1,114 @@
+package services_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+	"github.com/SeltikHD/chameleon-vitae/internal/testutil/fixtures"
+)
+
+func TestEscapeLaTeX(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: `C:\Users`, want: `C:\textbackslash{}Users`},
+		{in: `{braces}`, want: `\{braces\}`},
+		{in: `$100`, want: `\$100`},
+		{in: `R&D`, want: `R\&D`},
+		{in: `#1`, want: `\#1`},
+		{in: `x^2`, want: `x\textasciicircum{}2`},
+		{in: `snake_case`, want: `snake\_case`},
+		{in: `~/bin`, want: `\textasciitilde{}/bin`},
+		{in: `40%`, want: `40\%`},
+		{in: `<b>`, want: `\textless{}b\textgreater{}`},
+		// Replacements are not escaped again.
+		{in: `\{}`, want: `\textbackslash{}\{\}`},
+		{in: `Zoë Инженер 日本語`, want: `Zoë Инженер 日本語`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, services.EscapeLaTeX(tt.in))
+		})
+	}
+}
+
+func TestLaTeXHref(t *testing.T) {
+	tests := []struct {
+		name  string
+		url   string
+		label string
+		want  string
+	}{
+		{
+			name:  "plain URL",
+			url:   "https://example.com/ana",
+			label: "example.com/ana",
+			want:  `\href{https://example.com/ana}{\underline{example.com/ana}}`,
+		},
+		{
+			name:  "percent-encoded URL",
+			url:   "https://example.com/ana%20souza",
+			label: "Ana Souza",
+			want:  `\href{https://example.com/ana\%20souza}{\underline{Ana Souza}}`,
+		},
+		{
+			name:  "URL with a fragment",
+			url:   "https://example.com/cv#projects",
+			label: "cv#projects",
+			want:  `\href{https://example.com/cv\#projects}{\underline{cv\#projects}}`,
+		},
+		{
+			name:  "label with special characters",
+			url:   "https://example.com/a_b",
+			label: "a_b & co",
+			want:  `\href{https://example.com/a_b}{\underline{a\_b \& co}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, services.LaTeXHref(tt.url, tt.label))
+		})
+	}
+}
+
+func TestLaTeXMarkdownBold(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "Cut latency by **40%**", want: `Cut latency by \textbf{40\%}`},
+		{in: "**R&D** and **$1M**", want: `\textbf{R\&D} and \textbf{\$1M}`},
+		{in: "Unclosed **bold", want: `Unclosed **bold`},
+		{in: "No bold", want: "No bold"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, services.LaTeXMarkdownBold(tt.in))
+		})
+	}
+}
+
+// TestLaTeXNonLatinScripts checks that text in other scripts is kept as it
+// is, and that the preamble loads fontspec when compiled with xelatex or
+// lualatex, as pdflatex cannot typeset it.
+func TestLaTeXNonLatinScripts(t *testing.T) {
+	tex := services.NewLaTeXResumeTemplate().Render(fixtures.NonASCII().TemplateData(services.LocaleEnUS))
+
+	assert.Contains(t, tex, "Инженер")
+	assert.Contains(t, tex, "王小明")
+
+	preamble, _, found := strings.Cut(tex, `\begin{document}`)
+	require.True(t, found)
+	assert.Contains(t, preamble, "\\ifPDFTeX\n  \\usepackage[utf8]{inputenc}")
+	assert.Contains(t, preamble, "\\else\n  % xelatex or lualatex")
+	assert.Contains(t, preamble, `\usepackage{fontspec}`)
+	assert.Contains(t, preamble, "\\ifPDFTeX\n  \\pdfgentounicode=1\n\\fi")
+}
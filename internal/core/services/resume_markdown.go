@@ -0,0 +1,205 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// MarkdownResumeTemplate renders a tailored resume as clean Markdown,
+// suitable for pasting into emails, Notion or plaintext application forms.
+// Sections: Header → Summary → Experience → Projects → Skills → Education → Languages.
+type MarkdownResumeTemplate struct{}
+
+// NewMarkdownResumeTemplate creates a new Markdown resume template.
+func NewMarkdownResumeTemplate() *MarkdownResumeTemplate {
+	return &MarkdownResumeTemplate{}
+}
+
+// Render generates the Markdown for the resume.
+func (t *MarkdownResumeTemplate) Render(data ResumeTemplateData) string {
+	i18n := data.i18n()
+
+	var sections []string
+
+	if header := t.renderHeader(data.User, data.Locale); header != "" {
+		sections = append(sections, header)
+	}
+
+	if data.ShowSummary {
+		if summary := data.summary(); summary != "" {
+			sections = append(sections, fmt.Sprintf("## %s\n\n%s", i18n.T(KeyProfessionalSummary), summary))
+		}
+	}
+
+	content := data.Resume.GeneratedContent
+
+	if content != nil && len(content.Experiences) > 0 {
+		sections = append(sections, t.renderExperience(content.Experiences, i18n))
+	}
+
+	if len(data.Projects) > 0 {
+		sections = append(sections, t.renderProjects(data.Projects, i18n))
+	}
+
+	if content != nil && len(content.Skills) > 0 {
+		sections = append(sections, t.renderSkills(content.Skills, data.Skills, data.Resume.Sections.SkillsDisplay, i18n))
+	}
+
+	if len(data.Education) > 0 {
+		sections = append(sections, t.renderEducation(data.Education, i18n))
+	}
+
+	if len(data.Languages) > 0 {
+		sections = append(sections, t.renderLanguages(data.Languages, i18n))
+	}
+
+	return strings.Join(sections, "\n\n") + "\n"
+}
+
+// renderHeader generates the name heading and contact line.
+func (t *MarkdownResumeTemplate) renderHeader(user *domain.User, locale Locale) string {
+	if user == nil {
+		return ""
+	}
+
+	var contacts []string
+	if user.Phone != nil && *user.Phone != "" {
+		contacts = append(contacts, displayPhone(*user.Phone, locale))
+	}
+	if user.Email != nil && *user.Email != "" {
+		contacts = append(contacts, fmt.Sprintf("[%s](mailto:%s)", *user.Email, *user.Email))
+	}
+	if user.LinkedInURL != nil && *user.LinkedInURL != "" {
+		contacts = append(contacts, fmt.Sprintf("[%s](%s)", extractURLDisplay(*user.LinkedInURL, "linkedin.com/in/"), *user.LinkedInURL))
+	}
+	if user.GitHubURL != nil && *user.GitHubURL != "" {
+		contacts = append(contacts, fmt.Sprintf("[%s](%s)", extractURLDisplay(*user.GitHubURL, "github.com/"), *user.GitHubURL))
+	}
+	if user.PortfolioURL != nil && *user.PortfolioURL != "" {
+		contacts = append(contacts, fmt.Sprintf("[%s](%s)", extractDomain(*user.PortfolioURL), *user.PortfolioURL))
+	}
+
+	header := "# " + user.GetDisplayName()
+	if len(contacts) > 0 {
+		header += "\n\n" + strings.Join(contacts, " | ")
+	}
+	return header
+}
+
+// renderExperience generates the experience section.
+func (t *MarkdownResumeTemplate) renderExperience(experiences []domain.TailoredExperience, i18n *I18n) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## %s", i18n.T(KeyExperience))
+
+	for _, exp := range experiences {
+		fmt.Fprintf(&sb, "\n\n### %s — %s\n\n", exp.Title, exp.Organization)
+		fmt.Fprintf(&sb, "*%s*", formatExperienceDateRangeLocalized(exp.StartDate, exp.EndDate, exp.IsCurrent, i18n))
+
+		if len(exp.Bullets) > 0 {
+			sb.WriteString("\n")
+			for _, bullet := range exp.Bullets {
+				content := bullet.DisplayContent()
+				fmt.Fprintf(&sb, "\n- %s", content)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// renderProjects generates the projects section.
+func (t *MarkdownResumeTemplate) renderProjects(projects []domain.Project, i18n *I18n) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## %s", i18n.T(KeyProjects))
+
+	for _, proj := range projects {
+		fmt.Fprintf(&sb, "\n\n### %s", proj.Name)
+
+		var meta []string
+		if len(proj.TechStack) > 0 {
+			meta = append(meta, "*"+strings.Join(proj.TechStack, ", ")+"*")
+		}
+		if dateStr := formatProjectDateRangeLocalized(proj.StartDate, proj.EndDate, i18n); dateStr != "" {
+			meta = append(meta, dateStr)
+		}
+		if proj.RepositoryURL != nil && *proj.RepositoryURL != "" {
+			meta = append(meta, fmt.Sprintf("[Source](%s)", *proj.RepositoryURL))
+		}
+		if proj.URL != nil && *proj.URL != "" {
+			meta = append(meta, fmt.Sprintf("[Demo](%s)", *proj.URL))
+		}
+		if len(meta) > 0 {
+			sb.WriteString("\n\n" + strings.Join(meta, " | "))
+		}
+
+		if len(proj.Bullets) > 0 {
+			sb.WriteString("\n")
+			for _, bullet := range proj.Bullets {
+				fmt.Fprintf(&sb, "\n- %s", bullet.Content)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// renderSkills generates the technical skills section grouped by category.
+func (t *MarkdownResumeTemplate) renderSkills(selectedSkills []string, userSkills []domain.Skill, display domain.SkillsDisplay, i18n *I18n) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## %s\n", i18n.T(KeyTechnicalSkills))
+
+	for _, group := range groupSkillsByCategory(selectedSkills, userSkills) {
+		fmt.Fprintf(&sb, "\n- **%s:** %s", group.Category, strings.Join(group.labels(display, i18n), ", "))
+	}
+
+	return sb.String()
+}
+
+// renderEducation generates the education section.
+func (t *MarkdownResumeTemplate) renderEducation(education []domain.Education, i18n *I18n) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## %s", i18n.T(KeyEducation))
+
+	for _, edu := range education {
+		fmt.Fprintf(&sb, "\n\n### %s", edu.Institution)
+		if edu.Location != nil && *edu.Location != "" {
+			fmt.Fprintf(&sb, " — %s", *edu.Location)
+		}
+
+		degree := edu.Degree
+		if edu.FieldOfStudy != nil && *edu.FieldOfStudy != "" {
+			degree += " in " + *edu.FieldOfStudy
+		}
+		fmt.Fprintf(&sb, "\n\n%s", degree)
+		if dateStr := formatEducationDateRangeLocalized(edu.StartDate, edu.EndDate, edu.IsExpected, i18n); dateStr != "" {
+			fmt.Fprintf(&sb, " | *%s*", dateStr)
+		}
+
+		var extras []string
+		if edu.GPA != nil && *edu.GPA != "" {
+			extras = append(extras, i18n.T(KeyGPA)+": "+*edu.GPA)
+		}
+		if len(edu.Honors) > 0 {
+			extras = append(extras, strings.Join(edu.Honors, ", "))
+		}
+		if len(extras) > 0 {
+			fmt.Fprintf(&sb, "\n\n%s", strings.Join(extras, " | "))
+		}
+	}
+
+	return sb.String()
+}
+
+// renderLanguages generates the spoken languages section.
+func (t *MarkdownResumeTemplate) renderLanguages(languages []domain.SpokenLanguage, i18n *I18n) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## %s\n", i18n.T(KeyLanguages))
+
+	for _, lang := range languages {
+		fmt.Fprintf(&sb, "\n- %s (%s)", lang.Language, i18n.FormatProficiencyLevel(string(lang.Proficiency)))
+	}
+
+	return sb.String()
+}
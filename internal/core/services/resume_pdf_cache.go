@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// SetJobRunner runs PDF caching on runner, so that it is drained on shutdown
+// and resumed on the next start. Without it, PDF caching runs on a runner of
+// its own whose interrupted jobs are dropped.
+func (s *ResumeService) SetJobRunner(runner *JobRunner) {
+	s.jobs = runner
+	runner.Handle(domain.BackgroundJobCachePDF, s.resumeCachePDF)
+}
+
+// newCachePDFJob returns the job caching the PDF of a resume rendered with a
+// template and density.
+func newCachePDFJob(resume *domain.Resume, templateName string, density Density) *domain.BackgroundJob {
+	return domain.NewBackgroundJob(domain.BackgroundJobCachePDF, resume, map[string]string{
+		"template": templateName,
+		"density":  string(density),
+	})
+}
+
+// uploadCachedPDF stores the PDF of a cache job under key.
+func (s *ResumeService) uploadCachedPDF(ctx context.Context, job domain.BackgroundJob, key string, content []byte) error {
+	_, err := s.fileStorage.Upload(ctx, ports.UploadRequest{
+		Key:         key,
+		Content:     newBytesReader(content),
+		ContentType: "application/pdf",
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to cache PDF: %w", err)
+		reportError(ctx, s.reporter, "cache pdf", err, job.UserID, job.ResumeID)
+	}
+	return err
+}
+
+// resumeCachePDF runs a cache job saved by an earlier process. The PDF is
+// generated again from the resume as it is now, without counting against the
+// user's quota. Jobs whose resume is gone or no longer exportable are done.
+func (s *ResumeService) resumeCachePDF(ctx context.Context, job domain.BackgroundJob) error {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, job.ResumeID, job.UserID)
+	if errors.Is(err, domain.ErrResumeNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get resume: %w", err)
+	}
+	if !resume.CanGeneratePDF() {
+		return nil
+	}
+
+	density, err := ParseDensity(job.Params["density"])
+	if err != nil {
+		return err
+	}
+	templateName := job.Params["template"]
+
+	user, err := s.userRepo.GetByID(ctx, resume.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	htmlContent, err := s.renderResumeHTMLWithTemplate(ctx, user, resume, templateName, density)
+	if err != nil {
+		return err
+	}
+
+	pdfResult, err := s.pdfEngine.GeneratePDF(ctx, ports.GeneratePDFRequest{
+		HTML:         htmlContent,
+		TemplateName: templateName,
+		Options:      resumePDFOptions(resume, templateName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate PDF: %w", err)
+	}
+	defer pdfResult.Content.Close()
+
+	pdfBytes, err := readAll(pdfResult.Content)
+	if err != nil {
+		return fmt.Errorf("failed to read PDF content: %w", err)
+	}
+	return s.uploadCachedPDF(ctx, job, pdfCacheKey(resume, templateName, density), pdfBytes)
+}
@@ -0,0 +1,51 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestPDFURLKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		pdfURL string
+		want   string
+	}{
+		{
+			name:   "local storage URL",
+			pdfURL: "http://localhost:8080/files/resumes/user-1/resume-1.pdf?expires=1&signature=abc",
+			want:   "resumes/user-1/resume-1.pdf",
+		},
+		{
+			name:   "template PDF",
+			pdfURL: "https://storage.googleapis.com/bucket/resumes/user-1/resume-1_academic.pdf?X-Goog-Signature=abc",
+			want:   "resumes/user-1/resume-1_academic.pdf",
+		},
+		{
+			name:   "another resume's PDF",
+			pdfURL: "http://localhost:8080/files/resumes/user-1/resume-10.pdf",
+			want:   "resumes/user-1/resume-1.pdf",
+		},
+		{
+			name:   "another user's PDF",
+			pdfURL: "http://localhost:8080/files/resumes/user-2/resume-1_academic.pdf",
+			want:   "resumes/user-1/resume-1.pdf",
+		},
+		{
+			name:   "not a storage URL",
+			pdfURL: "memory://expired",
+			want:   "resumes/user-1/resume-1.pdf",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resume := &domain.Resume{ID: "resume-1", UserID: "user-1", PDFURL: &tt.pdfURL}
+			assert.Equal(t, tt.want, services.PDFURLKey(resume))
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// plainTextWidth is the width of section header rules in plain-text exports.
+const plainTextWidth = 60
+
+// PlainTextResumeTemplate renders an ATS-safe plain-text resume.
+// Output is strict ASCII with uppercase section headers and no markdown
+// markers, for legacy applicant portals that only accept copy-pasted text.
+type PlainTextResumeTemplate struct{}
+
+// NewPlainTextResumeTemplate creates a new plain-text resume template.
+func NewPlainTextResumeTemplate() *PlainTextResumeTemplate {
+	return &PlainTextResumeTemplate{}
+}
+
+// Render generates the plain text for the resume.
+func (t *PlainTextResumeTemplate) Render(data ResumeTemplateData) string {
+	i18n := data.i18n()
+
+	var sections []string
+
+	if header := t.renderHeader(data.User, data.Locale); header != "" {
+		sections = append(sections, header)
+	}
+
+	if data.ShowSummary {
+		if summary := data.summary(); summary != "" {
+			sections = append(sections, t.sectionHeader(i18n.T(KeyProfessionalSummary))+stripMarkdownBold(summary))
+		}
+	}
+
+	content := data.Resume.GeneratedContent
+
+	if content != nil && len(content.Experiences) > 0 {
+		sections = append(sections, t.renderExperience(content.Experiences, i18n))
+	}
+
+	if len(data.Projects) > 0 {
+		sections = append(sections, t.renderProjects(data.Projects, i18n))
+	}
+
+	if content != nil && len(content.Skills) > 0 {
+		sections = append(sections, t.renderSkills(content.Skills, data.Skills, data.Resume.Sections.SkillsDisplay, i18n))
+	}
+
+	if len(data.Education) > 0 {
+		sections = append(sections, t.renderEducation(data.Education, i18n))
+	}
+
+	if len(data.Languages) > 0 {
+		sections = append(sections, t.renderLanguages(data.Languages, i18n))
+	}
+
+	return tidyPlainText(toASCII(strings.Join(sections, "\n\n"))) + "\n"
+}
+
+// tidyPlainText trims the spaces that characters dropped by toASCII leave
+// at line ends, and drops the list items left empty.
+func tidyPlainText(text string) string {
+	lines := strings.Split(text, "\n")
+	out := lines[:0]
+	for _, line := range lines {
+		line = strings.TrimRight(line, " ")
+		if line == "-" {
+			continue
+		}
+		out = append(out, line)
+	}
+	return strings.Join(out, "\n")
+}
+
+// sectionHeader formats a standard uppercase section header followed by a rule.
+func (t *PlainTextResumeTemplate) sectionHeader(title string) string {
+	return strings.ToUpper(title) + "\n" + strings.Repeat("-", plainTextWidth) + "\n"
+}
+
+// renderHeader generates the name and contact lines.
+func (t *PlainTextResumeTemplate) renderHeader(user *domain.User, locale Locale) string {
+	if user == nil {
+		return ""
+	}
+
+	var contacts []string
+	if user.Phone != nil && *user.Phone != "" {
+		contacts = append(contacts, displayPhone(*user.Phone, locale))
+	}
+	if user.Email != nil && *user.Email != "" {
+		contacts = append(contacts, *user.Email)
+	}
+	if user.Location != nil && *user.Location != "" {
+		contacts = append(contacts, *user.Location)
+	}
+
+	var links []string
+	for _, u := range []*string{user.LinkedInURL, user.GitHubURL, user.PortfolioURL} {
+		if u != nil && *u != "" {
+			links = append(links, *u)
+		}
+	}
+
+	lines := []string{strings.ToUpper(user.GetDisplayName())}
+	if len(contacts) > 0 {
+		lines = append(lines, strings.Join(contacts, " | "))
+	}
+	lines = append(lines, links...)
+
+	return strings.Join(lines, "\n")
+}
+
+// renderExperience generates the experience section.
+func (t *PlainTextResumeTemplate) renderExperience(experiences []domain.TailoredExperience, i18n *I18n) string {
+	var sb strings.Builder
+	sb.WriteString(t.sectionHeader(i18n.T(KeyExperience)))
+
+	for i, exp := range experiences {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "%s\n%s\n%s\n", exp.Title, exp.Organization,
+			formatExperienceDateRangeLocalized(exp.StartDate, exp.EndDate, exp.IsCurrent, i18n))
+
+		for _, bullet := range exp.Bullets {
+			content := bullet.DisplayContent()
+			fmt.Fprintf(&sb, "- %s\n", stripMarkdownBold(content))
+		}
+	}
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// renderProjects generates the projects section.
+func (t *PlainTextResumeTemplate) renderProjects(projects []domain.Project, i18n *I18n) string {
+	var sb strings.Builder
+	sb.WriteString(t.sectionHeader(i18n.T(KeyProjects)))
+
+	for i, proj := range projects {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(proj.Name + "\n")
+		if len(proj.TechStack) > 0 {
+			sb.WriteString(strings.Join(proj.TechStack, ", ") + "\n")
+		}
+		if dateStr := formatProjectDateRangeLocalized(proj.StartDate, proj.EndDate, i18n); dateStr != "" {
+			sb.WriteString(dateStr + "\n")
+		}
+		if proj.URL != nil && *proj.URL != "" {
+			sb.WriteString(*proj.URL + "\n")
+		}
+		if proj.RepositoryURL != nil && *proj.RepositoryURL != "" {
+			sb.WriteString(*proj.RepositoryURL + "\n")
+		}
+		for _, bullet := range proj.Bullets {
+			fmt.Fprintf(&sb, "- %s\n", stripMarkdownBold(bullet.Content))
+		}
+	}
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// renderSkills generates the skills section grouped by category.
+func (t *PlainTextResumeTemplate) renderSkills(selectedSkills []string, userSkills []domain.Skill, display domain.SkillsDisplay, i18n *I18n) string {
+	var sb strings.Builder
+	sb.WriteString(t.sectionHeader(i18n.T(KeyTechnicalSkills)))
+
+	for _, group := range groupSkillsByCategory(selectedSkills, userSkills) {
+		fmt.Fprintf(&sb, "%s: %s\n", group.Category, strings.Join(group.labels(display, i18n), ", "))
+	}
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// renderEducation generates the education section.
+func (t *PlainTextResumeTemplate) renderEducation(education []domain.Education, i18n *I18n) string {
+	var sb strings.Builder
+	sb.WriteString(t.sectionHeader(i18n.T(KeyEducation)))
+
+	for i, edu := range education {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		institution := edu.Institution
+		if edu.Location != nil && *edu.Location != "" {
+			institution += ", " + *edu.Location
+		}
+		sb.WriteString(institution + "\n")
+
+		degree := edu.Degree
+		if edu.FieldOfStudy != nil && *edu.FieldOfStudy != "" {
+			degree += " in " + *edu.FieldOfStudy
+		}
+		sb.WriteString(degree + "\n")
+
+		if dateStr := formatEducationDateRangeLocalized(edu.StartDate, edu.EndDate, edu.IsExpected, i18n); dateStr != "" {
+			sb.WriteString(dateStr + "\n")
+		}
+		if edu.GPA != nil && *edu.GPA != "" {
+			sb.WriteString(i18n.T(KeyGPA) + ": " + *edu.GPA + "\n")
+		}
+		if len(edu.Honors) > 0 {
+			sb.WriteString(strings.Join(edu.Honors, ", ") + "\n")
+		}
+	}
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// renderLanguages generates the spoken languages section.
+func (t *PlainTextResumeTemplate) renderLanguages(languages []domain.SpokenLanguage, i18n *I18n) string {
+	var sb strings.Builder
+	sb.WriteString(t.sectionHeader(i18n.T(KeyLanguages)))
+
+	for _, lang := range languages {
+		fmt.Fprintf(&sb, "%s (%s)\n", lang.Language, i18n.FormatProficiencyLevel(string(lang.Proficiency)))
+	}
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// stripMarkdownBold removes **bold** markers, keeping the inner text.
+func stripMarkdownBold(text string) string {
+	return strings.ReplaceAll(text, "**", "")
+}
+
+// asciiReplacements maps common non-ASCII characters to ASCII equivalents.
+var asciiReplacements = map[rune]string{
+	'À': "A", 'Á': "A", 'Â': "A", 'Ã': "A", 'Ä': "A", 'Å': "A", 'Æ': "AE",
+	'Ç': "C", 'È': "E", 'É': "E", 'Ê': "E", 'Ë': "E",
+	'Ì': "I", 'Í': "I", 'Î': "I", 'Ï': "I", 'Ð': "D", 'Ñ': "N",
+	'Ò': "O", 'Ó': "O", 'Ô': "O", 'Õ': "O", 'Ö': "O", 'Ø': "O",
+	'Ù': "U", 'Ú': "U", 'Û': "U", 'Ü': "U", 'Ý': "Y", 'Þ': "Th", 'ß': "ss",
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae",
+	'ç': "c", 'è': "e", 'é': "e", 'ê': "e", 'ë': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ð': "d", 'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ý': "y", 'þ': "th", 'ÿ': "y",
+	'Ł': "L", 'ł': "l", 'Œ': "OE", 'œ': "oe", 'Š': "S", 'š': "s", 'Ž': "Z", 'ž': "z",
+	'Č': "C", 'č': "c", 'Ć': "C", 'ć': "c", 'Ś': "S", 'ś': "s", 'Ź': "Z", 'ź': "z",
+	'Ż': "Z", 'ż': "z", 'Ń': "N", 'ń': "n", 'Ę': "E", 'ę': "e", 'Ą': "A", 'ą': "a",
+	'Ř': "R", 'ř': "r", 'Ě': "E", 'ě': "e", 'Ů': "U", 'ů': "u", 'Ğ': "G", 'ğ': "g",
+	'İ': "I", 'ı': "i", 'Ş': "S", 'ş': "s", 'Ő': "O", 'ő': "o", 'Ű': "U", 'ű': "u",
+	'‘': "'", '’': "'", '‚': "'", '‛': "'", '“': `"`, '”': `"`, '„': `"`,
+	'–': "-", '—': "-", '―': "-", '‐': "-", '‑': "-", '−': "-",
+	'•': "-", '·': "-", '▪': "-", '●': "-",
+	'…': "...", ' ': " ", ' ': " ", ' ': " ",
+	'©': "(c)", '®': "(R)", '™': "(TM)", '€': "EUR", '£': "GBP", '°': " deg",
+	'×': "x", '→': "->", '←': "<-", '≥': ">=", '≤': "<=", '±': "+/-",
+}
+
+// toASCII converts text to strict ASCII, transliterating common characters
+// and dropping anything that has no reasonable ASCII equivalent.
+func toASCII(text string) string {
+	var sb strings.Builder
+	sb.Grow(len(text))
+
+	for _, r := range text {
+		switch {
+		case r == '\n' || r == '\t' || (r >= 0x20 && r < 0x7f):
+			sb.WriteRune(r)
+		default:
+			if replacement, ok := asciiReplacements[r]; ok {
+				sb.WriteString(replacement)
+			}
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,113 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// previewContent is saved resume content with one translated bullet.
+func previewContent() *domain.ResumeContent {
+	return &domain.ResumeContent{
+		Summary: "Backend engineer",
+		Experiences: []domain.TailoredExperience{{
+			ExperienceID: "exp-1",
+			Bullets: []domain.TailoredBullet{
+				{BulletID: "bullet-1", TailoredContent: "Cut latency", TranslatedContent: "Reduziu a latência"},
+				{BulletID: "bullet-2", TailoredContent: "Led five engineers"},
+			},
+		}},
+	}
+}
+
+func TestValidatePreviewRequest(t *testing.T) {
+	fontSize := func(size int) *int { return &size }
+
+	t.Run("accepts valid edits", func(t *testing.T) {
+		order, err := services.ValidatePreviewRequest(services.PreviewHTMLRequest{
+			Bullets:      map[string]string{"bullet-1": "Cut p99 latency"},
+			FontSize:     fontSize(10),
+			SectionOrder: []string{" Skills", "experience"},
+		}, previewContent())
+		require.NoError(t, err)
+		assert.Equal(t, []services.ResumeSection{services.SectionSkills, services.SectionExperience}, order)
+	})
+
+	tests := []struct {
+		name      string
+		req       services.PreviewHTMLRequest
+		wantField string
+		wantMsg   string
+	}{
+		{
+			name:      "font size too small",
+			req:       services.PreviewHTMLRequest{FontSize: fontSize(8)},
+			wantField: "font_size",
+			wantMsg:   "must be between 9 and 12",
+		},
+		{
+			name:      "font size too large",
+			req:       services.PreviewHTMLRequest{FontSize: fontSize(13)},
+			wantField: "font_size",
+			wantMsg:   "must be between 9 and 12",
+		},
+		{
+			name:      "unknown section",
+			req:       services.PreviewHTMLRequest{SectionOrder: []string{"hobbies"}},
+			wantField: "section_order",
+			wantMsg:   `unknown section "hobbies"`,
+		},
+		{
+			name:      "duplicate section",
+			req:       services.PreviewHTMLRequest{SectionOrder: []string{"skills", "Skills"}},
+			wantField: "section_order",
+			wantMsg:   `duplicate section "Skills"`,
+		},
+		{
+			name:      "bullet not on the resume",
+			req:       services.PreviewHTMLRequest{Bullets: map[string]string{"bullet-9": "Shipped"}},
+			wantField: "bullets",
+			wantMsg:   `bullet "bullet-9" is not on this resume`,
+		},
+		{
+			name:      "empty bullet",
+			req:       services.PreviewHTMLRequest{Bullets: map[string]string{"bullet-2": "  "}},
+			wantField: "bullets",
+			wantMsg:   `bullet "bullet-2" must not be empty`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := services.ValidatePreviewRequest(tt.req, previewContent())
+
+			var validation *domain.ValidationErrors
+			require.ErrorAs(t, err, &validation)
+			require.Len(t, validation.Errors, 1)
+			assert.Equal(t, tt.wantField, validation.Errors[0].Field)
+			assert.Equal(t, tt.wantMsg, validation.Errors[0].Message)
+		})
+	}
+}
+
+func TestApplyPreviewEdits(t *testing.T) {
+	content := previewContent()
+	summary := "  Staff engineer  "
+
+	draft := services.ApplyPreviewEdits(content, services.PreviewHTMLRequest{
+		Summary: &summary,
+		Bullets: map[string]string{"bullet-1": " Cut p99 latency by 40% "},
+	})
+
+	assert.Equal(t, "Staff engineer", draft.Summary)
+	require.Len(t, draft.Experiences, 1)
+	assert.Equal(t, domain.TailoredBullet{BulletID: "bullet-1", TailoredContent: "Cut p99 latency by 40%"}, draft.Experiences[0].Bullets[0])
+	assert.Equal(t, "Led five engineers", draft.Experiences[0].Bullets[1].TailoredContent)
+
+	// The saved content is left as it was.
+	assert.Equal(t, previewContent(), content)
+}
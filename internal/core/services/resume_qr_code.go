@@ -0,0 +1,32 @@
+package services
+
+import (
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// SetQRCodeEncoder enables the QR codes resumes can show in their header,
+// linking to one of the user's profile links. Without it no QR code is shown.
+func (s *ResumeService) SetQRCodeEncoder(encoder ports.QRCodeEncoder) {
+	s.qrEncoder = encoder
+}
+
+// qrCodeImage returns the header QR code image of a resume, or "" when the
+// resume has none, the user has not set the targeted link or it cannot be
+// encoded. A missing QR code never prevents rendering.
+func (s *ResumeService) qrCodeImage(user *domain.User, resume *domain.Resume) string {
+	if s.qrEncoder == nil || user == nil || resume.QRCode == domain.QRCodeNone {
+		return ""
+	}
+
+	link := resume.QRCode.URL(user)
+	if link == "" {
+		return ""
+	}
+
+	image, err := s.qrEncoder.DataURI(link)
+	if err != nil {
+		return ""
+	}
+	return image
+}
@@ -0,0 +1,303 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// Sections of a tailored resume that can be regenerated. Experiences are
+// named by RegenerateExperiencePrefix followed by the experience ID.
+const (
+	RegenerateSummary          = "summary"
+	RegenerateSkills           = "skills"
+	RegenerateExperiencePrefix = "experience:"
+)
+
+// MaxRegenerateInstructionsLength is the longest instructions, in
+// characters, the AI is given when regenerating a section.
+const MaxRegenerateInstructionsLength = 500
+
+// RegenerateSectionRequest identifies the section of a resume to generate
+// again.
+type RegenerateSectionRequest struct {
+	ResumeID string
+	UserID   string
+
+	// Section is RegenerateSummary, RegenerateSkills, or
+	// RegenerateExperiencePrefix followed by an experience ID.
+	Section string
+
+	// Instructions are the user's own instructions for the AI, such as
+	// "mention the on-call rotation". Skills are chosen without the AI, so
+	// they take none.
+	Instructions string
+}
+
+// RegenerateSection generates one section of a tailored resume again,
+// instead of tailoring it all over: the summary, the bullets of one
+// experience, or the skills list. Only the AI step of the section runs,
+// skipping cached replies so the result differs, with the job analysis and
+// writing voice kept from tailoring; resumes tailored before the analysis
+// was kept have their job analyzed again once. Bullet rewrites that fail
+// validation keep their current content, and bullets no longer in the
+// profile are left as they are.
+func (s *ResumeService) RegenerateSection(ctx context.Context, req RegenerateSectionRequest) (*domain.Resume, error) {
+	section, experienceID, err := parseRegenerateSection(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+	content := resume.GeneratedContent
+	if content == nil {
+		return nil, domain.ErrResumeNotReady
+	}
+
+	experience := -1
+	if section == RegenerateExperiencePrefix {
+		experience = slices.IndexFunc(content.Experiences, func(exp domain.TailoredExperience) bool {
+			return exp.ExperienceID == experienceID
+		})
+		if experience < 0 {
+			v := &domain.ValidationErrors{}
+			v.AddFieldError("section", fmt.Sprintf("experience %q is not on this resume", experienceID))
+			return nil, v
+		}
+	}
+
+	ctx, aiRun := startAIRun(ctx, nil)
+	instructions := strings.TrimSpace(req.Instructions)
+	if err := s.regenerateSection(ctx, resume, section, experience, instructions); err != nil {
+		reportError(ctx, s.reporter, "regenerate section", err, resume.UserID, resume.ID)
+		return nil, err
+	}
+
+	if err := s.updateResume(ctx, resume); err != nil {
+		return nil, fmt.Errorf("failed to update resume: %w", err)
+	}
+	s.recordActivity(ctx, domain.NewAIRunEvent(resume, domain.AIRunRegenerate, aiRun()))
+
+	return resume, nil
+}
+
+// parseRegenerateSection validates req and returns its section, with
+// RegenerateExperiencePrefix standing for every experience, and the
+// experience ID.
+func parseRegenerateSection(req RegenerateSectionRequest) (string, string, error) {
+	v := &domain.ValidationErrors{}
+
+	section, experienceID := strings.TrimSpace(req.Section), ""
+	switch {
+	case section == RegenerateSummary:
+	case section == RegenerateSkills:
+		if strings.TrimSpace(req.Instructions) != "" {
+			v.AddFieldError("instructions", "skills are chosen from the profile and take no instructions")
+		}
+	case strings.HasPrefix(section, RegenerateExperiencePrefix):
+		experienceID = strings.TrimSpace(strings.TrimPrefix(section, RegenerateExperiencePrefix))
+		section = RegenerateExperiencePrefix
+		if experienceID == "" {
+			v.AddFieldError("section", "experience ID is required")
+		}
+	default:
+		v.AddFieldError("section", "must be summary, skills or experience:{experience ID}")
+	}
+
+	if utf8.RuneCountInString(strings.TrimSpace(req.Instructions)) > MaxRegenerateInstructionsLength {
+		v.AddFieldError("instructions", fmt.Sprintf("must be at most %d characters", MaxRegenerateInstructionsLength))
+	}
+
+	return section, experienceID, v.ToError()
+}
+
+// regenerateSection generates a section of resume's content again; the
+// experience section is the experience at index experience.
+func (s *ResumeService) regenerateSection(ctx context.Context, resume *domain.Resume, section string, experience int, instructions string) error {
+	job, err := s.resumeJobAnalysis(ctx, resume)
+	if err != nil {
+		return err
+	}
+
+	skills, err := s.skillRepo.ListByUserID(ctx, resume.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get skills: %w", err)
+	}
+	content := resume.GeneratedContent
+
+	if section == RegenerateSkills {
+		// Keep listing highlighted skills the job does not mention when
+		// the resume did.
+		include := false
+		for _, skill := range skills {
+			if skill.IsHighlighted && slices.Contains(content.Skills, skill.Name) && !jobMentionsSkill(job, resume.JobDescription, skill.Name) {
+				include = true
+			}
+		}
+		content.Skills = tailoredSkills(skills, job, resume.JobDescription, include)
+		return nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, resume.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	fresh := ports.WithFreshAIReply(ctx)
+	var voice domain.WritingVoice
+	if content.Voice != nil {
+		voice = *content.Voice
+	}
+	if section == RegenerateSummary {
+		bullets, err := s.bulletRepo.ListByIDs(ctx, resume.SelectedBullets)
+		if err != nil {
+			return fmt.Errorf("failed to get selected bullets: %w", err)
+		}
+		result, err := s.generateSummary(fresh, ports.GenerateSummaryRequest{
+			User:              user,
+			JobAnalysis:       job,
+			SelectedBullets:   bullets,
+			HighlightedSkills: highlightedSkillNames(skills),
+			TargetLanguage:    resume.TargetLanguage,
+			Instructions:      instructions,
+			Voice:             voice,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to generate summary: %w", err)
+		}
+		// Keep the angle the user picked when it was written again.
+		angle := content.SummaryAngle
+		setSummary(content, result)
+		if angle != "" && content.SummaryAngle != angle {
+			_ = content.SelectSummary(angle)
+		}
+		return nil
+	}
+
+	exp := &content.Experiences[experience]
+	ids := make([]string, 0, len(exp.Bullets))
+	for _, bullet := range exp.Bullets {
+		ids = append(ids, bullet.BulletID)
+	}
+	bullets, err := s.bulletRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get bullets: %w", err)
+	}
+	byID := make(map[string]domain.Bullet, len(bullets))
+	for _, bullet := range bullets {
+		byID[bullet.ID] = bullet
+	}
+
+	// Rewrites are checked against the whole profile for invented terms.
+	allBullets, err := s.bulletRepo.ListByUserID(ctx, resume.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get bullets: %w", err)
+	}
+	experiences, err := s.listExperiences(ctx, resume.UserID)
+	if err != nil {
+		return err
+	}
+	projects, err := s.projectRepo.ListByUserIDWithBullets(ctx, resume.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get projects: %w", err)
+	}
+	vocabulary := profileVocabulary(user, allBullets, experiences, skills, projects)
+	writingPrefs := s.writingPreferences(ctx, resume.UserID)
+
+	var firstErr error
+	rewritten := 0
+	for i := range exp.Bullets {
+		tb := &exp.Bullets[i]
+		bullet, ok := byID[tb.BulletID]
+		if !ok {
+			continue
+		}
+		result, _, err := s.tailorBullet(fresh, ports.TailorBulletRequest{
+			Bullet:             bullet,
+			JobAnalysis:        job,
+			TargetLanguage:     resume.TargetLanguage,
+			SourceLanguage:     user.PreferredLanguage,
+			Style:              "professional",
+			WritingPreferences: writingPrefs,
+			Instructions:       instructions,
+			Voice:              voice,
+		}, &domain.TailorValidation{})
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		rewritten++
+		if result == nil {
+			continue
+		}
+		tb.OriginalContent = bullet.Content
+		tb.TailoredContent = result.TailoredContent
+		tb.TranslatedContent = result.TranslatedContent
+		tb.UnverifiedTerms = vocabulary.UnverifiedTerms(result.TailoredContent, result.TranslatedContent)
+		tb.NeedsReview = len(tb.UnverifiedTerms) > 0
+	}
+	if rewritten == 0 && firstErr != nil {
+		return fmt.Errorf("failed to tailor bullets: %w", firstErr)
+	}
+	conformBulletTenses(content.Experiences[experience : experience+1])
+	return nil
+}
+
+// resumeJobAnalysis returns the analysis of the job a resume was tailored
+// to. Content tailored before analyses were kept has the job analyzed again,
+// and the analysis is kept with it.
+func (s *ResumeService) resumeJobAnalysis(ctx context.Context, resume *domain.Resume) (*ports.JobAnalysis, error) {
+	content := resume.GeneratedContent
+	if content.Job == nil {
+		analysis, err := s.aiProvider.AnalyzeJob(ctx, ports.AnalyzeJobRequest{
+			JobDescription: resume.JobDescription,
+			TargetLanguage: resume.TargetLanguage,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to analyze job: %w", err)
+		}
+		content.Job = jobRequirements(analysis)
+		return analysis, nil
+	}
+
+	job := content.Job
+	analysis := &ports.JobAnalysis{
+		RequiredSkills:  job.RequiredSkills,
+		PreferredSkills: job.PreferredSkills,
+		Keywords:        job.Keywords,
+		SeniorityLevel:  job.SeniorityLevel,
+		YearsExperience: job.YearsExperience,
+		Summary:         job.Summary,
+		Insights:        resume.JobInsights,
+	}
+	if resume.JobTitle != nil {
+		analysis.Title = *resume.JobTitle
+	}
+	if resume.CompanyName != nil {
+		analysis.Company = *resume.CompanyName
+	}
+	return analysis, nil
+}
+
+// jobRequirements returns the requirements of a job analysis to keep with
+// tailored content.
+func jobRequirements(analysis *ports.JobAnalysis) *domain.JobRequirements {
+	return &domain.JobRequirements{
+		RequiredSkills:  analysis.RequiredSkills,
+		PreferredSkills: analysis.PreferredSkills,
+		Keywords:        analysis.Keywords,
+		SeniorityLevel:  analysis.SeniorityLevel,
+		YearsExperience: analysis.YearsExperience,
+		Summary:         analysis.Summary,
+	}
+}
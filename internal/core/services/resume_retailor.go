@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// RetailorResumeRequest identifies a resume to tailor again.
+type RetailorResumeRequest struct {
+	ResumeID string
+	UserID   string
+
+	// SameSettings tailors with the seed, options and writing voice of the
+	// last tailoring run, to reproduce it. Otherwise the resume is tailored
+	// with the default options and fresh AI replies, to contrast the runs.
+	SameSettings bool
+}
+
+// RetailorResult is a resume tailored again, with the settings of the run
+// it replaced.
+type RetailorResult struct {
+	Resume *domain.Resume
+
+	// PreviousSettings are the settings of the replaced run; nil when the
+	// resume was tailored before settings were recorded.
+	PreviousSettings *domain.AIRunSettings
+
+	// Differences describe how the settings of the new run differ from the
+	// previous ones, such as a prompt version bumped since.
+	Differences []string
+}
+
+// RetailorResume tailors a resume again, either with the settings of its
+// last tailoring run or with the defaults, and reports how the settings of
+// the two runs differ. The model applies seeds on a best effort basis, and
+// prompts or models changed since cannot be restored, so a run with the same
+// settings may still differ; the differences tell why.
+func (s *ResumeService) RetailorResume(ctx context.Context, req RetailorResumeRequest) (*RetailorResult, error) {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+	content := resume.GeneratedContent
+	if content == nil {
+		return nil, domain.ErrResumeNotReady
+	}
+	previous := content.AISettings
+
+	tailorReq := TailorResumeRequest{ResumeID: req.ResumeID, UserID: req.UserID}
+	if req.SameSettings {
+		if previous == nil {
+			v := &domain.ValidationErrors{}
+			v.AddFieldError("same_settings", "resume was tailored before its settings were recorded")
+			return nil, v
+		}
+		tailorReq.Seed = previous.Seed
+		tailorReq.MaxBullets = previous.MaxBullets
+		tailorReq.IncludeHighlightedSkills = previous.IncludeHighlightedSkills
+		if content.Voice != nil {
+			tailorReq.Voice = *content.Voice
+		}
+	} else {
+		ctx = ports.WithFreshAIReply(ctx)
+	}
+
+	resume, err = s.TailorResume(ctx, tailorReq)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RetailorResult{Resume: resume, PreviousSettings: previous}
+	if previous != nil {
+		result.Differences = previous.Differences(resume.GeneratedContent.AISettings)
+	}
+	return result, nil
+}
+
+// startAIRun seeds the AI calls made with the returned context, unless seed
+// is nil, and records their settings; settings returns the settings of the
+// calls made so far.
+func startAIRun(ctx context.Context, seed *int64) (_ context.Context, settings func() *domain.AIRunSettings) {
+	if seed != nil {
+		ctx = ports.WithAISeed(ctx, *seed)
+	}
+	ctx, recorder := ports.WithAIRunRecorder(ctx)
+	return ctx, func() *domain.AIRunSettings {
+		return &domain.AIRunSettings{Seed: seed, Calls: recorder.Calls()}
+	}
+}
@@ -4,9 +4,16 @@ package services
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/langdetect"
+	"github.com/SeltikHD/chameleon-vitae/pkg/weburl"
 )
 
 // ResumeService handles resume generation and management use cases.
@@ -23,6 +30,50 @@ type ResumeService struct {
 	pdfEngine      ports.PDFEngine
 	jobParser      ports.JobParser
 	fileStorage    ports.FileStorage
+
+	// Optional semantic pre-ranking, see SetEmbeddings.
+	embeddings    ports.EmbeddingsProvider
+	embeddingRepo ports.BulletEmbeddingRepository
+	preRankLimit  int
+
+	// Optional plan limits, see SetQuotas.
+	quotas *QuotaService
+
+	// Optional per-user defaults, see SetPreferences.
+	prefs *PreferencesService
+
+	// Optional header QR codes, see SetQRCodeEncoder.
+	qrEncoder ports.QRCodeEncoder
+
+	// Bullet ranking before selection, see SetBulletRanking.
+	ranking BulletRanking
+
+	// Time budgets of the tailoring stages, see SetTailorBudget.
+	budget TailorBudget
+
+	// Optional writing preferences from bullet feedback, see SetFeedback.
+	feedbackRepo ports.BulletFeedbackRepository
+
+	// Optional resume activity logs, see SetActivityLog.
+	activity *ActivityService
+
+	// Optional error reporting, see SetErrorReporter.
+	reporter ports.ErrorReporter
+
+	// Runs PDF caching after responses, see SetJobRunner.
+	jobs *JobRunner
+
+	// Records resume events in the outbox, see SetEventOutbox.
+	outbox bool
+
+	// Optional academic CV sections, see SetAcademicEntries.
+	academicRepo ports.AcademicEntryRepository
+
+	// Optional operator templates, see SetCustomTemplates.
+	customTemplates *CustomTemplates
+
+	// Optional tagging by bulk actions, see SetResumeTags.
+	tagRepo ports.ResumeTagRepository
 }
 
 // NewResumeService creates a new ResumeService with required dependencies.
@@ -53,6 +104,9 @@ func NewResumeService(
 		pdfEngine:      pdfEngine,
 		jobParser:      jobParser,
 		fileStorage:    fileStorage,
+		ranking:        DefaultBulletRanking(),
+		budget:         DefaultTailorBudget(),
+		jobs:           NewJobRunner(nil),
 	}
 }
 
@@ -80,8 +134,25 @@ type CreateResumeRequest struct {
 	TargetLanguage string
 }
 
-// CreateResume creates a new resume draft.
-func (s *ResumeService) CreateResume(ctx context.Context, req CreateResumeRequest) (*domain.Resume, error) {
+// CreateResumeResult is a created resume draft.
+type CreateResumeResult struct {
+	Resume *domain.Resume
+
+	// DetectedLanguage is the ISO 639-1 code of the language of the job
+	// description, such as "pt", or "" when it was not detected.
+	DetectedLanguage string
+}
+
+// CreateResume creates a new resume draft. Without a target language, the
+// resume targets the language the job description is written in when it is
+// supported, and the user's default target language otherwise.
+func (s *ResumeService) CreateResume(ctx context.Context, req CreateResumeRequest) (*CreateResumeResult, error) {
+	v := &domain.ValidationErrors{}
+	req.JobURL = normalizeURL(v, "job_url", req.JobURL, weburl.Normalize)
+	if err := v.ToError(); err != nil {
+		return nil, err
+	}
+
 	// Verify user exists.
 	_, err := s.userRepo.GetByID(ctx, req.UserID)
 	if err != nil {
@@ -94,8 +165,14 @@ func (s *ResumeService) CreateResume(ctx context.Context, req CreateResumeReques
 		return nil, err
 	}
 
-	if req.TargetLanguage != "" {
+	detected := langdetect.Detect(req.JobDescription)
+	switch {
+	case req.TargetLanguage != "":
 		resume.TargetLanguage = req.TargetLanguage
+	case targetLanguageOf(detected) != "":
+		resume.TargetLanguage = targetLanguageOf(detected)
+	default:
+		resume.TargetLanguage = s.userPreferences(ctx, req.UserID).DefaultTargetLanguage
 	}
 
 	if req.JobTitle != nil || req.CompanyName != nil || req.JobURL != nil {
@@ -118,28 +195,106 @@ func (s *ResumeService) CreateResume(ctx context.Context, req CreateResumeReques
 		return nil, err
 	}
 
+	if err := s.checkQuota(ctx, req.UserID, domain.UsageResumeCreated); err != nil {
+		return nil, err
+	}
+
 	if err := s.resumeRepo.Create(ctx, resume); err != nil {
 		return nil, fmt.Errorf("failed to create resume: %w", err)
 	}
+	s.recordUsage(ctx, req.UserID, domain.UsageResumeCreated)
 
-	return resume, nil
+	return &CreateResumeResult{Resume: resume, DetectedLanguage: detected}, nil
+}
+
+// targetLanguageOf returns the resume target language of a detected
+// language, or "" when resumes cannot target it.
+func targetLanguageOf(lang string) string {
+	switch lang {
+	case langdetect.English:
+		return "en"
+	case langdetect.Portuguese:
+		return "pt-br"
+	default:
+		return ""
+	}
 }
 
-// GetResume retrieves a resume by ID.
-func (s *ResumeService) GetResume(ctx context.Context, resumeID string) (*domain.Resume, error) {
-	resume, err := s.resumeRepo.GetByID(ctx, resumeID)
+// GetResume retrieves a user's resume by ID.
+func (s *ResumeService) GetResume(ctx context.Context, resumeID, userID string) (*domain.Resume, error) {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, resumeID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get resume: %w", err)
 	}
+	s.refreshPDFURL(resume)
+	s.recordAccess(ctx, resume)
 	return resume, nil
 }
 
+// accessRecordInterval is how long reads of a resume go unrecorded after
+// its last recorded access, so that repeated reads do not each write.
+const accessRecordInterval = time.Minute
+
+// recordAccess records that the user opened a resume, for the recently
+// viewed order. Failures are ignored; the read succeeds regardless.
+func (s *ResumeService) recordAccess(ctx context.Context, resume *domain.Resume) {
+	now := time.Now().UTC()
+	if resume.LastAccessedAt != nil && now.Sub(*resume.LastAccessedAt) < accessRecordInterval {
+		return
+	}
+	if err := s.resumeRepo.MarkAccessed(ctx, resume.ID, now); err == nil {
+		resume.LastAccessedAt = &now
+	}
+}
+
+// refreshPDFURL replaces the stored PDF URL with a fresh one, since storage
+// URLs may be signed and expire. The URL is signed without a storage lookup,
+// as lists refresh one per resume. The stored URL is kept if that fails.
+func (s *ResumeService) refreshPDFURL(resume *domain.Resume) {
+	if resume.PDFURL == nil {
+		return
+	}
+	if signed, err := s.fileStorage.SignURL(pdfURLKey(resume)); err == nil {
+		resume.PDFURL = &signed
+	}
+}
+
+// pdfURLKey returns the storage key of the PDF the stored PDF URL points to,
+// which is that of the template it was generated with. URLs that point to no
+// PDF of the resume fall back to its Jake's Resume PDF.
+func pdfURLKey(resume *domain.Resume) string {
+	fallback := pdfCacheKey(resume, domain.TemplateJake, DensityComfortable)
+
+	parsed, err := url.Parse(*resume.PDFURL)
+	if err != nil {
+		return fallback
+	}
+	dir := "resumes/" + resume.UserID + "/"
+	i := strings.LastIndex(parsed.Path, dir)
+	if i < 0 {
+		return fallback
+	}
+
+	key := parsed.Path[i:]
+	name := strings.TrimSuffix(strings.TrimPrefix(key, dir), ".pdf")
+	if !strings.HasSuffix(key, ".pdf") || (name != resume.ID && !strings.HasPrefix(name, resume.ID+"_")) {
+		return fallback
+	}
+	return key
+}
+
 // ListResumesRequest contains parameters for listing resumes.
 type ListResumesRequest struct {
 	UserID string
-	Status *string
 	Limit  int
 	Offset int
+
+	// Summary lists the resumes without their job description, generated
+	// content and selection explanation, for lightweight lists.
+	Summary bool
+
+	// ResumeSearch holds the filters.
+	domain.ResumeSearch
 }
 
 // ListResumesResponse contains the result of listing resumes.
@@ -148,7 +303,9 @@ type ListResumesResponse struct {
 	Total   int
 }
 
-// ListResumes lists resumes for a user with optional status filter.
+// ListResumes lists resumes for a user with optional status, job insight,
+// tag, score, company, creation date and language filters. Archived resumes
+// are left out unless the request includes or asks for them.
 func (s *ResumeService) ListResumes(ctx context.Context, req ListResumesRequest) (*ListResumesResponse, error) {
 	opts := ports.ListOptions{
 		Limit:  req.Limit,
@@ -159,44 +316,202 @@ func (s *ResumeService) ListResumes(ctx context.Context, req ListResumesRequest)
 		opts = ports.DefaultListOptions()
 	}
 
+	filter, err := newResumeFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
 	var resumes []domain.Resume
 	var total int
-	var err error
 
-	if req.Status != nil && *req.Status != "" {
-		status, parseErr := domain.ParseResumeStatus(*req.Status)
-		if parseErr != nil {
-			return nil, parseErr
-		}
-		resumes, total, err = s.resumeRepo.ListByUserIDAndStatus(ctx, req.UserID, status, opts)
-	} else {
+	if filter.IsEmpty() && filter.Sort == "" && !filter.Summary {
 		resumes, total, err = s.resumeRepo.ListByUserID(ctx, req.UserID, opts)
+	} else {
+		resumes, total, err = s.resumeRepo.ListByUserIDFiltered(ctx, req.UserID, filter, opts)
 	}
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to list resumes: %w", err)
 	}
 
+	for i := range resumes {
+		s.refreshPDFURL(&resumes[i])
+	}
+
 	return &ListResumesResponse{
 		Resumes: resumes,
 		Total:   total,
 	}, nil
 }
 
+// allResumesPageSize is how many resumes are loaded per query when listing
+// all of a user's resumes.
+const allResumesPageSize = 100
+
+// ListAllResumes lists all of a user's resumes, newest first, for exports.
+// PDF URLs are not refreshed.
+func (s *ResumeService) ListAllResumes(ctx context.Context, userID string) ([]domain.Resume, error) {
+	var resumes []domain.Resume
+	for offset := 0; ; offset += allResumesPageSize {
+		page, total, err := s.resumeRepo.ListByUserID(ctx, userID, ports.ListOptions{
+			Limit:  allResumesPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resumes: %w", err)
+		}
+		resumes = append(resumes, page...)
+		if len(page) < allResumesPageSize || len(resumes) >= total {
+			return resumes, nil
+		}
+	}
+}
+
+// newResumeFilter validates the list filters of a request.
+func newResumeFilter(req ListResumesRequest) (ports.ResumeFilter, error) {
+	var filter ports.ResumeFilter
+
+	if req.Status != "" {
+		status, err := domain.ParseResumeStatus(req.Status)
+		if err != nil {
+			return filter, err
+		}
+		filter.Status = &status
+	}
+
+	v := &domain.ValidationErrors{}
+	for _, value := range req.RemotePolicies {
+		policy := domain.RemotePolicy(strings.ToLower(strings.TrimSpace(value)))
+		if !policy.IsValid() {
+			v.AddFieldError("remote", "must be 'remote', 'hybrid' or 'onsite'")
+			break
+		}
+		filter.RemotePolicies = append(filter.RemotePolicies, policy)
+	}
+	if req.MinSalary != nil {
+		if *req.MinSalary < 0 {
+			v.AddFieldError("min_salary", "must not be negative")
+		}
+		filter.MinAnnualSalary = req.MinSalary
+	}
+	if req.SalaryCurrency != "" {
+		filter.SalaryCurrency = strings.ToUpper(req.SalaryCurrency)
+		if len(filter.SalaryCurrency) != 3 {
+			v.AddFieldError("currency", "must be a 3-letter ISO 4217 code")
+		}
+	}
+	filter.VisaSponsorship = req.VisaSponsorship
+	for _, id := range req.TagIDs {
+		if id = strings.TrimSpace(id); id != "" {
+			filter.TagIDs = append(filter.TagIDs, id)
+		}
+	}
+	if req.MinScore != nil {
+		if *req.MinScore < 0 || *req.MinScore > 100 {
+			v.AddFieldError("score_min", "must be between 0 and 100")
+		}
+		filter.MinScore = req.MinScore
+	}
+	filter.Company = strings.TrimSpace(req.Company)
+	if req.CreatedAfter != "" {
+		after, err := parseSearchTime(req.CreatedAfter, false)
+		if err != nil {
+			v.AddFieldError("created_after", "must be a date (YYYY-MM-DD) or RFC 3339 timestamp")
+		}
+		filter.CreatedAfter = &after
+	}
+	if req.CreatedBefore != "" {
+		before, err := parseSearchTime(req.CreatedBefore, true)
+		if err != nil {
+			v.AddFieldError("created_before", "must be a date (YYYY-MM-DD) or RFC 3339 timestamp")
+		}
+		filter.CreatedBefore = &before
+	}
+	filter.Language = strings.ToLower(strings.TrimSpace(req.Language))
+	filter.Summary = req.Summary
+	if req.Sort != "" {
+		filter.Sort = domain.ResumeSort(req.Sort)
+		if !filter.Sort.IsValid() {
+			v.AddFieldError("sort", "must be 'newest', 'recent' or 'pinned_first'")
+		}
+	}
+	switch {
+	case req.Archived != nil:
+		filter.Archived = req.Archived
+	case !req.IncludeArchived:
+		unarchived := false
+		filter.Archived = &unarchived
+	}
+
+	return filter, v.ToError()
+}
+
+// parseSearchTime parses a date (YYYY-MM-DD) or RFC 3339 timestamp bound
+// of a search. A date starts at midnight UTC; as an exclusive end bound it
+// ends the next midnight, so that the whole day is included.
+func parseSearchTime(value string, end bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
+
 // TailorResumeRequest contains parameters for tailoring a resume.
 type TailorResumeRequest struct {
 	ResumeID   string
+	UserID     string
 	MaxBullets int
+
+	// IncludeHighlightedSkills lists the user's highlighted skills even
+	// when the job does not mention them.
+	IncludeHighlightedSkills bool
+
+	// Voice is the tone and target reader of the summary and bullets,
+	// kept with the content.
+	Voice domain.WritingVoice
+
+	// Seed is given to the model with every AI call, for a run that can be
+	// reproduced; nil leaves the calls unseeded. It is kept with the run's
+	// other settings.
+	Seed *int64
 }
 
-// TailorResume generates AI-tailored content for a resume.
+// TailorResume generates AI-tailored content for a resume. Each AI stage
+// runs under its time budget; it stops with the request's error as soon as
+// the client goes away, and nothing is saved then.
 func (s *ResumeService) TailorResume(ctx context.Context, req TailorResumeRequest) (*domain.Resume, error) {
+	resume, err := s.tailorResume(ctx, req)
+	if err != nil {
+		reportError(ctx, s.reporter, "tailor resume", err, req.UserID, req.ResumeID)
+	}
+	return resume, err
+}
+
+// tailorResume implements TailorResume.
+func (s *ResumeService) tailorResume(ctx context.Context, req TailorResumeRequest) (*domain.Resume, error) {
+	if err := req.Voice.Validate(); err != nil {
+		return nil, err
+	}
+	ctx, aiRun := startAIRun(ctx, req.Seed)
+
 	// Get the resume.
-	resume, err := s.resumeRepo.GetByID(ctx, req.ResumeID)
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, req.ResumeID, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get resume: %w", err)
 	}
 
+	if err := s.checkQuota(ctx, resume.UserID, domain.UsageTailor); err != nil {
+		return nil, err
+	}
+	previousStatus := resume.Status
+
 	// Get user profile.
 	user, err := s.userRepo.GetByID(ctx, resume.UserID)
 	if err != nil {
@@ -209,7 +524,14 @@ func (s *ResumeService) TailorResume(ctx context.Context, req TailorResumeReques
 		return nil, fmt.Errorf("failed to get bullets: %w", err)
 	}
 
-	if len(allBullets) == 0 {
+	// Leave out experiences excluded from tailoring.
+	experiences, err := s.listExperiences(ctx, resume.UserID)
+	if err != nil {
+		return nil, err
+	}
+	tailored := tailoringBullets(resume, experiences, allBullets)
+
+	if len(tailored) == 0 {
 		return nil, domain.ErrNoBulletsAvailable
 	}
 
@@ -220,36 +542,54 @@ func (s *ResumeService) TailorResume(ctx context.Context, req TailorResumeReques
 	}
 
 	// Analyze job description.
-	jobAnalysis, err := s.aiProvider.AnalyzeJob(ctx, ports.AnalyzeJobRequest{
+	stageCtx, cancel := tailorStage(ctx, s.budget.Analysis)
+	jobAnalysis, err := s.aiProvider.AnalyzeJob(stageCtx, ports.AnalyzeJobRequest{
 		JobDescription: resume.JobDescription,
 		TargetLanguage: resume.TargetLanguage,
 	})
 	if err != nil {
+		err = tailorStageError(ctx, stageCtx, "job analysis", err)
+		cancel()
 		return nil, fmt.Errorf("failed to analyze job: %w", err)
 	}
+	cancel()
 
 	// Update job details from analysis if not already set.
 	if resume.JobTitle == nil && jobAnalysis.Title != "" {
 		resume.SetJobDetails(jobAnalysis.Title, jobAnalysis.Company, "")
 	}
+	if jobAnalysis.Insights != nil {
+		resume.JobInsights = jobAnalysis.Insights
+	}
 
 	// Select the most relevant bullets.
 	maxBullets := req.MaxBullets
 	if maxBullets == 0 {
-		maxBullets = 15 // Default.
+		maxBullets = s.userPreferences(ctx, resume.UserID).DefaultMaxBullets
 	}
 
-	bulletSelection, err := s.aiProvider.SelectBullets(ctx, ports.SelectBulletsRequest{
-		JobAnalysis:      jobAnalysis,
-		AvailableBullets: allBullets,
-		MaxBullets:       maxBullets,
-		TargetLanguage:   resume.TargetLanguage,
+	// Put recent, high-impact bullets first, then narrow large bullet
+	// libraries down by semantic similarity.
+	ranked := s.ranking.rank(tailored, experiences, time.Now())
+	candidates := s.preRankBullets(ctx, resume.UserID, ranked, jobAnalysis, resume.JobDescription)
+
+	stageCtx, cancel = tailorStage(ctx, s.budget.Selection)
+	bulletSelection, err := s.aiProvider.SelectBullets(stageCtx, ports.SelectBulletsRequest{
+		JobAnalysis:       jobAnalysis,
+		AvailableBullets:  candidates,
+		MaxBullets:        maxBullets,
+		HighlightedSkills: highlightedSkillNames(skills),
+		TargetLanguage:    resume.TargetLanguage,
 	})
 	if err != nil {
+		err = tailorStageError(ctx, stageCtx, "bullet selection", err)
+		cancel()
 		return nil, fmt.Errorf("failed to select bullets: %w", err)
 	}
+	cancel()
 
 	resume.SelectedBullets = bulletSelection.SelectedBulletIDs
+	resume.Selection = explainSelection(allBullets, tailored, candidates, bulletSelection)
 
 	// Get the selected bullets.
 	selectedBullets, err := s.bulletRepo.ListByIDs(ctx, bulletSelection.SelectedBulletIDs)
@@ -257,43 +597,82 @@ func (s *ResumeService) TailorResume(ctx context.Context, req TailorResumeReques
 		return nil, fmt.Errorf("failed to get selected bullets: %w", err)
 	}
 
-	// Tailor each bullet.
-	tailoredBulletResults := make([]ports.TailoredBulletResult, 0, len(selectedBullets))
+	// Tailor each bullet. Bullets are tailored in the profile language and
+	// translated when the resume targets another language, following what
+	// the user's feedback on earlier bullets says about their style. Bullets
+	// that fail, are not reached within the budget or whose rewrites fail
+	// validation keep their content.
+	writingPrefs := s.writingPreferences(ctx, resume.UserID)
+	tailoredBulletResults := make(map[string]*ports.TailoredBulletResult, len(selectedBullets))
+	rejectedRewrites := make(map[string][]domain.BulletIssue)
+	validation := &domain.TailorValidation{}
+	stageCtx, cancel = tailorStage(ctx, s.budget.Tailoring)
 	for _, bullet := range selectedBullets {
-		tailored, err := s.aiProvider.TailorBullet(ctx, ports.TailorBulletRequest{
-			Bullet:         bullet,
-			JobAnalysis:    jobAnalysis,
-			TargetLanguage: resume.TargetLanguage,
-			Style:          "professional",
-		})
+		if stageCtx.Err() != nil {
+			break
+		}
+		tailored, issues, err := s.tailorBullet(stageCtx, ports.TailorBulletRequest{
+			Bullet:             bullet,
+			JobAnalysis:        jobAnalysis,
+			TargetLanguage:     resume.TargetLanguage,
+			SourceLanguage:     user.PreferredLanguage,
+			Style:              "professional",
+			WritingPreferences: writingPrefs,
+			Voice:              req.Voice,
+		}, validation)
 		if err != nil {
 			// Log error but continue with other bullets.
 			continue
 		}
-		tailoredBulletResults = append(tailoredBulletResults, *tailored)
+		if tailored == nil {
+			rejectedRewrites[bullet.ID] = issues
+			continue
+		}
+		tailoredBulletResults[bullet.ID] = tailored
 	}
+	cancel()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	recordRejectedRewrites(resume.Selection, rejectedRewrites)
+	resume.Selection.Validation = validation
 
 	// Generate professional summary.
-	summaryResult, err := s.aiProvider.GenerateSummary(ctx, ports.GenerateSummaryRequest{
-		User:            user,
-		JobAnalysis:     jobAnalysis,
-		SelectedBullets: selectedBullets,
-		TargetLanguage:  resume.TargetLanguage,
+	stageCtx, cancel = tailorStage(ctx, s.budget.Summary)
+	summaryResult, err := s.generateSummary(stageCtx, ports.GenerateSummaryRequest{
+		User:              user,
+		JobAnalysis:       jobAnalysis,
+		SelectedBullets:   selectedBullets,
+		HighlightedSkills: highlightedSkillNames(skills),
+		TargetLanguage:    resume.TargetLanguage,
+		Voice:             req.Voice,
 	})
 	if err != nil {
+		err = tailorStageError(ctx, stageCtx, "summary", err)
+		cancel()
 		return nil, fmt.Errorf("failed to generate summary: %w", err)
 	}
+	cancel()
 
-	// Group tailored bullets by experience.
+	// Group tailored bullets by experience, flagging for review the
+	// bullets with terms the profile does not have.
+	projects, err := s.projectRepo.ListByUserIDWithBullets(ctx, resume.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+	vocabulary := profileVocabulary(user, allBullets, experiences, skills, projects)
 	bulletsByExp := make(map[string][]domain.TailoredBullet)
-	for i, bullet := range selectedBullets {
-		if i >= len(tailoredBulletResults) {
-			break
-		}
+	for _, bullet := range selectedBullets {
 		tb := domain.TailoredBullet{
 			BulletID:        bullet.ID,
 			OriginalContent: bullet.Content,
-			TailoredContent: tailoredBulletResults[i].TailoredContent,
+			TailoredContent: bullet.Content,
+		}
+		if result, ok := tailoredBulletResults[bullet.ID]; ok {
+			tb.TailoredContent = result.TailoredContent
+			tb.TranslatedContent = result.TranslatedContent
+			tb.UnverifiedTerms = vocabulary.UnverifiedTerms(result.TailoredContent, result.TranslatedContent)
+			tb.NeedsReview = len(tb.UnverifiedTerms) > 0
 		}
 		bulletsByExp[bullet.ExperienceID] = append(bulletsByExp[bullet.ExperienceID], tb)
 	}
@@ -306,7 +685,7 @@ func (s *ResumeService) TailorResume(ctx context.Context, req TailorResumeReques
 
 	tailoredExperiences := make([]domain.TailoredExperience, 0, len(expIDs))
 	for _, expID := range expIDs {
-		exp, err := s.experienceRepo.GetByID(ctx, expID)
+		exp, err := s.experienceRepo.GetByIDForUser(ctx, expID, resume.UserID)
 		if err != nil {
 			continue
 		}
@@ -326,14 +705,15 @@ func (s *ResumeService) TailorResume(ctx context.Context, req TailorResumeReques
 		tailoredExperiences = append(tailoredExperiences, te)
 	}
 
+	// Put the verbs bullets open with in the tense of their role.
+	validation.TenseFixed = conformBulletTenses(tailoredExperiences)
+
 	// Build skill list.
-	skillNames := make([]string, 0, len(skills))
-	for _, skill := range skills {
-		skillNames = append(skillNames, skill.Name)
-	}
+	skillNames := tailoredSkills(skills, jobAnalysis, resume.JobDescription, req.IncludeHighlightedSkills)
 
 	// Calculate match score.
-	matchScore, err := s.aiProvider.ScoreMatch(ctx, ports.ScoreMatchRequest{
+	stageCtx, cancel = tailorStage(ctx, s.budget.Scoring)
+	matchScore, err := s.aiProvider.ScoreMatch(stageCtx, ports.ScoreMatchRequest{
 		JobAnalysis: jobAnalysis,
 		Resume: &domain.ResumeContent{
 			Summary:     summaryResult.Summary,
@@ -342,33 +722,66 @@ func (s *ResumeService) TailorResume(ctx context.Context, req TailorResumeReques
 		},
 		UserSkills: skills,
 	})
+	cancel()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	if err != nil {
-		// Use default score if scoring fails.
+		// Use default score if scoring fails or runs out of time.
 		defaultScore, _ := domain.NewMatchScore(0)
 		matchScore = &defaultScore
 	}
 
+	// Group the bullets by skill theme for the functional template.
+	stageCtx, cancel = tailorStage(ctx, s.budget.Themes)
+	themes := s.skillThemes(stageCtx, jobAnalysis, tailoredExperiences, resume.TargetLanguage)
+	cancel()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// Build the generated content.
 	generatedContent := &domain.ResumeContent{
-		Summary:     summaryResult.Summary,
 		Experiences: tailoredExperiences,
 		Skills:      skillNames,
+		Themes:      themes,
+		Job:         jobRequirements(jobAnalysis),
 		Analysis: &domain.ResumeAnalysis{
 			MatchedKeywords: jobAnalysis.RequiredSkills,
 			MissingKeywords: jobAnalysis.PreferredSkills,
 			StrengthAreas:   []string{},
 		},
 	}
+	setSummary(generatedContent, summaryResult)
+	if req.Voice != (domain.WritingVoice{}) {
+		generatedContent.Voice = &req.Voice
+	}
+	generatedContent.AISettings = aiRun()
+	generatedContent.AISettings.MaxBullets = maxBullets
+	generatedContent.AISettings.IncludeHighlightedSkills = req.IncludeHighlightedSkills
+	if user.PreferredLanguage != "" && user.PreferredLanguage != resume.TargetLanguage {
+		generatedContent.SourceLanguage = user.PreferredLanguage
+	}
 
-	resume.SetGeneratedContent(generatedContent)
+	if err := resume.SetGeneratedContent(generatedContent); err != nil {
+		return nil, fmt.Errorf("invalid generated content: %w", err)
+	}
 	if err := resume.SetScore(matchScore.Int()); err != nil {
 		// Ignore score setting error.
 	}
 
 	// Save the updated resume.
-	if err := s.resumeRepo.Update(ctx, resume); err != nil {
+	events := append([]domain.OutboxEvent{
+		domain.NewResumeOutboxEvent(domain.EventResumeTailored, resume, map[string]string{
+			"score": strconv.Itoa(resume.Score.Int()),
+		}),
+	}, statusChangeEvents(resume, previousStatus)...)
+	if err := s.updateResume(ctx, resume, events...); err != nil {
 		return nil, fmt.Errorf("failed to update resume: %w", err)
 	}
+	s.recordUsage(ctx, resume.UserID, domain.UsageTailor)
+	s.recordActivity(ctx, domain.NewAIRunEvent(resume, domain.AIRunTailor, generatedContent.AISettings))
+	s.recordStatusChange(ctx, resume, previousStatus)
 
 	return resume, nil
 }
@@ -376,12 +789,22 @@ func (s *ResumeService) TailorResume(ctx context.Context, req TailorResumeReques
 // GeneratePDFRequest contains parameters for generating a PDF.
 type GeneratePDFRequest struct {
 	ResumeID     string
+	UserID       string
 	TemplateName string
 }
 
 // GeneratePDF generates a PDF for a resume.
 func (s *ResumeService) GeneratePDF(ctx context.Context, req GeneratePDFRequest) (*domain.Resume, error) {
-	resume, err := s.resumeRepo.GetByID(ctx, req.ResumeID)
+	resume, err := s.generatePDF(ctx, req)
+	if err != nil {
+		reportError(ctx, s.reporter, "generate pdf", err, req.UserID, req.ResumeID)
+	}
+	return resume, err
+}
+
+// generatePDF implements GeneratePDF.
+func (s *ResumeService) generatePDF(ctx context.Context, req GeneratePDFRequest) (*domain.Resume, error) {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, req.ResumeID, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get resume: %w", err)
 	}
@@ -390,70 +813,42 @@ func (s *ResumeService) GeneratePDF(ctx context.Context, req GeneratePDFRequest)
 		return nil, domain.ErrResumeNotReady
 	}
 
+	if err := s.checkQuota(ctx, resume.UserID, domain.UsagePDFRegeneration); err != nil {
+		return nil, err
+	}
+
 	// Get user for personal info.
 	user, err := s.userRepo.GetByID(ctx, resume.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
-	// Get spoken languages.
-	languages, err := s.languageRepo.ListByUserID(ctx, resume.UserID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get languages: %w", err)
-	}
-
-	// Get education entries.
-	education, err := s.educationRepo.ListByUserID(ctx, resume.UserID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get education: %w", err)
+	templateName := req.TemplateName
+	if templateName == "" {
+		templateName = s.userPreferences(ctx, resume.UserID).DefaultTemplate
 	}
+	templateName = s.resolveTemplate(templateName)
 
-	// Get projects with bullets.
-	projects, err := s.projectRepo.ListByUserIDWithBullets(ctx, resume.UserID)
+	// Render as DownloadPDF does, so both cache the same PDF under the same key.
+	html, err := s.renderResumeHTMLWithTemplate(ctx, user, resume, templateName, DensityComfortable)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get projects: %w", err)
-	}
-
-	// Get user skills for categorization.
-	skills, err := s.skillRepo.ListByUserID(ctx, resume.UserID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get skills: %w", err)
-	}
-
-	// Build HTML using Jake's Resume template.
-	template := NewJakeResumeTemplate()
-	html := template.Render(ResumeTemplateData{
-		User:        user,
-		Resume:      resume,
-		Education:   education,
-		Projects:    projects,
-		Languages:   languages,
-		Skills:      skills,
-		FontSize:    11, // Default to 11pt
-		ShowSummary: true,
-		Locale:      ParseLocale(resume.TargetLanguage),
-	})
-
-	// Generate PDF.
-	templateName := req.TemplateName
-	if templateName == "" {
-		templateName = "jake"
+		return nil, err
 	}
 
 	pdfResult, err := s.pdfEngine.GeneratePDF(ctx, ports.GeneratePDFRequest{
 		HTML:         html,
 		TemplateName: templateName,
-		Options:      ports.DefaultPDFOptions(),
+		Options:      resumePDFOptions(resume, templateName),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate PDF: %w", err)
 	}
 	defer pdfResult.Content.Close()
+	s.recordUsage(ctx, resume.UserID, domain.UsagePDFRegeneration)
 
 	// Upload PDF to storage.
-	filename := fmt.Sprintf("resumes/%s/%s.pdf", resume.UserID, resume.ID)
 	uploadResult, err := s.fileStorage.Upload(ctx, ports.UploadRequest{
-		Key:         filename,
+		Key:         pdfCacheKey(resume, templateName, DensityComfortable),
 		Content:     pdfResult.Content,
 		ContentType: "application/pdf",
 	})
@@ -462,14 +857,22 @@ func (s *ResumeService) GeneratePDF(ctx context.Context, req GeneratePDFRequest)
 	}
 
 	// Update resume with PDF URL.
+	previousStatus := resume.Status
 	resume.PDFURL = &uploadResult.URL
 	if err := resume.TransitionStatus(domain.ResumeStatusReviewed); err != nil {
 		// Ignore status transition error.
 	}
 
-	if err := s.resumeRepo.Update(ctx, resume); err != nil {
+	events := append([]domain.OutboxEvent{
+		domain.NewResumeOutboxEvent(domain.EventResumePDFGenerated, resume, map[string]string{
+			"template": templateName,
+		}),
+	}, statusChangeEvents(resume, previousStatus)...)
+	if err := s.updateResume(ctx, resume, events...); err != nil {
 		return nil, fmt.Errorf("failed to update resume: %w", err)
 	}
+	s.recordActivity(ctx, domain.NewResumeEvent(resume, domain.ResumeActivityPDFGenerated, templateName))
+	s.recordStatusChange(ctx, resume, previousStatus)
 
 	return resume, nil
 }
@@ -477,8 +880,16 @@ func (s *ResumeService) GeneratePDF(ctx context.Context, req GeneratePDFRequest)
 // DownloadPDFRequest contains parameters for downloading a resume PDF.
 type DownloadPDFRequest struct {
 	ResumeID        string
+	UserID          string
 	TemplateName    string
 	ForceRegenerate bool
+
+	// Watermark stamps a "DRAFT" watermark, in the resume's language, when the
+	// resume has not been reviewed yet. Watermarked PDFs are never cached.
+	Watermark bool
+
+	// Density is the spacing preset name; empty is comfortable.
+	Density string
 }
 
 // DownloadPDFResult contains the result of downloading a PDF.
@@ -490,7 +901,21 @@ type DownloadPDFResult struct {
 
 // DownloadPDF generates (if needed) and returns the PDF bytes for a resume.
 func (s *ResumeService) DownloadPDF(ctx context.Context, req DownloadPDFRequest) (*DownloadPDFResult, error) {
-	resume, err := s.resumeRepo.GetByID(ctx, req.ResumeID)
+	result, err := s.downloadPDF(ctx, req)
+	if err != nil {
+		reportError(ctx, s.reporter, "download pdf", err, req.UserID, req.ResumeID)
+	}
+	return result, err
+}
+
+// downloadPDF implements DownloadPDF.
+func (s *ResumeService) downloadPDF(ctx context.Context, req DownloadPDFRequest) (*DownloadPDFResult, error) {
+	density, err := ParseDensity(req.Density)
+	if err != nil {
+		return nil, err
+	}
+
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, req.ResumeID, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get resume: %w", err)
 	}
@@ -505,10 +930,21 @@ func (s *ResumeService) DownloadPDF(ctx context.Context, req DownloadPDFRequest)
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
+	templateName := req.TemplateName
+	if templateName == "" {
+		templateName = s.userPreferences(ctx, resume.UserID).DefaultTemplate
+	}
+	templateName = s.resolveTemplate(templateName)
+
+	var watermark string
+	if req.Watermark && resume.IsUnreviewed() {
+		watermark = NewI18n(ParseLocale(resume.TargetLanguage)).T(KeyDraft)
+	}
+
 	// Check if PDF already exists (skip cache if force regenerate is requested).
-	filename := fmt.Sprintf("resumes/%s/%s.pdf", resume.UserID, resume.ID)
+	filename := pdfCacheKey(resume, templateName, density)
 
-	if !req.ForceRegenerate {
+	if !req.ForceRegenerate && watermark == "" {
 		// Try to download existing PDF from cache.
 		reader, err := s.fileStorage.Download(ctx, filename)
 		if err == nil && reader != nil {
@@ -517,7 +953,7 @@ func (s *ResumeService) DownloadPDF(ctx context.Context, req DownloadPDFRequest)
 			if readErr == nil && len(content) > 0 {
 				return &DownloadPDFResult{
 					Content:     content,
-					Filename:    s.generatePDFFilename(user, resume),
+					Filename:    s.resumeFilename(ctx, user, resume, "pdf"),
 					ContentType: "application/pdf",
 				}, nil
 			}
@@ -525,124 +961,301 @@ func (s *ResumeService) DownloadPDF(ctx context.Context, req DownloadPDFRequest)
 	}
 
 	// PDF doesn't exist or force regenerate requested, generate it.
+	if err := s.checkQuota(ctx, resume.UserID, domain.UsagePDFRegeneration); err != nil {
+		return nil, err
+	}
+
+	htmlContent, err := s.renderResumeHTMLWithTemplate(ctx, user, resume, templateName, density)
+	if err != nil {
+		return nil, err
+	}
+
+	pdfResult, err := s.pdfEngine.GeneratePDF(ctx, ports.GeneratePDFRequest{
+		HTML:         htmlContent,
+		TemplateName: templateName,
+		Watermark:    watermark,
+		Options:      resumePDFOptions(resume, templateName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate PDF: %w", err)
+	}
+	defer pdfResult.Content.Close()
+
+	// Read PDF content.
+	pdfBytes, err := readAll(pdfResult.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PDF content: %w", err)
+	}
+	s.recordUsage(ctx, resume.UserID, domain.UsagePDFRegeneration)
+	s.recordActivity(ctx, domain.NewResumeEvent(resume, domain.ResumeActivityPDFGenerated, templateName))
+
+	// Upload for caching in the background (best effort, don't fail if
+	// upload fails). The cache only holds PDFs without a watermark.
+	if watermark == "" {
+		job := newCachePDFJob(resume, templateName, density)
+		_ = s.jobs.Go(ctx, *job, func(ctx context.Context, job domain.BackgroundJob) error {
+			return s.uploadCachedPDF(ctx, job, filename, pdfBytes)
+		})
+	}
+
+	return &DownloadPDFResult{
+		Content:     pdfBytes,
+		Filename:    s.resumeFilename(ctx, user, resume, "pdf"),
+		ContentType: "application/pdf",
+	}, nil
+}
+
+// renderResumeHTML loads the user's profile sections and renders the resume
+// with Jake's Resume template. The result is a self-contained HTML document.
+func (s *ResumeService) renderResumeHTML(ctx context.Context, user *domain.User, resume *domain.Resume) (string, error) {
+	return s.renderResumeHTMLWithTemplate(ctx, user, resume, domain.TemplateJake, DensityComfortable)
+}
+
+// renderResumeHTMLWithTemplate renders the resume with the named HTML template,
+// built-in or custom, and density preset. Unknown template names fall back to
+// Jake's Resume.
+func (s *ResumeService) renderResumeHTMLWithTemplate(ctx context.Context, user *domain.User, resume *domain.Resume, templateName string, density Density) (string, error) {
+	data, err := s.loadResumeTemplateData(ctx, user, resume)
+	if err != nil {
+		return "", err
+	}
+	data.Density = density
+
+	switch templateName {
+	case domain.TemplateEuropass:
+		return NewEuropassResumeTemplate().Render(data), nil
+	case domain.TemplateAcademic:
+		if s.academicRepo != nil {
+			data.Academic, err = s.academicRepo.ListByUserID(ctx, resume.UserID)
+			if err != nil {
+				return "", fmt.Errorf("failed to get academic entries: %w", err)
+			}
+		}
+		return NewAcademicCVTemplate().Render(data), nil
+	case domain.TemplateFunctional:
+		return NewFunctionalResumeTemplate().Render(data), nil
+	default:
+		if s.customTemplates.Has(templateName) {
+			return s.customTemplates.Render(templateName, data)
+		}
+		return NewJakeResumeTemplate().Render(data), nil
+	}
+}
+
+// loadResumeTemplateData loads the profile sections needed to render a resume.
+func (s *ResumeService) loadResumeTemplateData(ctx context.Context, user *domain.User, resume *domain.Resume) (ResumeTemplateData, error) {
 	languages, err := s.languageRepo.ListByUserID(ctx, resume.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get languages: %w", err)
+		return ResumeTemplateData{}, fmt.Errorf("failed to get languages: %w", err)
 	}
 
 	// Get education entries.
 	education, err := s.educationRepo.ListByUserID(ctx, resume.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get education: %w", err)
+		return ResumeTemplateData{}, fmt.Errorf("failed to get education: %w", err)
 	}
 
 	// Get projects with bullets.
 	projects, err := s.projectRepo.ListByUserIDWithBullets(ctx, resume.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get projects: %w", err)
+		return ResumeTemplateData{}, fmt.Errorf("failed to get projects: %w", err)
 	}
 
 	// Get user skills for categorization.
 	skills, err := s.skillRepo.ListByUserID(ctx, resume.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get skills: %w", err)
+		return ResumeTemplateData{}, fmt.Errorf("failed to get skills: %w", err)
 	}
 
-	// Build HTML using Jake's Resume template.
-	template := NewJakeResumeTemplate()
-	htmlContent := template.Render(ResumeTemplateData{
+	prefs := s.userPreferences(ctx, resume.UserID)
+
+	return ResumeTemplateData{
 		User:        user,
 		Resume:      resume,
 		Education:   education,
 		Projects:    projects,
 		Languages:   languages,
 		Skills:      skills,
-		FontSize:    11,
+		FontSize:    prefs.DefaultFontSize, // 0 keeps each template's default
 		ShowSummary: true,
 		Locale:      ParseLocale(resume.TargetLanguage),
-	})
-
-	templateName := req.TemplateName
-	if templateName == "" {
-		templateName = "jake"
-	}
+		DateFormat:  prefs.DateFormat,
+		QRCodeImage: s.qrCodeImage(user, resume),
+	}, nil
+}
 
-	pdfResult, err := s.pdfEngine.GeneratePDF(ctx, ports.GeneratePDFRequest{
-		HTML:         htmlContent,
-		TemplateName: templateName,
-		Options:      ports.DefaultPDFOptions(),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate PDF: %w", err)
+// resumePDFOptions returns the PDF options of a resume rendered with the
+// named template, which keep it to its page limit. Academic CVs have none.
+func resumePDFOptions(resume *domain.Resume, templateName string) ports.PDFOptions {
+	opts := ports.DefaultPDFOptions()
+	if templateName != domain.TemplateAcademic {
+		opts.MaxPages = resume.PageLimit()
 	}
-	defer pdfResult.Content.Close()
+	return opts
+}
 
-	// Read PDF content.
-	pdfBytes, err := readAll(pdfResult.Content)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read PDF content: %w", err)
+// resolveTemplate returns the name of the template that renders templateName:
+// itself when it is a built-in or custom template, Jake's Resume otherwise.
+func (s *ResumeService) resolveTemplate(templateName string) string {
+	if slices.Contains(builtinTemplates, templateName) || s.customTemplates.Has(templateName) {
+		return templateName
 	}
-
-	// Upload for caching (best effort, don't fail if upload fails).
-	go func() {
-		uploadCtx := context.Background()
-		_, uploadErr := s.fileStorage.Upload(uploadCtx, ports.UploadRequest{
-			Key:         filename,
-			Content:     newBytesReader(pdfBytes),
-			ContentType: "application/pdf",
-		})
-		if uploadErr != nil {
-			// Log but don't fail.
-			fmt.Printf("Warning: failed to cache PDF: %v\n", uploadErr)
-		}
-	}()
-
-	return &DownloadPDFResult{
-		Content:     pdfBytes,
-		Filename:    s.generatePDFFilename(user, resume),
-		ContentType: "application/pdf",
-	}, nil
+	return domain.TemplateJake
 }
 
-// generatePDFFilename generates a descriptive filename for the PDF.
-func (s *ResumeService) generatePDFFilename(user *domain.User, resume *domain.Resume) string {
-	name := user.GetDisplayName()
-	if resume.CompanyName != nil && *resume.CompanyName != "" {
-		return fmt.Sprintf("%s_Resume_%s.pdf", sanitizeFilename(name), sanitizeFilename(*resume.CompanyName))
+// pdfCacheKey returns the storage key of the cached PDF for a resume, template
+// and density. Jake's Resume at the comfortable density keeps the original
+// key so existing caches stay valid; other variants add suffixes. The template
+// name must have been resolved with resolveTemplate.
+func pdfCacheKey(resume *domain.Resume, templateName string, density Density) string {
+	name := resume.ID
+	if templateName != "" && templateName != domain.TemplateJake {
+		name += "_" + templateName
 	}
-	if resume.JobTitle != nil && *resume.JobTitle != "" {
-		return fmt.Sprintf("%s_Resume_%s.pdf", sanitizeFilename(name), sanitizeFilename(*resume.JobTitle))
+	if density != DensityComfortable {
+		name += "_" + string(density)
 	}
-	return fmt.Sprintf("%s_Resume.pdf", sanitizeFilename(name))
+	return fmt.Sprintf("resumes/%s/%s.pdf", resume.UserID, name)
 }
 
 // UpdateResumeStatusRequest contains parameters for updating resume status.
+// An empty NewStatus keeps the current status.
 type UpdateResumeStatusRequest struct {
 	ResumeID  string
+	UserID    string
 	NewStatus string
 	Notes     *string
+
+	// QRCode, when set, changes the profile link shown as a QR code in the
+	// header; an empty target removes it.
+	QRCode *string
+
+	// ContactPriority, when not nil, replaces the order of the header
+	// contacts; an empty list restores the default order.
+	ContactPriority []string
+
+	// Sections, when set, replaces the section display options.
+	Sections *domain.SectionConfig
+
+	// MaxPages, when set, changes the number of pages the resume may span.
+	MaxPages *int
+
+	// IncludedExperienceIDs and ExcludedExperienceIDs, when not nil,
+	// replace the experiences tailoring includes or excludes for this
+	// resume, overriding their exclude_from_tailoring flags.
+	IncludedExperienceIDs []string
+	ExcludedExperienceIDs []string
 }
 
 // UpdateResumeStatus updates the status of a resume.
 func (s *ResumeService) UpdateResumeStatus(ctx context.Context, req UpdateResumeStatusRequest) (*domain.Resume, error) {
-	resume, err := s.resumeRepo.GetByID(ctx, req.ResumeID)
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, req.ResumeID, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get resume: %w", err)
 	}
+	previousStatus := resume.Status
 
-	newStatus, err := domain.ParseResumeStatus(req.NewStatus)
-	if err != nil {
-		return nil, err
+	if req.NewStatus != "" {
+		newStatus, err := domain.ParseResumeStatus(req.NewStatus)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := resume.TransitionStatus(newStatus); err != nil {
+			return nil, err
+		}
 	}
 
-	if err := resume.TransitionStatus(newStatus); err != nil {
-		return nil, err
+	if req.QRCode != nil {
+		target := domain.QRCodeTarget(*req.QRCode)
+		if !target.IsValid() {
+			v := &domain.ValidationErrors{}
+			v.AddFieldError("qr_code", "must be empty, 'portfolio', 'website', 'linkedin' or 'github'")
+			return nil, v
+		}
+		resume.QRCode = target
+	}
+
+	if req.ContactPriority != nil {
+		priority, err := parseContactPriority(req.ContactPriority)
+		if err != nil {
+			return nil, err
+		}
+		resume.ContactPriority = priority
+	}
+
+	if req.Sections != nil {
+		if err := req.Sections.Validate(); err != nil {
+			return nil, err
+		}
+		resume.Sections = *req.Sections
+	}
+
+	if req.MaxPages != nil {
+		if err := resume.SetMaxPages(*req.MaxPages); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.IncludedExperienceIDs != nil || req.ExcludedExperienceIDs != nil {
+		included, excluded := resume.IncludedExperienceIDs, resume.ExcludedExperienceIDs
+		if req.IncludedExperienceIDs != nil {
+			included = req.IncludedExperienceIDs
+		}
+		if req.ExcludedExperienceIDs != nil {
+			excluded = req.ExcludedExperienceIDs
+		}
+		if err := s.validateExperienceOverrides(ctx, resume.UserID, included, excluded); err != nil {
+			return nil, err
+		}
+		resume.IncludedExperienceIDs, resume.ExcludedExperienceIDs = included, excluded
 	}
 
 	if req.Notes != nil {
 		resume.Notes = req.Notes
 	}
 
+	if err := s.updateResume(ctx, resume, statusChangeEvents(resume, previousStatus)...); err != nil {
+		return nil, fmt.Errorf("failed to update resume: %w", err)
+	}
+	s.recordStatusChange(ctx, resume, previousStatus)
+	if req.Notes != nil && strings.TrimSpace(*req.Notes) != "" {
+		s.recordActivity(ctx, domain.NewResumeNote(resume, strings.TrimSpace(*req.Notes)))
+	}
+
+	return resume, nil
+}
+
+// SetResumeArchived archives or unarchives a user's resume. Archived resumes
+// are kept but left out of resume lists by default.
+func (s *ResumeService) SetResumeArchived(ctx context.Context, resumeID, userID string, archived bool) (*domain.Resume, error) {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, resumeID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+
+	if archived {
+		resume.Archive()
+	} else {
+		resume.Unarchive()
+	}
+
+	if err := s.resumeRepo.Update(ctx, resume); err != nil {
+		return nil, fmt.Errorf("failed to update resume: %w", err)
+	}
+
+	return resume, nil
+}
+
+// SetResumePinned pins or unpins a user's resume.
+func (s *ResumeService) SetResumePinned(ctx context.Context, resumeID, userID string, pinned bool) (*domain.Resume, error) {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, resumeID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+
+	resume.Pin(pinned)
+
 	if err := s.resumeRepo.Update(ctx, resume); err != nil {
 		return nil, fmt.Errorf("failed to update resume: %w", err)
 	}
@@ -650,22 +1263,18 @@ func (s *ResumeService) UpdateResumeStatus(ctx context.Context, req UpdateResume
 	return resume, nil
 }
 
-// DeleteResume removes a resume.
-func (s *ResumeService) DeleteResume(ctx context.Context, resumeID string) error {
+// DeleteResume removes a user's resume.
+func (s *ResumeService) DeleteResume(ctx context.Context, resumeID, userID string) error {
 	// Get resume to check for PDF.
-	resume, err := s.resumeRepo.GetByID(ctx, resumeID)
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, resumeID, userID)
 	if err != nil {
 		return fmt.Errorf("failed to get resume: %w", err)
 	}
 
 	// Delete PDF from storage if exists.
-	if resume.PDFURL != nil {
-		filename := fmt.Sprintf("resumes/%s/%s.pdf", resume.UserID, resume.ID)
-		// Ignore delete errors for storage.
-		_ = s.fileStorage.Delete(ctx, filename)
-	}
+	s.deletePDF(ctx, resume)
 
-	if err := s.resumeRepo.Delete(ctx, resumeID); err != nil {
+	if err := s.resumeRepo.Delete(ctx, resumeID, userID); err != nil {
 		return fmt.Errorf("failed to delete resume: %w", err)
 	}
 
@@ -711,22 +1320,3 @@ func (r *bytesReader) Read(p []byte) (int, error) {
 	r.offset += n
 	return n, nil
 }
-
-// sanitizeFilename removes or replaces characters that are invalid in filenames.
-func sanitizeFilename(name string) string {
-	var result []byte
-	for i := 0; i < len(name); i++ {
-		c := name[i]
-		switch c {
-		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
-			result = append(result, '_')
-		case ' ':
-			result = append(result, '_')
-		default:
-			if c >= 32 && c < 127 {
-				result = append(result, c)
-			}
-		}
-	}
-	return string(result)
-}
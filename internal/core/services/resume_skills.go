@@ -0,0 +1,108 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// highlightedSkillNames returns the names of the user's highlighted skills.
+func highlightedSkillNames(skills []domain.Skill) []string {
+	var names []string
+	for _, skill := range skills {
+		if skill.IsHighlighted {
+			names = append(names, skill.Name)
+		}
+	}
+	return names
+}
+
+// tailoredSkills chooses the skills listed on a tailored resume: those the
+// job mentions, with highlighted skills first. includeHighlighted adds the
+// highlighted skills the job does not mention. When the job mentions none of
+// the user's skills, all of them are listed, highlighted skills first.
+// Skills otherwise keep the user's catalog order.
+func tailoredSkills(skills []domain.Skill, job *ports.JobAnalysis, jobDescription string, includeHighlighted bool) []string {
+	mentioned := make([]bool, len(skills))
+	anyMentioned := false
+	for i, skill := range skills {
+		mentioned[i] = jobMentionsSkill(job, jobDescription, skill.Name)
+		anyMentioned = anyMentioned || mentioned[i]
+	}
+
+	var highlighted, other []string
+	for i, skill := range skills {
+		if anyMentioned && !mentioned[i] && !(skill.IsHighlighted && includeHighlighted) {
+			continue
+		}
+		if skill.IsHighlighted {
+			highlighted = append(highlighted, skill.Name)
+		} else {
+			other = append(other, skill.Name)
+		}
+	}
+
+	return append(highlighted, other...)
+}
+
+// jobMentionsSkill reports whether the job analysis lists the skill, or the
+// job description contains its name as a whole word. Names are compared
+// case-insensitively.
+func jobMentionsSkill(job *ports.JobAnalysis, jobDescription, name string) bool {
+	if job != nil {
+		for _, list := range [][]string{job.RequiredSkills, job.PreferredSkills, job.Keywords} {
+			for _, s := range list {
+				if strings.EqualFold(s, name) {
+					return true
+				}
+			}
+		}
+	}
+	return containsWord(strings.ToLower(jobDescription), strings.ToLower(name))
+}
+
+// containsWord reports whether s contains word with no letter or digit
+// directly before or after it, so "Go" is found in "Go, Rust" but not in
+// "good".
+func containsWord(s, word string) bool {
+	if word == "" {
+		return false
+	}
+	for i := 0; ; {
+		j := strings.Index(s[i:], word)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(word)
+		if !isWordRune(lastRune(s[:start])) && !isWordRune(firstRune(s[end:])) {
+			return true
+		}
+		i = start + 1
+	}
+}
+
+// isWordRune reports whether r is part of a word.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// firstRune returns the first rune of s, or 0 when s is empty.
+func firstRune(s string) rune {
+	if s == "" {
+		return 0
+	}
+	r, _ := utf8.DecodeRuneInString(s)
+	return r
+}
+
+// lastRune returns the last rune of s, or 0 when s is empty.
+func lastRune(s string) rune {
+	if s == "" {
+		return 0
+	}
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return r
+}
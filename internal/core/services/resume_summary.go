@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// SelectSummaryRequest contains the summary variant to make a resume's
+// summary.
+type SelectSummaryRequest struct {
+	ResumeID string
+	UserID   string
+	Angle    string
+}
+
+// SelectSummary makes one of the summary variants generated with a resume
+// its summary, replacing the current one, edits included. The other
+// variants are kept to switch again later.
+func (s *ResumeService) SelectSummary(ctx context.Context, req SelectSummaryRequest) (*domain.Resume, error) {
+	angle := strings.ToLower(strings.TrimSpace(req.Angle))
+	if angle == "" {
+		v := &domain.ValidationErrors{}
+		v.AddFieldError("angle", "angle is required")
+		return nil, v
+	}
+
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+	if resume.GeneratedContent == nil {
+		return nil, domain.ErrResumeNotReady
+	}
+
+	if err := resume.GeneratedContent.SelectSummary(angle); err != nil {
+		return nil, err
+	}
+	if err := s.updateResume(ctx, resume); err != nil {
+		return nil, fmt.Errorf("failed to update resume: %w", err)
+	}
+	return resume, nil
+}
+
+// setSummary sets the summary of content and its variants from result, the
+// first variant becoming the summary.
+func setSummary(content *domain.ResumeContent, result *ports.SummaryResult) {
+	content.Summary = result.Summary
+	content.SummaryVariants = result.Variants
+	content.SummaryAngle = ""
+	if len(result.Variants) > 0 {
+		content.SummaryAngle = result.Variants[0].Angle
+	}
+}
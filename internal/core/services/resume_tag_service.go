@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// ResumeTagService handles user-defined resume tags.
+type ResumeTagService struct {
+	tagRepo    ports.ResumeTagRepository
+	resumeRepo ports.ResumeRepository
+}
+
+// NewResumeTagService creates a new ResumeTagService with required dependencies.
+func NewResumeTagService(tagRepo ports.ResumeTagRepository, resumeRepo ports.ResumeRepository) *ResumeTagService {
+	return &ResumeTagService{
+		tagRepo:    tagRepo,
+		resumeRepo: resumeRepo,
+	}
+}
+
+// CreateTag creates a new tag for a user.
+func (s *ResumeTagService) CreateTag(ctx context.Context, userID, name string) (*domain.ResumeTag, error) {
+	tag, err := domain.NewResumeTag(userID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNameAvailable(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	if err := s.tagRepo.Create(ctx, tag); err != nil {
+		return nil, fmt.Errorf("failed to create resume tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+// ListTags lists a user's tags by name, with their resume counts.
+func (s *ResumeTagService) ListTags(ctx context.Context, userID string) ([]domain.ResumeTag, error) {
+	tags, err := s.tagRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resume tags: %w", err)
+	}
+	return tags, nil
+}
+
+// RenameTag changes the name of a user's tag.
+func (s *ResumeTagService) RenameTag(ctx context.Context, tagID, userID, name string) (*domain.ResumeTag, error) {
+	tag, err := s.ownedTag(ctx, tagID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tag.Rename(name); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNameAvailable(ctx, tag); err != nil {
+		return nil, err
+	}
+
+	if err := s.tagRepo.Update(ctx, tag); err != nil {
+		return nil, fmt.Errorf("failed to update resume tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+// DeleteTag removes a user's tag from all resumes and deletes it.
+func (s *ResumeTagService) DeleteTag(ctx context.Context, tagID, userID string) error {
+	err := s.tagRepo.Delete(ctx, tagID, userID)
+	if errors.Is(err, domain.ErrResumeTagNotFound) {
+		return domain.ErrResumeTagNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete resume tag: %w", err)
+	}
+	return nil
+}
+
+// AssignTagRequest adds a tag to resumes and removes it from others.
+type AssignTagRequest struct {
+	TagID  string
+	UserID string
+
+	// Add and Remove list the resumes to tag and untag; a resume may not be
+	// in both.
+	Add    []string
+	Remove []string
+}
+
+// AssignTag adds a tag to and removes it from many resumes at once, and
+// returns the tag with its new resume count. All resumes must belong to the
+// user.
+func (s *ResumeTagService) AssignTag(ctx context.Context, req AssignTagRequest) (*domain.ResumeTag, error) {
+	if _, err := s.ownedTag(ctx, req.TagID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	v := &domain.ValidationErrors{}
+	if len(req.Add) == 0 && len(req.Remove) == 0 {
+		v.AddFieldError("add", "at least one resume to add or remove is required")
+	}
+	adding := make(map[string]bool, len(req.Add))
+	for _, id := range req.Add {
+		adding[id] = true
+	}
+	lists := []struct {
+		field string
+		ids   []string
+	}{{"add", req.Add}, {"remove", req.Remove}}
+	for _, list := range lists {
+		for _, id := range list.ids {
+			if list.field == "remove" && adding[id] {
+				v.AddFieldError("remove", fmt.Sprintf("resume %q is also added", id))
+				continue
+			}
+			if err := s.checkResumeOwned(ctx, id, req.UserID); err != nil {
+				if !errors.Is(err, domain.ErrResumeNotFound) {
+					return nil, err
+				}
+				v.AddFieldError(list.field, fmt.Sprintf("resume %q not found", id))
+			}
+		}
+	}
+	if err := v.ToError(); err != nil {
+		return nil, err
+	}
+
+	if err := s.tagRepo.Assign(ctx, req.TagID, req.Add, req.Remove); err != nil {
+		return nil, fmt.Errorf("failed to assign resume tag: %w", err)
+	}
+
+	tag, err := s.tagRepo.GetByIDForUser(ctx, req.TagID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume tag: %w", err)
+	}
+	return tag, nil
+}
+
+// ownedTag loads a tag, reporting tags of other users as not found.
+func (s *ResumeTagService) ownedTag(ctx context.Context, tagID, userID string) (*domain.ResumeTag, error) {
+	tag, err := s.tagRepo.GetByIDForUser(ctx, tagID, userID)
+	if errors.Is(err, domain.ErrResumeTagNotFound) {
+		return nil, domain.ErrResumeTagNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume tag: %w", err)
+	}
+	return tag, nil
+}
+
+// checkResumeOwned returns domain.ErrResumeNotFound unless the resume
+// exists and belongs to the user.
+func (s *ResumeTagService) checkResumeOwned(ctx context.Context, resumeID, userID string) error {
+	_, err := s.resumeRepo.GetByIDForUser(ctx, resumeID, userID)
+	if errors.Is(err, domain.ErrResumeNotFound) {
+		return domain.ErrResumeNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get resume: %w", err)
+	}
+	return nil
+}
+
+// checkNameAvailable returns domain.ErrResumeTagAlreadyExists when another
+// of the user's tags has the tag's name, ignoring case.
+func (s *ResumeTagService) checkNameAvailable(ctx context.Context, tag *domain.ResumeTag) error {
+	tags, err := s.tagRepo.ListByUserID(ctx, tag.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to list resume tags: %w", err)
+	}
+	for _, other := range tags {
+		if other.ID != tag.ID && strings.EqualFold(other.Name, tag.Name) {
+			return domain.ErrResumeTagAlreadyExists
+		}
+	}
+	return nil
+}
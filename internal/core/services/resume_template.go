@@ -2,8 +2,10 @@
 package services
 
 import (
+	"embed"
 	"fmt"
 	"html"
+	"html/template"
 	"slices"
 	"strings"
 
@@ -18,16 +20,129 @@ type ResumeTemplateData struct {
 	Projects    []domain.Project
 	Languages   []domain.SpokenLanguage
 	Skills      []domain.Skill
-	FontSize    int    // Base font size in pt (11, 10, or 9)
-	ShowSummary bool   // Whether to show the professional summary
-	Locale      Locale // Locale for internationalization (defaults to en-US)
+	Academic    []domain.AcademicEntry // Academic CV sections, if any
+	FontSize    int                    // Base font size in pt (11, 10, or 9)
+	ShowSummary bool                   // Whether to show the professional summary
+	Locale      Locale                 // Locale for internationalization (defaults to en-US)
+	DateFormat  domain.DateFormat      // Date style (defaults to the locale's)
+
+	// SectionOrder is the order of the sections below the header. Sections
+	// left out keep their default relative order after the listed ones.
+	SectionOrder []ResumeSection
+
+	// QRCodeImage is the data URI of the header QR code, which links to the
+	// resume's QR code target. Empty for none.
+	QRCodeImage string
+
+	// Density is the spacing preset; empty is DensityComfortable.
+	Density Density
 }
 
+// ResumeSection identifies a section of a rendered resume.
+type ResumeSection string
+
+// Resume section constants.
+const (
+	SectionSummary    ResumeSection = "summary"
+	SectionEducation  ResumeSection = "education"
+	SectionSkills     ResumeSection = "skills"
+	SectionExperience ResumeSection = "experience"
+	SectionProjects   ResumeSection = "projects"
+	SectionLanguages  ResumeSection = "languages"
+)
+
+// DefaultSectionOrder is the section order of Jake's Resume.
+var DefaultSectionOrder = []ResumeSection{
+	SectionSummary,
+	SectionEducation,
+	SectionSkills,
+	SectionExperience,
+	SectionProjects,
+	SectionLanguages,
+}
+
+// IsValid checks if the section is known.
+func (s ResumeSection) IsValid() bool {
+	return slices.Contains(DefaultSectionOrder, s)
+}
+
+// sectionOrder returns the full section order, completing a partial custom
+// order with the remaining sections in their default order.
+func (d ResumeTemplateData) sectionOrder() []ResumeSection {
+	order := make([]ResumeSection, 0, len(DefaultSectionOrder))
+	for _, section := range d.SectionOrder {
+		if section.IsValid() && !slices.Contains(order, section) {
+			order = append(order, section)
+		}
+	}
+	for _, section := range DefaultSectionOrder {
+		if !slices.Contains(order, section) {
+			order = append(order, section)
+		}
+	}
+	return order
+}
+
+// pageLimit returns the number of pages the resume may span.
+func (d ResumeTemplateData) pageLimit() int {
+	if d.Resume == nil {
+		return 1
+	}
+	return d.Resume.PageLimit()
+}
+
+// i18n returns the translator for the data's locale and date format.
+func (d ResumeTemplateData) i18n() *I18n {
+	return NewI18n(d.Locale).WithDateFormat(d.DateFormat)
+}
+
+// summary returns the tailored summary, falling back to the user's profile summary.
+func (d ResumeTemplateData) summary() string {
+	if d.Resume != nil && d.Resume.GeneratedContent != nil && d.Resume.GeneratedContent.Summary != "" {
+		return d.Resume.GeneratedContent.Summary
+	}
+	if d.User != nil && d.User.Summary != nil && *d.User.Summary != "" {
+		return *d.User.Summary
+	}
+	return ""
+}
+
+// qrCode returns the header QR code as a link to its target, or "" for none.
+func (d ResumeTemplateData) qrCode(class string) string {
+	if d.QRCodeImage == "" || d.Resume == nil || d.User == nil {
+		return ""
+	}
+	link := d.Resume.QRCode.URL(d.User)
+	return fmt.Sprintf(`<a class="%s" href="%s"><img src="%s" alt="%s"></a>`,
+		class, html.EscapeString(link), html.EscapeString(d.QRCodeImage), html.EscapeString(link))
+}
+
+// jakeLayout is the comfortable layout of Jake's Resume.
+var jakeLayout = densityLayout{
+	lineHeight: 1.6,
+	marginY:    0.3,
+	marginX:    0.4,
+	sectionGap: 8,
+	entryGap:   6,
+	bulletGap:  1,
+}
+
+// jakeTemplateFS holds the html/template files of Jake's Resume.
+//
+//go:embed templates/jake/*.tmpl
+var jakeTemplateFS embed.FS
+
+// jakeTemplates are the parsed templates of Jake's Resume.
+var jakeTemplates = template.Must(template.ParseFS(jakeTemplateFS, "templates/jake/*.tmpl"))
+
 // JakeResumeTemplate implements the Jake's Resume format.
 // This is the gold standard for developer resumes:
 // - Single page, dense, ATS-friendly
 // - Sections: Header → Education → Experience → Projects → Technical Skills
 // - Clean typography with clear visual hierarchy
+//
+// The markup lives in templates/jake, rendered with html/template from the
+// jake*View view models built here.
 type JakeResumeTemplate struct{}
 
 // NewJakeResumeTemplate creates a new Jake's Resume template.
@@ -35,433 +150,323 @@ func NewJakeResumeTemplate() *JakeResumeTemplate {
 	return &JakeResumeTemplate{}
 }
 
-// Render generates the HTML for the resume.
-func (t *JakeResumeTemplate) Render(data ResumeTemplateData) string {
-	if data.FontSize == 0 {
-		data.FontSize = 11
-	}
+// jakeDocumentView is the view model of a whole resume.
+type jakeDocumentView struct {
+	Head      jakeHeadView
+	Header    *jakeHeaderView
+	MultiPage bool
+	Sections  []jakeSectionView
+}
 
-	// Initialize i18n with the specified locale (defaults to en-US).
-	i18n := NewI18n(data.Locale)
+// jakeHeadView is the view model of the document head.
+type jakeHeadView struct {
+	Lang       string
+	Name       string
+	FontSize   int
+	Density    template.CSS
+	PageMargin template.CSS
+	MultiPage  bool
+}
 
-	var sb strings.Builder
+// jakeHeaderView is the view model of the header, with the contacts laid out
+// in lines.
+type jakeHeaderView struct {
+	Name         string
+	QRCode       template.HTML
+	ContactLines [][]jakeContactView
+}
 
-	// Document head
-	sb.WriteString(t.renderHead(data))
+// jakeContactView is a header contact. Href is empty for phones.
+type jakeContactView struct {
+	Text string
+	Href string
+}
 
-	// Body content
-	sb.WriteString(`<body>`)
-	sb.WriteString(`<div class="resume-container">`)
+// jakeSectionView is the view model of a section; exactly one field is set.
+type jakeSectionView struct {
+	Summary    *jakeSummaryView
+	Education  *jakeEducationView
+	Experience *jakeExperienceView
+	Projects   *jakeProjectsView
+	Skills     *jakeSkillsView
+	Languages  *jakeLanguagesView
+}
 
-	// Header section
-	sb.WriteString(t.renderHeader(data.User))
+// jakeSummaryView is the professional summary.
+type jakeSummaryView struct {
+	Title string
+	Text  template.HTML
+}
 
-	// Professional Summary section (optional - after header, before education)
-	if data.ShowSummary {
-		summary := ""
-		if data.Resume.GeneratedContent != nil && data.Resume.GeneratedContent.Summary != "" {
-			summary = data.Resume.GeneratedContent.Summary
-		} else if data.User != nil && data.User.Summary != nil && *data.User.Summary != "" {
-			summary = *data.User.Summary
-		}
-		if summary != "" {
-			sb.WriteString(t.renderSummary(summary, i18n))
-		}
-	}
+// jakeEducationView is the education section.
+type jakeEducationView struct {
+	Title   string
+	Entries []jakeEducationEntry
+}
 
-	// Education section (always first in Jake's Resume)
-	if len(data.Education) > 0 {
-		sb.WriteString(t.renderEducation(data.Education, i18n))
-	}
+// jakeEducationEntry is an education entry. Extras are the GPA and honors.
+type jakeEducationEntry struct {
+	Institution string
+	Location    string
+	Degree      string
+	Dates       string
+	Extras      string
+}
 
-	// Technical Skills section
-	if data.Resume.GeneratedContent != nil && len(data.Resume.GeneratedContent.Skills) > 0 {
-		sb.WriteString(t.renderSkills(data.Resume.GeneratedContent.Skills, data.Skills, i18n))
-	}
+// jakeExperienceView is the experience section.
+type jakeExperienceView struct {
+	Title   string
+	Entries []jakeExperienceEntry
+}
+
+// jakeExperienceEntry is a tailored experience.
+type jakeExperienceEntry struct {
+	Title        string
+	Organization string
+	Dates        string
+	Bullets      []template.HTML
+}
+
+// jakeProjectsView is the projects section.
+type jakeProjectsView struct {
+	Title   string
+	Entries []jakeProjectEntry
+}
+
+// jakeProjectEntry is a project.
+type jakeProjectEntry struct {
+	Name          string
+	TechStack     string
+	RepositoryURL string
+	URL           string
+	Dates         string
+	Bullets       []template.HTML
+}
+
+// jakeSkillsView is the technical skills section, as key-value rows or, with
+// Bars, as proficiency bars.
+type jakeSkillsView struct {
+	Title  string
+	Bars   bool
+	Groups []jakeSkillGroup
+}
+
+// jakeSkillGroup is a skill category. Items is its row in key-value format
+// and Skills its bars.
+type jakeSkillGroup struct {
+	Category string
+	Items    string
+	Skills   []jakeSkillBar
+}
+
+// jakeSkillBar is a skill with its proficiency level, when in the catalog.
+type jakeSkillBar struct {
+	Name     string
+	HasLevel bool
+	Level    int
+}
+
+// jakeLanguagesView is the spoken languages section.
+type jakeLanguagesView struct {
+	Title   string
+	Entries []jakeLanguageEntry
+}
+
+// jakeLanguageEntry is a spoken language.
+type jakeLanguageEntry struct {
+	Language string
+	Level    string
+}
 
-	// Experience section
-	if data.Resume.GeneratedContent != nil && len(data.Resume.GeneratedContent.Experiences) > 0 {
-		sb.WriteString(t.renderExperience(data.Resume.GeneratedContent.Experiences, i18n))
+// Render generates the HTML for the resume.
+func (t *JakeResumeTemplate) Render(data ResumeTemplateData) string {
+	if data.FontSize == 0 {
+		data.FontSize = 11
 	}
 
-	// Projects section (buffer section - can be dropped for one-page fit)
-	if len(data.Projects) > 0 {
-		sb.WriteString(t.renderProjects(data.Projects, i18n))
+	// Initialize i18n with the specified locale (defaults to en-US).
+	i18n := data.i18n()
+
+	view := jakeDocumentView{
+		Head:      t.headView(data),
+		Header:    t.headerView(data.User, data.Resume, data.Locale, data.qrCode("resume-qr")),
+		MultiPage: data.pageLimit() > 1,
 	}
 
-	// Languages section (if any)
-	if len(data.Languages) > 0 {
-		sb.WriteString(t.renderLanguages(data.Languages, i18n))
+	// Sections in the requested order (Jake's order by default)
+	for _, section := range data.sectionOrder() {
+		if s, ok := t.sectionView(section, data, i18n); ok {
+			view.Sections = append(view.Sections, s)
+		}
 	}
 
-	sb.WriteString(`</div>`)
-	sb.WriteString(`</body></html>`)
+	return executeJakeTemplate("document", view)
+}
 
+// executeJakeTemplate renders a template of Jake's Resume. The view models
+// always fit the templates, so failing to render is a programming error.
+func executeJakeTemplate(name string, view any) string {
+	var sb strings.Builder
+	if err := jakeTemplates.ExecuteTemplate(&sb, name, view); err != nil {
+		panic(fmt.Sprintf("jake template %q: %v", name, err))
+	}
 	return sb.String()
 }
 
-// renderHead generates the HTML head with Jake's Resume CSS.
-func (t *JakeResumeTemplate) renderHead(data ResumeTemplateData) string {
-	userName := "Resume"
-	if data.User != nil {
-		userName = data.User.GetDisplayName()
+// renderSection renders one section, or nothing when it has no content.
+func (t *JakeResumeTemplate) renderSection(section ResumeSection, data ResumeTemplateData, i18n *I18n) string {
+	view, ok := t.sectionView(section, data, i18n)
+	if !ok {
+		return ""
 	}
+	return executeJakeTemplate("section", view)
+}
 
-	lang := "en"
-	if data.Resume != nil {
-		lang = data.Resume.TargetLanguage
-	}
-
-	baseFontSize := data.FontSize
-	if baseFontSize == 0 {
-		baseFontSize = 11
-	}
-
-	return fmt.Sprintf(`<!DOCTYPE html>
-<html lang="%s">
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Resume - %s</title>
-    <style>
-        /* Jake's Resume CSS - ATS-friendly, single-page optimized */
-
-        /* Reset and base */
-        *, *::before, *::after {
-            box-sizing: border-box;
-            margin: 0;
-            padding: 0;
-        }
-
-        body {
-			font-family: Arial, sans-serif;
-			line-height: 1.6;
-            --font-family: 'Times New Roman', Times, serif;
-            font-size: %dpt;
-            --line-height: 1.15;
-            color: #000;
-            background: #fff;
-        }
-
-        .resume-container {
-            max-width: 8.5in;
-            margin: 0 auto;
-            padding: 0.3in 0.4in;
-        }
-
-        /* Header */
-        .resume-header {
-            text-align: center;
-            margin-bottom: 8pt;
-            border-bottom: 1pt solid #000;
-            padding-bottom: 4pt;
-        }
-
-        .resume-name {
-            font-size: 18pt;
-            font-weight: bold;
-            letter-spacing: 0.5pt;
-            text-transform: uppercase;
-            margin-bottom: 4pt;
-        }
-
-		.resume-contact {
-			white-space: nowrap;
-			overflow: hidden;
-			text-overflow: ellipsis;
-			width: 100%%;
-			font-size: 9pt;
-			color: #333;
+// sectionView builds the view of one section, reporting false when it has no
+// content.
+func (t *JakeResumeTemplate) sectionView(section ResumeSection, data ResumeTemplateData, i18n *I18n) (jakeSectionView, bool) {
+	content := data.Resume.GeneratedContent
+
+	switch section {
+	case SectionSummary:
+		// Professional Summary is optional.
+		if summary := data.summary(); data.ShowSummary && summary != "" {
+			return jakeSectionView{Summary: &jakeSummaryView{
+				Title: i18n.T(KeyProfessionalSummary),
+				Text:  template.HTML(renderMarkdownBold(summary)),
+			}}, true
+		}
+	case SectionEducation:
+		if len(data.Education) > 0 {
+			return jakeSectionView{Education: t.educationView(data.Education, i18n)}, true
+		}
+	case SectionSkills:
+		if content != nil && len(content.Skills) > 0 {
+			return jakeSectionView{Skills: t.skillsView(content.Skills, data.Skills, data.Resume.Sections.SkillsDisplay, i18n)}, true
+		}
+	case SectionExperience:
+		if content != nil && len(content.Experiences) > 0 {
+			return jakeSectionView{Experience: t.experienceView(content.Experiences, i18n)}, true
+		}
+	case SectionProjects:
+		// Buffer section - can be dropped for one-page fit.
+		if len(data.Projects) > 0 {
+			return jakeSectionView{Projects: t.projectsView(data.Projects, i18n)}, true
+		}
+	case SectionLanguages:
+		if len(data.Languages) > 0 {
+			return jakeSectionView{Languages: t.languagesView(data.Languages, i18n)}, true
 		}
-
-        .resume-contact a {
-            color: #000;
-            text-decoration: none;
-        }
-
-        .resume-contact a:hover {
-            text-decoration: underline;
-        }
-
-        .contact-separator {
-            margin: 0 6pt;
-        }
-
-        /* Section styling */
-        .resume-section {
-            margin-bottom: 8pt;
-        }
-
-        .section-title {
-            font-size: 11pt;
-            font-weight: bold;
-            text-transform: uppercase;
-            letter-spacing: 1pt;
-            border-bottom: 1pt solid #000;
-            padding-bottom: 2pt;
-            margin-bottom: 4pt;
-        }
-
-        /* Professional Summary */
-        .summary-section {
-            margin-bottom: 10pt;
-        }
-
-        .summary-text {
-            margin: 0;
-            text-align: justify;
-            line-height: 1.3;
-        }
-
-        /* Entry (Education, Experience, Project) */
-        .resume-entry {
-            margin-bottom: 6pt;
-        }
-
-        .entry-header {
-            display: flex;
-            justify-content: space-between;
-            align-items: baseline;
-        }
-
-        .entry-title {
-            font-weight: bold;
-        }
-
-        .entry-location {
-            font-style: italic;
-            font-size: 10pt;
-        }
-
-        .entry-subheader {
-            display: flex;
-            justify-content: space-between;
-            align-items: baseline;
-            font-style: italic;
-        }
-
-        .entry-subtitle {
-            font-style: italic;
-        }
-
-        .entry-date {
-            font-size: 10pt;
-        }
-
-        /* Bullets */
-        .entry-bullets {
-            list-style-type: disc;
-            margin-left: 18pt;
-            margin-top: 2pt;
-        }
-
-        .entry-bullets li {
-            margin-bottom: 1pt;
-            text-align: justify;
-        }
-
-        /* Projects specific */
-        .project-header {
-            display: flex;
-            align-items: baseline;
-            gap: 8pt;
-        }
-
-        .project-name {
-            font-weight: bold;
-        }
-
-        .project-tech {
-            font-style: italic;
-            font-size: 10pt;
-        }
-
-        .project-link {
-            font-size: 9pt;
-            color: #0066cc;
-            text-decoration: none;
-            margin-left: 4pt;
-        }
-
-        .project-link:hover {
-            text-decoration: underline;
-        }
-
-        .project-links {
-            font-size: 9pt;
-        }
-
-        .project-links a {
-            color: #000;
-            text-decoration: none;
-        }
-
-        /* Skills section */
-        .skills-list {
-            margin: 0;
-            padding: 0;
-            list-style: none;
-        }
-
-        .skills-row {
-            margin-bottom: 2pt;
-        }
-
-        .skill-category {
-            font-weight: bold;
-        }
-
-        .skill-items {
-            font-weight: normal;
-        }
-
-        /* Languages section */
-        .languages-list {
-            display: flex;
-            flex-wrap: wrap;
-            gap: 12pt;
-        }
-
-        .language-item {
-            font-size: 10pt;
-        }
-
-        .language-name {
-            font-weight: bold;
-        }
-
-        .language-level {
-            font-style: italic;
-        }
-
-        /* Honors/GPA inline */
-        .education-honors {
-            font-style: italic;
-            font-size: 10pt;
-        }
-
-        /* Print optimization */
-        @media print {
-            body {
-                -webkit-print-color-adjust: exact;
-                print-color-adjust: exact;
-            }
-
-            .resume-container {
-                padding: 0;
-            }
-
-            @page {
-                size: letter;
-                margin: 0.3in 0.4in;
-            }
-        }
-    </style>
-</head>
-`, lang, html.EscapeString(userName), baseFontSize)
-}
-
-// renderHeader generates the header section with name and contact info.
-func (t *JakeResumeTemplate) renderHeader(user *domain.User) string {
-	if user == nil {
-		return ""
 	}
+	return jakeSectionView{}, false
+}
 
-	var sb strings.Builder
-	sb.WriteString(`<header class="resume-header">`)
-	fmt.Fprintf(&sb, `<h1 class="resume-name">%s</h1>`, html.EscapeString(user.GetDisplayName()))
-
-	// Build contact line
-	var contacts []string
+// renderHead generates the HTML head with Jake's Resume CSS.
+func (t *JakeResumeTemplate) renderHead(data ResumeTemplateData) string {
+	return executeJakeTemplate("head", t.headView(data))
+}
 
-	if user.Phone != nil && *user.Phone != "" {
-		contacts = append(contacts, html.EscapeString(*user.Phone))
+// headView builds the view of the document head.
+func (t *JakeResumeTemplate) headView(data ResumeTemplateData) jakeHeadView {
+	view := jakeHeadView{
+		Lang:     "en",
+		Name:     "Resume",
+		FontSize: data.FontSize,
+		// Page breaks are only controlled for resumes that may span pages.
+		MultiPage:  data.pageLimit() > 1,
+		Density:    template.CSS(jakeLayout.cssVariables(data.Density)),
+		PageMargin: template.CSS(jakeLayout.pageMargin(data.Density)),
 	}
-
-	if user.Email != nil && *user.Email != "" {
-		contacts = append(contacts, fmt.Sprintf(`<a href="mailto:%s">%s</a>`,
-			html.EscapeString(*user.Email),
-			html.EscapeString(*user.Email)))
+	if data.User != nil {
+		view.Name = data.User.GetDisplayName()
 	}
-
-	if user.LinkedInURL != nil && *user.LinkedInURL != "" {
-		// Extract username from LinkedIn URL if possible
-		linkedIn := extractURLDisplay(*user.LinkedInURL, "linkedin.com/in/")
-		contacts = append(contacts, fmt.Sprintf(`<a href="%s">%s</a>`,
-			html.EscapeString(*user.LinkedInURL),
-			html.EscapeString(linkedIn)))
+	if data.Resume != nil {
+		view.Lang = data.Resume.TargetLanguage
+	}
+	if view.FontSize == 0 {
+		view.FontSize = 11
 	}
+	return view
+}
 
-	if user.GitHubURL != nil && *user.GitHubURL != "" {
-		// Extract username from GitHub URL if possible
-		github := extractURLDisplay(*user.GitHubURL, "github.com/")
-		contacts = append(contacts, fmt.Sprintf(`<a href="%s">%s</a>`,
-			html.EscapeString(*user.GitHubURL),
-			html.EscapeString(github)))
+// renderHeader generates the header section with name and contact info,
+// and the QR code, if any, in the top right corner.
+func (t *JakeResumeTemplate) renderHeader(user *domain.User, resume *domain.Resume, locale Locale, qrCode string) string {
+	view := t.headerView(user, resume, locale, qrCode)
+	if view == nil {
+		return ""
 	}
+	return executeJakeTemplate("header", view)
+}
 
-	if user.PortfolioURL != nil && *user.PortfolioURL != "" {
-		contacts = append(contacts, fmt.Sprintf(`<a href="%s">%s</a>`,
-			html.EscapeString(*user.PortfolioURL),
-			html.EscapeString(extractDomain(*user.PortfolioURL))))
+// headerView builds the view of the header, or nil without a user. Contacts
+// that do not fit on one line wrap to a second one, and past that the lowest
+// priority ones are left out rather than cut off.
+func (t *JakeResumeTemplate) headerView(user *domain.User, resume *domain.Resume, locale Locale, qrCode string) *jakeHeaderView {
+	if user == nil {
+		return nil
 	}
 
-	if len(contacts) > 0 {
-		sb.WriteString(`<p class="resume-contact">`)
-		sb.WriteString(strings.Join(contacts, `<span class="contact-separator">|</span>`))
-		sb.WriteString(`</p>`)
+	view := &jakeHeaderView{Name: user.GetDisplayName(), QRCode: template.HTML(qrCode)}
+	width := contactLineWidth
+	if qrCode != "" {
+		width -= contactQRCodeWidth
 	}
 
-	sb.WriteString(`</header>`)
-	return sb.String()
+	contacts := resumeContacts(user, resume, locale, jakeContactText)
+	for _, line := range layoutContacts(contacts, width) {
+		items := make([]jakeContactView, 0, len(line))
+		for _, c := range line {
+			items = append(items, jakeContact(c))
+		}
+		view.ContactLines = append(view.ContactLines, items)
+	}
+	return view
 }
 
-// renderSummary generates the professional summary section.
-func (t *JakeResumeTemplate) renderSummary(summary string, i18n *I18n) string {
-	if summary == "" {
-		return ""
+// jakeContactText returns the text shown for a contact: the number, address
+// or username, or the domain of portfolio links.
+func jakeContactText(field domain.ContactField, value string) string {
+	switch field {
+	case domain.ContactLinkedIn:
+		return extractURLDisplay(value, "linkedin.com/in/")
+	case domain.ContactGitHub:
+		return extractURLDisplay(value, "github.com/")
+	case domain.ContactPortfolio:
+		return extractDomain(value)
+	default:
+		return value
 	}
-
-	var sb strings.Builder
-	sb.WriteString(`<section class="resume-section summary-section">`)
-	sb.WriteString(fmt.Sprintf(`<h2 class="section-title">%s</h2>`, html.EscapeString(i18n.T(KeyProfessionalSummary))))
-	sb.WriteString(`<p class="summary-text">`)
-	sb.WriteString(renderMarkdownBold(summary))
-	sb.WriteString(`</p>`)
-	sb.WriteString(`</section>`)
-	return sb.String()
 }
 
-// renderEducation generates the education section.
-func (t *JakeResumeTemplate) renderEducation(education []domain.Education, i18n *I18n) string {
-	if len(education) == 0 {
-		return ""
+// jakeContact builds the view of a contact, linking emails and URLs.
+func jakeContact(c headerContact) jakeContactView {
+	switch c.Field {
+	case domain.ContactPhone:
+		return jakeContactView{Text: c.Text}
+	case domain.ContactEmail:
+		return jakeContactView{Text: c.Text, Href: "mailto:" + c.Value}
+	default:
+		return jakeContactView{Text: c.Text, Href: c.Value}
 	}
+}
 
-	var sb strings.Builder
-	sb.WriteString(`<section class="resume-section">`)
-	sb.WriteString(fmt.Sprintf(`<h2 class="section-title">%s</h2>`, html.EscapeString(i18n.T(KeyEducation))))
+// educationView builds the view of the education section.
+func (t *JakeResumeTemplate) educationView(education []domain.Education, i18n *I18n) *jakeEducationView {
+	view := &jakeEducationView{Title: i18n.T(KeyEducation)}
 
 	for _, edu := range education {
-		sb.WriteString(`<div class="resume-entry">`)
-
-		// First line: Institution | Location
-		sb.WriteString(`<div class="entry-header">`)
-		fmt.Fprintf(&sb, `<span class="entry-title">%s</span>`, html.EscapeString(edu.Institution))
-		if edu.Location != nil && *edu.Location != "" {
-			fmt.Fprintf(&sb, `<span class="entry-location">%s</span>`, html.EscapeString(*edu.Location))
+		entry := jakeEducationEntry{
+			Institution: edu.Institution,
+			Degree:      edu.Degree,
+			Dates:       formatEducationDateRangeLocalized(edu.StartDate, edu.EndDate, edu.IsExpected, i18n),
+		}
+		if edu.Location != nil {
+			entry.Location = *edu.Location
 		}
-		sb.WriteString(`</div>`)
-
-		// Second line: Degree, Field of Study | Dates
-		sb.WriteString(`<div class="entry-subheader">`)
-		degree := edu.Degree
 		if edu.FieldOfStudy != nil && *edu.FieldOfStudy != "" {
-			degree += " in " + *edu.FieldOfStudy
+			entry.Degree += " in " + *edu.FieldOfStudy
 		}
-		fmt.Fprintf(&sb, `<span class="entry-subtitle">%s</span>`, html.EscapeString(degree))
-		fmt.Fprintf(&sb, `<span class="entry-date">%s</span>`, formatEducationDateRangeLocalized(edu.StartDate, edu.EndDate, i18n))
-		sb.WriteString(`</div>`)
 
 		// Honors/GPA if present
 		var extras []string
@@ -471,135 +476,151 @@ func (t *JakeResumeTemplate) renderEducation(education []domain.Education, i18n
 		if len(edu.Honors) > 0 {
 			extras = append(extras, strings.Join(edu.Honors, ", "))
 		}
-		if len(extras) > 0 {
-			fmt.Fprintf(&sb, `<div class="education-honors">%s</div>`, html.EscapeString(strings.Join(extras, " | ")))
-		}
+		entry.Extras = strings.Join(extras, " | ")
 
-		sb.WriteString(`</div>`)
+		view.Entries = append(view.Entries, entry)
 	}
-
-	sb.WriteString(`</section>`)
-	return sb.String()
+	return view
 }
 
-// renderExperience generates the experience section.
-func (t *JakeResumeTemplate) renderExperience(experiences []domain.TailoredExperience, i18n *I18n) string {
-	if len(experiences) == 0 {
-		return ""
-	}
-
-	var sb strings.Builder
-	sb.WriteString(`<section class="resume-section">`)
-	sb.WriteString(fmt.Sprintf(`<h2 class="section-title">%s</h2>`, html.EscapeString(i18n.T(KeyExperience))))
+// experienceView builds the view of the experience section.
+func (t *JakeResumeTemplate) experienceView(experiences []domain.TailoredExperience, i18n *I18n) *jakeExperienceView {
+	view := &jakeExperienceView{Title: i18n.T(KeyExperience)}
 
 	for _, exp := range experiences {
-		sb.WriteString(`<div class="resume-entry">`)
-
-		// First line: Title | Dates
-		sb.WriteString(`<div class="entry-header">`)
-		fmt.Fprintf(&sb, `<span class="entry-title">%s</span>`, html.EscapeString(exp.Title))
-		dateStr := formatExperienceDateRangeLocalized(exp.StartDate, exp.EndDate, exp.IsCurrent, i18n)
-		fmt.Fprintf(&sb, `<span class="entry-date">%s</span>`, html.EscapeString(dateStr))
-		sb.WriteString(`</div>`)
-
-		// Second line: Organization
-		sb.WriteString(`<div class="entry-subheader">`)
-		fmt.Fprintf(&sb, `<span class="entry-subtitle">%s</span>`, html.EscapeString(exp.Organization))
-		sb.WriteString(`</div>`)
-
-		// Bullets
-		if len(exp.Bullets) > 0 {
-			sb.WriteString(`<ul class="entry-bullets">`)
-			for _, bullet := range exp.Bullets {
-				content := bullet.TailoredContent
-				if content == "" {
-					content = bullet.OriginalContent
-				}
-				fmt.Fprintf(&sb, `<li>%s</li>`, renderMarkdownBold(content))
-			}
-			sb.WriteString(`</ul>`)
-		}
-
-		sb.WriteString(`</div>`)
-	}
-
-	sb.WriteString(`</section>`)
-	return sb.String()
+		view.Entries = append(view.Entries, jakeExperienceEntry{
+			Title:        exp.Title,
+			Organization: exp.Organization,
+			Dates:        formatExperienceDateRangeLocalized(exp.StartDate, exp.EndDate, exp.IsCurrent, i18n),
+			Bullets:      customTemplateBullets(tailoredBulletTexts(exp.Bullets)),
+		})
+	}
+	return view
 }
 
-// renderProjects generates the projects section.
-func (t *JakeResumeTemplate) renderProjects(projects []domain.Project, i18n *I18n) string {
-	if len(projects) == 0 {
-		return ""
-	}
-
-	var sb strings.Builder
-	sb.WriteString(`<section class="resume-section">`)
-	sb.WriteString(fmt.Sprintf(`<h2 class="section-title">%s</h2>`, html.EscapeString(i18n.T(KeyProjects))))
+// projectsView builds the view of the projects section.
+func (t *JakeResumeTemplate) projectsView(projects []domain.Project, i18n *I18n) *jakeProjectsView {
+	view := &jakeProjectsView{Title: i18n.T(KeyProjects)}
 
 	for _, proj := range projects {
-		sb.WriteString(`<div class="resume-entry">`)
-
-		// Project header: Name | Tech Stack | Links | Date
-		sb.WriteString(`<div class="entry-header">`)
-		sb.WriteString(`<div class="project-header">`)
-		fmt.Fprintf(&sb, `<span class="project-name">%s</span>`, html.EscapeString(proj.Name))
-		if len(proj.TechStack) > 0 {
-			fmt.Fprintf(&sb, `<span class="project-tech">| %s</span>`,
-				html.EscapeString(strings.Join(proj.TechStack, ", ")))
+		entry := jakeProjectEntry{
+			Name:      proj.Name,
+			TechStack: strings.Join(proj.TechStack, ", "),
+			Dates:     formatProjectDateRangeLocalized(proj.StartDate, proj.EndDate, i18n),
 		}
 		// Discrete project links
-		if proj.RepositoryURL != nil && *proj.RepositoryURL != "" {
-			fmt.Fprintf(&sb, `<a href="%s" class="project-link">[Source]</a>`,
-				html.EscapeString(*proj.RepositoryURL))
+		if proj.RepositoryURL != nil {
+			entry.RepositoryURL = *proj.RepositoryURL
 		}
-		if proj.URL != nil && *proj.URL != "" {
-			fmt.Fprintf(&sb, `<a href="%s" class="project-link">[Demo]</a>`,
-				html.EscapeString(*proj.URL))
+		if proj.URL != nil {
+			entry.URL = *proj.URL
 		}
-		sb.WriteString(`</div>`)
-		dateStr := formatProjectDateRangeLocalized(proj.StartDate, proj.EndDate, i18n)
-		if dateStr != "" {
-			fmt.Fprintf(&sb, `<span class="entry-date">%s</span>`, html.EscapeString(dateStr))
+		var bullets []string
+		for _, bullet := range proj.Bullets {
+			bullets = append(bullets, bullet.Content)
 		}
-		sb.WriteString(`</div>`)
+		entry.Bullets = customTemplateBullets(bullets)
+
+		view.Entries = append(view.Entries, entry)
+	}
+	return view
+}
 
-		// Bullets
-		if len(proj.Bullets) > 0 {
-			sb.WriteString(`<ul class="entry-bullets">`)
-			for _, bullet := range proj.Bullets {
-				fmt.Fprintf(&sb, `<li>%s</li>`, renderMarkdownBold(bullet.Content))
+// skillsView builds the view of the technical skills section, in key-value
+// format or, for SkillsDisplayBars, as proficiency bars grouped by category.
+func (t *JakeResumeTemplate) skillsView(selectedSkills []string, userSkills []domain.Skill, display domain.SkillsDisplay, i18n *I18n) *jakeSkillsView {
+	view := &jakeSkillsView{
+		Title: i18n.T(KeyTechnicalSkills),
+		Bars:  display == domain.SkillsDisplayBars,
+	}
+
+	for _, group := range groupSkillsByCategory(selectedSkills, userSkills) {
+		g := jakeSkillGroup{
+			Category: group.Category,
+			Items:    strings.Join(group.labels(display, i18n), ", "),
+		}
+		for _, name := range group.Skills {
+			bar := jakeSkillBar{Name: name}
+			if skill, ok := group.skill(name); ok {
+				bar.HasLevel = true
+				bar.Level = skill.ProficiencyLevel.Int()
 			}
-			sb.WriteString(`</ul>`)
+			g.Skills = append(g.Skills, bar)
 		}
+		view.Groups = append(view.Groups, g)
+	}
+	return view
+}
 
-		sb.WriteString(`</div>`)
+// languagesView builds the view of the spoken languages section.
+func (t *JakeResumeTemplate) languagesView(languages []domain.SpokenLanguage, i18n *I18n) *jakeLanguagesView {
+	view := &jakeLanguagesView{Title: i18n.T(KeyLanguages)}
+
+	for _, lang := range languages {
+		view.Entries = append(view.Entries, jakeLanguageEntry{
+			Language: lang.Language,
+			Level:    i18n.FormatProficiencyLevel(string(lang.Proficiency)),
+		})
 	}
+	return view
+}
 
-	sb.WriteString(`</section>`)
-	return sb.String()
+// Helper functions
+
+// skillGroup is a category with the selected skills that belong to it.
+type skillGroup struct {
+	Category string
+	Skills   []string
+
+	// catalog maps lowercase skill names to the user's skills.
+	catalog map[string]domain.Skill
 }
 
-// renderSkills generates the technical skills section in key-value format.
-func (t *JakeResumeTemplate) renderSkills(selectedSkills []string, userSkills []domain.Skill, i18n *I18n) string {
-	if len(selectedSkills) == 0 {
-		return ""
+// skill returns the user's skill with the given name, if it is in the catalog.
+func (g skillGroup) skill(name string) (domain.Skill, bool) {
+	skill, ok := g.catalog[strings.ToLower(name)]
+	return skill, ok
+}
+
+// labels returns the group's skills as shown by the display mode: annotated
+// with years of experience for SkillsDisplayYears, when known, and plain
+// names otherwise.
+func (g skillGroup) labels(display domain.SkillsDisplay, i18n *I18n) []string {
+	if display != domain.SkillsDisplayYears {
+		return g.Skills
 	}
 
-	// Group skills by category
-	categorySkills := make(map[string][]string)
-	skillCategories := make(map[string]string) // skill name -> category
+	labels := make([]string, 0, len(g.Skills))
+	for _, name := range g.Skills {
+		if skill, ok := g.skill(name); ok && skill.YearsOfExperience != nil && *skill.YearsOfExperience > 0 {
+			name = fmt.Sprintf("%s (%s)", name, i18n.FormatSkillYears(*skill.YearsOfExperience))
+		}
+		labels = append(labels, name)
+	}
+	return labels
+}
+
+// skillCategoryOrder is the preferred display order for skill categories.
+var skillCategoryOrder = []string{"Languages", "Frameworks", "Tools", "Databases", "Cloud", "Other"}
 
+// groupSkillsByCategory groups the selected skill names using the categories
+// from the user's skill catalog. Known categories come first in the preferred
+// order, followed by any custom categories in alphabetical order.
+func groupSkillsByCategory(selectedSkills []string, userSkills []domain.Skill) []skillGroup {
 	// Build skill lookup from user skills
+	skillCategories := make(map[string]string) // skill name -> category
+	catalog := make(map[string]domain.Skill, len(userSkills))
 	for _, skill := range userSkills {
 		category := "Other"
 		if skill.Category != nil && *skill.Category != "" {
 			category = *skill.Category
 		}
 		skillCategories[strings.ToLower(skill.Name)] = category
+		catalog[strings.ToLower(skill.Name)] = skill
 	}
 
 	// Group selected skills by category
+	categorySkills := make(map[string][]string)
 	for _, skillName := range selectedSkills {
 		category := skillCategories[strings.ToLower(skillName)]
 		if category == "" {
@@ -608,66 +629,28 @@ func (t *JakeResumeTemplate) renderSkills(selectedSkills []string, userSkills []
 		categorySkills[category] = append(categorySkills[category], skillName)
 	}
 
-	// Define category order
-	categoryOrder := []string{"Languages", "Frameworks", "Tools", "Databases", "Cloud", "Other"}
-
-	var sb strings.Builder
-	sb.WriteString(`<section class="resume-section">`)
-	sb.WriteString(fmt.Sprintf(`<h2 class="section-title">%s</h2>`, html.EscapeString(i18n.T(KeyTechnicalSkills))))
-	sb.WriteString(`<ul class="skills-list">`)
-
-	for _, category := range categoryOrder {
-		skills, exists := categorySkills[category]
-		if !exists || len(skills) == 0 {
-			continue
+	groups := make([]skillGroup, 0, len(categorySkills))
+	for _, category := range skillCategoryOrder {
+		if skills := categorySkills[category]; len(skills) > 0 {
+			groups = append(groups, skillGroup{Category: category, Skills: skills, catalog: catalog})
 		}
-		sb.WriteString(`<li class="skills-row">`)
-		fmt.Fprintf(&sb, `<span class="skill-category">%s:</span> `, html.EscapeString(category))
-		fmt.Fprintf(&sb, `<span class="skill-items">%s</span>`, html.EscapeString(strings.Join(skills, ", ")))
-		sb.WriteString(`</li>`)
 	}
 
 	// Handle any remaining categories not in the predefined order
-	for category, skills := range categorySkills {
-		found := slices.Contains(categoryOrder, category)
-		if !found && len(skills) > 0 {
-			sb.WriteString(`<li class="skills-row">`)
-			fmt.Fprintf(&sb, `<span class="skill-category">%s:</span> `, html.EscapeString(category))
-			fmt.Fprintf(&sb, `<span class="skill-items">%s</span>`, html.EscapeString(strings.Join(skills, ", ")))
-			sb.WriteString(`</li>`)
+	var custom []string
+	for category := range categorySkills {
+		if !slices.Contains(skillCategoryOrder, category) {
+			custom = append(custom, category)
 		}
 	}
-
-	sb.WriteString(`</ul>`)
-	sb.WriteString(`</section>`)
-	return sb.String()
-}
-
-// renderLanguages generates the spoken languages section.
-func (t *JakeResumeTemplate) renderLanguages(languages []domain.SpokenLanguage, i18n *I18n) string {
-	if len(languages) == 0 {
-		return ""
-	}
-
-	var sb strings.Builder
-	sb.WriteString(`<section class="resume-section">`)
-	sb.WriteString(fmt.Sprintf(`<h2 class="section-title">%s</h2>`, html.EscapeString(i18n.T(KeyLanguages))))
-	sb.WriteString(`<div class="languages-list">`)
-
-	for _, lang := range languages {
-		sb.WriteString(`<span class="language-item">`)
-		fmt.Fprintf(&sb, `<span class="language-name">%s</span>`, html.EscapeString(lang.Language))
-		fmt.Fprintf(&sb, ` (<span class="language-level">%s</span>)`, html.EscapeString(i18n.FormatProficiencyLevel(string(lang.Proficiency))))
-		sb.WriteString(`</span>`)
+	slices.Sort(custom)
+	for _, category := range custom {
+		groups = append(groups, skillGroup{Category: category, Skills: categorySkills[category], catalog: catalog})
 	}
 
-	sb.WriteString(`</div>`)
-	sb.WriteString(`</section>`)
-	return sb.String()
+	return groups
 }
 
-// Helper functions
-
 // renderMarkdownBold converts markdown **bold** syntax to HTML <strong> tags.
 // It first escapes HTML in the input, then converts **text** to <strong>text</strong>.
 func renderMarkdownBold(text string) string {
@@ -722,7 +705,9 @@ func extractDomain(url string) string {
 	return url
 }
 
-func formatEducationDateRangeLocalized(startDate, endDate *domain.Date, i18n *I18n) string {
+// formatEducationDateRangeLocalized formats an education date range. An
+// expected graduation date is prefixed with the localized "Expected".
+func formatEducationDateRangeLocalized(startDate, endDate *domain.Date, isExpected bool, i18n *I18n) string {
 	if startDate == nil && endDate == nil {
 		return ""
 	}
@@ -739,6 +724,8 @@ func formatEducationDateRangeLocalized(startDate, endDate *domain.Date, i18n *I1
 
 	if end == "" {
 		end = i18n.T(KeyPresent)
+	} else if isExpected {
+		end = i18n.T(KeyExpected) + " " + end
 	}
 
 	if start == "" {
@@ -0,0 +1,146 @@
+package services_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func ptr[T any](v T) *T { return &v }
+
+// goldenResumeData returns a resume with every section filled.
+func goldenResumeData() services.ResumeTemplateData {
+	start := domain.NewMonthDate(2016, time.September)
+	end := domain.NewMonthDate(2020, time.July)
+	expected := domain.NewMonthDate(2026, time.December)
+	projectStart := domain.NewMonthDate(2023, time.March)
+
+	return services.ResumeTemplateData{
+		User: &domain.User{
+			Name:         ptr("Ana <Souza> & Co"),
+			Email:        ptr("ana@example.com"),
+			Phone:        ptr("(11) 99999-0000"),
+			LinkedInURL:  ptr("https://www.linkedin.com/in/ana-souza"),
+			GitHubURL:    ptr("https://github.com/anasouza"),
+			PortfolioURL: ptr("https://ana.dev/portfolio"),
+		},
+		Resume: &domain.Resume{
+			TargetLanguage: "en",
+			QRCode:         domain.QRCodePortfolio,
+			GeneratedContent: &domain.ResumeContent{
+				Summary: "Backend engineer with **8 years** building \"reliable\" APIs",
+				Experiences: []domain.TailoredExperience{
+					{
+						ExperienceID: "exp-1",
+						Title:        "Senior Engineer",
+						Organization: "Acme & Sons",
+						StartDate:    "2021-02",
+						IsCurrent:    true,
+						Bullets: []domain.TailoredBullet{
+							{BulletID: "b1", TailoredContent: "Cut p99 latency by **40%** across <12> services"},
+							{BulletID: "b2", TailoredContent: "Led a team of 5", TranslatedContent: "Led a team of **5** engineers"},
+						},
+					},
+					{
+						ExperienceID: "exp-2",
+						Title:        "Engineer",
+						Organization: "Globex",
+						StartDate:    "2018-01-15",
+						EndDate:      ptr("2021-01"),
+						Bullets:      []domain.TailoredBullet{{BulletID: "b3", OriginalContent: "Wrote Go services"}},
+					},
+				},
+				Skills: []string{"Go", "PostgreSQL", "Kubernetes", "Rust", "Leadership"},
+			},
+		},
+		Education: []domain.Education{
+			{
+				Institution:  "University of São Paulo",
+				Degree:       "BSc",
+				FieldOfStudy: ptr("Computer Science"),
+				Location:     ptr("São Paulo"),
+				StartDate:    &start,
+				EndDate:      &end,
+				GPA:          ptr("3.8"),
+				Honors:       []string{"Magna cum laude"},
+			},
+			{Institution: "Online Institute", Degree: "MSc", EndDate: &expected, IsExpected: true},
+		},
+		Projects: []domain.Project{
+			{
+				Name:          "Chameleon",
+				TechStack:     []string{"Go", "React"},
+				URL:           ptr("https://chameleon.dev"),
+				RepositoryURL: ptr("https://github.com/anasouza/chameleon"),
+				StartDate:     &projectStart,
+				Bullets:       []domain.ProjectBullet{{Content: "Tailors resumes with **AI**"}},
+			},
+			{Name: "Dotfiles"},
+		},
+		Languages: []domain.SpokenLanguage{
+			{Language: "Portuguese", Proficiency: domain.ProficiencyNative},
+			{Language: "English", Proficiency: domain.ProficiencyFluent},
+		},
+		Skills: []domain.Skill{
+			{Name: "Go", Category: ptr("Languages"), ProficiencyLevel: 90, YearsOfExperience: ptr(6.0)},
+			{Name: "Rust", Category: ptr("Languages"), ProficiencyLevel: 40, YearsOfExperience: ptr(0.5)},
+			{Name: "PostgreSQL", Category: ptr("Databases"), ProficiencyLevel: 80},
+			{Name: "Kubernetes", Category: ptr("Cloud"), ProficiencyLevel: 70},
+			{Name: "Leadership", Category: ptr("Soft Skills")},
+		},
+		ShowSummary: true,
+		QRCodeImage: "data:image/png;base64,iVBORw0KGgo=",
+	}
+}
+
+func TestJakeResumeTemplateGolden(t *testing.T) {
+	tests := map[string]func(*services.ResumeTemplateData){
+		"full": func(*services.ResumeTemplateData) {},
+		"minimal": func(d *services.ResumeTemplateData) {
+			*d = services.ResumeTemplateData{
+				User:   &domain.User{Name: ptr("Ana Souza")},
+				Resume: &domain.Resume{TargetLanguage: "en"},
+			}
+		},
+		"localized_compact": func(d *services.ResumeTemplateData) {
+			d.Locale = services.LocalePtBR
+			d.Resume.TargetLanguage = "pt-br"
+			d.FontSize = 10
+			d.Density = services.DensityCompact
+			d.Resume.Sections.SkillsDisplay = domain.SkillsDisplayYears
+			d.QRCodeImage = ""
+		},
+		"two_pages_bars": func(d *services.ResumeTemplateData) {
+			d.Resume.MaxPages = 2
+			d.Resume.Sections.SkillsDisplay = domain.SkillsDisplayBars
+			d.SectionOrder = []services.ResumeSection{services.SectionExperience, services.SectionLanguages}
+			d.ShowSummary = false
+		},
+	}
+
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := goldenResumeData()
+			modify(&data)
+			got := services.NewJakeResumeTemplate().Render(data)
+
+			path := filepath.Join("testdata", "jake_"+name+".golden.html")
+			if *update {
+				require.NoError(t, os.WriteFile(path, []byte(got), 0o644))
+			}
+			want, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), got)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// TrimSummaryID identifies the summary among the texts FitResume shortens,
+// next to bullet IDs.
+const TrimSummaryID = "summary"
+
+// maxTrimRounds is how many times texts are shortened before giving up on
+// fitting the page limit.
+const maxTrimRounds = 3
+
+// FitResumeResult is the outcome of FitResume.
+type FitResumeResult struct {
+	Resume *domain.Resume
+
+	// Before and After are the fit estimates before and after trimming;
+	// After is Before when nothing was shortened.
+	Before *FitCheck
+	After  *FitCheck
+
+	// Shortened lists the IDs of the shortened bullets, and TrimSummaryID
+	// when the summary was shortened.
+	Shortened []string
+
+	// Rounds is the number of times the AI shortened texts.
+	Rounds int
+}
+
+// FitResume shortens a resume's content until it is estimated to fit its
+// page limit with the rendering req describes. The longest bullets and the
+// summary are rewritten by the AI under length budgets, one line shorter
+// each, and the fit is estimated again after every round. Rewrites that
+// are not shorter, drop a job keyword the text had or fail bullet
+// validation are discarded. Content that already fits is left unchanged.
+func (s *ResumeService) FitResume(ctx context.Context, req FitCheckRequest) (*FitResumeResult, error) {
+	resume, data, templateName, opts, err := s.loadFitData(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	fit := estimateFit(data, templateName, opts)
+	result := &FitResumeResult{Resume: resume, Before: fit, After: fit}
+
+	// The template data renders resume, so edits to its content show in
+	// the next estimate.
+	content := resume.GeneratedContent
+	var keywords []string
+	if content.Analysis != nil {
+		keywords = content.Analysis.MatchedKeywords
+	}
+
+	ctx, aiRun := startAIRun(ctx, nil)
+	shortened := make(map[string]bool)
+	for result.Rounds < maxTrimRounds && !result.After.Fits {
+		budgets := planTrim(data, templateName, opts, result.After.OverflowLines)
+		if len(budgets) == 0 {
+			break
+		}
+
+		texts, err := s.aiProvider.ShortenTexts(ctx, ports.ShortenTextsRequest{
+			Texts:    budgets,
+			Keywords: trimKeywords(budgets, keywords),
+			Language: resume.TargetLanguage,
+		})
+		if err != nil {
+			// Keep the rounds done unless the client went away.
+			if result.Rounds > 0 && ctx.Err() == nil {
+				break
+			}
+			err = fmt.Errorf("failed to shorten content: %w", err)
+			reportError(ctx, s.reporter, "fit resume", err, resume.UserID, resume.ID)
+			return nil, err
+		}
+		result.Rounds++
+
+		applied := applyShortenedTexts(content, budgets, texts, keywords)
+		if len(applied) == 0 {
+			break
+		}
+		for _, id := range applied {
+			shortened[id] = true
+		}
+		result.After = estimateFit(data, templateName, opts)
+	}
+
+	if len(shortened) == 0 {
+		return result, nil
+	}
+
+	if err := s.updateResume(ctx, resume); err != nil {
+		return nil, fmt.Errorf("failed to update resume: %w", err)
+	}
+	s.recordActivity(ctx, domain.NewAIRunEvent(resume, domain.AIRunFit, aiRun()))
+
+	for id := range shortened {
+		result.Shortened = append(result.Shortened, id)
+	}
+	slices.Sort(result.Shortened)
+	return result, nil
+}
+
+// trimCandidate is a text that could be shortened by a line.
+type trimCandidate struct {
+	budget ports.TextBudget
+	lines  int
+}
+
+// planTrim picks the texts to shorten by a line each to save overflow
+// lines, longest first, with the length that leaves them a line shorter.
+// Only the tailored summary and bullets of two lines or more are picked;
+// fewer texts are returned when they cannot save overflow lines.
+func planTrim(data ResumeTemplateData, templateName string, opts ports.PDFOptions, overflow int) []ports.TextBudget {
+	content := data.Resume.GeneratedContent
+	if content == nil {
+		return nil
+	}
+	e := newFitEstimator(data, templateName, opts)
+
+	var candidates []trimCandidate
+	add := func(id, text string, indent float64) {
+		lines := e.lines(text, indent)
+		if lines < 2 {
+			return
+		}
+		// Wrapped words leave the end of lines empty, so aim a tenth lower.
+		candidates = append(candidates, trimCandidate{
+			budget: ports.TextBudget{ID: id, Text: text, MaxLength: (lines - 1) * e.chars(indent) * 9 / 10},
+			lines:  lines,
+		})
+	}
+	if data.ShowSummary && content.Summary != "" {
+		add(TrimSummaryID, content.Summary, 0)
+	}
+	for _, exp := range content.Experiences {
+		for _, bullet := range exp.Bullets {
+			add(bullet.BulletID, bullet.DisplayContent(), fitBulletIndent)
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b trimCandidate) int {
+		if a.lines != b.lines {
+			return cmp.Compare(b.lines, a.lines)
+		}
+		return cmp.Compare(utf8.RuneCountInString(b.budget.Text), utf8.RuneCountInString(a.budget.Text))
+	})
+
+	budgets := make([]ports.TextBudget, min(max(overflow, 0), len(candidates)))
+	for i := range budgets {
+		budgets[i] = candidates[i].budget
+	}
+	return budgets
+}
+
+// trimKeywords returns the keywords found in any of the texts to shorten.
+func trimKeywords(budgets []ports.TextBudget, keywords []string) []string {
+	var found []string
+	for _, keyword := range keywords {
+		for _, budget := range budgets {
+			if containsFold(budget.Text, keyword) {
+				found = append(found, keyword)
+				break
+			}
+		}
+	}
+	return found
+}
+
+// applyShortenedTexts replaces the texts of content with their shortened
+// versions, and returns the IDs of those replaced. A shortened text is
+// discarded when it is not shorter, fails bullet validation or drops one
+// of keywords the text had.
+func applyShortenedTexts(content *domain.ResumeContent, budgets []ports.TextBudget, texts map[string]string, keywords []string) []string {
+	var applied []string
+	for _, budget := range budgets {
+		text := strings.TrimSpace(texts[budget.ID])
+		if text == "" || utf8.RuneCountInString(text) >= utf8.RuneCountInString(budget.Text) {
+			continue
+		}
+
+		var kept []string
+		for _, keyword := range keywords {
+			if containsFold(budget.Text, keyword) {
+				kept = append(kept, keyword)
+			}
+		}
+		if len(domain.ValidateTailoredBullet(budget.Text, kept, text)) > 0 {
+			continue
+		}
+
+		if setContentText(content, budget.ID, text) {
+			applied = append(applied, budget.ID)
+		}
+	}
+	return applied
+}
+
+// setContentText replaces the summary, or the text a bullet is rendered
+// with, and reports whether id was found.
+func setContentText(content *domain.ResumeContent, id, text string) bool {
+	if id == TrimSummaryID {
+		content.Summary = text
+		return true
+	}
+	for i := range content.Experiences {
+		bullets := content.Experiences[i].Bullets
+		for j := range bullets {
+			if bullets[j].BulletID != id {
+				continue
+			}
+			if bullets[j].TranslatedContent != "" {
+				bullets[j].TranslatedContent = text
+			} else {
+				bullets[j].TailoredContent = text
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// containsFold reports whether substr is within s, ignoring case.
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// SavedFilterService handles named resume list searches.
+type SavedFilterService struct {
+	filterRepo    ports.SavedResumeFilterRepository
+	resumeService *ResumeService
+}
+
+// NewSavedFilterService creates a new SavedFilterService with required dependencies.
+func NewSavedFilterService(filterRepo ports.SavedResumeFilterRepository, resumeService *ResumeService) *SavedFilterService {
+	return &SavedFilterService{
+		filterRepo:    filterRepo,
+		resumeService: resumeService,
+	}
+}
+
+// SaveFilterRequest names a resume search.
+type SaveFilterRequest struct {
+	UserID string
+	Name   string
+	Search domain.ResumeSearch
+}
+
+// CreateSavedFilter saves a resume search under a name.
+func (s *SavedFilterService) CreateSavedFilter(ctx context.Context, req SaveFilterRequest) (*domain.SavedResumeFilter, error) {
+	filter, err := domain.NewSavedResumeFilter(req.UserID, req.Name, req.Search)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSavable(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	if err := s.filterRepo.Create(ctx, filter); err != nil {
+		return nil, fmt.Errorf("failed to create saved filter: %w", err)
+	}
+
+	return filter, nil
+}
+
+// ListSavedFilters lists a user's saved filters by name.
+func (s *SavedFilterService) ListSavedFilters(ctx context.Context, userID string) ([]domain.SavedResumeFilter, error) {
+	filters, err := s.filterRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved filters: %w", err)
+	}
+	return filters, nil
+}
+
+// ReplaceSavedFilter replaces the name and search of a user's saved filter.
+func (s *SavedFilterService) ReplaceSavedFilter(ctx context.Context, filterID string, req SaveFilterRequest) (*domain.SavedResumeFilter, error) {
+	filter, err := s.ownedFilter(ctx, filterID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := filter.Replace(req.Name, req.Search); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSavable(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	if err := s.filterRepo.Update(ctx, filter); err != nil {
+		return nil, fmt.Errorf("failed to update saved filter: %w", err)
+	}
+
+	return filter, nil
+}
+
+// DeleteSavedFilter deletes a user's saved filter.
+func (s *SavedFilterService) DeleteSavedFilter(ctx context.Context, filterID, userID string) error {
+	err := s.filterRepo.Delete(ctx, filterID, userID)
+	if errors.Is(err, domain.ErrSavedFilterNotFound) {
+		return domain.ErrSavedFilterNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete saved filter: %w", err)
+	}
+	return nil
+}
+
+// RunSavedFilterRequest contains parameters for running a saved filter.
+type RunSavedFilterRequest struct {
+	FilterID string
+	UserID   string
+	Limit    int
+	Offset   int
+
+	// Summary lists the resumes without their heavy fields, as in
+	// ListResumesRequest.
+	Summary bool
+}
+
+// RunSavedFilter lists the resumes matching a saved filter.
+func (s *SavedFilterService) RunSavedFilter(ctx context.Context, req RunSavedFilterRequest) (*ListResumesResponse, error) {
+	filter, err := s.ownedFilter(ctx, req.FilterID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.resumeService.ListResumes(ctx, ListResumesRequest{
+		UserID:       req.UserID,
+		Limit:        req.Limit,
+		Offset:       req.Offset,
+		Summary:      req.Summary,
+		ResumeSearch: filter.Search,
+	})
+}
+
+// ownedFilter loads a saved filter, reporting filters of other users as not
+// found.
+func (s *SavedFilterService) ownedFilter(ctx context.Context, filterID, userID string) (*domain.SavedResumeFilter, error) {
+	filter, err := s.filterRepo.GetByIDForUser(ctx, filterID, userID)
+	if errors.Is(err, domain.ErrSavedFilterNotFound) {
+		return nil, domain.ErrSavedFilterNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved filter: %w", err)
+	}
+	return filter, nil
+}
+
+// checkSavable checks that the filter's search is valid, so that it can be
+// run, and returns domain.ErrSavedFilterAlreadyExists when another of the
+// user's filters has its name, ignoring case.
+func (s *SavedFilterService) checkSavable(ctx context.Context, filter *domain.SavedResumeFilter) error {
+	if _, err := newResumeFilter(ListResumesRequest{ResumeSearch: filter.Search}); err != nil {
+		return err
+	}
+
+	filters, err := s.filterRepo.ListByUserID(ctx, filter.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to list saved filters: %w", err)
+	}
+	for _, other := range filters {
+		if other.ID != filter.ID && strings.EqualFold(other.Name, filter.Name) {
+			return domain.ErrSavedFilterAlreadyExists
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// DefaultPreRankLimit is the number of bullets kept by semantic pre-ranking.
+const DefaultPreRankLimit = 60
+
+// SetEmbeddings enables semantic pre-ranking of bullets before the LLM
+// selection step. When a user has more than preRankLimit bullets, only the
+// preRankLimit bullets closest to the job description are sent to the model.
+func (s *ResumeService) SetEmbeddings(provider ports.EmbeddingsProvider, repo ports.BulletEmbeddingRepository, preRankLimit int) {
+	if preRankLimit <= 0 {
+		preRankLimit = DefaultPreRankLimit
+	}
+	s.embeddings = provider
+	s.embeddingRepo = repo
+	s.preRankLimit = preRankLimit
+}
+
+// preRankBullets returns the bullets most similar to the job, or all bullets
+// when semantic ranking is disabled or not needed. bullets are in ranking
+// order, which is fused with the similarity ranking. Embedding failures are
+// not fatal: the LLM selection step simply receives every bullet.
+func (s *ResumeService) preRankBullets(ctx context.Context, userID string, bullets []domain.Bullet, job *ports.JobAnalysis, jobDescription string) []domain.Bullet {
+	if s.embeddings == nil || s.embeddingRepo == nil || len(bullets) <= s.preRankLimit {
+		return bullets
+	}
+
+	vectors, err := syncBulletEmbeddings(ctx, s.embeddings, s.embeddingRepo, userID, bullets)
+	if err != nil {
+		return bullets
+	}
+
+	jobVectors, err := s.embeddings.Embed(ctx, []string{jobEmbeddingText(job, jobDescription)})
+	if err != nil || len(jobVectors) != 1 {
+		return bullets
+	}
+
+	similar := rankBySimilarity(bullets, vectors, jobVectors[0], len(bullets))
+	return fuseRankings(s.preRankLimit, similar, bullets)
+}
+
+// syncBulletEmbeddings returns an embedding per bullet ID, computing and
+// storing the ones that are missing or stale.
+func syncBulletEmbeddings(ctx context.Context, provider ports.EmbeddingsProvider, repo ports.BulletEmbeddingRepository, userID string, bullets []domain.Bullet) (map[string][]float32, error) {
+	model := provider.Model()
+
+	stored, err := repo.ListByUserID(ctx, userID, model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bullet embeddings: %w", err)
+	}
+
+	byID := make(map[string]domain.BulletEmbedding, len(stored))
+	for _, e := range stored {
+		byID[e.BulletID] = e
+	}
+
+	vectors := make(map[string][]float32, len(bullets))
+	var (
+		pending []domain.Bullet
+		texts   []string
+	)
+	for _, bullet := range bullets {
+		text := bullet.EmbeddingText()
+		if e, ok := byID[bullet.ID]; ok && e.ContentHash == domain.HashEmbeddingText(text) {
+			vectors[bullet.ID] = e.Vector
+			continue
+		}
+		pending = append(pending, bullet)
+		texts = append(texts, text)
+	}
+
+	if len(pending) == 0 {
+		return vectors, nil
+	}
+
+	computed, err := provider.Embed(ctx, texts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed bullets: %w", err)
+	}
+	if len(computed) != len(pending) {
+		return nil, fmt.Errorf("embeddings provider returned %d vectors for %d bullets", len(computed), len(pending))
+	}
+
+	now := time.Now().UTC()
+	fresh := make([]domain.BulletEmbedding, 0, len(pending))
+	for i, bullet := range pending {
+		vectors[bullet.ID] = computed[i]
+		fresh = append(fresh, domain.BulletEmbedding{
+			BulletID:    bullet.ID,
+			Model:       model,
+			ContentHash: domain.HashEmbeddingText(texts[i]),
+			Vector:      computed[i],
+			UpdatedAt:   now,
+		})
+	}
+
+	if err := repo.Upsert(ctx, fresh); err != nil {
+		return nil, fmt.Errorf("failed to store bullet embeddings: %w", err)
+	}
+
+	return vectors, nil
+}
+
+// jobEmbeddingText builds the text embedded for a job: the analysed
+// requirements followed by the raw description.
+func jobEmbeddingText(job *ports.JobAnalysis, description string) string {
+	var parts []string
+	if job != nil {
+		if job.Title != "" {
+			parts = append(parts, job.Title)
+		}
+		if job.Summary != "" {
+			parts = append(parts, job.Summary)
+		}
+		if len(job.RequiredSkills) > 0 {
+			parts = append(parts, strings.Join(job.RequiredSkills, ", "))
+		}
+		if len(job.Keywords) > 0 {
+			parts = append(parts, strings.Join(job.Keywords, ", "))
+		}
+	}
+	parts = append(parts, description)
+	return strings.Join(parts, "\n")
+}
+
+// rankBySimilarity returns the limit bullets most similar to query, keeping
+// the original order among bullets with equal scores.
+func rankBySimilarity(bullets []domain.Bullet, vectors map[string][]float32, query []float32, limit int) []domain.Bullet {
+	type scored struct {
+		bullet domain.Bullet
+		score  float64
+	}
+
+	ranked := make([]scored, 0, len(bullets))
+	for _, bullet := range bullets {
+		ranked = append(ranked, scored{bullet: bullet, score: cosineSimilarity(vectors[bullet.ID], query)})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	result := make([]domain.Bullet, 0, len(ranked))
+	for _, r := range ranked {
+		result = append(result, r.bullet)
+	}
+	return result
+}
+
+// rrfK dampens the weight of top ranks in reciprocal rank fusion.
+const rrfK = 60
+
+// fuseRankings merges several ranked bullet lists with reciprocal rank
+// fusion, so bullets ranked well by more than one list come first.
+func fuseRankings(limit int, rankings ...[]domain.Bullet) []domain.Bullet {
+	scores := make(map[string]float64)
+	byID := make(map[string]domain.Bullet)
+	var order []string
+
+	for _, ranking := range rankings {
+		for rank, bullet := range ranking {
+			if _, seen := byID[bullet.ID]; !seen {
+				byID[bullet.ID] = bullet
+				order = append(order, bullet.ID)
+			}
+			scores[bullet.ID] += 1 / float64(rrfK+rank+1)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] > scores[order[j]]
+	})
+
+	if len(order) > limit {
+		order = order[:limit]
+	}
+
+	result := make([]domain.Bullet, 0, len(order))
+	for _, id := range order {
+		result = append(result, byID[id])
+	}
+	return result
+}
+
+// cosineSimilarity returns the cosine similarity of two vectors, or 0 when
+// they are empty, of different lengths, or either has zero magnitude.
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -84,9 +84,9 @@ func (s *SkillService) CreateSkill(ctx context.Context, req CreateSkillRequest)
 	return skill, nil
 }
 
-// GetSkill retrieves a skill by ID.
-func (s *SkillService) GetSkill(ctx context.Context, skillID string) (*domain.Skill, error) {
-	skill, err := s.skillRepo.GetByID(ctx, skillID)
+// GetSkill retrieves a user's skill by ID.
+func (s *SkillService) GetSkill(ctx context.Context, skillID, userID string) (*domain.Skill, error) {
+	skill, err := s.skillRepo.GetByIDForUser(ctx, skillID, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get skill: %w", err)
 	}
@@ -128,6 +128,7 @@ func (s *SkillService) ListHighlightedSkills(ctx context.Context, userID string)
 // UpdateSkillRequest contains the parameters for updating a skill.
 type UpdateSkillRequest struct {
 	SkillID           string
+	UserID            string
 	Name              *string
 	Category          *string
 	ProficiencyLevel  *int
@@ -138,7 +139,7 @@ type UpdateSkillRequest struct {
 
 // UpdateSkill updates an existing skill.
 func (s *SkillService) UpdateSkill(ctx context.Context, req UpdateSkillRequest) (*domain.Skill, error) {
-	skill, err := s.skillRepo.GetByID(ctx, req.SkillID)
+	skill, err := s.skillRepo.GetByIDForUser(ctx, req.SkillID, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get skill: %w", err)
 	}
@@ -247,9 +248,9 @@ func (s *SkillService) BatchUpsertSkills(ctx context.Context, req BatchUpsertSki
 	}, nil
 }
 
-// DeleteSkill removes a skill.
-func (s *SkillService) DeleteSkill(ctx context.Context, skillID string) error {
-	if err := s.skillRepo.Delete(ctx, skillID); err != nil {
+// DeleteSkill removes a user's skill.
+func (s *SkillService) DeleteSkill(ctx context.Context, skillID, userID string) error {
+	if err := s.skillRepo.Delete(ctx, skillID, userID); err != nil {
 		return fmt.Errorf("failed to delete skill: %w", err)
 	}
 	return nil
@@ -311,6 +312,7 @@ func (s *SkillService) ListSpokenLanguages(ctx context.Context, userID string) (
 // UpdateSpokenLanguageRequest contains parameters for updating a spoken language.
 type UpdateSpokenLanguageRequest struct {
 	LanguageID   string
+	UserID       string
 	Language     *string
 	Proficiency  *string
 	DisplayOrder *int
@@ -318,7 +320,7 @@ type UpdateSpokenLanguageRequest struct {
 
 // UpdateSpokenLanguage updates an existing spoken language.
 func (s *SkillService) UpdateSpokenLanguage(ctx context.Context, req UpdateSpokenLanguageRequest) (*domain.SpokenLanguage, error) {
-	language, err := s.languageRepo.GetByID(ctx, req.LanguageID)
+	language, err := s.languageRepo.GetByIDForUser(ctx, req.LanguageID, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get spoken language: %w", err)
 	}
@@ -350,9 +352,9 @@ func (s *SkillService) UpdateSpokenLanguage(ctx context.Context, req UpdateSpoke
 	return language, nil
 }
 
-// DeleteSpokenLanguage removes a spoken language.
-func (s *SkillService) DeleteSpokenLanguage(ctx context.Context, languageID string) error {
-	if err := s.languageRepo.Delete(ctx, languageID); err != nil {
+// DeleteSpokenLanguage removes a user's spoken language.
+func (s *SkillService) DeleteSpokenLanguage(ctx context.Context, languageID, userID string) error {
+	if err := s.languageRepo.Delete(ctx, languageID, userID); err != nil {
 		return fmt.Errorf("failed to delete spoken language: %w", err)
 	}
 	return nil
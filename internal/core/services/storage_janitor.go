@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// ownerLookupBatchSize is how many resume IDs are looked up per query when
+// checking stored files.
+const ownerLookupBatchSize = 500
+
+// StorageJanitorService deletes stored files left behind by deleted resumes.
+// PDF caches are uploaded in the background after rendering, so a resume
+// deleted meanwhile leaves its PDF behind.
+type StorageJanitorService struct {
+	resumeRepo ports.ResumeRepository
+	storage    ports.FileStorage
+}
+
+// NewStorageJanitorService creates a new StorageJanitorService with required dependencies.
+func NewStorageJanitorService(resumeRepo ports.ResumeRepository, storage ports.FileStorage) *StorageJanitorService {
+	return &StorageJanitorService{
+		resumeRepo: resumeRepo,
+		storage:    storage,
+	}
+}
+
+// StorageCleanupResult summarizes a storage cleanup.
+type StorageCleanupResult struct {
+	// Orphans are the files without a resume.
+	Orphans []ports.FileInfo
+
+	// ReclaimableBytes is the total size of the orphans.
+	ReclaimableBytes int64
+
+	// Deleted is the number of orphans deleted; 0 in a dry run.
+	Deleted int
+
+	DryRun bool
+}
+
+// CleanupOrphans finds the cached resume PDFs whose resume no longer exists,
+// or belongs to another user than the one in the key, and deletes them
+// unless dryRun is set. Files outside the PDF cache layout are left alone.
+func (s *StorageJanitorService) CleanupOrphans(ctx context.Context, dryRun bool) (*StorageCleanupResult, error) {
+	files, err := s.storage.List(ctx, "resumes/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stored PDFs: %w", err)
+	}
+
+	owners, err := s.pdfOwners(ctx, files)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &StorageCleanupResult{Orphans: []ports.FileInfo{}, DryRun: dryRun}
+	for _, file := range files {
+		userID, resumeID, ok := parsePDFCacheKey(file.Key)
+		if !ok || owners[resumeID] == userID {
+			continue
+		}
+		result.Orphans = append(result.Orphans, file)
+		result.ReclaimableBytes += file.Size
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	for _, orphan := range result.Orphans {
+		if err := s.storage.Delete(ctx, orphan.Key); err != nil {
+			return nil, fmt.Errorf("failed to delete %s: %w", orphan.Key, err)
+		}
+		result.Deleted++
+	}
+
+	return result, nil
+}
+
+// pdfOwners looks up the owners of the resumes of cached PDFs, by resume ID.
+func (s *StorageJanitorService) pdfOwners(ctx context.Context, files []ports.FileInfo) (map[string]string, error) {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, file := range files {
+		if _, resumeID, ok := parsePDFCacheKey(file.Key); ok && !seen[resumeID] {
+			seen[resumeID] = true
+			ids = append(ids, resumeID)
+		}
+	}
+
+	owners := make(map[string]string, len(ids))
+	for start := 0; start < len(ids); start += ownerLookupBatchSize {
+		end := min(start+ownerLookupBatchSize, len(ids))
+		batch, err := s.resumeRepo.OwnersByID(ctx, ids[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up resumes: %w", err)
+		}
+		for id, userID := range batch {
+			owners[id] = userID
+		}
+	}
+	return owners, nil
+}
+
+// parsePDFCacheKey returns the user and resume of a pdfCacheKey, whatever
+// its template and density suffixes.
+func parsePDFCacheKey(key string) (userID, resumeID string, ok bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 3 || parts[0] != "resumes" || parts[1] == "" || !strings.HasSuffix(parts[2], ".pdf") {
+		return "", "", false
+	}
+
+	resumeID, _, _ = strings.Cut(strings.TrimSuffix(parts[2], ".pdf"), "_")
+	if resumeID == "" {
+		return "", "", false
+	}
+	return parts[1], resumeID, true
+}
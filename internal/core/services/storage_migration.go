@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// DefaultMigrationPrefixes are the key prefixes copied by a storage migration
+// when none are given. Import chunks are short-lived and left behind.
+var DefaultMigrationPrefixes = []string{"resumes/"}
+
+// StorageMigrationService copies stored files between storage backends.
+type StorageMigrationService struct {
+	resumeRepo ports.ResumeRepository
+}
+
+// NewStorageMigrationService creates a new StorageMigrationService with required dependencies.
+func NewStorageMigrationService(resumeRepo ports.ResumeRepository) *StorageMigrationService {
+	return &StorageMigrationService{
+		resumeRepo: resumeRepo,
+	}
+}
+
+// MigrateStorageRequest contains the parameters for a storage migration.
+type MigrateStorageRequest struct {
+	Source      ports.FileStorage
+	Destination ports.FileStorage
+
+	// Prefixes limits the migration to keys with one of these prefixes.
+	// Defaults to DefaultMigrationPrefixes.
+	Prefixes []string
+
+	// DryRun lists the files that would be copied without copying them.
+	DryRun bool
+}
+
+// StorageMigrationResult summarizes a storage migration.
+type StorageMigrationResult struct {
+	// Keys are the keys of the migrated files.
+	Keys []string
+
+	// ResumesUpdated is the number of resumes whose PDF URL now points to
+	// the destination.
+	ResumesUpdated int
+
+	DryRun bool
+}
+
+// Migrate copies every file under the request prefixes from the source to the
+// destination, then points the PDF URLs of the affected resumes to the
+// destination in a single transaction. Nothing is updated if a copy fails, and
+// source files are kept: delete them once the deployment uses the destination.
+// Running a migration again copies the files again, so it is safe to retry.
+func (s *StorageMigrationService) Migrate(ctx context.Context, req MigrateStorageRequest) (*StorageMigrationResult, error) {
+	prefixes := req.Prefixes
+	if len(prefixes) == 0 {
+		prefixes = DefaultMigrationPrefixes
+	}
+
+	var keys []string
+	for _, prefix := range prefixes {
+		found, err := req.Source.List(ctx, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %q: %w", prefix, err)
+		}
+		for _, file := range found {
+			keys = append(keys, file.Key)
+		}
+	}
+
+	result := &StorageMigrationResult{Keys: keys, DryRun: req.DryRun}
+	if req.DryRun {
+		return result, nil
+	}
+
+	var updates []ports.PDFURLUpdate
+	for _, key := range keys {
+		url, err := copyFile(ctx, req.Source, req.Destination, key)
+		if err != nil {
+			return nil, err
+		}
+		if resumeID, ok := resumeIDFromPDFKey(key); ok {
+			updates = append(updates, ports.PDFURLUpdate{ResumeID: resumeID, URL: url})
+		}
+	}
+
+	updated, err := s.resumeRepo.UpdatePDFURLs(ctx, updates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update resume PDF URLs: %w", err)
+	}
+	result.ResumesUpdated = updated
+
+	return result, nil
+}
+
+// copyFile copies one file and returns its URL in the destination.
+func copyFile(ctx context.Context, source, destination ports.FileStorage, key string) (string, error) {
+	content, err := source.Download(ctx, key)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	defer content.Close()
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	uploaded, err := destination.Upload(ctx, ports.UploadRequest{
+		Key:         key,
+		Content:     content,
+		ContentType: contentType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", key, err)
+	}
+
+	return uploaded.URL, nil
+}
+
+// resumeIDFromPDFKey returns the resume whose PDF URL points to key, the
+// pdfCacheKey of its Jake's Resume PDF.
+func resumeIDFromPDFKey(key string) (string, bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 3 || parts[0] != "resumes" || !strings.HasSuffix(parts[2], ".pdf") {
+		return "", false
+	}
+
+	// Other templates add a "_<template>" suffix.
+	resumeID := strings.TrimSuffix(parts[2], ".pdf")
+	if resumeID == "" || strings.Contains(resumeID, "_") {
+		return "", false
+	}
+	return resumeID, true
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// Suggestion limits.
+const (
+	// DefaultSuggestionsPerType is how many suggestions of each type are
+	// returned when no limit is given.
+	DefaultSuggestionsPerType = 5
+
+	// MaxSuggestionsPerType caps the suggestions of each type.
+	MaxSuggestionsPerType = 20
+
+	// maxSuggestQueryLength bounds the query; longer text is not
+	// autocompleted.
+	maxSuggestQueryLength = 100
+)
+
+// SuggestService looks up type-ahead suggestions in a user's own data.
+type SuggestService struct {
+	suggestionRepo ports.SuggestionRepository
+}
+
+// NewSuggestService creates a new SuggestService with required dependencies.
+func NewSuggestService(suggestionRepo ports.SuggestionRepository) *SuggestService {
+	return &SuggestService{
+		suggestionRepo: suggestionRepo,
+	}
+}
+
+// SuggestRequest contains the parameters for a type-ahead lookup.
+type SuggestRequest struct {
+	UserID string
+	Query  string
+
+	// Limit caps the suggestions of each type; 0 uses the default.
+	Limit int
+}
+
+// Suggest returns the companies, job titles, skills and tags of the user
+// that contain the query, ignoring case. A blank query has no suggestions.
+func (s *SuggestService) Suggest(ctx context.Context, req SuggestRequest) ([]domain.Suggestion, error) {
+	query := strings.TrimSpace(req.Query)
+
+	v := &domain.ValidationErrors{}
+	if utf8.RuneCountInString(query) > maxSuggestQueryLength {
+		v.AddFieldError("q", fmt.Sprintf("must be at most %d characters", maxSuggestQueryLength))
+	}
+	if req.Limit < 0 || req.Limit > MaxSuggestionsPerType {
+		v.AddFieldError("limit", fmt.Sprintf("must be between 1 and %d", MaxSuggestionsPerType))
+	}
+	if err := v.ToError(); err != nil {
+		return nil, err
+	}
+
+	if query == "" {
+		return []domain.Suggestion{}, nil
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultSuggestionsPerType
+	}
+
+	suggestions, err := s.suggestionRepo.Suggest(ctx, req.UserID, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up suggestions: %w", err)
+	}
+	return suggestions, nil
+}
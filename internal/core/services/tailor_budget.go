@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+)
+
+// TailorBudget limits how long each AI stage of tailoring a resume may take.
+// Every stage is also bounded by the request, so tailoring stops as soon as
+// the client goes away. A zero duration leaves the stage bounded only by the
+// request.
+type TailorBudget struct {
+	Analysis  time.Duration
+	Selection time.Duration
+
+	// Tailoring bounds rewriting all selected bullets. Bullets not rewritten
+	// in time keep their original content.
+	Tailoring time.Duration
+	Summary   time.Duration
+
+	// Scoring bounds the match score; a score not computed in time is 0.
+	Scoring time.Duration
+
+	// Themes bounds grouping bullets by skill theme for the functional
+	// template, which lists them without themes when not done in time.
+	Themes time.Duration
+}
+
+// DefaultTailorBudget returns the stage budgets used unless configured.
+func DefaultTailorBudget() TailorBudget {
+	return TailorBudget{
+		Analysis:  20 * time.Second,
+		Selection: 20 * time.Second,
+		Tailoring: 45 * time.Second,
+		Summary:   20 * time.Second,
+		Scoring:   15 * time.Second,
+		Themes:    15 * time.Second,
+	}
+}
+
+// SetTailorBudget replaces the stage budgets of tailoring.
+func (s *ResumeService) SetTailorBudget(budget TailorBudget) {
+	s.budget = budget
+}
+
+// tailorStage derives the context of a tailoring stage from the request
+// context.
+func tailorStage(ctx context.Context, budget time.Duration) (context.Context, context.CancelFunc) {
+	if budget <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, budget)
+}
+
+// tailorStageError explains the failure of a tailoring stage: the request's
+// error when the client went away, a domain.ErrTailorTimeout naming the stage
+// when the stage ran out of time, and err otherwise. stageCtx must not be
+// cancelled yet.
+func tailorStageError(ctx, stageCtx context.Context, stage string, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if stageCtx.Err() == context.DeadlineExceeded {
+		return domain.NewDomainError(domain.ErrTailorTimeout, stage+" ran out of time")
+	}
+	return err
+}
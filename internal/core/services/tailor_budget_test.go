@@ -0,0 +1,77 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestTailorStage(t *testing.T) {
+	t.Run("budget bounds the stage", func(t *testing.T) {
+		stageCtx, cancel := services.TailorStage(context.Background(), time.Minute)
+		defer cancel()
+
+		deadline, ok := stageCtx.Deadline()
+		require.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+	})
+
+	t.Run("zero budget leaves the stage bounded by the request", func(t *testing.T) {
+		stageCtx, cancel := services.TailorStage(context.Background(), 0)
+		defer cancel()
+
+		_, ok := stageCtx.Deadline()
+		assert.False(t, ok)
+	})
+
+	t.Run("the request ends the stage", func(t *testing.T) {
+		ctx, cancelRequest := context.WithCancel(context.Background())
+		stageCtx, cancel := services.TailorStage(ctx, time.Minute)
+		defer cancel()
+
+		cancelRequest()
+		assert.ErrorIs(t, stageCtx.Err(), context.Canceled)
+	})
+}
+
+func TestTailorStageError(t *testing.T) {
+	errAI := errors.New("ai failed")
+
+	t.Run("client went away", func(t *testing.T) {
+		ctx, cancelRequest := context.WithCancel(context.Background())
+		stageCtx, cancel := services.TailorStage(ctx, time.Minute)
+		defer cancel()
+		cancelRequest()
+
+		err := services.TailorStageError(ctx, stageCtx, "summary", errAI)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.NotErrorIs(t, err, domain.ErrTailorTimeout)
+	})
+
+	t.Run("stage ran out of time", func(t *testing.T) {
+		ctx := context.Background()
+		stageCtx, cancel := services.TailorStage(ctx, time.Nanosecond)
+		defer cancel()
+		<-stageCtx.Done()
+
+		err := services.TailorStageError(ctx, stageCtx, "bullet selection", errAI)
+		assert.ErrorIs(t, err, domain.ErrTailorTimeout)
+		assert.ErrorContains(t, err, "bullet selection ran out of time")
+	})
+
+	t.Run("stage failed", func(t *testing.T) {
+		ctx := context.Background()
+		stageCtx, cancel := services.TailorStage(ctx, time.Minute)
+		defer cancel()
+
+		err := services.TailorStageError(ctx, stageCtx, "summary", errAI)
+		assert.Equal(t, errAI, err)
+	})
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// EstimateTailoringRequest identifies a resume to estimate tailoring for.
+type EstimateTailoringRequest struct {
+	ResumeID string
+	UserID   string
+
+	// MaxBullets is the number of bullets tailoring would select; zero
+	// uses the user's default, as TailorResume does.
+	MaxBullets int
+}
+
+// TailoringEstimate is the approximate size and cost of tailoring a resume,
+// with the user's tailoring quota.
+type TailoringEstimate struct {
+	*ports.TailoringEstimate
+
+	// Bullets is the number of bullets the AI would select from.
+	Bullets int
+
+	// MaxBullets is the number of bullets it would select.
+	MaxBullets int
+
+	// Quota is the user's tailoring quota; nil when plan limits are off.
+	Quota *Quota
+}
+
+// EstimateTailoring estimates the AI tokens and cost of tailoring a resume
+// now, from its job description, the bullets tailoring would choose from
+// and the configured models, without calling the AI. It fails as
+// TailorResume would when the resume has no bullets to tailor.
+func (s *ResumeService) EstimateTailoring(ctx context.Context, req EstimateTailoringRequest) (*TailoringEstimate, error) {
+	resume, err := s.resumeRepo.GetByIDForUser(ctx, req.ResumeID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume: %w", err)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, resume.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	allBullets, err := s.bulletRepo.ListByUserID(ctx, resume.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bullets: %w", err)
+	}
+	experiences, err := s.listExperiences(ctx, resume.UserID)
+	if err != nil {
+		return nil, err
+	}
+	bullets := tailoringBullets(resume, experiences, allBullets)
+	if len(bullets) == 0 {
+		return nil, domain.ErrNoBulletsAvailable
+	}
+
+	// Semantic pre-ranking, when enabled, narrows large libraries down to
+	// the bullets closest to the job; their sizes are taken as typical.
+	if s.embeddings != nil && s.embeddingRepo != nil && len(bullets) > s.preRankLimit {
+		bullets = bullets[:s.preRankLimit]
+	}
+
+	maxBullets := req.MaxBullets
+	if maxBullets == 0 {
+		maxBullets = s.userPreferences(ctx, resume.UserID).DefaultMaxBullets
+	}
+
+	estimate := &TailoringEstimate{
+		TailoringEstimate: s.aiProvider.EstimateTailoring(ports.EstimateTailoringRequest{
+			JobDescription: resume.JobDescription,
+			Bullets:        bullets,
+			MaxBullets:     maxBullets,
+			Translate:      user.PreferredLanguage != "" && user.PreferredLanguage != resume.TargetLanguage,
+		}),
+		Bullets:    len(bullets),
+		MaxBullets: maxBullets,
+	}
+	if s.quotas != nil {
+		estimate.Quota, err = s.quotas.GetQuota(ctx, resume.UserID, domain.UsageTailor)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return estimate, nil
+}
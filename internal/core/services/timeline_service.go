@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// timelinePageSize is how many experiences are loaded per query when
+// building a timeline.
+const timelinePageSize = 100
+
+// TimelineService builds experience timelines.
+type TimelineService struct {
+	experienceRepo ports.ExperienceRepository
+}
+
+// NewTimelineService creates a new TimelineService with required dependencies.
+func NewTimelineService(experienceRepo ports.ExperienceRepository) *TimelineService {
+	return &TimelineService{
+		experienceRepo: experienceRepo,
+	}
+}
+
+// GetTimelineRequest contains the parameters for building a timeline.
+type GetTimelineRequest struct {
+	UserID string
+
+	// GapThresholdMonths flags gaps longer than this; 0 uses the default.
+	GapThresholdMonths int
+}
+
+// GetTimeline builds the timeline of all of a user's experiences.
+func (s *TimelineService) GetTimeline(ctx context.Context, req GetTimelineRequest) (*domain.Timeline, error) {
+	var experiences []domain.Experience
+	for offset := 0; ; offset += timelinePageSize {
+		page, total, err := s.experienceRepo.ListByUserIDWithBullets(ctx, req.UserID, ports.ListOptions{
+			Limit:  timelinePageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list experiences: %w", err)
+		}
+		experiences = append(experiences, page...)
+		if len(page) < timelinePageSize || len(experiences) >= total {
+			break
+		}
+	}
+
+	return domain.BuildTimeline(experiences, domain.TimelineOptions{
+		GapThresholdMonths: req.GapThresholdMonths,
+	}), nil
+}
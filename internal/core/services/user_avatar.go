@@ -0,0 +1,108 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// avatarCache holds the dependencies of profile picture caching.
+type avatarCache struct {
+	fetcher ports.AvatarFetcher
+	storage ports.FileStorage
+
+	// url returns the URL the API serves a user's cached picture on.
+	url func(userID string) string
+}
+
+// SetAvatarCache enables caching the profile pictures of identity providers,
+// whose URLs expire and count as hotlinks. On sync, the picture is stored in
+// storage as a thumbnail and the user's PictureURL becomes url(userID), the
+// API route serving it. When a picture cannot be cached, PictureURL stays the
+// provider's URL.
+func (s *UserService) SetAvatarCache(fetcher ports.AvatarFetcher, storage ports.FileStorage, url func(userID string) string) {
+	s.avatars = &avatarCache{fetcher: fetcher, storage: storage, url: url}
+}
+
+// avatarKey returns the storage key of a user's cached picture.
+func avatarKey(userID string) string {
+	return "avatars/" + userID + "/avatar.jpg"
+}
+
+// setPicture sets the picture of a user from the provider's URL, caching it
+// when enabled. Users must have an ID.
+func (s *UserService) setPicture(ctx context.Context, user *domain.User, sourceURL string) {
+	user.PictureSourceURL = &sourceURL
+	user.PictureURL = &sourceURL
+	user.UpdatedAt = time.Now().UTC()
+
+	if s.avatars == nil {
+		return
+	}
+	if _, err := s.cacheAvatar(ctx, user.ID, sourceURL); err != nil {
+		return
+	}
+	// The version changes the URL of a new picture, so that it is not
+	// served from caches.
+	cached := fmt.Sprintf("%s?v=%d", s.avatars.url(user.ID), user.UpdatedAt.Unix())
+	user.PictureURL = &cached
+}
+
+// cacheAvatar downloads a user's picture and stores its thumbnail.
+func (s *UserService) cacheAvatar(ctx context.Context, userID, sourceURL string) ([]byte, error) {
+	content, err := s.avatars.fetcher.FetchAvatar(ctx, sourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch avatar: %w", err)
+	}
+
+	_, err = s.avatars.storage.Upload(ctx, ports.UploadRequest{
+		Key:         avatarKey(userID),
+		Content:     bytes.NewReader(content),
+		ContentType: "image/jpeg",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store avatar: %w", err)
+	}
+	return content, nil
+}
+
+// GetAvatar returns a user's cached profile picture, a JPEG. A picture
+// missing from storage, such as after moving storage, is cached again from
+// the provider. Users without a cached picture return an error matching
+// domain.ErrFileNotFound.
+func (s *UserService) GetAvatar(ctx context.Context, userID string) (io.ReadCloser, error) {
+	if s.avatars == nil {
+		return nil, domain.ErrFileNotFound
+	}
+
+	content, err := s.avatars.storage.Download(ctx, avatarKey(userID))
+	if err == nil {
+		return content, nil
+	}
+	if !errors.Is(err, domain.ErrFileNotFound) {
+		return nil, fmt.Errorf("failed to get avatar: %w", err)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, domain.ErrFileNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.PictureSourceURL == nil {
+		return nil, domain.ErrFileNotFound
+	}
+
+	thumbnail, err := s.cacheAvatar(ctx, userID, *user.PictureSourceURL)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(thumbnail)), nil
+}
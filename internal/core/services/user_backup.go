@@ -0,0 +1,497 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// UserArchiveVersion is the format version of the archives written by
+// ExportUser. Archives of other versions are not imported.
+const UserArchiveVersion = 1
+
+// UserArchive is a portable copy of the data of a user, for support and for
+// moving users between instances. Bullet embeddings, usage counts, cached
+// files, linked sign-in methods and the subscription plan are not included:
+// they are recomputed, kept per instance or managed elsewhere.
+type UserArchive struct {
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+
+	User domain.User `json:"user"`
+
+	// PictureSourceURL is the provider's URL of the user's picture, which
+	// the user's JSON leaves out.
+	PictureSourceURL *string `json:"picture_source_url,omitempty"`
+
+	// Preferences is nil for users who never saved theirs.
+	Preferences *domain.UserPreferences `json:"preferences,omitempty"`
+
+	// Experiences and Projects include their bullets.
+	Experiences     []domain.Experience     `json:"experiences"`
+	Skills          []domain.Skill          `json:"skills"`
+	SpokenLanguages []domain.SpokenLanguage `json:"spoken_languages"`
+	Education       []domain.Education      `json:"education"`
+	AcademicEntries []domain.AcademicEntry  `json:"academic_entries"`
+	Projects        []domain.Project        `json:"projects"`
+
+	// Resumes include the IDs of their tags.
+	ResumeTags     []domain.ResumeTag         `json:"resume_tags"`
+	Resumes        []domain.Resume            `json:"resumes"`
+	Critiques      []domain.ResumeCritique    `json:"critiques"`
+	BulletFeedback []domain.BulletFeedback    `json:"bullet_feedback"`
+	ResumeActivity []domain.ResumeActivity    `json:"resume_activity"`
+	SavedFilters   []domain.SavedResumeFilter `json:"saved_filters"`
+}
+
+// UserBackupService exports the data of a user to a UserArchive and imports
+// archives, for support workflows and instance migrations.
+type UserBackupService struct {
+	userRepo          ports.UserRepository
+	preferencesRepo   ports.PreferencesRepository
+	experienceRepo    ports.ExperienceRepository
+	skillRepo         ports.SkillRepository
+	languageRepo      ports.SpokenLanguageRepository
+	educationRepo     ports.EducationRepository
+	academicRepo      ports.AcademicEntryRepository
+	projectRepo       ports.ProjectRepository
+	projectBulletRepo ports.ProjectBulletRepository
+	resumeRepo        ports.ResumeRepository
+	tagRepo           ports.ResumeTagRepository
+	critiqueRepo      ports.ResumeCritiqueRepository
+	feedbackRepo      ports.BulletFeedbackRepository
+	activityRepo      ports.ResumeActivityRepository
+	filterRepo        ports.SavedResumeFilterRepository
+	audit             ports.AuditRepository
+}
+
+// NewUserBackupService creates a new UserBackupService with required dependencies.
+func NewUserBackupService(
+	userRepo ports.UserRepository,
+	preferencesRepo ports.PreferencesRepository,
+	experienceRepo ports.ExperienceRepository,
+	skillRepo ports.SkillRepository,
+	languageRepo ports.SpokenLanguageRepository,
+	educationRepo ports.EducationRepository,
+	academicRepo ports.AcademicEntryRepository,
+	projectRepo ports.ProjectRepository,
+	projectBulletRepo ports.ProjectBulletRepository,
+	resumeRepo ports.ResumeRepository,
+	tagRepo ports.ResumeTagRepository,
+	critiqueRepo ports.ResumeCritiqueRepository,
+	feedbackRepo ports.BulletFeedbackRepository,
+	activityRepo ports.ResumeActivityRepository,
+	filterRepo ports.SavedResumeFilterRepository,
+	audit ports.AuditRepository,
+) *UserBackupService {
+	return &UserBackupService{
+		userRepo:          userRepo,
+		preferencesRepo:   preferencesRepo,
+		experienceRepo:    experienceRepo,
+		skillRepo:         skillRepo,
+		languageRepo:      languageRepo,
+		educationRepo:     educationRepo,
+		academicRepo:      academicRepo,
+		projectRepo:       projectRepo,
+		projectBulletRepo: projectBulletRepo,
+		resumeRepo:        resumeRepo,
+		tagRepo:           tagRepo,
+		critiqueRepo:      critiqueRepo,
+		feedbackRepo:      feedbackRepo,
+		activityRepo:      activityRepo,
+		filterRepo:        filterRepo,
+		audit:             audit,
+	}
+}
+
+// archivePageSize is how many experiences and resumes are loaded per query
+// when exporting a user.
+const archivePageSize = 100
+
+// ExportUser returns the archive of a user's data. The export is recorded in
+// the audit log under adminID; exports without an admin, such as from the
+// command line, are not.
+func (s *UserBackupService) ExportUser(ctx context.Context, adminID, userID string) (*UserArchive, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	archive := &UserArchive{
+		Version:          UserArchiveVersion,
+		ExportedAt:       time.Now().UTC(),
+		User:             *user,
+		PictureSourceURL: user.PictureSourceURL,
+	}
+
+	archive.Preferences, err = s.preferencesRepo.GetByUserID(ctx, userID)
+	if err != nil && !errors.Is(err, domain.ErrPreferencesNotFound) {
+		return nil, fmt.Errorf("failed to get preferences: %w", err)
+	}
+
+	for offset := 0; ; offset += archivePageSize {
+		page, total, err := s.experienceRepo.ListByUserIDWithBullets(ctx, userID, ports.ListOptions{
+			Limit:  archivePageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list experiences: %w", err)
+		}
+		archive.Experiences = append(archive.Experiences, page...)
+		if len(page) < archivePageSize || len(archive.Experiences) >= total {
+			break
+		}
+	}
+
+	if archive.Skills, err = s.skillRepo.ListByUserID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to list skills: %w", err)
+	}
+	if archive.SpokenLanguages, err = s.languageRepo.ListByUserID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to list spoken languages: %w", err)
+	}
+	if archive.Education, err = s.educationRepo.ListByUserID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to list education: %w", err)
+	}
+	if archive.AcademicEntries, err = s.academicRepo.ListByUserID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to list academic entries: %w", err)
+	}
+	if archive.Projects, err = s.projectRepo.ListByUserIDWithBullets(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	if archive.ResumeTags, err = s.tagRepo.ListByUserID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to list resume tags: %w", err)
+	}
+	if archive.SavedFilters, err = s.filterRepo.ListByUserID(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to list saved filters: %w", err)
+	}
+
+	for offset := 0; ; offset += archivePageSize {
+		page, total, err := s.resumeRepo.ListByUserID(ctx, userID, ports.ListOptions{
+			Limit:  archivePageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resumes: %w", err)
+		}
+		archive.Resumes = append(archive.Resumes, page...)
+		if len(page) < archivePageSize || len(archive.Resumes) >= total {
+			break
+		}
+	}
+
+	for _, resume := range archive.Resumes {
+		critiques, err := s.critiqueRepo.ListByResumeID(ctx, resume.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list critiques: %w", err)
+		}
+		archive.Critiques = append(archive.Critiques, critiques...)
+
+		feedback, err := s.feedbackRepo.ListByResumeID(ctx, resume.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list bullet feedback: %w", err)
+		}
+		archive.BulletFeedback = append(archive.BulletFeedback, feedback...)
+
+		activity, err := s.activityRepo.ListByResumeID(ctx, resume.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resume activity: %w", err)
+		}
+		archive.ResumeActivity = append(archive.ResumeActivity, activity...)
+	}
+
+	if err := s.record(ctx, domain.AuditUserExported, adminID, userID); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+// ImportUserRequest contains the parameters for importing a user archive.
+type ImportUserRequest struct {
+	Archive *UserArchive
+
+	// AdminID is the admin importing the archive, recorded in the audit
+	// log; empty for imports from the command line.
+	AdminID string
+
+	// RemapIDs gives the user and all their records new IDs, so that an
+	// archive can be imported into the instance it was exported from.
+	// Otherwise IDs are kept, and importing fails if the user exists.
+	RemapIDs bool
+
+	// FirebaseUID, when set, replaces the sign-in of the archived user, so
+	// that another account signs in to the imported data.
+	FirebaseUID string
+}
+
+// ImportUser creates a user with the data of an archive and returns it. It
+// fails with domain.ErrUserAlreadyExists when the user's ID, with IDs kept,
+// or sign-in is already used. Cached resume PDFs are not part of archives,
+// so they are generated again on download. An import that fails midway is
+// undone by deleting the new user.
+func (s *UserBackupService) ImportUser(ctx context.Context, req ImportUserRequest) (*domain.User, error) {
+	if req.Archive == nil || req.Archive.Version != UserArchiveVersion {
+		return nil, domain.ErrUnsupportedArchiveVersion
+	}
+	copied := *req.Archive
+	archive := &copied
+	if archive.User.ID == "" {
+		archive.User.ID = uuid.New().String()
+	}
+	if req.FirebaseUID != "" {
+		archive.User.FirebaseUID = req.FirebaseUID
+	}
+	if archive.User.FirebaseUID == "" {
+		return nil, domain.ErrInvalidFirebaseUID
+	}
+
+	if req.RemapIDs {
+		remapped, err := remapArchiveIDs(archive)
+		if err != nil {
+			return nil, err
+		}
+		archive = remapped
+	} else if _, err := s.userRepo.GetByID(ctx, archive.User.ID); err == nil {
+		return nil, domain.ErrUserAlreadyExists
+	} else if !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if _, err := s.userRepo.GetByFirebaseUID(ctx, archive.User.FirebaseUID); err == nil {
+		return nil, domain.ErrUserAlreadyExists
+	} else if !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	user := archive.User
+	user.PictureSourceURL = archive.PictureSourceURL
+	// Pictures cached by the exporting instance are not served here.
+	user.PictureURL = archive.PictureSourceURL
+	user.SessionsRevokedAt = nil
+
+	if err := s.record(ctx, domain.AuditUserImported, req.AdminID, user.ID); err != nil {
+		return nil, err
+	}
+	if err := s.userRepo.Create(ctx, &user); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	if err := s.importData(ctx, user.ID, archive); err != nil {
+		if deleteErr := s.userRepo.Delete(context.WithoutCancel(ctx), user.ID); deleteErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to delete partially imported user: %w", deleteErr))
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// importData creates the records of an archive for the user with userID, in
+// the order of their references.
+func (s *UserBackupService) importData(ctx context.Context, userID string, archive *UserArchive) error {
+	if archive.Preferences != nil {
+		prefs := *archive.Preferences
+		prefs.UserID = userID
+		if err := s.preferencesRepo.Upsert(ctx, &prefs); err != nil {
+			return fmt.Errorf("failed to import preferences: %w", err)
+		}
+	}
+
+	experiences := make([]domain.Experience, len(archive.Experiences))
+	for i, experience := range archive.Experiences {
+		experience.UserID = userID
+		experiences[i] = experience
+	}
+	if len(experiences) > 0 {
+		if err := s.experienceRepo.CreateBatch(ctx, experiences); err != nil {
+			return fmt.Errorf("failed to import experiences: %w", err)
+		}
+	}
+
+	for _, skill := range archive.Skills {
+		skill.UserID = userID
+		if err := s.skillRepo.Create(ctx, &skill); err != nil {
+			return fmt.Errorf("failed to import skill: %w", err)
+		}
+	}
+	for _, language := range archive.SpokenLanguages {
+		language.UserID = userID
+		if err := s.languageRepo.Create(ctx, &language); err != nil {
+			return fmt.Errorf("failed to import spoken language: %w", err)
+		}
+	}
+	for _, education := range archive.Education {
+		education.UserID = userID
+		if err := s.educationRepo.Create(ctx, &education); err != nil {
+			return fmt.Errorf("failed to import education: %w", err)
+		}
+	}
+	for _, entry := range archive.AcademicEntries {
+		entry.UserID = userID
+		if err := s.academicRepo.Create(ctx, &entry); err != nil {
+			return fmt.Errorf("failed to import academic entry: %w", err)
+		}
+	}
+
+	for _, project := range archive.Projects {
+		bullets := project.Bullets
+		project.UserID = userID
+		project.Bullets = nil
+		if err := s.projectRepo.Create(ctx, &project); err != nil {
+			return fmt.Errorf("failed to import project: %w", err)
+		}
+		for _, bullet := range bullets {
+			bullet.ProjectID = project.ID
+			if err := s.projectBulletRepo.Create(ctx, &bullet); err != nil {
+				return fmt.Errorf("failed to import project bullet: %w", err)
+			}
+		}
+	}
+
+	for _, tag := range archive.ResumeTags {
+		tag.UserID = userID
+		if err := s.tagRepo.Create(ctx, &tag); err != nil {
+			return fmt.Errorf("failed to import resume tag: %w", err)
+		}
+	}
+
+	tagged := make(map[string][]string)
+	for _, resume := range archive.Resumes {
+		for _, tagID := range resume.TagIDs {
+			tagged[tagID] = append(tagged[tagID], resume.ID)
+		}
+		resume.UserID = userID
+		resume.PDFURL = nil
+		resume.TagIDs = nil
+		if err := s.resumeRepo.Create(ctx, &resume); err != nil {
+			return fmt.Errorf("failed to import resume: %w", err)
+		}
+	}
+	for tagID, resumeIDs := range tagged {
+		if err := s.tagRepo.Assign(ctx, tagID, resumeIDs, nil); err != nil {
+			return fmt.Errorf("failed to import resume tags: %w", err)
+		}
+	}
+
+	for _, critique := range archive.Critiques {
+		critique.UserID = userID
+		if err := s.critiqueRepo.Create(ctx, &critique); err != nil {
+			return fmt.Errorf("failed to import critique: %w", err)
+		}
+	}
+	for _, feedback := range archive.BulletFeedback {
+		feedback.UserID = userID
+		if err := s.feedbackRepo.Upsert(ctx, &feedback); err != nil {
+			return fmt.Errorf("failed to import bullet feedback: %w", err)
+		}
+	}
+	for _, activity := range archive.ResumeActivity {
+		activity.UserID = userID
+		if err := s.activityRepo.Create(ctx, &activity); err != nil {
+			return fmt.Errorf("failed to import resume activity: %w", err)
+		}
+	}
+
+	for _, filter := range archive.SavedFilters {
+		filter.UserID = userID
+		if err := s.filterRepo.Create(ctx, &filter); err != nil {
+			return fmt.Errorf("failed to import saved filter: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// record adds an export or import by adminID to the audit log; nothing is
+// recorded without an admin.
+func (s *UserBackupService) record(ctx context.Context, action domain.AuditAction, adminID, userID string) error {
+	if adminID == "" {
+		return nil
+	}
+	err := s.audit.Record(ctx, &domain.AuditEvent{
+		Action:    action,
+		ActorID:   adminID,
+		SubjectID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	return nil
+}
+
+// remapArchiveIDs returns a copy of an archive in which the user and each
+// record have a new ID. IDs are UUIDs, unique across the archive, so every
+// occurrence of one is replaced wherever it appears: in references between
+// records as well as in generated content and selection explanations.
+func remapArchiveIDs(archive *UserArchive) (*UserArchive, error) {
+	var pairs []string
+	remap := func(id string) {
+		if id != "" {
+			pairs = append(pairs, id, uuid.New().String())
+		}
+	}
+
+	remap(archive.User.ID)
+	for _, experience := range archive.Experiences {
+		remap(experience.ID)
+		for _, bullet := range experience.Bullets {
+			remap(bullet.ID)
+		}
+	}
+	for _, skill := range archive.Skills {
+		remap(skill.ID)
+	}
+	for _, language := range archive.SpokenLanguages {
+		remap(language.ID)
+	}
+	for _, education := range archive.Education {
+		remap(education.ID)
+	}
+	for _, entry := range archive.AcademicEntries {
+		remap(entry.ID)
+	}
+	for _, project := range archive.Projects {
+		remap(project.ID)
+		for _, bullet := range project.Bullets {
+			remap(bullet.ID)
+		}
+	}
+	for _, tag := range archive.ResumeTags {
+		remap(tag.ID)
+	}
+	for _, resume := range archive.Resumes {
+		remap(resume.ID)
+	}
+	for _, critique := range archive.Critiques {
+		remap(critique.ID)
+	}
+	for _, feedback := range archive.BulletFeedback {
+		remap(feedback.ID)
+	}
+	for _, activity := range archive.ResumeActivity {
+		remap(activity.ID)
+	}
+	for _, filter := range archive.SavedFilters {
+		remap(filter.ID)
+	}
+
+	data, err := json.Marshal(archive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode archive: %w", err)
+	}
+	data = []byte(strings.NewReplacer(pairs...).Replace(string(data)))
+
+	var remapped UserArchive
+	if err := json.Unmarshal(data, &remapped); err != nil {
+		return nil, fmt.Errorf("failed to decode archive: %w", err)
+	}
+	return &remapped, nil
+}
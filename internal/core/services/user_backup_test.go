@@ -0,0 +1,72 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+func TestRemapArchiveIDs(t *testing.T) {
+	const (
+		userID       = "11111111-1111-1111-1111-111111111111"
+		experienceID = "22222222-2222-2222-2222-222222222222"
+		bulletID     = "33333333-3333-3333-3333-333333333333"
+		resumeID     = "44444444-4444-4444-4444-444444444444"
+		tagID        = "55555555-5555-5555-5555-555555555555"
+		deletedID    = "66666666-6666-6666-6666-666666666666"
+	)
+
+	archive := &services.UserArchive{
+		Version: services.UserArchiveVersion,
+		User:    domain.User{ID: userID, FirebaseUID: "firebase-123"},
+		Experiences: []domain.Experience{{
+			ID:      experienceID,
+			UserID:  userID,
+			Bullets: []domain.Bullet{{ID: bulletID, ExperienceID: experienceID, Content: "Shipped it"}},
+		}},
+		ResumeTags: []domain.ResumeTag{{ID: tagID, UserID: userID, Name: "Backend"}},
+		Resumes: []domain.Resume{{
+			ID:                    resumeID,
+			UserID:                userID,
+			SelectedBullets:       []string{bulletID},
+			IncludedExperienceIDs: []string{experienceID},
+			TagIDs:                []string{tagID},
+		}},
+		BulletFeedback: []domain.BulletFeedback{{ResumeID: resumeID, BulletID: deletedID}},
+	}
+
+	remapped, err := services.RemapArchiveIDs(archive)
+	require.NoError(t, err)
+
+	user := remapped.User
+	experience := remapped.Experiences[0]
+	bullet := experience.Bullets[0]
+	resume := remapped.Resumes[0]
+	tag := remapped.ResumeTags[0]
+
+	assert.NotEqual(t, userID, user.ID)
+	assert.Equal(t, "firebase-123", user.FirebaseUID)
+	assert.NotEqual(t, experienceID, experience.ID)
+	assert.NotEqual(t, bulletID, bullet.ID)
+	assert.NotEqual(t, resumeID, resume.ID)
+	assert.NotEqual(t, tagID, tag.ID)
+
+	// References follow the records they point to.
+	assert.Equal(t, user.ID, experience.UserID)
+	assert.Equal(t, experience.ID, bullet.ExperienceID)
+	assert.Equal(t, user.ID, resume.UserID)
+	assert.Equal(t, []string{bullet.ID}, resume.SelectedBullets)
+	assert.Equal(t, []string{experience.ID}, resume.IncludedExperienceIDs)
+	assert.Equal(t, []string{tag.ID}, resume.TagIDs)
+	assert.Equal(t, resume.ID, remapped.BulletFeedback[0].ResumeID)
+
+	// IDs of records outside the archive are kept.
+	assert.Equal(t, deletedID, remapped.BulletFeedback[0].BulletID)
+
+	// The archive itself is left as it was.
+	assert.Equal(t, userID, archive.User.ID)
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// LinkIdentity links the sign-in method of an ID token, such as GitHub, to a
+// user, so that they can sign in to the same account with it. Tokens that
+// cannot be verified return domain.ErrInvalidIdentityToken.
+func (s *UserService) LinkIdentity(ctx context.Context, userID, idToken string) (*domain.UserIdentity, error) {
+	claims, err := s.authProvider.VerifyToken(ctx, idToken)
+	if err != nil {
+		return nil, domain.ErrInvalidIdentityToken
+	}
+
+	owner, err := s.userRepo.GetByFirebaseUID(ctx, claims.UserID)
+	switch {
+	case err == nil && owner.ID == userID:
+		return nil, domain.ErrIdentityAlreadyLinked
+	case err == nil:
+		return nil, domain.ErrIdentityInUse
+	case !errors.Is(err, domain.ErrUserNotFound):
+		return nil, fmt.Errorf("failed to check identity: %w", err)
+	}
+
+	return s.linkIdentity(ctx, userID, claims)
+}
+
+// linkIdentity links the identity of claims to a user.
+func (s *UserService) linkIdentity(ctx context.Context, userID string, claims *ports.AuthClaims) (*domain.UserIdentity, error) {
+	identity, err := domain.NewUserIdentity(userID, claims.UserID, claims.Provider, claims.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.LinkIdentity(ctx, identity); err != nil {
+		return nil, fmt.Errorf("failed to link identity: %w", err)
+	}
+	return identity, nil
+}
+
+// ListIdentities lists the sign-in methods linked to a user besides the one
+// they signed up with.
+func (s *UserService) ListIdentities(ctx context.Context, userID string) ([]domain.UserIdentity, error) {
+	identities, err := s.userRepo.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+	return identities, nil
+}
+
+// UnlinkIdentity removes a sign-in method linked to a user. The sign-up
+// identity cannot be unlinked.
+func (s *UserService) UnlinkIdentity(ctx context.Context, userID, identityID string) error {
+	if err := s.userRepo.UnlinkIdentity(ctx, identityID, userID); err != nil {
+		return fmt.Errorf("failed to unlink identity: %w", err)
+	}
+	return nil
+}
@@ -10,12 +10,27 @@ import (
 
 	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
 	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/pkg/phonenumber"
+	"github.com/SeltikHD/chameleon-vitae/pkg/weburl"
 )
 
 // UserService handles user-related use cases.
 type UserService struct {
 	userRepo     ports.UserRepository
 	authProvider ports.AuthProvider
+
+	// Records user events in the outbox, see SetEventOutbox.
+	outbox bool
+
+	// Caches profile pictures, see SetAvatarCache.
+	avatars *avatarCache
+
+	// Lists and revokes sign-in sessions, see SetSessionManager.
+	sessions ports.SessionManager
+
+	// Providers whose verified emails link sign-ins to existing accounts,
+	// see SetTrustedEmailProviders.
+	trustedEmailProviders map[string]bool
 }
 
 // NewUserService creates a new UserService with the required dependencies.
@@ -35,10 +50,28 @@ type SyncUserRequest struct {
 type SyncUserResponse struct {
 	User      *domain.User
 	IsNewUser bool
+
+	// Linked is true when the sign-in method was linked to an existing
+	// account with the same verified email.
+	Linked bool
+}
+
+// SetTrustedEmailProviders sets the sign-in providers (e.g., "google.com")
+// trusted to verify emails: SyncUser links a new sign-in method from one of
+// them to the existing account with the same email. Without it, no sign-in is
+// linked automatically.
+func (s *UserService) SetTrustedEmailProviders(providers []string) {
+	s.trustedEmailProviders = make(map[string]bool, len(providers))
+	for _, provider := range providers {
+		s.trustedEmailProviders[provider] = true
+	}
 }
 
 // SyncUser synchronizes a user from the authentication provider.
 // Creates a new user if they don't exist, or updates their information if they do.
+// A new sign-in method whose email, verified by a trusted provider, matches an
+// existing account is linked to that account; any other match returns
+// domain.ErrAccountExists.
 func (s *UserService) SyncUser(ctx context.Context, req SyncUserRequest) (*SyncUserResponse, error) {
 	// Verify the ID token and extract claims.
 	claims, err := s.authProvider.VerifyToken(ctx, req.IDToken)
@@ -53,6 +86,10 @@ func (s *UserService) SyncUser(ctx context.Context, req SyncUserRequest) (*SyncU
 	}
 
 	if existingUser != nil {
+		if existingUser.SessionRevoked(claims.IssuedAt) {
+			return nil, domain.ErrSessionRevoked
+		}
+
 		// Update existing user with latest auth info.
 		updated := false
 
@@ -66,9 +103,8 @@ func (s *UserService) SyncUser(ctx context.Context, req SyncUserRequest) (*SyncU
 			updated = true
 		}
 
-		if claims.Picture != "" && (existingUser.PictureURL == nil || *existingUser.PictureURL != claims.Picture) {
-			existingUser.PictureURL = &claims.Picture
-			existingUser.UpdatedAt = time.Now().UTC()
+		if claims.Picture != "" && (existingUser.PictureSourceURL == nil || *existingUser.PictureSourceURL != claims.Picture) {
+			s.setPicture(ctx, existingUser, claims.Picture)
 			updated = true
 		}
 
@@ -84,6 +120,31 @@ func (s *UserService) SyncUser(ctx context.Context, req SyncUserRequest) (*SyncU
 		}, nil
 	}
 
+	if claims.Email != "" {
+		owner, err := s.userRepo.GetByEmail(ctx, claims.Email)
+		if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+			return nil, fmt.Errorf("failed to check existing email: %w", err)
+		}
+		if owner != nil {
+			// Linking on an unverified email would let anyone claiming it
+			// take over the account, and so would trusting the verified
+			// flag of any issuer.
+			if !claims.EmailVerified || !s.trustedEmailProviders[claims.Provider] {
+				return nil, domain.ErrAccountExists
+			}
+			if owner.SessionRevoked(claims.IssuedAt) {
+				return nil, domain.ErrSessionRevoked
+			}
+			if _, err := s.linkIdentity(ctx, owner.ID, claims); err != nil {
+				return nil, err
+			}
+			return &SyncUserResponse{
+				User:   owner,
+				Linked: true,
+			}, nil
+		}
+	}
+
 	// Create new user.
 	newUser, err := domain.NewUser(claims.UserID)
 	if err != nil {
@@ -98,12 +159,28 @@ func (s *UserService) SyncUser(ctx context.Context, req SyncUserRequest) (*SyncU
 	}
 	if claims.Picture != "" {
 		newUser.PictureURL = &claims.Picture
+		newUser.PictureSourceURL = &claims.Picture
 	}
 
-	if err := s.userRepo.Create(ctx, newUser); err != nil {
+	if s.outbox {
+		err = s.userRepo.CreateWithEvents(ctx, newUser, []domain.OutboxEvent{
+			domain.NewUserOutboxEvent(domain.EventUserCreated, newUser),
+		})
+	} else {
+		err = s.userRepo.Create(ctx, newUser)
+	}
+	if err != nil {
 		return nil, fmt.Errorf("failed to save user: %w", err)
 	}
 
+	// The picture is cached under the user ID, known once saved.
+	if claims.Picture != "" && s.avatars != nil {
+		s.setPicture(ctx, newUser, claims.Picture)
+		if err := s.userRepo.Update(ctx, newUser); err != nil {
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+	}
+
 	return &SyncUserResponse{
 		User:      newUser,
 		IsNewUser: true,
@@ -143,8 +220,35 @@ type UpdateProfileRequest struct {
 	PreferredLanguage *string
 }
 
-// UpdateProfile updates a user's profile information.
+// UpdateProfile updates a user's profile information. Profile links are
+// normalized, and LinkedIn links must point to a profile. Phone numbers are
+// stored in the E.164 form; numbers without a country code are read in the
+// region of the user's preferred language.
 func (s *UserService) UpdateProfile(ctx context.Context, req UpdateProfileRequest) (*domain.User, error) {
+	user, err := s.updatedProfile(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return user, nil
+}
+
+// updatedProfile returns the user of a profile update with the update
+// applied and validated, ready to be saved.
+func (s *UserService) updatedProfile(ctx context.Context, req UpdateProfileRequest) (*domain.User, error) {
+	v := &domain.ValidationErrors{}
+	req.Website = normalizeURL(v, "website", req.Website, weburl.Normalize)
+	req.LinkedInURL = normalizeURL(v, "linkedin_url", req.LinkedInURL, weburl.NormalizeLinkedIn)
+	req.GitHubURL = normalizeURL(v, "github_url", req.GitHubURL, weburl.Normalize)
+	req.PortfolioURL = normalizeURL(v, "portfolio_url", req.PortfolioURL, weburl.Normalize)
+	if err := v.ToError(); err != nil {
+		return nil, err
+	}
+
 	user, err := s.userRepo.GetByID(ctx, req.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
@@ -179,7 +283,18 @@ func (s *UserService) UpdateProfile(ctx context.Context, req UpdateProfileReques
 		if *req.Phone == "" {
 			user.Phone = nil
 		} else {
-			user.Phone = req.Phone
+			language := user.PreferredLanguage
+			if req.PreferredLanguage != nil && *req.PreferredLanguage != "" {
+				language = *req.PreferredLanguage
+			}
+			number, err := phonenumber.Parse(*req.Phone, ParseLocale(language).Region())
+			if err != nil {
+				v := &domain.ValidationErrors{}
+				v.Add(domain.NewFieldError(err, "phone", err.Error()))
+				return nil, v
+			}
+			e164 := number.E164()
+			user.Phone = &e164
 		}
 	}
 	if req.Website != nil {
@@ -221,10 +336,6 @@ func (s *UserService) UpdateProfile(ctx context.Context, req UpdateProfileReques
 		return nil, err
 	}
 
-	if err := s.userRepo.Update(ctx, user); err != nil {
-		return nil, fmt.Errorf("failed to update user: %w", err)
-	}
-
 	return user, nil
 }
 
@@ -239,3 +350,18 @@ func (s *UserService) DeleteUser(ctx context.Context, userID string) error {
 	}
 	return nil
 }
+
+// normalizeURL normalizes a URL field of a request with normalize, adding a
+// field error to v when it is invalid. Nil and empty values, which leave and
+// clear the field, are returned as they are.
+func normalizeURL(v *domain.ValidationErrors, field string, value *string, normalize func(string) (string, error)) *string {
+	if value == nil || *value == "" {
+		return value
+	}
+	normalized, err := normalize(*value)
+	if err != nil {
+		v.Add(domain.NewFieldError(domain.ErrValidation, field, err.Error()))
+		return value
+	}
+	return &normalized
+}
@@ -0,0 +1,116 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// syncUserRepo is a UserRepository holding one existing account, for the
+// calls SyncUser makes on a new sign-in.
+type syncUserRepo struct {
+	ports.UserRepository
+	owner   *domain.User
+	linked  []*domain.UserIdentity
+	created []*domain.User
+}
+
+func (r *syncUserRepo) GetByFirebaseUID(context.Context, string) (*domain.User, error) {
+	return nil, domain.ErrUserNotFound
+}
+
+func (r *syncUserRepo) GetByEmail(_ context.Context, email string) (*domain.User, error) {
+	if r.owner.Email != nil && *r.owner.Email == email {
+		return r.owner, nil
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+func (r *syncUserRepo) LinkIdentity(_ context.Context, identity *domain.UserIdentity) error {
+	r.linked = append(r.linked, identity)
+	return nil
+}
+
+func (r *syncUserRepo) Create(_ context.Context, user *domain.User) error {
+	r.created = append(r.created, user)
+	return nil
+}
+
+type staticAuthProvider struct {
+	claims *ports.AuthClaims
+}
+
+func (p staticAuthProvider) VerifyToken(context.Context, string) (*ports.AuthClaims, error) {
+	return p.claims, nil
+}
+
+func (p staticAuthProvider) Close() error { return nil }
+
+func TestSyncUserLinksOnlyTrustedProviders(t *testing.T) {
+	tests := []struct {
+		name          string
+		provider      string
+		emailVerified bool
+		wantLinked    bool
+	}{
+		{name: "trusted provider", provider: "google.com", emailVerified: true, wantLinked: true},
+		{name: "unverified email", provider: "google.com", emailVerified: false},
+		{name: "untrusted issuer", provider: "evil.example.com", emailVerified: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, err := domain.NewUser("firebase-owner")
+			require.NoError(t, err)
+			owner.ID = "11111111-1111-1111-1111-111111111111"
+			owner.SetEmail("ana@example.com")
+
+			repo := &syncUserRepo{owner: owner}
+			service := services.NewUserService(repo, staticAuthProvider{claims: &ports.AuthClaims{
+				UserID:        "provider-uid",
+				Email:         "ana@example.com",
+				EmailVerified: tt.emailVerified,
+				Provider:      tt.provider,
+			}})
+			service.SetTrustedEmailProviders([]string{"google.com"})
+
+			result, err := service.SyncUser(context.Background(), services.SyncUserRequest{IDToken: "token"})
+			if !tt.wantLinked {
+				require.ErrorIs(t, err, domain.ErrAccountExists)
+				assert.Empty(t, repo.linked)
+				assert.Empty(t, repo.created)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, result.Linked)
+			assert.Equal(t, owner.ID, result.User.ID)
+			require.Len(t, repo.linked, 1)
+			assert.Equal(t, "provider-uid", repo.linked[0].ProviderUserID)
+		})
+	}
+}
+
+func TestSyncUserLinksNothingWithoutTrustedProviders(t *testing.T) {
+	owner, err := domain.NewUser("firebase-owner")
+	require.NoError(t, err)
+	owner.SetEmail("ana@example.com")
+
+	repo := &syncUserRepo{owner: owner}
+	service := services.NewUserService(repo, staticAuthProvider{claims: &ports.AuthClaims{
+		UserID:        "provider-uid",
+		Email:         "ana@example.com",
+		EmailVerified: true,
+		Provider:      "google.com",
+	}})
+
+	_, err = service.SyncUser(context.Background(), services.SyncUserRequest{IDToken: "token"})
+	require.ErrorIs(t, err, domain.ErrAccountExists)
+	assert.Empty(t, repo.linked)
+}
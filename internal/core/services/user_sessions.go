@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/ports"
+)
+
+// SetSessionManager enables listing and revoking the sign-in sessions of
+// users at the authentication provider. Without it, both return
+// domain.ErrSessionsNotSupported.
+func (s *UserService) SetSessionManager(sessions ports.SessionManager) {
+	s.sessions = sessions
+}
+
+// UserSessions describes the sign-in sessions of a user.
+type UserSessions struct {
+	// Account holds the sign-in activity known to the provider.
+	Account *ports.AuthAccount
+
+	// RevokedAt is when the user last revoked their sessions; nil if never.
+	RevokedAt *time.Time
+}
+
+// GetSessions returns the sign-in activity of a user.
+func (s *UserService) GetSessions(ctx context.Context, userID string) (*UserSessions, error) {
+	if s.sessions == nil {
+		return nil, domain.ErrSessionsNotSupported
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	account, err := s.sessions.GetAccount(ctx, user.FirebaseUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	return &UserSessions{Account: account, RevokedAt: user.SessionsRevokedAt}, nil
+}
+
+// RevokeSessions signs a user out on every device, including the one making
+// the request. The provider stops refreshing their tokens, and tokens issued
+// until now are rejected at once rather than when they expire. It returns
+// the revocation time.
+func (s *UserService) RevokeSessions(ctx context.Context, userID string) (time.Time, error) {
+	if s.sessions == nil {
+		return time.Time{}, domain.ErrSessionsNotSupported
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := s.sessions.RevokeSessions(ctx, user.FirebaseUID); err != nil {
+		return time.Time{}, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	// Tokens carry their issue time in seconds.
+	revokedAt := time.Now().UTC().Truncate(time.Second)
+	user.SessionsRevokedAt = &revokedAt
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return time.Time{}, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return revokedAt, nil
+}
@@ -0,0 +1,310 @@
+// Package fixtures provides representative resume profiles for tests: the
+// smallest resume that renders, one with every section filled, and profiles
+// that stress rendering with non-ASCII names, right-to-left text and very
+// long bullets.
+//
+// Every function returns fresh values, so tests may modify them.
+package fixtures
+
+import (
+	"strings"
+	"time"
+
+	"github.com/SeltikHD/chameleon-vitae/internal/core/domain"
+	"github.com/SeltikHD/chameleon-vitae/internal/core/services"
+)
+
+// Profile is a user with a tailored resume and the profile sections rendered
+// with it.
+type Profile struct {
+	// Name identifies the profile in test and golden file names.
+	Name string
+
+	User      *domain.User
+	Resume    *domain.Resume
+	Education []domain.Education
+	Projects  []domain.Project
+	Languages []domain.SpokenLanguage
+	Skills    []domain.Skill
+	Academic  []domain.AcademicEntry
+
+	// QRCodeImage is the data URI of the header QR code, or empty.
+	QRCodeImage string
+}
+
+// TemplateData returns the data to render the profile's resume in a locale.
+func (p Profile) TemplateData(locale services.Locale) services.ResumeTemplateData {
+	return services.ResumeTemplateData{
+		User:        p.User,
+		Resume:      p.Resume,
+		Education:   p.Education,
+		Projects:    p.Projects,
+		Languages:   p.Languages,
+		Skills:      p.Skills,
+		Academic:    p.Academic,
+		ShowSummary: true,
+		Locale:      locale,
+		QRCodeImage: p.QRCodeImage,
+	}
+}
+
+// Profiles returns every profile, in a stable order.
+func Profiles() []Profile {
+	return []Profile{Minimal(), Maximal(), NonASCII(), RTL(), LongBullets()}
+}
+
+// qrCodeImage is a tiny PNG data URI standing in for a QR code.
+const qrCodeImage = "data:image/png;base64,iVBORw0KGgo="
+
+// Minimal returns a user with only a name and an untailored resume.
+func Minimal() Profile {
+	return Profile{
+		Name:   "minimal",
+		User:   &domain.User{ID: "user-minimal", Name: ptr("Alex Doe")},
+		Resume: &domain.Resume{ID: "resume-minimal", UserID: "user-minimal", TargetLanguage: "en"},
+	}
+}
+
+// Maximal returns a user with every contact and profile section filled, and
+// markup-like text that must be escaped.
+func Maximal() Profile {
+	return Profile{
+		Name: "maximal",
+		User: &domain.User{
+			ID:           "user-maximal",
+			Name:         ptr("Ana <Souza> & Co"),
+			Headline:     ptr("Staff Backend Engineer"),
+			Email:        ptr("ana@example.com"),
+			Phone:        ptr("+55 11 99999-0000"),
+			Location:     ptr("São Paulo, Brazil"),
+			Website:      ptr("https://ana.example.com"),
+			LinkedInURL:  ptr("https://www.linkedin.com/in/ana-souza"),
+			GitHubURL:    ptr("https://github.com/anasouza"),
+			PortfolioURL: ptr("https://ana.dev/portfolio"),
+			Summary:      ptr("Backend engineer building reliable APIs"),
+		},
+		Resume: &domain.Resume{
+			ID:             "resume-maximal",
+			UserID:         "user-maximal",
+			TargetLanguage: "en",
+			QRCode:         domain.QRCodePortfolio,
+			GeneratedContent: &domain.ResumeContent{
+				Summary: "Backend engineer with **8 years** building \"reliable\" APIs & platforms",
+				Experiences: []domain.TailoredExperience{
+					{
+						ExperienceID: "exp-1",
+						Title:        "Staff Engineer",
+						Organization: "Acme & Sons",
+						StartDate:    "2021-02",
+						IsCurrent:    true,
+						Bullets: []domain.TailoredBullet{
+							{BulletID: "b1", TailoredContent: "Cut p99 latency by **40%** across <12> services"},
+							{BulletID: "b2", TailoredContent: "Led a team of **5** engineers through a database migration"},
+						},
+					},
+					{
+						ExperienceID: "exp-2",
+						Title:        "Software Engineer",
+						Organization: "Globex",
+						StartDate:    "2017-06",
+						EndDate:      ptr("2021-01"),
+						Bullets: []domain.TailoredBullet{
+							{BulletID: "b3", TailoredContent: "Built the **billing** service in Go"},
+							{BulletID: "b4", TailoredContent: "Mentored 3 interns"},
+						},
+					},
+				},
+				Skills: []string{"Go", "PostgreSQL", "Kubernetes", "Rust", "Leadership"},
+				Themes: []domain.SkillTheme{
+					{Name: "Performance", BulletIDs: []string{"b1", "b3"}},
+					{Name: "Leadership", BulletIDs: []string{"b2"}},
+				},
+			},
+		},
+		Education: []domain.Education{
+			{
+				Institution:  "University of São Paulo",
+				Degree:       "BSc",
+				FieldOfStudy: ptr("Computer Science"),
+				Location:     ptr("São Paulo"),
+				StartDate:    date(2013, time.February),
+				EndDate:      date(2016, time.December),
+				GPA:          ptr("3.8"),
+				Honors:       []string{"Magna cum laude"},
+			},
+			{Institution: "Online Institute", Degree: "MSc", EndDate: date(2026, time.December), IsExpected: true},
+		},
+		Projects: []domain.Project{
+			{
+				Name:          "Chameleon",
+				TechStack:     []string{"Go", "React"},
+				URL:           ptr("https://chameleon.dev"),
+				RepositoryURL: ptr("https://github.com/anasouza/chameleon"),
+				StartDate:     date(2023, time.March),
+				Bullets:       []domain.ProjectBullet{{Content: "Tailors resumes to **job descriptions**"}},
+			},
+			{Name: "Dotfiles"},
+		},
+		Languages: []domain.SpokenLanguage{
+			{Language: "Portuguese", Proficiency: domain.ProficiencyNative},
+			{Language: "English", Proficiency: domain.ProficiencyFluent},
+			{Language: "Spanish", Proficiency: domain.ProficiencyIntermediate},
+		},
+		Skills: []domain.Skill{
+			{Name: "Go", Category: ptr("Languages"), ProficiencyLevel: 90, YearsOfExperience: ptr(6.0)},
+			{Name: "Rust", Category: ptr("Languages"), ProficiencyLevel: 40, YearsOfExperience: ptr(0.5)},
+			{Name: "PostgreSQL", Category: ptr("Databases"), ProficiencyLevel: 80},
+			{Name: "Kubernetes", Category: ptr("Cloud"), ProficiencyLevel: 70},
+			{Name: "Leadership", Category: ptr("Soft Skills")},
+		},
+		Academic: []domain.AcademicEntry{
+			{
+				Section:      domain.AcademicPublications,
+				Title:        "Tail Latency in Microservices",
+				Organization: ptr("SIGOPS"),
+				Authors:      []string{"A. Souza", "B. Lima"},
+				URL:          ptr("https://doi.org/10.1000/xyz"),
+				EndDate:      date(2022, time.May),
+			},
+			{
+				Section:      domain.AcademicGrants,
+				Title:        "Cloud Research Credits",
+				Organization: ptr("FAPESP"),
+				Amount:       ptr("BRL 50,000"),
+				StartDate:    date(2021, time.January),
+			},
+			{Section: domain.AcademicTeaching, Title: "Distributed Systems (TA)", Organization: ptr("USP"), StartDate: date(2015, time.March)},
+			{Section: domain.AcademicService, Title: "Program Committee", Organization: ptr("GopherCon Brasil"), StartDate: date(2023, time.January)},
+		},
+		QRCodeImage: qrCodeImage,
+	}
+}
+
+// NonASCII returns a user whose names and content mix accented Latin,
+// Cyrillic and CJK scripts.
+func NonASCII() Profile {
+	return Profile{
+		Name: "non_ascii",
+		User: &domain.User{
+			ID:       "user-non-ascii",
+			Name:     ptr("Zoë Łukasiewicz-Øster 王小明"),
+			Email:    ptr("zoe@例え.jp"),
+			Location: ptr("Kraków"),
+		},
+		Resume: &domain.Resume{
+			ID:             "resume-non-ascii",
+			UserID:         "user-non-ascii",
+			TargetLanguage: "en",
+			GeneratedContent: &domain.ResumeContent{
+				Summary: "Инженер with **naïve** résumé experience and 日本語 fluency",
+				Experiences: []domain.TailoredExperience{{
+					ExperienceID: "exp-1",
+					Title:        "Ingénieure Logicielle",
+					Organization: "Société Générale Ürün GmbH",
+					StartDate:    "2020-09",
+					IsCurrent:    true,
+					Bullets: []domain.TailoredBullet{
+						{BulletID: "b1", TailoredContent: "Réduit la latence de **35 %** — «quickly»"},
+						{BulletID: "b2", TailoredContent: "構築した **データ** パイプライン"},
+					},
+				}},
+				Skills: []string{"Go", "Kotlin"},
+			},
+		},
+		Education: []domain.Education{{
+			Institution: "Uniwersytet Jagielloński",
+			Degree:      "Magister",
+			Location:    ptr("Kraków"),
+			StartDate:   date(2014, time.October),
+			EndDate:     date(2019, time.June),
+		}},
+		Languages: []domain.SpokenLanguage{
+			{Language: "Polski", Proficiency: domain.ProficiencyNative},
+			{Language: "日本語", Proficiency: domain.ProficiencyAdvanced},
+		},
+	}
+}
+
+// RTL returns a user whose names and content are in right-to-left scripts,
+// mixed with left-to-right terms.
+func RTL() Profile {
+	return Profile{
+		Name: "rtl",
+		User: &domain.User{
+			ID:    "user-rtl",
+			Name:  ptr("ليلى حداد"),
+			Email: ptr("layla@example.com"),
+			Phone: ptr("+971 50 123 4567"),
+		},
+		Resume: &domain.Resume{
+			ID:             "resume-rtl",
+			UserID:         "user-rtl",
+			TargetLanguage: "ar",
+			GeneratedContent: &domain.ResumeContent{
+				Summary: "مهندسة برمجيات مع خبرة **7 سنوات** في Go و Kubernetes",
+				Experiences: []domain.TailoredExperience{{
+					ExperienceID: "exp-1",
+					Title:        "מהנדסת תוכנה בכירה",
+					Organization: "شركة التقنية",
+					StartDate:    "2019-04",
+					IsCurrent:    true,
+					Bullets: []domain.TailoredBullet{
+						{BulletID: "b1", TailoredContent: "خفضت زمن الاستجابة بنسبة **40%** (p99)"},
+					},
+				}},
+				Skills: []string{"Go", "Kubernetes"},
+			},
+		},
+		Languages: []domain.SpokenLanguage{
+			{Language: "العربية", Proficiency: domain.ProficiencyNative},
+			{Language: "עברית", Proficiency: domain.ProficiencyFluent},
+		},
+	}
+}
+
+// LongBullets returns a user with very long bullets, words and names, which
+// must wrap rather than overflow.
+func LongBullets() Profile {
+	long := strings.Repeat("Designed, built and operated a **multi-region** event pipeline processing billions of events per day; ", 6)
+	word := strings.Repeat("Supercalifragilistic", 8)
+
+	return Profile{
+		Name: "long_bullets",
+		User: &domain.User{
+			ID:   "user-long",
+			Name: ptr("Maximilian Alexander Bartholomew von Hohenzollern-Sigmaringen"),
+		},
+		Resume: &domain.Resume{
+			ID:             "resume-long",
+			UserID:         "user-long",
+			TargetLanguage: "en",
+			GeneratedContent: &domain.ResumeContent{
+				Summary: long,
+				Experiences: []domain.TailoredExperience{{
+					ExperienceID: "exp-1",
+					Title:        "Principal Distributed Systems and Platform Reliability Engineer",
+					Organization: "International Consolidated Holdings and Subsidiaries Incorporated",
+					StartDate:    "2015-01",
+					EndDate:      ptr("2024-12"),
+					Bullets: []domain.TailoredBullet{
+						{BulletID: "b1", TailoredContent: long},
+						{BulletID: "b2", TailoredContent: word},
+					},
+				}},
+				Skills: []string{word, "Go"},
+			},
+		},
+		Projects: []domain.Project{{
+			Name:      "An Extremely Long Project Name That Keeps Going",
+			TechStack: []string{"Go", "PostgreSQL", "Kafka", "Kubernetes", "Terraform", "gRPC", "Prometheus"},
+			Bullets:   []domain.ProjectBullet{{Content: long}},
+		}},
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func date(year int, month time.Month) *domain.Date {
+	d := domain.NewMonthDate(year, month)
+	return &d
+}
@@ -0,0 +1,225 @@
+// Package circuitbreaker provides a circuit breaker for calls to external services.
+//
+// A breaker starts closed and lets every call through. After FailureThreshold
+// consecutive failures it opens and rejects calls with ErrOpen for OpenTimeout,
+// sparing callers from stacking up timeouts against a service that is down.
+// It then moves to half-open and lets HalfOpenMaxCalls probe calls through:
+// a successful probe closes the breaker, a failed one opens it again.
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned when a call is rejected because the breaker is open.
+var ErrOpen = errors.New("circuit breaker is open")
+
+// OpenError is the error returned for rejected calls. It matches ErrOpen.
+type OpenError struct {
+	// Name identifies the breaker (usually the upstream service).
+	Name string
+
+	// RetryAfter is how long until the breaker lets a probe call through.
+	RetryAfter time.Duration
+}
+
+// Error returns the error message.
+func (e *OpenError) Error() string {
+	return fmt.Sprintf("%s: %s (retry in %s)", e.Name, ErrOpen, e.RetryAfter.Round(time.Second))
+}
+
+// Is reports whether target is ErrOpen.
+func (e *OpenError) Is(target error) bool {
+	return target == ErrOpen
+}
+
+// State is the state of a breaker.
+type State int
+
+// Breaker states.
+const (
+	StateClosed State = iota
+	StateOpen
+	StateHalfOpen
+)
+
+// String returns the state name.
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// Config holds circuit breaker configuration.
+type Config struct {
+	// FailureThreshold is the number of consecutive failures that opens the breaker.
+	FailureThreshold int
+
+	// OpenTimeout is how long the breaker stays open before allowing probe calls.
+	OpenTimeout time.Duration
+
+	// HalfOpenMaxCalls is the number of concurrent probe calls allowed while half-open.
+	HalfOpenMaxCalls int
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+		HalfOpenMaxCalls: 1,
+	}
+}
+
+// Breaker is a circuit breaker. It is safe for concurrent use.
+type Breaker struct {
+	name   string
+	config Config
+	now    func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probes   int
+
+	// generation changes each time the breaker opens or moves to half-open,
+	// so the outcomes of calls admitted before are ignored.
+	generation uint64
+}
+
+// New creates a breaker. Zero config values are replaced by defaults.
+func New(name string, cfg Config) *Breaker {
+	defaults := DefaultConfig()
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = defaults.FailureThreshold
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = defaults.OpenTimeout
+	}
+	if cfg.HalfOpenMaxCalls <= 0 {
+		cfg.HalfOpenMaxCalls = defaults.HalfOpenMaxCalls
+	}
+
+	return &Breaker{
+		name:   name,
+		config: cfg,
+		now:    time.Now,
+	}
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advanceLocked()
+	return b.state
+}
+
+// Allow reports whether a call may proceed. When it returns nil the caller
+// must report the outcome exactly once by calling done, passing true when the
+// upstream service failed. Errors that are not the service's fault (invalid
+// requests, canceled contexts) should be reported as false.
+func (b *Breaker) Allow() (done func(failed bool), err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advanceLocked()
+
+	probe := false
+	switch b.state {
+	case StateOpen:
+		return nil, &OpenError{Name: b.name, RetryAfter: b.config.OpenTimeout - b.now().Sub(b.openedAt)}
+	case StateHalfOpen:
+		if b.probes >= b.config.HalfOpenMaxCalls {
+			return nil, &OpenError{Name: b.name}
+		}
+		b.probes++
+		probe = true
+	}
+
+	generation := b.generation
+	var once sync.Once
+	return func(failed bool) {
+		once.Do(func() { b.record(generation, probe, failed) })
+	}, nil
+}
+
+// Execute runs fn if the breaker allows it. Any error other than a canceled
+// context counts as an upstream failure.
+func (b *Breaker) Execute(fn func() error) error {
+	done, err := b.Allow()
+	if err != nil {
+		return err
+	}
+
+	err = fn()
+	done(err != nil && !errors.Is(err, context.Canceled))
+	return err
+}
+
+// record updates the breaker with the outcome of a call admitted in the
+// given generation, as a probe or not. Outcomes of calls admitted before the
+// breaker last opened or moved to half-open are ignored: they say nothing
+// about the upstream service since.
+func (b *Breaker) record(generation uint64, probe, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if generation != b.generation {
+		return
+	}
+
+	// A probe that finishes after another closed the breaker counts as a
+	// call made while closed.
+	if probe && b.state == StateHalfOpen {
+		b.probes--
+		if failed {
+			b.openLocked()
+		} else {
+			b.state = StateClosed
+			b.failures = 0
+		}
+		return
+	}
+
+	if !failed {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == StateClosed && b.failures >= b.config.FailureThreshold {
+		b.openLocked()
+	}
+}
+
+// openLocked opens the breaker. b.mu must be held.
+func (b *Breaker) openLocked() {
+	b.state = StateOpen
+	b.openedAt = b.now()
+	b.failures = 0
+	b.probes = 0
+	b.generation++
+}
+
+// advanceLocked moves an open breaker to half-open once its timeout has
+// elapsed. b.mu must be held.
+func (b *Breaker) advanceLocked() {
+	if b.state == StateOpen && b.now().Sub(b.openedAt) >= b.config.OpenTimeout {
+		b.state = StateHalfOpen
+		b.probes = 0
+		b.generation++
+	}
+}
@@ -0,0 +1,147 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errUpstream = errors.New("upstream failed")
+
+// newTestBreaker returns a breaker with a controllable clock.
+func newTestBreaker(cfg Config) (*Breaker, *time.Time) {
+	b := New("test", cfg)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b.now = func() time.Time { return now }
+	return b, &now
+}
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	b, _ := newTestBreaker(Config{FailureThreshold: 3, OpenTimeout: time.Minute})
+
+	fail := func() error { return errUpstream }
+
+	assert.ErrorIs(t, b.Execute(fail), errUpstream)
+	assert.ErrorIs(t, b.Execute(fail), errUpstream)
+	require.NoError(t, b.Execute(func() error { return nil }))
+	assert.Equal(t, StateClosed, b.State(), "a success resets the failure count")
+
+	for range 3 {
+		assert.ErrorIs(t, b.Execute(fail), errUpstream)
+	}
+	assert.Equal(t, StateOpen, b.State())
+
+	called := false
+	err := b.Execute(func() error { called = true; return nil })
+	assert.ErrorIs(t, err, ErrOpen)
+	assert.False(t, called)
+
+	var openErr *OpenError
+	require.ErrorAs(t, err, &openErr)
+	assert.Equal(t, "test", openErr.Name)
+	assert.Equal(t, time.Minute, openErr.RetryAfter)
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	t.Run("successful probe closes the breaker", func(t *testing.T) {
+		b, now := newTestBreaker(Config{FailureThreshold: 1, OpenTimeout: time.Minute})
+		_ = b.Execute(func() error { return errUpstream })
+		require.Equal(t, StateOpen, b.State())
+
+		*now = now.Add(time.Minute)
+		assert.Equal(t, StateHalfOpen, b.State())
+
+		done, err := b.Allow()
+		require.NoError(t, err)
+
+		_, err = b.Allow()
+		assert.ErrorIs(t, err, ErrOpen, "only one probe at a time")
+
+		done(false)
+		assert.Equal(t, StateClosed, b.State())
+	})
+
+	t.Run("failed probe reopens the breaker", func(t *testing.T) {
+		b, now := newTestBreaker(Config{FailureThreshold: 1, OpenTimeout: time.Minute})
+		_ = b.Execute(func() error { return errUpstream })
+
+		*now = now.Add(time.Minute)
+		_ = b.Execute(func() error { return errUpstream })
+		assert.Equal(t, StateOpen, b.State())
+	})
+}
+
+func TestBreakerIgnoresCanceledContext(t *testing.T) {
+	b, _ := newTestBreaker(Config{FailureThreshold: 1})
+
+	err := b.Execute(func() error { return context.Canceled })
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, StateClosed, b.State())
+}
+
+func TestBreakerDoneIsIdempotent(t *testing.T) {
+	b, _ := newTestBreaker(Config{FailureThreshold: 2})
+
+	done, err := b.Allow()
+	require.NoError(t, err)
+	done(true)
+	done(true)
+
+	assert.Equal(t, StateClosed, b.State())
+}
+
+func TestBreakerIgnoresCallsAdmittedBeforeHalfOpen(t *testing.T) {
+	b, now := newTestBreaker(Config{FailureThreshold: 1, OpenTimeout: time.Minute})
+
+	// A slow call admitted while closed.
+	slow, err := b.Allow()
+	require.NoError(t, err)
+
+	_ = b.Execute(func() error { return errUpstream })
+	*now = now.Add(time.Minute)
+	require.Equal(t, StateHalfOpen, b.State())
+
+	probe, err := b.Allow()
+	require.NoError(t, err)
+
+	// The slow call finishing neither closes the breaker nor frees a probe.
+	slow(false)
+	assert.Equal(t, StateHalfOpen, b.State())
+	_, err = b.Allow()
+	assert.ErrorIs(t, err, ErrOpen, "only one probe at a time")
+
+	probe(false)
+	assert.Equal(t, StateClosed, b.State())
+}
+
+func TestBreakerIgnoresProbesOfEarlierHalfOpen(t *testing.T) {
+	b, now := newTestBreaker(Config{FailureThreshold: 1, OpenTimeout: time.Minute, HalfOpenMaxCalls: 2})
+	_ = b.Execute(func() error { return errUpstream })
+	*now = now.Add(time.Minute)
+
+	first, err := b.Allow()
+	require.NoError(t, err)
+	second, err := b.Allow()
+	require.NoError(t, err)
+
+	// The first probe reopens the breaker; the second finishes once it is
+	// half-open again and must not take the place of a new probe.
+	first(true)
+	require.Equal(t, StateOpen, b.State())
+	*now = now.Add(time.Minute)
+	require.Equal(t, StateHalfOpen, b.State())
+
+	second(false)
+	assert.Equal(t, StateHalfOpen, b.State())
+
+	for range 2 {
+		_, err = b.Allow()
+		require.NoError(t, err)
+	}
+	_, err = b.Allow()
+	assert.ErrorIs(t, err, ErrOpen)
+}
@@ -0,0 +1,65 @@
+// Package csvexport writes CSV files meant to be opened in spreadsheets.
+//
+// Files start with a UTF-8 byte order mark, without which Excel reads them
+// as the system code page and garbles accented names. Cells that a
+// spreadsheet would evaluate as a formula, such as "=HYPERLINK(...)" or
+// "+1-555", are prefixed with an apostrophe so that they are shown as typed
+// (CSV injection).
+package csvexport
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// ContentType is the media type of CSV files.
+const ContentType = "text/csv; charset=utf-8"
+
+// byteOrderMark is the UTF-8 encoding of U+FEFF.
+const byteOrderMark = "\ufeff"
+
+// Writer writes the rows of a CSV file after its header.
+type Writer struct {
+	csv *csv.Writer
+}
+
+// NewWriter writes the byte order mark and the header row to w, and
+// returns a Writer for the rows.
+func NewWriter(w io.Writer, header ...string) (*Writer, error) {
+	if _, err := io.WriteString(w, byteOrderMark); err != nil {
+		return nil, err
+	}
+
+	writer := &Writer{csv: csv.NewWriter(w)}
+	if err := writer.Write(header...); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// Write writes a row, neutralizing cells that would be read as formulas.
+// Rows are buffered; call Flush once done.
+func (w *Writer) Write(cells ...string) error {
+	row := make([]string, len(cells))
+	for i, cell := range cells {
+		row[i] = Sanitize(cell)
+	}
+	return w.csv.Write(row)
+}
+
+// Flush writes the buffered rows and reports any error from writing them.
+func (w *Writer) Flush() error {
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// Sanitize prefixes a cell with an apostrophe when spreadsheets would
+// evaluate it as a formula, that is when it starts with =, +, -, @, a tab or
+// a carriage return.
+func Sanitize(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
@@ -0,0 +1,45 @@
+package csvexport
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	w, err := NewWriter(&buf, "company", "title")
+	require.NoError(t, err)
+	require.NoError(t, w.Write("Acme, Inc.", "Engenheiro de Software"))
+	require.NoError(t, w.Write("=HYPERLINK(\"http://evil\")", "Said \"hi\""))
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t, "\ufeffcompany,title\n"+
+		"\"Acme, Inc.\",Engenheiro de Software\n"+
+		"\"'=HYPERLINK(\"\"http://evil\"\")\",\"Said \"\"hi\"\"\"\n",
+		buf.String())
+}
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"", ""},
+		{"Acme", "Acme"},
+		{"85", "85"},
+		{"=1+1", "'=1+1"},
+		{"+1-555-0100", "'+1-555-0100"},
+		{"-2", "'-2"},
+		{"@SUM(A1)", "'@SUM(A1)"},
+		{"\tcmd", "'\tcmd"},
+		{"a=b", "a=b"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, Sanitize(tt.in), "input %q", tt.in)
+	}
+}
@@ -0,0 +1,97 @@
+// Package filename prepares file names for download headers.
+//
+// Browsers disagree on non-ASCII characters in the plain filename parameter
+// of Content-Disposition, so ContentDisposition sends an ASCII spelling of
+// the name there and the exact UTF-8 name in the RFC 5987 filename*
+// parameter, which every current browser prefers when present.
+package filename
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// letters holds the ASCII spelling of letters that do not decompose into a
+// base letter and combining marks.
+var letters = map[rune]string{
+	'ß': "ss", 'ẞ': "SS", 'Æ': "AE", 'æ': "ae", 'Œ': "OE", 'œ': "oe",
+	'Ø': "O", 'ø': "o", 'Þ': "TH", 'þ': "th", 'Ð': "D", 'ð': "d",
+	'Đ': "D", 'đ': "d", 'Ħ': "H", 'ħ': "h", 'Ł': "L", 'ł': "l",
+	'Ŀ': "L", 'ŀ': "l", 'ı': "i", 'Ĳ': "IJ", 'ĳ': "ij", 'Ŧ': "T", 'ŧ': "t",
+	'‘': "'", '’': "'", '‚': "'", '“': "\"", '”': "\"", '„': "\"",
+	'–': "-", '—': "-", '…': "...",
+}
+
+// Transliterate spells s with ASCII characters. Accented letters lose their
+// accents ("José" becomes "Jose"), ligatures and special letters are spelled
+// out ("Straße" becomes "Strasse"), Unicode spaces become spaces and anything
+// else without an ASCII spelling is dropped.
+func Transliterate(s string) string {
+	var sb strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case r < 0x80:
+			sb.WriteRune(r)
+		case unicode.Is(unicode.Mn, r):
+			// A combining mark, such as the accent split off a letter.
+		case unicode.IsSpace(r):
+			sb.WriteByte(' ')
+		default:
+			sb.WriteString(letters[r])
+		}
+	}
+	return sb.String()
+}
+
+// ContentDisposition formats a Content-Disposition header value, such as
+// "attachment", for a file named name. Names with non-ASCII characters get
+// an ASCII fallback in filename and the exact name in filename*.
+func ContentDisposition(disposition, name string) string {
+	fallback := asciiFallback(name)
+	value := disposition + `; filename="` + fallback + `"`
+	if fallback != name {
+		value += "; filename*=UTF-8''" + encodeExtValue(name)
+	}
+	return value
+}
+
+// asciiFallback transliterates name and replaces the characters that cannot
+// appear in a quoted string.
+func asciiFallback(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7F || r == '"' || r == '\\' {
+			return '_'
+		}
+		return r
+	}, Transliterate(name))
+}
+
+// encodeExtValue percent-encodes s as an RFC 5987 ext-value, leaving only
+// attr-chars unescaped.
+func encodeExtValue(s string) string {
+	const hex = "0123456789ABCDEF"
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isAttrChar(c) {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteByte('%')
+		sb.WriteByte(hex[c>>4])
+		sb.WriteByte(hex[c&0x0F])
+	}
+	return sb.String()
+}
+
+// isAttrChar reports whether c is an RFC 5987 attr-char.
+func isAttrChar(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$&+-.^_`|~", c) >= 0
+}
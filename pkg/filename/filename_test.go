@@ -0,0 +1,46 @@
+package filename
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransliterate(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected string
+	}{
+		{"Jane_Doe_Resume.pdf", "Jane_Doe_Resume.pdf"},
+		{"José_Conceição", "Jose_Conceicao"},
+		{"Ærøskøbing_Straße", "AEroskobing_Strasse"},
+		{"Łódź", "Lodz"},
+		{"Zoë Brontë", "Zoe Bronte"},
+		// Decomposed input: "e" followed by a combining acute accent.
+		{"Renée", "Renee"},
+		{"李雷_Resume", "_Resume"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, Transliterate(tt.in), "input %q", tt.in)
+	}
+}
+
+func TestContentDisposition(t *testing.T) {
+	t.Run("ASCII names have no filename*", func(t *testing.T) {
+		assert.Equal(t, `attachment; filename="Jane_Doe_Resume.pdf"`,
+			ContentDisposition("attachment", "Jane_Doe_Resume.pdf"))
+	})
+
+	t.Run("non-ASCII names", func(t *testing.T) {
+		assert.Equal(t,
+			`inline; filename="Jose_Resume.pdf"; filename*=UTF-8''Jos%C3%A9_Resume.pdf`,
+			ContentDisposition("inline", "José_Resume.pdf"))
+	})
+
+	t.Run("quotes and spaces", func(t *testing.T) {
+		assert.Equal(t,
+			`attachment; filename="CV _O'Brien_ e.pdf"; filename*=UTF-8''CV%20%22O%27Brien%22%20%C3%A9.pdf`,
+			ContentDisposition("attachment", `CV "O'Brien" é.pdf`))
+	})
+}
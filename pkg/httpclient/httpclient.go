@@ -0,0 +1,166 @@
+// Package httpclient builds HTTP clients for the external service adapters.
+//
+// All clients created by a Factory share one transport, so connection pooling,
+// proxy and TLS settings are configured once and apply to every adapter, while
+// each adapter keeps its own request timeout.
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// Config holds shared HTTP transport configuration.
+type Config struct {
+	// Timeout is the request timeout for clients that don't set their own.
+	Timeout time.Duration
+
+	// ProxyURL routes all requests through this proxy. When empty the
+	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.
+	ProxyURL string
+
+	// CAFiles are PEM files with root CAs trusted in addition to the system pool.
+	CAFiles []string
+
+	// MaxIdleConns caps idle connections across all hosts.
+	MaxIdleConns int
+
+	// MaxIdleConnsPerHost caps idle connections kept per host.
+	MaxIdleConnsPerHost int
+
+	// MaxConnsPerHost caps connections per host. Zero means no limit.
+	MaxConnsPerHost int
+
+	// IdleConnTimeout is how long an idle connection is kept in the pool.
+	IdleConnTimeout time.Duration
+
+	// DialTimeout is the TCP connect timeout.
+	DialTimeout time.Duration
+
+	// TLSHandshakeTimeout is the TLS handshake timeout.
+	TLSHandshakeTimeout time.Duration
+}
+
+// DefaultConfig returns a Config with sensible defaults.
+func DefaultConfig() Config {
+	return Config{
+		Timeout:             30 * time.Second,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+		DialTimeout:         10 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+}
+
+// Factory creates HTTP clients sharing a single transport.
+type Factory struct {
+	config    Config
+	transport *http.Transport
+}
+
+// New creates a Factory. Zero config values are replaced by defaults.
+func New(cfg Config) (*Factory, error) {
+	defaults := DefaultConfig()
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+	if cfg.MaxIdleConns == 0 {
+		cfg.MaxIdleConns = defaults.MaxIdleConns
+	}
+	if cfg.MaxIdleConnsPerHost == 0 {
+		cfg.MaxIdleConnsPerHost = defaults.MaxIdleConnsPerHost
+	}
+	if cfg.IdleConnTimeout == 0 {
+		cfg.IdleConnTimeout = defaults.IdleConnTimeout
+	}
+	if cfg.DialTimeout == 0 {
+		cfg.DialTimeout = defaults.DialTimeout
+	}
+	if cfg.TLSHandshakeTimeout == 0 {
+		cfg.TLSHandshakeTimeout = defaults.TLSHandshakeTimeout
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("httpclient: invalid proxy URL %q", cfg.ProxyURL)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(cfg.CAFiles) > 0 {
+		pool, err := loadCertPool(cfg.CAFiles)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   cfg.DialTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+
+	return &Factory{
+		config: cfg,
+		transport: &http.Transport{
+			Proxy:                 proxy,
+			DialContext:           dialer.DialContext,
+			TLSClientConfig:       tlsConfig,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          cfg.MaxIdleConns,
+			MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+			MaxConnsPerHost:       cfg.MaxConnsPerHost,
+			IdleConnTimeout:       cfg.IdleConnTimeout,
+			TLSHandshakeTimeout:   cfg.TLSHandshakeTimeout,
+			ExpectContinueTimeout: time.Second,
+		},
+	}, nil
+}
+
+// Client returns a client using the shared transport. A zero timeout uses
+// the factory's default.
+func (f *Factory) Client(timeout time.Duration) *http.Client {
+	if timeout == 0 {
+		timeout = f.config.Timeout
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: f.transport,
+	}
+}
+
+// Close closes idle connections held by the shared transport.
+func (f *Factory) Close() {
+	f.transport.CloseIdleConnections()
+}
+
+// loadCertPool returns the system cert pool extended with the given PEM files.
+func loadCertPool(files []string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	for _, file := range files {
+		pem, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("httpclient: failed to read CA file: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("httpclient: no certificates found in %s", file)
+		}
+	}
+
+	return pool, nil
+}
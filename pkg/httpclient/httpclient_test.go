@@ -0,0 +1,82 @@
+package httpclient
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		f, err := New(Config{})
+		require.NoError(t, err)
+
+		assert.Equal(t, DefaultConfig().MaxIdleConns, f.transport.MaxIdleConns)
+		assert.Equal(t, DefaultConfig().Timeout, f.Client(0).Timeout)
+	})
+
+	t.Run("rejects invalid proxy URL", func(t *testing.T) {
+		_, err := New(Config{ProxyURL: "not a url"})
+		assert.Error(t, err)
+	})
+
+	t.Run("uses configured proxy", func(t *testing.T) {
+		f, err := New(Config{ProxyURL: "http://proxy.internal:3128"})
+		require.NoError(t, err)
+
+		req, _ := http.NewRequest(http.MethodGet, "https://api.groq.com", nil)
+		proxyURL, err := f.transport.Proxy(req)
+		require.NoError(t, err)
+		assert.Equal(t, "proxy.internal:3128", proxyURL.Host)
+	})
+
+	t.Run("rejects CA file without certificates", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(path, []byte("garbage"), 0o600))
+
+		_, err := New(Config{CAFiles: []string{path}})
+		assert.Error(t, err)
+	})
+}
+
+func TestClientSharesTransport(t *testing.T) {
+	f, err := New(Config{})
+	require.NoError(t, err)
+
+	a := f.Client(5 * time.Second)
+	b := f.Client(time.Minute)
+
+	assert.Same(t, a.Transport, b.Transport)
+	assert.Equal(t, 5*time.Second, a.Timeout)
+	assert.Equal(t, time.Minute, b.Timeout)
+}
+
+func TestClientTrustsCustomCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(path, certPEM, 0o600))
+
+	untrusted, err := New(Config{})
+	require.NoError(t, err)
+	_, err = untrusted.Client(0).Get(server.URL)
+	assert.Error(t, err)
+
+	trusted, err := New(Config{CAFiles: []string{path}})
+	require.NoError(t, err)
+	resp, err := trusted.Client(0).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
@@ -0,0 +1,108 @@
+// Package langdetect detects the language of a text, such as a job
+// description, from the frequency of its function words.
+//
+// Function words (articles, prepositions, pronouns) make up a large share
+// of any text and differ between languages, so counting them tells apart
+// English, Portuguese, Spanish, French and German reliably for texts of a
+// few sentences. Short or mixed texts are reported as undetected rather
+// than guessed.
+package langdetect
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Languages detected, as ISO 639-1 codes.
+const (
+	English    = "en"
+	Portuguese = "pt"
+	Spanish    = "es"
+	French     = "fr"
+	German     = "de"
+)
+
+// minHits is the fewest function words of the detected language a text must
+// have.
+const minHits = 3
+
+// minLead is how many times more function words the detected language must
+// have than the runner-up.
+const minLead = 1.5
+
+// functionWords are frequent words of each language, chosen to be rare in
+// the others. Words shared by several languages count for each.
+var functionWords = map[string][]string{
+	English: {
+		"a", "the", "and", "of", "to", "with", "for", "you", "our", "we", "will",
+		"is", "are", "in", "on", "your", "this", "that", "be", "an", "as",
+		"experience", "skills", "team", "or", "have", "about",
+	},
+	Portuguese: {
+		"o", "a", "e", "de", "do", "da", "dos", "das", "em", "no", "na",
+		"nos", "nas", "para", "com", "um", "uma", "os", "as", "ao", "você",
+		"nossa", "nosso", "que", "não", "será", "são", "experiência",
+		"conhecimento", "vaga", "ou", "pelo", "pela", "também",
+	},
+	Spanish: {
+		"de", "del", "el", "la", "los", "las", "en", "y", "para", "con",
+		"un", "una", "que", "por", "nuestro", "nuestra", "usted", "será",
+		"son", "experiencia", "conocimiento", "puesto", "o", "también",
+	},
+	French: {
+		"le", "la", "les", "des", "du", "de", "et", "en", "pour", "avec",
+		"un", "une", "vous", "nous", "notre", "est", "sont", "que", "dans",
+		"sur", "expérience", "compétences", "équipe", "ou", "au", "aux",
+	},
+	German: {
+		"der", "die", "das", "und", "mit", "für", "von", "zu", "im", "in",
+		"ein", "eine", "sie", "wir", "unser", "unsere", "ist", "sind",
+		"auf", "oder", "erfahrung", "kenntnisse", "bei", "den", "dem",
+	},
+}
+
+// languages lists the languages in a fixed order, to break ties the same
+// way every time.
+var languages = []string{English, Portuguese, Spanish, French, German}
+
+// wordLanguages maps each function word to the languages it belongs to.
+var wordLanguages = func() map[string][]string {
+	index := make(map[string][]string)
+	for _, lang := range languages {
+		for _, word := range functionWords[lang] {
+			index[word] = append(index[word], lang)
+		}
+	}
+	return index
+}()
+
+// Detect returns the ISO 639-1 code of the language text is written in, or
+// "" when it has too few function words or they do not clearly favor one
+// language.
+func Detect(text string) string {
+	hits := make(map[string]int, len(languages))
+	for _, field := range strings.Fields(strings.ToLower(text)) {
+		word := strings.TrimFunc(field, func(r rune) bool { return !unicode.IsLetter(r) })
+		for _, lang := range wordLanguages[word] {
+			hits[lang]++
+		}
+	}
+
+	best, runnerUp := "", 0
+	for _, lang := range languages {
+		switch {
+		case best == "" || hits[lang] > hits[best]:
+			if best != "" {
+				runnerUp = hits[best]
+			}
+			best = lang
+		case hits[lang] > runnerUp:
+			runnerUp = hits[lang]
+		}
+	}
+
+	if hits[best] < minHits || float64(hits[best]) < minLead*float64(runnerUp) {
+		return ""
+	}
+	return best
+}
@@ -0,0 +1,57 @@
+package langdetect_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/pkg/langdetect"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "english",
+			text: "We are looking for a Senior Backend Engineer to join our team. You will design and build APIs with Go and PostgreSQL.",
+			want: langdetect.English,
+		},
+		{
+			name: "portuguese",
+			text: "Estamos em busca de uma pessoa desenvolvedora backend para o nosso time. Você será responsável pela construção de APIs em Go, com experiência em PostgreSQL.",
+			want: langdetect.Portuguese,
+		},
+		{
+			name: "spanish",
+			text: "Buscamos un ingeniero backend para nuestro equipo. El puesto requiere experiencia con Go y conocimiento de las bases de datos del producto.",
+			want: langdetect.Spanish,
+		},
+		{
+			name: "french",
+			text: "Nous recherchons un développeur backend pour rejoindre notre équipe. Vous serez responsable des API et de la base de données avec Go.",
+			want: langdetect.French,
+		},
+		{
+			name: "german",
+			text: "Wir suchen einen Backend-Entwickler für unser Team. Sie sind verantwortlich für die APIs und haben Erfahrung mit Go und der Datenbank.",
+			want: langdetect.German,
+		},
+		{
+			name: "markdown and punctuation",
+			text: "## Requirements\n\n- **Experience** with Go\n- Knowledge of the AWS stack, and your own tools",
+			want: langdetect.English,
+		},
+		{name: "too short", text: "Go, Kubernetes, AWS", want: ""},
+		{name: "mixed", text: "The team and the APIs. O time e a experiência em APIs.", want: ""},
+		{name: "empty", text: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, langdetect.Detect(tt.text))
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// Package phonenumber parses phone numbers into the E.164 form and formats
+// them for display.
+//
+// Numbers of the regions the application has resume locales for are checked
+// and formatted by their national rules: in the national format for readers
+// in the same region, such as (11) 99999-9999 in Brazil, and in the
+// international format for everyone else, such as +55 11 99999-9999. Numbers
+// of other countries are accepted in international form and kept as dialed.
+package phonenumber
+
+import (
+	"errors"
+	"strings"
+)
+
+// Errors returned for numbers that cannot be parsed.
+var (
+	// ErrInvalid is returned for text that is not a phone number, or for
+	// numbers with the wrong number of digits for their region.
+	ErrInvalid = errors.New("must be a valid phone number")
+
+	// ErrNoRegion is returned for numbers without a country code when no
+	// region is given to read them in.
+	ErrNoRegion = errors.New("must start with the country code, such as +1 or +55")
+)
+
+// Number lengths allowed by E.164 for numbers of unknown regions, counting
+// the country code.
+const (
+	minDigits = 8
+	maxDigits = 15
+)
+
+// style is the way a region writes its numbers.
+type style int
+
+const (
+	// styleSpaced separates the groups with spaces, after the trunk prefix
+	// in the national format: 06 12 34 56 78.
+	styleSpaced style = iota
+
+	// styleParens puts the area code in parentheses and joins the last two
+	// groups with a hyphen in the national format, (11) 99999-9999, but not
+	// in the international format: +55 11 99999-9999.
+	styleParens
+
+	// styleNANP is the North American style: (555) 123-4567 in the national
+	// format and +1 555-123-4567 in the international format.
+	styleNANP
+)
+
+// region holds the numbering rules of a region.
+type region struct {
+	countryCode string
+
+	// trunk is the prefix dialed before national numbers within the region,
+	// dropped in the international format. National numbers never start
+	// with it.
+	trunk string
+
+	// groups holds the digit groups of national numbers by their length;
+	// the lengths are the valid ones.
+	groups map[int][]int
+
+	style style
+}
+
+// regions holds the numbering rules by ISO 3166 region code.
+var regions = map[string]region{
+	"US": {countryCode: "1", trunk: "1", groups: map[int][]int{10: {3, 3, 4}}, style: styleNANP},
+	"BR": {countryCode: "55", trunk: "0", groups: map[int][]int{10: {2, 4, 4}, 11: {2, 5, 4}}, style: styleParens},
+	"PT": {countryCode: "351", groups: map[int][]int{9: {3, 3, 3}}},
+	"ES": {countryCode: "34", groups: map[int][]int{9: {3, 2, 2, 2}}},
+	"FR": {countryCode: "33", trunk: "0", groups: map[int][]int{9: {1, 2, 2, 2, 2}}},
+	"DE": {countryCode: "49", trunk: "0", groups: map[int][]int{
+		// German area codes vary in length, so numbers are not grouped.
+		6: {6}, 7: {7}, 8: {8}, 9: {9}, 10: {10}, 11: {11}, 12: {12}, 13: {13},
+	}},
+	"GB": {countryCode: "44", trunk: "0", groups: map[int][]int{10: {4, 6}}},
+}
+
+// Number is a parsed phone number.
+type Number struct {
+	// Region is the ISO 3166 code of the number's region, or "" for numbers
+	// of regions without numbering rules here.
+	Region string
+
+	// CountryCode is the calling code of the region, "" when Region is "".
+	CountryCode string
+
+	// National holds the digits after the country code, or all the digits
+	// when Region is "".
+	National string
+}
+
+// Parse parses a phone number written in any common format, with spaces,
+// hyphens, dots, slashes or parentheses. Numbers starting with + or 00 are
+// international; others are read as national numbers of defaultRegion, an
+// ISO 3166 code such as "BR".
+func Parse(raw, defaultRegion string) (Number, error) {
+	digits, international, err := cleanDigits(raw)
+	if err != nil {
+		return Number{}, err
+	}
+
+	if !international {
+		r, ok := regions[strings.ToUpper(defaultRegion)]
+		if !ok {
+			return Number{}, ErrNoRegion
+		}
+		return national(strings.ToUpper(defaultRegion), r, digits)
+	}
+
+	// No country code here is a prefix of another assigned one.
+	for code, r := range regions {
+		if rest, ok := strings.CutPrefix(digits, r.countryCode); ok {
+			return national(code, r, rest)
+		}
+	}
+	if len(digits) < minDigits || len(digits) > maxDigits {
+		return Number{}, ErrInvalid
+	}
+	return Number{National: digits}, nil
+}
+
+// cleanDigits returns the digits of a phone number, and whether it was
+// written with an international prefix.
+func cleanDigits(raw string) (string, bool, error) {
+	raw = strings.TrimSpace(raw)
+	international := false
+	if rest, ok := strings.CutPrefix(raw, "+"); ok {
+		raw, international = rest, true
+	}
+
+	var sb strings.Builder
+	for _, r := range raw {
+		switch {
+		case r >= '0' && r <= '9':
+			sb.WriteRune(r)
+		case strings.ContainsRune(" -./()", r):
+		default:
+			return "", false, ErrInvalid
+		}
+	}
+
+	digits := sb.String()
+	if rest, ok := strings.CutPrefix(digits, "00"); ok && !international {
+		digits, international = rest, true
+	}
+	if digits == "" {
+		return "", false, ErrInvalid
+	}
+	return digits, international, nil
+}
+
+// national checks digits as a national number of a region, with or without
+// its trunk prefix.
+func national(code string, r region, digits string) (Number, error) {
+	digits = strings.TrimPrefix(digits, r.trunk)
+	if _, ok := r.groups[len(digits)]; !ok {
+		return Number{}, ErrInvalid
+	}
+	return Number{Region: code, CountryCode: r.countryCode, National: digits}, nil
+}
+
+// E164 returns the number in the E.164 form, such as +5511999999999.
+func (n Number) E164() string {
+	return "+" + n.CountryCode + n.National
+}
+
+// Format returns the number for display to readers in region: in the
+// national format when the number is from that region, and in the
+// international format otherwise.
+func (n Number) Format(region string) string {
+	r, ok := regions[n.Region]
+	if !ok {
+		return n.E164()
+	}
+
+	groups := splitGroups(n.National, r.groups[len(n.National)])
+	if !strings.EqualFold(region, n.Region) {
+		switch r.style {
+		case styleParens:
+			return "+" + r.countryCode + " " + groups[0] + " " + strings.Join(groups[1:], "-")
+		case styleNANP:
+			return "+" + r.countryCode + " " + strings.Join(groups, "-")
+		}
+		return "+" + r.countryCode + " " + strings.Join(groups, " ")
+	}
+
+	if r.style == styleSpaced {
+		return r.trunk + strings.Join(groups, " ")
+	}
+	return "(" + groups[0] + ") " + strings.Join(groups[1:], "-")
+}
+
+// splitGroups splits digits into groups of the given sizes.
+func splitGroups(digits string, sizes []int) []string {
+	groups := make([]string, 0, len(sizes))
+	for _, size := range sizes {
+		groups = append(groups, digits[:size])
+		digits = digits[size:]
+	}
+	return groups
+}
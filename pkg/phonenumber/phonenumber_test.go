@@ -0,0 +1,74 @@
+package phonenumber
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		raw      string
+		region   string
+		expected string
+		err      error
+	}{
+		{"(11) 99999-9999", "BR", "+5511999999999", nil},
+		{"011 3333-4444", "BR", "+551133334444", nil},
+		{"+55 11 99999-9999", "", "+5511999999999", nil},
+		{"0055 11 99999 9999", "US", "+5511999999999", nil},
+		{"+1-555-123-4567", "BR", "+15551234567", nil},
+		{"(555) 123-4567", "us", "+15551234567", nil},
+		{"1 555 123 4567", "US", "+15551234567", nil},
+		{"06 12 34 56 78", "FR", "+33612345678", nil},
+		{"+44 (0)7911 123456", "", "+447911123456", nil},
+		{"030 1234567", "DE", "+49301234567", nil},
+		{"+81 3-1234-5678", "", "+81312345678", nil},
+		{"99999-9999", "", "", ErrNoRegion},
+		{"99999-9999", "BR", "", ErrInvalid},
+		{"+55 11 9999", "", "", ErrInvalid},
+		{"call me", "US", "", ErrInvalid},
+		{"+", "", "", ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			number, err := Parse(tt.raw, tt.region)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, number.E164())
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		e164     string
+		region   string
+		expected string
+	}{
+		{"+5511999999999", "BR", "(11) 99999-9999"},
+		{"+551133334444", "BR", "(11) 3333-4444"},
+		{"+5511999999999", "US", "+55 11 99999-9999"},
+		{"+15551234567", "US", "(555) 123-4567"},
+		{"+15551234567", "BR", "+1 555-123-4567"},
+		{"+33612345678", "FR", "06 12 34 56 78"},
+		{"+33612345678", "ES", "+33 6 12 34 56 78"},
+		{"+351912345678", "PT", "912 345 678"},
+		{"+447911123456", "GB", "07911 123456"},
+		{"+49301234567", "US", "+49 301234567"},
+		{"+81312345678", "US", "+81312345678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.e164+" in "+tt.region, func(t *testing.T) {
+			number, err := Parse(tt.e164, "")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, number.Format(tt.region))
+		})
+	}
+}
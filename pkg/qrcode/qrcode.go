@@ -0,0 +1,558 @@
+// Package qrcode encodes short texts, such as URLs, as QR codes.
+//
+// Texts are encoded in byte mode with error correction level M, which
+// recovers from about 15% damage, using the smallest of versions 1 to 10
+// that fits them (up to 213 bytes). Codes can be rendered as SVG, which
+// stays sharp at any print size.
+package qrcode
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxLength is the maximum number of bytes that can be encoded.
+const MaxLength = 213
+
+// ErrTooLong is returned for texts longer than MaxLength bytes.
+var ErrTooLong = errors.New("qrcode: text too long")
+
+// quietZone is the light border, in modules, required around a code.
+const quietZone = 4
+
+// version describes the error correction blocks of a QR code version at
+// level M. Blocks of the second group hold one more data codeword.
+type version struct {
+	ecPerBlock int
+	blocks1    int
+	data1      int
+	blocks2    int
+	data2      int
+
+	// alignment holds the centers of the alignment patterns on each axis.
+	alignment []int
+
+	// remainder is the number of unused bits after the codewords.
+	remainder int
+}
+
+// versions holds versions 1 to 10 at level M, indexed by version - 1.
+var versions = []version{
+	{10, 1, 16, 0, 0, nil, 0},
+	{16, 1, 28, 0, 0, []int{6, 18}, 7},
+	{26, 1, 44, 0, 0, []int{6, 22}, 7},
+	{18, 2, 32, 0, 0, []int{6, 26}, 7},
+	{24, 2, 43, 0, 0, []int{6, 30}, 7},
+	{16, 4, 27, 0, 0, []int{6, 34}, 7},
+	{18, 4, 31, 0, 0, []int{6, 22, 38}, 0},
+	{22, 2, 38, 2, 39, []int{6, 24, 42}, 0},
+	{22, 3, 36, 2, 37, []int{6, 26, 46}, 0},
+	{26, 4, 43, 1, 44, []int{6, 28, 50}, 0},
+}
+
+// dataCodewords returns the number of data codewords of the version.
+func (v version) dataCodewords() int {
+	return v.blocks1*v.data1 + v.blocks2*v.data2
+}
+
+// Code is an encoded QR code.
+type Code struct {
+	// Version is the QR code version, from 1 to 10.
+	Version int
+
+	// Size is the width and height of the code in modules, without the
+	// quiet zone.
+	Size int
+
+	modules    [][]bool
+	isFunction [][]bool
+}
+
+// Encode encodes text as a QR code.
+func Encode(text string) (*Code, error) {
+	data := []byte(text)
+	if len(data) > MaxLength {
+		return nil, fmt.Errorf("%w: %d bytes, at most %d", ErrTooLong, len(data), MaxLength)
+	}
+
+	number := 1
+	for ; number <= len(versions); number++ {
+		if len(data) <= maxBytes(number) {
+			break
+		}
+	}
+	v := versions[number-1]
+
+	size := 17 + 4*number
+	c := &Code{
+		Version:    number,
+		Size:       size,
+		modules:    make([][]bool, size),
+		isFunction: make([][]bool, size),
+	}
+	for i := range size {
+		c.modules[i] = make([]bool, size)
+		c.isFunction[i] = make([]bool, size)
+	}
+
+	c.drawFunctionPatterns(v)
+	c.drawCodewords(interleave(v, encodeData(number, v, data)))
+
+	// Keep the mask with the lowest penalty.
+	best, bestPenalty := 0, -1
+	for mask := range 8 {
+		c.applyMask(mask)
+		c.drawFormatBits(mask)
+		if penalty := c.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			best, bestPenalty = mask, penalty
+		}
+		c.applyMask(mask) // Masks are their own inverse.
+	}
+	c.applyMask(best)
+	c.drawFormatBits(best)
+
+	return c, nil
+}
+
+// maxBytes returns how many bytes a version holds in byte mode.
+func maxBytes(number int) int {
+	headerBits := 4 + countBits(number)
+	return (versions[number-1].dataCodewords()*8 - headerBits) / 8
+}
+
+// countBits returns the length in bits of the byte mode character count.
+func countBits(number int) int {
+	if number < 10 {
+		return 8
+	}
+	return 16
+}
+
+// Dark reports whether the module at row y and column x is dark. Modules in
+// the quiet zone, outside of the code, are light.
+func (c *Code) Dark(x, y int) bool {
+	if x < 0 || y < 0 || x >= c.Size || y >= c.Size {
+		return false
+	}
+	return c.modules[y][x]
+}
+
+// SVG renders the code, with its quiet zone, as an SVG image where each
+// module is one user unit.
+func (c *Code) SVG() string {
+	var path strings.Builder
+	for y := range c.Size {
+		for x := range c.Size {
+			if c.modules[y][x] {
+				fmt.Fprintf(&path, "M%d,%dh1v1h-1z", x+quietZone, y+quietZone)
+			}
+		}
+	}
+
+	dim := c.Size + 2*quietZone
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+
+		`<rect width="100%%" height="100%%" fill="#fff"/><path d="%s" fill="#000"/></svg>`,
+		dim, dim, path.String())
+}
+
+// DataURI returns the SVG rendering as a base64 data URI, ready for an img src.
+func (c *Code) DataURI() string {
+	return "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString([]byte(c.SVG()))
+}
+
+// Encoder encodes texts as QR code data URIs. It implements the QR code
+// encoder port of the resume service.
+type Encoder struct{}
+
+// DataURI encodes text and returns its SVG rendering as a data URI.
+func (Encoder) DataURI(text string) (string, error) {
+	code, err := Encode(text)
+	if err != nil {
+		return "", err
+	}
+	return code.DataURI(), nil
+}
+
+// encodeData builds the data codewords: the byte mode header, the data, a
+// terminator and padding.
+func encodeData(number int, v version, data []byte) []byte {
+	var bits bitBuffer
+	bits.append(0b0100, 4)
+	bits.append(len(data), countBits(number))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+
+	capacity := v.dataCodewords() * 8
+	bits.append(0, min(4, capacity-len(bits)))
+	bits.append(0, (8-len(bits)%8)%8)
+	for pad := 0xEC; len(bits) < capacity; pad ^= 0xEC ^ 0x11 {
+		bits.append(pad, 8)
+	}
+
+	codewords := make([]byte, len(bits)/8)
+	for i, bit := range bits {
+		if bit {
+			codewords[i/8] |= 1 << (7 - i%8)
+		}
+	}
+	return codewords
+}
+
+// interleave splits the data codewords into blocks, computes each block's
+// error correction codewords and interleaves them all.
+func interleave(v version, data []byte) []byte {
+	var blocks [][]byte
+	for i := range v.blocks1 + v.blocks2 {
+		n := v.data1
+		if i >= v.blocks1 {
+			n = v.data2
+		}
+		blocks = append(blocks, data[:n])
+		data = data[n:]
+	}
+
+	divisor := reedSolomonDivisor(v.ecPerBlock)
+	ec := make([][]byte, len(blocks))
+	for i, block := range blocks {
+		ec[i] = reedSolomonRemainder(block, divisor)
+	}
+
+	var result []byte
+	for i := range max(v.data1, v.data2) {
+		for _, block := range blocks {
+			if i < len(block) {
+				result = append(result, block[i])
+			}
+		}
+	}
+	for i := range v.ecPerBlock {
+		for _, block := range ec {
+			result = append(result, block[i])
+		}
+	}
+	return result
+}
+
+// drawFunctionPatterns draws the finder, timing and alignment patterns and
+// reserves the format and version areas.
+func (c *Code) drawFunctionPatterns(v version) {
+	for i := range c.Size {
+		c.setFunction(6, i, i%2 == 0)
+		c.setFunction(i, 6, i%2 == 0)
+	}
+
+	c.drawFinder(3, 3)
+	c.drawFinder(c.Size-4, 3)
+	c.drawFinder(3, c.Size-4)
+
+	last := len(v.alignment) - 1
+	for i, x := range v.alignment {
+		for j, y := range v.alignment {
+			// Skip the corners taken by finder patterns.
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			c.drawAlignment(x, y)
+		}
+	}
+
+	c.drawFormatBits(0)
+	c.drawVersion()
+}
+
+// drawFinder draws a finder pattern and its separator around center (x, y).
+func (c *Code) drawFinder(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+			if xx < 0 || yy < 0 || xx >= c.Size || yy >= c.Size {
+				continue
+			}
+			dist := max(abs(dx), abs(dy))
+			c.setFunction(xx, yy, dist != 2 && dist != 4)
+		}
+	}
+}
+
+// drawAlignment draws an alignment pattern around center (x, y).
+func (c *Code) drawAlignment(x, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// formatBits returns the 15-bit format information for level M and a mask.
+func formatBits(mask int) int {
+	data := 0b00<<3 | mask // Level M is 00.
+	rem := data
+	for range 10 {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+// drawFormatBits draws both copies of the format information.
+func (c *Code) drawFormatBits(mask int) {
+	bits := formatBits(mask)
+
+	for i := range 6 {
+		c.setFunction(8, i, bit(bits, i))
+	}
+	c.setFunction(8, 7, bit(bits, 6))
+	c.setFunction(8, 8, bit(bits, 7))
+	c.setFunction(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		c.setFunction(14-i, 8, bit(bits, i))
+	}
+
+	for i := range 8 {
+		c.setFunction(c.Size-1-i, 8, bit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		c.setFunction(8, c.Size-15+i, bit(bits, i))
+	}
+	c.setFunction(8, c.Size-8, true) // Always dark.
+}
+
+// versionBits returns the 18-bit version information.
+func versionBits(number int) int {
+	rem := number
+	for range 12 {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	return number<<12 | rem
+}
+
+// drawVersion draws both copies of the version information of versions 7
+// and up.
+func (c *Code) drawVersion() {
+	if c.Version < 7 {
+		return
+	}
+
+	bits := versionBits(c.Version)
+	for i := range 18 {
+		a, b := c.Size-11+i%3, i/3
+		c.setFunction(a, b, bit(bits, i))
+		c.setFunction(b, a, bit(bits, i))
+	}
+}
+
+// drawCodewords places the codewords in the two-column zigzag from the
+// bottom right corner, skipping function modules.
+func (c *Code) drawCodewords(codewords []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5 // Skip the vertical timing pattern.
+		}
+		upward := (right+1)&2 == 0
+		for vert := range c.Size {
+			y := vert
+			if upward {
+				y = c.Size - 1 - vert
+			}
+			for j := range 2 {
+				x := right - j
+				if c.isFunction[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+				c.modules[y][x] = codewords[i/8]>>(7-i%8)&1 == 1
+				i++
+			}
+		}
+	}
+}
+
+// applyMask flips the data modules selected by a mask pattern.
+func (c *Code) applyMask(mask int) {
+	for y := range c.Size {
+		for x := range c.Size {
+			if c.isFunction[y][x] {
+				continue
+			}
+			var flip bool
+			switch mask {
+			case 0:
+				flip = (x+y)%2 == 0
+			case 1:
+				flip = y%2 == 0
+			case 2:
+				flip = x%3 == 0
+			case 3:
+				flip = (x+y)%3 == 0
+			case 4:
+				flip = (x/3+y/2)%2 == 0
+			case 5:
+				flip = x*y%2+x*y%3 == 0
+			case 6:
+				flip = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				flip = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if flip {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// finderLike are the module sequences penalized by rule 3: a finder pattern
+// core next to four light modules.
+var finderLike = [][]bool{
+	{true, false, true, true, true, false, true, false, false, false, false},
+	{false, false, false, false, true, false, true, true, true, false, true},
+}
+
+// penalty scores how hard the code is to scan; masks aim to minimize it.
+func (c *Code) penalty() int {
+	score := 0
+
+	for _, line := range c.lines() {
+		// Rule 1: runs of five or more modules of the same color.
+		run := 1
+		for i := 1; i <= len(line); i++ {
+			if i < len(line) && line[i] == line[i-1] {
+				run++
+				continue
+			}
+			if run >= 5 {
+				score += 3 + run - 5
+			}
+			run = 1
+		}
+
+		// Rule 3: patterns that look like finder patterns.
+		for i := 0; i+11 <= len(line); i++ {
+			for _, pattern := range finderLike {
+				if equal(line[i:i+11], pattern) {
+					score += 40
+				}
+			}
+		}
+	}
+
+	// Rule 2: 2x2 blocks of the same color.
+	dark := 0
+	for y := range c.Size {
+		for x := range c.Size {
+			if c.modules[y][x] {
+				dark++
+			}
+			if x+1 < c.Size && y+1 < c.Size {
+				m := c.modules[y][x]
+				if m == c.modules[y][x+1] && m == c.modules[y+1][x] && m == c.modules[y+1][x+1] {
+					score += 3
+				}
+			}
+		}
+	}
+
+	// Rule 4: imbalance between dark and light modules, per 5% step away
+	// from half.
+	total := c.Size * c.Size
+	score += ((abs(dark*20-total*10)+total-1)/total - 1) * 10
+
+	return score
+}
+
+// lines returns every row and column of the code.
+func (c *Code) lines() [][]bool {
+	lines := make([][]bool, 0, 2*c.Size)
+	for y := range c.Size {
+		lines = append(lines, c.modules[y])
+	}
+	for x := range c.Size {
+		column := make([]bool, c.Size)
+		for y := range c.Size {
+			column[y] = c.modules[y][x]
+		}
+		lines = append(lines, column)
+	}
+	return lines
+}
+
+// setFunction sets a function module, which data and masks leave alone.
+func (c *Code) setFunction(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.isFunction[y][x] = true
+}
+
+// reedSolomonDivisor returns the generator polynomial of the given degree,
+// highest coefficient first and without its leading 1.
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	// Multiply by (x - r^i) for i from 0 to degree-1, with r = 0x02.
+	root := byte(1)
+	for range degree {
+		for j := range degree {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < degree {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+// reedSolomonRemainder returns the error correction codewords of data.
+func reedSolomonRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coef := range divisor {
+			result[i] ^= gfMultiply(coef, factor)
+		}
+	}
+	return result
+}
+
+// gfMultiply multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1.
+func gfMultiply(x, y byte) byte {
+	var z int
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int(y>>i&1) * int(x)
+	}
+	return byte(z)
+}
+
+// bitBuffer is a sequence of bits.
+type bitBuffer []bool
+
+// append appends the n low bits of value, most significant first.
+func (b *bitBuffer) append(value, n int) {
+	for i := n - 1; i >= 0; i-- {
+		*b = append(*b, value>>i&1 == 1)
+	}
+}
+
+func bit(value, i int) bool {
+	return value>>i&1 == 1
+}
+
+func equal(a, b []bool) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return len(a) == len(b)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,213 @@
+package qrcode
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeVersion(t *testing.T) {
+	tests := []struct {
+		length  int
+		version int
+	}{
+		{1, 1},
+		{14, 1},
+		{15, 2},
+		{180, 9},
+		{181, 10},
+		{MaxLength, 10},
+	}
+
+	for _, tt := range tests {
+		code, err := Encode(strings.Repeat("a", tt.length))
+		require.NoError(t, err)
+		assert.Equal(t, tt.version, code.Version, "length %d", tt.length)
+		assert.Equal(t, 17+4*tt.version, code.Size)
+	}
+
+	_, err := Encode(strings.Repeat("a", MaxLength+1))
+	assert.ErrorIs(t, err, ErrTooLong)
+}
+
+func TestFormatBits(t *testing.T) {
+	// Level M rows of the format information table (ISO/IEC 18004, table C.1).
+	expected := []int{
+		0b101010000010010,
+		0b101000100100101,
+		0b101111001111100,
+		0b101101101001011,
+		0b100010111111001,
+		0b100000011001110,
+		0b100111110010111,
+		0b100101010100000,
+	}
+	for mask, bits := range expected {
+		assert.Equal(t, bits, formatBits(mask), "mask %d", mask)
+	}
+}
+
+func TestVersionBits(t *testing.T) {
+	expected := map[int]int{7: 0x07C94, 8: 0x085BC, 9: 0x09A99, 10: 0x0A4D3}
+	for number, bits := range expected {
+		assert.Equal(t, bits, versionBits(number), "version %d", number)
+	}
+}
+
+func TestReedSolomon(t *testing.T) {
+	// "HELLO WORLD" in alphanumeric mode at version 1-M.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	expected := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	assert.Equal(t, expected, reedSolomonRemainder(data, reedSolomonDivisor(10)))
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	for _, text := range []string{
+		"https://example.com",
+		"https://portfolio.example.dev/" + strings.Repeat("x", 120),
+		strings.Repeat("é", MaxLength/2),
+	} {
+		code, err := Encode(text)
+		require.NoError(t, err)
+
+		assert.Equal(t, text, decode(t, code))
+	}
+}
+
+func TestEncodeFinderPatterns(t *testing.T) {
+	code, err := Encode("https://example.com")
+	require.NoError(t, err)
+
+	// Each finder's 7x7 ring is dark, with a light ring and a dark 3x3 core.
+	for _, corner := range [][2]int{{0, 0}, {code.Size - 7, 0}, {0, code.Size - 7}} {
+		x, y := corner[0], corner[1]
+		assert.True(t, code.Dark(x, y))
+		assert.True(t, code.Dark(x+6, y+6))
+		assert.False(t, code.Dark(x+1, y+1))
+		assert.True(t, code.Dark(x+3, y+3))
+	}
+	assert.False(t, code.Dark(-1, 0), "the quiet zone is light")
+}
+
+func TestDataURI(t *testing.T) {
+	code, err := Encode("https://example.com")
+	require.NoError(t, err)
+
+	uri := code.DataURI()
+	require.True(t, strings.HasPrefix(uri, "data:image/svg+xml;base64,"))
+
+	svg, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(uri, "data:image/svg+xml;base64,"))
+	require.NoError(t, err)
+	assert.Contains(t, string(svg), `viewBox="0 0 33 33"`)
+	assert.Contains(t, string(svg), "M4,4h1v1h-1z", "the top left module is dark, past the quiet zone")
+}
+
+func TestEncoder(t *testing.T) {
+	uri, err := Encoder{}.DataURI("https://example.com")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(uri, "data:image/svg+xml;base64,"))
+
+	_, err = Encoder{}.DataURI(strings.Repeat("a", MaxLength+1))
+	assert.ErrorIs(t, err, ErrTooLong)
+}
+
+// decode reads a code back: it reads the mask from the format information,
+// unmasks the data modules, de-interleaves the blocks, checks their error
+// correction and decodes the byte mode segment.
+func decode(t *testing.T, code *Code) string {
+	t.Helper()
+
+	var format int
+	for i := range 6 {
+		format |= b2i(code.modules[i][8]) << i
+	}
+	format |= b2i(code.modules[7][8])<<6 | b2i(code.modules[8][8])<<7 | b2i(code.modules[8][7])<<8
+	for i := 9; i < 15; i++ {
+		format |= b2i(code.modules[8][14-i]) << i
+	}
+	mask := -1
+	for m := range 8 {
+		if formatBits(m) == format {
+			mask = m
+		}
+	}
+	require.NotEqual(t, -1, mask, "format information does not match any level M mask")
+
+	code.applyMask(mask)
+	defer code.applyMask(mask)
+
+	v := versions[code.Version-1]
+	total := v.dataCodewords() + (v.blocks1+v.blocks2)*v.ecPerBlock
+	raw := make([]byte, total)
+	i := 0
+	for right := code.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		upward := (right+1)&2 == 0
+		for vert := range code.Size {
+			y := vert
+			if upward {
+				y = code.Size - 1 - vert
+			}
+			for j := range 2 {
+				x := right - j
+				if code.isFunction[y][x] || i >= total*8 {
+					continue
+				}
+				raw[i/8] |= byte(b2i(code.modules[y][x])) << (7 - i%8)
+				i++
+			}
+		}
+	}
+
+	// De-interleave into data and error correction blocks.
+	numBlocks := v.blocks1 + v.blocks2
+	blocks := make([][]byte, numBlocks)
+	pos := 0
+	for i := range max(v.data1, v.data2) {
+		for b := range numBlocks {
+			if (b < v.blocks1 && i < v.data1) || (b >= v.blocks1 && i < v.data2) {
+				blocks[b] = append(blocks[b], raw[pos])
+				pos++
+			}
+		}
+	}
+	divisor := reedSolomonDivisor(v.ecPerBlock)
+	var data []byte
+	for i, block := range blocks {
+		var ec []byte
+		for j := range v.ecPerBlock {
+			ec = append(ec, raw[pos+j*numBlocks+i])
+		}
+		require.Equal(t, reedSolomonRemainder(block, divisor), ec, "block %d", i)
+		data = append(data, block...)
+	}
+
+	require.Equal(t, byte(0b0100), data[0]>>4, "byte mode")
+	if countBits(code.Version) == 8 {
+		length := int(data[0]&0x0F)<<4 | int(data[1]>>4)
+		out := make([]byte, length)
+		for i := range length {
+			out[i] = data[1+i]<<4 | data[2+i]>>4
+		}
+		return string(out)
+	}
+	length := int(data[0]&0x0F)<<12 | int(data[1])<<4 | int(data[2]>>4)
+	out := make([]byte, length)
+	for i := range length {
+		out[i] = data[2+i]<<4 | data[3+i]>>4
+	}
+	return string(out)
+}
+
+func b2i(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
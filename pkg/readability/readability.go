@@ -0,0 +1,113 @@
+// Package readability estimates how easy a text is to read, with the Flesch
+// reading ease score.
+//
+// Syllables are counted as groups of vowels, which is close enough for the
+// short sentences of a resume. English drops a final silent e; Portuguese
+// counts accented vowels and uses the Fernandes adaptation of the formula,
+// which raises the constant to account for its longer words.
+package readability
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Stats are the counts a readability score is computed from.
+type Stats struct {
+	Sentences int
+	Words     int
+	Syllables int
+}
+
+// Count returns the sentences, words and syllables of text in lang, a
+// language code such as "en" or "pt-br". Text without sentence punctuation
+// counts as one sentence.
+func Count(text, lang string) Stats {
+	portuguese := isPortuguese(lang)
+
+	var stats Stats
+	ended := true
+	for _, field := range strings.Fields(text) {
+		word := strings.TrimFunc(field, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+		if word == "" {
+			continue
+		}
+		stats.Words++
+		stats.Syllables += syllables(strings.ToLower(word), portuguese)
+		ended = strings.ContainsAny(field[len(field)-1:], ".!?;")
+		if ended {
+			stats.Sentences++
+		}
+	}
+	if !ended {
+		stats.Sentences++
+	}
+	return stats
+}
+
+// ReadingEase returns the Flesch reading ease of text in lang, from about 0
+// (very hard) to 100 (very easy); scores may fall outside that range. Text
+// without words scores 0.
+func ReadingEase(text, lang string) float64 {
+	stats := Count(text, lang)
+	if stats.Words == 0 {
+		return 0
+	}
+
+	base := 206.835
+	if isPortuguese(lang) {
+		base = 248.835
+	}
+	wordsPerSentence := float64(stats.Words) / float64(stats.Sentences)
+	syllablesPerWord := float64(stats.Syllables) / float64(stats.Words)
+	return base - 1.015*wordsPerSentence - 84.6*syllablesPerWord
+}
+
+// isPortuguese reports whether lang is a Portuguese language code.
+func isPortuguese(lang string) bool {
+	return strings.HasPrefix(strings.ToLower(lang), "pt")
+}
+
+// syllables estimates the syllables of a lowercase word, with at least one.
+func syllables(word string, portuguese bool) int {
+	count := 0
+	inVowels := false
+	for _, r := range word {
+		vowel := isVowel(r, portuguese)
+		if vowel && !inVowels {
+			count++
+		}
+		inVowels = vowel
+	}
+	if !portuguese && count > 1 && silentEnding(word) {
+		count--
+	}
+	return max(count, 1)
+}
+
+// silentEnding reports whether an English word ends in a silent e, as in
+// "make", or a silent ed, as in "reduced" but not "created".
+func silentEnding(word string) bool {
+	switch {
+	case strings.HasSuffix(word, "le"):
+		return false
+	case strings.HasSuffix(word, "e"):
+		return true
+	case strings.HasSuffix(word, "ed"):
+		return !strings.HasSuffix(word, "ted") && !strings.HasSuffix(word, "ded")
+	}
+	return false
+}
+
+// isVowel reports whether r is a vowel. y is a vowel in English only.
+func isVowel(r rune, portuguese bool) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u':
+		return true
+	case 'y':
+		return !portuguese
+	case 'á', 'à', 'â', 'ã', 'é', 'ê', 'í', 'ó', 'ô', 'õ', 'ú', 'ü':
+		return portuguese
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package readability_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/pkg/readability"
+)
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		lang string
+		want readability.Stats
+	}{
+		{name: "empty", text: "", lang: "en", want: readability.Stats{}},
+		{
+			name: "one sentence without period",
+			text: "Built the billing API",
+			lang: "en",
+			want: readability.Stats{Sentences: 1, Words: 4, Syllables: 6},
+		},
+		{
+			name: "silent endings",
+			text: "Reduced the code size. Made it simple!",
+			lang: "en",
+			want: readability.Stats{Sentences: 2, Words: 7, Syllables: 9},
+		},
+		{
+			name: "symbols are not words",
+			text: "Cut costs — by 40%",
+			lang: "en",
+			want: readability.Stats{Sentences: 1, Words: 4, Syllables: 4},
+		},
+		{
+			name: "portuguese accented vowels",
+			text: "Reduzi a latência.",
+			lang: "pt-br",
+			want: readability.Stats{Sentences: 1, Words: 3, Syllables: 7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, readability.Count(tt.text, tt.lang))
+		})
+	}
+}
+
+func TestReadingEase(t *testing.T) {
+	assert.Zero(t, readability.ReadingEase("", "en"))
+	assert.Zero(t, readability.ReadingEase("— ...", "en"))
+
+	simple := readability.ReadingEase("Led a team. Cut costs.", "en")
+	dense := readability.ReadingEase("Orchestrated comprehensive infrastructure modernization initiatives", "en")
+	assert.Greater(t, simple, 100.0)
+	assert.Less(t, dense, 0.0)
+
+	// 4 words and 6 syllables in one sentence.
+	assert.InDelta(t, 206.835-1.015*4-84.6*1.5, readability.ReadingEase("Built the billing API", "en"), 0.001)
+	assert.InDelta(t, 248.835-1.015*4-84.6*1.5, readability.ReadingEase("Built the billing API", "pt-br"), 0.001)
+}
@@ -0,0 +1,64 @@
+package signedurl
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSigner returns a signer with a controllable clock.
+func newTestSigner(t *testing.T) (*Signer, *time.Time) {
+	t.Helper()
+
+	s, err := New([]byte(strings.Repeat("k", MinKeySize)))
+	require.NoError(t, err)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	return s, &now
+}
+
+func TestNewRejectsShortKeys(t *testing.T) {
+	_, err := New([]byte("short"))
+	assert.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	s, now := newTestSigner(t)
+	query := s.Sign("resumes/user-1/resume-1.pdf", 15*time.Minute)
+
+	t.Run("accepts a valid signature", func(t *testing.T) {
+		assert.NoError(t, s.Verify("resumes/user-1/resume-1.pdf", query))
+	})
+
+	t.Run("rejects another path", func(t *testing.T) {
+		assert.ErrorIs(t, s.Verify("resumes/user-2/resume-1.pdf", query), ErrInvalidSignature)
+	})
+
+	t.Run("rejects a tampered expiry", func(t *testing.T) {
+		tampered := url.Values{
+			ExpiresParam:   {"99999999999"},
+			SignatureParam: {query.Get(SignatureParam)},
+		}
+		assert.ErrorIs(t, s.Verify("resumes/user-1/resume-1.pdf", tampered), ErrInvalidSignature)
+	})
+
+	t.Run("rejects another key", func(t *testing.T) {
+		other, err := New([]byte(strings.Repeat("o", MinKeySize)))
+		require.NoError(t, err)
+		assert.ErrorIs(t, other.Verify("resumes/user-1/resume-1.pdf", query), ErrInvalidSignature)
+	})
+
+	t.Run("rejects missing parameters", func(t *testing.T) {
+		assert.ErrorIs(t, s.Verify("resumes/user-1/resume-1.pdf", url.Values{}), ErrInvalidSignature)
+	})
+
+	t.Run("rejects expired URLs", func(t *testing.T) {
+		*now = now.Add(16 * time.Minute)
+		assert.ErrorIs(t, s.Verify("resumes/user-1/resume-1.pdf", query), ErrExpired)
+	})
+}
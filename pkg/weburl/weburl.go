@@ -0,0 +1,123 @@
+// Package weburl validates and normalizes the web addresses users enter, such
+// as portfolio, profile and job posting links.
+//
+// Users paste links in many shapes: without a scheme, with tracking
+// parameters copied from a share button, or pointing at a country subdomain.
+// Normalize turns them into one https or http form so that the same link is
+// stored once and prints cleanly on resumes.
+package weburl
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// Errors returned for addresses that cannot be normalized.
+var (
+	// ErrInvalid is returned for text that is not a web address.
+	ErrInvalid = errors.New("must be a valid web address, such as https://example.com")
+
+	// ErrScheme is returned for addresses with a scheme other than http or
+	// https, such as ftp: or javascript:.
+	ErrScheme = errors.New("must be an http or https address")
+
+	// ErrNotLinkedInProfile is returned by NormalizeLinkedIn for addresses
+	// that are not a LinkedIn profile.
+	ErrNotLinkedInProfile = errors.New("must be a LinkedIn profile, such as https://www.linkedin.com/in/your-name")
+)
+
+// trackingParams holds the query parameters, lowercased, that only track
+// where a click came from. Parameters starting with utm_ are tracking too.
+var trackingParams = map[string]bool{
+	"fbclid": true, "gclid": true, "dclid": true, "msclkid": true, "yclid": true,
+	"mc_cid": true, "mc_eid": true, "igshid": true, "_hsenc": true, "_hsmi": true,
+	"trk": true, "trackingid": true, "refid": true, "lipi": true,
+}
+
+// Normalize validates a web address and returns its normalized form: https
+// is assumed when the scheme is missing, the scheme and host are lowercased
+// and tracking parameters are removed. Addresses with credentials or without
+// a domain name are invalid.
+func Normalize(raw string) (string, error) {
+	u, err := parse(raw)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// NormalizeLinkedIn validates a LinkedIn profile address and returns it in
+// the canonical https://www.linkedin.com/in/<handle> form, whatever the
+// subdomain, query or trailing path it was given with.
+func NormalizeLinkedIn(raw string) (string, error) {
+	u, err := parse(raw)
+	if err != nil {
+		return "", err
+	}
+
+	// Country subdomains, such as br.linkedin.com, serve the same profiles.
+	host := u.Hostname()
+	if host != "linkedin.com" && !strings.HasSuffix(host, ".linkedin.com") {
+		return "", ErrNotLinkedInProfile
+	}
+
+	parts := strings.Split(strings.Trim(u.EscapedPath(), "/"), "/")
+	if len(parts) < 2 || !strings.EqualFold(parts[0], "in") || parts[1] == "" {
+		return "", ErrNotLinkedInProfile
+	}
+	return "https://www.linkedin.com/in/" + strings.ToLower(parts[1]), nil
+}
+
+// parse parses raw as a web address and normalizes its scheme, host and
+// query.
+func parse(raw string) (*url.URL, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, ErrInvalid
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + strings.TrimPrefix(raw, "//")
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, ErrScheme
+	}
+	if u.User != nil || !strings.Contains(strings.Trim(u.Hostname(), "."), ".") {
+		return nil, ErrInvalid
+	}
+
+	u.Host = strings.ToLower(u.Host)
+	u.RawQuery = stripTracking(u.RawQuery)
+	u.ForceQuery = false
+	return u, nil
+}
+
+// stripTracking removes the tracking parameters from a raw query, keeping the
+// order of the others.
+func stripTracking(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+
+	kept := make([]string, 0, strings.Count(rawQuery, "&")+1)
+	for _, param := range strings.Split(rawQuery, "&") {
+		if param == "" {
+			continue
+		}
+		key, _, _ := strings.Cut(param, "=")
+		if key, err := url.QueryUnescape(key); err == nil {
+			key = strings.ToLower(key)
+			if strings.HasPrefix(key, "utm_") || trackingParams[key] {
+				continue
+			}
+		}
+		kept = append(kept, param)
+	}
+	return strings.Join(kept, "&")
+}
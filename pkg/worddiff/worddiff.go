@@ -0,0 +1,147 @@
+// Package worddiff computes word-level differences between two texts, for
+// showing what changed in rewritten sentences.
+//
+// Texts are split into words and the whitespace between them, and the
+// longest common subsequence of the two is kept as unchanged. Whitespace
+// belongs to the segments around it, so joining the text of the equal and
+// deleted segments gives back the old text, and of the equal and inserted
+// segments the new one.
+package worddiff
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Op is the kind of a Segment.
+type Op string
+
+// Segment kinds.
+const (
+	Equal  Op = "equal"
+	Delete Op = "delete"
+	Insert Op = "insert"
+)
+
+// Segment is a run of text of one kind.
+type Segment struct {
+	Op   Op     `json:"op"`
+	Text string `json:"text"`
+}
+
+// MaxTokens bounds the words and spaces of each text. Longer texts are
+// compared as a whole, as one deletion and one insertion, since the
+// comparison takes time and memory in the product of their lengths.
+const MaxTokens = 2000
+
+// Diff returns the segments turning oldText into newText. Where a deletion
+// and an insertion meet, the deletion comes first.
+func Diff(oldText, newText string) []Segment {
+	if oldText == newText {
+		if oldText == "" {
+			return nil
+		}
+		return []Segment{{Op: Equal, Text: oldText}}
+	}
+
+	a, b := tokenize(oldText), tokenize(newText)
+	if len(a) > MaxTokens || len(b) > MaxTokens {
+		var segments []Segment
+		segments = appendSegment(segments, Delete, oldText)
+		return appendSegment(segments, Insert, newText)
+	}
+
+	// lengths[i][j] is the length of the longest common subsequence of
+	// a[i:] and b[j:].
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	var segments []Segment
+	var deleted, inserted strings.Builder
+	flush := func() {
+		segments = appendSegment(segments, Delete, deleted.String())
+		segments = appendSegment(segments, Insert, inserted.String())
+		deleted.Reset()
+		inserted.Reset()
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			flush()
+			segments = appendSegment(segments, Equal, a[i])
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lengths[i+1][j] >= lengths[i][j+1]):
+			deleted.WriteString(a[i])
+			i++
+		default:
+			inserted.WriteString(b[j])
+			j++
+		}
+	}
+	flush()
+
+	return segments
+}
+
+// Changed reports whether segments hold any deletion or insertion.
+func Changed(segments []Segment) bool {
+	for _, segment := range segments {
+		if segment.Op != Equal {
+			return true
+		}
+	}
+	return false
+}
+
+// appendSegment appends text to segments, merging it into the last segment
+// when it is of the same kind. Empty texts are dropped.
+func appendSegment(segments []Segment, op Op, text string) []Segment {
+	if text == "" {
+		return segments
+	}
+	if n := len(segments); n > 0 && segments[n-1].Op == op {
+		segments[n-1].Text += text
+		return segments
+	}
+	return append(segments, Segment{Op: op, Text: text})
+}
+
+// tokenize splits text into runs of whitespace and runs of other
+// characters.
+func tokenize(text string) []string {
+	var tokens []string
+	start := 0
+	for i, r := range text {
+		if i > start && unicode.IsSpace(r) != isSpaceAt(text, start) {
+			tokens = append(tokens, text[start:i])
+			start = i
+		}
+	}
+	if start < len(text) {
+		tokens = append(tokens, text[start:])
+	}
+	return tokens
+}
+
+// isSpaceAt reports whether the character at byte offset i of text is
+// whitespace.
+func isSpaceAt(text string, i int) bool {
+	for _, r := range text[i:] {
+		return unicode.IsSpace(r)
+	}
+	return false
+}
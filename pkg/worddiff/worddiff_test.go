@@ -0,0 +1,114 @@
+package worddiff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/SeltikHD/chameleon-vitae/pkg/worddiff"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		want     []worddiff.Segment
+	}{
+		{name: "both empty", old: "", new: "", want: nil},
+		{
+			name: "unchanged",
+			old:  "Built the API",
+			new:  "Built the API",
+			want: []worddiff.Segment{{Op: worddiff.Equal, Text: "Built the API"}},
+		},
+		{
+			name: "added",
+			old:  "",
+			new:  "Built the API",
+			want: []worddiff.Segment{{Op: worddiff.Insert, Text: "Built the API"}},
+		},
+		{
+			name: "replaced word",
+			old:  "Built the REST API",
+			new:  "Designed the REST API",
+			want: []worddiff.Segment{
+				{Op: worddiff.Delete, Text: "Built"},
+				{Op: worddiff.Insert, Text: "Designed"},
+				{Op: worddiff.Equal, Text: " the REST API"},
+			},
+		},
+		{
+			name: "inserted words",
+			old:  "Cut latency with caching",
+			new:  "Cut p99 latency by 40% with caching",
+			want: []worddiff.Segment{
+				{Op: worddiff.Equal, Text: "Cut "},
+				{Op: worddiff.Insert, Text: "p99 "},
+				{Op: worddiff.Equal, Text: "latency "},
+				{Op: worddiff.Insert, Text: "by 40% "},
+				{Op: worddiff.Equal, Text: "with caching"},
+			},
+		},
+		{
+			name: "deleted words",
+			old:  "Led a team of five engineers",
+			new:  "Led five engineers",
+			want: []worddiff.Segment{
+				{Op: worddiff.Equal, Text: "Led "},
+				{Op: worddiff.Delete, Text: "a team of "},
+				{Op: worddiff.Equal, Text: "five engineers"},
+			},
+		},
+		{
+			name: "punctuation belongs to words",
+			old:  "Shipped v2.",
+			new:  "Shipped v2 early.",
+			want: []worddiff.Segment{
+				{Op: worddiff.Equal, Text: "Shipped "},
+				{Op: worddiff.Delete, Text: "v2."},
+				{Op: worddiff.Insert, Text: "v2 early."},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, worddiff.Diff(tt.old, tt.new))
+		})
+	}
+}
+
+func TestDiffRebuildsTexts(t *testing.T) {
+	oldText := "Migrated  the billing service\nto Go, halving costs"
+	newText := "Rewrote the billing service in Go,\nhalving infrastructure costs"
+
+	var rebuiltOld, rebuiltNew strings.Builder
+	for _, segment := range worddiff.Diff(oldText, newText) {
+		if segment.Op != worddiff.Insert {
+			rebuiltOld.WriteString(segment.Text)
+		}
+		if segment.Op != worddiff.Delete {
+			rebuiltNew.WriteString(segment.Text)
+		}
+	}
+
+	assert.Equal(t, oldText, rebuiltOld.String())
+	assert.Equal(t, newText, rebuiltNew.String())
+}
+
+func TestDiffLongTexts(t *testing.T) {
+	oldText := strings.Repeat("word ", worddiff.MaxTokens)
+	newText := oldText + "more"
+
+	assert.Equal(t, []worddiff.Segment{
+		{Op: worddiff.Delete, Text: oldText},
+		{Op: worddiff.Insert, Text: newText},
+	}, worddiff.Diff(oldText, newText))
+}
+
+func TestChanged(t *testing.T) {
+	assert.False(t, worddiff.Changed(nil))
+	assert.False(t, worddiff.Changed(worddiff.Diff("same", "same")))
+	assert.True(t, worddiff.Changed(worddiff.Diff("old", "new")))
+}
@@ -0,0 +1,250 @@
+// Package yamljson converts documents between JSON and YAML, so that types
+// with JSON tags can be read and written as YAML.
+//
+// Keys keep their order and strings stay strings: a JSON "2024-01-15" or
+// "true" is quoted in YAML rather than read back as a date or a boolean.
+// Strings spanning several lines are written as YAML literal blocks, which
+// read like the text they hold.
+package yamljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// ContentType is the media type of YAML documents.
+const ContentType = "application/yaml"
+
+// Limits of ToJSON, so that untrusted documents cannot exhaust the stack or
+// the memory: aliases referring to their own anchor are rejected, and
+// aliases to aliases cannot expand a short document exponentially.
+const (
+	// maxDepth is the deepest nesting of collections, aliases expanded.
+	maxDepth = 100
+
+	// maxAliasBytes is the most JSON written for expanded aliases.
+	maxAliasBytes = 1 << 20
+)
+
+// FromJSON converts a JSON document to YAML.
+func FromJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	node, err := nodeFromJSON(decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// nodeFromJSON reads the next JSON value of decoder as a YAML node.
+func nodeFromJSON(decoder *json.Decoder) (*yaml.Node, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := token.(type) {
+	case json.Delim:
+		if value == '[' {
+			node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+			for decoder.More() {
+				item, err := nodeFromJSON(decoder)
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, item)
+			}
+			_, err := decoder.Token()
+			return node, err
+		}
+
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			item, err := nodeFromJSON(decoder)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, stringNode(key.(string)), item)
+		}
+		_, err := decoder.Token()
+		return node, err
+	case string:
+		return stringNode(value), nil
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(value.String(), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value.String()}, nil
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: fmt.Sprint(value)}, nil
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	}
+}
+
+// stringNode returns the node of a string.
+func stringNode(value string) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+	if strings.Contains(strings.TrimSuffix(value, "\n"), "\n") {
+		node.Style = yaml.LiteralStyle
+	}
+	return node
+}
+
+// ToJSON converts a YAML document to JSON. Mapping keys must be strings,
+// and dates are converted as the strings they are written as. Aliases are
+// expanded, within maxDepth and maxAliasBytes.
+func ToJSON(data []byte) ([]byte, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	if document.Kind == 0 {
+		return nil, errors.New("yaml: empty document")
+	}
+
+	w := &jsonWriter{expanding: make(map[*yaml.Node]bool)}
+	if err := w.write(&document, 0); err != nil {
+		return nil, err
+	}
+	return w.buf.Bytes(), nil
+}
+
+// jsonWriter writes YAML nodes as JSON.
+type jsonWriter struct {
+	buf bytes.Buffer
+
+	// expanding holds the anchors of the aliases being expanded.
+	expanding map[*yaml.Node]bool
+
+	// aliasBytes is the JSON written for the expanded aliases before the
+	// one being expanded, which started at aliasStart.
+	aliasBytes int
+	aliasStart int
+}
+
+// write writes a YAML node, nested depth collections deep, as JSON.
+func (w *jsonWriter) write(node *yaml.Node, depth int) error {
+	if depth >= maxDepth {
+		return fmt.Errorf("yaml: line %d: document nested more than %d levels deep", node.Line, maxDepth)
+	}
+
+	buf := &w.buf
+	switch node.Kind {
+	case yaml.DocumentNode:
+		return w.write(node.Content[0], depth)
+	case yaml.AliasNode:
+		return w.writeAlias(node, depth)
+	case yaml.SequenceNode:
+		buf.WriteByte('[')
+		for i, item := range node.Content {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := w.write(item, depth+1); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	case yaml.MappingNode:
+		buf.WriteByte('{')
+		for i := 0; i < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Kind != yaml.ScalarNode || key.ShortTag() != "!!str" {
+				return fmt.Errorf("yaml: line %d: mapping keys must be strings", key.Line)
+			}
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeScalar(buf, key.Value); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := w.write(value, depth+1); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	}
+
+	var value any
+	switch node.ShortTag() {
+	case "!!null":
+		value = nil
+	case "!!bool", "!!int", "!!float":
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+	default:
+		value = node.Value
+	}
+	if err := writeScalar(buf, value); err != nil {
+		return fmt.Errorf("yaml: line %d: %w", node.Line, err)
+	}
+	return nil
+}
+
+// writeAlias writes the node an alias refers to, unless the alias is within
+// that node or the aliases expanded so far exceed maxAliasBytes.
+func (w *jsonWriter) writeAlias(alias *yaml.Node, depth int) error {
+	anchor := alias.Alias
+	if w.expanding[anchor] {
+		return fmt.Errorf("yaml: line %d: alias *%s refers to itself", alias.Line, alias.Value)
+	}
+
+	// Nested aliases are counted with the outermost one, checked as each
+	// of them ends so that an expansion stops soon after the limit.
+	outermost := len(w.expanding) == 0
+	if outermost {
+		w.aliasStart = w.buf.Len()
+	}
+	w.expanding[anchor] = true
+	err := w.write(anchor, depth+1)
+	delete(w.expanding, anchor)
+	if err != nil {
+		return err
+	}
+
+	expanded := w.aliasBytes + w.buf.Len() - w.aliasStart
+	if expanded > maxAliasBytes {
+		return fmt.Errorf("yaml: line %d: aliases expand to more than %d bytes", alias.Line, maxAliasBytes)
+	}
+	if outermost {
+		w.aliasBytes = expanded
+	}
+	return nil
+}
+
+// writeScalar writes a scalar to buf as JSON.
+func writeScalar(buf *bytes.Buffer, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	buf.Write(data)
+	return nil
+}
@@ -0,0 +1,132 @@
+package yamljson_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SeltikHD/chameleon-vitae/pkg/yamljson"
+)
+
+func TestFromJSON(t *testing.T) {
+	yaml, err := yamljson.FromJSON([]byte(`{
+		"version": 1,
+		"name": "Ada",
+		"summary": "First line\nSecond line",
+		"started": "2024-01-15",
+		"remote": "true",
+		"score": 0.5,
+		"current": true,
+		"end": null,
+		"tags": ["go", "sql"],
+		"empty": []
+	}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, `version: 1
+name: Ada
+summary: |-
+  First line
+  Second line
+started: "2024-01-15"
+remote: "true"
+score: 0.5
+current: true
+end: null
+tags:
+  - go
+  - sql
+empty: []
+`, string(yaml))
+}
+
+func TestToJSON(t *testing.T) {
+	json, err := yamljson.ToJSON([]byte(`
+version: 1
+name: Ada
+summary: |
+  First line
+  Second line
+started: 2024-01-15
+score: 0.5
+current: true
+end: ~
+tags: [go, sql]
+defaults: &defaults
+  level: 3
+copy: *defaults
+`))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"version": 1,
+		"name": "Ada",
+		"summary": "First line\nSecond line\n",
+		"started": "2024-01-15",
+		"score": 0.5,
+		"current": true,
+		"end": null,
+		"tags": ["go", "sql"],
+		"defaults": {"level": 3},
+		"copy": {"level": 3}
+	}`, string(json))
+}
+
+func TestToJSONErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+	}{
+		{name: "empty document", yaml: ""},
+		{name: "invalid syntax", yaml: "name: [unclosed"},
+		{name: "non-string key", yaml: "1: one"},
+		{name: "self-referencing alias", yaml: "a: &a [*a]"},
+		{name: "alias within its anchor", yaml: "a: &a {b: [1, {c: *a}]}"},
+		{name: "nested aliases", yaml: nestedAliases(9)},
+		{name: "deep nesting", yaml: strings.Repeat("[", maxDepth+1) + strings.Repeat("]", maxDepth+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := yamljson.ToJSON([]byte(tt.yaml))
+			assert.Error(t, err)
+		})
+	}
+}
+
+// maxDepth is the nesting limit of ToJSON.
+const maxDepth = 100
+
+// nestedAliases returns a document of levels anchors, each a list of ten
+// aliases to the previous one, whose last alias expands to 10^levels
+// strings.
+func nestedAliases(levels int) string {
+	var doc strings.Builder
+	doc.WriteString("a0: &a0 [lol]\n")
+	for i := 1; i <= levels; i++ {
+		alias := fmt.Sprintf("*a%d", i-1)
+		fmt.Fprintf(&doc, "a%d: &a%d [%s]\n", i, i, strings.Repeat(alias+",", 9)+alias)
+	}
+	fmt.Fprintf(&doc, "bomb: *a%d\n", levels)
+	return doc.String()
+}
+
+func TestToJSONNestedAliasesWithinLimit(t *testing.T) {
+	json, err := yamljson.ToJSON([]byte(nestedAliases(2)))
+	require.NoError(t, err)
+	assert.Equal(t, 100, strings.Count(string(json[strings.Index(string(json), `"bomb"`):]), "lol"))
+}
+
+func TestRoundTrip(t *testing.T) {
+	original := `{"a":"multi\nline","b":[1,2.5,"3"],"c":{"d":"true","e":false}}`
+
+	yaml, err := yamljson.FromJSON([]byte(original))
+	require.NoError(t, err)
+	json, err := yamljson.ToJSON(yaml)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, original, string(json))
+}